@@ -1,19 +1,32 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/sigs"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
-// BaseApp adds DeliverTx, CheckTx, and BeginBlock
+// BaseApp adds DeliverTx, CheckTx, BeginBlock and EndBlock
 // handlers to the storage and query functionality of StoreApp
 type BaseApp struct {
 	*StoreApp
 	decoder weave.TxDecoder
-	handler weave.Handler
-	ticker  weave.Ticker
-	debug   bool
+	// checkDecoder is optional. If set, it is used instead of the decoder
+	// by CheckTx.
+	checkDecoder weave.TxDecoder
+	handler      weave.Handler
+	ticker       weave.Ticker
+	debug        bool
+	// checkCache is optional. If set, CheckTx signature verifications are
+	// cached.
+	checkCache *CheckCache
+	// endBlockHooks are called in the order of registration at the end of
+	// each block.
+	endBlockHooks []weave.EndBlockHook
 }
 
 var _ abci.Application = BaseApp{}
@@ -35,13 +48,43 @@ func NewBaseApp(
 	}
 }
 
+// WithCheckCache configures the application to use given cache for CheckTx
+// signature verifications. Use nil to disable caching.
+func (b BaseApp) WithCheckCache(c *CheckCache) BaseApp {
+	if c != nil {
+		height, _ := weave.GetHeight(b.BlockContext())
+		c.Commit(height)
+	}
+	b.checkCache = c
+	return b
+}
+
+// WithCheckTxDecoder configures the application to use given decoder for
+// CheckTx only. DeliverTx is always using the decoder the application was
+// created with. Use it to apply node specific admission policies, for
+// example decode limits, that must not influence the consensus.
+func (b BaseApp) WithCheckTxDecoder(decoder weave.TxDecoder) BaseApp {
+	b.checkDecoder = decoder
+	return b
+}
+
+// WithEndBlockHook configures the application to call given hook at the end
+// of each block. Many hooks can be registered. They are called in the order of
+// registration and their tags are included in the EndBlock response in the
+// same order. A hook that fails is logged and its changes are discarded.
+func (b BaseApp) WithEndBlockHook(fn weave.EndBlockHook) BaseApp {
+	hooks := make([]weave.EndBlockHook, len(b.endBlockHooks), len(b.endBlockHooks)+1)
+	copy(hooks, b.endBlockHooks)
+	b.endBlockHooks = append(hooks, fn)
+	return b
+}
+
 // DeliverTx - ABCI - dispatches to the handler
 func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
-	tx, err := b.loadTx(txBytes)
+	tx, err := loadTx(b.decoder, txBytes)
 	if err != nil {
 		return weave.DeliverTxError(err, b.debug)
 	}
-
 	// ignore error here, allow it to be logged
 	ctx := weave.WithLogInfo(b.BlockContext(),
 		"call", "deliver_tx",
@@ -56,7 +99,11 @@ func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
 
 // CheckTx - ABCI - dispatches to the handler
 func (b BaseApp) CheckTx(txBytes []byte) abci.ResponseCheckTx {
-	tx, err := b.loadTx(txBytes)
+	decoder := b.decoder
+	if b.checkDecoder != nil {
+		decoder = b.checkDecoder
+	}
+	tx, err := loadTx(decoder, txBytes)
 	if err != nil {
 		return weave.CheckTxError(err, b.debug)
 	}
@@ -64,11 +111,25 @@ func (b BaseApp) CheckTx(txBytes []byte) abci.ResponseCheckTx {
 	ctx := weave.WithLogInfo(b.BlockContext(),
 		"call", "check_tx",
 		"path", weave.GetPath(tx))
+	if b.checkCache != nil {
+		ctx = sigs.WithVerificationCache(ctx, b.checkCache)
+	}
 
 	res, err := b.handler.Check(ctx, b.CheckStore(), tx)
 	return weave.CheckOrError(res, err, b.debug)
 }
 
+// Commit - ABCI - commits the state and drops expired cached CheckTx
+// signature verifications
+func (b BaseApp) Commit() abci.ResponseCommit {
+	res := b.StoreApp.Commit()
+	if b.checkCache != nil {
+		height, _ := weave.GetHeight(b.BlockContext())
+		b.checkCache.Commit(height)
+	}
+	return res
+}
+
 // BeginBlock - ABCI
 func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	// default: set the context properly
@@ -84,9 +145,46 @@ func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock
 	return response
 }
 
+// EndBlock - ABCI - calls all end block hooks and returns the validator
+// changes made in this block
+func (b BaseApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	var tags []common.KVPair
+	ctx := weave.WithLogInfo(b.BlockContext(), "call", "end_block")
+	for i, fn := range b.endBlockHooks {
+		hookTags, err := b.endBlockHook(ctx, fn)
+		if err != nil {
+			weave.GetLogger(ctx).Error("end block hook failed", "hook", i, "err", err)
+			continue
+		}
+		tags = append(tags, hookTags...)
+	}
+
+	response := b.StoreApp.EndBlock(req)
+	response.Tags = tags
+	return response
+}
+
+// endBlockHook calls the hook with a cache wrap of the deliver store, that is
+// written only if the hook succeeded.
+func (b BaseApp) endBlockHook(ctx weave.Context, fn weave.EndBlockHook) ([]common.KVPair, error) {
+	cache := b.DeliverStore().CacheWrap()
+	tags, err := fn(ctx, cache)
+	if err != nil {
+		cache.Discard()
+		return nil, err
+	}
+	if err := cache.Write(); err != nil {
+		// Writing to the deliver store cache can fail only because of
+		// an instance specific issue. This node cannot continue with
+		// a state that differs from the rest of the network.
+		panic(fmt.Sprintf("cannot write end block hook changes: %+v", err))
+	}
+	return tags, nil
+}
+
 // loadTx calls the decoder, and capture any panics
-func (b BaseApp) loadTx(txBytes []byte) (tx weave.Tx, err error) {
+func loadTx(decoder weave.TxDecoder, txBytes []byte) (tx weave.Tx, err error) {
 	defer errors.Recover(&err)
-	tx, err = b.decoder(txBytes)
+	tx, err = decoder(txBytes)
 	return
 }
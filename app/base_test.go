@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestBaseAppEndBlockHooks(t *testing.T) {
+	countKey := []byte("count")
+
+	// Each delivered transaction increments the counter.
+	handler := &countingHandler{key: countKey}
+	decoder := func(raw []byte) (weave.Tx, error) {
+		return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}}, nil
+	}
+
+	// Summary of the block is reported and the counter is reset.
+	summary := func(ctx weave.Context, db weave.KVStore) ([]common.KVPair, error) {
+		raw, err := db.Get(countKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Delete(countKey); err != nil {
+			return nil, err
+		}
+		return []common.KVPair{{Key: []byte("count"), Value: raw}}, nil
+	}
+	failing := func(ctx weave.Context, db weave.KVStore) ([]common.KVPair, error) {
+		if err := db.Set([]byte("failing"), []byte("x")); err != nil {
+			return nil, err
+		}
+		return []common.KVPair{{Key: []byte("failing")}}, errors.ErrHuman
+	}
+	constant := func(ctx weave.Context, db weave.KVStore) ([]common.KVPair, error) {
+		return []common.KVPair{{Key: []byte("const"), Value: []byte("1")}}, nil
+	}
+
+	store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+	base := NewBaseApp(store, decoder, handler, nil, false).
+		WithEndBlockHook(summary).
+		WithEndBlockHook(failing).
+		WithEndBlockHook(constant)
+
+	for height, txs := range []int{3, 1} {
+		base.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: int64(height + 1), Time: time.Now()}})
+		for i := 0; i < txs; i++ {
+			assert.Equal(t, false, base.DeliverTx([]byte("tx")).IsErr())
+		}
+		res := base.EndBlock(abci.RequestEndBlock{})
+		assert.Equal(t, []common.KVPair{
+			{Key: []byte("count"), Value: []byte(strconv.Itoa(txs))},
+			{Key: []byte("const"), Value: []byte("1")},
+		}, res.Tags)
+		base.Commit()
+	}
+
+	db := store.DeliverStore()
+	if v, err := db.Get([]byte("failing")); err != nil || v != nil {
+		t.Fatalf("changes of the failed hook must be discarded: %q, %v", v, err)
+	}
+	if v, err := db.Get(countKey); err != nil || v != nil {
+		t.Fatalf("counter must be reset: %q, %v", v, err)
+	}
+}
+
+// countingHandler increments a counter stored under the key with each
+// delivered transaction.
+type countingHandler struct {
+	key []byte
+}
+
+var _ weave.Handler = (*countingHandler)(nil)
+
+func (h *countingHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return &weave.CheckResult{}, nil
+}
+
+func (h *countingHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	raw, err := db.Get(h.key)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := strconv.Atoi(string(raw))
+	if err := db.Set(h.key, []byte(strconv.Itoa(n+1))); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func TestBaseAppCheckTxDecoder(t *testing.T) {
+	decoder := func(raw []byte) (weave.Tx, error) {
+		return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}}, nil
+	}
+	limited := LimitedTxDecoder(decoder, DecodeLimits{MaxTxBytes: 4})
+
+	handler := &weavetest.Handler{}
+	store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+	base := NewBaseApp(store, decoder, handler, nil, false).
+		WithCheckTxDecoder(limited)
+
+	base.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1, Time: time.Now()}})
+
+	assert.Equal(t, false, base.CheckTx([]byte("tx")).IsErr())
+	assert.Equal(t, true, base.CheckTx([]byte("too big tx")).IsErr())
+	assert.Equal(t, 1, handler.CheckCallCount())
+
+	// Transaction included in a block is processed regardless of the
+	// node specific limits.
+	assert.Equal(t, false, base.DeliverTx([]byte("too big tx")).IsErr())
+	assert.Equal(t, 1, handler.DeliverCallCount())
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+// CapabilitiesPath is the reserved query path that the capabilities of the
+// application are exposed under.
+const CapabilitiesPath = "/_capabilities"
+
+var _ weave.QueryHandler = (*CapabilitiesQuery)(nil)
+
+// CapabilitiesQuery allows clients to discover which query paths and
+// messages are supported by the application, together with the application
+// version and the schema versions of all packages.
+type CapabilitiesQuery struct {
+	version string
+	router  *Router
+	queries weave.QueryRouter
+}
+
+// NewCapabilitiesQuery returns a query handler that describes the given
+// message router and query router. Query router paths are read at the query
+// time, so all handlers registered later are listed as well.
+func NewCapabilitiesQuery(version string, r *Router, qr weave.QueryRouter) *CapabilitiesQuery {
+	return &CapabilitiesQuery{
+		version: version,
+		router:  r,
+		queries: qr,
+	}
+}
+
+// Capabilities returns the description of the application functionality.
+// The result is deterministic, all lists are sorted.
+func (q *CapabilitiesQuery) Capabilities(db weave.ReadOnlyKVStore) (*Capabilities, error) {
+	versions, err := migration.NewSchemaBucket().CurrentSchemas(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema versions")
+	}
+	schemas := make([]*PackageSchema, 0, len(versions))
+	for pkg, ver := range versions {
+		schemas = append(schemas, &PackageSchema{Pkg: pkg, Version: ver})
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Pkg < schemas[j].Pkg })
+
+	return &Capabilities{
+		Version:    q.version,
+		QueryPaths: q.queries.Paths(),
+		MsgPaths:   q.router.Paths(),
+		Schemas:    schemas,
+	}, nil
+}
+
+// Query implements weave.QueryHandler interface.
+func (q *CapabilitiesQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	c, err := q.Capabilities(db)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+	return []weave.Model{weave.Pair([]byte(CapabilitiesPath), raw)}, nil
+}
+
+// RegisterQuery registers the capabilities query under the reserved
+// CapabilitiesPath.
+func (q *CapabilitiesQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register(CapabilitiesPath, q)
+}
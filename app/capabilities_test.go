@@ -0,0 +1,56 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCapabilitiesQuery(t *testing.T) {
+	r := NewRouter()
+	r.Handle(&weavetest.Msg{RoutePath: "test/zulu"}, &weavetest.Handler{})
+	r.Handle(&weavetest.Msg{RoutePath: "test/alpha"}, &weavetest.Handler{})
+
+	qr := weave.NewQueryRouter()
+	qr.Register("/things", nopQueryHandler{})
+	q := NewCapabilitiesQuery("v1.2.3", r, qr)
+	q.RegisterQuery(qr)
+	// Registered after the capabilities query, must be listed as well.
+	qr.Register("/animals", nopQueryHandler{})
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "zoo", "bank")
+	_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "zoo",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+
+	models, err := qr.Handler(CapabilitiesPath).Query(db, weave.KeyQueryMod, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+
+	var got Capabilities
+	assert.Nil(t, got.Unmarshal(models[0].Value))
+	want := Capabilities{
+		Version:    "v1.2.3",
+		QueryPaths: []string{"/_capabilities", "/animals", "/things"},
+		MsgPaths:   []string{"test/alpha", "test/zulu"},
+		Schemas: []*PackageSchema{
+			{Pkg: "bank", Version: 1},
+			{Pkg: "zoo", Version: 2},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+type nopQueryHandler struct{}
+
+func (nopQueryHandler) Query(weave.ReadOnlyKVStore, string, []byte) ([]weave.Model, error) {
+	return nil, nil
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/iov-one/weave/x/sigs"
+)
+
+// CheckCache is a least recently used cache of successful signature
+// verifications. Mempool gossip causes the same transaction to be checked by
+// a node many times. Cached verification allows to skip the repeated
+// signature verification, which is the most expensive part of the check.
+//
+// Only the cryptographic verification is cached. Its result depends only on
+// the public key, the signed message and the signature, so a cached result
+// never becomes invalid. Each CheckTx still executes the full decorator and
+// handler stack against the current check state, so the sequence, the fee,
+// the balance and any other state dependent condition is validated again.
+//
+// Because a cached verification never becomes invalid, the TTL is only an
+// eviction policy. It bounds for how many blocks an entry is kept, so that
+// verifications of transactions that were already included in a block do not
+// occupy the cache until the LRU limit pushes them out.
+//
+// This cache is used only by CheckTx. DeliverTx is never affected.
+type CheckCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     int64
+	height  int64
+	entries map[string]*list.Element
+	// order holds the entries, starting with the most recently used one.
+	order *list.List
+}
+
+var _ sigs.VerificationCache = (*CheckCache)(nil)
+
+type checkCacheEntry struct {
+	key    string
+	height int64
+}
+
+// NewCheckCache returns a cache that holds at most size verifications. A
+// cached verification is evicted ttl blocks after the height it was added at.
+func NewCheckCache(size int, ttl int64) *CheckCache {
+	return &CheckCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Has implements sigs.VerificationCache interface. It returns true if given
+// verification was cached and is not due for eviction at the current height.
+func (c *CheckCache) Has(key []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[string(key)]
+	if !ok {
+		return false
+	}
+	if c.height-el.Value.(*checkCacheEntry).height > c.ttl {
+		c.remove(el)
+		return false
+	}
+	c.order.MoveToFront(el)
+	return true
+}
+
+// Add implements sigs.VerificationCache interface. It stores given
+// verification at the current height.
+func (c *CheckCache) Add(key []byte) {
+	if c.size < 1 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[string(key)]; ok {
+		c.remove(el)
+	}
+	e := &checkCacheEntry{
+		key:    string(key),
+		height: c.height,
+	}
+	c.entries[e.key] = c.order.PushFront(e)
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// Commit sets the current height and evicts all verifications that were
+// added more than TTL blocks before that height.
+func (c *CheckCache) Commit(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.height = height
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if height-el.Value.(*checkCacheEntry).height > c.ttl {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// Len returns the number of cached verifications.
+func (c *CheckCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *CheckCache) remove(el *list.Element) {
+	e := c.order.Remove(el).(*checkCacheEntry)
+	delete(c.entries, e.key)
+}
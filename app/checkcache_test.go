@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/sigs"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestCheckCache(t *testing.T) {
+	c := NewCheckCache(2, 3)
+	c.Commit(1)
+
+	c.Add([]byte("a"))
+	c.Add([]byte("b"))
+	assert.Equal(t, true, c.Has([]byte("a")))
+	assert.Equal(t, false, c.Has([]byte("unknown")))
+
+	// Least recently used verification is evicted when the size limit is
+	// reached.
+	c.Add([]byte("c"))
+	assert.Equal(t, false, c.Has([]byte("b")))
+	assert.Equal(t, 2, c.Len())
+
+	// Verification is valid only within the TTL window.
+	c.Commit(4)
+	assert.Equal(t, true, c.Has([]byte("a")))
+	c.Commit(5)
+	assert.Equal(t, false, c.Has([]byte("a")))
+
+	// Commit drops the verifications that are no longer valid.
+	c.Add([]byte("d"))
+	c.Commit(9)
+	assert.Equal(t, 0, c.Len())
+
+	// Cache of size zero stores nothing.
+	empty := NewCheckCache(0, 3)
+	empty.Add([]byte("a"))
+	assert.Equal(t, false, empty.Has([]byte("a")))
+}
+
+func TestBaseAppCheckCache(t *testing.T) {
+	const chainID = "test-chain"
+	alice := weavetest.NewKey()
+
+	tx := &signedTx{}
+	sig, err := sigs.SignTx(alice, tx, chainID, 0)
+	assert.Nil(t, err)
+	tx.signatures = []*sigs.StdSignature{sig}
+	decoder := func(raw []byte) (weave.Tx, error) {
+		return tx, nil
+	}
+
+	handler := &weavetest.Handler{}
+	stack := ChainDecorators(sigs.NewDecorator()).WithHandler(handler)
+	ctx := weave.WithChainID(context.Background(), chainID)
+	store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), ctx)
+	cache := NewCheckCache(10, 2)
+	base := NewBaseApp(store, decoder, stack, nil, false).
+		WithCheckCache(cache)
+	migration.MustInitPkg(store.DeliverStore(), "sigs")
+	store.Commit()
+
+	base.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1, Time: time.Now()}})
+
+	assert.Equal(t, false, base.CheckTx([]byte("tx")).IsErr())
+	assert.Equal(t, 1, cache.Len())
+
+	// The first check advanced the sequence of alice, so the replayed
+	// transaction must be rejected, the same as without the cache.
+	res := base.CheckTx([]byte("tx"))
+	wantCode, _ := errors.ABCIInfo(sigs.ErrInvalidSequence, false)
+	assert.Equal(t, wantCode, res.Code)
+	assert.Equal(t, 1, handler.CheckCallCount())
+
+	// Commit of a block that did not include the transaction resets the
+	// check state. The recheck is using the cached verification, but the
+	// handler is always called, so that any state change is validated.
+	base.EndBlock(abci.RequestEndBlock{})
+	base.Commit()
+	handler.CheckErr = errors.ErrAmount
+	wantCode, _ = errors.ABCIInfo(errors.ErrAmount, false)
+	assert.Equal(t, wantCode, base.CheckTx([]byte("tx")).Code)
+	assert.Equal(t, 2, handler.CheckCallCount())
+	assert.Equal(t, 1, cache.Len())
+
+	// Deliver is never using the cache.
+	assert.Equal(t, false, base.DeliverTx([]byte("tx")).IsErr())
+	assert.Equal(t, 1, handler.DeliverCallCount())
+}
+
+// signedTx is a transaction that declares its signers. If signatures are
+// set, they are returned instead of unsigned signatures of the signers.
+type signedTx struct {
+	weavetest.Tx
+	signers    []*crypto.PublicKey
+	signatures []*sigs.StdSignature
+}
+
+var _ sigs.SignedTx = (*signedTx)(nil)
+
+func (tx *signedTx) GetSignBytes() ([]byte, error) {
+	return nil, nil
+}
+
+func (tx *signedTx) GetSignatures() []*sigs.StdSignature {
+	if tx.signatures != nil {
+		return tx.signatures
+	}
+	res := make([]*sigs.StdSignature, len(tx.signers))
+	for i, pub := range tx.signers {
+		res[i] = &sigs.StdSignature{Pubkey: pub}
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 )
 
 // CommitStore handles loading from a KVCommitStore, maintaining different
@@ -11,6 +12,10 @@ type CommitStore struct {
 	committed weave.CommitKVStore
 	deliver   weave.KVCacheWrap
 	check     weave.KVCacheWrap
+
+	// iterators is not nil if iterators created by the deliver and
+	// check caches must be tracked.
+	iterators *store.IteratorTracker
 }
 
 // NewCommitStore loads the CommitKVStore from disk or panics. It sets up the
@@ -51,11 +56,28 @@ func (cs *CommitStore) Commit() (weave.CommitID, error) {
 	}
 
 	// set up new caches
-	cs.deliver = cs.committed.CacheWrap()
-	cs.check = cs.committed.CacheWrap()
+	cs.deliver = cs.cacheWrap()
+	cs.check = cs.cacheWrap()
 	return res, nil
 }
 
+// TrackIterators configures the store to record all iterators created by
+// the deliver and check caches using given tracker. Current caches are
+// replaced, so this must be called before any data is written.
+func (cs *CommitStore) TrackIterators(t *store.IteratorTracker) {
+	cs.iterators = t
+	cs.deliver = cs.cacheWrap()
+	cs.check = cs.cacheWrap()
+}
+
+func (cs *CommitStore) cacheWrap() weave.KVCacheWrap {
+	c := cs.committed.CacheWrap()
+	if cs.iterators != nil {
+		c = cs.iterators.Wrap(c)
+	}
+	return c
+}
+
 // CheckStore returns a store implementation that must be used during the
 // checking phase.
 func (cs *CommitStore) CheckStore() weave.CacheableKVStore {
@@ -76,11 +98,21 @@ const chainIDKey = "_wv:chainID"
 // mustLoadChainID returns the chain id stored if any
 // panics on db error
 func mustLoadChainID(kv weave.KVStore) string {
-	v, err := kv.Get([]byte(chainIDKey))
+	chainID, err := LoadChainID(kv)
 	if err != nil {
 		panic(err)
 	}
-	return string(v)
+	return chainID
+}
+
+// LoadChainID returns the chain id stored during the genesis initialization.
+// An empty string is returned if the chain was not initialized yet.
+func LoadChainID(kv weave.ReadOnlyKVStore) (string, error) {
+	v, err := kv.Get([]byte(chainIDKey))
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
 }
 
 // saveChainID stores a chain id in the kv store.
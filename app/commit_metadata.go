@@ -0,0 +1,112 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+// CommitMetadataPath is the reserved query path that the commit metadata
+// record is exposed under.
+const CommitMetadataPath = "/_commit"
+
+// _wv: is a prefix for weave internal data
+const commitMetadataKey = "_wv:commitMeta"
+
+// SchemaDigest returns a SHA-256 digest of the current schema versions of all
+// initialized packages. Packages are processed in the lexicographical order
+// of their names, each contributing its name, a zero byte and the big endian
+// encoded version. The result is deterministic for a given schema set.
+func SchemaDigest(db weave.ReadOnlyKVStore) ([]byte, error) {
+	versions, err := migration.NewSchemaBucket().CurrentSchemas(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "schema versions")
+	}
+	pkgs := make([]string, 0, len(versions))
+	for pkg := range versions {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	h := sha256.New()
+	for _, pkg := range pkgs {
+		var ver [4]byte
+		binary.BigEndian.PutUint32(ver[:], versions[pkg])
+		_, _ = h.Write([]byte(pkg))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write(ver[:])
+	}
+	return h.Sum(nil), nil
+}
+
+// LoadCommitMetadata returns the commit metadata record stored in the given
+// state. ErrNotFound is returned if the record was never written.
+func LoadCommitMetadata(db weave.ReadOnlyKVStore) (*CommitMetadata, error) {
+	raw, err := db.Get([]byte(commitMetadataKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "load commit metadata")
+	}
+	if raw == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "commit metadata")
+	}
+	var meta CommitMetadata
+	if err := meta.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "unmarshal commit metadata")
+	}
+	return &meta, nil
+}
+
+// saveCommitMetadata writes the commit metadata record describing given
+// binary and the current schema set of the state.
+func saveCommitMetadata(db weave.KVStore, version, gitCommit string) error {
+	digest, err := SchemaDigest(db)
+	if err != nil {
+		return err
+	}
+	meta := CommitMetadata{
+		Version:      version,
+		GitCommit:    gitCommit,
+		SchemaDigest: digest,
+	}
+	raw, err := meta.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal commit metadata")
+	}
+	if err := db.Set([]byte(commitMetadataKey), raw); err != nil {
+		return errors.Wrap(err, "save commit metadata")
+	}
+	return nil
+}
+
+var _ weave.QueryHandler = (*CommitMetadataQuery)(nil)
+
+// CommitMetadataQuery returns the commit metadata record of the queried
+// state. Query the height of a block to learn which binary and schema set
+// produced the application hash of that block.
+type CommitMetadataQuery struct{}
+
+// Query implements weave.QueryHandler interface. No result is returned if
+// the record does not exist.
+func (CommitMetadataQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	raw, err := db.Get([]byte(commitMetadataKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "load commit metadata")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	return []weave.Model{weave.Pair([]byte(CommitMetadataPath), raw)}, nil
+}
+
+// RegisterQuery registers the commit metadata query under the reserved
+// CommitMetadataPath.
+func (q CommitMetadataQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register(CommitMetadataPath, q)
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ versionedStore = iavl.CommitStore{}
+
+func TestCommitMetadata(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	CommitMetadataQuery{}.RegisterQuery(qr)
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), qr, context.Background()).
+		WithCommitMetadata("v1.2.3", "c0ffee")
+
+	migration.MustInitPkg(app.DeliverStore(), "zoo", "bank")
+	app.Commit()
+	v1Digest, err := SchemaDigest(app.DeliverStore())
+	assert.Nil(t, err)
+
+	_, err = migration.NewSchemaBucket().Create(app.DeliverStore(), &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "zoo",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+	// Emulate a binary upgrade. The record of the previous height must
+	// still describe the binary that produced it.
+	app.WithCommitMetadata("v1.2.4", "decaf")
+	app.Commit()
+	v2Digest, err := SchemaDigest(app.DeliverStore())
+	assert.Nil(t, err)
+	if string(v1Digest) == string(v2Digest) {
+		t.Fatal("schema change must change the digest")
+	}
+
+	cases := map[string]struct {
+		Height     int64
+		WantHeight int64
+		WantMeta   CommitMetadata
+		WantErr    *errors.Error
+	}{
+		"latest": {
+			Height:     0,
+			WantHeight: 2,
+			WantMeta: CommitMetadata{
+				Version:      "v1.2.4",
+				GitCommit:    "decaf",
+				SchemaDigest: v2Digest,
+			},
+		},
+		"latest by height": {
+			Height:     2,
+			WantHeight: 2,
+			WantMeta: CommitMetadata{
+				Version:      "v1.2.4",
+				GitCommit:    "decaf",
+				SchemaDigest: v2Digest,
+			},
+		},
+		"historical": {
+			Height:     1,
+			WantHeight: 1,
+			WantMeta: CommitMetadata{
+				Version:      "v1.2.3",
+				GitCommit:    "c0ffee",
+				SchemaDigest: v1Digest,
+			},
+		},
+		"not committed yet": {
+			Height:  3,
+			WantErr: errors.ErrInput,
+		},
+		"negative height": {
+			Height:  -1,
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{
+				Path:   CommitMetadataPath,
+				Height: tc.Height,
+			})
+			if tc.WantErr != nil {
+				wantCode, _ := errors.ABCIInfo(tc.WantErr, false)
+				assert.Equal(t, wantCode, res.Code)
+				return
+			}
+			assert.Equal(t, uint32(0), res.Code)
+			assert.Equal(t, tc.WantHeight, res.Height)
+
+			models, err := toModels(res.Key, res.Value)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(models))
+			var got CommitMetadata
+			assert.Nil(t, got.Unmarshal(models[0].Value))
+			assert.Equal(t, tc.WantMeta, got)
+		})
+	}
+}
+
+// TestCommitMetadataAppHash documents the influence of the commit metadata
+// record on the application hash. Binaries that differ in the version, the
+// git commit or the schema set must produce a different hash for the same
+// state transition.
+func TestCommitMetadataAppHash(t *testing.T) {
+	commit := func(enabled bool, version, gitCommit string, pkgs ...string) []byte {
+		app := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+		if enabled {
+			app = app.WithCommitMetadata(version, gitCommit)
+		}
+		migration.MustInitPkg(app.DeliverStore(), pkgs...)
+		return app.Commit().Data
+	}
+
+	base := commit(true, "v1", "aaa", "zoo", "bank")
+	assert.Equal(t, base, commit(true, "v1", "aaa", "bank", "zoo"))
+
+	differ := map[string][]byte{
+		"disabled":       commit(false, "v1", "aaa", "zoo", "bank"),
+		"other version":  commit(true, "v2", "aaa", "zoo", "bank"),
+		"other commit":   commit(true, "v1", "bbb", "zoo", "bank"),
+		"other packages": commit(true, "v1", "aaa", "zoo"),
+	}
+	for name, hash := range differ {
+		if string(hash) == string(base) {
+			t.Errorf("%s: application hash must differ", name)
+		}
+	}
+}
+
+func TestSchemaDigest(t *testing.T) {
+	digest := func(pkgs map[string]uint32) []byte {
+		db := store.MemStore()
+		for pkg, ver := range pkgs {
+			migration.MustInitPkg(db, pkg)
+			for v := uint32(2); v <= ver; v++ {
+				_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+					Metadata: &weave.Metadata{Schema: 1},
+					Pkg:      pkg,
+					Version:  v,
+				})
+				assert.Nil(t, err)
+			}
+		}
+		d, err := SchemaDigest(db)
+		assert.Nil(t, err)
+		return d
+	}
+
+	a := digest(map[string]uint32{"ab": 1, "c": 2})
+	assert.Equal(t, 32, len(a))
+	assert.Equal(t, a, digest(map[string]uint32{"c": 2, "ab": 1}))
+	if string(a) == string(digest(map[string]uint32{"a": 1, "bc": 2})) {
+		t.Fatal("package names must be separated")
+	}
+	if string(a) == string(digest(map[string]uint32{"ab": 2, "c": 1})) {
+		t.Fatal("versions must be bound to the package")
+	}
+}
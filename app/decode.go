@@ -0,0 +1,103 @@
+package app
+
+import (
+	"reflect"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// DecodeLimits declares the limits of a transaction that a decoder accepts.
+// Zero value of any attribute disables the corresponding limit.
+type DecodeLimits struct {
+	// MaxTxBytes is the maximum size of a serialized transaction. It is
+	// checked before the transaction is unmarshaled and it bounds the
+	// memory and CPU that the unmarshal can consume.
+	MaxTxBytes int
+	// MaxRepeated is the maximum number of elements of any single repeated
+	// field of a decoded transaction.
+	MaxRepeated int
+	// MaxDepth is the maximum nesting level of messages of a decoded
+	// transaction. The transaction itself is at level one and each oneof
+	// wrapper counts as a separate level, so for example a message
+	// wrapped by a batch message is a few levels deeper than a message
+	// wrapped by the transaction directly.
+	MaxDepth int
+}
+
+// LimitedTxDecoder returns a decoder that rejects a transaction exceeding
+// given limits. The size is checked before calling given decoder. Repeated
+// field length and the nesting level are verified after the transaction is
+// decoded, before any other processing is done.
+func LimitedTxDecoder(decode weave.TxDecoder, limits DecodeLimits) weave.TxDecoder {
+	return func(txBytes []byte) (weave.Tx, error) {
+		if limits.MaxTxBytes > 0 && len(txBytes) > limits.MaxTxBytes {
+			return nil, errors.Wrapf(errors.ErrInput, "transaction of %d bytes exceeds the limit of %d bytes", len(txBytes), limits.MaxTxBytes)
+		}
+		tx, err := decode(txBytes)
+		if err != nil {
+			return nil, err
+		}
+		if limits.MaxRepeated > 0 || limits.MaxDepth > 0 {
+			if err := auditDecoded(reflect.ValueOf(tx), limits, 0); err != nil {
+				return nil, errors.Wrap(err, "transaction")
+			}
+		}
+		return tx, nil
+	}
+}
+
+// auditDecoded returns an error if given value, or any value it references,
+// exceeds the repeated field or the nesting level limit. Given depth is the
+// nesting level of the structure containing the value.
+func auditDecoded(v reflect.Value, limits DecodeLimits, depth int) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return auditDecoded(v.Elem(), limits, depth)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if v.Kind() != reflect.Map && v.Type().Elem().Kind() == reflect.Uint8 {
+			// Bytes are not a repeated field.
+			return nil
+		}
+		if limits.MaxRepeated > 0 && v.Len() > limits.MaxRepeated {
+			return errors.Wrapf(errors.ErrInput, "%d elements exceed the limit of %d", v.Len(), limits.MaxRepeated)
+		}
+		if v.Kind() == reflect.Map {
+			it := v.MapRange()
+			for it.Next() {
+				if err := auditDecoded(it.Value(), limits, depth); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := auditDecoded(v.Index(i), limits, depth); err != nil {
+				return errors.Wrapf(err, "%d", i)
+			}
+		}
+		return nil
+	case reflect.Struct:
+		depth++
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			return errors.Wrapf(errors.ErrInput, "nesting level exceeds the limit of %d", limits.MaxDepth)
+		}
+		tp := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := tp.Field(i)
+			if f.PkgPath != "" {
+				// Unexported field is not decoded.
+				continue
+			}
+			if err := auditDecoded(v.Field(i), limits, depth); err != nil {
+				return errors.Wrap(err, f.Name)
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
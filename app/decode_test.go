@@ -0,0 +1,230 @@
+package app
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+func TestLimitedTxDecoder(t *testing.T) {
+	nested := func(depth int) *auditNode {
+		var n *auditNode
+		for i := 0; i < depth; i++ {
+			n = &auditNode{Child: n}
+		}
+		return n
+	}
+
+	cases := map[string]struct {
+		Limits      DecodeLimits
+		Raw         []byte
+		Tx          *auditTx
+		WantErr     *errors.Error
+		WantDecoded bool
+	}{
+		"zero limits accept anything": {
+			Raw: make([]byte, 1000),
+			Tx: &auditTx{
+				Items: make([]string, 1000),
+				Node:  nested(100),
+			},
+			WantDecoded: true,
+		},
+		"size is checked before decoding": {
+			Limits:      DecodeLimits{MaxTxBytes: 10},
+			Raw:         make([]byte, 11),
+			Tx:          &auditTx{},
+			WantErr:     errors.ErrInput,
+			WantDecoded: false,
+		},
+		"size within the limit": {
+			Limits:      DecodeLimits{MaxTxBytes: 10},
+			Raw:         make([]byte, 10),
+			Tx:          &auditTx{},
+			WantDecoded: true,
+		},
+		"too many repeated elements": {
+			Limits:      DecodeLimits{MaxRepeated: 3},
+			Tx:          &auditTx{Items: []string{"a", "b", "c", "d"}},
+			WantErr:     errors.ErrInput,
+			WantDecoded: true,
+		},
+		"too many repeated elements of a nested message": {
+			Limits: DecodeLimits{MaxRepeated: 3},
+			Tx: &auditTx{
+				Node: &auditNode{Child: &auditNode{Leaves: make([]auditNode, 4)}},
+			},
+			WantErr:     errors.ErrInput,
+			WantDecoded: true,
+		},
+		"bytes are not a repeated field": {
+			Limits:      DecodeLimits{MaxRepeated: 3},
+			Tx:          &auditTx{Data: make([]byte, 100), Items: []string{"a", "b", "c"}},
+			WantDecoded: true,
+		},
+		"unexported fields are not audited": {
+			Limits:      DecodeLimits{MaxRepeated: 3},
+			Tx:          &auditTx{cache: make([]string, 10)},
+			WantDecoded: true,
+		},
+		"nesting level within the limit": {
+			// Transaction is the first level.
+			Limits:      DecodeLimits{MaxDepth: 6},
+			Tx:          &auditTx{Node: nested(5)},
+			WantDecoded: true,
+		},
+		"nesting level exceeds the limit": {
+			Limits:      DecodeLimits{MaxDepth: 6},
+			Tx:          &auditTx{Node: nested(6)},
+			WantErr:     errors.ErrInput,
+			WantDecoded: true,
+		},
+		"nesting level of a repeated field element": {
+			Limits: DecodeLimits{MaxDepth: 3},
+			Tx: &auditTx{
+				Node: &auditNode{Leaves: []auditNode{{Child: &auditNode{}}}},
+			},
+			WantErr:     errors.ErrInput,
+			WantDecoded: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var decoded bool
+			decode := LimitedTxDecoder(func([]byte) (weave.Tx, error) {
+				decoded = true
+				return tc.Tx, nil
+			}, tc.Limits)
+			tx, err := decode(tc.Raw)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if decoded != tc.WantDecoded {
+				t.Fatalf("want decoded %v, got %v", tc.WantDecoded, decoded)
+			}
+			if err == nil && tx != tc.Tx {
+				t.Fatalf("unexpected transaction returned: %#v", tx)
+			}
+		})
+	}
+}
+
+// TestLimitedTxDecoderAdversarialInput ensures that a protobuf decoder
+// wrapped with limits rejects crafted and random inputs, without ever
+// decoding a transaction bigger than allowed.
+func TestLimitedTxDecoderAdversarialInput(t *testing.T) {
+	limits := DecodeLimits{
+		MaxTxBytes:  4096,
+		MaxRepeated: 20,
+		MaxDepth:    8,
+	}
+	var decodedBytes int
+	decode := LimitedTxDecoder(func(raw []byte) (weave.Tx, error) {
+		if len(raw) > decodedBytes {
+			decodedBytes = len(raw)
+		}
+		var tx capabilitiesTx
+		if err := tx.Unmarshal(raw); err != nil {
+			return nil, err
+		}
+		return &tx, nil
+	}, limits)
+
+	schema := PackageSchema{Pkg: "cash", Version: 1}
+	rawSchema, err := schema.Marshal()
+	if err != nil {
+		t.Fatalf("cannot marshal schema: %s", err)
+	}
+	// Protobuf repeated field elements are concatenated on the wire, so
+	// repeating a single encoded element creates a huge repeated field
+	// from a tiny input.
+	field := append([]byte{0x22, byte(len(rawSchema))}, rawSchema...)
+
+	// Within the size limit, but with too many repeated elements.
+	if _, err := decode(bytes.Repeat(field, 100)); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	// Above the size limit is never decoded.
+	if _, err := decode(bytes.Repeat(field, 100000)); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	// Within all limits.
+	if _, err := decode(bytes.Repeat(field, 20)); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 2000; i++ {
+		raw := make([]byte, rnd.Intn(2*limits.MaxTxBytes))
+		switch rnd.Intn(3) {
+		case 0:
+			rnd.Read(raw)
+		case 1:
+			// Mutated repetition of a valid field.
+			size := len(raw)
+			raw = raw[:0]
+			for len(raw) < size {
+				raw = append(raw, field...)
+			}
+			if len(raw) > 0 {
+				raw[rnd.Intn(len(raw))] = byte(rnd.Intn(256))
+			}
+		case 2:
+			// Length prefixes that claim more data than given.
+			for j := range raw {
+				raw[j] = 0x22
+			}
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("decoding %d bytes panicked: %v", len(raw), r)
+				}
+			}()
+			tx, err := decode(raw)
+			if err != nil {
+				return
+			}
+			if n := len(tx.(*capabilitiesTx).Schemas); n > limits.MaxRepeated {
+				t.Fatalf("accepted a transaction with %d schemas", n)
+			}
+		}()
+	}
+	if decodedBytes > limits.MaxTxBytes {
+		t.Fatalf("decoded %d bytes, above the limit", decodedBytes)
+	}
+}
+
+type auditTx struct {
+	Data  []byte
+	Items []string
+	Node  *auditNode
+
+	// Unexported fields are not audited.
+	cache []string
+}
+
+var _ weave.Tx = (*auditTx)(nil)
+
+func (*auditTx) GetMsg() (weave.Msg, error) { return nil, errors.ErrHuman }
+func (*auditTx) Marshal() ([]byte, error)   { return nil, errors.ErrHuman }
+func (*auditTx) Unmarshal(raw []byte) error { return errors.ErrHuman }
+
+type auditNode struct {
+	Child  *auditNode
+	Leaves []auditNode
+}
+
+// capabilitiesTx is a transaction that uses the wire format of the
+// Capabilities message. It is used only to exercise the protobuf decoder.
+type capabilitiesTx struct {
+	Capabilities
+}
+
+var _ weave.Tx = (*capabilitiesTx)(nil)
+
+func (*capabilitiesTx) GetMsg() (weave.Msg, error) { return nil, errors.ErrHuman }
@@ -1,12 +1,21 @@
 package app
 
 import (
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 )
 
 //------ init state -----
 
-// ChainInitializers lets you initialize many extensions with one function
+// ChainInitializers lets you initialize many extensions with one function.
+// Initializers are run in the given order, unless an initializer declares
+// dependencies by implementing weave.DependentInitializer. Such an
+// initializer is run after all initializers of the extensions it depends on.
 func ChainInitializers(inits ...weave.Initializer) weave.Initializer {
 	return chainInitializer{inits}
 }
@@ -15,10 +24,14 @@ type chainInitializer struct {
 	inits []weave.Initializer
 }
 
-// FromGenesis will pass opts to all Initializers in the list,
+// FromGenesis will pass opts to all Initializers in the dependency order,
 // aborting at the first error.
 func (c chainInitializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	for _, i := range c.inits {
+	inits, err := sortInitializers(c.inits, initializerName)
+	if err != nil {
+		return errors.Wrap(err, "initializers order")
+	}
+	for _, i := range inits {
 		err := i.FromGenesis(opts, params, kv)
 		if err != nil {
 			return err
@@ -26,3 +39,80 @@ func (c chainInitializer) FromGenesis(opts weave.Options, params weave.GenesisPa
 	}
 	return nil
 }
+
+// sortInitializers returns given initializers ordered so that each
+// initializer is preceded by all initializers of the extensions it depends
+// on. Initializers that do not depend on each other keep their relative
+// order. Given function returns the extension name of an initializer.
+func sortInitializers(inits []weave.Initializer, nameOf func(weave.Initializer) string) ([]weave.Initializer, error) {
+	names := make([]string, len(inits))
+	declared := make(map[string]struct{}, len(inits))
+	for i, init := range inits {
+		names[i] = nameOf(init)
+		declared[names[i]] = struct{}{}
+	}
+
+	deps := make([][]string, len(inits))
+	for i, init := range inits {
+		d, ok := init.(weave.DependentInitializer)
+		if !ok {
+			continue
+		}
+		for _, dep := range d.Dependencies() {
+			if _, ok := declared[dep]; !ok {
+				return nil, errors.Wrapf(errors.ErrNotFound, "%s initializer depends on %s, which is not registered", names[i], dep)
+			}
+		}
+		deps[i] = d.Dependencies()
+	}
+
+	// remaining counts initializers of each extension that did not run yet.
+	remaining := make(map[string]int, len(inits))
+	for _, n := range names {
+		remaining[n]++
+	}
+	ready := func(i int) bool {
+		for _, dep := range deps[i] {
+			if remaining[dep] != 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	sorted := make([]weave.Initializer, 0, len(inits))
+	done := make([]bool, len(inits))
+	for len(sorted) < len(inits) {
+		next := -1
+		for i := range inits {
+			if !done[i] && ready(i) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []string
+			for i, n := range names {
+				if !done[i] {
+					cycle = append(cycle, n)
+				}
+			}
+			sort.Strings(cycle)
+			return nil, errors.Wrapf(errors.ErrState, "dependency cycle between initializers: %s", strings.Join(cycle, ", "))
+		}
+		done[next] = true
+		remaining[names[next]]--
+		sorted = append(sorted, inits[next])
+	}
+	return sorted, nil
+}
+
+// initializerName returns the name of the extension that given initializer
+// belongs to. This is the name of the package it is declared in.
+func initializerName(init weave.Initializer) string {
+	tp := reflect.TypeOf(init)
+	for tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return path.Base(tp.PkgPath())
+}
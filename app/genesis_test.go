@@ -0,0 +1,147 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSortInitializers(t *testing.T) {
+	cases := map[string]struct {
+		inits   []weave.Initializer
+		want    []string
+		wantErr *errors.Error
+	}{
+		"no dependencies keep the order": {
+			inits: []weave.Initializer{
+				&namedInit{name: "b"},
+				&namedInit{name: "a"},
+				&namedInit{name: "c"},
+			},
+			want: []string{"b", "a", "c"},
+		},
+		"dependency is initialized first": {
+			inits: []weave.Initializer{
+				&dependentInit{namedInit: namedInit{name: "termdeposit"}, deps: []string{"multisig", "migration"}},
+				&namedInit{name: "cash"},
+				&namedInit{name: "multisig"},
+				&namedInit{name: "migration"},
+			},
+			want: []string{"cash", "multisig", "migration", "termdeposit"},
+		},
+		"transitive dependencies": {
+			inits: []weave.Initializer{
+				&dependentInit{namedInit: namedInit{name: "a"}, deps: []string{"b"}},
+				&dependentInit{namedInit: namedInit{name: "b"}, deps: []string{"c"}},
+				&namedInit{name: "c"},
+				&namedInit{name: "d"},
+			},
+			want: []string{"c", "b", "a", "d"},
+		},
+		"all initializers of a dependency run first": {
+			inits: []weave.Initializer{
+				&namedInit{name: "a"},
+				&dependentInit{namedInit: namedInit{name: "b"}, deps: []string{"a"}},
+				&namedInit{name: "a"},
+			},
+			want: []string{"a", "a", "b"},
+		},
+		"missing dependency": {
+			inits: []weave.Initializer{
+				&dependentInit{namedInit: namedInit{name: "a"}, deps: []string{"missing"}},
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"dependency cycle": {
+			inits: []weave.Initializer{
+				&namedInit{name: "x"},
+				&dependentInit{namedInit: namedInit{name: "a"}, deps: []string{"b"}},
+				&dependentInit{namedInit: namedInit{name: "b"}, deps: []string{"c"}},
+				&dependentInit{namedInit: namedInit{name: "c"}, deps: []string{"a"}},
+			},
+			wantErr: errors.ErrState,
+		},
+		"self dependency": {
+			inits: []weave.Initializer{
+				&dependentInit{namedInit: namedInit{name: "a"}, deps: []string{"a"}},
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			sorted, err := sortInitializers(tc.inits, testInitName)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			var names []string
+			for _, init := range sorted {
+				names = append(names, testInitName(init))
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
+
+func TestChainInitializersOrder(t *testing.T) {
+	var calls []string
+	init := ChainInitializers(
+		&dependentInit{namedInit: namedInit{name: "a", calls: &calls}, deps: []string{"app"}},
+		&namedInit{name: "app", calls: &calls},
+	)
+	// Extension name is the package name of the initializer, so both
+	// initializers are named "app" and the first one depends on itself.
+	err := init.FromGenesis(nil, weave.GenesisParams{}, store.MemStore())
+	if !errors.ErrState.Is(err) {
+		t.Fatalf("want a cycle error, got %+v", err)
+	}
+
+	init = ChainInitializers(
+		&namedInit{name: "first", calls: &calls},
+		&namedInit{name: "second", calls: &calls},
+	)
+	assert.Nil(t, init.FromGenesis(nil, weave.GenesisParams{}, store.MemStore()))
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func testInitName(init weave.Initializer) string {
+	switch i := init.(type) {
+	case *namedInit:
+		return i.name
+	case *dependentInit:
+		return i.name
+	default:
+		return initializerName(init)
+	}
+}
+
+// namedInit is an initializer that records its name when called.
+type namedInit struct {
+	name  string
+	calls *[]string
+}
+
+func (i *namedInit) FromGenesis(weave.Options, weave.GenesisParams, weave.KVStore) error {
+	if i.calls != nil {
+		*i.calls = append(*i.calls, i.name)
+	}
+	return nil
+}
+
+type dependentInit struct {
+	namedInit
+	deps []string
+}
+
+var _ weave.DependentInitializer = (*dependentInit)(nil)
+
+func (i *dependentInit) Dependencies() []string {
+	return i.deps
+}
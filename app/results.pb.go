@@ -66,21 +66,233 @@ func (m *ResultSet) GetResults() [][]byte {
 	return nil
 }
 
+// Capabilities describes the functionality supported by the application. It
+// is returned by the "/_capabilities" query.
+type Capabilities struct {
+	// Version of the application binary.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// QueryPaths is a lexicographically sorted list of all registered query
+	// paths.
+	QueryPaths []string `protobuf:"bytes,2,rep,name=query_paths,json=queryPaths,proto3" json:"query_paths,omitempty"`
+	// MsgPaths is a lexicographically sorted list of paths of all messages that
+	// the application can process.
+	MsgPaths []string `protobuf:"bytes,3,rep,name=msg_paths,json=msgPaths,proto3" json:"msg_paths,omitempty"`
+	// Schemas is a list of the current schema versions of all initialized
+	// packages, sorted by the package name.
+	Schemas []*PackageSchema `protobuf:"bytes,4,rep,name=schemas,proto3" json:"schemas,omitempty"`
+}
+
+func (m *Capabilities) Reset()         { *m = Capabilities{} }
+func (m *Capabilities) String() string { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()    {}
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef4977b2ac0c9d2, []int{1}
+}
+func (m *Capabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Capabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Capabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Capabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capabilities.Merge(m, src)
+}
+func (m *Capabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *Capabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capabilities proto.InternalMessageInfo
+
+func (m *Capabilities) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Capabilities) GetQueryPaths() []string {
+	if m != nil {
+		return m.QueryPaths
+	}
+	return nil
+}
+
+func (m *Capabilities) GetMsgPaths() []string {
+	if m != nil {
+		return m.MsgPaths
+	}
+	return nil
+}
+
+func (m *Capabilities) GetSchemas() []*PackageSchema {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+// PackageSchema declares the current schema version of a package.
+type PackageSchema struct {
+	Pkg     string `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *PackageSchema) Reset()         { *m = PackageSchema{} }
+func (m *PackageSchema) String() string { return proto.CompactTextString(m) }
+func (*PackageSchema) ProtoMessage()    {}
+func (*PackageSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef4977b2ac0c9d2, []int{2}
+}
+func (m *PackageSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PackageSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PackageSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PackageSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackageSchema.Merge(m, src)
+}
+func (m *PackageSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *PackageSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackageSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackageSchema proto.InternalMessageInfo
+
+func (m *PackageSchema) GetPkg() string {
+	if m != nil {
+		return m.Pkg
+	}
+	return ""
+}
+
+func (m *PackageSchema) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// CommitMetadata describes the application binary and the schema set that
+// produced the state of a block. It is stored with every commit and is part
+// of the application hash. It is returned by the "/_commit" query.
+type CommitMetadata struct {
+	// Version of the application binary.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// GitCommit is the source control revision the binary was built from.
+	GitCommit string `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	// SchemaDigest is a SHA-256 digest of the current schema versions of all
+	// initialized packages.
+	SchemaDigest []byte `protobuf:"bytes,3,opt,name=schema_digest,json=schemaDigest,proto3" json:"schema_digest,omitempty"`
+}
+
+func (m *CommitMetadata) Reset()         { *m = CommitMetadata{} }
+func (m *CommitMetadata) String() string { return proto.CompactTextString(m) }
+func (*CommitMetadata) ProtoMessage()    {}
+func (*CommitMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef4977b2ac0c9d2, []int{3}
+}
+func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMetadata.Merge(m, src)
+}
+func (m *CommitMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
+
+func (m *CommitMetadata) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CommitMetadata) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
+	}
+	return ""
+}
+
+func (m *CommitMetadata) GetSchemaDigest() []byte {
+	if m != nil {
+		return m.SchemaDigest
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ResultSet)(nil), "app.ResultSet")
+	proto.RegisterType((*Capabilities)(nil), "app.Capabilities")
+	proto.RegisterType((*PackageSchema)(nil), "app.PackageSchema")
+	proto.RegisterType((*CommitMetadata)(nil), "app.CommitMetadata")
 }
 
 func init() { proto.RegisterFile("app/results.proto", fileDescriptor_9ef4977b2ac0c9d2) }
 
 var fileDescriptor_9ef4977b2ac0c9d2 = []byte{
-	// 105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2c, 0x28, 0xd0,
-	0x2f, 0x4a, 0x2d, 0x2e, 0xcd, 0x29, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e,
-	0x2c, 0x28, 0x50, 0x52, 0xe5, 0xe2, 0x0c, 0x02, 0x8b, 0x06, 0xa7, 0x96, 0x08, 0x49, 0x70, 0xb1,
-	0x43, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0xc1, 0xb8, 0x4e, 0x12, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x90, 0xc4, 0x06, 0x36, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x13, 0x46, 0x35, 0xf1, 0x61, 0x00, 0x00, 0x00,
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0x9b, 0x46, 0xac, 0x3b, 0xdd, 0x8a, 0xe6, 0x14, 0x10, 0xd7, 0xb0, 0x22, 0xe4, 0x20,
+	0x15, 0xf4, 0xe8, 0xcd, 0x7a, 0x15, 0x4a, 0xfa, 0x00, 0x65, 0xda, 0x86, 0x34, 0xb4, 0xdb, 0x8d,
+	0x9b, 0x54, 0xf0, 0x2d, 0x3c, 0xf8, 0x50, 0x1e, 0x7b, 0xf4, 0x28, 0xed, 0x8b, 0x48, 0xb3, 0xad,
+	0xe8, 0xc5, 0x5b, 0xe6, 0xfb, 0x7e, 0x86, 0x3f, 0x0c, 0x9c, 0xa2, 0x73, 0x37, 0x95, 0xf6, 0xcb,
+	0x79, 0xf0, 0x5d, 0x57, 0x95, 0xa1, 0x64, 0x14, 0x9d, 0xcb, 0xaf, 0x20, 0x51, 0x91, 0x0e, 0x74,
+	0x60, 0x1c, 0x5a, 0xbb, 0x08, 0x27, 0x82, 0xca, 0x54, 0xed, 0xc7, 0xfc, 0x9d, 0x40, 0xda, 0x43,
+	0x87, 0x23, 0x3b, 0xb7, 0xc1, 0x6a, 0xbf, 0x8d, 0xbe, 0xe8, 0xca, 0xdb, 0x72, 0xc1, 0x89, 0x20,
+	0x32, 0x51, 0xfb, 0x91, 0x5d, 0x40, 0xfb, 0x79, 0xa9, 0xab, 0xd7, 0xa1, 0xc3, 0x30, 0xf5, 0xbc,
+	0x29, 0xa8, 0x4c, 0x14, 0x44, 0xd4, 0xdf, 0x12, 0x76, 0x06, 0x49, 0xe1, 0xcd, 0x4e, 0xd3, 0xa8,
+	0x8f, 0x0a, 0x6f, 0x6a, 0x79, 0x0d, 0x2d, 0x3f, 0x9e, 0xea, 0x02, 0x3d, 0x3f, 0x10, 0x54, 0xb6,
+	0x6f, 0x59, 0x17, 0x9d, 0xeb, 0xf6, 0x71, 0x3c, 0x43, 0xa3, 0x07, 0x51, 0xa9, 0x7d, 0x24, 0xbf,
+	0x87, 0xce, 0x1f, 0xc3, 0x4e, 0x80, 0xba, 0x99, 0xd9, 0x55, 0xda, 0x3e, 0x7f, 0x17, 0x6d, 0x0a,
+	0x22, 0x3b, 0x3f, 0x45, 0xf3, 0x05, 0x1c, 0xf7, 0xca, 0xa2, 0xb0, 0xe1, 0x49, 0x07, 0x9c, 0x60,
+	0xc0, 0x7f, 0x3e, 0x75, 0x0e, 0x60, 0x6c, 0x18, 0x8e, 0x63, 0x3e, 0x2e, 0x4a, 0x54, 0x62, 0x6c,
+	0xa8, 0x17, 0xb0, 0x4b, 0xe8, 0xd4, 0x95, 0x86, 0x13, 0x6b, 0xb4, 0x0f, 0x9c, 0x0a, 0x22, 0x53,
+	0x95, 0xd6, 0xf0, 0x31, 0xb2, 0x07, 0xfe, 0xb1, 0xce, 0xc8, 0x6a, 0x9d, 0x91, 0xaf, 0x75, 0x46,
+	0xde, 0x36, 0x59, 0x63, 0xb5, 0xc9, 0x1a, 0x9f, 0x9b, 0xac, 0x31, 0x3a, 0x8c, 0x07, 0xb9, 0xfb,
+	0x1e, 0x00, 0x06, 0x6d, 0x5f, 0xc8, 0xa5, 0x01, 0x00, 0x00,
 }
 
 func (m *ResultSet) Marshal() (dAtA []byte, err error) {
@@ -109,6 +321,137 @@ func (m *ResultSet) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Capabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Capabilities) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.QueryPaths) > 0 {
+		for _, s := range m.QueryPaths {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.MsgPaths) > 0 {
+		for _, s := range m.MsgPaths {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for _, msg := range m.Schemas {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintResults(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PackageSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PackageSchema) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pkg) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Pkg)))
+		i += copy(dAtA[i:], m.Pkg)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *CommitMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMetadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.GitCommit) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.GitCommit)))
+		i += copy(dAtA[i:], m.GitCommit)
+	}
+	if len(m.SchemaDigest) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.SchemaDigest)))
+		i += copy(dAtA[i:], m.SchemaDigest)
+	}
+	return i, nil
+}
+
 func encodeVarintResults(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -133,6 +476,74 @@ func (m *ResultSet) Size() (n int) {
 	return n
 }
 
+func (m *Capabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	if len(m.QueryPaths) > 0 {
+		for _, s := range m.QueryPaths {
+			l = len(s)
+			n += 1 + l + sovResults(uint64(l))
+		}
+	}
+	if len(m.MsgPaths) > 0 {
+		for _, s := range m.MsgPaths {
+			l = len(s)
+			n += 1 + l + sovResults(uint64(l))
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovResults(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PackageSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pkg)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovResults(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *CommitMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	l = len(m.GitCommit)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	l = len(m.SchemaDigest)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	return n
+}
+
 func sovResults(x uint64) (n int) {
 	for {
 		n++
@@ -231,6 +642,444 @@ func (m *ResultSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Capabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Capabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Capabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryPaths = append(m.QueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPaths = append(m.MsgPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, &PackageSchema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PackageSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PackageSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PackageSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaDigest = append(m.SchemaDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.SchemaDigest == nil {
+				m.SchemaDigest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipResults(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
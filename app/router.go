@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -46,6 +47,17 @@ func (r *Router) Handle(m weave.Msg, h weave.Handler) {
 	r.routes[path] = h
 }
 
+// Paths returns paths of all messages that a handler is registered for,
+// sorted in lexicographical order.
+func (r *Router) Paths() []string {
+	paths := make([]string, 0, len(r.routes))
+	for p := range r.routes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
 // handler returns the registered Handler for this path. If no path is found,
 // returns a noSuchPath Handler.  This method always returns a non-nil Handler.
 func (r *Router) handler(m weave.Msg) weave.Handler {
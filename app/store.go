@@ -7,6 +7,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -52,6 +53,17 @@ type StoreApp struct {
 	// blockContext contains context info that is valid for the
 	// current block (eg. height, header), reset on BeginBlock
 	blockContext weave.Context
+
+	// iterators is not nil if iterator leak detection is enabled.
+	iterators *store.IteratorTracker
+	// panicOnLeak is true if an iterator leak must cause a panic instead
+	// of being logged.
+	panicOnLeak bool
+
+	// commitMetadata is not nil if the commit metadata record must be
+	// written with every commit. Only the binary description is set, the
+	// schema digest is computed at the commit time.
+	commitMetadata *CommitMetadata
 }
 
 // NewStoreApp initializes this app into a ready state with some defaults
@@ -84,6 +96,37 @@ func NewStoreApp(name string, store weave.CommitKVStore,
 	return s
 }
 
+// WithIteratorLeakDetection enables tracking of all iterators created during
+// the check and delivery phases. Any iterator not released before the commit
+// is reported together with its creation stack. The report is logged, unless
+// panicOnLeak is set, which is meant to be used by tests.
+// Tracking is expensive and should be used only for debugging.
+func (s *StoreApp) WithIteratorLeakDetection(panicOnLeak bool) *StoreApp {
+	s.iterators = store.NewIteratorTracker()
+	s.panicOnLeak = panicOnLeak
+	s.store.TrackIterators(s.iterators)
+	return s
+}
+
+// WithCommitMetadata enables writing of the commit metadata record with every
+// commit. The record contains given version and git commit of the binary
+// together with the digest of all package schema versions.
+//
+// The record is stored in the state and therefore it is part of the
+// application hash. This is deliberate: nodes running a different binary or
+// having a different schema set produce a different application hash for
+// the same block, so divergence is detected at the first block instead of
+// when the state transitions differ. As a consequence all validators must
+// run a binary built with the same version and git commit. Enabling or
+// disabling this feature changes the application hash as well.
+func (s *StoreApp) WithCommitMetadata(version, gitCommit string) *StoreApp {
+	s.commitMetadata = &CommitMetadata{
+		Version:   version,
+		GitCommit: gitCommit,
+	}
+	return s
+}
+
 // GetChainID returns the current chainID
 func (s *StoreApp) GetChainID() string {
 	return s.chainID
@@ -183,6 +226,7 @@ func (s *StoreApp) Info(req abci.RequestInfo) abci.ResponseInfo {
 
 	return abci.ResponseInfo{
 		Data:             s.name,
+		Version:          weave.Version,
 		LastBlockHeight:  info.Version,
 		LastBlockAppHash: info.Hash,
 	}
@@ -199,7 +243,8 @@ Query gets data from the app store.
 A query request has the following elements:
 * Path - the type of query
 * Data - what to query, interpreted based on Path
-* Height - the block height to query (if 0 most recent)
+* Height - the block height to query (if 0 most recent). Querying an
+  older height requires the store to retain the state of that height.
 * Prove - if true, also return a proof
 
 Path may be "/", "/<bucket>", or "/<bucket>/<index>"
@@ -223,23 +268,11 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return
 	}
 
-	// TODO: support historical queries by getting old read-only
-	// height := reqQuery.Height
-	// if height == 0 {
-	// 	withProof := s.CommittedHeight() - 1
-	// 	if tree.Tree.VersionExists(uint64(withProof)) {
-	// 		height = withProof
-	// 	} else {
-	// 		height = s.CommittedHeight()
-	// 	}
-	// }
-	info, err := s.store.CommitInfo()
+	db, height, err := s.queryStore(reqQuery.Height)
 	if err != nil {
 		return queryError(err)
 	}
-	resQuery.Height = info.Version
-	// TODO: better version handling!
-	db := s.store.committed.CacheWrap()
+	resQuery.Height = height
 
 	// make the query
 	models, err := qh.Query(db, mod, reqQuery.Data)
@@ -270,6 +303,40 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 	return resQuery
 }
 
+// versionedStore is implemented by the commit stores that retain the state
+// of older versions.
+type versionedStore interface {
+	ReadOnlyVersion(version int64) (weave.ReadOnlyKVStore, error)
+}
+
+// queryStore returns the committed state at given height, together with the
+// height of that state. Zero height means the latest committed state.
+func (s *StoreApp) queryStore(height int64) (weave.ReadOnlyKVStore, int64, error) {
+	info, err := s.store.CommitInfo()
+	if err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case height < 0:
+		return nil, 0, errors.Wrapf(errors.ErrInput, "invalid height %d", height)
+	case height > info.Version:
+		return nil, 0, errors.Wrapf(errors.ErrInput, "height %d is not committed yet", height)
+	case height == 0 || height == info.Version:
+		// TODO: better version handling!
+		return s.store.committed.CacheWrap(), info.Version, nil
+	}
+
+	vs, ok := s.store.committed.(versionedStore)
+	if !ok {
+		return nil, 0, errors.Wrap(errors.ErrHuman, "historical queries not supported")
+	}
+	db, err := vs.ReadOnlyVersion(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	return db, height, nil
+}
+
 // splitPath splits out the real path along with the query
 // modifier (everything after the ?)
 func splitPath(path string) (string, string) {
@@ -292,6 +359,15 @@ func queryError(err error) abci.ResponseQuery {
 
 // Commit implements abci.Application
 func (s *StoreApp) Commit() (res abci.ResponseCommit) {
+	s.reportIteratorLeaks()
+
+	if m := s.commitMetadata; m != nil {
+		if err := saveCommitMetadata(s.DeliverStore(), m.Version, m.GitCommit); err != nil {
+			// abci interface doesn't allow returning errors here, so just die
+			panic(err)
+		}
+	}
+
 	commitID, err := s.store.Commit()
 	if err != nil {
 		// abci interface doesn't allow returning errors here, so just die
@@ -306,6 +382,24 @@ func (s *StoreApp) Commit() (res abci.ResponseCommit) {
 	return abci.ResponseCommit{Data: commitID.Hash}
 }
 
+// reportIteratorLeaks logs or panics if any tracked iterator was not
+// released.
+func (s *StoreApp) reportIteratorLeaks() {
+	if s.iterators == nil {
+		return
+	}
+	leaks := s.iterators.Leaks()
+	if len(leaks) == 0 {
+		return
+	}
+	if s.panicOnLeak {
+		panic(fmt.Sprintf("%d iterators not released, first created at:\n%s", len(leaks), leaks[0]))
+	}
+	for _, stack := range leaks {
+		s.logger.Error("Iterator not released", "stack", stack)
+	}
+}
+
 // InitChain implements ABCI
 // Note: in tendermint 0.17, the genesis file is passed
 // in here, we should use this to trigger reading the genesis now
@@ -328,6 +422,14 @@ func (s *StoreApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBegi
 	ctx := weave.WithHeader(s.baseContext, req.Header)
 	ctx = weave.WithHeight(ctx, req.Header.GetHeight())
 	ctx = weave.WithCommitInfo(ctx, req.LastCommitInfo)
+	if proposer := req.Header.GetProposerAddress(); len(proposer) != 0 {
+		ctx = weave.WithBlockProposer(ctx, weave.ConsensusAddress(proposer))
+	}
+	signed := make([]bool, len(req.LastCommitInfo.Votes))
+	for i, v := range req.LastCommitInfo.Votes {
+		signed[i] = v.SignedLastBlock
+	}
+	ctx = weave.WithSignedValidators(ctx, signed)
 
 	now := req.Header.GetTime()
 	if now.IsZero() {
@@ -55,3 +55,19 @@ func TestAddValChange(t *testing.T) {
 		assert.Equal(t, diff, weave.ValidatorUpdatesFromABCI(res.ValidatorUpdates).ValidatorUpdates)
 	})
 }
+
+func TestIteratorLeakDetection(t *testing.T) {
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background()).
+		WithIteratorLeakDetection(true)
+
+	// Released iterators are not reported.
+	it, err := app.DeliverStore().CacheWrap().Iterator(nil, nil)
+	assert.Nil(t, err)
+	it.Release()
+	app.Commit()
+
+	// Stores are replaced on commit and must be tracked as well.
+	_, err = app.CheckStore().ReverseIterator(nil, nil)
+	assert.Nil(t, err)
+	assert.Panics(t, func() { app.Commit() })
+}
@@ -186,6 +186,47 @@ ReplaceAccountTargetsMsg.
 	return err
 }
 
+func cmdWithAccountResource(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Attach a resource to given transaction.
+
+This functionality is intended to extend ReplaceAccountResourcesMsg.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		uriFl      = fl.String("uri", "", "URI identifying the kind of the resource.")
+		resourceFl = fl.String("resource", "", "Value of the resource.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read input transaction: %s", err)
+	}
+
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return fmt.Errorf("cannot extract message from the transaction: %s", err)
+	}
+
+	switch msg := msg.(type) {
+	case *account.ReplaceAccountResourcesMsg:
+		msg.NewResources = append(msg.NewResources, account.AccountResource{
+			URI:      *uriFl,
+			Resource: *resourceFl,
+		})
+	default:
+		return fmt.Errorf("unsupported transaction message: %T", msg)
+	}
+
+	// Serialize back the transaction from the input. It was modified.
+	_, err = writeTx(output, tx)
+	return err
+}
+
 func cmdUpdateAccountConfiguration(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -202,6 +243,10 @@ Create a transaction to change account extension configuration.
 		validBlockchainAddr = fl.String("valid-bl-address", "", "Regular expression defining a rule for a valid blockchain address string.")
 		domainRenewFl       = fl.Duration("domain-renew", 0, "Domain renew time.")
 		domainGracePeriodFl = fl.Duration("domain-grace-period", 30*24*time.Hour, "Domain grace period.")
+		certSizeMaxFl       = fl.Int("certificate-size-max", 0, "Maximum size in bytes of a single account certificate. Zero means the greatest allowed size.")
+		certCountMaxFl      = fl.Int("certificate-count-max", 0, "Maximum number of certificates of a single account. Zero means the greatest allowed count.")
+		resSizeMaxFl        = fl.Int("resource-size-max", 0, "Maximum size in bytes of a single account resource. Zero means the greatest allowed size.")
+		resTotalSizeMaxFl   = fl.Int("resources-size-max", 0, "Maximum total size in bytes of all resources of a single account. Zero means the greatest allowed size.")
 	)
 	fl.Parse(args)
 
@@ -216,6 +261,10 @@ Create a transaction to change account extension configuration.
 			ValidBlockchainAddress: *validBlockchainAddr,
 			DomainRenew:            weave.AsUnixDuration(*domainRenewFl),
 			DomainGracePeriod:      weave.AsUnixDuration(*domainGracePeriodFl),
+			CertificateSizeMax:     int32(*certSizeMaxFl),
+			CertificateCountMax:    int32(*certCountMaxFl),
+			ResourceSizeMax:        int32(*resSizeMaxFl),
+			ResourcesSizeMax:       int32(*resTotalSizeMaxFl),
 		},
 	}
 	if err := msg.Validate(); err != nil {
@@ -515,6 +564,39 @@ new targets.
 	return err
 }
 
+func cmdReplaceAccountResources(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction to replace resources for a given account.
+
+Use another command to configure which resources should be set.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		nameFl   = fl.String("name", "", "Account name")
+		domainFl = fl.String("domain", "", "Domain that this account belongs to.")
+	)
+	fl.Parse(args)
+
+	msg := account.ReplaceAccountResourcesMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Domain:   *domainFl,
+		Name:     *nameFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AccountReplaceAccountResourcesMsg{
+			AccountReplaceAccountResourcesMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
 func cmdReplaceAccountMsgFees(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -16,6 +16,7 @@ import (
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -153,6 +154,12 @@ original transactions (ie signatures) are being dropped.
 					DistributionResetMsg: msg,
 				},
 			})
+		case *distribution.ClaimMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_DistributionClaimMsg{
+					DistributionClaimMsg: msg,
+				},
+			})
 		case *msgfee.SetMsgFeeMsg:
 			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
 				Sum: &bnsd.ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{
@@ -219,6 +226,12 @@ original transactions (ie signatures) are being dropped.
 					AccountReplaceAccountTargetsMsg: msg,
 				},
 			})
+		case *account.ReplaceAccountResourcesMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg{
+					AccountReplaceAccountResourcesMsg: msg,
+				},
+			})
 		case *account.DeleteAccountMsg:
 			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
 				Sum: &bnsd.ExecuteBatchMsg_Union_AccountDeleteAccountMsg{
@@ -255,12 +268,42 @@ original transactions (ie signatures) are being dropped.
 					CashUpdateConfigurationMsg: msg,
 				},
 			})
+		case *cash.UpdateWalletConfigMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg{
+					CashUpdateWalletConfigMsg: msg,
+				},
+			})
+		case *escrow.FundEscrowMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowFundEscrowMsg{
+					EscrowFundEscrowMsg: msg,
+				},
+			})
 		case *txfee.UpdateConfigurationMsg:
 			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
 				Sum: &bnsd.ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg{
 					TxfeeUpdateConfigurationMsg: msg,
 				},
 			})
+		case *sigs.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg{
+					SigsUpdateConfigurationMsg: msg,
+				},
+			})
+		case *currency.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg{
+					CurrencyUpdateConfigurationMsg: msg,
+				},
+			})
+		case *currency.UpdateTokenInfoMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg{
+					CurrencyUpdateTokenInfoMsg: msg,
+				},
+			})
 
 		case nil:
 			return errors.New("transaction without a message")
@@ -24,6 +24,8 @@ Create a transaction for updating cash extension configuration.
 		ownerFl     = flAddress(fl, "owner", "", "A new configuration owner.")
 		collectorFl = flAddress(fl, "collector", "", "A new collector address.")
 		minFeeFl    = flCoin(fl, "min-fee", "1 IOV", "A new minimal fee value.")
+		dustFl      = flCoins(fl, "dust-threshold", "Smallest balance that a transfer can leave in the source wallet. Can be used many times, once for each currency.")
+		deleteFl    = fl.Bool("delete-empty-wallets", false, "Remove wallets without any funds from the state.")
 	)
 	fl.Parse(args)
 
@@ -32,9 +34,11 @@ Create a transaction for updating cash extension configuration.
 			CashUpdateConfigurationMsg: &cash.UpdateConfigurationMsg{
 				Metadata: &weave.Metadata{Schema: 1},
 				Patch: &cash.Configuration{
-					Owner:            *ownerFl,
-					CollectorAddress: *collectorFl,
-					MinimalFee:       *minFeeFl,
+					Owner:              *ownerFl,
+					CollectorAddress:   *collectorFl,
+					MinimalFee:         *minFeeFl,
+					DustThresholds:     *dustFl,
+					DeleteEmptyWallets: *deleteFl,
 				},
 			},
 		},
@@ -43,6 +47,39 @@ Create a transaction for updating cash extension configuration.
 	return err
 }
 
+func cmdUpdateWalletConfig(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for setting the configuration of a wallet. Transaction
+must be signed by the wallet owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		addressFl     = flAddress(fl, "address", "", "Address of the wallet that is configured.")
+		requireMemoFl = fl.Bool("require-memo", false, "If set, all incoming transfers without a memo are rejected.")
+	)
+	fl.Parse(args)
+
+	msg := cash.UpdateWalletConfigMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Address:     *addressFl,
+		RequireMemo: *requireMemoFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashUpdateWalletConfigMsg{
+			CashUpdateWalletConfigMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
 func cmdSendTokens(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
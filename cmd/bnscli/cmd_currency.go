@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/x/currency"
+)
+
+func cmdCurrencyUpdateConfiguration(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for configuring currency extension. Transaction must be
+signed by the current configuration owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		ownerFl      = flAddress(fl, "owner", "", "Address of the new configuration owner. Leave empty to not change.")
+		governanceFl = flAddress(fl, "governance", "", "Address allowed to update tokens registered without an issuer. Leave empty to not change.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CurrencyUpdateConfigurationMsg{
+			CurrencyUpdateConfigurationMsg: &currency.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &currency.Configuration{
+					Metadata:   &weave.Metadata{Schema: 1},
+					Owner:      *ownerFl,
+					Governance: *governanceFl,
+				},
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
@@ -41,3 +41,32 @@ Create a transaction for releasing funds from given escrow.
 	_, err := writeTx(output, tx)
 	return err
 }
+
+func cmdFundEscrow(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for adding funds to given escrow.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		escrowFl = flSeq(fl, "escrow", "", "An ID of an escrow that is to be funded.")
+		senderFl = flAddress(fl, "sender", "", "An address that the funds are transferred from.")
+		amountFl = flCoin(fl, "amount", "", "An amount that is to be transferred to the escrow.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_EscrowFundEscrowMsg{
+			EscrowFundEscrowMsg: &escrow.FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: *escrowFl,
+				Sender:   *senderFl,
+				Amount:   []*coin.Coin{amountFl},
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -170,6 +171,12 @@ transaction (ie signatures) are being dropped.
 						DistributionResetMsg: m,
 					},
 				})
+			case *distribution.ClaimMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_DistributionClaimMsg{
+						DistributionClaimMsg: m,
+					},
+				})
 			case *gov.UpdateElectorateMsg:
 				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
 					Sum: &bnsd.ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{
@@ -254,6 +261,12 @@ transaction (ie signatures) are being dropped.
 						AccountReplaceAccountTargetsMsg: m,
 					},
 				})
+			case *account.ReplaceAccountResourcesMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg{
+						AccountReplaceAccountResourcesMsg: m,
+					},
+				})
 			case *account.DeleteAccountMsg:
 				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
 					Sum: &bnsd.ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg{
@@ -296,6 +309,24 @@ transaction (ie signatures) are being dropped.
 						TxfeeUpdateConfigurationMsg: m,
 					},
 				})
+			case *sigs.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg{
+						SigsUpdateConfigurationMsg: m,
+					},
+				})
+			case *currency.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg{
+						CurrencyUpdateConfigurationMsg: m,
+					},
+				})
+			case *currency.UpdateTokenInfoMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg{
+						CurrencyUpdateTokenInfoMsg: m,
+					},
+				})
 			case *termdeposit.CreateDepositContractMsg:
 				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
 					Sum: &bnsd.ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg{
@@ -314,6 +345,18 @@ transaction (ie signatures) are being dropped.
 						TermdepositReleaseDepositMsg: m,
 					},
 				})
+			case *termdeposit.SweepDepositsMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg{
+						TermdepositSweepDepositsMsg: m,
+					},
+				})
+			case *termdeposit.TopUpDepositMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg{
+						TermdepositTopUpDepositMsg: m,
+					},
+				})
 			case *termdeposit.UpdateConfigurationMsg:
 				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
 					Sum: &bnsd.ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg{
@@ -373,10 +416,22 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_DistributionResetMsg{
 			DistributionResetMsg: msg,
 		}
+	case *distribution.ClaimMsg:
+		option.Option = &bnsd.ProposalOptions_DistributionClaimMsg{
+			DistributionClaimMsg: msg,
+		}
 	case *migration.UpgradeSchemaMsg:
 		option.Option = &bnsd.ProposalOptions_MigrationUpgradeSchemaMsg{
 			MigrationUpgradeSchemaMsg: msg,
 		}
+	case *migration.DowngradeSchemaMsg:
+		option.Option = &bnsd.ProposalOptions_MigrationDowngradeSchemaMsg{
+			MigrationDowngradeSchemaMsg: msg,
+		}
+	case *migration.RenameSchemaMsg:
+		option.Option = &bnsd.ProposalOptions_MigrationRenameSchemaMsg{
+			MigrationRenameSchemaMsg: msg,
+		}
 	case *gov.UpdateElectorateMsg:
 		option.Option = &bnsd.ProposalOptions_GovUpdateElectorateMsg{
 			GovUpdateElectorateMsg: msg,
@@ -433,6 +488,10 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_AccountReplaceAccountTargetsMsg{
 			AccountReplaceAccountTargetsMsg: msg,
 		}
+	case *account.ReplaceAccountResourcesMsg:
+		option.Option = &bnsd.ProposalOptions_AccountReplaceAccountResourcesMsg{
+			AccountReplaceAccountResourcesMsg: msg,
+		}
 	case *account.DeleteAccountMsg:
 		option.Option = &bnsd.ProposalOptions_AccountDeleteAccountMsg{
 			AccountDeleteAccountMsg: msg,
@@ -461,6 +520,18 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_TxfeeUpdateConfigurationMsg{
 			TxfeeUpdateConfigurationMsg: msg,
 		}
+	case *sigs.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_SigsUpdateConfigurationMsg{
+			SigsUpdateConfigurationMsg: msg,
+		}
+	case *currency.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_CurrencyUpdateConfigurationMsg{
+			CurrencyUpdateConfigurationMsg: msg,
+		}
+	case *currency.UpdateTokenInfoMsg:
+		option.Option = &bnsd.ProposalOptions_CurrencyUpdateTokenInfoMsg{
+			CurrencyUpdateTokenInfoMsg: msg,
+		}
 	case *termdeposit.CreateDepositContractMsg:
 		option.Option = &bnsd.ProposalOptions_TermdepositCreateDepositContractMsg{
 			TermdepositCreateDepositContractMsg: msg,
@@ -473,6 +544,14 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_TermdepositReleaseDepositMsg{
 			TermdepositReleaseDepositMsg: msg,
 		}
+	case *termdeposit.SweepDepositsMsg:
+		option.Option = &bnsd.ProposalOptions_TermdepositSweepDepositsMsg{
+			TermdepositSweepDepositsMsg: msg,
+		}
+	case *termdeposit.TopUpDepositMsg:
+		option.Option = &bnsd.ProposalOptions_TermdepositTopUpDepositMsg{
+			TermdepositTopUpDepositMsg: msg,
+		}
 	case *termdeposit.UpdateConfigurationMsg:
 		option.Option = &bnsd.ProposalOptions_TermdepositUpdateConfigurationMsg{
 			TermdepositUpdateConfigurationMsg: msg,
@@ -725,6 +804,10 @@ Creates a new version for an existing election rule. The new version is used for
 		numeratorFl   = fl.Int("threshold-numerator", 0, "The top number of the fraction.")
 		denominatorFl = fl.Uint("threshold-denominator", 0, "The bottom number of the fraction")
 		quorumFl      = flFraction(fl, "quorum", "", "New quorum fraction in format <numerator>/<denominator>. Zero quorum deletes the value.")
+		delayFl       = fl.Int("execution-delay", 0, "Duration in seconds how long the execution of an accepted proposal is delayed. Zero keeps the current delay.")
+		abstainFl     = fl.Bool("abstain-counts-for-quorum", false, "If set, abstain votes are included in the quorum turnout. Not set keeps the current value.")
+		maxTitleFl    = fl.Uint("max-title-length", 0, "The greatest length in bytes of a proposal title. Zero keeps the current limit.")
+		maxDescFl     = fl.Uint("max-description-length", 0, "The greatest length in bytes of a proposal description. Zero keeps the current limit.")
 	)
 	fl.Parse(args)
 	if len(*id) == 0 {
@@ -748,11 +831,15 @@ Creates a new version for an existing election rule. The new version is used for
 	govTx := &bnsd.Tx{
 		Sum: &bnsd.Tx_GovUpdateElectionRuleMsg{
 			GovUpdateElectionRuleMsg: &gov.UpdateElectionRuleMsg{
-				Metadata:       &weave.Metadata{Schema: 1},
-				ElectionRuleID: []byte(*id),
-				VotingPeriod:   weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
-				Threshold:      fraction,
-				Quorum:         quorum,
+				Metadata:               &weave.Metadata{Schema: 1},
+				ElectionRuleID:         []byte(*id),
+				VotingPeriod:           weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
+				Threshold:              fraction,
+				Quorum:                 quorum,
+				ExecutionDelay:         weave.AsUnixDuration(time.Duration(*delayFl) * time.Second),
+				AbstainCountsForQuorum: *abstainFl,
+				MaxTitleLength:         uint32(*maxTitleFl),
+				MaxDescriptionLength:   uint32(*maxDescFl),
 			},
 		},
 	}
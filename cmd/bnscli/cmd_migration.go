@@ -41,3 +41,71 @@ Create a transaction for upgrading or initializing schema version of a given ext
 	_, err := writeTx(output, tx)
 	return err
 }
+
+func cmdDowngradeSchema(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for an emergency rollback of the schema version of a given
+extension. Rollback is accepted only if the migration configuration allows
+downgrades and a reverse migration is registered for the extension.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		pkgFl         = fl.String("pkg", "", "Name of the extension that schema is to be downgraded")
+		fromVersionFl = fl.Uint("ver", 0, "Current schema version that is to be rolled back.")
+	)
+	fl.Parse(args)
+
+	msg := migration.DowngradeSchemaMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Pkg:         *pkgFl,
+		FromVersion: uint32(*fromVersionFl),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_MigrationDowngradeSchemaMsg{
+			MigrationDowngradeSchemaMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRenameSchema(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for moving the schema of a renamed extension from the old
+name to the new name. An alias between both names must be registered by the
+application.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		oldPkgFl = fl.String("old", "", "Name of the extension that the schema is stored under.")
+		newPkgFl = fl.String("new", "", "Name of the extension that the schema is to be stored under.")
+	)
+	fl.Parse(args)
+
+	msg := migration.RenameSchemaMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		OldPkg:   *oldPkgFl,
+		NewPkg:   *newPkgFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_MigrationRenameSchemaMsg{
+			MigrationRenameSchemaMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
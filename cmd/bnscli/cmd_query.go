@@ -40,7 +40,7 @@ Execute a ABCI query and print JSON encoded result.
 		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
-		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
+		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates and 'proposal/address' for votes by proposal and voter and 'blockchain:address' for usernames by target")
 		prefixQueryFl = fl.String("prefix", "false", "If true, use prefix queries instead of the exact match with provided data. [true/false]")
 	)
 	fl.Parse(args)
@@ -164,6 +164,16 @@ var queries = map[string]struct {
 		decKey: rawKey,
 		encID:  addressID,
 	},
+	"/votes/proposalvoter": {
+		newObj: func() model { return &gov.Vote{} },
+		decKey: rawKey,
+		encID:  proposalVoterID,
+	},
+	"/votes/proposal": {
+		newObj: func() model { return &gov.Vote{} },
+		decKey: rawKey,
+		encID:  proposalVoterID,
+	},
 	"/usernames": {
 		newObj: func() model { return &username.Token{} },
 		decKey: rawKey,
@@ -174,6 +184,11 @@ var queries = map[string]struct {
 		decKey: rawKey,
 		encID:  addressID,
 	},
+	"/usernames/target": {
+		newObj: func() model { return &username.Token{} },
+		decKey: rawKey,
+		encID:  strID,
+	},
 	"/wallets": {
 		newObj: func() model { return &cash.Set{} },
 		decKey: rawKey,
@@ -271,6 +286,24 @@ func addressID(s string) ([]byte, error) {
 	return weave.ParseAddress(s)
 }
 
+// proposalVoterID encodes a proposal ID, optionally followed by a voter
+// address. Expected format is 'proposal/address'.
+func proposalVoterID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	encID, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode proposal ID: %s", err)
+	}
+	if len(tokens) == 1 {
+		return encID, nil
+	}
+	addr, err := weave.ParseAddress(tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode voter address: %s", err)
+	}
+	return append(encID, addr...), nil
+}
+
 func strID(s string) ([]byte, error) {
 	return []byte(s), nil
 }
@@ -9,7 +9,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/sigs"
@@ -35,6 +38,7 @@ content.
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		forkFl = fl.Bool("fork-discriminator", false, "Include in the signature the fork discriminator computed from the genesis time.")
 	)
 	fl.Parse(args)
 
@@ -61,7 +65,12 @@ content.
 	if seq, err := aNonce.Next(); err != nil {
 		return fmt.Errorf("cannot get the next sequence number: %s", err)
 	} else {
-		sig, err := sigs.SignTx(key, tx, genesis.ChainID, seq)
+		var sig *sigs.StdSignature
+		if *forkFl {
+			sig, err = sigs.SignTxV2(key, tx, genesis.ChainID, sigs.ForkDiscriminator(genesis.GenesisTime), seq)
+		} else {
+			sig, err = sigs.SignTx(key, tx, genesis.ChainID, seq)
+		}
 		if err != nil {
 			return fmt.Errorf("cannot sign transaction: %s", err)
 		}
@@ -105,5 +114,41 @@ func fetchGenesis(serverURL string) (*genesis, error) {
 }
 
 type genesis struct {
-	ChainID string `json:"chain_id"`
+	ChainID     string    `json:"chain_id"`
+	GenesisTime time.Time `json:"genesis_time"`
+}
+
+func cmdSigsUpdateConfiguration(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for configuring sigs extension. Transaction must be
+signed by the current configuration owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		ownerFl         = flAddress(fl, "owner", "", "Address of the new configuration owner. Leave empty to not change.")
+		discriminatorFl = flHex(fl, "fork-discriminator", "", "Hex encoded fork discriminator. Leave empty to not change.")
+		heightFl        = fl.Int64("fork-discriminator-height", 0, "Block height starting with which signatures must include the fork discriminator.")
+		legacyFl        = fl.Int64("legacy-sign-bytes-blocks", 0, "Number of blocks after the fork discriminator height during which signatures without it are still accepted.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_SigsUpdateConfigurationMsg{
+			SigsUpdateConfigurationMsg: &sigs.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &sigs.Configuration{
+					Metadata:                &weave.Metadata{Schema: 1},
+					Owner:                   *ownerFl,
+					ForkDiscriminator:       *discriminatorFl,
+					ForkDiscriminatorHeight: *heightFl,
+					LegacySignBytesBlocks:   *legacyFl,
+				},
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
 }
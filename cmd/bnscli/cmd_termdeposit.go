@@ -21,7 +21,7 @@ message can be submitted only if related Deposit Contract is expired.
 		fl.PrintDefaults()
 	}
 	var (
-		depositFl = flSeq(fl, "deposit", "", "An ID of a deposit that is to be released.")
+		depositFl = flID(fl, "deposit", "", "An ID of a deposit that is to be released. A deposit created with a nonce has a hex encoded ID, for example hex:<id>.")
 	)
 	fl.Parse(args)
 
@@ -36,6 +36,67 @@ message can be submitted only if related Deposit Contract is expired.
 	_, err := writeTx(output, tx)
 	return err
 }
+
+func cmdTermdepositSweepDeposits(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for releasing not yet released deposits of a given
+Deposit Contract. This message can be submitted only if the contract matured
+at least the configured auto sweep duration ago. A single transaction
+processes a limited number of deposits. Its result is the ID of the last
+processed deposit that must be used as the start of the next sweep.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		contractFl = flSeq(fl, "contract", "", "An ID of a deposit contract that deposits are to be released.")
+		afterFl    = flHex(fl, "after", "", "Optional hex encoded ID of the last deposit processed by the previous sweep.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_TermdepositSweepDepositsMsg{
+			TermdepositSweepDepositsMsg: &termdeposit.SweepDepositsMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				DepositContractID: *contractFl,
+				StartAfter:        *afterFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdTermdepositTopUpDeposit(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for adding funds to an existing deposit. Funds are
+withdrawn from the depositor account and locked until the deposit release.
+Deposit rate is recomputed to include the rate of the added funds.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		depositFl = flID(fl, "deposit", "", "An ID of a deposit that is to be topped up. A deposit created with a nonce has a hex encoded ID, for example hex:<id>.")
+		amountFl  = flCoin(fl, "amount", "", "Funds to be added to the deposit.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_TermdepositTopUpDepositMsg{
+			TermdepositTopUpDepositMsg: &termdeposit.TopUpDepositMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				DepositID: *depositFl,
+				Amount:    *amountFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
 func cmdTermdepositDeposit(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -49,6 +110,8 @@ Declared funds are locked until created Deposit release.
 		contractFl = flSeq(fl, "contract", "", "An ID of a deposit contract that funds are deposited with.")
 		amountFl   = flCoin(fl, "amount", "", "Funds to be deposited within that contract.")
 		depositoFl = flAddress(fl, "depositor", "", "Source of the deposit. An address that funds are withdrawn from and later returned to.")
+		nonceFl    = flHex(fl, "nonce", "", "Optional hex encoded nonce. If provided, the deposit ID is derived from the message content and submitting the same message again fails.")
+		rolloverFl = fl.Bool("rollover", false, "If set, once released the funds are deposited again within the successor of the contract, if it accepts deposits.")
 	)
 	fl.Parse(args)
 
@@ -59,6 +122,8 @@ Declared funds are locked until created Deposit release.
 				DepositContractID: *contractFl,
 				Amount:            *amountFl,
 				Depositor:         *depositoFl,
+				Nonce:             *nonceFl,
+				Rollover:          *rolloverFl,
 			},
 		},
 	}
@@ -75,17 +140,19 @@ Create a transaction for creating a new termdeposit Contract entity.
 		fl.PrintDefaults()
 	}
 	var (
-		validSinceFl = flTime(fl, "valid-since", time.Now, "Start date of a contract.")
-		validUntilFl = flTime(fl, "valid-until", nextWeek, "Expiration date of a contract.")
+		validSinceFl  = flTime(fl, "valid-since", time.Now, "Start date of a contract.")
+		validUntilFl  = flTime(fl, "valid-until", nextWeek, "Expiration date of a contract.")
+		predecessorFl = flSeq(fl, "predecessor", "", "Optional ID of a contract that the created contract succeeds. Rollover deposits of that contract are deposited within the created one.")
 	)
 	fl.Parse(args)
 
 	tx := &bnsd.Tx{
 		Sum: &bnsd.Tx_TermdepositCreateDepositContractMsg{
 			TermdepositCreateDepositContractMsg: &termdeposit.CreateDepositContractMsg{
-				Metadata:   &weave.Metadata{Schema: 1},
-				ValidSince: validSinceFl.UnixTime(),
-				ValidUntil: validUntilFl.UnixTime(),
+				Metadata:      &weave.Metadata{Schema: 1},
+				ValidSince:    validSinceFl.UnixTime(),
+				ValidUntil:    validUntilFl.UnixTime(),
+				PredecessorID: *predecessorFl,
 			},
 		},
 	}
@@ -97,6 +164,17 @@ func nextWeek() time.Time {
 	return time.Now().Add(time.Hour * 24 * 7)
 }
 
+var supportedInterestModes = map[string]termdeposit.InterestMode{
+	"simple":   termdeposit.InterestMode_SimpleInterest,
+	"compound": termdeposit.InterestMode_CompoundInterest,
+}
+
+var supportedRoundingModes = map[string]termdeposit.RoundingMode{
+	"floor":     termdeposit.RoundingMode_RoundFloor,
+	"ceil":      termdeposit.RoundingMode_RoundCeil,
+	"half-even": termdeposit.RoundingMode_RoundHalfEven,
+}
+
 func cmdTermdepositUpdateConfiguration(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -106,19 +184,42 @@ Create a transaction for updating termdeposit extension configuration.
 		fl.PrintDefaults()
 	}
 	var (
-		ownerFl = flAddress(fl, "owner", "", "A new configuration owner.")
-		adminFl = flAddress(fl, "admin", "", "A new admin address.")
+		ownerFl  = flAddress(fl, "owner", "", "A new configuration owner.")
+		adminFl  = flAddress(fl, "admin", "", "A new admin address.")
+		graceFl  = fl.Duration("post-maturity-grace", 0, "Duration after the contract maturity during which deposits keep accruing. Zero stops accrual at the maturity.")
+		rateFl   = flFraction(fl, "post-maturity-rate", "", "Part of the deposited amount accrued over the whole post maturity grace period.")
+		modeFl   = fl.String("interest-mode", "simple", "Post maturity interest mode. Supported modes are: simple, compound")
+		periodFl = fl.Duration("compounding-period", 0, "Compounding period of the post maturity accrual. Used by the compound interest mode only.")
+		sweepFl  = fl.Duration("auto-sweep-after", 0, "Duration after the contract maturity after which anyone can release its deposits. Zero disables sweeping.")
+		maxFl    = fl.Uint("max-deposits-per-address", 0, "Maximum number of not released deposits a single address can hold. Zero means no limit.")
+		roundFl  = fl.String("rounding-mode", "floor", "Rounding mode of the post maturity accrual. Supported modes are: floor, ceil, half-even")
 	)
 	fl.Parse(args)
 
+	mode, ok := supportedInterestModes[*modeFl]
+	if !ok {
+		flagDie("unsupported interest mode: %q", *modeFl)
+	}
+	rounding, ok := supportedRoundingModes[*roundFl]
+	if !ok {
+		flagDie("unsupported rounding mode: %q", *roundFl)
+	}
+
 	tx := &bnsd.Tx{
 		Sum: &bnsd.Tx_TermdepositUpdateConfigurationMsg{
 			TermdepositUpdateConfigurationMsg: &termdeposit.UpdateConfigurationMsg{
 				Metadata: &weave.Metadata{Schema: 1},
 				Patch: &termdeposit.Configuration{
-					Metadata: &weave.Metadata{Schema: 1},
-					Owner:    *ownerFl,
-					Admin:    *adminFl,
+					Metadata:              &weave.Metadata{Schema: 1},
+					Owner:                 *ownerFl,
+					Admin:                 *adminFl,
+					PostMaturityGrace:     weave.AsUnixDuration(*graceFl),
+					PostMaturityRate:      rateFl.Fraction(),
+					InterestMode:          mode,
+					CompoundingPeriod:     weave.AsUnixDuration(*periodFl),
+					AutoSweepAfter:        weave.AsUnixDuration(*sweepFl),
+					MaxDepositsPerAddress: uint32(*maxFl),
+					RoundingMode:          rounding,
 				},
 			},
 		},
@@ -138,8 +239,10 @@ This functionality is intended to extend UpdateConfigurationMsg message.
 		fl.PrintDefaults()
 	}
 	var (
+		denomFl  = fl.String("denom", "IOV", "Denomination of the deposits that this bonus applies to.")
 		periodFl = fl.Duration("period", 10*24*time.Hour, "Lockin period required for this bonus.")
 		bonusFl  = flFraction(fl, "bonus", "1/2", "Bonus value for this period.")
+		untilFl  = flTime(fl, "valid-until", nil, "Expiration date of this bonus. If not provided, the bonus never expires.")
 	)
 	fl.Parse(args)
 
@@ -155,10 +258,27 @@ This functionality is intended to extend UpdateConfigurationMsg message.
 
 	switch msg := msg.(type) {
 	case *termdeposit.UpdateConfigurationMsg:
-		msg.Patch.Bonuses = append(msg.Patch.Bonuses, termdeposit.DepositBonus{
+		bonus := termdeposit.DepositBonus{
 			LockinPeriod: weave.AsUnixDuration(*periodFl),
 			Bonus:        bonusFl.Fraction(),
-		})
+		}
+		if !untilFl.Time().IsZero() {
+			bonus.ValidUntil = untilFl.UnixTime()
+		}
+		var found bool
+		for i, b := range msg.Patch.Bonuses {
+			if b.Denom == *denomFl {
+				msg.Patch.Bonuses[i].Bonuses = append(b.Bonuses, bonus)
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg.Patch.Bonuses = append(msg.Patch.Bonuses, termdeposit.DenomBonuses{
+				Denom:   *denomFl,
+				Bonuses: []termdeposit.DepositBonus{bonus},
+			})
+		}
 	default:
 		return fmt.Errorf("unsupported transaction message: %T", msg)
 	}
@@ -78,6 +78,36 @@ func unpackSequence(raw string) ([]byte, error) {
 
 }
 
+// unpackID returns the binary representation of an entity ID. All sequence
+// formats are accepted, but a hex or base64 encoded value can be of any
+// length, so that an ID that is not a sequence value, for example a hash, can
+// be used as well.
+func unpackID(raw string) ([]byte, error) {
+	chunks := strings.SplitN(raw, ":", 2)
+	if len(chunks) != 2 {
+		return unpackSequence(raw)
+	}
+	var (
+		b   []byte
+		err error
+	)
+	switch chunks[0] {
+	case "hex":
+		b, err = hex.DecodeString(chunks[1])
+	case "base64":
+		b, err = base64.StdEncoding.DecodeString(chunks[1])
+	default:
+		return unpackSequence(raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s format: %s", chunks[0], err)
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty")
+	}
+	return b, nil
+}
+
 // sequenceID returns a sequence value encoded as implemented in the orm
 // package.
 func sequenceID(n uint64) []byte {
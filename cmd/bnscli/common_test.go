@@ -88,3 +88,65 @@ func TestUnpackSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestUnpackID(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xab}, 32)
+
+	cases := map[string]struct {
+		Raw     string
+		WantErr bool
+		Want    []byte
+	}{
+		"default encoding (decimal)": {
+			Raw:  "123",
+			Want: sequenceID(123),
+		},
+		"zero decimal value is not allowed": {
+			Raw:     "0",
+			WantErr: true,
+		},
+		"hex encoded sequence value": {
+			Raw:  "hex:" + hex.EncodeToString(sequenceID(1234567890)),
+			Want: sequenceID(1234567890),
+		},
+		"hex encoded hash": {
+			Raw:  "hex:" + hex.EncodeToString(hash),
+			Want: hash,
+		},
+		"base64 encoded hash": {
+			Raw:  "base64:" + base64.StdEncoding.EncodeToString(hash),
+			Want: hash,
+		},
+		"empty hex encoded value": {
+			Raw:     "hex:",
+			WantErr: true,
+		},
+		"invalid hex encoded value": {
+			Raw:     "hex:xyz",
+			WantErr: true,
+		},
+		"unknown encoding (random string)": {
+			Raw:     "x:_P1U_!RU)RQU_AU)FAf",
+			WantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			b, err := unpackID(tc.Raw)
+
+			if tc.WantErr {
+				if err == nil {
+					t.Fatalf("want error, got %x", b)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !bytes.Equal(b, tc.Want) {
+					t.Fatalf("unexpected result: %x", b)
+				}
+			}
+		})
+	}
+}
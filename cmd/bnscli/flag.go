@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/iov-one/weave"
@@ -47,6 +48,35 @@ func flCoin(fl *flag.FlagSet, name, defaultVal, usage string) *coin.Coin {
 	return &c
 }
 
+// flCoins returns a list of coins that is extended with a value of each
+// occurrence of the flag in the command line arguments.
+func flCoins(fl *flag.FlagSet, name, usage string) *flagcoins {
+	var fc flagcoins
+	fl.Var(&fc, name, usage)
+	return &fc
+}
+
+// flagcoins is a list of coins that implements flag.Value interface. Setting
+// a value appends it to the list.
+type flagcoins []coin.Coin
+
+func (c flagcoins) String() string {
+	s := make([]string, len(c))
+	for i, v := range c {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func (c *flagcoins) Set(raw string) error {
+	val, err := coin.ParseHumanFormat(raw)
+	if err != nil {
+		return err
+	}
+	*c = append(*c, val)
+	return nil
+}
+
 func flTime(fl *flag.FlagSet, name string, defaultVal func() time.Time, usage string) *flagTime {
 	var t flagTime
 	if defaultVal != nil {
@@ -175,6 +205,48 @@ func (b *flagseq) Set(raw string) error {
 	return nil
 }
 
+// flID returns a value that is being initialized with given default value
+// and optionally overwritten by a command line argument if provided. This
+// function follows Go's flag package convention.
+// If given value cannot be deserialized to required type, process is
+// terminated.
+// ID can be serialized using any of the sequence formats. Hex and base64
+// serialized values can be of any length.
+func flID(fl *flag.FlagSet, name, defaultVal, usage string) *flagid {
+	var b []byte
+	if defaultVal != "" {
+		var err error
+		b, err = unpackID(defaultVal)
+		if err != nil {
+			flagDie("Cannot parse %q ID flag value. %s", name, err)
+		}
+	}
+	var fi flagid = b
+	fl.Var(&fi, name, usage)
+	return &fi
+}
+
+type flagid []byte
+
+func (b flagid) String() string {
+	if len(b) == 0 {
+		return ""
+	}
+	if n, err := fromSequence(b); err == nil {
+		return fmt.Sprint(n)
+	}
+	return "hex:" + hex.EncodeToString(b)
+}
+
+func (b *flagid) Set(raw string) error {
+	val, err := unpackID(raw)
+	if err != nil {
+		return err
+	}
+	*b = val
+	return nil
+}
+
 func flFraction(fl *flag.FlagSet, name, defaultVal, usage string) *flagfraction {
 	var ff flagfraction
 	if defaultVal != "" {
@@ -42,13 +42,16 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"as-batch":                             cmdAsBatch,
 	"as-proposal":                          cmdAsProposal,
 	"as-sequence":                          cmdAsSequence,
+	"currency-update-configuration":        cmdCurrencyUpdateConfiguration,
 	"datamigration":                        cmdDataMigrationExecute,
 	"del-account-certificate":              cmdDelAccountCertificate,
 	"del-proposal":                         cmdDelProposal,
 	"delete-account":                       cmdDeleteAccount,
 	"delete-domain":                        cmdDeleteDomain,
+	"downgrade-schema":                     cmdDowngradeSchema,
 	"flush-domain":                         cmdFlushDomain,
 	"from-sequence":                        cmdFromSequence,
+	"fund-escrow":                          cmdFundEscrow,
 	"keyaddr":                              cmdKeyaddr,
 	"keygen":                               cmdKeygen,
 	"mnemonic":                             cmdMnemonic,
@@ -62,9 +65,11 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"register-domain":                      cmdRegisterDomain,
 	"register-username":                    cmdRegisterUsername,
 	"release-escrow":                       cmdReleaseEscrow,
+	"rename-schema":                        cmdRenameSchema,
 	"renew-account":                        cmdRenewAccount,
 	"renew-domain":                         cmdRenewDomain,
 	"replace-account-msg-fees":             cmdReplaceAccountMsgFees,
+	"replace-account-resources":            cmdReplaceAccountResources,
 	"replace-account-targets":              cmdReplaceAccountTrarget,
 	"reset-revenue":                        cmdResetRevenue,
 	"resolve-username":                     cmdResolveUsername,
@@ -72,10 +77,13 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"set-msgfee":                           cmdSetMsgFee,
 	"set-validators":                       cmdSetValidators,
 	"sign":                                 cmdSignTransaction,
+	"sigs-update-configuration":            cmdSigsUpdateConfiguration,
 	"submit":                               cmdSubmitTransaction,
 	"termdeposit-create-contract":          cmdTermdepositCreateDepositContract,
 	"termdeposit-deposit":                  cmdTermdepositDeposit,
 	"termdeposit-release-deposit":          cmdTermdepositReleaseDeposit,
+	"termdeposit-sweep-deposits":           cmdTermdepositSweepDeposits,
+	"termdeposit-top-up-deposit":           cmdTermdepositTopUpDeposit,
 	"termdeposit-update-configuration":     cmdTermdepositUpdateConfiguration,
 	"termdeposit-with-base-rate":           cmdTermdepositWithBaseRate,
 	"termdeposit-with-bonus":               cmdTermdepositWithBonus,
@@ -89,11 +97,13 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"update-election-rule":                 cmdUpdateElectionRule,
 	"update-electorate":                    cmdUpdateElectorate,
 	"update-username-configuration":        cmdUpdateUsernameConfiguration,
+	"update-wallet-config":                 cmdUpdateWalletConfig,
 	"upgrade-schema":                       cmdUpgradeSchema,
 	"version":                              cmdVersion,
 	"view":                                 cmdTransactionView,
 	"vote":                                 cmdVote,
 	"with-account-msg-fee":                 cmdWithAccountMsgFee,
+	"with-account-resource":                cmdWithAccountResource,
 	"with-account-target":                  cmdWithAccountTarget,
 	"with-blockchain-address":              cmdWithBlockchainAddress,
 	"with-elector":                         cmdWithElector,
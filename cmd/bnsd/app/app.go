@@ -49,8 +49,9 @@ func Authenticator() x.Authenticator {
 }
 
 // Chain returns a chain of decorators, to handle authentication,
-// fees, logging, and recovery
-func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
+// fees, logging, and recovery. If fees is not nil, all collected fees are
+// counted by it.
+func Chain(authFn x.Authenticator, minFee coin.Coin, fees *cash.FeeSummary) app.Decorators {
 	return app.ChainDecorators(
 		utils.NewLogging(),
 		utils.NewRecovery(),
@@ -60,7 +61,7 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 		sigs.NewDecorator(),
 		multisig.NewDecorator(authFn),
 		// cash.NewDynamicFeeDecorator embeds utils.NewSavepoint().OnDeliver()
-		cash.NewDynamicFeeDecorator(authFn, ctrl),
+		cash.NewDynamicFeeDecorator(authFn, ctrl).WithFeeSummary(fees),
 		msgfee.NewAntispamFeeDecorator(minFee),
 		msgfee.NewFeeDecorator(),
 		preregistration.NewZeroFeeDecorator(),
@@ -94,8 +95,8 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	validators.RegisterRoutes(r, authFn)
 	distribution.RegisterRoutes(r, authFn, ctrl)
 	sigs.RegisterRoutes(r, authFn)
-	aswap.RegisterRoutes(r, authFn, ctrl)
-	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler)
+	aswap.RegisterRoutes(r, authFn, ctrl, scheduler)
+	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl, scheduler), scheduler)
 	username.RegisterRoutes(r, authFn)
 	msgfee.RegisterRoutes(r, authFn)
 	datamigration.RegisterRoutes(r, authFn)
@@ -134,14 +135,19 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 		preregistration.RegisterQuery,
 		msgfee.RegisterQuery,
 	)
+	// Message handler configuration does not influence the list of
+	// registered message paths.
+	app.NewCapabilitiesQuery(weave.Version, Router(Authenticator(), nil), r).RegisterQuery(r)
+	app.CommitMetadataQuery{}.RegisterQuery(r)
 	return r
 }
 
 // Stack wires up a standard router with a standard decorator
-// chain. This can be passed into BaseApp.
-func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
+// chain. This can be passed into BaseApp. If fees is not nil, its end block
+// hook must be registered in the BaseApp.
+func Stack(issuer weave.Address, minFee coin.Coin, fees *cash.FeeSummary) weave.Handler {
 	authFn := Authenticator()
-	return Chain(authFn, minFee).WithHandler(Router(authFn, issuer))
+	return Chain(authFn, minFee, fees).WithHandler(Router(authFn, issuer))
 }
 
 // CronStack wires up a standard router with a cron specific decorator chain.
@@ -151,14 +157,15 @@ func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
 // fee).
 func CronStack() weave.Handler {
 	rt := app.NewRouter()
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	authFn := cron.Authenticator{}
 
 	// Cron is using custom router as not the same handlers are registered.
-	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl))
+	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl, scheduler), scheduler)
 	distribution.RegisterRoutes(rt, authFn, ctrl)
 	escrow.RegisterRoutes(rt, authFn, ctrl)
-	aswap.RegisterRoutes(rt, authFn, ctrl)
+	aswap.RegisterRoutes(rt, authFn, ctrl, scheduler)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
@@ -185,9 +192,25 @@ func Application(
 	if err != nil {
 		return app.BaseApp{}, errors.Wrap(err, "cannot create store")
 	}
-	store := app.NewStoreApp(name, kv, QueryRouter(options.MinFee), ctx)
+	store := app.NewStoreApp(name, kv, QueryRouter(options.MinFee), ctx).
+		WithCommitMetadata(weave.Version, weave.GitCommit)
+	if options.TrackIterators {
+		store = store.WithIteratorLeakDetection(false)
+	}
 	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
-	base := app.NewBaseApp(store, tx, h, ticker, options.Debug)
+	// Decode limits are configured per node, so they are applied to the
+	// CheckTx only. DeliverTx must accept every transaction included in a
+	// block.
+	checkTx := app.LimitedTxDecoder(tx, app.DecodeLimits{
+		MaxTxBytes:  options.MaxTxBytes,
+		MaxRepeated: options.MaxTxRepeated,
+		MaxDepth:    options.MaxTxDepth,
+	})
+	base := app.NewBaseApp(store, tx, h, ticker, options.Debug).
+		WithCheckTxDecoder(checkTx)
+	if options.CheckCacheSize > 0 {
+		base = base.WithCheckCache(app.NewCheckCache(options.CheckCacheSize, options.CheckCacheTTL))
+	}
 	return base, nil
 }
 
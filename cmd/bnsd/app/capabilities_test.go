@@ -0,0 +1,62 @@
+package bnsd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	weaveApp "github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/cmd/bnsd/app/testdata/fixtures"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var goldFl = flag.Bool("gold", false, "If true, write result to golden files instead of comparing with them.")
+
+// TestCapabilitiesSnapshot ensures that no query or message path is
+// accidentally removed. If a change is intended, run this test with the
+// -gold flag to update the snapshot.
+func TestCapabilitiesSnapshot(t *testing.T) {
+	const goldFilePath = "testdata/capabilities.json.gold"
+
+	myApp := fixtures.NewApp().Build()
+	res := myApp.Query(abci.RequestQuery{Path: weaveApp.CapabilitiesPath})
+	assert.Equal(t, uint32(0), res.Code)
+
+	var c weaveApp.Capabilities
+	assert.Nil(t, weaveApp.UnmarshalOneResult(res.Value, &c))
+	// Version depends on the build flags.
+	c.Version = ""
+
+	out, err := json.MarshalIndent(c, "", "  ")
+	assert.Nil(t, err)
+	out = append(out, '\n')
+
+	if *goldFl {
+		if err := ioutil.WriteFile(goldFilePath, out, 0644); err != nil {
+			t.Fatalf("cannot write golden file: %s", err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(goldFilePath)
+	if err != nil {
+		t.Fatalf("cannot read golden file: %s", err)
+	}
+
+	if !bytes.Equal(want, out) {
+		diff := difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(want)),
+			B:        difflib.SplitLines(string(out)),
+			FromFile: "Gold",
+			ToFile:   "Current",
+			Context:  2,
+		}
+		text, _ := difflib.GetUnifiedDiffString(diff)
+		t.Log(text)
+		t.Fatal("unexpected result")
+	}
+}
@@ -114,6 +114,18 @@ type Tx struct {
 	//	*Tx_QualityscoreUpdateConfigurationMsg
 	//	*Tx_PreregistrationUpdateConfigurationMsg
 	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_ValidatorsSetValidatorProfileMsg
+	//	*Tx_CurrencyUpdateTokenInfoMsg
+	//	*Tx_CurrencyUpdateConfigurationMsg
+	//	*Tx_MigrationDowngradeSchemaMsg
+	//	*Tx_CashUpdateWalletConfigMsg
+	//	*Tx_EscrowFundEscrowMsg
+	//	*Tx_SigsUpdateConfigurationMsg
+	//	*Tx_TermdepositTopUpDepositMsg
+	//	*Tx_DistributionClaimMsg
+	//	*Tx_MigrationRenameSchemaMsg
+	//	*Tx_TermdepositSweepDepositsMsg
+	//	*Tx_AccountReplaceAccountResourcesMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -312,6 +324,42 @@ type Tx_PreregistrationUpdateConfigurationMsg struct {
 type Tx_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type Tx_ValidatorsSetValidatorProfileMsg struct {
+	ValidatorsSetValidatorProfileMsg *validators.SetValidatorProfileMsg `protobuf:"bytes,106,opt,name=validators_set_validator_profile_msg,json=validatorsSetValidatorProfileMsg,proto3,oneof"`
+}
+type Tx_CurrencyUpdateTokenInfoMsg struct {
+	CurrencyUpdateTokenInfoMsg *currency.UpdateTokenInfoMsg `protobuf:"bytes,107,opt,name=currency_update_token_info_msg,json=currencyUpdateTokenInfoMsg,proto3,oneof"`
+}
+type Tx_CurrencyUpdateConfigurationMsg struct {
+	CurrencyUpdateConfigurationMsg *currency.UpdateConfigurationMsg `protobuf:"bytes,108,opt,name=currency_update_configuration_msg,json=currencyUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_MigrationDowngradeSchemaMsg struct {
+	MigrationDowngradeSchemaMsg *migration.DowngradeSchemaMsg `protobuf:"bytes,110,opt,name=migration_downgrade_schema_msg,json=migrationDowngradeSchemaMsg,proto3,oneof"`
+}
+type Tx_CashUpdateWalletConfigMsg struct {
+	CashUpdateWalletConfigMsg *cash.UpdateWalletConfigMsg `protobuf:"bytes,111,opt,name=cash_update_wallet_config_msg,json=cashUpdateWalletConfigMsg,proto3,oneof"`
+}
+type Tx_EscrowFundEscrowMsg struct {
+	EscrowFundEscrowMsg *escrow.FundEscrowMsg `protobuf:"bytes,112,opt,name=escrow_fund_escrow_msg,json=escrowFundEscrowMsg,proto3,oneof"`
+}
+type Tx_SigsUpdateConfigurationMsg struct {
+	SigsUpdateConfigurationMsg *sigs.UpdateConfigurationMsg `protobuf:"bytes,113,opt,name=sigs_update_configuration_msg,json=sigsUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_TermdepositTopUpDepositMsg struct {
+	TermdepositTopUpDepositMsg *termdeposit.TopUpDepositMsg `protobuf:"bytes,114,opt,name=termdeposit_top_up_deposit_msg,json=termdepositTopUpDepositMsg,proto3,oneof"`
+}
+type Tx_DistributionClaimMsg struct {
+	DistributionClaimMsg *distribution.ClaimMsg `protobuf:"bytes,115,opt,name=distribution_claim_msg,json=distributionClaimMsg,proto3,oneof"`
+}
+type Tx_MigrationRenameSchemaMsg struct {
+	MigrationRenameSchemaMsg *migration.RenameSchemaMsg `protobuf:"bytes,116,opt,name=migration_rename_schema_msg,json=migrationRenameSchemaMsg,proto3,oneof"`
+}
+type Tx_TermdepositSweepDepositsMsg struct {
+	TermdepositSweepDepositsMsg *termdeposit.SweepDepositsMsg `protobuf:"bytes,117,opt,name=termdeposit_sweep_deposits_msg,json=termdepositSweepDepositsMsg,proto3,oneof"`
+}
+type Tx_AccountReplaceAccountResourcesMsg struct {
+	AccountReplaceAccountResourcesMsg *account.ReplaceAccountResourcesMsg `protobuf:"bytes,118,opt,name=account_replace_account_resources_msg,json=accountReplaceAccountResourcesMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                           {}
 func (*Tx_EscrowCreateMsg) isTx_Sum()                       {}
@@ -365,6 +413,18 @@ func (*Tx_TermdepositUpdateConfigurationMsg) isTx_Sum()     {}
 func (*Tx_QualityscoreUpdateConfigurationMsg) isTx_Sum()    {}
 func (*Tx_PreregistrationUpdateConfigurationMsg) isTx_Sum() {}
 func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()          {}
+func (*Tx_ValidatorsSetValidatorProfileMsg) isTx_Sum()      {}
+func (*Tx_CurrencyUpdateTokenInfoMsg) isTx_Sum()            {}
+func (*Tx_CurrencyUpdateConfigurationMsg) isTx_Sum()        {}
+func (*Tx_MigrationDowngradeSchemaMsg) isTx_Sum()           {}
+func (*Tx_CashUpdateWalletConfigMsg) isTx_Sum()             {}
+func (*Tx_EscrowFundEscrowMsg) isTx_Sum()                   {}
+func (*Tx_SigsUpdateConfigurationMsg) isTx_Sum()            {}
+func (*Tx_TermdepositTopUpDepositMsg) isTx_Sum()            {}
+func (*Tx_DistributionClaimMsg) isTx_Sum()                  {}
+func (*Tx_MigrationRenameSchemaMsg) isTx_Sum()              {}
+func (*Tx_TermdepositSweepDepositsMsg) isTx_Sum()           {}
+func (*Tx_AccountReplaceAccountResourcesMsg) isTx_Sum()     {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -758,6 +818,90 @@ func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
 	return nil
 }
 
+func (m *Tx) GetValidatorsSetValidatorProfileMsg() *validators.SetValidatorProfileMsg {
+	if x, ok := m.GetSum().(*Tx_ValidatorsSetValidatorProfileMsg); ok {
+		return x.ValidatorsSetValidatorProfileMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCurrencyUpdateTokenInfoMsg() *currency.UpdateTokenInfoMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyUpdateTokenInfoMsg); ok {
+		return x.CurrencyUpdateTokenInfoMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCurrencyUpdateConfigurationMsg() *currency.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_CurrencyUpdateConfigurationMsg); ok {
+		return x.CurrencyUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMigrationDowngradeSchemaMsg() *migration.DowngradeSchemaMsg {
+	if x, ok := m.GetSum().(*Tx_MigrationDowngradeSchemaMsg); ok {
+		return x.MigrationDowngradeSchemaMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashUpdateWalletConfigMsg() *cash.UpdateWalletConfigMsg {
+	if x, ok := m.GetSum().(*Tx_CashUpdateWalletConfigMsg); ok {
+		return x.CashUpdateWalletConfigMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowFundEscrowMsg() *escrow.FundEscrowMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowFundEscrowMsg); ok {
+		return x.EscrowFundEscrowMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSigsUpdateConfigurationMsg() *sigs.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_SigsUpdateConfigurationMsg); ok {
+		return x.SigsUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetTermdepositTopUpDepositMsg() *termdeposit.TopUpDepositMsg {
+	if x, ok := m.GetSum().(*Tx_TermdepositTopUpDepositMsg); ok {
+		return x.TermdepositTopUpDepositMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetDistributionClaimMsg() *distribution.ClaimMsg {
+	if x, ok := m.GetSum().(*Tx_DistributionClaimMsg); ok {
+		return x.DistributionClaimMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMigrationRenameSchemaMsg() *migration.RenameSchemaMsg {
+	if x, ok := m.GetSum().(*Tx_MigrationRenameSchemaMsg); ok {
+		return x.MigrationRenameSchemaMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetTermdepositSweepDepositsMsg() *termdeposit.SweepDepositsMsg {
+	if x, ok := m.GetSum().(*Tx_TermdepositSweepDepositsMsg); ok {
+		return x.TermdepositSweepDepositsMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetAccountReplaceAccountResourcesMsg() *account.ReplaceAccountResourcesMsg {
+	if x, ok := m.GetSum().(*Tx_AccountReplaceAccountResourcesMsg); ok {
+		return x.AccountReplaceAccountResourcesMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -813,6 +957,18 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_QualityscoreUpdateConfigurationMsg)(nil),
 		(*Tx_PreregistrationUpdateConfigurationMsg)(nil),
 		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_ValidatorsSetValidatorProfileMsg)(nil),
+		(*Tx_CurrencyUpdateTokenInfoMsg)(nil),
+		(*Tx_CurrencyUpdateConfigurationMsg)(nil),
+		(*Tx_MigrationDowngradeSchemaMsg)(nil),
+		(*Tx_CashUpdateWalletConfigMsg)(nil),
+		(*Tx_EscrowFundEscrowMsg)(nil),
+		(*Tx_SigsUpdateConfigurationMsg)(nil),
+		(*Tx_TermdepositTopUpDepositMsg)(nil),
+		(*Tx_DistributionClaimMsg)(nil),
+		(*Tx_MigrationRenameSchemaMsg)(nil),
+		(*Tx_TermdepositSweepDepositsMsg)(nil),
+		(*Tx_AccountReplaceAccountResourcesMsg)(nil),
 	}
 }
 
@@ -1080,6 +1236,66 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *Tx_ValidatorsSetValidatorProfileMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsSetValidatorProfileMsg); err != nil {
+			return err
+		}
+	case *Tx_CurrencyUpdateTokenInfoMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateTokenInfoMsg); err != nil {
+			return err
+		}
+	case *Tx_CurrencyUpdateConfigurationMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_MigrationDowngradeSchemaMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationDowngradeSchemaMsg); err != nil {
+			return err
+		}
+	case *Tx_CashUpdateWalletConfigMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUpdateWalletConfigMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowFundEscrowMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowFundEscrowMsg); err != nil {
+			return err
+		}
+	case *Tx_SigsUpdateConfigurationMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_TermdepositTopUpDepositMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositTopUpDepositMsg); err != nil {
+			return err
+		}
+	case *Tx_DistributionClaimMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionClaimMsg); err != nil {
+			return err
+		}
+	case *Tx_MigrationRenameSchemaMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationRenameSchemaMsg); err != nil {
+			return err
+		}
+	case *Tx_TermdepositSweepDepositsMsg:
+		_ = b.EncodeVarint(117<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositSweepDepositsMsg); err != nil {
+			return err
+		}
+	case *Tx_AccountReplaceAccountResourcesMsg:
+		_ = b.EncodeVarint(118<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccountReplaceAccountResourcesMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -1506,6 +1722,102 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // sum.validators_set_validator_profile_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(validators.SetValidatorProfileMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_ValidatorsSetValidatorProfileMsg{msg}
+		return true, err
+	case 107: // sum.currency_update_token_info_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateTokenInfoMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CurrencyUpdateTokenInfoMsg{msg}
+		return true, err
+	case 108: // sum.currency_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CurrencyUpdateConfigurationMsg{msg}
+		return true, err
+	case 110: // sum.migration_downgrade_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.DowngradeSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MigrationDowngradeSchemaMsg{msg}
+		return true, err
+	case 111: // sum.cash_update_wallet_config_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UpdateWalletConfigMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashUpdateWalletConfigMsg{msg}
+		return true, err
+	case 112: // sum.escrow_fund_escrow_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.FundEscrowMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowFundEscrowMsg{msg}
+		return true, err
+	case 113: // sum.sigs_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SigsUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // sum.termdeposit_top_up_deposit_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.TopUpDepositMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_TermdepositTopUpDepositMsg{msg}
+		return true, err
+	case 115: // sum.distribution_claim_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.ClaimMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_DistributionClaimMsg{msg}
+		return true, err
+	case 116: // sum.migration_rename_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.RenameSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MigrationRenameSchemaMsg{msg}
+		return true, err
+	case 117: // sum.termdeposit_sweep_deposits_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.SweepDepositsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_TermdepositSweepDepositsMsg{msg}
+		return true, err
+	case 118: // sum.account_replace_account_resources_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(account.ReplaceAccountResourcesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_AccountReplaceAccountResourcesMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1775,6 +2087,66 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_ValidatorsSetValidatorProfileMsg:
+		s := proto.Size(x.ValidatorsSetValidatorProfileMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CurrencyUpdateTokenInfoMsg:
+		s := proto.Size(x.CurrencyUpdateTokenInfoMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CurrencyUpdateConfigurationMsg:
+		s := proto.Size(x.CurrencyUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MigrationDowngradeSchemaMsg:
+		s := proto.Size(x.MigrationDowngradeSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashUpdateWalletConfigMsg:
+		s := proto.Size(x.CashUpdateWalletConfigMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowFundEscrowMsg:
+		s := proto.Size(x.EscrowFundEscrowMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SigsUpdateConfigurationMsg:
+		s := proto.Size(x.SigsUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_TermdepositTopUpDepositMsg:
+		s := proto.Size(x.TermdepositTopUpDepositMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_DistributionClaimMsg:
+		s := proto.Size(x.DistributionClaimMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MigrationRenameSchemaMsg:
+		s := proto.Size(x.MigrationRenameSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_TermdepositSweepDepositsMsg:
+		s := proto.Size(x.TermdepositSweepDepositsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_AccountReplaceAccountResourcesMsg:
+		s := proto.Size(x.AccountReplaceAccountResourcesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1870,6 +2242,15 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg
+	//	*ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg
+	//	*ExecuteBatchMsg_Union_EscrowFundEscrowMsg
+	//	*ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg
+	//	*ExecuteBatchMsg_Union_DistributionClaimMsg
+	//	*ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg
+	//	*ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2035,6 +2416,33 @@ type ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct {
 type ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg struct {
+	CurrencyUpdateTokenInfoMsg *currency.UpdateTokenInfoMsg `protobuf:"bytes,107,opt,name=currency_update_token_info_msg,json=currencyUpdateTokenInfoMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg struct {
+	CurrencyUpdateConfigurationMsg *currency.UpdateConfigurationMsg `protobuf:"bytes,108,opt,name=currency_update_configuration_msg,json=currencyUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg struct {
+	CashUpdateWalletConfigMsg *cash.UpdateWalletConfigMsg `protobuf:"bytes,111,opt,name=cash_update_wallet_config_msg,json=cashUpdateWalletConfigMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowFundEscrowMsg struct {
+	EscrowFundEscrowMsg *escrow.FundEscrowMsg `protobuf:"bytes,112,opt,name=escrow_fund_escrow_msg,json=escrowFundEscrowMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg struct {
+	SigsUpdateConfigurationMsg *sigs.UpdateConfigurationMsg `protobuf:"bytes,113,opt,name=sigs_update_configuration_msg,json=sigsUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg struct {
+	TermdepositTopUpDepositMsg *termdeposit.TopUpDepositMsg `protobuf:"bytes,114,opt,name=termdeposit_top_up_deposit_msg,json=termdepositTopUpDepositMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_DistributionClaimMsg struct {
+	DistributionClaimMsg *distribution.ClaimMsg `protobuf:"bytes,115,opt,name=distribution_claim_msg,json=distributionClaimMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg struct {
+	TermdepositSweepDepositsMsg *termdeposit.SweepDepositsMsg `protobuf:"bytes,117,opt,name=termdeposit_sweep_deposits_msg,json=termdepositSweepDepositsMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg struct {
+	AccountReplaceAccountResourcesMsg *account.ReplaceAccountResourcesMsg `protobuf:"bytes,118,opt,name=account_replace_account_resources_msg,json=accountReplaceAccountResourcesMsg,proto3,oneof"`
+}
 
 func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                           {}
 func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()                       {}
@@ -2077,6 +2485,15 @@ func (*ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) isExecuteBatchMs
 func (*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()    {}
 func (*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum() {}
 func (*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg) isExecuteBatchMsg_Union_Sum()            {}
+func (*ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()        {}
+func (*ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg) isExecuteBatchMsg_Union_Sum()             {}
+func (*ExecuteBatchMsg_Union_EscrowFundEscrowMsg) isExecuteBatchMsg_Union_Sum()                   {}
+func (*ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()            {}
+func (*ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg) isExecuteBatchMsg_Union_Sum()            {}
+func (*ExecuteBatchMsg_Union_DistributionClaimMsg) isExecuteBatchMsg_Union_Sum()                  {}
+func (*ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg) isExecuteBatchMsg_Union_Sum()           {}
+func (*ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg) isExecuteBatchMsg_Union_Sum()     {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -2372,6 +2789,69 @@ func (m *ExecuteBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfee.Update
 	return nil
 }
 
+func (m *ExecuteBatchMsg_Union) GetCurrencyUpdateTokenInfoMsg() *currency.UpdateTokenInfoMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg); ok {
+		return x.CurrencyUpdateTokenInfoMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCurrencyUpdateConfigurationMsg() *currency.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg); ok {
+		return x.CurrencyUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashUpdateWalletConfigMsg() *cash.UpdateWalletConfigMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg); ok {
+		return x.CashUpdateWalletConfigMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowFundEscrowMsg() *escrow.FundEscrowMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowFundEscrowMsg); ok {
+		return x.EscrowFundEscrowMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetSigsUpdateConfigurationMsg() *sigs.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg); ok {
+		return x.SigsUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetTermdepositTopUpDepositMsg() *termdeposit.TopUpDepositMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg); ok {
+		return x.TermdepositTopUpDepositMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetDistributionClaimMsg() *distribution.ClaimMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_DistributionClaimMsg); ok {
+		return x.DistributionClaimMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetTermdepositSweepDepositsMsg() *termdeposit.SweepDepositsMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg); ok {
+		return x.TermdepositSweepDepositsMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetAccountReplaceAccountResourcesMsg() *account.ReplaceAccountResourcesMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg); ok {
+		return x.AccountReplaceAccountResourcesMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
@@ -2416,6 +2896,15 @@ func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto
 		(*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowFundEscrowMsg)(nil),
+		(*ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg)(nil),
+		(*ExecuteBatchMsg_Union_DistributionClaimMsg)(nil),
+		(*ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg)(nil),
+		(*ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg)(nil),
 	}
 }
 
@@ -2628,6 +3117,51 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateTokenInfoMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUpdateWalletConfigMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowFundEscrowMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowFundEscrowMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositTopUpDepositMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_DistributionClaimMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionClaimMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg:
+		_ = b.EncodeVarint(117<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositSweepDepositsMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg:
+		_ = b.EncodeVarint(118<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccountReplaceAccountResourcesMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -2966,19 +3500,91 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ExecuteBatchMsg_Union)
-	// sum
-	switch x := m.Sum.(type) {
-	case *ExecuteBatchMsg_Union_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
+	case 107: // sum.currency_update_token_info_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateTokenInfoMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg{msg}
+		return true, err
+	case 108: // sum.currency_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg{msg}
+		return true, err
+	case 111: // sum.cash_update_wallet_config_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UpdateWalletConfigMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg{msg}
+		return true, err
+	case 112: // sum.escrow_fund_escrow_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.FundEscrowMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowFundEscrowMsg{msg}
+		return true, err
+	case 113: // sum.sigs_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // sum.termdeposit_top_up_deposit_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.TopUpDepositMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg{msg}
+		return true, err
+	case 115: // sum.distribution_claim_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.ClaimMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_DistributionClaimMsg{msg}
+		return true, err
+	case 117: // sum.termdeposit_sweep_deposits_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.SweepDepositsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg{msg}
+		return true, err
+	case 118: // sum.account_replace_account_resources_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(account.ReplaceAccountResourcesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExecuteBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteBatchMsg_Union_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
 		n += s
 	case *ExecuteBatchMsg_Union_EscrowCreateMsg:
 		s := proto.Size(x.EscrowCreateMsg)
@@ -3180,6 +3786,51 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg:
+		s := proto.Size(x.CurrencyUpdateTokenInfoMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg:
+		s := proto.Size(x.CurrencyUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg:
+		s := proto.Size(x.CashUpdateWalletConfigMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowFundEscrowMsg:
+		s := proto.Size(x.EscrowFundEscrowMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg:
+		s := proto.Size(x.SigsUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg:
+		s := proto.Size(x.TermdepositTopUpDepositMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_DistributionClaimMsg:
+		s := proto.Size(x.DistributionClaimMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg:
+		s := proto.Size(x.TermdepositSweepDepositsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg:
+		s := proto.Size(x.AccountReplaceAccountResourcesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -3234,6 +3885,16 @@ type ProposalOptions struct {
 	//	*ProposalOptions_QualityscoreUpdateConfigurationMsg
 	//	*ProposalOptions_PreregistrationUpdateConfigurationMsg
 	//	*ProposalOptions_MsgfeeUpdateConfigurationMsg
+	//	*ProposalOptions_CurrencyUpdateTokenInfoMsg
+	//	*ProposalOptions_CurrencyUpdateConfigurationMsg
+	//	*ProposalOptions_MigrationDowngradeSchemaMsg
+	//	*ProposalOptions_SigsUpdateConfigurationMsg
+	//	*ProposalOptions_TermdepositTopUpDepositMsg
+	//	*ProposalOptions_DistributionClaimMsg
+	//	*ProposalOptions_MigrationRenameSchemaMsg
+	//	*ProposalOptions_TermdepositSweepDepositsMsg
+	//	*ProposalOptions_AccountReplaceAccountResourcesMsg
+	//	*ProposalOptions_GovCancelProposalExecutionMsg
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -3405,6 +4066,36 @@ type ProposalOptions_PreregistrationUpdateConfigurationMsg struct {
 type ProposalOptions_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ProposalOptions_CurrencyUpdateTokenInfoMsg struct {
+	CurrencyUpdateTokenInfoMsg *currency.UpdateTokenInfoMsg `protobuf:"bytes,107,opt,name=currency_update_token_info_msg,json=currencyUpdateTokenInfoMsg,proto3,oneof"`
+}
+type ProposalOptions_CurrencyUpdateConfigurationMsg struct {
+	CurrencyUpdateConfigurationMsg *currency.UpdateConfigurationMsg `protobuf:"bytes,108,opt,name=currency_update_configuration_msg,json=currencyUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_MigrationDowngradeSchemaMsg struct {
+	MigrationDowngradeSchemaMsg *migration.DowngradeSchemaMsg `protobuf:"bytes,110,opt,name=migration_downgrade_schema_msg,json=migrationDowngradeSchemaMsg,proto3,oneof"`
+}
+type ProposalOptions_SigsUpdateConfigurationMsg struct {
+	SigsUpdateConfigurationMsg *sigs.UpdateConfigurationMsg `protobuf:"bytes,113,opt,name=sigs_update_configuration_msg,json=sigsUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_TermdepositTopUpDepositMsg struct {
+	TermdepositTopUpDepositMsg *termdeposit.TopUpDepositMsg `protobuf:"bytes,114,opt,name=termdeposit_top_up_deposit_msg,json=termdepositTopUpDepositMsg,proto3,oneof"`
+}
+type ProposalOptions_DistributionClaimMsg struct {
+	DistributionClaimMsg *distribution.ClaimMsg `protobuf:"bytes,115,opt,name=distribution_claim_msg,json=distributionClaimMsg,proto3,oneof"`
+}
+type ProposalOptions_MigrationRenameSchemaMsg struct {
+	MigrationRenameSchemaMsg *migration.RenameSchemaMsg `protobuf:"bytes,116,opt,name=migration_rename_schema_msg,json=migrationRenameSchemaMsg,proto3,oneof"`
+}
+type ProposalOptions_TermdepositSweepDepositsMsg struct {
+	TermdepositSweepDepositsMsg *termdeposit.SweepDepositsMsg `protobuf:"bytes,117,opt,name=termdeposit_sweep_deposits_msg,json=termdepositSweepDepositsMsg,proto3,oneof"`
+}
+type ProposalOptions_AccountReplaceAccountResourcesMsg struct {
+	AccountReplaceAccountResourcesMsg *account.ReplaceAccountResourcesMsg `protobuf:"bytes,118,opt,name=account_replace_account_resources_msg,json=accountReplaceAccountResourcesMsg,proto3,oneof"`
+}
+type ProposalOptions_GovCancelProposalExecutionMsg struct {
+	GovCancelProposalExecutionMsg *gov.CancelProposalExecutionMsg `protobuf:"bytes,119,opt,name=gov_cancel_proposal_execution_msg,json=govCancelProposalExecutionMsg,proto3,oneof"`
+}
 
 func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                           {}
 func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()                      {}
@@ -3449,6 +4140,16 @@ func (*ProposalOptions_TermdepositUpdateConfigurationMsg) isProposalOptions_Opti
 func (*ProposalOptions_QualityscoreUpdateConfigurationMsg) isProposalOptions_Option()    {}
 func (*ProposalOptions_PreregistrationUpdateConfigurationMsg) isProposalOptions_Option() {}
 func (*ProposalOptions_MsgfeeUpdateConfigurationMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_CurrencyUpdateTokenInfoMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_CurrencyUpdateConfigurationMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_MigrationDowngradeSchemaMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_SigsUpdateConfigurationMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_TermdepositTopUpDepositMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_DistributionClaimMsg) isProposalOptions_Option()                  {}
+func (*ProposalOptions_MigrationRenameSchemaMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_TermdepositSweepDepositsMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_AccountReplaceAccountResourcesMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_GovCancelProposalExecutionMsg) isProposalOptions_Option()         {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -3758,6 +4459,76 @@ func (m *ProposalOptions) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfig
 	return nil
 }
 
+func (m *ProposalOptions) GetCurrencyUpdateTokenInfoMsg() *currency.UpdateTokenInfoMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CurrencyUpdateTokenInfoMsg); ok {
+		return x.CurrencyUpdateTokenInfoMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCurrencyUpdateConfigurationMsg() *currency.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CurrencyUpdateConfigurationMsg); ok {
+		return x.CurrencyUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMigrationDowngradeSchemaMsg() *migration.DowngradeSchemaMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MigrationDowngradeSchemaMsg); ok {
+		return x.MigrationDowngradeSchemaMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetSigsUpdateConfigurationMsg() *sigs.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_SigsUpdateConfigurationMsg); ok {
+		return x.SigsUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetTermdepositTopUpDepositMsg() *termdeposit.TopUpDepositMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_TermdepositTopUpDepositMsg); ok {
+		return x.TermdepositTopUpDepositMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetDistributionClaimMsg() *distribution.ClaimMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_DistributionClaimMsg); ok {
+		return x.DistributionClaimMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMigrationRenameSchemaMsg() *migration.RenameSchemaMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MigrationRenameSchemaMsg); ok {
+		return x.MigrationRenameSchemaMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetTermdepositSweepDepositsMsg() *termdeposit.SweepDepositsMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_TermdepositSweepDepositsMsg); ok {
+		return x.TermdepositSweepDepositsMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetAccountReplaceAccountResourcesMsg() *account.ReplaceAccountResourcesMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_AccountReplaceAccountResourcesMsg); ok {
+		return x.AccountReplaceAccountResourcesMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovCancelProposalExecutionMsg() *gov.CancelProposalExecutionMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovCancelProposalExecutionMsg); ok {
+		return x.GovCancelProposalExecutionMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
@@ -3804,6 +4575,16 @@ func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ProposalOptions_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_CurrencyUpdateTokenInfoMsg)(nil),
+		(*ProposalOptions_CurrencyUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_MigrationDowngradeSchemaMsg)(nil),
+		(*ProposalOptions_SigsUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_TermdepositTopUpDepositMsg)(nil),
+		(*ProposalOptions_DistributionClaimMsg)(nil),
+		(*ProposalOptions_MigrationRenameSchemaMsg)(nil),
+		(*ProposalOptions_TermdepositSweepDepositsMsg)(nil),
+		(*ProposalOptions_AccountReplaceAccountResourcesMsg)(nil),
+		(*ProposalOptions_GovCancelProposalExecutionMsg)(nil),
 	}
 }
 
@@ -4026,6 +4807,56 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ProposalOptions_CurrencyUpdateTokenInfoMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateTokenInfoMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CurrencyUpdateConfigurationMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MigrationDowngradeSchemaMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationDowngradeSchemaMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_SigsUpdateConfigurationMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_TermdepositTopUpDepositMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositTopUpDepositMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_DistributionClaimMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionClaimMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MigrationRenameSchemaMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationRenameSchemaMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_TermdepositSweepDepositsMsg:
+		_ = b.EncodeVarint(117<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositSweepDepositsMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_AccountReplaceAccountResourcesMsg:
+		_ = b.EncodeVarint(118<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccountReplaceAccountResourcesMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovCancelProposalExecutionMsg:
+		_ = b.EncodeVarint(119<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCancelProposalExecutionMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -4380,6 +5211,86 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 107: // option.currency_update_token_info_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateTokenInfoMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CurrencyUpdateTokenInfoMsg{msg}
+		return true, err
+	case 108: // option.currency_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CurrencyUpdateConfigurationMsg{msg}
+		return true, err
+	case 110: // option.migration_downgrade_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.DowngradeSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MigrationDowngradeSchemaMsg{msg}
+		return true, err
+	case 113: // option.sigs_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_SigsUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // option.termdeposit_top_up_deposit_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.TopUpDepositMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_TermdepositTopUpDepositMsg{msg}
+		return true, err
+	case 115: // option.distribution_claim_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.ClaimMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_DistributionClaimMsg{msg}
+		return true, err
+	case 116: // option.migration_rename_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.RenameSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MigrationRenameSchemaMsg{msg}
+		return true, err
+	case 117: // option.termdeposit_sweep_deposits_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.SweepDepositsMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_TermdepositSweepDepositsMsg{msg}
+		return true, err
+	case 118: // option.account_replace_account_resources_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(account.ReplaceAccountResourcesMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_AccountReplaceAccountResourcesMsg{msg}
+		return true, err
+	case 119: // option.gov_cancel_proposal_execution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CancelProposalExecutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovCancelProposalExecutionMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -4604,6 +5515,56 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ProposalOptions_CurrencyUpdateTokenInfoMsg:
+		s := proto.Size(x.CurrencyUpdateTokenInfoMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CurrencyUpdateConfigurationMsg:
+		s := proto.Size(x.CurrencyUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MigrationDowngradeSchemaMsg:
+		s := proto.Size(x.MigrationDowngradeSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_SigsUpdateConfigurationMsg:
+		s := proto.Size(x.SigsUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_TermdepositTopUpDepositMsg:
+		s := proto.Size(x.TermdepositTopUpDepositMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_DistributionClaimMsg:
+		s := proto.Size(x.DistributionClaimMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MigrationRenameSchemaMsg:
+		s := proto.Size(x.MigrationRenameSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_TermdepositSweepDepositsMsg:
+		s := proto.Size(x.TermdepositSweepDepositsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_AccountReplaceAccountResourcesMsg:
+		s := proto.Size(x.AccountReplaceAccountResourcesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovCancelProposalExecutionMsg:
+		s := proto.Size(x.GovCancelProposalExecutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -4697,6 +5658,14 @@ type ExecuteProposalBatchMsg_Union struct {
 	//	*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg
+	//	*ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg
+	//	*ExecuteProposalBatchMsg_Union_DistributionClaimMsg
+	//	*ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg
+	//	*ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg
+	//	*ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg
 	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -4859,6 +5828,30 @@ type ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct
 type ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg struct {
+	CurrencyUpdateTokenInfoMsg *currency.UpdateTokenInfoMsg `protobuf:"bytes,107,opt,name=currency_update_token_info_msg,json=currencyUpdateTokenInfoMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg struct {
+	CurrencyUpdateConfigurationMsg *currency.UpdateConfigurationMsg `protobuf:"bytes,108,opt,name=currency_update_configuration_msg,json=currencyUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg struct {
+	SigsUpdateConfigurationMsg *sigs.UpdateConfigurationMsg `protobuf:"bytes,113,opt,name=sigs_update_configuration_msg,json=sigsUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg struct {
+	TermdepositTopUpDepositMsg *termdeposit.TopUpDepositMsg `protobuf:"bytes,114,opt,name=termdeposit_top_up_deposit_msg,json=termdepositTopUpDepositMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_DistributionClaimMsg struct {
+	DistributionClaimMsg *distribution.ClaimMsg `protobuf:"bytes,115,opt,name=distribution_claim_msg,json=distributionClaimMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg struct {
+	TermdepositSweepDepositsMsg *termdeposit.SweepDepositsMsg `protobuf:"bytes,117,opt,name=termdeposit_sweep_deposits_msg,json=termdepositSweepDepositsMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg struct {
+	AccountReplaceAccountResourcesMsg *account.ReplaceAccountResourcesMsg `protobuf:"bytes,118,opt,name=account_replace_account_resources_msg,json=accountReplaceAccountResourcesMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg struct {
+	GovCancelProposalExecutionMsg *gov.CancelProposalExecutionMsg `protobuf:"bytes,119,opt,name=gov_cancel_proposal_execution_msg,json=govCancelProposalExecutionMsg,proto3,oneof"`
+}
 
 func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
 func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
@@ -4919,6 +5912,21 @@ func (*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isEx
 }
 func (*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
+func (*ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_DistributionClaimMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 
 func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
 	if m != nil {
@@ -5207,12 +6215,68 @@ func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfe
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
-		(*ExecuteProposalBatchMsg_Union_SendMsg)(nil),
-		(*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg)(nil),
-		(*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg)(nil),
+func (m *ExecuteProposalBatchMsg_Union) GetCurrencyUpdateTokenInfoMsg() *currency.UpdateTokenInfoMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg); ok {
+		return x.CurrencyUpdateTokenInfoMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCurrencyUpdateConfigurationMsg() *currency.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg); ok {
+		return x.CurrencyUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetSigsUpdateConfigurationMsg() *sigs.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg); ok {
+		return x.SigsUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetTermdepositTopUpDepositMsg() *termdeposit.TopUpDepositMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg); ok {
+		return x.TermdepositTopUpDepositMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetDistributionClaimMsg() *distribution.ClaimMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_DistributionClaimMsg); ok {
+		return x.DistributionClaimMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetTermdepositSweepDepositsMsg() *termdeposit.SweepDepositsMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg); ok {
+		return x.TermdepositSweepDepositsMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetAccountReplaceAccountResourcesMsg() *account.ReplaceAccountResourcesMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg); ok {
+		return x.AccountReplaceAccountResourcesMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetGovCancelProposalExecutionMsg() *gov.CancelProposalExecutionMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg); ok {
+		return x.GovCancelProposalExecutionMsg
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
+		(*ExecuteProposalBatchMsg_Union_SendMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg)(nil),
@@ -5250,6 +6314,14 @@ func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message,
 		(*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_DistributionClaimMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg)(nil),
 	}
 }
 
@@ -5457,6 +6529,46 @@ func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.B
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateTokenInfoMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CurrencyUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositTopUpDepositMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_DistributionClaimMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionClaimMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg:
+		_ = b.EncodeVarint(117<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TermdepositSweepDepositsMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg:
+		_ = b.EncodeVarint(118<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccountReplaceAccountResourcesMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg:
+		_ = b.EncodeVarint(119<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCancelProposalExecutionMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
@@ -5787,6 +6899,70 @@ func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wir
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 107: // sum.currency_update_token_info_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateTokenInfoMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg{msg}
+		return true, err
+	case 108: // sum.currency_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(currency.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg{msg}
+		return true, err
+	case 113: // sum.sigs_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // sum.termdeposit_top_up_deposit_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.TopUpDepositMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg{msg}
+		return true, err
+	case 115: // sum.distribution_claim_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.ClaimMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_DistributionClaimMsg{msg}
+		return true, err
+	case 117: // sum.termdeposit_sweep_deposits_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(termdeposit.SweepDepositsMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg{msg}
+		return true, err
+	case 118: // sum.account_replace_account_resources_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(account.ReplaceAccountResourcesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg{msg}
+		return true, err
+	case 119: // sum.gov_cancel_proposal_execution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CancelProposalExecutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -5996,6 +7172,46 @@ func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg:
+		s := proto.Size(x.CurrencyUpdateTokenInfoMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg:
+		s := proto.Size(x.CurrencyUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg:
+		s := proto.Size(x.SigsUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg:
+		s := proto.Size(x.TermdepositTopUpDepositMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_DistributionClaimMsg:
+		s := proto.Size(x.DistributionClaimMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg:
+		s := proto.Size(x.TermdepositSweepDepositsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg:
+		s := proto.Size(x.AccountReplaceAccountResourcesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg:
+		s := proto.Size(x.GovCancelProposalExecutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -6024,7 +7240,9 @@ type CronTask struct {
 	//	*CronTask_EscrowReturnMsg
 	//	*CronTask_DistributionDistributeMsg
 	//	*CronTask_AswapReleaseMsg
+	//	*CronTask_AswapReturnMsg
 	//	*CronTask_GovTallyMsg
+	//	*CronTask_GovExecuteProposalMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
 
@@ -6079,15 +7297,23 @@ type CronTask_DistributionDistributeMsg struct {
 type CronTask_AswapReleaseMsg struct {
 	AswapReleaseMsg *aswap.ReleaseMsg `protobuf:"bytes,71,opt,name=aswap_release_msg,json=aswapReleaseMsg,proto3,oneof"`
 }
+type CronTask_AswapReturnMsg struct {
+	AswapReturnMsg *aswap.ReturnMsg `protobuf:"bytes,72,opt,name=aswap_return_msg,json=aswapReturnMsg,proto3,oneof"`
+}
 type CronTask_GovTallyMsg struct {
 	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
 }
+type CronTask_GovExecuteProposalMsg struct {
+	GovExecuteProposalMsg *gov.ExecuteProposalMsg `protobuf:"bytes,109,opt,name=gov_execute_proposal_msg,json=govExecuteProposalMsg,proto3,oneof"`
+}
 
 func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()          {}
 func (*CronTask_EscrowReturnMsg) isCronTask_Sum()           {}
 func (*CronTask_DistributionDistributeMsg) isCronTask_Sum() {}
 func (*CronTask_AswapReleaseMsg) isCronTask_Sum()           {}
+func (*CronTask_AswapReturnMsg) isCronTask_Sum()            {}
 func (*CronTask_GovTallyMsg) isCronTask_Sum()               {}
+func (*CronTask_GovExecuteProposalMsg) isCronTask_Sum()     {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
 	if m != nil {
@@ -6131,6 +7357,13 @@ func (m *CronTask) GetAswapReleaseMsg() *aswap.ReleaseMsg {
 	return nil
 }
 
+func (m *CronTask) GetAswapReturnMsg() *aswap.ReturnMsg {
+	if x, ok := m.GetSum().(*CronTask_AswapReturnMsg); ok {
+		return x.AswapReturnMsg
+	}
+	return nil
+}
+
 func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	if x, ok := m.GetSum().(*CronTask_GovTallyMsg); ok {
 		return x.GovTallyMsg
@@ -6138,6 +7371,13 @@ func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	return nil
 }
 
+func (m *CronTask) GetGovExecuteProposalMsg() *gov.ExecuteProposalMsg {
+	if x, ok := m.GetSum().(*CronTask_GovExecuteProposalMsg); ok {
+		return x.GovExecuteProposalMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
@@ -6145,7 +7385,9 @@ func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*CronTask_EscrowReturnMsg)(nil),
 		(*CronTask_DistributionDistributeMsg)(nil),
 		(*CronTask_AswapReleaseMsg)(nil),
+		(*CronTask_AswapReturnMsg)(nil),
 		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_GovExecuteProposalMsg)(nil),
 	}
 }
 
@@ -6173,11 +7415,21 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.AswapReleaseMsg); err != nil {
 			return err
 		}
+	case *CronTask_AswapReturnMsg:
+		_ = b.EncodeVarint(72<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AswapReturnMsg); err != nil {
+			return err
+		}
 	case *CronTask_GovTallyMsg:
 		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
 			return err
 		}
+	case *CronTask_GovExecuteProposalMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovExecuteProposalMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
@@ -6220,6 +7472,14 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_AswapReleaseMsg{msg}
 		return true, err
+	case 72: // sum.aswap_return_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(aswap.ReturnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_AswapReturnMsg{msg}
+		return true, err
 	case 76: // sum.gov_tally_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -6228,6 +7488,14 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_GovTallyMsg{msg}
 		return true, err
+	case 109: // sum.gov_execute_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.ExecuteProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_GovExecuteProposalMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -6257,11 +7525,21 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_AswapReturnMsg:
+		s := proto.Size(x.AswapReturnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *CronTask_GovTallyMsg:
 		s := proto.Size(x.GovTallyMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_GovExecuteProposalMsg:
+		s := proto.Size(x.GovExecuteProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -6282,137 +7560,163 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 2079 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdb, 0x72, 0x1c, 0x47,
-	0x19, 0x96, 0x22, 0x27, 0xa8, 0xda, 0x47, 0xb5, 0x6d, 0x69, 0xb5, 0x92, 0x56, 0xb2, 0x64, 0x3b,
-	0x2e, 0xaa, 0x98, 0xa5, 0x6c, 0xce, 0x24, 0x18, 0x6b, 0x25, 0xe3, 0x04, 0x7c, 0xc8, 0x4a, 0x0a,
-	0x01, 0x3b, 0xd9, 0xb4, 0x66, 0x7a, 0x47, 0x83, 0x77, 0xa7, 0x37, 0x73, 0x58, 0xad, 0xa8, 0xe2,
-	0x86, 0xe2, 0x01, 0x78, 0x05, 0x9e, 0x03, 0x1e, 0x20, 0x97, 0xb9, 0xe4, 0xca, 0x45, 0xd9, 0xaf,
-	0xc0, 0x15, 0x57, 0x54, 0x9f, 0x66, 0xfe, 0xee, 0x9d, 0xb1, 0x81, 0x50, 0x65, 0x48, 0xfa, 0xca,
-	0x3b, 0xfd, 0x7d, 0xf3, 0x7d, 0x7d, 0xfc, 0xa7, 0xff, 0x9e, 0xb1, 0x50, 0xc3, 0x1f, 0x06, 0xed,
-	0xc3, 0x38, 0x0d, 0xda, 0x64, 0x34, 0x6a, 0xfb, 0x2c, 0xa0, 0xbe, 0x37, 0x4a, 0x58, 0xc6, 0xf0,
-	0x29, 0x5e, 0xda, 0x6c, 0x15, 0xf8, 0xa4, 0x4d, 0x7c, 0x9f, 0xe5, 0x71, 0x06, 0x59, 0xcd, 0xeb,
-	0x00, 0x1f, 0x25, 0x34, 0xa1, 0x61, 0x94, 0x66, 0x09, 0xc9, 0x22, 0x16, 0x1b, 0xbc, 0x2d, 0xc0,
-	0xfb, 0x2c, 0x27, 0x83, 0x28, 0x3b, 0x49, 0x7d, 0x96, 0x50, 0x83, 0xb4, 0x09, 0x48, 0x19, 0x4d,
-	0x86, 0x01, 0x1d, 0xb1, 0x34, 0x32, 0x0d, 0xd7, 0x01, 0x27, 0x4f, 0x69, 0x12, 0x93, 0xa1, 0x29,
-	0xb2, 0x1c, 0x90, 0x8c, 0x0c, 0xa3, 0xb0, 0xa2, 0x12, 0x97, 0x42, 0x16, 0x32, 0xf1, 0xb3, 0xcd,
-	0x7f, 0xa9, 0xd2, 0xcb, 0xd5, 0xe4, 0x8b, 0x93, 0x36, 0x49, 0x8f, 0x89, 0xd1, 0x29, 0x4d, 0x3c,
-	0x69, 0xfb, 0x24, 0x3d, 0x32, 0xca, 0x16, 0x27, 0x6d, 0x3f, 0x4f, 0x12, 0x1a, 0xfb, 0x27, 0x46,
-	0x79, 0x73, 0xd2, 0x0e, 0x78, 0x67, 0x44, 0x87, 0xf9, 0x74, 0x4d, 0x26, 0x6d, 0x9a, 0xfa, 0x09,
-	0x3b, 0x36, 0x4a, 0x17, 0x26, 0xed, 0x90, 0x8d, 0x6d, 0xe2, 0x30, 0x0d, 0xfb, 0x94, 0xda, 0x96,
-	0xc3, 0x7c, 0x90, 0x45, 0x69, 0x14, 0xda, 0xd5, 0x4b, 0xa3, 0x30, 0xb5, 0xdb, 0x91, 0x4d, 0x6c,
-	0x81, 0xc6, 0xa4, 0x3d, 0x26, 0x83, 0x28, 0x20, 0x19, 0x4b, 0x0c, 0xfa, 0xe6, 0xb3, 0x6b, 0xe8,
-	0x8d, 0xfd, 0x09, 0xbe, 0x82, 0x4e, 0xf5, 0x29, 0x4d, 0x1b, 0xb3, 0x1b, 0xb3, 0x37, 0x4e, 0xdf,
-	0x3c, 0xeb, 0xf1, 0x56, 0x7b, 0x77, 0x29, 0x7d, 0x2f, 0xee, 0xb3, 0xae, 0x80, 0xf0, 0x4d, 0x84,
-	0xd2, 0x28, 0x8c, 0x49, 0x96, 0x27, 0x34, 0x6d, 0xbc, 0xb1, 0x31, 0x77, 0xe3, 0xf4, 0x4d, 0xec,
-	0x71, 0x7f, 0x6f, 0x2f, 0x0b, 0xf6, 0x34, 0xd4, 0x05, 0x2c, 0xdc, 0x44, 0xf3, 0xba, 0xe2, 0x8d,
-	0x53, 0x1b, 0x73, 0x37, 0xce, 0x74, 0x8b, 0x6b, 0x7c, 0x0b, 0x9d, 0xe5, 0x2e, 0xbd, 0x94, 0xc6,
-	0x41, 0x6f, 0x98, 0x86, 0x8d, 0x5b, 0xd0, 0x7b, 0x8f, 0xc6, 0xc1, 0xfd, 0x34, 0xbc, 0x37, 0xd3,
-	0x3d, 0xcd, 0xaf, 0xd5, 0x25, 0xbe, 0x8d, 0x16, 0x64, 0x47, 0xf6, 0xfc, 0x84, 0x92, 0x8c, 0x8a,
-	0x1b, 0xbf, 0x23, 0x6e, 0x5c, 0xf0, 0x24, 0xe2, 0x75, 0x04, 0x22, 0x6f, 0x3e, 0x2f, 0xcb, 0x8a,
-	0x22, 0xbc, 0x8d, 0xb0, 0x12, 0x48, 0xe8, 0x80, 0x92, 0x54, 0x2a, 0x7c, 0x57, 0x28, 0x60, 0xad,
-	0xd0, 0x95, 0x90, 0x94, 0xb8, 0x20, 0x0b, 0xcb, 0x32, 0x50, 0x89, 0x84, 0x66, 0x79, 0x12, 0x0b,
-	0x89, 0xef, 0x99, 0x95, 0xe8, 0x0a, 0xc4, 0xa8, 0x44, 0x51, 0x84, 0x0f, 0xd0, 0xb2, 0x12, 0xc8,
-	0x47, 0x01, 0x6f, 0xc5, 0x88, 0x24, 0x59, 0x44, 0x53, 0x21, 0xf4, 0x7d, 0x21, 0xd4, 0xd0, 0x42,
-	0x07, 0x82, 0xf1, 0x48, 0x12, 0xa4, 0xde, 0xa2, 0x84, 0x6c, 0x04, 0xef, 0xa2, 0x8b, 0xba, 0x77,
-	0x61, 0xf7, 0xfc, 0x40, 0x08, 0x5e, 0xf4, 0x34, 0x66, 0x74, 0xd0, 0x82, 0x2e, 0x2d, 0xbb, 0x08,
-	0xca, 0xa8, 0xfa, 0x71, 0x99, 0x1f, 0xda, 0x32, 0xd2, 0xdf, 0x92, 0x29, 0x0a, 0x79, 0x23, 0xcb,
-	0x39, 0xd7, 0x23, 0xa3, 0xd1, 0xe0, 0xa4, 0x17, 0x44, 0xfd, 0xbe, 0x10, 0xfb, 0x91, 0x6a, 0x64,
-	0xc9, 0xf0, 0xee, 0x70, 0xc6, 0x4e, 0xd4, 0xef, 0xab, 0x46, 0x96, 0x10, 0x44, 0x78, 0xed, 0xf4,
-	0xf2, 0x83, 0x8d, 0xfc, 0xb1, 0xaa, 0x9d, 0xc6, 0xcc, 0x46, 0xea, 0xd2, 0xb2, 0x91, 0x1d, 0xb4,
-	0x40, 0x27, 0xd4, 0xcf, 0x33, 0xda, 0x3b, 0x24, 0x99, 0x7f, 0x24, 0x44, 0xde, 0x11, 0x22, 0x97,
-	0x3d, 0x1e, 0x6f, 0xbc, 0x5d, 0x09, 0x6f, 0x73, 0x54, 0x8f, 0xa3, 0x59, 0x84, 0x1f, 0xa3, 0x15,
-	0x1d, 0x93, 0x7a, 0x32, 0x14, 0xd2, 0xa4, 0x97, 0xb1, 0xa7, 0x54, 0x4e, 0x89, 0x77, 0x85, 0x5c,
-	0xd3, 0xd3, 0x1c, 0xaf, 0xab, 0x38, 0xfb, 0x9c, 0x22, 0x35, 0x1b, 0x1a, 0xb4, 0x31, 0x43, 0x3c,
-	0x4b, 0x48, 0x9c, 0xf6, 0x0d, 0xf1, 0x9f, 0xd8, 0xe2, 0xfb, 0x8a, 0x53, 0x25, 0x6e, 0x63, 0xf8,
-	0x29, 0xba, 0x52, 0x88, 0xfb, 0x47, 0x24, 0x0e, 0xa9, 0x92, 0xce, 0x48, 0x12, 0xd2, 0x4c, 0xce,
-	0xc4, 0xdb, 0xc2, 0x62, 0xbd, 0xb4, 0xe8, 0x08, 0xa6, 0x10, 0xd9, 0x97, 0x3c, 0xe9, 0xb3, 0xa6,
-	0x19, 0x95, 0x04, 0x3c, 0x04, 0x66, 0x6a, 0x42, 0xf9, 0x2c, 0xee, 0x47, 0x61, 0x2e, 0xe3, 0xb0,
-	0x30, 0xfb, 0xa9, 0x30, 0xdb, 0x28, 0xcd, 0xe4, 0x4c, 0xea, 0x40, 0xa2, 0x74, 0x6b, 0x69, 0x4a,
-	0x35, 0x03, 0x7f, 0x80, 0x96, 0x60, 0x20, 0x86, 0xb3, 0x64, 0x5b, 0x98, 0x2c, 0x79, 0x10, 0x37,
-	0x66, 0xca, 0x65, 0x88, 0x94, 0xb3, 0xe5, 0x1e, 0xba, 0x60, 0x48, 0x72, 0xad, 0x8e, 0xd0, 0x5a,
-	0x31, 0xb5, 0x76, 0xf4, 0x85, 0x8e, 0x3f, 0x10, 0xe5, 0x4a, 0x0f, 0xd0, 0xa2, 0xa1, 0x94, 0xd0,
-	0x94, 0x66, 0x42, 0x6f, 0x47, 0xe8, 0x2d, 0x9a, 0x7a, 0x5d, 0x0e, 0x4b, 0xa9, 0x4b, 0x10, 0xd0,
-	0xe5, 0xf8, 0x13, 0xb4, 0x5a, 0x3c, 0xcf, 0x7a, 0xf9, 0x28, 0x4c, 0x48, 0x40, 0x7b, 0xa9, 0x7f,
-	0x44, 0x87, 0x44, 0xa8, 0xee, 0xaa, 0x5a, 0x16, 0x24, 0xef, 0x40, 0x92, 0xf6, 0x04, 0x47, 0x4a,
-	0x2f, 0x17, 0xa8, 0x0d, 0xe2, 0x77, 0xd0, 0x05, 0xf1, 0x58, 0x84, 0xbd, 0x78, 0x57, 0x68, 0x5e,
-	0xf0, 0x04, 0x60, 0x74, 0xdf, 0x39, 0x51, 0x54, 0xf6, 0xdb, 0x6d, 0xb4, 0x20, 0xef, 0x86, 0xc1,
-	0xf6, 0x67, 0x2a, 0x52, 0xca, 0xdb, 0x8d, 0x58, 0x7b, 0x5e, 0x94, 0x81, 0x50, 0x5b, 0xd8, 0x83,
-	0x48, 0x7b, 0xcf, 0xb0, 0x87, 0x81, 0xf6, 0x9c, 0xba, 0x5d, 0xc7, 0xd9, 0x87, 0x68, 0x29, 0x64,
-	0x63, 0x5d, 0xf5, 0x51, 0xc2, 0x46, 0x2c, 0x25, 0x03, 0x21, 0xf2, 0x9e, 0xea, 0xed, 0x90, 0x8d,
-	0x55, 0x0b, 0x1e, 0x29, 0x58, 0xf5, 0x76, 0xc8, 0xc6, 0x53, 0xe5, 0x5a, 0x30, 0xa0, 0x03, 0x6a,
-	0x0b, 0xbe, 0x0f, 0x04, 0x77, 0x04, 0x3e, 0x2d, 0x38, 0x55, 0x8e, 0xbf, 0x8d, 0xce, 0x70, 0xc1,
-	0x31, 0x53, 0x5d, 0xfb, 0x73, 0xa1, 0x72, 0x46, 0xa8, 0x7c, 0xc8, 0x74, 0xb7, 0xa2, 0x90, 0x8d,
-	0xd5, 0x15, 0x0f, 0xab, 0xfc, 0x0e, 0xb5, 0x8e, 0xe8, 0x80, 0xfa, 0x19, 0x4b, 0xf4, 0xc8, 0xdc,
-	0x57, 0x61, 0x95, 0xdf, 0x2e, 0x57, 0xc7, 0x6e, 0x41, 0x50, 0x61, 0x35, 0x64, 0xe3, 0x0a, 0x04,
-	0x3f, 0x41, 0xab, 0xb6, 0xac, 0x98, 0x9e, 0xf9, 0x40, 0x2a, 0x3f, 0x50, 0xe1, 0xc6, 0x52, 0xe6,
-	0x53, 0x31, 0x1f, 0x28, 0xed, 0x86, 0xa9, 0x5d, 0x62, 0xf8, 0x7d, 0xb4, 0x28, 0xb7, 0x35, 0x3d,
-	0x35, 0xdb, 0x7b, 0xfc, 0x37, 0xd7, 0x7d, 0x24, 0x74, 0x2f, 0x79, 0x12, 0xf6, 0xf6, 0xc4, 0xac,
-	0xbe, 0x4b, 0x95, 0x22, 0x96, 0xc5, 0xb0, 0x14, 0xa7, 0x68, 0xcb, 0xd8, 0xf2, 0xf5, 0x74, 0x1c,
-	0x2f, 0x4b, 0xb8, 0xf0, 0x07, 0x42, 0x78, 0xd3, 0x33, 0xb8, 0x3a, 0xa8, 0xdf, 0xd7, 0x05, 0xd2,
-	0x66, 0xc3, 0x20, 0x55, 0x70, 0xf0, 0x6f, 0xd0, 0x86, 0xda, 0x0e, 0xd7, 0x47, 0xb0, 0xae, 0x0a,
-	0x97, 0x8a, 0x58, 0x1f, 0xc0, 0xd6, 0x14, 0xa3, 0x26, 0x7e, 0x3d, 0x46, 0x2b, 0xda, 0xab, 0x78,
-	0xa8, 0x04, 0x6c, 0x48, 0x22, 0x69, 0xb3, 0xa7, 0x46, 0x42, 0xdb, 0xe8, 0x07, 0xc7, 0x8e, 0xa0,
-	0xa8, 0x91, 0x50, 0xe0, 0x14, 0x86, 0x13, 0x74, 0xb5, 0x14, 0x1f, 0x0d, 0x88, 0x4f, 0x7b, 0xfa,
-	0x5a, 0x0d, 0x8b, 0x8c, 0xfd, 0xfb, 0xc2, 0xe5, 0x0a, 0x70, 0x11, 0xe4, 0x3b, 0xf2, 0x52, 0x8e,
-	0x86, 0x8a, 0xfe, 0xeb, 0x85, 0x59, 0x35, 0x05, 0x36, 0xa8, 0x78, 0x90, 0x81, 0x06, 0x1d, 0x58,
-	0x0d, 0xd2, 0x0f, 0xab, 0xaa, 0x06, 0x4d, 0x61, 0xb8, 0x8b, 0x1a, 0x65, 0x83, 0x62, 0x7a, 0x0c,
-	0x95, 0x3f, 0x54, 0xe1, 0xbe, 0x6c, 0x44, 0x4c, 0x8f, 0xa1, 0xec, 0xe5, 0xa2, 0xea, 0x10, 0xe0,
-	0x6b, 0x4c, 0x6b, 0xaa, 0xa5, 0x0e, 0x44, 0x7f, 0xa9, 0xd6, 0x98, 0x16, 0x95, 0x8b, 0x1a, 0xaa,
-	0x2e, 0x2a, 0xc8, 0x42, 0x78, 0xac, 0x9e, 0x1a, 0x58, 0xd0, 0xf9, 0x8d, 0x8f, 0x54, 0xac, 0xb6,
-	0x47, 0xb6, 0xec, 0x51, 0x1e, 0xab, 0xad, 0xa1, 0x2d, 0x41, 0xa8, 0x5f, 0xf4, 0x33, 0xd4, 0xff,
-	0x95, 0xa5, 0xaf, 0x3b, 0xb3, 0x52, 0x7f, 0x1a, 0xc4, 0x9f, 0xa1, 0xad, 0xba, 0xb9, 0x03, 0xb7,
-	0x0d, 0xbf, 0x7e, 0xe9, 0xd4, 0x31, 0x36, 0x0e, 0xd5, 0x53, 0x07, 0x6c, 0x1d, 0x3e, 0x42, 0x4d,
-	0x6b, 0x24, 0x60, 0x83, 0x1e, 0x0b, 0xa7, 0x65, 0x6b, 0x28, 0x8c, 0xe6, 0x2c, 0x19, 0x63, 0x01,
-	0x1a, 0x03, 0xe6, 0x4d, 0x7f, 0x90, 0xa7, 0x47, 0x70, 0x88, 0x9f, 0x58, 0xf3, 0xe6, 0x2e, 0x27,
-	0x54, 0xcd, 0x1b, 0x13, 0x80, 0xf3, 0x46, 0xce, 0x45, 0x58, 0xd9, 0x8f, 0xad, 0x79, 0x23, 0xe6,
-	0x9c, 0x51, 0xd7, 0x45, 0x38, 0x1b, 0xab, 0xfb, 0x9d, 0x04, 0x41, 0x21, 0xea, 0xd3, 0x24, 0x8b,
-	0xfa, 0x91, 0xaf, 0x83, 0xff, 0x27, 0x56, 0xbf, 0xdf, 0x09, 0x02, 0x25, 0xd2, 0x29, 0x99, 0x66,
-	0xbf, 0xd7, 0x51, 0xf0, 0x6f, 0xd1, 0xf5, 0x9a, 0x7e, 0xb7, 0x5d, 0x7b, 0xc2, 0xf5, 0x6a, 0xf5,
-	0x18, 0x4c, 0x19, 0x6f, 0x56, 0x0d, 0x87, 0xe5, 0xfd, 0x29, 0x5a, 0xb5, 0x8e, 0x16, 0xca, 0xe5,
-	0xc2, 0x1d, 0x3f, 0x15, 0x8e, 0xab, 0x9e, 0x45, 0x2a, 0x96, 0x8b, 0x74, 0x6a, 0x5a, 0x30, 0x40,
-	0x31, 0x41, 0x6b, 0x22, 0xf5, 0xac, 0x0d, 0xe5, 0x44, 0x59, 0x88, 0x54, 0xb4, 0x36, 0x8e, 0x37,
-	0x39, 0x5c, 0x13, 0xc4, 0x03, 0xd4, 0x12, 0x69, 0x78, 0xbd, 0xc7, 0xa1, 0xf0, 0x58, 0xf3, 0x04,
-	0xad, 0xde, 0x64, 0x45, 0xe0, 0x35, 0x2e, 0xbf, 0x43, 0x6f, 0x83, 0x83, 0x13, 0xbd, 0xd1, 0x29,
-	0x2e, 0x59, 0x9c, 0x25, 0xc4, 0x97, 0xd3, 0xcf, 0x17, 0x76, 0xd7, 0x3c, 0xc0, 0x57, 0x1b, 0x9f,
-	0x1d, 0x79, 0xd5, 0x51, 0x6c, 0x69, 0xbb, 0x05, 0x78, 0x75, 0x34, 0xbe, 0xd3, 0x86, 0xf6, 0xfa,
-	0x5f, 0x6e, 0x17, 0xa8, 0x25, 0x04, 0xed, 0x94, 0x82, 0x5a, 0x42, 0x00, 0x29, 0x01, 0x1c, 0xa2,
-	0x75, 0x28, 0xa9, 0xf7, 0x8d, 0x50, 0x9a, 0x0a, 0xe9, 0x96, 0x21, 0xad, 0xb6, 0x8c, 0x86, 0xc3,
-	0x2a, 0x20, 0x4c, 0xe1, 0x78, 0x8c, 0xae, 0x42, 0xa3, 0xda, 0x61, 0xea, 0x0b, 0xb7, 0x2d, 0xc3,
-	0xad, 0x76, 0xb0, 0xae, 0x00, 0x56, 0xcd, 0x90, 0x9d, 0xa0, 0x6b, 0xf0, 0x40, 0xac, 0xde, 0x38,
-	0x54, 0x0b, 0x0b, 0xb2, 0xeb, 0x9d, 0x37, 0x21, 0xad, 0xc6, 0xfa, 0xf7, 0xb3, 0xe8, 0x86, 0xbd,
-	0xb2, 0x6a, 0xed, 0x8f, 0x84, 0xfd, 0xdb, 0x53, 0xab, 0xac, 0xb6, 0x06, 0xd7, 0x2c, 0x66, 0x4d,
-	0x25, 0x42, 0xb4, 0xae, 0xb6, 0x82, 0xb5, 0xd6, 0x91, 0x1a, 0x60, 0xb5, 0x27, 0xac, 0x75, 0x5c,
-	0x95, 0x84, 0x6a, 0x7c, 0xfb, 0x4d, 0x34, 0x97, 0xe6, 0xc3, 0xcd, 0xbf, 0xb7, 0xd0, 0x79, 0x2b,
-	0x95, 0xc7, 0xef, 0xa2, 0xf9, 0x21, 0x4d, 0x53, 0x12, 0x8a, 0x13, 0xaf, 0x39, 0xf1, 0x50, 0xac,
-	0xca, 0xf9, 0xbd, 0x83, 0x38, 0x62, 0xf1, 0xf6, 0xa9, 0xcf, 0x9f, 0xad, 0xcf, 0x74, 0x8b, 0x5b,
-	0x9a, 0x7f, 0x6a, 0xa1, 0x37, 0x05, 0xe2, 0xce, 0xb0, 0xdc, 0x19, 0xd6, 0x6b, 0x3c, 0xc3, 0x72,
-	0xc7, 0x4f, 0xee, 0xf8, 0xe9, 0x35, 0x1f, 0x3f, 0xb9, 0xc4, 0xde, 0x25, 0xf6, 0x2e, 0xb1, 0x77,
-	0x89, 0xbd, 0x4b, 0xec, 0x5d, 0x62, 0xff, 0xca, 0xc4, 0xde, 0xa5, 0xdd, 0x2e, 0xed, 0x76, 0x69,
-	0xf7, 0xd7, 0x23, 0xed, 0xfe, 0xcb, 0x06, 0x3a, 0xaf, 0x5f, 0x74, 0x3d, 0x1c, 0x71, 0x30, 0xfd,
-	0xcf, 0xb2, 0xe5, 0xff, 0x46, 0xb2, 0x7b, 0x80, 0x96, 0xf5, 0x8b, 0x2d, 0x29, 0xf5, 0x6f, 0xe6,
-	0xaa, 0xf2, 0xe6, 0x5d, 0x41, 0xa8, 0xc9, 0x55, 0xbf, 0xb2, 0x49, 0xe6, 0x13, 0xd4, 0xd4, 0xfb,
-	0xf0, 0xe2, 0x7d, 0xa7, 0xfd, 0xc5, 0xc4, 0x9a, 0x71, 0x7a, 0xa2, 0x87, 0x1d, 0x7c, 0x39, 0xb1,
-	0x44, 0xab, 0x21, 0x97, 0xc2, 0xba, 0x14, 0xf6, 0xab, 0xfe, 0x05, 0xc5, 0xff, 0xe5, 0x0b, 0xfb,
-	0x43, 0xd4, 0x02, 0x5f, 0x4e, 0x64, 0x74, 0xc2, 0xf7, 0x04, 0x29, 0x1b, 0x94, 0x83, 0xf7, 0x50,
-	0xed, 0xd5, 0xca, 0x0f, 0x28, 0xf6, 0xe9, 0x24, 0xeb, 0x16, 0x24, 0xb5, 0x57, 0x2b, 0x3e, 0xa3,
-	0x98, 0x42, 0xdd, 0xd9, 0x81, 0x3b, 0x3b, 0x70, 0x67, 0x07, 0xee, 0xec, 0xc0, 0x9d, 0x1d, 0xb8,
-	0xb3, 0x03, 0x77, 0x76, 0xe0, 0xce, 0x0e, 0xdc, 0xd9, 0xc1, 0xd7, 0xfe, 0xec, 0x60, 0x1e, 0xbd,
-	0xc5, 0xc4, 0x59, 0xc1, 0xe6, 0x1f, 0xd6, 0xd1, 0x52, 0x4d, 0x3a, 0x89, 0x77, 0xa7, 0xde, 0xde,
-	0x6f, 0xbd, 0x34, 0xff, 0x7c, 0xe5, 0x5b, 0xfc, 0x6f, 0xa2, 0xf9, 0x57, 0x1d, 0x49, 0x7c, 0x23,
-	0x75, 0xc7, 0x11, 0x5f, 0xee, 0x38, 0xc2, 0x65, 0xfa, 0x2e, 0xd3, 0x7f, 0xcd, 0x99, 0xbe, 0xcb,
-	0xc4, 0x5d, 0x26, 0xee, 0x32, 0x71, 0x97, 0x89, 0xbb, 0x4c, 0xdc, 0x65, 0xe2, 0x2e, 0x13, 0x77,
-	0x99, 0xb8, 0xcb, 0xc4, 0x5d, 0x26, 0xee, 0x32, 0xf1, 0x97, 0xbd, 0xc5, 0xff, 0xf3, 0x1c, 0x9a,
-	0xef, 0x24, 0x2c, 0xde, 0x27, 0xe9, 0x53, 0xfc, 0x00, 0x9d, 0x23, 0x79, 0x76, 0x44, 0xe3, 0x8c,
-	0xc7, 0x02, 0x96, 0xc8, 0xec, 0xfb, 0xcc, 0xf6, 0xf5, 0x7f, 0x3c, 0x5b, 0xdf, 0x0c, 0xa3, 0xec,
-	0x28, 0x3f, 0xf4, 0x7c, 0x36, 0x6c, 0x47, 0x6c, 0xfc, 0x2d, 0x16, 0xd3, 0xf6, 0x31, 0x25, 0x63,
-	0xea, 0x75, 0x58, 0x1c, 0x44, 0x62, 0x43, 0x6b, 0xdd, 0xfd, 0xbf, 0xf1, 0x19, 0xfb, 0xc7, 0x68,
-	0xc5, 0xc8, 0x31, 0x8a, 0x0b, 0xfa, 0xaf, 0x27, 0x2e, 0xcb, 0x10, 0x35, 0xc0, 0x2f, 0xff, 0x1f,
-	0xa0, 0x6f, 0xa1, 0xb3, 0x7c, 0xfb, 0x9f, 0x91, 0xc1, 0xe0, 0x44, 0xdc, 0xfc, 0x0b, 0x75, 0x40,
-	0xc1, 0x77, 0xfb, 0xfb, 0xbc, 0x54, 0x7d, 0x33, 0x11, 0xb2, 0xb1, 0xbe, 0x54, 0xa3, 0xb7, 0xdd,
-	0xf8, 0xfc, 0x79, 0x6b, 0xf6, 0x8b, 0xe7, 0xad, 0xd9, 0xbf, 0x3d, 0x6f, 0xcd, 0xfe, 0xf1, 0x45,
-	0x6b, 0xe6, 0x8b, 0x17, 0xad, 0x99, 0xbf, 0xbe, 0x68, 0xcd, 0x1c, 0xbe, 0x25, 0xfe, 0xf8, 0xc7,
-	0xad, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x84, 0x8a, 0x61, 0x0f, 0x46, 0x00, 0x00,
+	// 2496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdb, 0x72, 0xdc, 0xb6,
+	0x19, 0xb6, 0x62, 0x27, 0xf5, 0xc0, 0x47, 0xc1, 0xb6, 0xb4, 0x5a, 0xc9, 0x2b, 0x59, 0xb2, 0x1c,
+	0x4f, 0x67, 0xca, 0xed, 0xd8, 0x3d, 0x37, 0xa9, 0x6b, 0x1d, 0x5c, 0x27, 0x8d, 0x0f, 0x59, 0x49,
+	0x4e, 0x5a, 0x3b, 0xd9, 0x50, 0x24, 0x96, 0x62, 0xcc, 0x25, 0xd6, 0x3c, 0xec, 0xae, 0x3b, 0xd3,
+	0x9b, 0xbe, 0x40, 0x7b, 0xdb, 0x07, 0xe9, 0x4d, 0x9f, 0x20, 0x37, 0x9d, 0xc9, 0x5d, 0x7b, 0x95,
+	0xe9, 0xd8, 0xb7, 0x7d, 0x82, 0x5e, 0x75, 0x00, 0xfc, 0x20, 0x01, 0x90, 0x74, 0xda, 0x34, 0x53,
+	0x37, 0x32, 0xae, 0xbc, 0xc4, 0xf7, 0xf1, 0xfb, 0x71, 0xe2, 0x0f, 0xe0, 0x1b, 0x58, 0xa8, 0xe5,
+	0x0d, 0xfd, 0xee, 0x7e, 0x9c, 0xfa, 0x5d, 0x77, 0x34, 0xea, 0x7a, 0xd4, 0x27, 0x9e, 0x33, 0x4a,
+	0x68, 0x46, 0xf1, 0x31, 0x56, 0xda, 0xee, 0x14, 0xf8, 0xb4, 0xeb, 0x7a, 0x1e, 0xcd, 0xe3, 0x4c,
+	0x65, 0xb5, 0xaf, 0x28, 0xf8, 0x28, 0x21, 0x09, 0x09, 0xc2, 0x34, 0x4b, 0xdc, 0x2c, 0xa4, 0xb1,
+	0xc6, 0x5b, 0x53, 0x78, 0x4f, 0x72, 0x37, 0x0a, 0xb3, 0xa7, 0xa9, 0x47, 0x13, 0xa2, 0x91, 0x56,
+	0x15, 0x52, 0x46, 0x92, 0xa1, 0x4f, 0x46, 0x34, 0x0d, 0xf5, 0x80, 0xcb, 0x0a, 0x27, 0x4f, 0x49,
+	0x12, 0xbb, 0x43, 0x5d, 0x64, 0xc1, 0x77, 0x33, 0x77, 0x18, 0x06, 0x35, 0x95, 0x38, 0x1f, 0xd0,
+	0x80, 0xf2, 0x9f, 0x5d, 0xf6, 0x0b, 0x4a, 0x2f, 0xd4, 0x93, 0xcf, 0x4d, 0xbb, 0x6e, 0x3a, 0x71,
+	0xb5, 0x4e, 0x69, 0xe3, 0x69, 0xd7, 0x73, 0xd3, 0x03, 0xad, 0x6c, 0x6e, 0xda, 0xf5, 0xf2, 0x24,
+	0x21, 0xb1, 0xf7, 0x54, 0x2b, 0x6f, 0x4f, 0xbb, 0x3e, 0xeb, 0x8c, 0x70, 0x3f, 0xaf, 0xd6, 0x64,
+	0xda, 0x25, 0xa9, 0x97, 0xd0, 0x89, 0x56, 0x3a, 0x3b, 0xed, 0x06, 0x74, 0x6c, 0x12, 0x87, 0x69,
+	0x30, 0x20, 0xc4, 0x0c, 0x39, 0xcc, 0xa3, 0x2c, 0x4c, 0xc3, 0xc0, 0xac, 0x5e, 0x1a, 0x06, 0xa9,
+	0xd9, 0x8e, 0x6c, 0x6a, 0x0a, 0xb4, 0xa6, 0xdd, 0xb1, 0x1b, 0x85, 0xbe, 0x9b, 0xd1, 0x44, 0xa3,
+	0xaf, 0xfe, 0xbe, 0x8b, 0x5e, 0xdb, 0x9d, 0xe2, 0x4b, 0xe8, 0xd8, 0x80, 0x90, 0xb4, 0x35, 0xb3,
+	0x32, 0x73, 0xf5, 0xc4, 0xb5, 0x53, 0x0e, 0x6b, 0xb5, 0x73, 0x8b, 0x90, 0x77, 0xe2, 0x01, 0xed,
+	0x71, 0x08, 0x5f, 0x43, 0x28, 0x0d, 0x83, 0xd8, 0xcd, 0xf2, 0x84, 0xa4, 0xad, 0xd7, 0x56, 0x8e,
+	0x5e, 0x3d, 0x71, 0x0d, 0x3b, 0x2c, 0xbe, 0xb3, 0x93, 0xf9, 0x3b, 0x12, 0xea, 0x29, 0x2c, 0xdc,
+	0x46, 0xc7, 0x65, 0xc5, 0x5b, 0xc7, 0x56, 0x8e, 0x5e, 0x3d, 0xd9, 0x2b, 0x9e, 0xf1, 0x75, 0x74,
+	0x8a, 0x45, 0xe9, 0xa7, 0x24, 0xf6, 0xfb, 0xc3, 0x34, 0x68, 0x5d, 0x57, 0x63, 0xef, 0x90, 0xd8,
+	0xbf, 0x93, 0x06, 0xb7, 0x8f, 0xf4, 0x4e, 0xb0, 0x67, 0x78, 0xc4, 0x37, 0xd0, 0xac, 0xe8, 0xc8,
+	0xbe, 0x97, 0x10, 0x37, 0x23, 0xfc, 0xc5, 0xef, 0xf1, 0x17, 0x67, 0x1d, 0x81, 0x38, 0x9b, 0x1c,
+	0x11, 0x2f, 0x9f, 0x11, 0x65, 0x45, 0x11, 0xde, 0x40, 0x18, 0x04, 0x12, 0x12, 0x11, 0x37, 0x15,
+	0x0a, 0xdf, 0xe7, 0x0a, 0x58, 0x2a, 0xf4, 0x04, 0x24, 0x24, 0xce, 0x8a, 0xc2, 0xb2, 0x4c, 0xa9,
+	0x44, 0x42, 0xb2, 0x3c, 0x89, 0xb9, 0xc4, 0x0f, 0xf4, 0x4a, 0xf4, 0x38, 0xa2, 0x55, 0xa2, 0x28,
+	0xc2, 0x7b, 0x68, 0x01, 0x04, 0xf2, 0x91, 0xcf, 0x5a, 0x31, 0x72, 0x93, 0x2c, 0x24, 0x29, 0x17,
+	0xfa, 0x21, 0x17, 0x6a, 0x49, 0xa1, 0x3d, 0xce, 0xb8, 0x2f, 0x08, 0x42, 0x6f, 0x4e, 0x40, 0x26,
+	0x82, 0xb7, 0xd1, 0x39, 0xd9, 0xbb, 0x6a, 0xf7, 0xfc, 0x88, 0x0b, 0x9e, 0x73, 0x24, 0xa6, 0x75,
+	0xd0, 0xac, 0x2c, 0x2d, 0xbb, 0x48, 0x95, 0x81, 0xfa, 0x31, 0x99, 0x1f, 0x9b, 0x32, 0x22, 0xbe,
+	0x21, 0x53, 0x14, 0xb2, 0x46, 0x96, 0x73, 0xae, 0xef, 0x8e, 0x46, 0xd1, 0xd3, 0xbe, 0x1f, 0x0e,
+	0x06, 0x5c, 0xec, 0x27, 0xd0, 0xc8, 0x92, 0xe1, 0xdc, 0x64, 0x8c, 0xad, 0x70, 0x30, 0x80, 0x46,
+	0x96, 0x90, 0x8a, 0xb0, 0xda, 0xc9, 0xcf, 0x4f, 0x6d, 0xe4, 0x4f, 0xa1, 0x76, 0x12, 0xd3, 0x1b,
+	0x29, 0x4b, 0xcb, 0x46, 0x6e, 0xa2, 0x59, 0x32, 0x25, 0x5e, 0x9e, 0x91, 0xfe, 0xbe, 0x9b, 0x79,
+	0x07, 0x5c, 0xe4, 0x2d, 0x2e, 0x72, 0xc1, 0x61, 0xf9, 0xc6, 0xd9, 0x16, 0xf0, 0x06, 0x43, 0xe5,
+	0x38, 0xea, 0x45, 0xf8, 0x21, 0x5a, 0x94, 0x39, 0xa9, 0x2f, 0x52, 0x21, 0x49, 0xfa, 0x19, 0x7d,
+	0x4c, 0xc4, 0x94, 0x78, 0x9b, 0xcb, 0xb5, 0x1d, 0xc9, 0x71, 0x7a, 0xc0, 0xd9, 0x65, 0x14, 0xa1,
+	0xd9, 0x92, 0xa0, 0x89, 0x69, 0xe2, 0x59, 0xe2, 0xc6, 0xe9, 0x40, 0x13, 0xff, 0x99, 0x29, 0xbe,
+	0x0b, 0x9c, 0x3a, 0x71, 0x13, 0xc3, 0x8f, 0xd1, 0xa5, 0x42, 0xdc, 0x3b, 0x70, 0xe3, 0x80, 0x80,
+	0x74, 0xe6, 0x26, 0x01, 0xc9, 0xc4, 0x4c, 0xbc, 0xc1, 0x43, 0x2c, 0x97, 0x21, 0x36, 0x39, 0x93,
+	0x8b, 0xec, 0x0a, 0x9e, 0x88, 0x73, 0x51, 0x32, 0x6a, 0x09, 0x78, 0xa8, 0x04, 0x83, 0x09, 0xe5,
+	0xd1, 0x78, 0x10, 0x06, 0xb9, 0xc8, 0xc3, 0x3c, 0xd8, 0xcf, 0x79, 0xb0, 0x95, 0x32, 0x98, 0x98,
+	0x49, 0x9b, 0x2a, 0x51, 0x44, 0xeb, 0x48, 0x4a, 0x3d, 0x03, 0xbf, 0x8f, 0xe6, 0xd5, 0x44, 0xac,
+	0xce, 0x92, 0x0d, 0x1e, 0x64, 0xde, 0x51, 0x71, 0x6d, 0xa6, 0x5c, 0x50, 0x91, 0x72, 0xb6, 0xdc,
+	0x46, 0x67, 0x35, 0x49, 0xa6, 0xb5, 0xc9, 0xb5, 0x16, 0x75, 0xad, 0x2d, 0xf9, 0x20, 0xf3, 0x8f,
+	0x8a, 0x32, 0xa5, 0xbb, 0x68, 0x4e, 0x53, 0x4a, 0x48, 0x4a, 0x32, 0xae, 0xb7, 0xc5, 0xf5, 0xe6,
+	0x74, 0xbd, 0x1e, 0x83, 0x85, 0xd4, 0x79, 0x15, 0x90, 0xe5, 0xf8, 0x63, 0xb4, 0x54, 0xac, 0x67,
+	0xfd, 0x7c, 0x14, 0x24, 0xae, 0x4f, 0xfa, 0xa9, 0x77, 0x40, 0x86, 0x2e, 0x57, 0xdd, 0x86, 0x5a,
+	0x16, 0x24, 0x67, 0x4f, 0x90, 0x76, 0x38, 0x47, 0x48, 0x2f, 0x14, 0xa8, 0x09, 0xe2, 0xb7, 0xd0,
+	0x59, 0xbe, 0x2c, 0xaa, 0xbd, 0x78, 0x8b, 0x6b, 0x9e, 0x75, 0x38, 0xa0, 0x75, 0xdf, 0x69, 0x5e,
+	0x54, 0xf6, 0xdb, 0x0d, 0x34, 0x2b, 0xde, 0x56, 0x93, 0xed, 0x2f, 0x20, 0x53, 0x8a, 0xd7, 0xb5,
+	0x5c, 0x7b, 0x86, 0x97, 0x95, 0x45, 0x65, 0x78, 0x25, 0xd3, 0xde, 0xd6, 0xc2, 0xab, 0x89, 0xf6,
+	0x34, 0xbc, 0x2e, 0xf3, 0xec, 0x3d, 0x34, 0x1f, 0xd0, 0xb1, 0xac, 0xfa, 0x28, 0xa1, 0x23, 0x9a,
+	0xba, 0x11, 0x17, 0x79, 0x07, 0x7a, 0x3b, 0xa0, 0x63, 0x68, 0xc1, 0x7d, 0x80, 0xa1, 0xb7, 0x03,
+	0x3a, 0xae, 0x94, 0x4b, 0x41, 0x9f, 0x44, 0xc4, 0x14, 0x7c, 0x57, 0x11, 0xdc, 0xe2, 0x78, 0x55,
+	0xb0, 0x52, 0x8e, 0xbf, 0x8b, 0x4e, 0x32, 0xc1, 0x31, 0x85, 0xae, 0xfd, 0x25, 0x57, 0x39, 0xc9,
+	0x55, 0x1e, 0x50, 0xd9, 0xad, 0x28, 0xa0, 0xe3, 0x07, 0xb4, 0x48, 0xab, 0xec, 0x0d, 0xf8, 0x8e,
+	0x48, 0x44, 0xbc, 0x8c, 0x26, 0x72, 0x64, 0xee, 0x40, 0x5a, 0x65, 0xaf, 0x8b, 0xaf, 0x63, 0xbb,
+	0x20, 0x40, 0x5a, 0x0d, 0xe8, 0xb8, 0x06, 0xc1, 0x8f, 0xd0, 0x92, 0x29, 0xcb, 0xa7, 0x67, 0x1e,
+	0x09, 0xe5, 0xbb, 0x90, 0x6e, 0x0c, 0x65, 0x36, 0x15, 0xf3, 0x08, 0xb4, 0x5b, 0xba, 0x76, 0x89,
+	0xe1, 0x77, 0xd1, 0x9c, 0xd8, 0xd6, 0xf4, 0x61, 0xb6, 0xf7, 0x07, 0x44, 0xe8, 0xde, 0xe7, 0xba,
+	0xe7, 0x1d, 0x01, 0x3b, 0x3b, 0x7c, 0x56, 0xdf, 0x22, 0xa0, 0x88, 0x45, 0xb1, 0x5a, 0x8a, 0x53,
+	0xb4, 0xa6, 0x6d, 0xf9, 0xfa, 0x32, 0x8f, 0x97, 0x25, 0x4c, 0xf8, 0x7d, 0x2e, 0xbc, 0xea, 0x68,
+	0x5c, 0x99, 0xd4, 0xef, 0xc8, 0x02, 0x11, 0x66, 0x45, 0x23, 0xd5, 0x70, 0xf0, 0xa7, 0x68, 0x05,
+	0xb6, 0xc3, 0xcd, 0x19, 0xac, 0x07, 0xe9, 0x12, 0x88, 0xcd, 0x09, 0xec, 0x22, 0x30, 0x1a, 0xf2,
+	0xd7, 0x43, 0xb4, 0x28, 0x63, 0x15, 0x8b, 0x8a, 0x4f, 0x87, 0x6e, 0x28, 0xc2, 0xec, 0xc0, 0x48,
+	0xc8, 0x30, 0x72, 0xe1, 0xd8, 0xe2, 0x14, 0x18, 0x09, 0x00, 0x2b, 0x18, 0x4e, 0xd0, 0xe5, 0x52,
+	0x7c, 0x14, 0xb9, 0x1e, 0xe9, 0xcb, 0x67, 0x18, 0x16, 0x91, 0xfb, 0x77, 0x79, 0x94, 0x4b, 0x4a,
+	0x14, 0x4e, 0xbe, 0x29, 0x1e, 0xc5, 0x68, 0x40, 0xf6, 0x5f, 0x2e, 0x82, 0xd5, 0x53, 0xd4, 0x06,
+	0x15, 0x0b, 0x99, 0xd2, 0xa0, 0x3d, 0xa3, 0x41, 0x72, 0xb1, 0xaa, 0x6b, 0x50, 0x05, 0xc3, 0x3d,
+	0xd4, 0x2a, 0x1b, 0x14, 0x93, 0x89, 0xaa, 0xfc, 0x00, 0xd2, 0x7d, 0xd9, 0x88, 0x98, 0x4c, 0x54,
+	0xd9, 0x0b, 0x45, 0xd5, 0x55, 0x80, 0x7d, 0x63, 0x52, 0x13, 0x3e, 0x75, 0x45, 0xf4, 0x03, 0xf8,
+	0xc6, 0xa4, 0xa8, 0xf8, 0xa8, 0x55, 0xd5, 0x39, 0x80, 0x0c, 0x84, 0xe5, 0xea, 0xca, 0xc0, 0x2a,
+	0x9d, 0xdf, 0xfa, 0x10, 0x72, 0xb5, 0x39, 0xb2, 0x65, 0x8f, 0xb2, 0x5c, 0x6d, 0x0c, 0x6d, 0x09,
+	0xaa, 0xfa, 0x45, 0x3f, 0xab, 0xfa, 0xbf, 0x32, 0xf4, 0x65, 0x67, 0xd6, 0xea, 0x57, 0x41, 0xfc,
+	0x04, 0xad, 0x35, 0xcd, 0x1d, 0x75, 0xdb, 0xf0, 0xeb, 0x17, 0x4e, 0x1d, 0x6d, 0xe3, 0x50, 0x3f,
+	0x75, 0x4a, 0x0a, 0xfe, 0x10, 0xb5, 0x8d, 0x91, 0x50, 0x1b, 0xf4, 0x90, 0x47, 0x5a, 0x30, 0x86,
+	0x42, 0x6b, 0xce, 0xbc, 0x36, 0x16, 0x4a, 0x63, 0x94, 0x79, 0x33, 0x88, 0xf2, 0xf4, 0x40, 0x1d,
+	0xe2, 0x47, 0xc6, 0xbc, 0xb9, 0xc5, 0x08, 0x75, 0xf3, 0x46, 0x07, 0xd4, 0x79, 0x23, 0xe6, 0xa2,
+	0x5a, 0xd9, 0x8f, 0x8c, 0x79, 0xc3, 0xe7, 0x9c, 0x56, 0xd7, 0x39, 0x75, 0x36, 0xd6, 0xf7, 0xbb,
+	0xeb, 0xfb, 0x85, 0xa8, 0x47, 0x92, 0x2c, 0x1c, 0x84, 0x9e, 0x4c, 0xfe, 0x1f, 0x1b, 0xfd, 0x7e,
+	0xd3, 0xf7, 0x41, 0x64, 0xb3, 0x64, 0xea, 0xfd, 0xde, 0x44, 0xc1, 0xbf, 0x41, 0x57, 0x1a, 0xfa,
+	0xdd, 0x8c, 0xda, 0xe7, 0x51, 0x2f, 0xd7, 0x8f, 0x41, 0x25, 0xf0, 0x6a, 0xdd, 0x70, 0x18, 0xb1,
+	0x3f, 0x41, 0x4b, 0x86, 0xb5, 0x50, 0x7e, 0x2e, 0x2c, 0xe2, 0x27, 0x3c, 0xe2, 0x92, 0x63, 0x90,
+	0x8a, 0xcf, 0x45, 0x44, 0x6a, 0x1b, 0xb0, 0x82, 0x62, 0x17, 0x5d, 0xe4, 0x47, 0xcf, 0xc6, 0x54,
+	0xee, 0x42, 0x08, 0xc6, 0x6a, 0xce, 0xe3, 0x6d, 0x06, 0x37, 0x24, 0x71, 0x1f, 0x75, 0xf8, 0x31,
+	0xbc, 0x39, 0xc6, 0x3e, 0x8f, 0x71, 0xd1, 0xe1, 0xb4, 0xe6, 0x20, 0x8b, 0x1c, 0x6f, 0x88, 0xf2,
+	0x5b, 0xf4, 0xa6, 0x62, 0x9c, 0xc8, 0x8d, 0x4e, 0xf1, 0x48, 0xe3, 0x2c, 0x71, 0x3d, 0x31, 0xfd,
+	0x3c, 0x1e, 0x6e, 0xdd, 0x51, 0xf8, 0xb0, 0xf1, 0xd9, 0x12, 0x4f, 0x9b, 0xc0, 0x16, 0x61, 0xd7,
+	0x14, 0x5e, 0x13, 0x8d, 0xed, 0xb4, 0xd5, 0xf0, 0xf2, 0x5f, 0x16, 0xce, 0x87, 0x4f, 0x48, 0x0d,
+	0x07, 0x0a, 0xf0, 0x09, 0x29, 0x48, 0x09, 0xe0, 0x00, 0x2d, 0xab, 0x92, 0x72, 0xdf, 0xa8, 0x4a,
+	0x13, 0x2e, 0xdd, 0xd1, 0xa4, 0x61, 0xcb, 0xa8, 0x45, 0x58, 0x52, 0x08, 0x15, 0x1c, 0x8f, 0xd1,
+	0x65, 0x35, 0x50, 0xe3, 0x30, 0x0d, 0x78, 0xb4, 0x35, 0x2d, 0x5a, 0xe3, 0x60, 0x5d, 0x52, 0x58,
+	0x0d, 0x43, 0xf6, 0x14, 0xad, 0xab, 0x86, 0x58, 0x73, 0xe0, 0x00, 0x3e, 0x2c, 0x95, 0xdd, 0x1c,
+	0x79, 0x55, 0xa5, 0x35, 0x84, 0xfe, 0xdd, 0x0c, 0xba, 0x6a, 0x7e, 0x59, 0x8d, 0xe1, 0x0f, 0x78,
+	0xf8, 0x37, 0x2b, 0x5f, 0x59, 0x63, 0x0d, 0xd6, 0x0d, 0x66, 0x43, 0x25, 0x02, 0xb4, 0x0c, 0x5b,
+	0xc1, 0xc6, 0xd0, 0x21, 0x0c, 0xb0, 0xe0, 0x35, 0x47, 0x5c, 0x12, 0x84, 0x86, 0x40, 0x19, 0xba,
+	0xac, 0xf8, 0x0f, 0x29, 0xc9, 0xfa, 0xc5, 0x23, 0xdb, 0xb9, 0x0f, 0x42, 0xd8, 0xd9, 0x7e, 0x0a,
+	0x1b, 0xc5, 0x92, 0xcc, 0x76, 0xa1, 0x0f, 0xe4, 0xd3, 0x7d, 0x41, 0x85, 0x8d, 0x62, 0x49, 0xaa,
+	0xe7, 0xe0, 0x7d, 0xd4, 0x29, 0xec, 0x09, 0x68, 0xa0, 0x38, 0x58, 0x87, 0xf1, 0x80, 0xf2, 0x78,
+	0x8f, 0x65, 0x6e, 0x01, 0x1a, 0xb4, 0x8f, 0x1f, 0x9a, 0x99, 0xdd, 0x26, 0x73, 0x0b, 0xc0, 0x55,
+	0x94, 0x9d, 0xa7, 0xcd, 0x18, 0xd5, 0x4e, 0x8c, 0xe0, 0x3c, 0x6d, 0x84, 0xa9, 0x3b, 0x4f, 0xeb,
+	0xa1, 0xea, 0x52, 0x59, 0xb9, 0xb5, 0xf6, 0xe9, 0x24, 0xae, 0x1c, 0x32, 0x63, 0x48, 0x65, 0x05,
+	0xcd, 0xd9, 0x92, 0x34, 0xf5, 0x98, 0xb9, 0x58, 0xe0, 0x55, 0x18, 0xf7, 0xf5, 0x9c, 0x3c, 0x71,
+	0xa3, 0x88, 0x64, 0xd0, 0x2e, 0x1e, 0x84, 0xc2, 0xee, 0x45, 0xc9, 0xc9, 0x1f, 0x70, 0x92, 0xa8,
+	0x30, 0xec, 0x5e, 0xca, 0x94, 0x6c, 0x80, 0xf8, 0x3d, 0x04, 0xbe, 0x59, 0x7f, 0x90, 0xc7, 0x7e,
+	0x1f, 0x7e, 0x33, 0xe5, 0x11, 0xd8, 0x3e, 0xa2, 0xc8, 0xb9, 0x95, 0xc7, 0xfe, 0x36, 0xff, 0x29,
+	0x34, 0xcf, 0x89, 0x72, 0xad, 0x98, 0x2d, 0x21, 0x69, 0x18, 0xa4, 0xcd, 0xfd, 0xff, 0x04, 0x86,
+	0x99, 0xb1, 0x5e, 0xb0, 0x84, 0x30, 0xb8, 0xa1, 0xdf, 0xf7, 0x51, 0x47, 0xcd, 0x50, 0x19, 0x1d,
+	0xf5, 0xf3, 0x91, 0x96, 0x09, 0x13, 0x88, 0xa1, 0xe6, 0xa6, 0x5d, 0x3a, 0xda, 0x1b, 0x69, 0x79,
+	0xb0, 0xad, 0xc0, 0x06, 0x5a, 0xb1, 0x23, 0xbc, 0xc8, 0x0d, 0x87, 0x5c, 0x3b, 0xad, 0xb3, 0x23,
+	0x36, 0x19, 0x5c, 0x63, 0x47, 0xc8, 0x72, 0xb6, 0xd5, 0x2f, 0xe7, 0x4a, 0x42, 0xb8, 0xe5, 0xa3,
+	0x4c, 0x94, 0x0c, 0xb6, 0xfa, 0xe5, 0x44, 0xe9, 0x71, 0x8e, 0x3a, 0x4b, 0x5a, 0x05, 0x68, 0x60,
+	0x7c, 0x4d, 0x55, 0x3a, 0x24, 0x9d, 0x10, 0x52, 0xf4, 0x87, 0xd8, 0x7a, 0xe6, 0x72, 0x4d, 0x55,
+	0x3a, 0x64, 0x87, 0xd1, 0xa0, 0xc9, 0xa9, 0x5c, 0x53, 0x4b, 0xdc, 0x84, 0xf1, 0x04, 0xad, 0x37,
+	0xed, 0x72, 0x13, 0x92, 0xd2, 0x3c, 0xf1, 0xe0, 0x88, 0x34, 0x86, 0x95, 0xa1, 0x7e, 0x9f, 0xdb,
+	0x93, 0x5c, 0x58, 0x19, 0x6a, 0x77, 0xba, 0x2a, 0x69, 0xe3, 0x75, 0x74, 0x34, 0xcd, 0x87, 0xab,
+	0xff, 0x58, 0x47, 0x67, 0x0c, 0xef, 0x11, 0xbf, 0x8d, 0x8e, 0x0f, 0x49, 0x9a, 0xba, 0x01, 0xb7,
+	0xe8, 0x8f, 0xf2, 0xef, 0xa0, 0xce, 0xa4, 0x74, 0xf6, 0xe2, 0x90, 0xc6, 0x1b, 0xc7, 0x3e, 0xfb,
+	0x62, 0xf9, 0x48, 0xaf, 0x78, 0xa5, 0xfd, 0xc7, 0x75, 0xf4, 0x3a, 0x47, 0xac, 0xe9, 0x6e, 0x4d,
+	0xf7, 0x97, 0x68, 0xba, 0x5b, 0xbf, 0xdc, 0xfa, 0xe5, 0x2f, 0xd9, 0x2f, 0xb7, 0x4e, 0xa4, 0x75,
+	0x22, 0xad, 0x13, 0x69, 0x9d, 0x48, 0xeb, 0x44, 0x5a, 0x27, 0xf2, 0x4b, 0x9d, 0x48, 0xeb, 0x13,
+	0x5a, 0x9f, 0xd0, 0xfa, 0x84, 0x87, 0xdc, 0x27, 0x3c, 0x84, 0x8e, 0x9d, 0xf5, 0xd2, 0x0e, 0x83,
+	0x97, 0xf6, 0x6a, 0xd8, 0x5d, 0x7f, 0xba, 0x8a, 0xce, 0xc8, 0x1b, 0x31, 0xf7, 0x46, 0xac, 0x03,
+	0xd2, 0xaf, 0xe6, 0x52, 0x7d, 0x1d, 0x26, 0xd3, 0x1e, 0x5a, 0x80, 0x49, 0x08, 0x52, 0xff, 0xa1,
+	0x47, 0x24, 0x5e, 0x16, 0x33, 0xbb, 0xc1, 0x23, 0x3a, 0xb4, 0xe6, 0xce, 0x23, 0xd4, 0x96, 0xe7,
+	0xdf, 0xe2, 0x62, 0x94, 0x79, 0xb5, 0xf2, 0xa2, 0xe6, 0x5a, 0xca, 0x61, 0x57, 0xae, 0x58, 0xce,
+	0x93, 0x7a, 0xc8, 0x5a, 0x47, 0xd6, 0x3a, 0x3a, 0xec, 0x57, 0x2d, 0xbf, 0x91, 0x37, 0xfb, 0xf6,
+	0x51, 0x47, 0xb9, 0x62, 0x99, 0x91, 0xa9, 0x58, 0x36, 0xa2, 0x72, 0xf0, 0xee, 0xc1, 0xc2, 0x5a,
+	0xde, 0xb4, 0xdc, 0x25, 0xd3, 0xac, 0x57, 0x90, 0x60, 0x61, 0x2d, 0xee, 0x5b, 0x56, 0x50, 0xeb,
+	0xd9, 0x59, 0xcf, 0xce, 0x7a, 0x76, 0xd6, 0xb3, 0xb3, 0x9e, 0x9d, 0xf5, 0xec, 0xac, 0x67, 0x67,
+	0x3d, 0x3b, 0xeb, 0xd9, 0x59, 0xcf, 0xce, 0xde, 0xb2, 0xfb, 0x6a, 0xb7, 0xec, 0xac, 0xd5, 0x66,
+	0xaf, 0xad, 0x7d, 0xbd, 0x3e, 0x1e, 0xf3, 0x37, 0xf8, 0x09, 0xd0, 0x8d, 0x3d, 0x12, 0x95, 0xd6,
+	0x8f, 0x38, 0x57, 0xc9, 0x69, 0x35, 0x81, 0xd3, 0x0d, 0x3f, 0x04, 0x72, 0xa6, 0x74, 0x78, 0xb6,
+	0x25, 0x0f, 0x4e, 0x37, 0xec, 0x1c, 0xd8, 0x48, 0xd8, 0x38, 0x8e, 0xde, 0xa0, 0xdc, 0x24, 0x5c,
+	0xfd, 0xf3, 0x3a, 0x9a, 0x6f, 0xf0, 0x91, 0xf0, 0x76, 0xe5, 0xba, 0xdc, 0xda, 0x0b, 0x8d, 0xa7,
+	0x86, 0x6b, 0x73, 0x7f, 0xbd, 0x2c, 0xaf, 0xcd, 0x7d, 0x1b, 0x1d, 0xff, 0x32, 0x2f, 0xf2, 0x5b,
+	0xa9, 0xf5, 0x21, 0xff, 0x3b, 0x1f, 0xd2, 0x5a, 0x7c, 0xd6, 0xe2, 0x7b, 0xc9, 0x16, 0x9f, 0xb5,
+	0xe0, 0xac, 0x05, 0x67, 0x2d, 0x38, 0x6b, 0xc1, 0x59, 0x0b, 0xce, 0x5a, 0x70, 0xd6, 0x82, 0xb3,
+	0x16, 0x9c, 0xb5, 0xe0, 0xac, 0x05, 0x67, 0x2d, 0xb8, 0x57, 0xc5, 0x82, 0xb3, 0xe6, 0xd8, 0x2b,
+	0x78, 0x0f, 0xed, 0x7f, 0xeb, 0x5f, 0xc1, 0xa5, 0xb7, 0xbf, 0x1c, 0x43, 0xc7, 0x37, 0x13, 0x1a,
+	0xef, 0xba, 0xe9, 0x63, 0x7c, 0x17, 0x9d, 0x76, 0xf3, 0xec, 0x80, 0xc4, 0x19, 0x5b, 0x41, 0x69,
+	0x22, 0x3c, 0xab, 0x93, 0x1b, 0x57, 0xfe, 0xf9, 0xc5, 0xf2, 0x6a, 0x10, 0x66, 0x07, 0xf9, 0xbe,
+	0xe3, 0xd1, 0x61, 0x37, 0xa4, 0xe3, 0xef, 0xd0, 0x98, 0x74, 0x27, 0xc4, 0x1d, 0x13, 0x67, 0x93,
+	0xc6, 0x7e, 0xc8, 0x8f, 0x81, 0xc6, 0xdb, 0xff, 0x1f, 0xff, 0xdb, 0xf2, 0x23, 0xb4, 0xa8, 0x4d,
+	0xc2, 0xe2, 0x81, 0xfc, 0xfb, 0xc7, 0xfd, 0x05, 0x15, 0xd5, 0xc0, 0x97, 0xfd, 0x87, 0xc5, 0xae,
+	0xa3, 0x53, 0x6c, 0xce, 0x64, 0x6e, 0x14, 0x3d, 0xe5, 0xaf, 0xbe, 0x07, 0xa6, 0x20, 0x9b, 0x1f,
+	0xbb, 0xac, 0x54, 0xbc, 0x77, 0x22, 0xa0, 0x63, 0xf9, 0xc8, 0x36, 0xac, 0xec, 0xa5, 0xca, 0x25,
+	0x39, 0xf6, 0xfe, 0x10, 0xd6, 0x73, 0xf6, 0xbe, 0x61, 0x52, 0xc2, 0x7a, 0x1e, 0xd0, 0x71, 0x15,
+	0x80, 0xf9, 0xb4, 0xd1, 0xfa, 0xec, 0x59, 0x67, 0xe6, 0xf3, 0x67, 0x9d, 0x99, 0xbf, 0x3f, 0xeb,
+	0xcc, 0xfc, 0xe1, 0x79, 0xe7, 0xc8, 0xe7, 0xcf, 0x3b, 0x47, 0xfe, 0xf6, 0xbc, 0x73, 0x64, 0xff,
+	0x0d, 0xfe, 0x67, 0x3e, 0xaf, 0xff, 0x6b, 0x00, 0xd8, 0x0f, 0x28, 0x0f, 0xf9, 0x55, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -7302,70 +8606,31 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
-}
-
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn55, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *Tx_ValidatorsSetValidatorProfileMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsSetValidatorProfileMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsSetValidatorProfileMsg.Size()))
+		n55, err := m.ValidatorsSetValidatorProfileMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn55
+		i += n55
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CurrencyUpdateTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		dAtA[i] = 0xda
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n56, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateTokenInfoMsg.Size()))
+		n56, err := m.CurrencyUpdateTokenInfoMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7373,15 +8638,15 @@ func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error)
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CurrencyUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowCreateMsg != nil {
-		dAtA[i] = 0xa2
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n57, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateConfigurationMsg.Size()))
+		n57, err := m.CurrencyUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7389,15 +8654,15 @@ func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MigrationDowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
-		dAtA[i] = 0xaa
+	if m.MigrationDowngradeSchemaMsg != nil {
+		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n58, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationDowngradeSchemaMsg.Size()))
+		n58, err := m.MigrationDowngradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7405,15 +8670,15 @@ func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, er
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashUpdateWalletConfigMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
-		dAtA[i] = 0xb2
+	if m.CashUpdateWalletConfigMsg != nil {
+		dAtA[i] = 0xfa
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n59, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateWalletConfigMsg.Size()))
+		n59, err := m.CashUpdateWalletConfigMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7421,15 +8686,15 @@ func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowFundEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowUpdatePartiesMsg != nil {
-		dAtA[i] = 0xba
+	if m.EscrowFundEscrowMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n60, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowFundEscrowMsg.Size()))
+		n60, err := m.EscrowFundEscrowMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7437,15 +8702,15 @@ func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (i
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_SigsUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
+	if m.SigsUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n61, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsUpdateConfigurationMsg.Size()))
+		n61, err := m.SigsUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7453,15 +8718,15 @@ func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_TermdepositTopUpDepositMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
+	if m.TermdepositTopUpDepositMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n62, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositTopUpDepositMsg.Size()))
+		n62, err := m.TermdepositTopUpDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -7469,19 +8734,250 @@ func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_DistributionClaimMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
+	if m.DistributionClaimMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionClaimMsg.Size()))
+		n63, err := m.DistributionClaimMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	return i, nil
+}
+func (m *Tx_MigrationRenameSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationRenameSchemaMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationRenameSchemaMsg.Size()))
+		n64, err := m.MigrationRenameSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	return i, nil
+}
+func (m *Tx_TermdepositSweepDepositsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositSweepDepositsMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositSweepDepositsMsg.Size()))
+		n65, err := m.TermdepositSweepDepositsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	return i, nil
+}
+func (m *Tx_AccountReplaceAccountResourcesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountResourcesMsg.Size()))
+		n66, err := m.AccountReplaceAccountResourcesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn67, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn67
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n68, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowCreateMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
+		n69, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n70, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n71, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdatePartiesMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
+		n72, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n73, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n74, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
 		i++
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n63, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n75, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n75
 	}
 	return i, nil
 }
@@ -7493,11 +8989,11 @@ func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n64, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n76, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n76
 	}
 	return i, nil
 }
@@ -7509,11 +9005,11 @@ func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n65, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n77, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n77
 	}
 	return i, nil
 }
@@ -7525,11 +9021,11 @@ func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n66, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n78, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n78
 	}
 	return i, nil
 }
@@ -7541,11 +9037,11 @@ func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n67, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n79, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n79
 	}
 	return i, nil
 }
@@ -7557,11 +9053,11 @@ func (m *ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n68, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n80, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n80
 	}
 	return i, nil
 }
@@ -7573,11 +9069,11 @@ func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n69, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n81, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n81
 	}
 	return i, nil
 }
@@ -7589,11 +9085,11 @@ func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n70, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n82, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n82
 	}
 	return i, nil
 }
@@ -7605,11 +9101,11 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n71, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n83, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n83
 	}
 	return i, nil
 }
@@ -7621,11 +9117,11 @@ func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n72, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n84, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n84
 	}
 	return i, nil
 }
@@ -7637,11 +9133,11 @@ func (m *ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n73, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n85, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n85
 	}
 	return i, nil
 }
@@ -7653,11 +9149,11 @@ func (m *ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n74, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n86, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n86
 	}
 	return i, nil
 }
@@ -7669,11 +9165,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n75, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n87, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n87
 	}
 	return i, nil
 }
@@ -7685,11 +9181,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n76, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n88, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n88
 	}
 	return i, nil
 }
@@ -7701,11 +9197,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n77, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n89, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n89
 	}
 	return i, nil
 }
@@ -7717,11 +9213,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n78, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n90, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n90
 	}
 	return i, nil
 }
@@ -7733,11 +9229,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n79, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n91, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n91
 	}
 	return i, nil
 }
@@ -7749,11 +9245,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n80, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n92, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n92
 	}
 	return i, nil
 }
@@ -7765,11 +9261,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n81, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n93, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n93
 	}
 	return i, nil
 }
@@ -7781,11 +9277,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n82, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n94, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n94
 	}
 	return i, nil
 }
@@ -7797,11 +9293,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n83, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n95, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n95
 	}
 	return i, nil
 }
@@ -7813,11 +9309,11 @@ func (m *ExecuteBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n84, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n96, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n96
 	}
 	return i, nil
 }
@@ -7829,11 +9325,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n85, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n97, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n97
 	}
 	return i, nil
 }
@@ -7845,11 +9341,11 @@ func (m *ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n86, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n98, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n98
 	}
 	return i, nil
 }
@@ -7861,11 +9357,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg) MarshalTo(dAt
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n87, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n99, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n99
 	}
 	return i, nil
 }
@@ -7877,11 +9373,11 @@ func (m *ExecuteBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n88, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n100, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n100
 	}
 	return i, nil
 }
@@ -7893,11 +9389,11 @@ func (m *ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n89, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n101, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n101
 	}
 	return i, nil
 }
@@ -7909,11 +9405,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n90, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n102, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n102
 	}
 	return i, nil
 }
@@ -7925,11 +9421,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n91, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n103, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n103
 	}
 	return i, nil
 }
@@ -7941,11 +9437,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n92, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n104, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n104
 	}
 	return i, nil
 }
@@ -7957,11 +9453,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n93, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n105, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n105
 	}
 	return i, nil
 }
@@ -7973,11 +9469,11 @@ func (m *ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n94, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n106, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n106
 	}
 	return i, nil
 }
@@ -7989,11 +9485,11 @@ func (m *ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n95, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n107, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n107
 	}
 	return i, nil
 }
@@ -8005,11 +9501,155 @@ func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n96, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n108, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n96
+		i += n108
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateTokenInfoMsg.Size()))
+		n109, err := m.CurrencyUpdateTokenInfoMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n109
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateConfigurationMsg.Size()))
+		n110, err := m.CurrencyUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashUpdateWalletConfigMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateWalletConfigMsg.Size()))
+		n111, err := m.CashUpdateWalletConfigMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowFundEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowFundEscrowMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowFundEscrowMsg.Size()))
+		n112, err := m.EscrowFundEscrowMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SigsUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsUpdateConfigurationMsg.Size()))
+		n113, err := m.SigsUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n113
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositTopUpDepositMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositTopUpDepositMsg.Size()))
+		n114, err := m.TermdepositTopUpDepositMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n114
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_DistributionClaimMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionClaimMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionClaimMsg.Size()))
+		n115, err := m.DistributionClaimMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n115
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositSweepDepositsMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositSweepDepositsMsg.Size()))
+		n116, err := m.TermdepositSweepDepositsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n116
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountResourcesMsg.Size()))
+		n117, err := m.AccountReplaceAccountResourcesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n117
 	}
 	return i, nil
 }
@@ -8029,11 +9669,11 @@ func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Option != nil {
-		nn97, err := m.Option.MarshalTo(dAtA[i:])
+		nn118, err := m.Option.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn97
+		i += nn118
 	}
 	return i, nil
 }
@@ -8046,11 +9686,11 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n98, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n119, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n98
+		i += n119
 	}
 	return i, nil
 }
@@ -8062,11 +9702,11 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n99, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n120, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n99
+		i += n120
 	}
 	return i, nil
 }
@@ -8078,11 +9718,11 @@ func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n100, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n121, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n100
+		i += n121
 	}
 	return i, nil
 }
@@ -8094,11 +9734,11 @@ func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n101, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n122, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n101
+		i += n122
 	}
 	return i, nil
 }
@@ -8110,11 +9750,11 @@ func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n102, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n123, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n102
+		i += n123
 	}
 	return i, nil
 }
@@ -8126,11 +9766,11 @@ func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n103, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n124, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n103
+		i += n124
 	}
 	return i, nil
 }
@@ -8142,11 +9782,11 @@ func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n104, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		n125, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n104
+		i += n125
 	}
 	return i, nil
 }
@@ -8158,11 +9798,11 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n105, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n126, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n105
+		i += n126
 	}
 	return i, nil
 }
@@ -8174,11 +9814,11 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n106, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n127, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n106
+		i += n127
 	}
 	return i, nil
 }
@@ -8190,11 +9830,11 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n107, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n128, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n107
+		i += n128
 	}
 	return i, nil
 }
@@ -8206,11 +9846,11 @@ func (m *ProposalOptions_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n108, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n129, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n108
+		i += n129
 	}
 	return i, nil
 }
@@ -8222,11 +9862,11 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n109, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n130, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n109
+		i += n130
 	}
 	return i, nil
 }
@@ -8238,11 +9878,11 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n110, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n131, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n110
+		i += n131
 	}
 	return i, nil
 }
@@ -8254,11 +9894,11 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n111, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n132, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n111
+		i += n132
 	}
 	return i, nil
 }
@@ -8270,11 +9910,11 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n112, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n133, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n112
+		i += n133
 	}
 	return i, nil
 }
@@ -8286,11 +9926,11 @@ func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n113, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n134, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n113
+		i += n134
 	}
 	return i, nil
 }
@@ -8302,11 +9942,11 @@ func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n114, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n135, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n114
+		i += n135
 	}
 	return i, nil
 }
@@ -8318,11 +9958,11 @@ func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n115, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n136, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n115
+		i += n136
 	}
 	return i, nil
 }
@@ -8334,11 +9974,11 @@ func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n116, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n137, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n116
+		i += n137
 	}
 	return i, nil
 }
@@ -8350,11 +9990,11 @@ func (m *ProposalOptions_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n117, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n138, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n117
+		i += n138
 	}
 	return i, nil
 }
@@ -8366,11 +10006,11 @@ func (m *ProposalOptions_AccountUpdateConfigurationMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n118, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n139, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n118
+		i += n139
 	}
 	return i, nil
 }
@@ -8382,11 +10022,11 @@ func (m *ProposalOptions_AccountRegisterDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n119, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n140, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n119
+		i += n140
 	}
 	return i, nil
 }
@@ -8398,11 +10038,11 @@ func (m *ProposalOptions_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n120, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n141, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n120
+		i += n141
 	}
 	return i, nil
 }
@@ -8414,11 +10054,11 @@ func (m *ProposalOptions_AccountTransferDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n121, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n142, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n121
+		i += n142
 	}
 	return i, nil
 }
@@ -8430,11 +10070,11 @@ func (m *ProposalOptions_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n122, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n143, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n122
+		i += n143
 	}
 	return i, nil
 }
@@ -8446,11 +10086,11 @@ func (m *ProposalOptions_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n123, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n144, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n123
+		i += n144
 	}
 	return i, nil
 }
@@ -8462,11 +10102,11 @@ func (m *ProposalOptions_AccountRegisterAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n124, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n145, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n124
+		i += n145
 	}
 	return i, nil
 }
@@ -8478,11 +10118,11 @@ func (m *ProposalOptions_AccountTransferAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n125, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n146, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n125
+		i += n146
 	}
 	return i, nil
 }
@@ -8494,11 +10134,11 @@ func (m *ProposalOptions_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n126, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n147, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n126
+		i += n147
 	}
 	return i, nil
 }
@@ -8510,11 +10150,11 @@ func (m *ProposalOptions_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n127, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n148, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n127
+		i += n148
 	}
 	return i, nil
 }
@@ -8526,11 +10166,11 @@ func (m *ProposalOptions_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n128, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n149, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n128
+		i += n149
 	}
 	return i, nil
 }
@@ -8542,11 +10182,11 @@ func (m *ProposalOptions_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n129, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n150, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n129
+		i += n150
 	}
 	return i, nil
 }
@@ -8558,11 +10198,11 @@ func (m *ProposalOptions_AccountAddAccountCertificateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n130, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n151, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n130
+		i += n151
 	}
 	return i, nil
 }
@@ -8574,11 +10214,11 @@ func (m *ProposalOptions_AccountDeleteAccountCertificateMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n131, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n152, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n131
+		i += n152
 	}
 	return i, nil
 }
@@ -8590,11 +10230,11 @@ func (m *ProposalOptions_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n132, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n153, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n132
+		i += n153
 	}
 	return i, nil
 }
@@ -8606,11 +10246,11 @@ func (m *ProposalOptions_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n133, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n154, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n133
+		i += n154
 	}
 	return i, nil
 }
@@ -8622,11 +10262,11 @@ func (m *ProposalOptions_TermdepositCreateDepositContractMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n134, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n155, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n134
+		i += n155
 	}
 	return i, nil
 }
@@ -8638,11 +10278,11 @@ func (m *ProposalOptions_TermdepositDepositMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n135, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n156, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n135
+		i += n156
 	}
 	return i, nil
 }
@@ -8654,11 +10294,11 @@ func (m *ProposalOptions_TermdepositReleaseDepositMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n136, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n157, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n136
+		i += n157
 	}
 	return i, nil
 }
@@ -8670,11 +10310,11 @@ func (m *ProposalOptions_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n137, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n158, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n137
+		i += n158
 	}
 	return i, nil
 }
@@ -8686,11 +10326,11 @@ func (m *ProposalOptions_QualityscoreUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n138, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n159, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n138
+		i += n159
 	}
 	return i, nil
 }
@@ -8702,11 +10342,11 @@ func (m *ProposalOptions_PreregistrationUpdateConfigurationMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n139, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n160, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n139
+		i += n160
 	}
 	return i, nil
 }
@@ -8718,82 +10358,242 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n140, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n161, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n140
-	}
-	return i, nil
-}
-func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n161
 	}
 	return i, nil
 }
-
-func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn141, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *ProposalOptions_CurrencyUpdateTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateTokenInfoMsg.Size()))
+		n162, err := m.CurrencyUpdateTokenInfoMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn141
+		i += n162
 	}
 	return i, nil
 }
-
-func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOptions_CurrencyUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.SendMsg != nil {
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateConfigurationMsg.Size()))
+		n163, err := m.CurrencyUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n163
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MigrationDowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationDowngradeSchemaMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationDowngradeSchemaMsg.Size()))
+		n164, err := m.MigrationDowngradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n164
+	}
+	return i, nil
+}
+func (m *ProposalOptions_SigsUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SigsUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsUpdateConfigurationMsg.Size()))
+		n165, err := m.SigsUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n165
+	}
+	return i, nil
+}
+func (m *ProposalOptions_TermdepositTopUpDepositMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositTopUpDepositMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositTopUpDepositMsg.Size()))
+		n166, err := m.TermdepositTopUpDepositMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n166
+	}
+	return i, nil
+}
+func (m *ProposalOptions_DistributionClaimMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionClaimMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionClaimMsg.Size()))
+		n167, err := m.DistributionClaimMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n167
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MigrationRenameSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationRenameSchemaMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationRenameSchemaMsg.Size()))
+		n168, err := m.MigrationRenameSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n168
+	}
+	return i, nil
+}
+func (m *ProposalOptions_TermdepositSweepDepositsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositSweepDepositsMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositSweepDepositsMsg.Size()))
+		n169, err := m.TermdepositSweepDepositsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n169
+	}
+	return i, nil
+}
+func (m *ProposalOptions_AccountReplaceAccountResourcesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountResourcesMsg.Size()))
+		n170, err := m.AccountReplaceAccountResourcesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n170
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovCancelProposalExecutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCancelProposalExecutionMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCancelProposalExecutionMsg.Size()))
+		n171, err := m.GovCancelProposalExecutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n171
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn172, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn172
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SendMsg != nil {
 		dAtA[i] = 0x9a
 		i++
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n142, err := m.SendMsg.MarshalTo(dAtA[i:])
+		n173, err := m.SendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n142
+		i += n173
 	}
 	return i, nil
 }
@@ -8805,11 +10605,11 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n143, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n174, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n143
+		i += n174
 	}
 	return i, nil
 }
@@ -8821,11 +10621,11 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n144, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n175, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n144
+		i += n175
 	}
 	return i, nil
 }
@@ -8837,11 +10637,11 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n145, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n176, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n145
+		i += n176
 	}
 	return i, nil
 }
@@ -8853,11 +10653,11 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n146, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n177, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n146
+		i += n177
 	}
 	return i, nil
 }
@@ -8869,11 +10669,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n147, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n178, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n147
+		i += n178
 	}
 	return i, nil
 }
@@ -8885,11 +10685,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n148, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n179, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n148
+		i += n179
 	}
 	return i, nil
 }
@@ -8901,11 +10701,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n149, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n180, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n149
+		i += n180
 	}
 	return i, nil
 }
@@ -8917,11 +10717,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n150, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n181, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n150
+		i += n181
 	}
 	return i, nil
 }
@@ -8933,11 +10733,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n151, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n182, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n151
+		i += n182
 	}
 	return i, nil
 }
@@ -8949,11 +10749,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n152, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n183, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n152
+		i += n183
 	}
 	return i, nil
 }
@@ -8965,11 +10765,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n153, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n184, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n153
+		i += n184
 	}
 	return i, nil
 }
@@ -8981,11 +10781,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n154, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n185, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n154
+		i += n185
 	}
 	return i, nil
 }
@@ -8997,11 +10797,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n155, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n186, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n155
+		i += n186
 	}
 	return i, nil
 }
@@ -9013,11 +10813,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n156, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n187, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n156
+		i += n187
 	}
 	return i, nil
 }
@@ -9029,11 +10829,11 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n157, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n188, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n157
+		i += n188
 	}
 	return i, nil
 }
@@ -9045,11 +10845,11 @@ func (m *ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg) Marshal
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n158, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n189, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n158
+		i += n189
 	}
 	return i, nil
 }
@@ -9061,11 +10861,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n159, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n190, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n159
+		i += n190
 	}
 	return i, nil
 }
@@ -9077,11 +10877,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n160, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n191, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n160
+		i += n191
 	}
 	return i, nil
 }
@@ -9093,11 +10893,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n161, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n192, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n161
+		i += n192
 	}
 	return i, nil
 }
@@ -9109,11 +10909,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n162, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n193, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n162
+		i += n193
 	}
 	return i, nil
 }
@@ -9125,11 +10925,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n163, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n194, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n163
+		i += n194
 	}
 	return i, nil
 }
@@ -9141,11 +10941,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n164, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n195, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n164
+		i += n195
 	}
 	return i, nil
 }
@@ -9157,11 +10957,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n165, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n196, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n165
+		i += n196
 	}
 	return i, nil
 }
@@ -9173,11 +10973,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n166, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n197, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n166
+		i += n197
 	}
 	return i, nil
 }
@@ -9189,11 +10989,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n167, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n198, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n167
+		i += n198
 	}
 	return i, nil
 }
@@ -9205,11 +11005,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n168, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n199, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n168
+		i += n199
 	}
 	return i, nil
 }
@@ -9221,11 +11021,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n169, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n200, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n169
+		i += n200
 	}
 	return i, nil
 }
@@ -9237,11 +11037,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n170, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n201, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n170
+		i += n201
 	}
 	return i, nil
 }
@@ -9253,11 +11053,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n171, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n202, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n171
+		i += n202
 	}
 	return i, nil
 }
@@ -9269,11 +11069,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountCertificateMsg) Marsh
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n172, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n203, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n172
+		i += n203
 	}
 	return i, nil
 }
@@ -9285,11 +11085,11 @@ func (m *ExecuteProposalBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n173, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n204, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n173
+		i += n204
 	}
 	return i, nil
 }
@@ -9301,11 +11101,11 @@ func (m *ExecuteProposalBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n174, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n205, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n174
+		i += n205
 	}
 	return i, nil
 }
@@ -9317,11 +11117,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg) Mars
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n175, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n206, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n175
+		i += n206
 	}
 	return i, nil
 }
@@ -9333,11 +11133,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n176, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n207, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n176
+		i += n207
 	}
 	return i, nil
 }
@@ -9349,11 +11149,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n177, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n208, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n177
+		i += n208
 	}
 	return i, nil
 }
@@ -9365,11 +11165,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg) Marsha
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n178, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n209, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n178
+		i += n209
 	}
 	return i, nil
 }
@@ -9381,11 +11181,11 @@ func (m *ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg) Marsh
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n179, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n210, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n179
+		i += n210
 	}
 	return i, nil
 }
@@ -9397,11 +11197,11 @@ func (m *ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) Ma
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n180, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n211, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n180
+		i += n211
 	}
 	return i, nil
 }
@@ -9413,92 +11213,220 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n181, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n212, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n181
+		i += n212
 	}
 	return i, nil
 }
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.Sum != nil {
-		nn182, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateTokenInfoMsg.Size()))
+		n213, err := m.CurrencyUpdateTokenInfoMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn182
+		i += n213
 	}
 	return i, nil
 }
-
-func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
-		dAtA[i] = 0xaa
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n183, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyUpdateConfigurationMsg.Size()))
+		n214, err := m.CurrencyUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n183
+		i += n214
 	}
 	return i, nil
 }
-func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
-		dAtA[i] = 0xb2
+	if m.SigsUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n184, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsUpdateConfigurationMsg.Size()))
+		n215, err := m.SigsUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n184
+		i += n215
 	}
 	return i, nil
 }
-func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionDistributeMsg != nil {
+	if m.TermdepositTopUpDepositMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositTopUpDepositMsg.Size()))
+		n216, err := m.TermdepositTopUpDepositMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n216
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionClaimMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionClaimMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionClaimMsg.Size()))
+		n217, err := m.DistributionClaimMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n217
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TermdepositSweepDepositsMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositSweepDepositsMsg.Size()))
+		n218, err := m.TermdepositSweepDepositsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n218
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountResourcesMsg.Size()))
+		n219, err := m.AccountReplaceAccountResourcesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n219
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCancelProposalExecutionMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCancelProposalExecutionMsg.Size()))
+		n220, err := m.GovCancelProposalExecutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n220
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn221, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn221
+	}
+	return i, nil
+}
+
+func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n222, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n222
+	}
+	return i, nil
+}
+func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n223, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n223
+	}
+	return i, nil
+}
+func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionDistributeMsg != nil {
 		dAtA[i] = 0x9a
 		i++
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n185, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		n224, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n185
+		i += n224
 	}
 	return i, nil
 }
@@ -9510,11 +11438,27 @@ func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n186, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		n225, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n186
+		i += n225
+	}
+	return i, nil
+}
+func (m *CronTask_AswapReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AswapReturnMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReturnMsg.Size()))
+		n226, err := m.AswapReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n226
 	}
 	return i, nil
 }
@@ -9526,11 +11470,27 @@ func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n187, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		n227, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n187
+		i += n227
+	}
+	return i, nil
+}
+func (m *CronTask_GovExecuteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovExecuteProposalMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovExecuteProposalMsg.Size()))
+		n228, err := m.GovExecuteProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n228
 	}
 	return i, nil
 }
@@ -10195,6 +12155,150 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Tx_ValidatorsSetValidatorProfileMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsSetValidatorProfileMsg != nil {
+		l = m.ValidatorsSetValidatorProfileMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyUpdateTokenInfoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		l = m.CurrencyUpdateTokenInfoMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		l = m.CurrencyUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationDowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationDowngradeSchemaMsg != nil {
+		l = m.MigrationDowngradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CashUpdateWalletConfigMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashUpdateWalletConfigMsg != nil {
+		l = m.CashUpdateWalletConfigMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_EscrowFundEscrowMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowFundEscrowMsg != nil {
+		l = m.EscrowFundEscrowMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SigsUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsUpdateConfigurationMsg != nil {
+		l = m.SigsUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_TermdepositTopUpDepositMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositTopUpDepositMsg != nil {
+		l = m.TermdepositTopUpDepositMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DistributionClaimMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionClaimMsg != nil {
+		l = m.DistributionClaimMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationRenameSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationRenameSchemaMsg != nil {
+		l = m.MigrationRenameSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_TermdepositSweepDepositsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositSweepDepositsMsg != nil {
+		l = m.TermdepositSweepDepositsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_AccountReplaceAccountResourcesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		l = m.AccountReplaceAccountResourcesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10714,37 +12818,145 @@ func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ProposalOptions) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Option != nil {
-		n += m.Option.Size()
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		l = m.CurrencyUpdateTokenInfoMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		l = m.CurrencyUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EscrowReleaseMsg != nil {
+	if m.CashUpdateWalletConfigMsg != nil {
+		l = m.CashUpdateWalletConfigMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowFundEscrowMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowFundEscrowMsg != nil {
+		l = m.EscrowFundEscrowMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsUpdateConfigurationMsg != nil {
+		l = m.SigsUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositTopUpDepositMsg != nil {
+		l = m.TermdepositTopUpDepositMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_DistributionClaimMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionClaimMsg != nil {
+		l = m.DistributionClaimMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositSweepDepositsMsg != nil {
+		l = m.TermdepositSweepDepositsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		l = m.AccountReplaceAccountResourcesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != nil {
+		n += m.Option.Size()
+	}
+	return n
+}
+
+func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
 		l = m.EscrowReleaseMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
@@ -11242,6 +13454,126 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ProposalOptions_CurrencyUpdateTokenInfoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		l = m.CurrencyUpdateTokenInfoMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CurrencyUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		l = m.CurrencyUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationDowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationDowngradeSchemaMsg != nil {
+		l = m.MigrationDowngradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_SigsUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsUpdateConfigurationMsg != nil {
+		l = m.SigsUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_TermdepositTopUpDepositMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositTopUpDepositMsg != nil {
+		l = m.TermdepositTopUpDepositMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_DistributionClaimMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionClaimMsg != nil {
+		l = m.DistributionClaimMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationRenameSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationRenameSchemaMsg != nil {
+		l = m.MigrationRenameSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_TermdepositSweepDepositsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositSweepDepositsMsg != nil {
+		l = m.TermdepositSweepDepositsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_AccountReplaceAccountResourcesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		l = m.AccountReplaceAccountResourcesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovCancelProposalExecutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCancelProposalExecutionMsg != nil {
+		l = m.GovCancelProposalExecutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteProposalBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11749,6 +14081,102 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n i
 	}
 	return n
 }
+func (m *ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateTokenInfoMsg != nil {
+		l = m.CurrencyUpdateTokenInfoMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyUpdateConfigurationMsg != nil {
+		l = m.CurrencyUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsUpdateConfigurationMsg != nil {
+		l = m.SigsUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositTopUpDepositMsg != nil {
+		l = m.TermdepositTopUpDepositMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionClaimMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionClaimMsg != nil {
+		l = m.DistributionClaimMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TermdepositSweepDepositsMsg != nil {
+		l = m.TermdepositSweepDepositsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountReplaceAccountResourcesMsg != nil {
+		l = m.AccountReplaceAccountResourcesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCancelProposalExecutionMsg != nil {
+		l = m.GovCancelProposalExecutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *CronTask) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11815,14 +14243,38 @@ func (m *CronTask_AswapReleaseMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *CronTask_GovTallyMsg) Size() (n int) {
+func (m *CronTask_AswapReturnMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovTallyMsg != nil {
-		l = m.GovTallyMsg.Size()
+	if m.AswapReturnMsg != nil {
+		l = m.AswapReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_GovTallyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovTallyMsg != nil {
+		l = m.GovTallyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_GovExecuteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovExecuteProposalMsg != nil {
+		l = m.GovExecuteProposalMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
@@ -11851,28 +14303,1285 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCodec
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowCreateMsg{v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReturnMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.RegisterTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.TransferTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionCreateMsg{v}
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.DistributeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionMsg{v}
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.ResetMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionResetMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapCreateMsg{v}
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapReleaseMsg{v}
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapReturnMsg{v}
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovCreateProposalMsg{v}
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.DeleteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.VoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovVoteMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &datamigration.ExecuteMigrationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DatamigrationExecuteMigrationMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.RegisterDomainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountRegisterDomainMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.ReplaceAccountMsgFeesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountReplaceAccountMsgFeesMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.TransferDomainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountTransferDomainMsg{v}
+			iNdEx = postIndex
+		case 86:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &account.RenewDomainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &Tx_AccountRenewDomainMsg{v}
+			iNdEx = postIndex
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11899,16 +15608,50 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
+			v := &account.DeleteDomainMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sum = &Tx_AccountDeleteDomainMsg{v}
+			iNdEx = postIndex
+		case 88:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &account.RegisterAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_AccountRegisterAccountMsg{v}
 			iNdEx = postIndex
-		case 2:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11935,16 +15678,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &account.TransferAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_AccountTransferAccountMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -11954,27 +15698,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &account.ReplaceAccountTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AccountReplaceAccountTargetsMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12001,15 +15748,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &account.DeleteAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Sum = &Tx_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12036,15 +15783,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &account.FlushDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowCreateMsg{v}
+			m.Sum = &Tx_AccountFlushDomainMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12071,15 +15818,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &account.RenewAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReleaseMsg{v}
+			m.Sum = &Tx_AccountRenewAccountMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12106,15 +15853,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &account.AddAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReturnMsg{v}
+			m.Sum = &Tx_AccountAddAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12141,15 +15888,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &account.DeleteAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			m.Sum = &Tx_AccountDeleteAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationRegisterMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12176,15 +15923,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &preregistration.RegisterMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Sum = &Tx_PreregistrationRegisterMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12211,15 +15958,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Sum = &Tx_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12246,15 +15993,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Sum = &Tx_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12281,15 +16028,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CurrencyCreateMsg{v}
+			m.Sum = &Tx_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12316,15 +16063,85 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Sum = &Tx_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &termdeposit.ReleaseDepositMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_TermdepositReleaseDepositMsg{v}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &termdeposit.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_TermdepositUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12351,15 +16168,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			m.Sum = &Tx_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12386,15 +16203,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			m.Sum = &Tx_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12421,15 +16238,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 64:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSetValidatorProfileMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12456,15 +16273,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.UpdateConfigurationMsg{}
+			v := &validators.SetValidatorProfileMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameUpdateConfigurationMsg{v}
+			m.Sum = &Tx_ValidatorsSetValidatorProfileMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateTokenInfoMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12491,15 +16308,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &currency.UpdateTokenInfoMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionCreateMsg{v}
+			m.Sum = &Tx_CurrencyUpdateTokenInfoMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12526,15 +16343,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &currency.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionMsg{v}
+			m.Sum = &Tx_CurrencyUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDowngradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12561,15 +16378,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &migration.DowngradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionResetMsg{v}
+			m.Sum = &Tx_MigrationDowngradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 111:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateWalletConfigMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12596,15 +16413,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &cash.UpdateWalletConfigMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Sum = &Tx_CashUpdateWalletConfigMsg{v}
 			iNdEx = postIndex
-		case 70:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowFundEscrowMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12631,15 +16448,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.CreateMsg{}
+			v := &escrow.FundEscrowMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapCreateMsg{v}
+			m.Sum = &Tx_EscrowFundEscrowMsg{v}
 			iNdEx = postIndex
-		case 71:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12666,15 +16483,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReleaseMsg{}
+			v := &sigs.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReleaseMsg{v}
+			m.Sum = &Tx_SigsUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 72:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositTopUpDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12701,15 +16518,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReturnMsg{}
+			v := &termdeposit.TopUpDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReturnMsg{v}
+			m.Sum = &Tx_TermdepositTopUpDepositMsg{v}
 			iNdEx = postIndex
-		case 73:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12736,15 +16553,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateProposalMsg{}
+			v := &distribution.ClaimMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovCreateProposalMsg{v}
+			m.Sum = &Tx_DistributionClaimMsg{v}
 			iNdEx = postIndex
-		case 74:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationRenameSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12771,15 +16588,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.DeleteProposalMsg{}
+			v := &migration.RenameSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			m.Sum = &Tx_MigrationRenameSchemaMsg{v}
 			iNdEx = postIndex
-		case 75:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositSweepDepositsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12806,15 +16623,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.VoteMsg{}
+			v := &termdeposit.SweepDepositsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovVoteMsg{v}
+			m.Sum = &Tx_TermdepositSweepDepositsMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountResourcesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12841,15 +16658,68 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &account.ReplaceAccountResourcesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			m.Sum = &Tx_AccountReplaceAccountResourcesMsg{v}
 			iNdEx = postIndex
-		case 78:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12876,15 +16746,67 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 80:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12911,15 +16833,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &cash.SendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
 			iNdEx = postIndex
-		case 81:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12946,15 +16868,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &datamigration.ExecuteMigrationMsg{}
+			v := &escrow.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DatamigrationExecuteMigrationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
 			iNdEx = postIndex
-		case 82:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12981,15 +16903,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.UpdateConfigurationMsg{}
+			v := &escrow.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
 			iNdEx = postIndex
-		case 83:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13016,15 +16938,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterDomainMsg{}
+			v := &escrow.ReturnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountRegisterDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
 			iNdEx = postIndex
-		case 84:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13051,15 +16973,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountMsgFeesMsg{}
+			v := &escrow.UpdatePartiesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountReplaceAccountMsgFeesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
 			iNdEx = postIndex
-		case 85:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13086,15 +17008,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferDomainMsg{}
+			v := &multisig.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountTransferDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
 			iNdEx = postIndex
-		case 86:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13121,15 +17043,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewDomainMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountRenewDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 87:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13156,15 +17078,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteDomainMsg{}
+			v := &validators.ApplyDiffMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountDeleteDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
 			iNdEx = postIndex
-		case 88:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13191,15 +17113,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterAccountMsg{}
+			v := &currency.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountRegisterAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
 			iNdEx = postIndex
-		case 89:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13226,15 +17148,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferAccountMsg{}
+			v := &username.RegisterTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountTransferAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
 			iNdEx = postIndex
-		case 90:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13261,15 +17183,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountTargetsMsg{}
+			v := &username.TransferTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountReplaceAccountTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
 			iNdEx = postIndex
-		case 91:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13296,15 +17218,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountMsg{}
+			v := &username.ChangeTokenTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountDeleteAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13331,15 +17253,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &username.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountFlushDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13366,15 +17288,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &distribution.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountRenewAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13401,15 +17323,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &distribution.DistributeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountAddAccountCertificateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13436,15 +17358,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AccountDeleteAccountCertificateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 96:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationRegisterMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13471,15 +17393,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.RegisterMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_PreregistrationRegisterMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13506,15 +17428,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &datamigration.ExecuteMigrationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
 			iNdEx = postIndex
-		case 98:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13541,15 +17463,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
+			v := &account.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TxfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 99:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13576,15 +17498,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &account.RegisterDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositCreateDepositContractMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterDomainMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13611,15 +17533,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &account.ReplaceAccountMsgFeesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositDepositMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13646,15 +17568,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &account.TransferDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositReleaseDepositMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountTransferDomainMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13681,15 +17603,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &account.RenewDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_TermdepositUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRenewDomainMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13716,15 +17638,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &account.DeleteDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_QualityscoreUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteDomainMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13751,15 +17673,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &account.RegisterAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterAccountMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13786,68 +17708,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &account.TransferAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountTransferAccountMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13874,67 +17743,15 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Union: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+			v := &account.ReplaceAccountTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
+			iNdEx = postIndex
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13961,15 +17778,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &account.DeleteAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13996,15 +17813,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &account.FlushDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountFlushDomainMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14031,15 +17848,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &account.RenewAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRenewAccountMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14066,15 +17883,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &account.AddAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14101,15 +17918,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &account.DeleteAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14136,15 +17953,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14171,15 +17988,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14206,15 +18023,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14241,15 +18058,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14276,15 +18093,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &termdeposit.ReleaseDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14311,15 +18128,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &termdeposit.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14346,15 +18163,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 64:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14381,15 +18198,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.UpdateConfigurationMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14416,15 +18233,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateTokenInfoMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14451,15 +18268,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &currency.UpdateTokenInfoMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateTokenInfoMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14486,15 +18303,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &currency.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 111:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateWalletConfigMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14521,15 +18338,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &cash.UpdateWalletConfigMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashUpdateWalletConfigMsg{v}
 			iNdEx = postIndex
-		case 81:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowFundEscrowMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14556,15 +18373,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &datamigration.ExecuteMigrationMsg{}
+			v := &escrow.FundEscrowMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowFundEscrowMsg{v}
 			iNdEx = postIndex
-		case 82:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14591,15 +18408,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.UpdateConfigurationMsg{}
+			v := &sigs.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_SigsUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 83:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositTopUpDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14626,15 +18443,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterDomainMsg{}
+			v := &termdeposit.TopUpDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositTopUpDepositMsg{v}
 			iNdEx = postIndex
-		case 84:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14661,15 +18478,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountMsgFeesMsg{}
+			v := &distribution.ClaimMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionClaimMsg{v}
 			iNdEx = postIndex
-		case 85:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositSweepDepositsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14696,15 +18513,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferDomainMsg{}
+			v := &termdeposit.SweepDepositsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountTransferDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositSweepDepositsMsg{v}
 			iNdEx = postIndex
-		case 86:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountResourcesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14731,15 +18548,68 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewDomainMsg{}
+			v := &account.ReplaceAccountResourcesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRenewDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountResourcesMsg{v}
 			iNdEx = postIndex
-		case 87:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14766,15 +18636,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteDomainMsg{}
+			v := &cash.SendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteDomainMsg{v}
+			m.Option = &ProposalOptions_CashSendMsg{v}
 			iNdEx = postIndex
-		case 88:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14801,15 +18671,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterAccountMsg{}
+			v := &escrow.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterAccountMsg{v}
+			m.Option = &ProposalOptions_EscrowReleaseMsg{v}
 			iNdEx = postIndex
-		case 89:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14836,15 +18706,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferAccountMsg{}
+			v := &escrow.UpdatePartiesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountTransferAccountMsg{v}
+			m.Option = &ProposalOptions_UpdateEscrowPartiesMsg{v}
 			iNdEx = postIndex
-		case 90:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14871,15 +18741,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountTargetsMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
+			m.Option = &ProposalOptions_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 91:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14906,15 +18776,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountMsg{}
+			v := &validators.ApplyDiffMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountMsg{v}
+			m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14941,15 +18811,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &currency.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountFlushDomainMsg{v}
+			m.Option = &ProposalOptions_CurrencyCreateMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14976,15 +18846,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &ExecuteProposalBatchMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRenewAccountMsg{v}
+			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15011,15 +18881,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &username.RegisterTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg{v}
+			m.Option = &ProposalOptions_UsernameRegisterTokenMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15046,15 +18916,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &username.TransferTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
+			m.Option = &ProposalOptions_UsernameTransferTokenMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15081,15 +18951,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &username.ChangeTokenTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_UsernameChangeTokenTargetsMsg{v}
 			iNdEx = postIndex
-		case 98:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15116,15 +18986,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
+			v := &username.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_UsernameUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 99:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15151,15 +19021,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &distribution.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
+			m.Option = &ProposalOptions_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15186,15 +19056,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &distribution.DistributeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositDepositMsg{v}
+			m.Option = &ProposalOptions_DistributionMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15221,15 +19091,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg{v}
+			m.Option = &ProposalOptions_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15256,15 +19126,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &migration.UpgradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15291,15 +19161,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &gov.UpdateElectorateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15326,15 +19196,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15361,68 +19231,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &gov.CreateTextResolutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15449,15 +19266,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_CashSendMsg{v}
+			m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15484,15 +19301,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &datamigration.ExecuteMigrationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_EscrowReleaseMsg{v}
+			m.Option = &ProposalOptions_DatamigrationExecuteMigrationMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15519,15 +19336,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &account.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UpdateEscrowPartiesMsg{v}
+			m.Option = &ProposalOptions_AccountUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15554,15 +19371,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &account.RegisterDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MultisigUpdateMsg{v}
+			m.Option = &ProposalOptions_AccountRegisterDomainMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15589,15 +19406,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &account.ReplaceAccountMsgFeesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{v}
+			m.Option = &ProposalOptions_AccountReplaceAccountMsgFeesMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15624,15 +19441,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &account.TransferDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_CurrencyCreateMsg{v}
+			m.Option = &ProposalOptions_AccountTransferDomainMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15659,15 +19476,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteProposalBatchMsg{}
+			v := &account.RenewDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
+			m.Option = &ProposalOptions_AccountRenewDomainMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15694,15 +19511,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &account.DeleteDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameRegisterTokenMsg{v}
+			m.Option = &ProposalOptions_AccountDeleteDomainMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15729,15 +19546,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &account.RegisterAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameTransferTokenMsg{v}
+			m.Option = &ProposalOptions_AccountRegisterAccountMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15764,15 +19581,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &account.TransferAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameChangeTokenTargetsMsg{v}
+			m.Option = &ProposalOptions_AccountTransferAccountMsg{v}
 			iNdEx = postIndex
-		case 64:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15799,15 +19616,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.UpdateConfigurationMsg{}
+			v := &account.ReplaceAccountTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_AccountReplaceAccountTargetsMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15834,15 +19651,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &account.DeleteAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionCreateMsg{v}
+			m.Option = &ProposalOptions_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15869,15 +19686,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &account.FlushDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionMsg{v}
+			m.Option = &ProposalOptions_AccountFlushDomainMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15904,15 +19721,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &account.RenewAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionResetMsg{v}
+			m.Option = &ProposalOptions_AccountRenewAccountMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15939,15 +19756,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &account.AddAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{v}
+			m.Option = &ProposalOptions_AccountAddAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15974,15 +19791,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &account.DeleteAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
+			m.Option = &ProposalOptions_AccountDeleteAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16009,15 +19826,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
+			m.Option = &ProposalOptions_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 79:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16044,15 +19861,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateTextResolutionMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
+			m.Option = &ProposalOptions_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16079,15 +19896,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{v}
+			m.Option = &ProposalOptions_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 81:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16114,15 +19931,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &datamigration.ExecuteMigrationMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DatamigrationExecuteMigrationMsg{v}
+			m.Option = &ProposalOptions_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 82:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16149,15 +19966,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.UpdateConfigurationMsg{}
+			v := &termdeposit.ReleaseDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_TermdepositReleaseDepositMsg{v}
 			iNdEx = postIndex
-		case 83:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16184,15 +20001,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterDomainMsg{}
+			v := &termdeposit.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountRegisterDomainMsg{v}
+			m.Option = &ProposalOptions_TermdepositUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 84:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16219,15 +20036,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountMsgFeesMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountReplaceAccountMsgFeesMsg{v}
+			m.Option = &ProposalOptions_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 85:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16254,15 +20071,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferDomainMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountTransferDomainMsg{v}
+			m.Option = &ProposalOptions_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 86:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16289,15 +20106,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewDomainMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountRenewDomainMsg{v}
+			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 87:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateTokenInfoMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16324,15 +20141,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteDomainMsg{}
+			v := &currency.UpdateTokenInfoMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountDeleteDomainMsg{v}
+			m.Option = &ProposalOptions_CurrencyUpdateTokenInfoMsg{v}
 			iNdEx = postIndex
-		case 88:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16359,15 +20176,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterAccountMsg{}
+			v := &currency.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountRegisterAccountMsg{v}
+			m.Option = &ProposalOptions_CurrencyUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 89:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDowngradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16394,15 +20211,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferAccountMsg{}
+			v := &migration.DowngradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountTransferAccountMsg{v}
+			m.Option = &ProposalOptions_MigrationDowngradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 90:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16429,15 +20246,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountTargetsMsg{}
+			v := &sigs.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountReplaceAccountTargetsMsg{v}
+			m.Option = &ProposalOptions_SigsUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 91:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositTopUpDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16464,15 +20281,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountMsg{}
+			v := &termdeposit.TopUpDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountDeleteAccountMsg{v}
+			m.Option = &ProposalOptions_TermdepositTopUpDepositMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16499,15 +20316,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &distribution.ClaimMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountFlushDomainMsg{v}
+			m.Option = &ProposalOptions_DistributionClaimMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationRenameSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16534,15 +20351,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &migration.RenameSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountRenewAccountMsg{v}
+			m.Option = &ProposalOptions_MigrationRenameSchemaMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositSweepDepositsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16569,15 +20386,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &termdeposit.SweepDepositsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountAddAccountCertificateMsg{v}
+			m.Option = &ProposalOptions_TermdepositSweepDepositsMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountResourcesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16604,15 +20421,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &account.ReplaceAccountResourcesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_AccountDeleteAccountCertificateMsg{v}
+			m.Option = &ProposalOptions_AccountReplaceAccountResourcesMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 119:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCancelProposalExecutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16639,15 +20456,68 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &gov.CancelProposalExecutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_CashUpdateConfigurationMsg{v}
+			m.Option = &ProposalOptions_GovCancelProposalExecutionMsg{v}
 			iNdEx = postIndex
-		case 98:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteProposalBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16674,15 +20544,67 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, ExecuteProposalBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 99:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16709,15 +20631,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &cash.SendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_TermdepositCreateDepositContractMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_SendMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16744,15 +20666,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &escrow.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_TermdepositDepositMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_EscrowReleaseMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16779,15 +20701,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &escrow.UpdatePartiesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_TermdepositReleaseDepositMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16814,15 +20736,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_TermdepositUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16849,15 +20771,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &validators.ApplyDiffMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_QualityscoreUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16884,15 +20806,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &username.RegisterTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16919,68 +20841,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &username.TransferTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteProposalBatchMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteProposalBatchMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteProposalBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17007,67 +20876,15 @@ func (m *ExecuteProposalBatchMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteProposalBatchMsg_Union{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Union: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17094,15 +20911,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &username.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_SendMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17129,15 +20946,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &distribution.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_EscrowReleaseMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17164,15 +20981,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &distribution.DistributeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17199,15 +21016,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17234,15 +21051,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &gov.UpdateElectorateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17269,15 +21086,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17304,15 +21121,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &gov.CreateTextResolutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17339,15 +21156,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 64:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17374,15 +21191,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.UpdateConfigurationMsg{}
+			v := &datamigration.ExecuteMigrationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17409,15 +21226,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &account.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionCreateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17444,15 +21261,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &account.RegisterDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17479,15 +21296,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &account.ReplaceAccountMsgFeesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionResetMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17514,15 +21331,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &account.TransferDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17549,15 +21366,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &account.RenewDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg{v}
 			iNdEx = postIndex
-		case 79:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17584,15 +21401,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateTextResolutionMsg{}
+			v := &account.DeleteDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17619,15 +21436,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &account.RegisterAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg{v}
 			iNdEx = postIndex
-		case 81:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17654,15 +21471,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &datamigration.ExecuteMigrationMsg{}
+			v := &account.TransferAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg{v}
 			iNdEx = postIndex
-		case 82:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17689,15 +21506,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.UpdateConfigurationMsg{}
+			v := &account.ReplaceAccountTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
 			iNdEx = postIndex
-		case 83:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17724,15 +21541,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterDomainMsg{}
+			v := &account.DeleteAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 84:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17759,15 +21576,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountMsgFeesMsg{}
+			v := &account.FlushDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountFlushDomainMsg{v}
 			iNdEx = postIndex
-		case 85:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17794,15 +21611,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferDomainMsg{}
+			v := &account.RenewAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRenewAccountMsg{v}
 			iNdEx = postIndex
-		case 86:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17829,15 +21646,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewDomainMsg{}
+			v := &account.AddAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountAddAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 87:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17864,15 +21681,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteDomainMsg{}
+			v := &account.DeleteAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 88:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17899,15 +21716,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterAccountMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 89:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17934,15 +21751,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferAccountMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 90:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17969,15 +21786,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountTargetsMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 91:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18004,15 +21821,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18039,15 +21856,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &termdeposit.ReleaseDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountFlushDomainMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositReleaseDepositMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18074,15 +21891,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &termdeposit.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountRenewAccountMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18109,15 +21926,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountAddAccountCertificateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18144,15 +21961,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18179,15 +21996,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_CashUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 98:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateTokenInfoMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18214,15 +22031,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
+			v := &currency.UpdateTokenInfoMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CurrencyUpdateTokenInfoMsg{v}
 			iNdEx = postIndex
-		case 99:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18249,15 +22066,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &currency.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CurrencyUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18284,15 +22101,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &sigs.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositDepositMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_SigsUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositTopUpDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18319,15 +22136,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &termdeposit.TopUpDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositReleaseDepositMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositTopUpDepositMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18354,15 +22171,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &distribution.ClaimMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionClaimMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositSweepDepositsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18389,15 +22206,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &termdeposit.SweepDepositsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_TermdepositSweepDepositsMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountResourcesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18424,15 +22241,15 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &account.ReplaceAccountResourcesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_AccountReplaceAccountResourcesMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 119:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCancelProposalExecutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18459,11 +22276,11 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &gov.CancelProposalExecutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovCancelProposalExecutionMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18690,6 +22507,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_AswapReleaseMsg{v}
 			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_AswapReturnMsg{v}
+			iNdEx = postIndex
 		case 76:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GovTallyMsg", wireType)
@@ -18725,6 +22577,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_GovTallyMsg{v}
 			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovExecuteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.ExecuteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_GovExecuteProposalMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -43,10 +43,18 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 		t.Sum = &CronTask_AswapReleaseMsg{
 			AswapReleaseMsg: msg,
 		}
+	case *aswap.ReturnMsg:
+		t.Sum = &CronTask_AswapReturnMsg{
+			AswapReturnMsg: msg,
+		}
 	case *gov.TallyMsg:
 		t.Sum = &CronTask_GovTallyMsg{
 			GovTallyMsg: msg,
 		}
+	case *gov.ExecuteProposalMsg:
+		t.Sum = &CronTask_GovExecuteProposalMsg{
+			GovExecuteProposalMsg: msg,
+		}
 	}
 
 	raw, err := t.Marshal()
@@ -1,6 +1,7 @@
 package bnsd
 
 import (
+	"bytes"
 	"context"
 	"strings"
 	"time"
@@ -8,12 +9,14 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/cmd/bnsd/x/account"
 	"github.com/iov-one/weave/cmd/bnsd/x/preregistration"
+	"github.com/iov-one/weave/cmd/bnsd/x/termdeposit"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/datamigration"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/gov"
 )
 
 func init() {
@@ -34,6 +37,60 @@ func init() {
 		},
 		Migrate: migrateRelease_1_0,
 	})
+
+	datamigration.MustRegister("abstain counts for quorum", datamigration.Migration{
+		RequiredSigners: []weave.Address{governingBoard},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: enableAbstainCountsForQuorum,
+	})
+
+	datamigration.MustRegister("termdeposit IOV bonus ladder", datamigration.Migration{
+		RequiredSigners: []weave.Address{governingBoard},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: migrateTermdepositBonuses,
+	})
+
+	datamigration.MustRegister("username target index", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: buildUsernameTargetIndex,
+	})
+
+	datamigration.MustRegister("gov proposal voter index", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: buildGovProposalVoterIndex,
+	})
+
+	datamigration.MustRegister("termdeposit unreleased index", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: buildTermdepositUnreleasedIndex,
+	})
+
+	datamigration.MustRegister("termdeposit owner index", datamigration.Migration{
+		RequiredSigners: []weave.Address{technicalExecutors},
+		ChainIDs: []string{
+			"iov-dancenet",
+			"iov-mainnet",
+		},
+		Migrate: buildTermdepositOwnerIndex,
+	})
 }
 
 var (
@@ -297,3 +354,108 @@ func migrateAccountTargetBlockchainID(targets []account.BlockchainAddress) ([]ac
 	}
 	return targets, updated
 }
+
+// enableAbstainCountsForQuorum sets the AbstainCountsForQuorum flag of all
+// election rules. Before the flag was introduced, abstain votes were always
+// included in the quorum turnout. This migration preserves that behaviour for
+// proposals created from the existing election rules. Already created
+// proposals are not modified, because their tally results include abstain
+// votes by default.
+// A new version of each election rule is created, so that the history of
+// changes is preserved.
+func enableAbstainCountsForQuorum(ctx context.Context, db weave.KVStore) error {
+	rules := gov.NewElectionRulesBucket()
+	ids, err := electionRuleIDs(db)
+	if err != nil {
+		return errors.Wrap(err, "election rule IDs")
+	}
+	for _, id := range ids {
+		_, obj, err := rules.GetLatestVersion(db, id)
+		switch {
+		case err == nil:
+		case errors.ErrDeleted.Is(err):
+			continue
+		default:
+			return errors.Wrapf(err, "cannot get %q election rule", id)
+		}
+		rule, ok := obj.Value().(*gov.ElectionRule)
+		if !ok {
+			return errors.Wrapf(errors.ErrType, "unexpected %q election rule type %T", id, obj.Value())
+		}
+		if rule.AbstainCountsForQuorum {
+			continue
+		}
+		rule.AbstainCountsForQuorum = true
+		if _, err := rules.Update(db, id, rule); err != nil {
+			return errors.Wrapf(err, "cannot save %q election rule", id)
+		}
+	}
+	return nil
+}
+
+// electionRuleIDs returns the IDs of all election rules, without the version
+// information. Each ID is returned only once.
+func electionRuleIDs(db weave.ReadOnlyKVStore) ([][]byte, error) {
+	prefix := []byte("electnrule:")
+	end := append(append([]byte{}, prefix...), 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255)
+	it, err := db.Iterator(prefix, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	var ids [][]byte
+	for {
+		key, _, err := it.Next()
+		switch {
+		case err == nil:
+		case errors.ErrIteratorDone.Is(err):
+			return ids, nil
+		default:
+			return nil, errors.Wrap(err, "iterator next")
+		}
+		ref, err := orm.UnmarshalVersionedID(key[len(prefix):])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %q", key)
+		}
+		if n := len(ids); n != 0 && bytes.Equal(ids[n-1], ref.ID) {
+			continue
+		}
+		ids = append(ids, append([]byte{}, ref.ID...))
+	}
+}
+
+// buildUsernameTargetIndex indexes all username tokens stored before the
+// target index was introduced. Until it is executed, the target index cannot
+// be used.
+func buildUsernameTargetIndex(ctx context.Context, db weave.KVStore) error {
+	return username.BuildTargetIndex(db)
+}
+
+// buildGovProposalVoterIndex indexes all votes stored before the proposal
+// voter index was introduced. Until it is executed, votes cannot be queried by
+// the proposal voter index.
+func buildGovProposalVoterIndex(ctx context.Context, db weave.KVStore) error {
+	return gov.BuildProposalVoterIndex(db)
+}
+
+// buildTermdepositUnreleasedIndex indexes all deposits stored before the
+// unreleased index was introduced. Until it is executed, deposits cannot be
+// swept.
+func buildTermdepositUnreleasedIndex(ctx context.Context, db weave.KVStore) error {
+	return termdeposit.BuildUnreleasedIndex(db)
+}
+
+// buildTermdepositOwnerIndex indexes all deposits stored before the owner
+// index was introduced. Until it is executed, new deposits cannot be created
+// if the number of deposits per address is limited.
+func buildTermdepositOwnerIndex(ctx context.Context, db weave.KVStore) error {
+	return termdeposit.BuildOwnerIndex(db)
+}
+
+// migrateTermdepositBonuses converts the termdeposit bonus list, declared
+// before bonus ladders were declared per denomination, into the IOV ladder.
+// Only IOV deposits were created on chains that used the legacy list.
+func migrateTermdepositBonuses(ctx context.Context, db weave.KVStore) error {
+	return termdeposit.MigrateLegacyBonuses(db, "IOV")
+}
@@ -9,11 +9,16 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/cmd/bnsd/x/account"
 	"github.com/iov-one/weave/cmd/bnsd/x/preregistration"
+	"github.com/iov-one/weave/cmd/bnsd/x/termdeposit"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/gov"
 )
 
 func TestRewriteUsernameAccounts(t *testing.T) {
@@ -391,3 +396,130 @@ func Test_migrateAccountTargetBlockchainID(t *testing.T) {
 		})
 	}
 }
+
+func TestEnableAbstainCountsForQuorum(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "datamigration", "gov")
+
+	ctx := context.Background()
+	adminCond := weave.NewCondition("admin", "test", []byte{1})
+
+	rules := gov.NewElectionRulesBucket()
+	rule := &gov.ElectionRule{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Title:        "my rule",
+		Admin:        adminCond.Address(),
+		VotingPeriod: weave.AsUnixDuration(time.Hour),
+		Threshold:    gov.Fraction{Numerator: 1, Denominator: 2},
+		ElectorateID: weavetest.SequenceID(1),
+		Address:      gov.Condition(weavetest.SequenceID(1)).Address(),
+	}
+	ruleRef, err := rules.Create(db, rule)
+	if err != nil {
+		t.Fatalf("cannot create election rule: %s", err)
+	}
+
+	if err := enableAbstainCountsForQuorum(ctx, db); err != nil {
+		t.Fatalf("migration: %s", err)
+	}
+
+	_, obj, err := rules.GetLatestVersion(db, ruleRef.ID)
+	if err != nil {
+		t.Fatalf("cannot get election rule: %s", err)
+	}
+	latest := obj.Value().(*gov.ElectionRule)
+	assert.Equal(t, true, latest.AbstainCountsForQuorum)
+	assert.Equal(t, uint32(2), latest.Version)
+
+	// Running the migration again must not create new versions.
+	if err := enableAbstainCountsForQuorum(ctx, db); err != nil {
+		t.Fatalf("second migration: %s", err)
+	}
+	_, obj, err = rules.GetLatestVersion(db, ruleRef.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), obj.Value().(*gov.ElectionRule).Version)
+}
+
+func TestBuildUsernameTargetIndex(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "username")
+
+	tokens := username.NewTokenBucket()
+	_, err := tokens.Put(db, []byte("alice*iov"), &username.Token{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+		Targets: []username.BlockchainAddress{
+			{BlockchainID: "blockchain1", Address: "addr1"},
+		},
+	})
+	assert.Nil(t, err)
+
+	var found []username.Token
+	if _, err := tokens.ByIndex(db, "target", username.TargetKey("blockchain1", "addr1"), &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error before the migration, got %+v", err)
+	}
+
+	assert.Nil(t, buildUsernameTargetIndex(context.Background(), db))
+
+	_, err = tokens.ByIndex(db, "target", username.TargetKey("blockchain1", "addr1"), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(found))
+}
+
+func TestBuildTermdepositUnreleasedIndex(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit")
+
+	contractID := []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	deposits := termdeposit.NewDepositBucket()
+	depositID, err := deposits.Put(db, nil, &termdeposit.Deposit{
+		Metadata:          &weave.Metadata{Schema: 1},
+		DepositContractID: contractID,
+		Amount:            coin.NewCoin(1, 0, "IOV"),
+		Rate:              weave.Fraction{Numerator: 1, Denominator: 10},
+		Depositor:         weavetest.NewCondition().Address(),
+		CreatedAt:         1572247483,
+	})
+	assert.Nil(t, err)
+
+	var found []termdeposit.Deposit
+	if _, _, err := deposits.ByIndexPage(db, "unreleased", contractID, nil, 10, &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error before the migration, got %+v", err)
+	}
+
+	assert.Nil(t, buildTermdepositUnreleasedIndex(context.Background(), db))
+
+	_, keys, err := deposits.ByIndexPage(db, "unreleased", contractID, nil, 10, &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{depositID}, keys)
+}
+
+func TestBuildTermdepositOwnerIndex(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit")
+
+	depositor := weavetest.NewCondition().Address()
+	deposits := termdeposit.NewDepositBucket()
+	for _, released := range []bool{false, true} {
+		_, err := deposits.Put(db, nil, &termdeposit.Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			Amount:            coin.NewCoin(1, 0, "IOV"),
+			Rate:              weave.Fraction{Numerator: 1, Denominator: 10},
+			Depositor:         depositor,
+			Released:          released,
+			CreatedAt:         1572247483,
+		})
+		assert.Nil(t, err)
+	}
+
+	if _, err := deposits.CountByIndex(db, "owner", depositor); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error before the migration, got %+v", err)
+	}
+
+	assert.Nil(t, buildTermdepositOwnerIndex(context.Background(), db))
+
+	n, err := deposits.CountByIndex(db, "owner", depositor)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
@@ -33,7 +35,7 @@ func decodeProposalOptions(raw []byte) (weave.Msg, error) {
 
 // proposalOptionsExecutor will set up an executor to allow governance-internal actions
 // such a setup can be easily extended to allow many more actions in other modules.
-func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
+func proposalOptionsExecutor(ctrl cash.Controller, scheduler weave.Scheduler) gov.Executor {
 	r := app.NewRouter()
 
 	// we only allow these to be authenticated by the governance context, not by sigs or other items
@@ -42,13 +44,15 @@ func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
 	// Make sure to register for all items in ProposalOptions
 	cash.RegisterRoutes(r, auth, ctrl)
 	validators.RegisterRoutes(r, auth)
+	currency.RegisterRoutes(r, auth, nil)
 	escrow.RegisterRoutes(r, auth, ctrl)
 	distribution.RegisterRoutes(r, auth, ctrl)
 	migration.RegisterRoutes(r, auth)
 	datamigration.RegisterRoutes(r, auth)
-	gov.RegisterBasicProposalRouters(r, auth)
+	gov.RegisterBasicProposalRouters(r, auth, scheduler)
 	msgfee.RegisterRoutes(r, auth)
 	txfee.RegisterRoutes(r, auth)
+	sigs.RegisterRoutes(r, auth)
 	termdeposit.RegisterRoutes(r, auth, ctrl)
 	qualityscore.RegisterRoutes(r, auth)
 	account.RegisterRoutes(r, auth)
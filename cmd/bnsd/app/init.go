@@ -23,6 +23,7 @@ import (
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -85,19 +86,28 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 		dbPath = filepath.Join(options.Home, "bns.db")
 	}
 
-	stack := Stack(nil, options.MinFee)
+	fees := cash.NewFeeSummary()
+	stack := Stack(nil, options.MinFee, fees)
 	application, err := Application("bnsd", stack, TxDecoder, dbPath, options)
 	if err != nil {
 		return nil, err
 	}
+	application = application.WithEndBlockHook(fees.EndBlockHook)
 	return DecorateApp(application, options.Logger), nil
 }
 
+// GenerateHandler returns the transaction handler configured the same way as
+// the one used by the application created by GenerateApp.
+func GenerateHandler(options *server.Options) weave.Handler {
+	return Stack(nil, options.MinFee, nil)
+}
+
 // DecorateApp adds initializers and Logger to an Application
 func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&migration.Initializer{},
 		&multisig.Initializer{},
+		&sigs.Initializer{},
 		&cash.Initializer{},
 		&currency.Initializer{},
 		&validators.Initializer{},
@@ -117,10 +127,13 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 // InlineApp will take a previously prepared CommitStore and return a complete Application
 func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Application {
 	minFee := coin.Coin{}
-	stack := Stack(nil, minFee)
+	fees := cash.NewFeeSummary()
+	stack := Stack(nil, minFee, fees)
 	ctx := context.Background()
-	store := app.NewStoreApp("bnsd", kv, QueryRouter(minFee), ctx)
-	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug)
+	store := app.NewStoreApp("bnsd", kv, QueryRouter(minFee), ctx).
+		WithCommitMetadata(weave.Version, weave.GitCommit)
+	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug).
+		WithEndBlockHook(fees.EndBlockHook)
 	return DecorateApp(base, logger)
 }
 
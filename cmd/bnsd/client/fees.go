@@ -0,0 +1,130 @@
+package client
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/pkg/errors"
+)
+
+// FeeScheduleClient is the subset of the client functionality required to
+// fetch the fee schedule. It is implemented by the BnsClient.
+type FeeScheduleClient interface {
+	AbciQuery(path string, data []byte) (AbciResponse, error)
+	Height() (int64, error)
+}
+
+// FeeSchedule provides access to the message fees set on the blockchain. The
+// whole fee schedule is fetched at once and cached. The cache is invalidated
+// when the chain height advances by the configured interval, therefore a fee
+// change becomes visible with a delay of at most that many blocks.
+type FeeSchedule struct {
+	client   FeeScheduleClient
+	interval int64
+
+	mu     sync.Mutex
+	height int64
+	fees   map[string]coin.Coin
+}
+
+// NewFeeSchedule returns a fee schedule that fetches the fees again once the
+// chain height advances by interval blocks since the last fetch. Interval
+// must be greater than zero.
+func NewFeeSchedule(c FeeScheduleClient, interval int64) *FeeSchedule {
+	if interval < 1 {
+		panic("fee schedule interval must be greater than zero")
+	}
+	return &FeeSchedule{client: c, interval: interval}
+}
+
+// Fee returns the fee of given message path. It returns nil if no fee is set
+// for given message path.
+func (s *FeeSchedule) Fee(msgPath string) (*coin.Coin, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	fee, ok := s.fees[msgPath]
+	if !ok {
+		return nil, nil
+	}
+	return &fee, nil
+}
+
+// MsgFee is a fee set for a single message path.
+type MsgFee struct {
+	MsgPath string
+	Fee     coin.Coin
+}
+
+// All returns all fees of the schedule, ordered by the message path.
+func (s *FeeSchedule) All() ([]MsgFee, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	all := make([]MsgFee, 0, len(s.fees))
+	for path, fee := range s.fees {
+		all = append(all, MsgFee{MsgPath: path, Fee: fee})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].MsgPath < all[j].MsgPath })
+	return all, nil
+}
+
+// Invalidate drops the cached fee schedule, so that it is fetched again on
+// the next use.
+func (s *FeeSchedule) Invalidate() {
+	s.mu.Lock()
+	s.fees = nil
+	s.mu.Unlock()
+}
+
+// refresh fetches the fee schedule if the cached one is missing or outdated.
+// Caller must hold the lock.
+func (s *FeeSchedule) refresh() error {
+	if s.fees != nil {
+		height, err := s.client.Height()
+		if err != nil {
+			return errors.Wrap(err, "cannot get chain height")
+		}
+		if height < s.height+s.interval {
+			return nil
+		}
+	}
+
+	fees := make(map[string]coin.Coin)
+	var height int64
+	var start []byte
+	for {
+		resp, err := s.client.AbciQuery("/msgfee/schedule?range", []byte(hex.EncodeToString(start)))
+		if err != nil {
+			return errors.Wrap(err, "cannot query fee schedule")
+		}
+		if height == 0 {
+			height = resp.Height
+		}
+		if len(resp.Models) == 0 {
+			break
+		}
+		for _, m := range resp.Models {
+			var fee coin.Coin
+			if err := fee.Unmarshal(m.Value); err != nil {
+				return errors.Wrapf(err, "cannot unmarshal fee of %q", string(m.Key))
+			}
+			fees[string(m.Key)] = fee
+		}
+		// Range start is inclusive, so the next page starts right after
+		// the last returned path.
+		last := resp.Models[len(resp.Models)-1].Key
+		start = append(append([]byte{}, last...), 0)
+	}
+	s.fees = fees
+	s.height = height
+	return nil
+}
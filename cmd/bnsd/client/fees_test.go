@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestFeeSchedule(t *testing.T) {
+	c := &feeScheduleClientMock{
+		height: 10,
+		fees: map[string]coin.Coin{
+			"cash/send":    coin.NewCoin(1, 0, "IOV"),
+			"gov/vote":     coin.NewCoin(0, 5, "IOV"),
+			"escrow/close": coin.NewCoin(2, 0, "IOV"),
+		},
+	}
+	s := NewFeeSchedule(c, 5)
+
+	fee, err := s.Fee("cash/send")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(1, 0, "IOV"), *fee)
+	// Each fee is returned on a separate page, followed by an empty one.
+	assert.Equal(t, 4, c.queries)
+
+	fee, err = s.Fee("unknown/path")
+	assert.Nil(t, err)
+	if fee != nil {
+		t.Fatalf("unexpected fee: %v", fee)
+	}
+
+	all, err := s.All()
+	assert.Nil(t, err)
+	assert.Equal(t, []MsgFee{
+		{MsgPath: "cash/send", Fee: coin.NewCoin(1, 0, "IOV")},
+		{MsgPath: "escrow/close", Fee: coin.NewCoin(2, 0, "IOV")},
+		{MsgPath: "gov/vote", Fee: coin.NewCoin(0, 5, "IOV")},
+	}, all)
+	assert.Equal(t, 4, c.queries)
+
+	// Fee change is not visible until the interval passes.
+	c.fees["cash/send"] = coin.NewCoin(7, 0, "IOV")
+	c.height = 14
+	fee, err = s.Fee("cash/send")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(1, 0, "IOV"), *fee)
+
+	c.height = 15
+	fee, err = s.Fee("cash/send")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(7, 0, "IOV"), *fee)
+	assert.Equal(t, 8, c.queries)
+
+	// Invalidation forces the schedule to be fetched again.
+	delete(c.fees, "cash/send")
+	s.Invalidate()
+	fee, err = s.Fee("cash/send")
+	assert.Nil(t, err)
+	if fee != nil {
+		t.Fatalf("unexpected fee: %v", fee)
+	}
+}
+
+// feeScheduleClientMock serves the fee schedule query returning a single fee
+// per page.
+type feeScheduleClientMock struct {
+	height  int64
+	fees    map[string]coin.Coin
+	queries int
+}
+
+func (m *feeScheduleClientMock) Height() (int64, error) {
+	return m.height, nil
+}
+
+func (m *feeScheduleClientMock) AbciQuery(path string, data []byte) (AbciResponse, error) {
+	m.queries++
+	resp := AbciResponse{Height: m.height}
+	if path != "/msgfee/schedule?range" {
+		return resp, nil
+	}
+	start, err := hex.DecodeString(string(data))
+	if err != nil {
+		return resp, err
+	}
+	var next string
+	for p := range m.fees {
+		if p >= string(start) && (next == "" || p < next) {
+			next = p
+		}
+	}
+	if next == "" {
+		return resp, nil
+	}
+	fee := m.fees[next]
+	raw, err := fee.Marshal()
+	if err != nil {
+		return resp, err
+	}
+	resp.Models = []weave.Model{weave.Pair([]byte(next), raw)}
+	return resp, nil
+}
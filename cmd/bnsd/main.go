@@ -34,6 +34,7 @@ func helpMessage() {
 	fmt.Println("start     Run the abci server")
 	fmt.Println("getblock  Extract a block from blockchain.db")
 	fmt.Println("retry     Run last block again to ensure it produces same result")
+	fmt.Println("debug-tx  Execute a transaction against the state of a given height")
 	fmt.Println("testgen   Generate various protoc and json files to test against")
 	fmt.Println("version   Print the app version")
 	fmt.Println(`
@@ -67,6 +68,8 @@ func main() {
 		err = server.GetBlockCmd(rest)
 	case "retry":
 		err = server.RetryCmd(bnsd.InlineApp, logger, *varHome, rest)
+	case "debug-tx":
+		err = server.DebugTxCmd(bnsd.GenerateHandler, bnsd.TxDecoder, filepath.Join(*varHome, "bns.db"), rest)
 	case "testgen":
 		err = commands.TestGenCmd(bnsd.Examples(), rest)
 	case "version":
@@ -3,7 +3,6 @@ package bnsdtest
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -20,6 +19,7 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/commands/server"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/weavejson"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/cron"
@@ -197,7 +197,7 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 		})
 	}
 
-	appState, err := json.MarshalIndent(dict{
+	appState, err := weavejson.MarshalIndent(dict{
 		"cash": []interface{}{
 			dict{
 				"address": env.Alice.PublicKey().Address(),
@@ -289,7 +289,8 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 						"numerator":   1,
 						"denominator": 2,
 					},
-					"electorate_id": 1,
+					"abstain_counts_for_quorum": true,
+					"electorate_id":             1,
 				},
 			},
 		},
@@ -306,7 +307,6 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
 			{"ver": 1, "pkg": "multisig"},
-			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
 			{"ver": 1, "pkg": "username"},
 			{"ver": 1, "pkg": "utils"},
@@ -235,6 +235,9 @@ type Account struct {
 	// an IOV reward initiative, for example. Must be a weave address that starts with a format or hex
 	// for example: bech32:tiov16hzpmhecd65u993lasmexrdlkvhcxtlnf7f4ws.
 	Broker github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=broker,proto3,casttype=github.com/iov-one/weave.Address" json:"broker,omitempty"`
+	// Resources is a list of generic records attached to this account. Each
+	// resource URI can be used only once within an account.
+	Resources []AccountResource `protobuf:"bytes,9,rep,name=resources,proto3" json:"resources"`
 }
 
 func (m *Account) Reset()         { *m = Account{} }
@@ -326,6 +329,13 @@ func (m *Account) GetBroker() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Account) GetResources() []AccountResource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
 // BlockchainAddress represents a blochain address. This structure clubs together
 // blokchain ID together with an address on that network. It is used to point
 // to an address on any blockchain network.
@@ -390,6 +400,64 @@ func (m *BlockchainAddress) GetAddress() string {
 	return ""
 }
 
+// AccountResource is a generic record attached to an account, similar to a
+// DNS resource record. It allows an account to point to any kind of data, for
+// example an URL, a public key or a text.
+type AccountResource struct {
+	// URI identifies the kind of the resource, for example
+	// "https://example.com/profile" or "pubkey:ed25519".
+	URI string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// Resource is the value of the record. Its format is defined by the URI.
+	Resource string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (m *AccountResource) Reset()         { *m = AccountResource{} }
+func (m *AccountResource) String() string { return proto.CompactTextString(m) }
+func (*AccountResource) ProtoMessage()    {}
+func (*AccountResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0cd3fcad09e620, []int{4}
+}
+func (m *AccountResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountResource.Merge(m, src)
+}
+func (m *AccountResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountResource proto.InternalMessageInfo
+
+func (m *AccountResource) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *AccountResource) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
 // Configuration is a dynamic configuration used by this extension, managed by
 // the functionality provided by gconf package.
 type Configuration struct {
@@ -415,13 +483,29 @@ type Configuration struct {
 	// Domain grace period defines the duration of the release duration of a domain. A non-admin
 	// can delete the domain after the grace period ends.
 	DomainGracePeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=domain_grace_period,json=domainGracePeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"domain_grace_period,omitempty"`
+	// Certificate size max defines the maximum size in bytes of a single
+	// account certificate. It cannot be greater than 8KB. Zero value means
+	// that the greatest allowed size is used.
+	CertificateSizeMax int32 `protobuf:"varint,9,opt,name=certificate_size_max,json=certificateSizeMax,proto3" json:"certificate_size_max,omitempty"`
+	// Certificate count max defines the maximum number of certificates that
+	// can be attached to a single account. It cannot be greater than 16. Zero
+	// value means that the greatest allowed count is used.
+	CertificateCountMax int32 `protobuf:"varint,10,opt,name=certificate_count_max,json=certificateCountMax,proto3" json:"certificate_count_max,omitempty"`
+	// Resource size max defines the maximum size in bytes of a single account
+	// resource, including its URI. It cannot be greater than 1KB. Zero value
+	// means that the greatest allowed size is used.
+	ResourceSizeMax int32 `protobuf:"varint,11,opt,name=resource_size_max,json=resourceSizeMax,proto3" json:"resource_size_max,omitempty"`
+	// Resources size max defines the maximum total size in bytes of all
+	// resources attached to a single account. It cannot be greater than 16KB.
+	// Zero value means that the greatest allowed size is used.
+	ResourcesSizeMax int32 `protobuf:"varint,12,opt,name=resources_size_max,json=resourcesSizeMax,proto3" json:"resources_size_max,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{4}
+	return fileDescriptor_8f0cd3fcad09e620, []int{5}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +590,34 @@ func (m *Configuration) GetDomainGracePeriod() github_com_iov_one_weave.UnixDura
 	return 0
 }
 
+func (m *Configuration) GetCertificateSizeMax() int32 {
+	if m != nil {
+		return m.CertificateSizeMax
+	}
+	return 0
+}
+
+func (m *Configuration) GetCertificateCountMax() int32 {
+	if m != nil {
+		return m.CertificateCountMax
+	}
+	return 0
+}
+
+func (m *Configuration) GetResourceSizeMax() int32 {
+	if m != nil {
+		return m.ResourceSizeMax
+	}
+	return 0
+}
+
+func (m *Configuration) GetResourcesSizeMax() int32 {
+	if m != nil {
+		return m.ResourcesSizeMax
+	}
+	return 0
+}
+
 // UpdateConfigurationMsg is used by the gconf extension to update the
 // configuration.
 type UpdateConfigurationMsg struct {
@@ -517,7 +629,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{5}
+	return fileDescriptor_8f0cd3fcad09e620, []int{6}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +699,7 @@ func (m *RegisterDomainMsg) Reset()         { *m = RegisterDomainMsg{} }
 func (m *RegisterDomainMsg) String() string { return proto.CompactTextString(m) }
 func (*RegisterDomainMsg) ProtoMessage()    {}
 func (*RegisterDomainMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{6}
+	return fileDescriptor_8f0cd3fcad09e620, []int{7}
 }
 func (m *RegisterDomainMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,7 +791,7 @@ func (m *ReplaceAccountMsgFeesMsg) Reset()         { *m = ReplaceAccountMsgFeesM
 func (m *ReplaceAccountMsgFeesMsg) String() string { return proto.CompactTextString(m) }
 func (*ReplaceAccountMsgFeesMsg) ProtoMessage()    {}
 func (*ReplaceAccountMsgFeesMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{7}
+	return fileDescriptor_8f0cd3fcad09e620, []int{8}
 }
 func (m *ReplaceAccountMsgFeesMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +855,7 @@ func (m *TransferDomainMsg) Reset()         { *m = TransferDomainMsg{} }
 func (m *TransferDomainMsg) String() string { return proto.CompactTextString(m) }
 func (*TransferDomainMsg) ProtoMessage()    {}
 func (*TransferDomainMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{8}
+	return fileDescriptor_8f0cd3fcad09e620, []int{9}
 }
 func (m *TransferDomainMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +917,7 @@ func (m *RenewDomainMsg) Reset()         { *m = RenewDomainMsg{} }
 func (m *RenewDomainMsg) String() string { return proto.CompactTextString(m) }
 func (*RenewDomainMsg) ProtoMessage()    {}
 func (*RenewDomainMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{9}
+	return fileDescriptor_8f0cd3fcad09e620, []int{10}
 }
 func (m *RenewDomainMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,7 +972,7 @@ func (m *DeleteDomainMsg) Reset()         { *m = DeleteDomainMsg{} }
 func (m *DeleteDomainMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteDomainMsg) ProtoMessage()    {}
 func (*DeleteDomainMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{10}
+	return fileDescriptor_8f0cd3fcad09e620, []int{11}
 }
 func (m *DeleteDomainMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1042,7 @@ func (m *RegisterAccountMsg) Reset()         { *m = RegisterAccountMsg{} }
 func (m *RegisterAccountMsg) String() string { return proto.CompactTextString(m) }
 func (*RegisterAccountMsg) ProtoMessage()    {}
 func (*RegisterAccountMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{11}
+	return fileDescriptor_8f0cd3fcad09e620, []int{12}
 }
 func (m *RegisterAccountMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1131,7 @@ func (m *TransferAccountMsg) Reset()         { *m = TransferAccountMsg{} }
 func (m *TransferAccountMsg) String() string { return proto.CompactTextString(m) }
 func (*TransferAccountMsg) ProtoMessage()    {}
 func (*TransferAccountMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{12}
+	return fileDescriptor_8f0cd3fcad09e620, []int{13}
 }
 func (m *TransferAccountMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1201,7 @@ func (m *ReplaceAccountTargetsMsg) Reset()         { *m = ReplaceAccountTargetsM
 func (m *ReplaceAccountTargetsMsg) String() string { return proto.CompactTextString(m) }
 func (*ReplaceAccountTargetsMsg) ProtoMessage()    {}
 func (*ReplaceAccountTargetsMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{13}
+	return fileDescriptor_8f0cd3fcad09e620, []int{14}
 }
 func (m *ReplaceAccountTargetsMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,6 +1258,76 @@ func (m *ReplaceAccountTargetsMsg) GetNewTargets() []BlockchainAddress {
 	return nil
 }
 
+// ReplaceAccountResourcesMsg is issuing rewrite of all resources attached to
+// given account.
+type ReplaceAccountResourcesMsg struct {
+	Metadata     *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Domain       string            `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Name         string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NewResources []AccountResource `protobuf:"bytes,4,rep,name=new_resources,json=newResources,proto3" json:"new_resources"`
+}
+
+func (m *ReplaceAccountResourcesMsg) Reset()         { *m = ReplaceAccountResourcesMsg{} }
+func (m *ReplaceAccountResourcesMsg) String() string { return proto.CompactTextString(m) }
+func (*ReplaceAccountResourcesMsg) ProtoMessage()    {}
+func (*ReplaceAccountResourcesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0cd3fcad09e620, []int{15}
+}
+func (m *ReplaceAccountResourcesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplaceAccountResourcesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplaceAccountResourcesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplaceAccountResourcesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceAccountResourcesMsg.Merge(m, src)
+}
+func (m *ReplaceAccountResourcesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplaceAccountResourcesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceAccountResourcesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaceAccountResourcesMsg proto.InternalMessageInfo
+
+func (m *ReplaceAccountResourcesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReplaceAccountResourcesMsg) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ReplaceAccountResourcesMsg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReplaceAccountResourcesMsg) GetNewResources() []AccountResource {
+	if m != nil {
+		return m.NewResources
+	}
+	return nil
+}
+
 // DeleteAccountMsg issues deletion of a name that belongs to given domain.
 // Message must be signed by the domain owner.
 type DeleteAccountMsg struct {
@@ -1158,7 +1340,7 @@ func (m *DeleteAccountMsg) Reset()         { *m = DeleteAccountMsg{} }
 func (m *DeleteAccountMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteAccountMsg) ProtoMessage()    {}
 func (*DeleteAccountMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{14}
+	return fileDescriptor_8f0cd3fcad09e620, []int{16}
 }
 func (m *DeleteAccountMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1402,7 @@ func (m *FlushDomainMsg) Reset()         { *m = FlushDomainMsg{} }
 func (m *FlushDomainMsg) String() string { return proto.CompactTextString(m) }
 func (*FlushDomainMsg) ProtoMessage()    {}
 func (*FlushDomainMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{15}
+	return fileDescriptor_8f0cd3fcad09e620, []int{17}
 }
 func (m *FlushDomainMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1458,7 @@ func (m *RenewAccountMsg) Reset()         { *m = RenewAccountMsg{} }
 func (m *RenewAccountMsg) String() string { return proto.CompactTextString(m) }
 func (*RenewAccountMsg) ProtoMessage()    {}
 func (*RenewAccountMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{16}
+	return fileDescriptor_8f0cd3fcad09e620, []int{18}
 }
 func (m *RenewAccountMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +1522,7 @@ func (m *AddAccountCertificateMsg) Reset()         { *m = AddAccountCertificateM
 func (m *AddAccountCertificateMsg) String() string { return proto.CompactTextString(m) }
 func (*AddAccountCertificateMsg) ProtoMessage()    {}
 func (*AddAccountCertificateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{17}
+	return fileDescriptor_8f0cd3fcad09e620, []int{19}
 }
 func (m *AddAccountCertificateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1414,7 +1596,7 @@ func (m *DeleteAccountCertificateMsg) Reset()         { *m = DeleteAccountCertif
 func (m *DeleteAccountCertificateMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteAccountCertificateMsg) ProtoMessage()    {}
 func (*DeleteAccountCertificateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f0cd3fcad09e620, []int{18}
+	return fileDescriptor_8f0cd3fcad09e620, []int{20}
 }
 func (m *DeleteAccountCertificateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,6 +1658,7 @@ func init() {
 	proto.RegisterType((*AccountMsgFee)(nil), "account.AccountMsgFee")
 	proto.RegisterType((*Account)(nil), "account.Account")
 	proto.RegisterType((*BlockchainAddress)(nil), "account.BlockchainAddress")
+	proto.RegisterType((*AccountResource)(nil), "account.AccountResource")
 	proto.RegisterType((*Configuration)(nil), "account.Configuration")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "account.UpdateConfigurationMsg")
 	proto.RegisterType((*RegisterDomainMsg)(nil), "account.RegisterDomainMsg")
@@ -1486,6 +1669,7 @@ func init() {
 	proto.RegisterType((*RegisterAccountMsg)(nil), "account.RegisterAccountMsg")
 	proto.RegisterType((*TransferAccountMsg)(nil), "account.TransferAccountMsg")
 	proto.RegisterType((*ReplaceAccountTargetsMsg)(nil), "account.ReplaceAccountTargetsMsg")
+	proto.RegisterType((*ReplaceAccountResourcesMsg)(nil), "account.ReplaceAccountResourcesMsg")
 	proto.RegisterType((*DeleteAccountMsg)(nil), "account.DeleteAccountMsg")
 	proto.RegisterType((*FlushDomainMsg)(nil), "account.FlushDomainMsg")
 	proto.RegisterType((*RenewAccountMsg)(nil), "account.RenewAccountMsg")
@@ -1496,69 +1680,79 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/x/account/codec.proto", fileDescriptor_8f0cd3fcad09e620) }
 
 var fileDescriptor_8f0cd3fcad09e620 = []byte{
-	// 988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x9b, 0x7f, 0xcd, 0x8b, 0x43, 0x1b, 0x2f, 0x54, 0xa6, 0x88, 0x24, 0x6b, 0x58, 0x29,
-	0x2b, 0x20, 0x91, 0x8a, 0x10, 0x68, 0x85, 0x90, 0x92, 0x96, 0xc2, 0x4a, 0xb4, 0xac, 0x4c, 0xbb,
-	0x12, 0xa7, 0x68, 0x62, 0xbf, 0xd8, 0xa6, 0xc9, 0x4c, 0xe4, 0x71, 0x9a, 0x3d, 0x72, 0xe0, 0x03,
-	0x70, 0xe1, 0xc4, 0x01, 0x21, 0xbe, 0x00, 0x27, 0x6e, 0xdc, 0xf7, 0xb8, 0x47, 0x4e, 0x11, 0x4a,
-	0xf9, 0x14, 0x3d, 0x21, 0xcf, 0x4c, 0x9a, 0xb8, 0x05, 0x84, 0xbb, 0xd9, 0x6a, 0x4f, 0xb1, 0xdf,
-	0xbc, 0xf7, 0xe6, 0x37, 0xef, 0xf7, 0x7b, 0xf3, 0x1c, 0xa8, 0x3a, 0x43, 0xb7, 0xd5, 0xa3, 0xdc,
-	0x6d, 0x3d, 0x69, 0x11, 0xc7, 0x61, 0x63, 0x1a, 0xb5, 0x1c, 0xe6, 0xa2, 0xd3, 0x1c, 0x85, 0x2c,
-	0x62, 0x46, 0x41, 0x19, 0x77, 0x4a, 0x4b, 0xd6, 0x9d, 0x2d, 0x87, 0x05, 0x74, 0xd9, 0x6f, 0xe7,
-	0x55, 0x8f, 0x79, 0x4c, 0x3c, 0xb6, 0xe2, 0x27, 0x69, 0xb5, 0x7e, 0xcf, 0x40, 0x7e, 0x9f, 0x0d,
-	0x49, 0x40, 0x8d, 0x77, 0x60, 0x63, 0x88, 0x11, 0x71, 0x49, 0x44, 0x4c, 0xad, 0xae, 0x35, 0x4a,
-	0xbb, 0x9b, 0xcd, 0x09, 0x92, 0x33, 0x6c, 0x1e, 0x2a, 0xb3, 0x7d, 0xe9, 0x60, 0x6c, 0x43, 0xde,
-	0x15, 0x61, 0xe6, 0x7a, 0x5d, 0x6b, 0x14, 0x6d, 0xf5, 0x66, 0x3c, 0x80, 0x1c, 0x71, 0x87, 0x01,
-	0x35, 0x33, 0x75, 0xad, 0xa1, 0x77, 0xde, 0xbe, 0x98, 0xd6, 0xea, 0x5e, 0x10, 0xf9, 0xe3, 0x5e,
-	0xd3, 0x61, 0xc3, 0x56, 0xc0, 0xce, 0xde, 0x63, 0x14, 0x5b, 0x32, 0x6f, 0xdb, 0x75, 0x43, 0xe4,
-	0xdc, 0x96, 0x21, 0xc6, 0x01, 0x94, 0xce, 0xc8, 0x20, 0x70, 0xbb, 0x63, 0x1a, 0x05, 0x03, 0x33,
-	0x5b, 0xd7, 0x1a, 0x99, 0xce, 0xbd, 0x8b, 0x69, 0xed, 0xee, 0xbf, 0x66, 0x38, 0xa1, 0xc1, 0x93,
-	0xe3, 0x60, 0x88, 0x36, 0x88, 0xc8, 0x93, 0x38, 0xd0, 0x78, 0x0b, 0xca, 0x3e, 0xe1, 0x5d, 0x3e,
-	0x1e, 0x61, 0x38, 0xe6, 0x18, 0x9a, 0xb9, 0xba, 0xd6, 0xd8, 0xb0, 0x75, 0x9f, 0xf0, 0xaf, 0xe6,
-	0x36, 0xe3, 0x43, 0xd8, 0x18, 0x72, 0xaf, 0xdb, 0x47, 0xe4, 0x66, 0xbe, 0x9e, 0x69, 0x94, 0x76,
-	0xb7, 0x9b, 0xaa, 0x92, 0xcd, 0xb6, 0xfc, 0x3d, 0xe4, 0xde, 0x01, 0x62, 0x27, 0xfb, 0x74, 0x5a,
-	0x5b, 0xb3, 0x0b, 0x43, 0xf1, 0xc6, 0x8d, 0x23, 0x28, 0x2b, 0xbf, 0x6e, 0x88, 0x14, 0x27, 0x66,
-	0x41, 0xe0, 0xbc, 0x7f, 0x31, 0xad, 0xdd, 0xfb, 0x4f, 0x9c, 0xfb, 0xe3, 0x90, 0x44, 0x01, 0xa3,
-	0xb6, 0xae, 0xe2, 0xed, 0x38, 0xdc, 0xf8, 0x18, 0xf2, 0xbd, 0x90, 0x9d, 0x62, 0x68, 0x6e, 0xa4,
-	0x28, 0x99, 0x8a, 0xb1, 0x8e, 0xa0, 0x9c, 0x40, 0x6b, 0xbc, 0x2e, 0xcf, 0x35, 0x22, 0x91, 0x2f,
-	0x58, 0x2c, 0x0a, 0xe4, 0x8f, 0x48, 0xe4, 0x1b, 0x16, 0x64, 0xfa, 0x88, 0x82, 0xb0, 0xd2, 0x2e,
-	0x34, 0x63, 0x85, 0x34, 0xf7, 0x58, 0x40, 0xd5, 0x09, 0xe3, 0x45, 0xeb, 0xbb, 0x0c, 0x14, 0x54,
-	0xc2, 0xd5, 0x08, 0xc2, 0x80, 0x2c, 0x25, 0x43, 0x14, 0x7a, 0x28, 0xda, 0xe2, 0x39, 0x16, 0x09,
-	0x9b, 0x50, 0x0c, 0x05, 0xc5, 0xff, 0x5b, 0x24, 0x22, 0xe4, 0xaa, 0x48, 0x72, 0x37, 0x15, 0xc9,
-	0x03, 0x28, 0x44, 0x24, 0xf4, 0x30, 0x9a, 0xd3, 0xbf, 0x73, 0x49, 0x7f, 0x67, 0xc0, 0x9c, 0x53,
-	0xc7, 0x27, 0x01, 0x55, 0x7b, 0xcf, 0x25, 0xa0, 0x02, 0x0c, 0x0b, 0x74, 0x07, 0xc3, 0x28, 0xe8,
-	0x07, 0x0e, 0x89, 0x90, 0x9b, 0x85, 0x7a, 0xa6, 0xa1, 0xdb, 0x09, 0xdb, 0x73, 0xd2, 0xea, 0x42,
-	0xe5, 0x1a, 0x0a, 0xe3, 0x03, 0x28, 0xf7, 0x2e, 0x8d, 0xdd, 0xc0, 0x95, 0xfc, 0x76, 0xb6, 0x66,
-	0xd3, 0x9a, 0xbe, 0xf0, 0x7e, 0xb8, 0x6f, 0xeb, 0x0b, 0xb7, 0x87, 0xae, 0x61, 0x42, 0x81, 0xc8,
-	0x0c, 0x8a, 0x9a, 0xf9, 0xab, 0xf5, 0x57, 0x06, 0xca, 0x7b, 0x8c, 0xf6, 0x03, 0x4f, 0x49, 0x33,
-	0x1d, 0xe5, 0x97, 0x34, 0xae, 0xa7, 0xa7, 0xf1, 0x2e, 0xe8, 0x92, 0x46, 0x25, 0x1a, 0x29, 0x0f,
-	0x49, 0xad, 0xba, 0x8f, 0xde, 0x04, 0xc9, 0x57, 0x57, 0xe8, 0x27, 0x2b, 0x1c, 0x8a, 0xc2, 0x72,
-	0x14, 0x8b, 0xe8, 0x53, 0xb8, 0x23, 0x97, 0x93, 0x35, 0xc9, 0x89, 0x9a, 0xbc, 0x36, 0x9b, 0xd6,
-	0x2a, 0x8f, 0xe3, 0xe5, 0x44, 0x61, 0x2a, 0x67, 0x57, 0x4c, 0xae, 0xf1, 0x11, 0x98, 0xd7, 0xd2,
-	0xcc, 0xcb, 0x95, 0x17, 0x7b, 0x6e, 0x5f, 0x09, 0x9a, 0xd3, 0xf1, 0x05, 0xe8, 0x12, 0xfc, 0x4d,
-	0xef, 0x81, 0x92, 0x0c, 0x97, 0xd7, 0xc0, 0xd7, 0x70, 0x47, 0x65, 0xf3, 0x42, 0xe2, 0x60, 0x77,
-	0x84, 0x61, 0xc0, 0x5c, 0x21, 0x9e, 0x54, 0x49, 0x2b, 0x32, 0xcb, 0x67, 0x71, 0x92, 0x47, 0x22,
-	0x87, 0xc5, 0x61, 0xfb, 0x64, 0xe4, 0x92, 0x08, 0x13, 0x5c, 0x1f, 0x72, 0x2f, 0x1d, 0xdd, 0xef,
-	0x42, 0x6e, 0x44, 0x22, 0xc7, 0x57, 0x17, 0xc8, 0xe2, 0xba, 0x4c, 0xa4, 0xb5, 0xa5, 0x93, 0xf5,
-	0x6d, 0x06, 0x2a, 0x36, 0x7a, 0x01, 0x8f, 0x30, 0x94, 0x84, 0xa6, 0xde, 0xf0, 0x45, 0xcc, 0x98,
-	0x6b, 0xb3, 0x21, 0xfb, 0x0f, 0xb3, 0x61, 0xd1, 0xbb, 0xb9, 0xf4, 0xbd, 0xfb, 0xd2, 0x4c, 0x16,
-	0xeb, 0x27, 0x0d, 0x4c, 0x1b, 0x47, 0x03, 0xe2, 0x60, 0x62, 0x5f, 0xbe, 0x32, 0x26, 0x3e, 0x01,
-	0x9d, 0xe2, 0xa4, 0x9b, 0xea, 0xb8, 0x40, 0x71, 0xa2, 0x70, 0x58, 0x3f, 0x6a, 0x50, 0x39, 0x0e,
-	0x09, 0xe5, 0xfd, 0x95, 0x8b, 0xa4, 0x0d, 0xc5, 0x18, 0x5a, 0x7a, 0xa1, 0x6c, 0x50, 0x9c, 0xb4,
-	0xe3, 0x28, 0xeb, 0x04, 0x5e, 0x11, 0x85, 0x5c, 0x2d, 0x32, 0xeb, 0x31, 0x6c, 0xee, 0xe3, 0x00,
-	0x23, 0x5c, 0x71, 0xde, 0x5f, 0xd6, 0xc1, 0x98, 0x77, 0xdc, 0xa2, 0xf0, 0x2f, 0xe7, 0x14, 0x5f,
-	0x9a, 0xbe, 0xb9, 0xb4, 0xd3, 0x77, 0xd1, 0x9d, 0xf9, 0x1b, 0x4c, 0xd6, 0x5f, 0x35, 0x30, 0xe6,
-	0x92, 0xbb, 0x8d, 0x2a, 0x29, 0x1d, 0xa6, 0xaf, 0x54, 0xac, 0xc3, 0x2f, 0xe3, 0x28, 0xeb, 0xb7,
-	0x6b, 0x7d, 0x7c, 0x2c, 0x4b, 0xb1, 0x72, 0xe0, 0xd9, 0x04, 0xf0, 0x52, 0x0c, 0x3c, 0x2d, 0x4d,
-	0x71, 0x7b, 0x2b, 0x78, 0xd6, 0x29, 0x6c, 0x49, 0xa5, 0xdf, 0x42, 0xa1, 0xe3, 0x6e, 0x3d, 0x18,
-	0x8c, 0xb9, 0xbf, 0xe2, 0xae, 0xfa, 0x06, 0x36, 0xc5, 0x25, 0x70, 0x1b, 0x47, 0xf8, 0x41, 0x03,
-	0xb3, 0xed, 0xba, 0x6a, 0xab, 0xbd, 0xc5, 0xe7, 0xe4, 0x0b, 0x55, 0x68, 0x1d, 0x4a, 0x4b, 0x5f,
-	0xae, 0x52, 0xa3, 0xf6, 0xb2, 0xc9, 0xfa, 0x59, 0x83, 0x37, 0x12, 0x44, 0xde, 0x16, 0xb4, 0xfb,
-	0xb0, 0xb5, 0x84, 0xa3, 0xeb, 0x13, 0xee, 0x2b, 0x7c, 0x9b, 0x4b, 0xf6, 0xcf, 0x09, 0xf7, 0x3b,
-	0xe6, 0xd3, 0x59, 0x55, 0x7b, 0x36, 0xab, 0x6a, 0x7f, 0xce, 0xaa, 0xda, 0xf7, 0xe7, 0xd5, 0xb5,
-	0x67, 0xe7, 0xd5, 0xb5, 0x3f, 0xce, 0xab, 0x6b, 0xbd, 0xbc, 0xf8, 0xa7, 0xfb, 0xfe, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x9c, 0x48, 0xd9, 0x49, 0x0f, 0x00, 0x00,
+	// 1146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xff, 0x9f, 0xd7, 0x38, 0x9e, 0xb4, 0xd1, 0xd6, 0x08, 0xdb, 0x5d, 0xa8, 0xe4,
+	0x42, 0xb1, 0x51, 0x10, 0x02, 0x55, 0x15, 0x52, 0x9c, 0x10, 0x1a, 0x89, 0x84, 0x6a, 0x9b, 0x54,
+	0xe2, 0x64, 0x4d, 0x76, 0x27, 0xde, 0x21, 0xf6, 0xae, 0xb5, 0xb3, 0x8e, 0xa3, 0x9e, 0xf8, 0x08,
+	0x5c, 0x38, 0x71, 0x40, 0x08, 0x3e, 0x00, 0x27, 0x0e, 0x48, 0x5c, 0x38, 0xf5, 0xd8, 0x23, 0x27,
+	0x0b, 0x39, 0xdf, 0x22, 0x27, 0xb4, 0x33, 0xb3, 0xde, 0x75, 0x02, 0x88, 0x4d, 0x9d, 0xa8, 0xa7,
+	0x78, 0xdf, 0xbc, 0xdf, 0x9b, 0xdf, 0xbc, 0xf7, 0x9b, 0x37, 0x4f, 0x81, 0x9a, 0x39, 0xb0, 0xda,
+	0x87, 0x0e, 0xb3, 0xda, 0xa7, 0x6d, 0x6c, 0x9a, 0xee, 0xc8, 0xf1, 0xdb, 0xa6, 0x6b, 0x11, 0xb3,
+	0x35, 0xf4, 0x5c, 0xdf, 0x45, 0x39, 0x69, 0xac, 0x16, 0x63, 0xd6, 0xea, 0x8a, 0xe9, 0x52, 0x27,
+	0xee, 0x57, 0xbd, 0xd5, 0x73, 0x7b, 0x2e, 0xff, 0xd9, 0x0e, 0x7e, 0x09, 0xab, 0xfe, 0x7b, 0x0a,
+	0xb2, 0x5b, 0xee, 0x00, 0x53, 0x07, 0xbd, 0x07, 0xf9, 0x01, 0xf1, 0xb1, 0x85, 0x7d, 0xac, 0x29,
+	0x0d, 0xa5, 0x59, 0x5c, 0x2f, 0xb7, 0xc6, 0x04, 0x9f, 0x90, 0xd6, 0xae, 0x34, 0x1b, 0x33, 0x07,
+	0xb4, 0x06, 0x59, 0x8b, 0xc3, 0xb4, 0xe5, 0x86, 0xd2, 0x2c, 0x18, 0xf2, 0x0b, 0x3d, 0x84, 0x0c,
+	0xb6, 0x06, 0xd4, 0xd1, 0x52, 0x0d, 0xa5, 0xa9, 0x76, 0xde, 0x39, 0x9f, 0xd4, 0x1b, 0x3d, 0xea,
+	0xdb, 0xa3, 0xc3, 0x96, 0xe9, 0x0e, 0xda, 0xd4, 0x3d, 0x79, 0xdf, 0x75, 0x48, 0x5b, 0xc4, 0xdd,
+	0xb0, 0x2c, 0x8f, 0x30, 0x66, 0x08, 0x08, 0xda, 0x86, 0xe2, 0x09, 0xee, 0x53, 0xab, 0x3b, 0x72,
+	0x7c, 0xda, 0xd7, 0xd2, 0x0d, 0xa5, 0x99, 0xea, 0xdc, 0x3b, 0x9f, 0xd4, 0xef, 0xfe, 0x6b, 0x84,
+	0x03, 0x87, 0x9e, 0xee, 0xd3, 0x01, 0x31, 0x80, 0x23, 0x0f, 0x02, 0x20, 0x7a, 0x1b, 0x4a, 0x36,
+	0x66, 0x5d, 0x36, 0x1a, 0x12, 0x6f, 0xc4, 0x88, 0xa7, 0x65, 0x1a, 0x4a, 0x33, 0x6f, 0xa8, 0x36,
+	0x66, 0x4f, 0x43, 0x1b, 0xfa, 0x18, 0xf2, 0x03, 0xd6, 0xeb, 0x1e, 0x11, 0xc2, 0xb4, 0x6c, 0x23,
+	0xd5, 0x2c, 0xae, 0xaf, 0xb5, 0x64, 0x26, 0x5b, 0x1b, 0xe2, 0xef, 0x2e, 0xeb, 0x6d, 0x13, 0xd2,
+	0x49, 0xbf, 0x98, 0xd4, 0x97, 0x8c, 0xdc, 0x80, 0x7f, 0x31, 0xb4, 0x07, 0x25, 0xe9, 0xd7, 0xf5,
+	0x88, 0x43, 0xc6, 0x5a, 0x8e, 0xf3, 0xbc, 0x7f, 0x3e, 0xa9, 0xdf, 0xfb, 0x4f, 0x9e, 0x5b, 0x23,
+	0x0f, 0xfb, 0xd4, 0x75, 0x0c, 0x55, 0xe2, 0x8d, 0x00, 0x8e, 0x1e, 0x41, 0xf6, 0xd0, 0x73, 0x8f,
+	0x89, 0xa7, 0xe5, 0x13, 0xa4, 0x4c, 0x62, 0xf4, 0x3d, 0x28, 0xcd, 0xb1, 0x45, 0x77, 0xc4, 0xb9,
+	0x86, 0xd8, 0xb7, 0x79, 0x15, 0x0b, 0x9c, 0xf9, 0x13, 0xec, 0xdb, 0x48, 0x87, 0xd4, 0x11, 0x21,
+	0xbc, 0x60, 0xc5, 0x75, 0x68, 0x05, 0x0a, 0x69, 0x6d, 0xba, 0xd4, 0x91, 0x27, 0x0c, 0x16, 0xf5,
+	0x3f, 0x52, 0x90, 0x93, 0x01, 0x17, 0x23, 0x08, 0x04, 0x69, 0x07, 0x0f, 0x08, 0xd7, 0x43, 0xc1,
+	0xe0, 0xbf, 0x03, 0x91, 0xb8, 0x63, 0x87, 0x78, 0x5a, 0x3a, 0xc1, 0x89, 0x05, 0xe4, 0xa2, 0x48,
+	0x32, 0x57, 0x15, 0xc9, 0x43, 0xc8, 0xf9, 0xd8, 0xeb, 0x11, 0x3f, 0x2c, 0x7f, 0x75, 0x56, 0xfe,
+	0x4e, 0xdf, 0x35, 0x8f, 0x4d, 0x1b, 0x53, 0x47, 0xee, 0x1d, 0x4a, 0x40, 0x02, 0x90, 0x0e, 0xaa,
+	0x49, 0x3c, 0x9f, 0x1e, 0x51, 0x13, 0xfb, 0x84, 0x69, 0xb9, 0x46, 0xaa, 0xa9, 0x1a, 0x73, 0xb6,
+	0x57, 0x2b, 0x2b, 0x7a, 0x04, 0x05, 0x8f, 0x30, 0x77, 0xe4, 0x99, 0x84, 0x69, 0x05, 0xce, 0x4f,
+	0xbb, 0x28, 0x4f, 0x43, 0x3a, 0x48, 0x76, 0x11, 0x40, 0xb7, 0xa0, 0x72, 0xe9, 0x0c, 0xe8, 0x23,
+	0x28, 0x1d, 0xce, 0x8c, 0x5d, 0x6a, 0x09, 0x75, 0x74, 0x56, 0xa6, 0x93, 0xba, 0x1a, 0x79, 0xef,
+	0x6c, 0x19, 0x6a, 0xe4, 0xb6, 0x63, 0x21, 0x0d, 0x72, 0x58, 0x44, 0x90, 0x85, 0x0d, 0x3f, 0xf5,
+	0xc7, 0x50, 0xbe, 0xc0, 0x04, 0xdd, 0x81, 0xd4, 0xc8, 0xa3, 0x32, 0x72, 0x6e, 0x3a, 0xa9, 0xa7,
+	0x0e, 0x8c, 0x1d, 0x23, 0xb0, 0xa1, 0x2a, 0xe4, 0x43, 0x82, 0x32, 0xd0, 0xec, 0x5b, 0xff, 0x39,
+	0x03, 0xa5, 0x4d, 0xd7, 0x39, 0xa2, 0x3d, 0x79, 0x45, 0x92, 0x49, 0x6f, 0x26, 0xa7, 0xe5, 0xe4,
+	0x72, 0xba, 0x0b, 0xaa, 0x90, 0x93, 0x14, 0xaf, 0x90, 0xa9, 0x90, 0x98, 0xec, 0x8b, 0x6f, 0x81,
+	0xd0, 0x4d, 0x97, 0xeb, 0x38, 0xcd, 0x1d, 0x0a, 0xdc, 0xb2, 0x17, 0x88, 0xf9, 0x33, 0x58, 0x15,
+	0xcb, 0xf3, 0xd9, 0xcd, 0xf0, 0x1c, 0xdc, 0x9e, 0x4e, 0xea, 0x95, 0x67, 0xc1, 0xf2, 0x5c, 0x8a,
+	0x2b, 0x27, 0x17, 0x4c, 0x16, 0xfa, 0x04, 0xb4, 0x4b, 0x61, 0xc2, 0xc4, 0x67, 0xf9, 0x9e, 0x6b,
+	0x17, 0x40, 0x61, 0x61, 0xbf, 0x00, 0x55, 0x90, 0xbf, 0x6a, 0x3f, 0x2a, 0x0a, 0xb8, 0x68, 0x47,
+	0x5f, 0xc1, 0xaa, 0x8c, 0xd6, 0xf3, 0xb0, 0x49, 0xba, 0x43, 0xe2, 0x51, 0xd7, 0xd2, 0xf2, 0x49,
+	0x83, 0x56, 0x44, 0x94, 0xcf, 0x83, 0x20, 0x4f, 0x78, 0x0c, 0xf4, 0x01, 0xdc, 0x8a, 0x5d, 0x91,
+	0x2e, 0xa3, 0xcf, 0x49, 0x77, 0x80, 0x4f, 0xb5, 0x42, 0x43, 0x69, 0x66, 0x0c, 0x14, 0x5b, 0x7b,
+	0x4a, 0x9f, 0x93, 0x5d, 0x7c, 0x8a, 0xd6, 0xe1, 0x76, 0x1c, 0x21, 0xba, 0x6e, 0x00, 0x01, 0x0e,
+	0x59, 0x8d, 0x2d, 0x6e, 0xf2, 0x1e, 0x88, 0x4f, 0xd1, 0xbb, 0x50, 0x09, 0x85, 0x15, 0x6d, 0x51,
+	0xe4, 0xfe, 0xe5, 0x70, 0x21, 0x8c, 0xff, 0x00, 0x50, 0x68, 0x62, 0x91, 0xb3, 0xca, 0x9d, 0x57,
+	0x66, 0x2b, 0xd2, 0x5b, 0x67, 0xb0, 0x76, 0x30, 0xb4, 0xf8, 0x5e, 0x31, 0xad, 0xee, 0xb2, 0x5e,
+	0x32, 0xb9, 0x3e, 0x80, 0xcc, 0x10, 0xfb, 0xa6, 0x2d, 0x1b, 0x71, 0xf4, 0xec, 0xcc, 0x85, 0x35,
+	0x84, 0x93, 0xfe, 0x4d, 0x0a, 0x2a, 0x06, 0xe9, 0x51, 0xe6, 0x13, 0x4f, 0x08, 0x32, 0xf1, 0x86,
+	0xd7, 0xf1, 0x56, 0x5f, 0x7a, 0x63, 0xd3, 0xff, 0xf0, 0xc6, 0x46, 0x3d, 0x30, 0x73, 0x85, 0x1e,
+	0xf8, 0xba, 0xbc, 0xd0, 0xfa, 0x0f, 0x0a, 0x68, 0x06, 0x19, 0xf6, 0xb1, 0x49, 0xe6, 0xf6, 0x65,
+	0x0b, 0xab, 0xc4, 0xa7, 0xa0, 0x3a, 0x64, 0xdc, 0x4d, 0x74, 0x5c, 0x70, 0xc8, 0x58, 0xf2, 0xd0,
+	0xbf, 0x57, 0xa0, 0xb2, 0xef, 0x61, 0x87, 0x1d, 0x2d, 0x5c, 0x24, 0x1b, 0x50, 0x08, 0xa8, 0x25,
+	0x17, 0x4a, 0xde, 0x21, 0xe3, 0x8d, 0x00, 0xa5, 0x1f, 0xc0, 0x1b, 0x3c, 0x91, 0x8b, 0x65, 0xa6,
+	0x3f, 0x83, 0xf2, 0x16, 0xe9, 0x13, 0x9f, 0x2c, 0x38, 0xee, 0x4f, 0xcb, 0x80, 0xc2, 0x1b, 0x17,
+	0x25, 0xfe, 0xf5, 0x9c, 0x86, 0x62, 0x53, 0x4c, 0x26, 0xe9, 0x14, 0x13, 0xdd, 0xce, 0xec, 0x15,
+	0x06, 0xcf, 0x5f, 0x14, 0x40, 0xa1, 0xe4, 0x6e, 0x22, 0x4b, 0x52, 0x87, 0xc9, 0x33, 0x15, 0xe8,
+	0xf0, 0xcb, 0x00, 0xa5, 0xff, 0x7a, 0xe9, 0x1e, 0xef, 0x8b, 0x54, 0x2c, 0x9c, 0x78, 0x7a, 0x8e,
+	0x78, 0x31, 0x20, 0x9e, 0xb4, 0x4c, 0xc1, 0xf5, 0x96, 0xf4, 0xf4, 0xdf, 0x14, 0xa8, 0xce, 0x13,
+	0x0f, 0x27, 0x2e, 0x76, 0xad, 0x39, 0xdf, 0x84, 0x52, 0x40, 0x3d, 0x9a, 0x44, 0xd3, 0xff, 0x6b,
+	0x12, 0x0d, 0x7a, 0xd9, 0x8c, 0xa0, 0x7e, 0x0c, 0x2b, 0xe2, 0x9a, 0xde, 0x80, 0x4a, 0x82, 0x56,
+	0xb3, 0xdd, 0x1f, 0x31, 0x7b, 0xc1, 0x2d, 0xe1, 0x6b, 0x28, 0xf3, 0x0e, 0x76, 0x13, 0x47, 0xf8,
+	0x4e, 0x01, 0x6d, 0xc3, 0xb2, 0xe4, 0x56, 0x9b, 0xd1, 0x84, 0x73, 0xad, 0xa5, 0x6e, 0x40, 0x31,
+	0x36, 0x4c, 0x89, 0x0b, 0x66, 0xc4, 0x4d, 0xfa, 0x8f, 0x0a, 0xbc, 0x39, 0x57, 0xc8, 0x9b, 0xa2,
+	0x76, 0x1f, 0x56, 0xe2, 0x43, 0xa0, 0x8d, 0x99, 0x2d, 0xf9, 0x95, 0x63, 0xf6, 0xc7, 0x98, 0xd9,
+	0x1d, 0xed, 0xc5, 0xb4, 0xa6, 0xbc, 0x9c, 0xd6, 0x94, 0xbf, 0xa6, 0x35, 0xe5, 0xdb, 0xb3, 0xda,
+	0xd2, 0xcb, 0xb3, 0xda, 0xd2, 0x9f, 0x67, 0xb5, 0xa5, 0xc3, 0x2c, 0xff, 0x77, 0xc7, 0x87, 0x7f,
+	0x0f, 0x00, 0x79, 0xf9, 0x03, 0x48, 0x4e, 0x11, 0x00, 0x00,
 }
 
 func (m *Domain) Marshal() (dAtA []byte, err error) {
@@ -1745,6 +1939,18 @@ func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Broker)))
 		i += copy(dAtA[i:], m.Broker)
 	}
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1778,6 +1984,36 @@ func (m *BlockchainAddress) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *AccountResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.URI)))
+		i += copy(dAtA[i:], m.URI)
+	}
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	return i, nil
+}
+
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1843,6 +2079,26 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DomainGracePeriod))
 	}
+	if m.CertificateSizeMax != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CertificateSizeMax))
+	}
+	if m.CertificateCountMax != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CertificateCountMax))
+	}
+	if m.ResourceSizeMax != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ResourceSizeMax))
+	}
+	if m.ResourcesSizeMax != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ResourcesSizeMax))
+	}
 	return i, nil
 }
 
@@ -2273,7 +2529,7 @@ func (m *ReplaceAccountTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteAccountMsg) Marshal() (dAtA []byte, err error) {
+func (m *ReplaceAccountResourcesMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2283,7 +2539,7 @@ func (m *DeleteAccountMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplaceAccountResourcesMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2310,10 +2566,22 @@ func (m *DeleteAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
+	if len(m.NewResources) > 0 {
+		for _, msg := range m.NewResources {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *FlushDomainMsg) Marshal() (dAtA []byte, err error) {
+func (m *DeleteAccountMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2323,7 +2591,7 @@ func (m *FlushDomainMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FlushDomainMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2344,10 +2612,16 @@ func (m *FlushDomainMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Domain)))
 		i += copy(dAtA[i:], m.Domain)
 	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
 	return i, nil
 }
 
-func (m *RenewAccountMsg) Marshal() (dAtA []byte, err error) {
+func (m *FlushDomainMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2357,7 +2631,7 @@ func (m *RenewAccountMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenewAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *FlushDomainMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2378,6 +2652,40 @@ func (m *RenewAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Domain)))
 		i += copy(dAtA[i:], m.Domain)
 	}
+	return i, nil
+}
+
+func (m *RenewAccountMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
+	}
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -2406,11 +2714,11 @@ func (m *AddAccountCertificateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		n19, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if len(m.Domain) > 0 {
 		dAtA[i] = 0x12
@@ -2452,11 +2760,11 @@ func (m *DeleteAccountCertificateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if len(m.Domain) > 0 {
 		dAtA[i] = 0x12
@@ -2584,6 +2892,12 @@ func (m *Account) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2604,6 +2918,23 @@ func (m *BlockchainAddress) Size() (n int) {
 	return n
 }
 
+func (m *AccountResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func (m *Configuration) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2640,6 +2971,18 @@ func (m *Configuration) Size() (n int) {
 	if m.DomainGracePeriod != 0 {
 		n += 1 + sovCodec(uint64(m.DomainGracePeriod))
 	}
+	if m.CertificateSizeMax != 0 {
+		n += 1 + sovCodec(uint64(m.CertificateSizeMax))
+	}
+	if m.CertificateCountMax != 0 {
+		n += 1 + sovCodec(uint64(m.CertificateCountMax))
+	}
+	if m.ResourceSizeMax != 0 {
+		n += 1 + sovCodec(uint64(m.ResourceSizeMax))
+	}
+	if m.ResourcesSizeMax != 0 {
+		n += 1 + sovCodec(uint64(m.ResourcesSizeMax))
+	}
 	return n
 }
 
@@ -2862,6 +3205,33 @@ func (m *ReplaceAccountTargetsMsg) Size() (n int) {
 	return n
 }
 
+func (m *ReplaceAccountResourcesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.NewResources) > 0 {
+		for _, e := range m.NewResources {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DeleteAccountMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3665,6 +4035,40 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				m.Broker = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, AccountResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3806,6 +4210,123 @@ func (m *BlockchainAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Configuration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4071,6 +4592,82 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateSizeMax", wireType)
+			}
+			m.CertificateSizeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertificateSizeMax |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateCountMax", wireType)
+			}
+			m.CertificateCountMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CertificateCountMax |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSizeMax", wireType)
+			}
+			m.ResourceSizeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceSizeMax |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesSizeMax", wireType)
+			}
+			m.ResourcesSizeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesSizeMax |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5663,6 +6260,193 @@ func (m *ReplaceAccountTargetsMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReplaceAccountResourcesMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplaceAccountResourcesMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplaceAccountResourcesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewResources = append(m.NewResources, AccountResource{})
+			if err := m.NewResources[len(m.NewResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeleteAccountMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,6 +10,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegister(2, &Configuration{}, migration.NoModification)
 }
 
 func (c *Configuration) Validate() error {
@@ -31,9 +32,71 @@ func (c *Configuration) Validate() error {
 	if c.DomainRenew <= 0 {
 		errs = errors.AppendField(errs, "DomainRenew", errors.Wrap(errors.ErrInput, "must be greater than zero"))
 	}
+	if c.CertificateSizeMax < 0 || c.CertificateSizeMax > maxCertificateSize {
+		errs = errors.AppendField(errs, "CertificateSizeMax", errors.Wrapf(errors.ErrInput, "must be between 0 and %d", maxCertificateSize))
+	}
+	if c.CertificateCountMax < 0 || c.CertificateCountMax > maxCertificateCount {
+		errs = errors.AppendField(errs, "CertificateCountMax", errors.Wrapf(errors.ErrInput, "must be between 0 and %d", maxCertificateCount))
+	}
+	if c.ResourceSizeMax < 0 || c.ResourceSizeMax > maxResourceSize {
+		errs = errors.AppendField(errs, "ResourceSizeMax", errors.Wrapf(errors.ErrInput, "must be between 0 and %d", maxResourceSize))
+	}
+	if c.ResourcesSizeMax < 0 || c.ResourcesSizeMax > maxResourcesSize {
+		errs = errors.AppendField(errs, "ResourcesSizeMax", errors.Wrapf(errors.ErrInput, "must be between 0 and %d", maxResourcesSize))
+	}
 	return errs
 }
 
+const (
+	// maxCertificateSize is the greatest allowed size in bytes of a single
+	// account certificate.
+	maxCertificateSize = 8 * 1024
+	// maxCertificateCount is the greatest allowed number of certificates
+	// attached to a single account.
+	maxCertificateCount = 16
+	// maxResourceSize is the greatest allowed size in bytes of a single
+	// account resource, including its URI.
+	maxResourceSize = 1024
+	// maxResourcesSize is the greatest allowed total size in bytes of all
+	// resources attached to a single account.
+	maxResourcesSize = 16 * 1024
+)
+
+// certificateSizeMax returns the maximum size of a single account
+// certificate.
+func (c *Configuration) certificateSizeMax() int {
+	if c.CertificateSizeMax == 0 {
+		return maxCertificateSize
+	}
+	return int(c.CertificateSizeMax)
+}
+
+// certificateCountMax returns the maximum number of certificates that can be
+// attached to a single account.
+func (c *Configuration) certificateCountMax() int {
+	if c.CertificateCountMax == 0 {
+		return maxCertificateCount
+	}
+	return int(c.CertificateCountMax)
+}
+
+// resourceSizeMax returns the maximum size of a single account resource.
+func (c *Configuration) resourceSizeMax() int {
+	if c.ResourceSizeMax == 0 {
+		return maxResourceSize
+	}
+	return int(c.ResourceSizeMax)
+}
+
+// resourcesSizeMax returns the maximum total size of all resources attached
+// to a single account.
+func (c *Configuration) resourcesSizeMax() int {
+	if c.ResourcesSizeMax == 0 {
+		return maxResourcesSize
+	}
+	return int(c.ResourcesSizeMax)
+}
+
 // validateRegexp returns an error if provided string is not a valid regular
 // expression.
 // This function ensures that the regular expression is a complete match test
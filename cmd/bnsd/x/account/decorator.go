@@ -5,6 +5,7 @@ import (
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/batch"
 )
 
 // NewAccountMsgFeeDecorator returns a weave decorator that charge additional
@@ -60,12 +61,49 @@ func (d *accountMsgFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore,
 	return res, nil
 }
 
+// msgFee returns the fee of the transaction message, as declared by the
+// domain that the message operates on. For a batch transaction, fees of all
+// batched messages are summed.
 func (d *accountMsgFeeDecorator) msgFee(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*coin.Coin, error) {
 	msg, err := tx.GetMsg()
 	if err != nil {
 		return nil, errors.Wrap(err, "get msg")
 	}
+	msgs := []weave.Msg{msg}
+	if batchMsg, ok := msg.(batch.Msg); ok {
+		inner, err := batchMsg.MsgList()
+		if err != nil {
+			return nil, errors.Wrap(err, "batch messages")
+		}
+		msgs = append(msgs, inner...)
+	}
+
+	var total *coin.Coin
+	for _, m := range msgs {
+		fee, err := d.domainMsgFee(store, m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fee of %q", m.Path())
+		}
+		if coin.IsEmpty(fee) {
+			continue
+		}
+		if total == nil {
+			total = fee.Clone()
+			continue
+		}
+		// Adding ensures all values are the same currency.
+		sum, err := total.Add(*fee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fee of %q", m.Path())
+		}
+		total = &sum
+	}
+	return total, nil
+}
 
+// domainMsgFee returns the fee of a single message, as declared by the domain
+// that the message operates on. Nil is returned if no fee is declared.
+func (d *accountMsgFeeDecorator) domainMsgFee(store weave.KVStore, msg weave.Msg) (*coin.Coin, error) {
 	scopedMsg, ok := msg.(domainScopedMsg)
 	if !ok {
 		return nil, nil
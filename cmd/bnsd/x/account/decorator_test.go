@@ -9,6 +9,7 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/batch"
 )
 
 func TestAccountMsgFeeDecorator(t *testing.T) {
@@ -53,6 +54,55 @@ func TestAccountMsgFeeDecorator(t *testing.T) {
 			},
 			WantCheckFee: coin.NewCoin(3, 0, "IOV"),
 		},
+		"fees of batched messages are summed": {
+			ReqFee: coin.NewCoin(3, 0, "IOV"),
+			Tx: &weavetest.Tx{
+				Msg: &batchMsg{
+					Msg: weavetest.Msg{RoutePath: "batch/execute_batch"},
+					msgs: []weave.Msg{
+						&RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "first",
+							Name:     "myaccount",
+						},
+						&DeleteAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "second",
+							Name:     "myaccount",
+						},
+						&TransferAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "second",
+							Name:     "myaccount",
+							NewOwner: weavetest.NewCondition().Address(),
+						},
+					},
+				},
+			},
+			WantCheckFee: coin.NewCoin(11, 0, "IOV"),
+		},
+		"mixed fee tickers within a batch": {
+			ReqFee: coin.NewCoin(3, 0, "IOV"),
+			Tx: &weavetest.Tx{
+				Msg: &batchMsg{
+					Msg: weavetest.Msg{RoutePath: "batch/execute_batch"},
+					msgs: []weave.Msg{
+						&RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "doge",
+							Name:     "myaccount",
+						},
+						&RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "first",
+							Name:     "myaccount",
+						},
+					},
+				},
+			},
+			WantCheckErr:   errors.ErrCurrency,
+			WantDeliverErr: errors.ErrCurrency,
+		},
 		"mixed fee tickers": {
 			ReqFee: coin.NewCoin(3, 0, "DOGE"),
 			Tx: &weavetest.Tx{
@@ -98,6 +148,18 @@ func TestAccountMsgFeeDecorator(t *testing.T) {
 				t.Fatalf("cannot put domain: %s", err)
 			}
 
+			ddomain := Domain{
+				Metadata: &weave.Metadata{Schema: 1},
+				Domain:   "doge",
+				Admin:    weavetest.NewCondition().Address(),
+				MsgFees: []AccountMsgFee{
+					{MsgPath: "account/register_account", Fee: coin.NewCoin(1, 0, "DOGE")},
+				},
+			}
+			if _, err := NewDomainBucket().Put(db, []byte(ddomain.Domain), &ddomain); err != nil {
+				t.Fatalf("cannot put domain: %s", err)
+			}
+
 			decorator := NewAccountMsgFeeDecorator()
 
 			handler := &weavetest.Handler{
@@ -120,3 +182,14 @@ func TestAccountMsgFeeDecorator(t *testing.T) {
 		})
 	}
 }
+
+type batchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+var _ batch.Msg = (*batchMsg)(nil)
+
+func (m *batchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
@@ -68,6 +68,11 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 		domains:  domains,
 		accounts: accounts,
 	})
+	r.Handle(&ReplaceAccountResourcesMsg{}, &replaceAccountResourcesHandler{
+		auth:     auth,
+		domains:  domains,
+		accounts: accounts,
+	})
 	r.Handle(&DeleteAccountMsg{}, &deleteAccountHandler{
 		auth:     auth,
 		domains:  domains,
@@ -251,6 +256,8 @@ func (h *transferDomainHandler) Deliver(ctx weave.Context, db weave.KVStore, tx
 			a.Certificates = nil
 			// clear account targets
 			a.Targets = nil
+			// clear account resources
+			a.Resources = nil
 			// update account owner
 			a.Owner = msg.NewAdmin
 			// update account key
@@ -628,6 +635,8 @@ func (h *transferAccountHandler) Deliver(ctx weave.Context, db weave.KVStore, tx
 	account.Certificates = nil
 	// reset targets
 	account.Targets = nil
+	// reset resources
+	account.Resources = nil
 	if _, err := h.accounts.Put(db, accountKey(msg.Name, msg.Domain), account); err != nil {
 		return nil, errors.Wrap(err, "cannot store account")
 	}
@@ -723,6 +732,73 @@ func (h *replaceAccountTargetHandler) validate(ctx weave.Context, db weave.KVSto
 	return &account, &msg, nil
 }
 
+// resourcesSchema is the account package schema version that introduced
+// resources.
+const resourcesSchema = 2
+
+type replaceAccountResourcesHandler struct {
+	auth     x.Authenticator
+	domains  orm.ModelBucket
+	accounts orm.ModelBucket
+}
+
+func (h *replaceAccountResourcesHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: 0}, nil
+}
+
+func (h *replaceAccountResourcesHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	account, msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	account.Resources = msg.NewResources
+	if _, err := h.accounts.Put(db, accountKey(msg.Name, msg.Domain), account); err != nil {
+		return nil, errors.Wrap(err, "cannot store account")
+	}
+	return &weave.DeliverResult{Data: nil}, nil
+}
+
+func (h *replaceAccountResourcesHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Account, *ReplaceAccountResourcesMsg, error) {
+	var msg ReplaceAccountResourcesMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	switch ver, err := migration.NewSchemaBucket().CurrentSchema(db, "account"); {
+	case err != nil:
+		return nil, nil, errors.Wrap(err, "cannot get schema version")
+	case ver < resourcesSchema:
+		return nil, nil, errors.Wrapf(errors.ErrSchema, "resources require account schema version %d", resourcesSchema)
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load configuration")
+	}
+	if err := validateResources(msg.NewResources, conf); err != nil {
+		return nil, nil, errors.Field("NewResources", err, "invalid resources")
+	}
+	var domain Domain
+	if err := h.domains.One(db, []byte(msg.Domain), &domain); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot get domain")
+	}
+	if weave.IsExpired(ctx, domain.ValidUntil) {
+		return nil, nil, errors.Wrap(errors.ErrExpired, "cannot update account in an expired domain")
+	}
+	var account Account
+	if err := h.accounts.One(db, accountKey(msg.Name, msg.Domain), &account); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot get account")
+	}
+	if weave.IsExpired(ctx, account.ValidUntil) {
+		return nil, nil, errors.Wrap(errors.ErrExpired, "cannot update an expired account")
+	}
+	if !h.auth.HasAddress(ctx, account.Owner) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "signature required")
+	}
+	return &account, &msg, nil
+}
+
 type deleteAccountHandler struct {
 	auth     x.Authenticator
 	domains  orm.ModelBucket
@@ -896,6 +972,17 @@ func (h *addAccountCertificateHandler) validate(ctx weave.Context, db weave.KVSt
 		return nil, nil, errors.Wrap(errors.ErrDuplicate, "certificate already added")
 	}
 
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load configuration")
+	}
+	if n := len(msg.Certificate); n > conf.certificateSizeMax() {
+		return nil, nil, errors.Wrapf(errors.ErrInput, "certificate too big, max size is %d bytes", conf.certificateSizeMax())
+	}
+	if len(account.Certificates) >= conf.certificateCountMax() {
+		return nil, nil, errors.Wrapf(errors.ErrState, "account cannot have more than %d certificates", conf.certificateCountMax())
+	}
+
 	return &msg, &account, nil
 }
 
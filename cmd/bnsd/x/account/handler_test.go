@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	weave "github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
@@ -284,6 +285,190 @@ func TestUseCases(t *testing.T) {
 				},
 			},
 		},
+		"certificate size and count are limited by the configuration": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata:               &weave.Metadata{Schema: 1},
+								Owner:                  adminCond.Address(),
+								ValidName:              `^[a-z0-9\-_.]{0,64}$`,
+								ValidDomain:            `^[a-z0-9]{3,16}$`,
+								ValidBlockchainID:      `^[a-z0-9]{2,64}$`,
+								ValidBlockchainAddress: `^[a-z0-9]{3,128}$`,
+								DomainRenew:            1000,
+								CertificateCountMax:    17,
+							},
+						},
+					},
+					BlockHeight: 99,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata:               &weave.Metadata{Schema: 1},
+								Owner:                  adminCond.Address(),
+								ValidName:              `^[a-z0-9\-_.]{0,64}$`,
+								ValidDomain:            `^[a-z0-9]{3,16}$`,
+								ValidBlockchainID:      `^[a-z0-9]{2,64}$`,
+								ValidBlockchainAddress: `^[a-z0-9]{3,128}$`,
+								DomainRenew:            1000,
+								CertificateSizeMax:     12,
+								CertificateCountMax:    2,
+							},
+						},
+					},
+					BlockHeight: 99,
+					WantErr:     nil,
+				},
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterDomainMsg{
+							Metadata:     &weave.Metadata{Schema: 1},
+							Domain:       "wunderland",
+							Admin:        aliceCond.Address(),
+							HasSuperuser: true,
+							AccountRenew: 1000,
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Owner:    bobCond.Address(),
+							Domain:   "wunderland",
+							Name:     "bob",
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &AddAccountCertificateMsg{
+							Metadata:    &weave.Metadata{Schema: 1},
+							Domain:      "wunderland",
+							Name:        "bob",
+							Certificate: []byte("a certificate"),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &AddAccountCertificateMsg{
+							Metadata:    &weave.Metadata{Schema: 1},
+							Domain:      "wunderland",
+							Name:        "bob",
+							Certificate: []byte("first cert"),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &AddAccountCertificateMsg{
+							Metadata:    &weave.Metadata{Schema: 1},
+							Domain:      "wunderland",
+							Name:        "bob",
+							Certificate: []byte("second cert"),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 4,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &AddAccountCertificateMsg{
+							Metadata:    &weave.Metadata{Schema: 1},
+							Domain:      "wunderland",
+							Name:        "bob",
+							Certificate: []byte("third cert"),
+						},
+					},
+					BlockHeight: 104,
+					WantErr:     errors.ErrState,
+				},
+				{
+					Now:        now + 5,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DeleteAccountCertificateMsg{
+							Metadata:        &weave.Metadata{Schema: 1},
+							Domain:          "wunderland",
+							Name:            "bob",
+							CertificateHash: checksum256(t, "third cert"),
+						},
+					},
+					BlockHeight: 105,
+					WantErr:     errors.ErrNotFound,
+				},
+				{
+					Now:        now + 6,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DeleteAccountCertificateMsg{
+							Metadata:        &weave.Metadata{Schema: 1},
+							Domain:          "wunderland",
+							Name:            "bob",
+							CertificateHash: checksum256(t, "first cert"),
+						},
+					},
+					BlockHeight: 106,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 7,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &AddAccountCertificateMsg{
+							Metadata:    &weave.Metadata{Schema: 1},
+							Domain:      "wunderland",
+							Name:        "bob",
+							Certificate: []byte("third cert"),
+						},
+					},
+					BlockHeight: 107,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				var a Account
+				if err := NewAccountBucket().One(db, accountKey("bob", "wunderland"), &a); err != nil {
+					t.Fatalf("cannot get bob account: %s", err)
+				}
+				want := [][]byte{[]byte("second cert"), []byte("third cert")}
+				if !reflect.DeepEqual(want, a.Certificates) {
+					t.Fatalf("unexpected certificates: %q", a.Certificates)
+				}
+			},
+		},
 		"domain admin cannot add a certificate to an account in that domain if not an account owner": {
 			Requests: []Request{
 				{
@@ -2630,12 +2815,324 @@ func TestUseCases(t *testing.T) {
 				}
 			},
 		},
+		"an account owner can replace the list of resources": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterDomainMsg{
+							Metadata:     &weave.Metadata{Schema: 1},
+							Domain:       "wunderland",
+							Admin:        aliceCond.Address(),
+							HasSuperuser: true,
+							AccountRenew: 1000,
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							Owner:    bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{aliceCond}, // Domain admin is not the account owner.
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							NewResources: []AccountResource{
+								{URI: "https://example.com", Resource: "bob.example.com"},
+							},
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrUnauthorized,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							NewResources: []AccountResource{
+								{URI: "https://example.com", Resource: "bob.example.com"},
+								{URI: "txt", Resource: "hello"},
+							},
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				accounts := NewAccountBucket()
+				var a Account
+				if err := accounts.One(db, accountKey("bob", "wunderland"), &a); err != nil {
+					t.Fatalf("cannot get wunderland account: %s", err)
+				}
+				assert.Equal(t, a.Resources, []AccountResource{
+					{URI: "https://example.com", Resource: "bob.example.com"},
+					{URI: "txt", Resource: "hello"},
+				})
+
+				var found []Account
+				keys, err := accounts.ByIndex(db, "resource", []byte("bob.example.com"), &found)
+				assert.Nil(t, err)
+				assert.Equal(t, [][]byte{accountKey("bob", "wunderland")}, keys)
+			},
+		},
+		"resource URI must be unique within an account": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterDomainMsg{
+							Metadata:     &weave.Metadata{Schema: 1},
+							Domain:       "wunderland",
+							Admin:        aliceCond.Address(),
+							HasSuperuser: true,
+							AccountRenew: 1000,
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "",
+							NewResources: []AccountResource{
+								{URI: "txt", Resource: "hello"},
+								{URI: "txt", Resource: "world"},
+							},
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     errors.ErrDuplicate,
+				},
+			},
+		},
+		"resource size is limited by the configuration": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata:               &weave.Metadata{Schema: 1},
+								Owner:                  adminCond.Address(),
+								ValidName:              `^[a-z0-9\-_.]{0,64}$`,
+								ValidDomain:            `^[a-z0-9]{3,16}$`,
+								ValidBlockchainID:      `^[a-z0-9]{2,64}$`,
+								ValidBlockchainAddress: `^[a-z0-9]{3,128}$`,
+								DomainRenew:            1000,
+								ResourceSizeMax:        maxResourceSize + 1,
+							},
+						},
+					},
+					BlockHeight: 99,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata:               &weave.Metadata{Schema: 1},
+								Owner:                  adminCond.Address(),
+								ValidName:              `^[a-z0-9\-_.]{0,64}$`,
+								ValidDomain:            `^[a-z0-9]{3,16}$`,
+								ValidBlockchainID:      `^[a-z0-9]{2,64}$`,
+								ValidBlockchainAddress: `^[a-z0-9]{3,128}$`,
+								DomainRenew:            1000,
+								ResourceSizeMax:        10,
+								ResourcesSizeMax:       15,
+							},
+						},
+					},
+					BlockHeight: 99,
+					WantErr:     nil,
+				},
+				{
+					Now:        now,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterDomainMsg{
+							Metadata:     &weave.Metadata{Schema: 1},
+							Domain:       "wunderland",
+							Admin:        aliceCond.Address(),
+							HasSuperuser: true,
+							AccountRenew: 1000,
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "",
+							NewResources: []AccountResource{
+								{URI: "txt", Resource: "12345678"}, // 11 bytes
+							},
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "",
+							NewResources: []AccountResource{
+								{URI: "a", Resource: "1234567"}, // 8 bytes
+								{URI: "b", Resource: "1234567"}, // 8 bytes
+							},
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "",
+							NewResources: []AccountResource{
+								{URI: "a", Resource: "123456789"}, // 10 bytes
+								{URI: "b", Resource: "1234"},      // 5 bytes
+							},
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+			},
+		},
+		"when an account is transferred resources are reset": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterDomainMsg{
+							Metadata:     &weave.Metadata{Schema: 1},
+							Domain:       "wunderland",
+							Admin:        aliceCond.Address(),
+							HasSuperuser: true,
+							AccountRenew: 1000,
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &RegisterAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							Owner:    bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &ReplaceAccountResourcesMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							NewResources: []AccountResource{
+								{URI: "txt", Resource: "hello"},
+							},
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &TransferAccountMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Domain:   "wunderland",
+							Name:     "bob",
+							NewOwner: charlieCond.Address(),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				accounts := NewAccountBucket()
+				var a Account
+				if err := accounts.One(db, accountKey("bob", "wunderland"), &a); err != nil {
+					t.Fatalf("cannot get wunderland account: %s", err)
+				}
+				assert.Equal(t, 0, len(a.Resources))
+
+				var found []Account
+				keys, err := accounts.ByIndex(db, "resource", []byte("hello"), &found)
+				assert.Nil(t, err)
+				assert.Equal(t, 0, len(keys))
+			},
+		},
 	}
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
 			db := store.MemStore()
 			migration.MustInitPkg(db, "account")
+			_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+				Metadata: &weave.Metadata{Schema: 1},
+				Pkg:      "account",
+				Version:  2,
+			})
+			assert.Nil(t, err)
 
 			rt := app.NewRouter()
 			auth := &weavetest.CtxAuth{Key: "auth"}
@@ -2678,6 +3175,101 @@ func TestUseCases(t *testing.T) {
 	}
 }
 
+func TestAccountSchemaMigration(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "account")
+
+	accounts := NewAccountBucket()
+	key := accountKey("bob", "wunderland")
+	_, err := accounts.Put(db, key, &Account{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Domain:     "wunderland",
+		Name:       "bob",
+		ValidUntil: 1000,
+		Resources: []AccountResource{
+			{URI: "txt", Resource: "hello"},
+		},
+	})
+	assert.Nil(t, err)
+
+	_, err = migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "account",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+
+	// Migration must not modify the account content.
+	var migrated Account
+	assert.Nil(t, accounts.One(db, key, &migrated))
+	assert.Equal(t, uint32(2), migrated.Metadata.Schema)
+	assert.Equal(t, []AccountResource{{URI: "txt", Resource: "hello"}}, migrated.Resources)
+}
+
+func TestReplaceAccountResourcesRequiresSchema(t *testing.T) {
+	bobCond := weavetest.NewCondition()
+	now := weave.AsUnixTime(time.Now())
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "account")
+	config := Configuration{
+		Metadata:               &weave.Metadata{Schema: 1},
+		Owner:                  weavetest.NewCondition().Address(),
+		ValidName:              `^[a-z0-9\-_.]{0,64}$`,
+		ValidDomain:            `^[a-z0-9]{3,16}$`,
+		ValidBlockchainID:      `^[a-z0-9]{2,64}$`,
+		ValidBlockchainAddress: `^[a-z0-9]{3,128}$`,
+		DomainRenew:            1000,
+		DomainGracePeriod:      10,
+	}
+	assert.Nil(t, gconf.Save(db, "account", &config))
+	_, err := NewDomainBucket().Put(db, []byte("wunderland"), &Domain{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Domain:     "wunderland",
+		Admin:      bobCond.Address(),
+		ValidUntil: now.Add(time.Hour),
+	})
+	assert.Nil(t, err)
+	_, err = NewAccountBucket().Put(db, accountKey("bob", "wunderland"), &Account{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Domain:     "wunderland",
+		Name:       "bob",
+		Owner:      bobCond.Address(),
+		ValidUntil: now.Add(time.Hour),
+	})
+	assert.Nil(t, err)
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	RegisterRoutes(rt, auth)
+
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+	ctx = auth.SetConditions(ctx, bobCond)
+	tx := &weavetest.Tx{
+		Msg: &ReplaceAccountResourcesMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Domain:   "wunderland",
+			Name:     "bob",
+			NewResources: []AccountResource{
+				{URI: "txt", Resource: "hello"},
+			},
+		},
+	}
+	if _, err := rt.Deliver(ctx, db, tx); !errors.ErrSchema.Is(err) {
+		t.Fatalf("want schema error, got %+v", err)
+	}
+
+	_, err = migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "account",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+	if _, err := rt.Deliver(ctx, db, tx); err != nil {
+		t.Fatalf("cannot replace resources: %+v", err)
+	}
+}
+
 func checksum256(t testing.TB, s string) []byte {
 	t.Helper()
 	sum := sha256.Sum256([]byte(s))
@@ -10,7 +10,30 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisAccounts is the genesis file representation of domains and
+// accounts.
+type genesisAccounts struct {
+	Domains []struct {
+		Domain       string             `json:"domain"`
+		Admin        weave.Address      `json:"admin"`
+		ValidUntil   weave.UnixTime     `json:"valid_until"`
+		AccountRenew weave.UnixDuration `json:"account_renew"`
+		HasSuperuser bool               `json:"has_superuser"`
+	}
+	Accounts []struct {
+		Domain     string         `json:"domain"`
+		Name       string         `json:"name"`
+		Owner      weave.Address  `json:"owner"`
+		ValidUntil weave.UnixTime `json:"valid_until"`
+	}
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
@@ -27,21 +50,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		return errors.Wrap(err, "cannot initialize gconf based configuration")
 	}
 
-	var input struct {
-		Domains []struct {
-			Domain       string             `json:"domain"`
-			Admin        weave.Address      `json:"admin"`
-			ValidUntil   weave.UnixTime     `json:"valid_until"`
-			AccountRenew weave.UnixDuration `json:"account_renew"`
-			HasSuperuser bool               `json:"has_superuser"`
-		}
-		Accounts []struct {
-			Domain     string         `json:"domain"`
-			Name       string         `json:"name"`
-			Owner      weave.Address  `json:"owner"`
-			ValidUntil weave.UnixTime `json:"valid_until"`
-		}
-	}
+	var input genesisAccounts
 	switch err := opts.ReadOptions("account", &input); {
 	case err == nil:
 		// All good.
@@ -80,3 +80,26 @@ func TestGenesisInitializer(t *testing.T) {
 	assert.Equal(t, empty.Name, "")
 	assert.Equal(t, empty.Domain, "first-domain")
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `{
+		"Domains": [
+			{
+				"domain": "wunderland",
+				"admin": "seq:test/alice/1",
+				"valid_until": "2034-11-10T23:00:00Z",
+				"account_renew": "10000s",
+				"has_superuser": true
+			}
+		],
+		"Accounts": [
+			{
+				"domain": "wunderland",
+				"name": "bob",
+				"owner": "0102030405060708090021222324252627282930",
+				"valid_until": 2046639600
+			}
+		]
+	}`
+	assert.JSONRoundTrip(t, []byte(genesis), &genesisAccounts{})
+}
@@ -12,7 +12,12 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Account{}, migration.NoModification)
+	// Resources were introduced with version 2. An account with schema
+	// version 1 cannot have any resource attached, because resources
+	// cannot be replaced before the package schema is upgraded.
+	migration.MustRegister(2, &Account{}, migration.NoModification)
 	migration.MustRegister(1, &Domain{}, migration.NoModification)
+	migration.MustRegister(2, &Domain{}, migration.NoModification)
 }
 
 var _ orm.Model = (*Account)(nil)
@@ -28,16 +33,38 @@ func (a *Account) Validate() error {
 	if a.Broker != nil {
 		errs = errors.AppendField(errs, "Broker", a.Broker.Validate())
 	}
+	errs = errors.AppendField(errs, "Resources", validateResourceURIs(a.Resources))
 	return errs
 }
 
 func NewAccountBucket() orm.ModelBucket {
 	b := orm.NewModelBucket("account", &Account{},
 		orm.WithNativeIndex("owner", accountOwner),
-		orm.WithNativeIndex("domain", accountDomain))
+		orm.WithNativeIndex("domain", accountDomain),
+		// Resource values can be longer than a native index allows, so a
+		// regular index is used.
+		orm.WithIndex("resource", accountResources, false))
 	return migration.NewModelBucket("account", b)
 }
 
+// accountResources indexes an account by the values of all its resources.
+func accountResources(o orm.Object) ([][]byte, error) {
+	a, ok := o.Value().(*Account)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrType, "not an Account")
+	}
+	var values [][]byte
+	seen := make(map[string]struct{}, len(a.Resources))
+	for _, r := range a.Resources {
+		if _, ok := seen[r.Resource]; ok {
+			continue
+		}
+		seen[r.Resource] = struct{}{}
+		values = append(values, []byte(r.Resource))
+	}
+	return values, nil
+}
+
 func accountDomain(o orm.Object) ([][]byte, error) {
 	a, ok := o.Value().(*Account)
 	if !ok {
@@ -162,6 +189,46 @@ func duplicatedBlockchains(bas []BlockchainAddress) []string {
 	return dups
 }
 
+// validateResourceURIs returns an error if any of given resources has no URI
+// or if an URI is used more than once.
+func validateResourceURIs(resources []AccountResource) error {
+	var errs error
+	uris := make(map[string]struct{}, len(resources))
+	for i, r := range resources {
+		if r.URI == "" {
+			errs = errors.AppendField(errs, fmt.Sprintf("%d.URI", i), errors.ErrEmpty)
+			continue
+		}
+		if _, ok := uris[r.URI]; ok {
+			errs = errors.AppendField(errs, fmt.Sprintf("%d.URI", i), errors.Wrap(errors.ErrDuplicate, "not unique"))
+			continue
+		}
+		uris[r.URI] = struct{}{}
+	}
+	return errs
+}
+
+// validateResources returns an error if given list of resources is not a
+// valid account resources state. Size limits are defined in the
+// configuration.
+func validateResources(resources []AccountResource, c *Configuration) error {
+	if err := validateResourceURIs(resources); err != nil {
+		return err
+	}
+	var total int
+	for i, r := range resources {
+		size := len(r.URI) + len(r.Resource)
+		if size > c.resourceSizeMax() {
+			return errors.Field(fmt.Sprint(i), errors.ErrInput, "resource too big, max size is %d bytes", c.resourceSizeMax())
+		}
+		total += size
+	}
+	if total > c.resourcesSizeMax() {
+		return errors.Wrapf(errors.ErrInput, "resources too big, max total size is %d bytes", c.resourcesSizeMax())
+	}
+	return nil
+}
+
 // accountKey returns a bucket wide unique account key.
 func accountKey(name, domain string) []byte {
 	key := make([]byte, 0, len(name)+len(domain)+1)
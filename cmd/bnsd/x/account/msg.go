@@ -9,21 +9,37 @@ import (
 
 func init() {
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(2, &UpdateConfigurationMsg{}, migration.NoModification)
 
 	migration.MustRegister(1, &RegisterDomainMsg{}, migration.NoModification)
+	migration.MustRegister(2, &RegisterDomainMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TransferDomainMsg{}, migration.NoModification)
+	migration.MustRegister(2, &TransferDomainMsg{}, migration.NoModification)
 	migration.MustRegister(1, &RenewDomainMsg{}, migration.NoModification)
+	migration.MustRegister(2, &RenewDomainMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteDomainMsg{}, migration.NoModification)
+	migration.MustRegister(2, &DeleteDomainMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReplaceAccountMsgFeesMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReplaceAccountMsgFeesMsg{}, migration.NoModification)
 	migration.MustRegister(1, &FlushDomainMsg{}, migration.NoModification)
+	migration.MustRegister(2, &FlushDomainMsg{}, migration.NoModification)
 
 	migration.MustRegister(1, &RegisterAccountMsg{}, migration.NoModification)
+	migration.MustRegister(2, &RegisterAccountMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TransferAccountMsg{}, migration.NoModification)
+	migration.MustRegister(2, &TransferAccountMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReplaceAccountTargetsMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReplaceAccountTargetsMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReplaceAccountResourcesMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReplaceAccountResourcesMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteAccountMsg{}, migration.NoModification)
+	migration.MustRegister(2, &DeleteAccountMsg{}, migration.NoModification)
 	migration.MustRegister(1, &RenewAccountMsg{}, migration.NoModification)
+	migration.MustRegister(2, &RenewAccountMsg{}, migration.NoModification)
 	migration.MustRegister(1, &AddAccountCertificateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &AddAccountCertificateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteAccountCertificateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &DeleteAccountCertificateMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
@@ -147,6 +163,22 @@ func (msg *ReplaceAccountTargetsMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*ReplaceAccountResourcesMsg)(nil)
+
+func (ReplaceAccountResourcesMsg) Path() string {
+	return "account/replace_account_resources"
+}
+
+func (msg *ReplaceAccountResourcesMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	errs = errors.AppendField(errs, "Domain", validateDomain(msg.Domain))
+	// Size of NewResources is validated by the handler, because limits
+	// are declared by the Configuration.
+	errs = errors.AppendField(errs, "NewResources", validateResourceURIs(msg.NewResources))
+	return errs
+}
+
 var _ weave.Msg = (*DeleteAccountMsg)(nil)
 
 func (DeleteAccountMsg) Path() string {
@@ -203,7 +235,7 @@ func (msg *AddAccountCertificateMsg) Validate() error {
 	switch n := len(msg.Certificate); {
 	case n == 0:
 		errs = errors.AppendField(errs, "Certificate", errors.ErrEmpty)
-	case n > 10240:
+	case n > maxCertificateSize:
 		errs = errors.AppendField(errs, "Certificate", errors.Wrap(errors.ErrInput, "too big"))
 	}
 	return errs
@@ -0,0 +1,15 @@
+package preregistration
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const conf = `{
+		"metadata": {"schema": 1},
+		"owner": "0102030405060708090021222324252627282930"
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
+}
@@ -0,0 +1,70 @@
+package termdeposit
+
+import (
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+var _ weave.QueryHandler = (*BonusesQuery)(nil)
+
+// BonusesQuery allows querying the whole bonus ladder of each denomination,
+// as declared by the configuration.
+type BonusesQuery struct{}
+
+func NewBonusesQuery() *BonusesQuery {
+	return &BonusesQuery{}
+}
+
+// Query returns a model for each denomination that a bonus ladder is
+// configured for. Model key is the ticker and the value is a serialized
+// DenomBonuses with all bonuses of that denomination, sorted by the lockin
+// period. Expired bonuses are included as well. Models are sorted by the
+// ticker.
+// If data is not empty, it is a ticker and only the ladder of that
+// denomination is returned.
+func (q *BonusesQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	ladders, err := Bonuses(db, string(data))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, 0, len(ladders))
+	for _, l := range ladders {
+		raw, err := l.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal bonuses")
+		}
+		res = append(res, weave.Pair([]byte(l.Denom), raw))
+	}
+	return res, nil
+}
+
+func (q *BonusesQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/termdeposit/bonuses", q)
+}
+
+// Bonuses returns all configured bonus ladders, sorted by the denomination.
+// Bonuses of each ladder are sorted by the lockin period. If ticker is not
+// empty, only the ladder of that denomination is returned.
+func Bonuses(db weave.ReadOnlyKVStore, ticker string) ([]DenomBonuses, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "termdeposit", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	ladders := make([]DenomBonuses, 0, len(conf.Bonuses))
+	for _, b := range conf.Bonuses {
+		if ticker != "" && b.Denom != ticker {
+			continue
+		}
+		bonuses := append([]DepositBonus(nil), b.Bonuses...)
+		sort.Slice(bonuses, func(i, j int) bool { return bonuses[i].LockinPeriod < bonuses[j].LockinPeriod })
+		ladders = append(ladders, DenomBonuses{Denom: b.Denom, Bonuses: bonuses})
+	}
+	sort.Slice(ladders, func(i, j int) bool { return ladders[i].Denom < ladders[j].Denom })
+	return ladders, nil
+}
@@ -0,0 +1,99 @@
+package termdeposit
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBonusesQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	q := qr.Handler("/termdeposit/bonuses")
+
+	// Configuration is required.
+	if _, err := q.Query(store.MemStore(), weave.KeyQueryMod, nil); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	db := store.MemStore()
+	admin := weavetest.NewCondition().Address()
+	config := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    admin,
+		Admin:    admin,
+		Bonuses: []DenomBonuses{
+			{
+				Denom: "IOV",
+				Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(30), Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}, ValidUntil: 1000},
+					{LockinPeriod: asDays(7), Bonus: weave.Fraction{Numerator: 2, Denominator: 10}},
+				},
+			},
+			{
+				Denom: "ETH",
+				Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 100}},
+				},
+			},
+		},
+		InterestMode: InterestMode_SimpleInterest,
+	}
+	if err := gconf.Save(db, "termdeposit", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	eth := DenomBonuses{
+		Denom: "ETH",
+		Bonuses: []DepositBonus{
+			{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 100}},
+		},
+	}
+	iov := DenomBonuses{
+		Denom: "IOV",
+		Bonuses: []DepositBonus{
+			{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}, ValidUntil: 1000},
+			{LockinPeriod: asDays(7), Bonus: weave.Fraction{Numerator: 2, Denominator: 10}},
+			{LockinPeriod: asDays(30), Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
+		},
+	}
+
+	cases := map[string]struct {
+		Ticker string
+		Want   []DenomBonuses
+	}{
+		"all denominations": {
+			Want: []DenomBonuses{eth, iov},
+		},
+		"single denomination": {
+			Ticker: "IOV",
+			Want:   []DenomBonuses{iov},
+		},
+		"no ladder for denomination": {
+			Ticker: "DOGE",
+			Want:   []DenomBonuses{},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := q.Query(db, weave.KeyQueryMod, []byte(tc.Ticker))
+			assert.Nil(t, err)
+
+			got := make([]DenomBonuses, 0, len(models))
+			for _, m := range models {
+				var b DenomBonuses
+				assert.Nil(t, b.Unmarshal(m.Value))
+				assert.Equal(t, b.Denom, string(m.Key))
+				got = append(got, b)
+			}
+			assert.Equal(t, tc.Want, got)
+		})
+	}
+}
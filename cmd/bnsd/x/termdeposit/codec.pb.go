@@ -25,6 +25,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// InterestMode declares how interest is accrued over time.
+type InterestMode int32
+
+const (
+	// Simple interest is computed from the principal only. It is the default.
+	InterestMode_SimpleInterest InterestMode = 0
+	// Compound interest adds the value accrued within each compounding period
+	// to the principal. Value accrued within a not completed period is
+	// computed as simple interest of the compounded principal.
+	InterestMode_CompoundInterest InterestMode = 1
+)
+
+var InterestMode_name = map[int32]string{
+	0: "INTEREST_MODE_SIMPLE",
+	1: "INTEREST_MODE_COMPOUND",
+}
+
+var InterestMode_value = map[string]int32{
+	"INTEREST_MODE_SIMPLE":   0,
+	"INTEREST_MODE_COMPOUND": 1,
+}
+
+func (x InterestMode) String() string {
+	return proto.EnumName(InterestMode_name, int32(x))
+}
+
+func (InterestMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{0}
+}
+
+// RoundingMode declares how a computed value is rounded to a fractional unit.
+type RoundingMode int32
+
+const (
+	// Floor rounding truncates the value. It is the default.
+	RoundingMode_RoundFloor RoundingMode = 0
+	// Ceil rounding rounds any remainder up.
+	RoundingMode_RoundCeil RoundingMode = 1
+	// Half even rounding rounds to the nearest value. A remainder of exactly
+	// one half is rounded to the even value.
+	RoundingMode_RoundHalfEven RoundingMode = 2
+)
+
+var RoundingMode_name = map[int32]string{
+	0: "ROUNDING_MODE_FLOOR",
+	1: "ROUNDING_MODE_CEIL",
+	2: "ROUNDING_MODE_HALF_EVEN",
+}
+
+var RoundingMode_value = map[string]int32{
+	"ROUNDING_MODE_FLOOR":     0,
+	"ROUNDING_MODE_CEIL":      1,
+	"ROUNDING_MODE_HALF_EVEN": 2,
+}
+
+func (x RoundingMode) String() string {
+	return proto.EnumName(RoundingMode_name, int32(x))
+}
+
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{1}
+}
+
 // DepositContract is an entity created in order to allow investment deposits.
 // Anyone can deposit funds and therefore sign a deposit contract in order to
 // lock funds and receive appropriate interest after the contract expires.
@@ -35,6 +98,11 @@ type DepositContract struct {
 	// An expiration date for this deposit contract. After this deadline, all
 	// depositor funds are released and deposit contract is no longer active.
 	ValidUntil github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=valid_until,json=validUntil,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_until,omitempty"`
+	// Successor ID is the ID of the contract created as the successor of this
+	// one. Funds of a rollover deposit of this contract are deposited within
+	// the successor contract when released, if it still accepts deposits. Empty
+	// if there is no successor.
+	SuccessorID []byte `protobuf:"bytes,4,opt,name=successor_id,json=successorId,proto3" json:"successor_id,omitempty"`
 }
 
 func (m *DepositContract) Reset()         { *m = DepositContract{} }
@@ -91,6 +159,13 @@ func (m *DepositContract) GetValidUntil() github_com_iov_one_weave.UnixTime {
 	return 0
 }
 
+func (m *DepositContract) GetSuccessorID() []byte {
+	if m != nil {
+		return m.SuccessorID
+	}
+	return nil
+}
+
 // Deposit represents a single fund deposition. Deposited funds are locked
 // until the contract expiration.
 type Deposit struct {
@@ -103,7 +178,9 @@ type Deposit struct {
 	// to increase the value of a deposit wallet only shortly before the
 	// computation.
 	Amount coin.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	// Pro-rated interest rate as detailed in the Confluence spec.
+	// Pro-rated interest rate as detailed in the Confluence spec. For a deposit
+	// that was topped up, this is the amount weighted average of the rates of
+	// all contributions.
 	Rate weave.Fraction `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate"`
 	// Payback is an address that locked funds and interest are send back to once
 	// the contract expires.
@@ -113,6 +190,18 @@ type Deposit struct {
 	Released bool `protobuf:"varint,6,opt,name=released,proto3" json:"released,omitempty"`
 	// CreatedAt is set to the wall clock value at the deposit creation time.
 	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+	// Post maturity accrual is the additional amount accrued between the
+	// contract maturity and the release of the funds, within the configured
+	// grace period. It is set when the deposit is released and, as any other
+	// interest, paid offchain.
+	PostMaturityAccrual coin.Coin `protobuf:"bytes,8,opt,name=post_maturity_accrual,json=postMaturityAccrual,proto3" json:"post_maturity_accrual"`
+	// Rollover flag declares that once released, the deposited amount should
+	// be deposited again within the successor of the deposit contract instead
+	// of being paid out.
+	Rollover bool `protobuf:"varint,9,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	// Predecessor ID is the ID of the deposit that this deposit was rolled
+	// over from. Empty for a deposit created by a DepositMsg.
+	PredecessorID []byte `protobuf:"bytes,10,opt,name=predecessor_id,json=predecessorId,proto3" json:"predecessor_id,omitempty"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
@@ -197,6 +286,91 @@ func (m *Deposit) GetCreatedAt() github_com_iov_one_weave.UnixTime {
 	return 0
 }
 
+func (m *Deposit) GetPostMaturityAccrual() coin.Coin {
+	if m != nil {
+		return m.PostMaturityAccrual
+	}
+	return coin.Coin{}
+}
+
+func (m *Deposit) GetRollover() bool {
+	if m != nil {
+		return m.Rollover
+	}
+	return false
+}
+
+func (m *Deposit) GetPredecessorID() []byte {
+	if m != nil {
+		return m.PredecessorID
+	}
+	return nil
+}
+
+// SweepCounter counts deposits released by SweepDepositsMsg messages within a
+// single block. Only the counter of the most recent block is stored.
+type SweepCounter struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Height of the block that the deposits were released in.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Count is the number of deposits released within that block.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *SweepCounter) Reset()         { *m = SweepCounter{} }
+func (m *SweepCounter) String() string { return proto.CompactTextString(m) }
+func (*SweepCounter) ProtoMessage()    {}
+func (*SweepCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{2}
+}
+func (m *SweepCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepCounter.Merge(m, src)
+}
+func (m *SweepCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepCounter proto.InternalMessageInfo
+
+func (m *SweepCounter) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SweepCounter) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SweepCounter) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // Configuration is a dynamic configuration used by this extension, managed by
 // the functionality provided by gconf package.
 type Configuration struct {
@@ -206,17 +380,46 @@ type Configuration struct {
 	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	// Admin is an address that is able to create deposit contracts.
 	Admin github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=admin,proto3,casttype=github.com/iov-one/weave.Address" json:"admin,omitempty"`
-	// A list of bonus values applied to each created Deposit instance.
-	Bonuses []DepositBonus `protobuf:"bytes,4,rep,name=bonuses,proto3" json:"bonuses"`
 	// Base rates defines a list of addresses that have their q-score value fixed.
 	BaseRates []CustomRate `protobuf:"bytes,5,rep,name=base_rates,json=baseRates,proto3" json:"base_rates"`
+	// Bonuses is a list of bonus ladders, each applied to deposits of a single
+	// denomination. Deposits made in a denomination without a declared ladder
+	// receive no bonus.
+	Bonuses []DenomBonuses `protobuf:"bytes,6,rep,name=bonuses,proto3" json:"bonuses"`
+	// Post maturity grace is the duration after the contract maturity during
+	// which a not yet released deposit keeps accruing. Zero value means that
+	// accrual stops at the maturity.
+	PostMaturityGrace github_com_iov_one_weave.UnixDuration `protobuf:"varint,7,opt,name=post_maturity_grace,json=postMaturityGrace,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"post_maturity_grace,omitempty"`
+	// Post maturity rate is the part of the deposited amount that is accrued
+	// over the whole post maturity grace period. A deposit released before the
+	// grace period ends accrues a pro-rated value. Zero value means that
+	// nothing is accrued.
+	PostMaturityRate weave.Fraction `protobuf:"bytes,8,opt,name=post_maturity_rate,json=postMaturityRate,proto3" json:"post_maturity_rate"`
+	// Interest mode declares how the post maturity accrual is computed.
+	InterestMode InterestMode `protobuf:"varint,9,opt,name=interest_mode,json=interestMode,proto3,enum=termdeposit.InterestMode" json:"interest_mode,omitempty"`
+	// Compounding period is the duration after which the accrued value is
+	// added to the principal. It is required by the compound interest mode and
+	// must divide the post maturity grace period into a limited number of
+	// periods. It must not be set in the simple interest mode.
+	CompoundingPeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,10,opt,name=compounding_period,json=compoundingPeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"compounding_period,omitempty"`
+	// Auto sweep after is the duration after the contract maturity, after
+	// which not yet released deposits of that contract can be released by
+	// anyone using SweepDepositsMsg. Zero value disables sweeping.
+	AutoSweepAfter github_com_iov_one_weave.UnixDuration `protobuf:"varint,11,opt,name=auto_sweep_after,json=autoSweepAfter,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"auto_sweep_after,omitempty"`
+	// Max deposits per address is the greatest number of not released
+	// deposits that a single depositor can hold at the same time. Zero value
+	// means no limit.
+	MaxDepositsPerAddress uint32 `protobuf:"varint,12,opt,name=max_deposits_per_address,json=maxDepositsPerAddress,proto3" json:"max_deposits_per_address,omitempty"`
+	// Rounding mode declares how each partial result of the post maturity
+	// accrual computation is rounded to a fractional unit.
+	RoundingMode RoundingMode `protobuf:"varint,13,opt,name=rounding_mode,json=roundingMode,proto3,enum=termdeposit.RoundingMode" json:"rounding_mode,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{2}
+	return fileDescriptor_a75d003f77d30257, []int{3}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,16 +469,169 @@ func (m *Configuration) GetAdmin() github_com_iov_one_weave.Address {
 	return nil
 }
 
-func (m *Configuration) GetBonuses() []DepositBonus {
+func (m *Configuration) GetBaseRates() []CustomRate {
+	if m != nil {
+		return m.BaseRates
+	}
+	return nil
+}
+
+func (m *Configuration) GetBonuses() []DenomBonuses {
 	if m != nil {
 		return m.Bonuses
 	}
 	return nil
 }
 
-func (m *Configuration) GetBaseRates() []CustomRate {
+func (m *Configuration) GetPostMaturityGrace() github_com_iov_one_weave.UnixDuration {
 	if m != nil {
-		return m.BaseRates
+		return m.PostMaturityGrace
+	}
+	return 0
+}
+
+func (m *Configuration) GetPostMaturityRate() weave.Fraction {
+	if m != nil {
+		return m.PostMaturityRate
+	}
+	return weave.Fraction{}
+}
+
+func (m *Configuration) GetInterestMode() InterestMode {
+	if m != nil {
+		return m.InterestMode
+	}
+	return InterestMode_SimpleInterest
+}
+
+func (m *Configuration) GetCompoundingPeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.CompoundingPeriod
+	}
+	return 0
+}
+
+func (m *Configuration) GetAutoSweepAfter() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.AutoSweepAfter
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxDepositsPerAddress() uint32 {
+	if m != nil {
+		return m.MaxDepositsPerAddress
+	}
+	return 0
+}
+
+func (m *Configuration) GetRoundingMode() RoundingMode {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return RoundingMode_RoundFloor
+}
+
+// LegacyConfiguration declares the Configuration fields that were removed.
+// It is used only to read a configuration stored before the removal, so that
+// it can be migrated.
+type LegacyConfiguration struct {
+	// A list of bonus values applied to each created Deposit instance,
+	// regardless of the deposited denomination.
+	Bonuses []DepositBonus `protobuf:"bytes,4,rep,name=bonuses,proto3" json:"bonuses"`
+}
+
+func (m *LegacyConfiguration) Reset()         { *m = LegacyConfiguration{} }
+func (m *LegacyConfiguration) String() string { return proto.CompactTextString(m) }
+func (*LegacyConfiguration) ProtoMessage()    {}
+func (*LegacyConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{4}
+}
+func (m *LegacyConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyConfiguration.Merge(m, src)
+}
+func (m *LegacyConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyConfiguration proto.InternalMessageInfo
+
+func (m *LegacyConfiguration) GetBonuses() []DepositBonus {
+	if m != nil {
+		return m.Bonuses
+	}
+	return nil
+}
+
+// DenomBonuses is a list of bonus values applied to each created Deposit
+// instance of a given denomination.
+type DenomBonuses struct {
+	// Denom is the ticker of the deposited tokens that this ladder applies to.
+	Denom   string         `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Bonuses []DepositBonus `protobuf:"bytes,2,rep,name=bonuses,proto3" json:"bonuses"`
+}
+
+func (m *DenomBonuses) Reset()         { *m = DenomBonuses{} }
+func (m *DenomBonuses) String() string { return proto.CompactTextString(m) }
+func (*DenomBonuses) ProtoMessage()    {}
+func (*DenomBonuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{5}
+}
+func (m *DenomBonuses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBonuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBonuses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBonuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBonuses.Merge(m, src)
+}
+func (m *DenomBonuses) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBonuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBonuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBonuses proto.InternalMessageInfo
+
+func (m *DenomBonuses) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomBonuses) GetBonuses() []DepositBonus {
+	if m != nil {
+		return m.Bonuses
 	}
 	return nil
 }
@@ -290,7 +646,7 @@ func (m *CustomRate) Reset()         { *m = CustomRate{} }
 func (m *CustomRate) String() string { return proto.CompactTextString(m) }
 func (*CustomRate) ProtoMessage()    {}
 func (*CustomRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{3}
+	return fileDescriptor_a75d003f77d30257, []int{6}
 }
 func (m *CustomRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,13 +696,17 @@ type DepositBonus struct {
 	LockinPeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,1,opt,name=lockin_period,json=lockinPeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"lockin_period,omitempty"`
 	// Bonus rate for given range.
 	Bonus weave.Fraction `protobuf:"bytes,2,opt,name=bonus,proto3" json:"bonus"`
+	// Optional expiration time of this bonus. An expired bonus is not used
+	// when computing the rate of a new deposit. Zero value means that the
+	// bonus never expires.
+	ValidUntil github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=valid_until,json=validUntil,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_until,omitempty"`
 }
 
 func (m *DepositBonus) Reset()         { *m = DepositBonus{} }
 func (m *DepositBonus) String() string { return proto.CompactTextString(m) }
 func (*DepositBonus) ProtoMessage()    {}
 func (*DepositBonus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{4}
+	return fileDescriptor_a75d003f77d30257, []int{7}
 }
 func (m *DepositBonus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +749,13 @@ func (m *DepositBonus) GetBonus() weave.Fraction {
 	return weave.Fraction{}
 }
 
+func (m *DepositBonus) GetValidUntil() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
 // CreateDepositContractMsg creates a new DepositContract entity. This message
 // must be signed by the admin as configured via the Configuration entity.
 type CreateDepositContractMsg struct {
@@ -397,13 +764,19 @@ type CreateDepositContractMsg struct {
 	ValidSince github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=valid_since,json=validSince,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_since,omitempty"`
 	// An expiration date for the newly created deposit contract.
 	ValidUntil github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=valid_until,json=validUntil,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_until,omitempty"`
+	// Predecessor ID is an optional ID of an existing contract that the newly
+	// created contract succeeds. Rollover deposits of the predecessor are
+	// deposited within the new contract when released. The predecessor must
+	// not have a successor yet and the new contract must be active at the
+	// predecessor maturity.
+	PredecessorID []byte `protobuf:"bytes,4,opt,name=predecessor_id,json=predecessorId,proto3" json:"predecessor_id,omitempty"`
 }
 
 func (m *CreateDepositContractMsg) Reset()         { *m = CreateDepositContractMsg{} }
 func (m *CreateDepositContractMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateDepositContractMsg) ProtoMessage()    {}
 func (*CreateDepositContractMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{5}
+	return fileDescriptor_a75d003f77d30257, []int{8}
 }
 func (m *CreateDepositContractMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +826,13 @@ func (m *CreateDepositContractMsg) GetValidUntil() github_com_iov_one_weave.Unix
 	return 0
 }
 
+func (m *CreateDepositContractMsg) GetPredecessorID() []byte {
+	if m != nil {
+		return m.PredecessorID
+	}
+	return nil
+}
+
 // DepositMsg can be send by anyone to deposit funds within a non expired
 // contract. Funds will stay locked until that contract expiration date.
 type DepositMsg struct {
@@ -465,13 +845,22 @@ type DepositMsg struct {
 	// Payback is an address that locked funds and interest are send back to once
 	// the contract expires.
 	Depositor github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=depositor,proto3,casttype=github.com/iov-one/weave.Address" json:"depositor,omitempty"`
+	// Nonce is an optional value chosen by the client. When set, the deposit
+	// ID is derived from the message content instead of the sequence, so that
+	// resubmitting the same message is rejected as a duplicate instead of
+	// creating another deposit.
+	Nonce []byte `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Rollover flag declares that once released, the deposited amount should
+	// be deposited again within the successor of the deposit contract, if that
+	// contract still accepts deposits. Otherwise the funds are paid out.
+	Rollover bool `protobuf:"varint,6,opt,name=rollover,proto3" json:"rollover,omitempty"`
 }
 
 func (m *DepositMsg) Reset()         { *m = DepositMsg{} }
 func (m *DepositMsg) String() string { return proto.CompactTextString(m) }
 func (*DepositMsg) ProtoMessage()    {}
 func (*DepositMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{6}
+	return fileDescriptor_a75d003f77d30257, []int{9}
 }
 func (m *DepositMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,6 +917,20 @@ func (m *DepositMsg) GetDepositor() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *DepositMsg) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *DepositMsg) GetRollover() bool {
+	if m != nil {
+		return m.Rollover
+	}
+	return false
+}
+
 // ReleaseDepositMsg cause releasing of all funds allocated within given
 // deposit. Related contract must be expired. Anyone can submit this message.
 type ReleaseDepositMsg struct {
@@ -540,7 +943,7 @@ func (m *ReleaseDepositMsg) Reset()         { *m = ReleaseDepositMsg{} }
 func (m *ReleaseDepositMsg) String() string { return proto.CompactTextString(m) }
 func (*ReleaseDepositMsg) ProtoMessage()    {}
 func (*ReleaseDepositMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{7}
+	return fileDescriptor_a75d003f77d30257, []int{10}
 }
 func (m *ReleaseDepositMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,23 +986,31 @@ func (m *ReleaseDepositMsg) GetDepositID() []byte {
 	return nil
 }
 
-type UpdateConfigurationMsg struct {
+// TopUpDepositMsg increases the amount of funds locked by an existing deposit.
+// Deposit rate is recomputed as the amount weighted average of the current
+// deposit rate and the rate of a new deposit locked for the remaining period.
+// Related contract must not be expired and the deposit must not be released.
+type TopUpDepositMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	// ID of the deposit that is to be topped up.
+	DepositID []byte `protobuf:"bytes,2,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	// Amount that is added to the deposit. Must be of the same denomination as
+	// the deposit. Funds are withdrawn from the depositor account.
+	Amount coin.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
-func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
-func (*UpdateConfigurationMsg) ProtoMessage()    {}
-func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a75d003f77d30257, []int{8}
+func (m *TopUpDepositMsg) Reset()         { *m = TopUpDepositMsg{} }
+func (m *TopUpDepositMsg) String() string { return proto.CompactTextString(m) }
+func (*TopUpDepositMsg) ProtoMessage()    {}
+func (*TopUpDepositMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{11}
 }
-func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+func (m *TopUpDepositMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopUpDepositMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopUpDepositMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -609,94 +1020,264 @@ func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+func (m *TopUpDepositMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpDepositMsg.Merge(m, src)
 }
-func (m *UpdateConfigurationMsg) XXX_Size() int {
+func (m *TopUpDepositMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+func (m *TopUpDepositMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpDepositMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+var xxx_messageInfo_TopUpDepositMsg proto.InternalMessageInfo
 
-func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+func (m *TopUpDepositMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+func (m *TopUpDepositMsg) GetDepositID() []byte {
 	if m != nil {
-		return m.Patch
+		return m.DepositID
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*DepositContract)(nil), "termdeposit.DepositContract")
-	proto.RegisterType((*Deposit)(nil), "termdeposit.Deposit")
-	proto.RegisterType((*Configuration)(nil), "termdeposit.Configuration")
-	proto.RegisterType((*CustomRate)(nil), "termdeposit.CustomRate")
-	proto.RegisterType((*DepositBonus)(nil), "termdeposit.DepositBonus")
-	proto.RegisterType((*CreateDepositContractMsg)(nil), "termdeposit.CreateDepositContractMsg")
-	proto.RegisterType((*DepositMsg)(nil), "termdeposit.DepositMsg")
-	proto.RegisterType((*ReleaseDepositMsg)(nil), "termdeposit.ReleaseDepositMsg")
-	proto.RegisterType((*UpdateConfigurationMsg)(nil), "termdeposit.UpdateConfigurationMsg")
+func (m *TopUpDepositMsg) GetAmount() coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return coin.Coin{}
 }
 
-func init() { proto.RegisterFile("cmd/bnsd/x/termdeposit/codec.proto", fileDescriptor_a75d003f77d30257) }
-
-var fileDescriptor_a75d003f77d30257 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xba, 0x76, 0x5d, 0x5f, 0x37, 0x8d, 0x99, 0x5f, 0xa1, 0x87, 0xb6, 0x44, 0x4c, 0xea,
-	0x34, 0x48, 0xd0, 0x38, 0x81, 0x10, 0xd2, 0xd2, 0x32, 0x69, 0x87, 0x21, 0x14, 0xd8, 0xb9, 0x72,
-	0x63, 0xd3, 0x59, 0x34, 0x76, 0x15, 0xbb, 0xeb, 0xfe, 0x07, 0x2e, 0xfc, 0x23, 0xfc, 0x1f, 0x3b,
-	0xa1, 0xdd, 0xe0, 0x54, 0xa1, 0xee, 0xce, 0x19, 0xed, 0x84, 0xe2, 0xb8, 0x5d, 0x3b, 0x89, 0xd1,
-	0xee, 0x80, 0xc4, 0x2d, 0xb6, 0xbf, 0xef, 0xf9, 0x7d, 0xdf, 0x7b, 0x7e, 0x01, 0x27, 0x8c, 0x88,
-	0xd7, 0xe6, 0x92, 0x78, 0x27, 0x9e, 0xa2, 0x71, 0x44, 0x68, 0x4f, 0x48, 0xa6, 0xbc, 0x50, 0x10,
-	0x1a, 0xba, 0xbd, 0x58, 0x28, 0x81, 0x4a, 0x53, 0x07, 0xe5, 0xd2, 0xd4, 0x49, 0xf9, 0x56, 0x28,
-	0x18, 0x9f, 0xc6, 0x96, 0xef, 0x74, 0x44, 0x47, 0xe8, 0x4f, 0x2f, 0xf9, 0x4a, 0x77, 0x9d, 0xaf,
-	0x16, 0xac, 0x37, 0xd3, 0x00, 0x0d, 0xc1, 0x55, 0x8c, 0x43, 0x85, 0xb6, 0x61, 0x25, 0xa2, 0x0a,
-	0x13, 0xac, 0xb0, 0x6d, 0xd5, 0xac, 0x7a, 0x69, 0x67, 0xdd, 0x1d, 0x50, 0x7c, 0x4c, 0xdd, 0x03,
-	0xb3, 0x1d, 0x4c, 0x00, 0x68, 0x0f, 0x4a, 0xc7, 0xb8, 0xcb, 0x48, 0x4b, 0x32, 0x1e, 0x52, 0x3b,
-	0x5b, 0xb3, 0xea, 0x4b, 0xfe, 0xe6, 0xc5, 0xb0, 0xfa, 0xb0, 0xc3, 0xd4, 0x51, 0xbf, 0xed, 0x86,
-	0x22, 0xf2, 0x98, 0x38, 0x7e, 0x22, 0x38, 0xf5, 0xd2, 0x28, 0x87, 0x9c, 0x9d, 0xbc, 0x67, 0x11,
-	0x0d, 0x40, 0x33, 0xdf, 0x25, 0xc4, 0xcb, 0x38, 0x7d, 0xae, 0x58, 0xd7, 0x5e, 0x5a, 0x3c, 0xce,
-	0x61, 0x42, 0x74, 0x7e, 0x65, 0xa1, 0x60, 0x04, 0x2d, 0x26, 0xe4, 0x35, 0xdc, 0x36, 0x4e, 0xb6,
-	0x42, 0xe3, 0x44, 0x8b, 0x11, 0x2d, 0x68, 0xd5, 0xbf, 0x3b, 0x1a, 0x56, 0x37, 0xae, 0xf8, 0xb4,
-	0xdf, 0x0c, 0x36, 0xc8, 0x95, 0x2d, 0x82, 0xea, 0xb0, 0x8c, 0x23, 0xd1, 0xe7, 0x4a, 0x4b, 0x28,
-	0xed, 0x80, 0x9b, 0x54, 0xc2, 0x6d, 0x08, 0xc6, 0xfd, 0xdc, 0xe9, 0xb0, 0x9a, 0x09, 0xcc, 0x39,
-	0xda, 0x82, 0x5c, 0x8c, 0x15, 0xb5, 0x73, 0x33, 0x99, 0xed, 0x25, 0x71, 0x98, 0x18, 0x83, 0x35,
-	0x04, 0xf9, 0x50, 0x34, 0x37, 0x89, 0xd8, 0xce, 0xeb, 0x8c, 0x1e, 0x5d, 0x0c, 0xab, 0xb5, 0x3f,
-	0x5a, 0xb3, 0x4b, 0x48, 0x4c, 0xa5, 0x0c, 0x2e, 0x69, 0xa8, 0x0c, 0x2b, 0x31, 0xed, 0x52, 0x2c,
-	0x29, 0xb1, 0x97, 0x6b, 0x56, 0x7d, 0x25, 0x98, 0xac, 0x51, 0x13, 0x20, 0x8c, 0x29, 0x56, 0x94,
-	0xb4, 0xb0, 0xb2, 0x0b, 0x8b, 0x78, 0x5f, 0x34, 0xc4, 0x5d, 0xe5, 0x7c, 0xc9, 0xc2, 0x5a, 0x43,
-	0xf0, 0x0f, 0xac, 0xd3, 0x8f, 0x71, 0xa2, 0x61, 0xb1, 0x02, 0xbc, 0x80, 0xbc, 0x18, 0x70, 0x1a,
-	0x1b, 0xcb, 0xe7, 0x13, 0x98, 0x52, 0x12, 0x2e, 0x26, 0x11, 0xe3, 0xda, 0xf4, 0xb9, 0xb9, 0x9a,
-	0x82, 0x9e, 0x43, 0xa1, 0x2d, 0x78, 0x5f, 0x52, 0x69, 0xe7, 0x6a, 0x4b, 0xf5, 0xd2, 0xce, 0x03,
-	0x77, 0xea, 0x59, 0xb9, 0xa6, 0xea, 0x7e, 0x02, 0x31, 0x45, 0x19, 0xe3, 0xd1, 0x4b, 0x80, 0x36,
-	0x96, 0xb4, 0x95, 0x14, 0x49, 0xda, 0x79, 0xcd, 0xbe, 0x3f, 0xc3, 0x6e, 0xf4, 0xa5, 0x12, 0x51,
-	0x80, 0x15, 0x35, 0xdc, 0x62, 0x42, 0x48, 0xd6, 0xd2, 0x19, 0x00, 0x5c, 0x1e, 0xa3, 0x57, 0x50,
-	0xc0, 0x69, 0x62, 0xda, 0xaa, 0x79, 0x45, 0x8c, 0x49, 0x93, 0x76, 0xca, 0xfe, 0xb5, 0x9d, 0x9c,
-	0x4f, 0x16, 0xac, 0x4e, 0xcb, 0x42, 0x6f, 0x60, 0xad, 0x2b, 0xc2, 0x8f, 0x8c, 0xb7, 0x7a, 0x34,
-	0x66, 0x82, 0xe8, 0x0c, 0xf2, 0xfe, 0xd6, 0xc5, 0xb0, 0xba, 0x79, 0x6d, 0x0b, 0x34, 0x4d, 0xa5,
-	0x83, 0xd5, 0x94, 0xff, 0x56, 0xd3, 0xd1, 0x36, 0xe4, 0xb5, 0x45, 0xd7, 0x27, 0x93, 0x62, 0x9c,
-	0x6f, 0x16, 0xd8, 0x0d, 0xdd, 0x44, 0x57, 0x1e, 0xd8, 0x81, 0xec, 0xfc, 0xdf, 0xb3, 0xe8, 0xa7,
-	0x05, 0x60, 0x34, 0x2d, 0xac, 0xe5, 0x9f, 0x8f, 0xa3, 0x99, 0x19, 0x93, 0xbb, 0xd1, 0x8c, 0x71,
-	0x38, 0x6c, 0x04, 0xe9, 0x4c, 0xb9, 0xa9, 0xec, 0xc7, 0x00, 0x63, 0xd9, 0x13, 0xb5, 0x6b, 0xa3,
-	0x61, 0xb5, 0x68, 0x02, 0xee, 0x37, 0x27, 0xf7, 0xed, 0x13, 0x67, 0x00, 0xf7, 0x0e, 0x7b, 0x04,
-	0x2b, 0x3a, 0x33, 0x76, 0x16, 0xbe, 0xf4, 0x29, 0xe4, 0x7b, 0x58, 0x85, 0x47, 0xa6, 0x5d, 0xcb,
-	0xb3, 0x2f, 0x78, 0x3a, 0x74, 0x90, 0x02, 0x7d, 0xfb, 0x74, 0x54, 0xb1, 0xce, 0x46, 0x15, 0xeb,
-	0xc7, 0xa8, 0x62, 0x7d, 0x3e, 0xaf, 0x64, 0xce, 0xce, 0x2b, 0x99, 0xef, 0xe7, 0x95, 0x4c, 0x7b,
-	0x59, 0xff, 0x57, 0x9f, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x6d, 0x51, 0xc1, 0xbf, 0x07,
-	0x00, 0x00,
+// SweepDepositsMsg releases deposits of a contract that matured at least the
+// configured auto sweep duration ago and were not released yet. Released
+// funds are send back to each depositor. A single message processes a limited
+// number of deposits. Anyone can submit this message.
+type SweepDepositsMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ID of the deposit contract that the deposits were made with.
+	DepositContractID []byte `protobuf:"bytes,2,opt,name=deposit_contract_id,json=depositContractId,proto3" json:"deposit_contract_id,omitempty"`
+	// Start after is the ID of the last deposit processed by the previous
+	// sweep of the same contract, returned as the result data of that sweep.
+	// Leave empty to start from the first deposit.
+	StartAfter []byte `protobuf:"bytes,3,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 }
 
-func (m *DepositContract) Marshal() (dAtA []byte, err error) {
+func (m *SweepDepositsMsg) Reset()         { *m = SweepDepositsMsg{} }
+func (m *SweepDepositsMsg) String() string { return proto.CompactTextString(m) }
+func (*SweepDepositsMsg) ProtoMessage()    {}
+func (*SweepDepositsMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{12}
+}
+func (m *SweepDepositsMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepDepositsMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepDepositsMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepDepositsMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepDepositsMsg.Merge(m, src)
+}
+func (m *SweepDepositsMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepDepositsMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepDepositsMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepDepositsMsg proto.InternalMessageInfo
+
+func (m *SweepDepositsMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SweepDepositsMsg) GetDepositContractID() []byte {
+	if m != nil {
+		return m.DepositContractID
+	}
+	return nil
+}
+
+func (m *SweepDepositsMsg) GetStartAfter() []byte {
+	if m != nil {
+		return m.StartAfter
+	}
+	return nil
+}
+
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a75d003f77d30257, []int{13}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("termdeposit.InterestMode", InterestMode_name, InterestMode_value)
+	proto.RegisterEnum("termdeposit.RoundingMode", RoundingMode_name, RoundingMode_value)
+	proto.RegisterType((*DepositContract)(nil), "termdeposit.DepositContract")
+	proto.RegisterType((*Deposit)(nil), "termdeposit.Deposit")
+	proto.RegisterType((*SweepCounter)(nil), "termdeposit.SweepCounter")
+	proto.RegisterType((*Configuration)(nil), "termdeposit.Configuration")
+	proto.RegisterType((*LegacyConfiguration)(nil), "termdeposit.LegacyConfiguration")
+	proto.RegisterType((*DenomBonuses)(nil), "termdeposit.DenomBonuses")
+	proto.RegisterType((*CustomRate)(nil), "termdeposit.CustomRate")
+	proto.RegisterType((*DepositBonus)(nil), "termdeposit.DepositBonus")
+	proto.RegisterType((*CreateDepositContractMsg)(nil), "termdeposit.CreateDepositContractMsg")
+	proto.RegisterType((*DepositMsg)(nil), "termdeposit.DepositMsg")
+	proto.RegisterType((*ReleaseDepositMsg)(nil), "termdeposit.ReleaseDepositMsg")
+	proto.RegisterType((*TopUpDepositMsg)(nil), "termdeposit.TopUpDepositMsg")
+	proto.RegisterType((*SweepDepositsMsg)(nil), "termdeposit.SweepDepositsMsg")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "termdeposit.UpdateConfigurationMsg")
+}
+
+func init() { proto.RegisterFile("cmd/bnsd/x/termdeposit/codec.proto", fileDescriptor_a75d003f77d30257) }
+
+var fileDescriptor_a75d003f77d30257 = []byte{
+	// 1254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0x8d, 0x6d, 0xf0, 0xb3, 0x0d, 0x66, 0x20, 0xc9, 0xd6, 0x07, 0xec, 0xae, 0x1a, 0x95,
+	0xfc, 0xa9, 0x1d, 0xd1, 0x43, 0xff, 0xa8, 0x8a, 0x04, 0xb6, 0x49, 0x5c, 0x61, 0xb0, 0xd6, 0x50,
+	0xb5, 0xa7, 0xd5, 0xb0, 0x3b, 0x31, 0xab, 0x7a, 0x77, 0xac, 0xd9, 0x31, 0x90, 0x6b, 0x8f, 0x1c,
+	0xaa, 0x56, 0xaa, 0x7a, 0x43, 0xea, 0xad, 0x1f, 0xa2, 0x5f, 0x20, 0xc7, 0x48, 0xbd, 0xf4, 0x64,
+	0x55, 0xce, 0xb7, 0xc8, 0xa9, 0x9a, 0xd9, 0xb1, 0xd9, 0xa5, 0x09, 0xcd, 0x46, 0x55, 0xaa, 0x9e,
+	0xf0, 0x9b, 0xfd, 0xbd, 0x37, 0x6f, 0x7e, 0xf3, 0x7b, 0xf3, 0x1e, 0x60, 0xd8, 0x9e, 0x53, 0x3f,
+	0xf2, 0x03, 0xa7, 0x7e, 0x56, 0xe7, 0x84, 0x79, 0x0e, 0x19, 0xd2, 0xc0, 0xe5, 0x75, 0x9b, 0x3a,
+	0xc4, 0xae, 0x0d, 0x19, 0xe5, 0x14, 0xe5, 0x23, 0x1f, 0xca, 0xf9, 0xc8, 0x97, 0x72, 0xc9, 0xa6,
+	0xae, 0x1f, 0xc5, 0x96, 0xd7, 0xfa, 0xb4, 0x4f, 0xe5, 0xcf, 0xba, 0xf8, 0x15, 0xae, 0x1a, 0xdf,
+	0xa5, 0x60, 0xb9, 0x19, 0x06, 0x68, 0x50, 0x9f, 0x33, 0x6c, 0x73, 0x74, 0x0f, 0x16, 0x3d, 0xc2,
+	0xb1, 0x83, 0x39, 0xd6, 0xb5, 0xaa, 0xb6, 0x91, 0xdf, 0x5c, 0xae, 0x9d, 0x12, 0x7c, 0x42, 0x6a,
+	0x1d, 0xb5, 0x6c, 0xce, 0x00, 0x68, 0x07, 0xf2, 0x27, 0x78, 0xe0, 0x3a, 0x56, 0xe0, 0xfa, 0x36,
+	0xd1, 0x53, 0x55, 0x6d, 0x63, 0x7e, 0xfb, 0xf6, 0xcb, 0x71, 0xe5, 0xfd, 0xbe, 0xcb, 0x8f, 0x47,
+	0x47, 0x35, 0x9b, 0x7a, 0x75, 0x97, 0x9e, 0x7c, 0x44, 0x7d, 0x52, 0x0f, 0xa3, 0x1c, 0xfa, 0xee,
+	0xd9, 0x81, 0xeb, 0x11, 0x13, 0xa4, 0x67, 0x4f, 0x38, 0x5e, 0xc6, 0x19, 0xf9, 0xdc, 0x1d, 0xe8,
+	0xf3, 0xc9, 0xe3, 0x1c, 0x0a, 0x47, 0xb4, 0x09, 0x85, 0x60, 0x64, 0xdb, 0x24, 0x08, 0x28, 0xb3,
+	0x5c, 0x47, 0x4f, 0x57, 0xb5, 0x8d, 0xc2, 0xf6, 0xf2, 0x64, 0x5c, 0xc9, 0xf7, 0xa6, 0xeb, 0xed,
+	0xa6, 0x99, 0x9f, 0x81, 0xda, 0x8e, 0xf1, 0x53, 0x1a, 0x16, 0x14, 0x09, 0xc9, 0x0e, 0xdf, 0x82,
+	0x55, 0xc5, 0xbe, 0x65, 0x2b, 0xf6, 0xc4, 0x9e, 0x29, 0xb9, 0xe7, 0x8d, 0xc9, 0xb8, 0xb2, 0x72,
+	0x85, 0xdb, 0x76, 0xd3, 0x5c, 0x71, 0xae, 0x2c, 0x39, 0x68, 0x03, 0xb2, 0xd8, 0xa3, 0x23, 0x9f,
+	0xcb, 0x63, 0xe7, 0x37, 0xa1, 0x26, 0x6e, 0xaf, 0xd6, 0xa0, 0xae, 0xbf, 0x9d, 0x7e, 0x36, 0xae,
+	0xcc, 0x99, 0xea, 0x3b, 0xba, 0x03, 0x69, 0x86, 0x39, 0xd1, 0xd3, 0xb1, 0xcc, 0x76, 0x44, 0x1c,
+	0x97, 0x4e, 0xc1, 0x12, 0x82, 0xb6, 0x21, 0xa7, 0x76, 0xa2, 0x4c, 0xcf, 0xc8, 0x8c, 0x3e, 0x78,
+	0x39, 0xae, 0x54, 0x5f, 0x4b, 0xe7, 0x96, 0xe3, 0x30, 0x12, 0x04, 0xe6, 0xa5, 0x1b, 0x2a, 0xc3,
+	0x22, 0x23, 0x03, 0x82, 0x03, 0xe2, 0xe8, 0xd9, 0xaa, 0xb6, 0xb1, 0x68, 0xce, 0x6c, 0xd4, 0x04,
+	0xb0, 0x19, 0xc1, 0x9c, 0x38, 0x16, 0xe6, 0xfa, 0x42, 0x92, 0xfb, 0xca, 0x29, 0xc7, 0x2d, 0x8e,
+	0x9a, 0x70, 0x63, 0x48, 0x03, 0x6e, 0x79, 0x98, 0x8f, 0x98, 0xcb, 0x9f, 0x5a, 0xd8, 0xb6, 0xd9,
+	0x08, 0x0f, 0xf4, 0xc5, 0xd7, 0x30, 0xb1, 0x2a, 0xe0, 0x1d, 0x85, 0xde, 0x0a, 0xc1, 0x32, 0x4f,
+	0x3a, 0x18, 0xd0, 0x13, 0xc2, 0xf4, 0x9c, 0xca, 0x53, 0xd9, 0xe8, 0x53, 0x58, 0x1a, 0x32, 0xe2,
+	0x90, 0x4b, 0x49, 0x80, 0x24, 0x63, 0x65, 0x32, 0xae, 0x14, 0xbb, 0x97, 0x5f, 0xda, 0x4d, 0xb3,
+	0x18, 0x01, 0xb6, 0x1d, 0xc3, 0x85, 0x42, 0xef, 0x94, 0x90, 0x61, 0x43, 0x50, 0x4f, 0x58, 0x32,
+	0x69, 0xdc, 0x84, 0xec, 0x31, 0x71, 0xfb, 0xc7, 0x3c, 0x2c, 0x09, 0x53, 0x59, 0x68, 0x0d, 0x32,
+	0xf6, 0xec, 0xaa, 0x8b, 0x66, 0x68, 0x18, 0xbf, 0x65, 0xa1, 0xd8, 0xa0, 0xfe, 0x13, 0xb7, 0x3f,
+	0x62, 0x58, 0x5c, 0x65, 0xb2, 0xcd, 0x3e, 0x87, 0x0c, 0x3d, 0xf5, 0x09, 0xd3, 0x53, 0x09, 0xee,
+	0x39, 0x74, 0x11, 0xbe, 0xd8, 0xf1, 0x5c, 0x5f, 0x9f, 0x4f, 0xe2, 0x2b, 0x5d, 0xd0, 0x17, 0x00,
+	0x47, 0x38, 0x20, 0x96, 0x10, 0x5c, 0xa0, 0x67, 0xaa, 0xf3, 0x1b, 0xf9, 0xcd, 0x5b, 0xb5, 0xc8,
+	0xa3, 0x54, 0x6b, 0x8c, 0x02, 0x4e, 0x3d, 0x13, 0x73, 0xa2, 0xee, 0x2f, 0x27, 0x1c, 0x84, 0x1d,
+	0xa0, 0xcf, 0x60, 0xe1, 0x88, 0xfa, 0xa3, 0x80, 0x04, 0x7a, 0x56, 0xba, 0xbe, 0x17, 0x73, 0x6d,
+	0x12, 0x9f, 0x7a, 0xdb, 0x21, 0x40, 0x39, 0x4f, 0xf1, 0xe8, 0x1b, 0x58, 0x8d, 0xcb, 0xa6, 0xcf,
+	0xb0, 0x4d, 0x94, 0x0a, 0xef, 0xbc, 0x1c, 0x57, 0x6e, 0x5f, 0xab, 0xc2, 0xa6, 0x62, 0xd9, 0x5c,
+	0x89, 0xaa, 0xe9, 0x91, 0x88, 0x81, 0x1a, 0x80, 0xe2, 0xa1, 0x65, 0xc1, 0x2d, 0x5e, 0x57, 0x70,
+	0xa5, 0x68, 0x14, 0x71, 0x36, 0xf4, 0x10, 0x8a, 0xae, 0xd0, 0x0c, 0x11, 0x81, 0xa8, 0x43, 0xa4,
+	0x2a, 0x97, 0xae, 0x1c, 0xb0, 0xad, 0x10, 0x1d, 0xea, 0x10, 0xb3, 0xe0, 0x46, 0x2c, 0xf4, 0x35,
+	0x20, 0x9b, 0x7a, 0x43, 0x3a, 0xf2, 0x1d, 0xd7, 0xef, 0x5b, 0x43, 0xc2, 0x5c, 0x1a, 0x0a, 0x37,
+	0xd9, 0xf1, 0x22, 0x41, 0xba, 0x32, 0x06, 0xea, 0x41, 0x09, 0x8f, 0x38, 0xb5, 0x02, 0xa1, 0x6c,
+	0x0b, 0x3f, 0xe1, 0x84, 0xe9, 0xf9, 0xa4, 0x71, 0x97, 0x44, 0x08, 0x59, 0x1b, 0x5b, 0x22, 0x00,
+	0xfa, 0x04, 0x74, 0x0f, 0x9f, 0x59, 0xea, 0x60, 0x81, 0xc8, 0xd7, 0xc2, 0xa1, 0x54, 0xf4, 0x82,
+	0xd4, 0xf9, 0x0d, 0x0f, 0x9f, 0xa9, 0xb7, 0x30, 0xe8, 0x12, 0xa6, 0x74, 0x24, 0x78, 0x62, 0xd3,
+	0x43, 0x4a, 0x9e, 0x8a, 0xaf, 0xe0, 0xc9, 0x54, 0x88, 0x90, 0x27, 0x16, 0xb1, 0xbe, 0x4c, 0x2f,
+	0xa6, 0x4b, 0x19, 0xa3, 0x0b, 0xab, 0xbb, 0xa4, 0x8f, 0xed, 0xa7, 0xf1, 0x12, 0x8a, 0xe8, 0x2b,
+	0xfd, 0x4a, 0x7d, 0xc9, 0xbf, 0x52, 0x61, 0x57, 0xf4, 0x65, 0x58, 0x50, 0x88, 0xca, 0x4f, 0x54,
+	0xad, 0x23, 0x6c, 0x59, 0x8a, 0x39, 0x33, 0x34, 0xa2, 0x1b, 0xa4, 0x12, 0x6e, 0x70, 0x0a, 0x70,
+	0x59, 0x1a, 0xe8, 0x21, 0x2c, 0x4c, 0xe9, 0xd2, 0x12, 0x54, 0xe1, 0xd4, 0x69, 0xd6, 0x16, 0x52,
+	0xff, 0xd8, 0x16, 0x8c, 0xdf, 0x35, 0x28, 0x44, 0x13, 0x43, 0x7b, 0x50, 0x1c, 0x50, 0xfb, 0x5b,
+	0xd7, 0x9f, 0xaa, 0x4c, 0x64, 0x90, 0x49, 0xa2, 0x86, 0x42, 0xe8, 0xaf, 0x04, 0x76, 0x0f, 0x32,
+	0xf2, 0x90, 0xd7, 0x27, 0x13, 0x62, 0xfe, 0xad, 0xae, 0x6f, 0xfc, 0x98, 0x02, 0xbd, 0x21, 0x9b,
+	0xca, 0x95, 0x86, 0xdb, 0x09, 0xfa, 0xff, 0xef, 0x79, 0xe6, 0xef, 0xed, 0x2b, 0xfd, 0x86, 0xed,
+	0xeb, 0x97, 0x14, 0x80, 0x62, 0x23, 0x31, 0x0b, 0xef, 0x7c, 0xb0, 0x89, 0x4d, 0x2b, 0xe9, 0xb7,
+	0x9b, 0x56, 0xd6, 0x20, 0xe3, 0x53, 0x71, 0x69, 0x72, 0xda, 0x31, 0x43, 0x23, 0x36, 0x1b, 0x64,
+	0xe3, 0xb3, 0x81, 0xe1, 0xc3, 0x8a, 0x19, 0xce, 0x33, 0x6f, 0x4b, 0xd4, 0x7d, 0x80, 0x29, 0x51,
+	0x33, 0x7e, 0x8a, 0x93, 0x71, 0x25, 0xa7, 0x02, 0xb6, 0x9b, 0xb3, 0x0c, 0xdb, 0x8e, 0xf1, 0xb3,
+	0x06, 0xcb, 0x07, 0x74, 0x78, 0x38, 0x7c, 0x27, 0xdb, 0xbd, 0x39, 0xfd, 0xc6, 0xaf, 0x1a, 0x94,
+	0xe4, 0x7b, 0x3e, 0x7d, 0xa3, 0xff, 0x2b, 0xc5, 0x54, 0x20, 0x1f, 0x70, 0xcc, 0xb8, 0xea, 0x4c,
+	0x72, 0x26, 0x31, 0x41, 0x2e, 0xc9, 0x56, 0x63, 0x9c, 0xc2, 0xcd, 0xc3, 0xa1, 0x83, 0x39, 0x89,
+	0xbd, 0xf5, 0x89, 0xd3, 0x7d, 0x00, 0x99, 0x21, 0xe6, 0xf6, 0xb1, 0x7a, 0xa5, 0xca, 0xf1, 0xa1,
+	0x25, 0x1a, 0xda, 0x0c, 0x81, 0x77, 0x7d, 0x28, 0x44, 0x1b, 0x36, 0xba, 0x0f, 0x6b, 0xed, 0xbd,
+	0x83, 0x96, 0xd9, 0xea, 0x1d, 0x58, 0x9d, 0xfd, 0x66, 0xcb, 0xea, 0xb5, 0x3b, 0xdd, 0xdd, 0x56,
+	0x69, 0xae, 0x8c, 0xce, 0x2f, 0xaa, 0x4b, 0x3d, 0xd7, 0x1b, 0x0e, 0xc8, 0xd4, 0x03, 0x3d, 0x80,
+	0x9b, 0x71, 0x74, 0x63, 0xbf, 0xd3, 0xdd, 0x3f, 0xdc, 0x6b, 0x96, 0xb4, 0xf2, 0xda, 0xf9, 0x45,
+	0xb5, 0xd4, 0x50, 0x9d, 0x7a, 0xea, 0x71, 0xf7, 0x7b, 0x0d, 0x0a, 0xd1, 0xce, 0x87, 0x3e, 0x84,
+	0x55, 0x53, 0x78, 0xb4, 0xf7, 0x1e, 0x85, 0x21, 0x76, 0x76, 0xf7, 0xf7, 0xcd, 0xd2, 0x5c, 0x79,
+	0xe9, 0xfc, 0xa2, 0x0a, 0x12, 0xba, 0x33, 0xa0, 0x94, 0xa1, 0xdb, 0x80, 0xe2, 0xc0, 0x46, 0xab,
+	0xbd, 0x5b, 0xd2, 0xca, 0xc5, 0xf3, 0x8b, 0x6a, 0x4e, 0xe2, 0x1a, 0xc4, 0x1d, 0xa0, 0x1a, 0xdc,
+	0x8a, 0xc3, 0x1e, 0x6f, 0xed, 0xee, 0x58, 0xad, 0xaf, 0x5a, 0x7b, 0xa5, 0x54, 0x79, 0xe5, 0xfc,
+	0xa2, 0x5a, 0x94, 0xd8, 0xc7, 0x78, 0xf0, 0xa4, 0x75, 0x42, 0xfc, 0x6d, 0xfd, 0xd9, 0x64, 0x5d,
+	0x7b, 0x3e, 0x59, 0xd7, 0xfe, 0x9c, 0xac, 0x6b, 0x3f, 0xbc, 0x58, 0x9f, 0x7b, 0xfe, 0x62, 0x7d,
+	0xee, 0x8f, 0x17, 0xeb, 0x73, 0x47, 0x59, 0xf9, 0xbf, 0xe4, 0xc7, 0x7f, 0x0d, 0x00, 0xa6, 0x57,
+	0x5b, 0x0f, 0xb3, 0x0e, 0x00, 0x00,
+}
+
+func (m *DepositContract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -731,6 +1312,12 @@ func (m *DepositContract) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntil))
 	}
+	if len(m.SuccessorID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SuccessorID)))
+		i += copy(dAtA[i:], m.SuccessorID)
+	}
 	return i, nil
 }
 
@@ -802,6 +1389,68 @@ func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
 	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.PostMaturityAccrual.Size()))
+	n5, err := m.PostMaturityAccrual.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.Rollover {
+		dAtA[i] = 0x48
+		i++
+		if m.Rollover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PredecessorID) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PredecessorID)))
+		i += copy(dAtA[i:], m.PredecessorID)
+	}
+	return i, nil
+}
+
+func (m *SweepCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SweepCounter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Count))
+	}
 	return i, nil
 }
 
@@ -824,11 +1473,11 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Owner) > 0 {
 		dAtA[i] = 0x12
@@ -842,6 +1491,86 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
 		i += copy(dAtA[i:], m.Admin)
 	}
+	if len(m.BaseRates) > 0 {
+		for _, msg := range m.BaseRates {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Bonuses) > 0 {
+		for _, msg := range m.Bonuses {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.PostMaturityGrace != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PostMaturityGrace))
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.PostMaturityRate.Size()))
+	n8, err := m.PostMaturityRate.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.InterestMode != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.InterestMode))
+	}
+	if m.CompoundingPeriod != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CompoundingPeriod))
+	}
+	if m.AutoSweepAfter != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AutoSweepAfter))
+	}
+	if m.MaxDepositsPerAddress != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxDepositsPerAddress))
+	}
+	if m.RoundingMode != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RoundingMode))
+	}
+	return i, nil
+}
+
+func (m *LegacyConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if len(m.Bonuses) > 0 {
 		for _, msg := range m.Bonuses {
 			dAtA[i] = 0x22
@@ -854,9 +1583,33 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.BaseRates) > 0 {
-		for _, msg := range m.BaseRates {
-			dAtA[i] = 0x2a
+	return i, nil
+}
+
+func (m *DenomBonuses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBonuses) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Denom)))
+		i += copy(dAtA[i:], m.Denom)
+	}
+	if len(m.Bonuses) > 0 {
+		for _, msg := range m.Bonuses {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -893,11 +1646,11 @@ func (m *CustomRate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Rate.Size()))
-	n6, err := m.Rate.MarshalTo(dAtA[i:])
+	n9, err := m.Rate.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n9
 	return i, nil
 }
 
@@ -924,11 +1677,16 @@ func (m *DepositBonus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Bonus.Size()))
-	n7, err := m.Bonus.MarshalTo(dAtA[i:])
+	n10, err := m.Bonus.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n10
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntil))
+	}
 	return i, nil
 }
 
@@ -951,11 +1709,11 @@ func (m *CreateDepositContractMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	if m.ValidSince != 0 {
 		dAtA[i] = 0x10
@@ -967,6 +1725,12 @@ func (m *CreateDepositContractMsg) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntil))
 	}
+	if len(m.PredecessorID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PredecessorID)))
+		i += copy(dAtA[i:], m.PredecessorID)
+	}
 	return i, nil
 }
 
@@ -989,11 +1753,11 @@ func (m *DepositMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	if len(m.DepositContractID) > 0 {
 		dAtA[i] = 0x12
@@ -1004,17 +1768,33 @@ func (m *DepositMsg) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-	n10, err := m.Amount.MarshalTo(dAtA[i:])
+	n13, err := m.Amount.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n13
 	if len(m.Depositor) > 0 {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Depositor)))
 		i += copy(dAtA[i:], m.Depositor)
 	}
+	if len(m.Nonce) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Nonce)))
+		i += copy(dAtA[i:], m.Nonce)
+	}
+	if m.Rollover {
+		dAtA[i] = 0x30
+		i++
+		if m.Rollover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1037,11 +1817,45 @@ func (m *ReleaseDepositMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n11, err := m.Metadata.MarshalTo(dAtA[i:])
+		n14, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n14
+	}
+	if len(m.DepositID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DepositID)))
+		i += copy(dAtA[i:], m.DepositID)
+	}
+	return i, nil
+}
+
+func (m *TopUpDepositMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopUpDepositMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	if len(m.DepositID) > 0 {
 		dAtA[i] = 0x12
@@ -1049,6 +1863,54 @@ func (m *ReleaseDepositMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.DepositID)))
 		i += copy(dAtA[i:], m.DepositID)
 	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
+	n16, err := m.Amount.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	return i, nil
+}
+
+func (m *SweepDepositsMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SweepDepositsMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.DepositContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DepositContractID)))
+		i += copy(dAtA[i:], m.DepositContractID)
+	}
+	if len(m.StartAfter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StartAfter)))
+		i += copy(dAtA[i:], m.StartAfter)
+	}
 	return i, nil
 }
 
@@ -1071,21 +1933,21 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		n18, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n18
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n13, err := m.Patch.MarshalTo(dAtA[i:])
+		n19, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n19
 	}
 	return i, nil
 }
@@ -1115,6 +1977,10 @@ func (m *DepositContract) Size() (n int) {
 	if m.ValidUntil != 0 {
 		n += 1 + sovCodec(uint64(m.ValidUntil))
 	}
+	l = len(m.SuccessorID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -1146,6 +2012,34 @@ func (m *Deposit) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovCodec(uint64(m.CreatedAt))
 	}
+	l = m.PostMaturityAccrual.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Rollover {
+		n += 2
+	}
+	l = len(m.PredecessorID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SweepCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCodec(uint64(m.Count))
+	}
 	return n
 }
 
@@ -1167,14 +2061,68 @@ func (m *Configuration) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.BaseRates) > 0 {
+		for _, e := range m.BaseRates {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	if len(m.Bonuses) > 0 {
 		for _, e := range m.Bonuses {
 			l = e.Size()
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
-	if len(m.BaseRates) > 0 {
-		for _, e := range m.BaseRates {
+	if m.PostMaturityGrace != 0 {
+		n += 1 + sovCodec(uint64(m.PostMaturityGrace))
+	}
+	l = m.PostMaturityRate.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.InterestMode != 0 {
+		n += 1 + sovCodec(uint64(m.InterestMode))
+	}
+	if m.CompoundingPeriod != 0 {
+		n += 1 + sovCodec(uint64(m.CompoundingPeriod))
+	}
+	if m.AutoSweepAfter != 0 {
+		n += 1 + sovCodec(uint64(m.AutoSweepAfter))
+	}
+	if m.MaxDepositsPerAddress != 0 {
+		n += 1 + sovCodec(uint64(m.MaxDepositsPerAddress))
+	}
+	if m.RoundingMode != 0 {
+		n += 1 + sovCodec(uint64(m.RoundingMode))
+	}
+	return n
+}
+
+func (m *LegacyConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bonuses) > 0 {
+		for _, e := range m.Bonuses {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBonuses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Bonuses) > 0 {
+		for _, e := range m.Bonuses {
 			l = e.Size()
 			n += 1 + l + sovCodec(uint64(l))
 		}
@@ -1208,6 +2156,9 @@ func (m *DepositBonus) Size() (n int) {
 	}
 	l = m.Bonus.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	if m.ValidUntil != 0 {
+		n += 1 + sovCodec(uint64(m.ValidUntil))
+	}
 	return n
 }
 
@@ -1227,6 +2178,10 @@ func (m *CreateDepositContractMsg) Size() (n int) {
 	if m.ValidUntil != 0 {
 		n += 1 + sovCodec(uint64(m.ValidUntil))
 	}
+	l = len(m.PredecessorID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -1250,6 +2205,13 @@ func (m *DepositMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Rollover {
+		n += 2
+	}
 	return n
 }
 
@@ -1270,6 +2232,46 @@ func (m *ReleaseDepositMsg) Size() (n int) {
 	return n
 }
 
+func (m *TopUpDepositMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DepositID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *SweepDepositsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DepositContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func (m *UpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1403,6 +2405,40 @@ func (m *DepositContract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorID = append(m.SuccessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SuccessorID == nil {
+				m.SuccessorID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1665,33 +2701,247 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostMaturityAccrual", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Configuration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+			if err := m.PostMaturityAccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollover = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredecessorID = append(m.PredecessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.PredecessorID == nil {
+				m.PredecessorID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SweepCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SweepCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SweepCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1822,9 +3072,9 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				m.Admin = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bonuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1851,14 +3101,14 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bonuses = append(m.Bonuses, DepositBonus{})
-			if err := m.Bonuses[len(m.Bonuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseRates = append(m.BaseRates, CustomRate{})
+			if err := m.BaseRates[len(m.BaseRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1885,36 +3135,633 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseRates = append(m.BaseRates, CustomRate{})
-			if err := m.BaseRates[len(m.BaseRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bonuses = append(m.Bonuses, DenomBonuses{})
+			if err := m.Bonuses[len(m.Bonuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostMaturityGrace", wireType)
 			}
-			if skippy < 0 {
+			m.PostMaturityGrace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostMaturityGrace |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostMaturityRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			if err := m.PostMaturityRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestMode", wireType)
+			}
+			m.InterestMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestMode |= InterestMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundingPeriod", wireType)
+			}
+			m.CompoundingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompoundingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSweepAfter", wireType)
+			}
+			m.AutoSweepAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoSweepAfter |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositsPerAddress", wireType)
+			}
+			m.MaxDepositsPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepositsPerAddress |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingMode", wireType)
+			}
+			m.RoundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundingMode |= RoundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonuses = append(m.Bonuses, DepositBonus{})
+			if err := m.Bonuses[len(m.Bonuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBonuses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBonuses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBonuses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonuses = append(m.Bonuses, DepositBonus{})
+			if err := m.Bonuses[len(m.Bonuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositBonus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositBonus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositBonus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockinPeriod", wireType)
+			}
+			m.LockinPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockinPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *CustomRate) Unmarshal(dAtA []byte) error {
+func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1937,15 +3784,212 @@ func (m *CustomRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDepositContractMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDepositContractMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidSince", wireType)
+			}
+			m.ValidSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidSince |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredecessorID = append(m.PredecessorID[:0], dAtA[iNdEx:postIndex]...)
+			if m.PredecessorID == nil {
+				m.PredecessorID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1972,14 +4016,14 @@ func (m *CustomRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.DepositContractID = append(m.DepositContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractID == nil {
+				m.DepositContractID = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2006,68 +4050,15 @@ func (m *CustomRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositBonus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositBonus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositBonus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockinPeriod", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			m.LockinPeriod = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2077,16 +4068,31 @@ func (m *DepositBonus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockinPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bonus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2096,25 +4102,46 @@ func (m *DepositBonus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rollover = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2139,7 +4166,7 @@ func (m *DepositBonus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
+func (m *ReleaseDepositMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,10 +4189,10 @@ func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDepositContractMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseDepositMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDepositContractMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseDepositMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2205,10 +4232,10 @@ func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidSince", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositID", wireType)
 			}
-			m.ValidSince = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2218,30 +4245,26 @@ func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidSince |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.ValidUntil = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidUntil |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositID = append(m.DepositID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositID == nil {
+				m.DepositID = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2266,7 +4289,7 @@ func (m *CreateDepositContractMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositMsg) Unmarshal(dAtA []byte) error {
+func (m *TopUpDepositMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2289,10 +4312,10 @@ func (m *DepositMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopUpDepositMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopUpDepositMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2333,7 +4356,7 @@ func (m *DepositMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2360,9 +4383,9 @@ func (m *DepositMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositContractID = append(m.DepositContractID[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositContractID == nil {
-				m.DepositContractID = []byte{}
+			m.DepositID = append(m.DepositID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositID == nil {
+				m.DepositID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
@@ -2398,40 +4421,6 @@ func (m *DepositMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2456,7 +4445,7 @@ func (m *DepositMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReleaseDepositMsg) Unmarshal(dAtA []byte) error {
+func (m *SweepDepositsMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2479,10 +4468,10 @@ func (m *ReleaseDepositMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseDepositMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: SweepDepositsMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseDepositMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SweepDepositsMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2523,7 +4512,7 @@ func (m *ReleaseDepositMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2550,9 +4539,43 @@ func (m *ReleaseDepositMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositID = append(m.DepositID[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositID == nil {
-				m.DepositID = []byte{}
+			m.DepositContractID = append(m.DepositContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractID == nil {
+				m.DepositContractID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = append(m.StartAfter[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartAfter == nil {
+				m.StartAfter = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1,7 +1,11 @@
 package termdeposit
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
@@ -10,6 +14,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegister(2, &Configuration{}, migration.NoModification)
 }
 
 var _ orm.Model = (*Configuration)(nil)
@@ -22,6 +27,14 @@ func (c *Configuration) Validate() error {
 	if len(c.Bonuses) == 0 {
 		errs = errors.AppendField(errs, "Bonuses", errors.ErrEmpty)
 	}
+	denoms := make(map[string]struct{})
+	for i, b := range c.Bonuses {
+		if _, ok := denoms[b.Denom]; ok {
+			errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d.Denom", i), errors.ErrDuplicate)
+		}
+		denoms[b.Denom] = struct{}{}
+		errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d", i), b.Validate())
+	}
 	const maxBaseRates = 100 // Arbitrary limit to avoid huge data set.
 	if len(c.BaseRates) > maxBaseRates {
 		errs = errors.AppendField(errs, "BaseRates",
@@ -30,6 +43,52 @@ func (c *Configuration) Validate() error {
 	if hasDuplicates(c.BaseRates) {
 		errs = errors.AppendField(errs, "BaseRates", errors.ErrDuplicate)
 	}
+	if c.PostMaturityGrace < 0 {
+		errs = errors.AppendField(errs, "PostMaturityGrace", errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	if err := c.PostMaturityRate.Validate(); err != nil {
+		errs = errors.AppendField(errs, "PostMaturityRate", err)
+	} else if c.PostMaturityRate.Numerator > c.PostMaturityRate.Denominator {
+		errs = errors.AppendField(errs, "PostMaturityRate", errors.Wrap(errors.ErrInput, "must not be greater than one"))
+	}
+	if c.AutoSweepAfter < 0 {
+		errs = errors.AppendField(errs, "AutoSweepAfter", errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	switch c.InterestMode {
+	case InterestMode_SimpleInterest:
+		if c.CompoundingPeriod != 0 {
+			errs = errors.AppendField(errs, "CompoundingPeriod", errors.Wrap(errors.ErrInput, "not used by the simple interest mode"))
+		}
+	case InterestMode_CompoundInterest:
+		const maxCompoundingPeriods = 1000 // Arbitrary limit to bound the accrual computation cost.
+		if c.CompoundingPeriod <= 0 {
+			errs = errors.AppendField(errs, "CompoundingPeriod", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+		} else if c.PostMaturityGrace/c.CompoundingPeriod > maxCompoundingPeriods {
+			errs = errors.AppendField(errs, "CompoundingPeriod",
+				errors.Wrapf(errors.ErrInput, "post maturity grace can contain at most %d periods", maxCompoundingPeriods))
+		}
+	default:
+		errs = errors.AppendField(errs, "InterestMode", errors.Wrapf(errors.ErrInput, "unknown mode %d", c.InterestMode))
+	}
+	switch c.RoundingMode {
+	case RoundingMode_RoundFloor, RoundingMode_RoundCeil, RoundingMode_RoundHalfEven:
+	default:
+		errs = errors.AppendField(errs, "RoundingMode", errors.Wrapf(errors.ErrInput, "unknown mode %d", c.RoundingMode))
+	}
+	return errs
+}
+
+// ValidateStrict returns an error if this configuration is not valid or if
+// it does not separate duties of the funds owner and the administrator.
+// It is used by the genesis initializer and the configuration update handler.
+// Validate remains permissive, so that a configuration stored before this
+// requirement was introduced can still be loaded.
+func (c *Configuration) ValidateStrict() error {
+	errs := c.Validate()
+	if len(c.Owner) != 0 && c.Owner.Equals(c.Admin) {
+		errs = errors.AppendField(errs, "Admin",
+			errors.Wrap(errors.ErrInput, "must be different from the owner"))
+	}
 	return errs
 }
 
@@ -45,16 +104,109 @@ func hasDuplicates(rates []CustomRate) bool {
 	return false
 }
 
-// bestDepositBonus returns the best available for given period deposit bonus
-// value. This function returns nil if no match was found.
-func bestDepositBonus(bonuses []DepositBonus, duration weave.UnixDuration) *DepositBonus {
+// MigrateLegacyBonuses converts the bonus list of a configuration stored
+// before bonus ladders were declared per denomination. The legacy list was
+// applied to all deposits, so it becomes the ladder of given denomination.
+// The legacy list is removed from the stored configuration. This function is
+// a no-op if the stored configuration does not contain a legacy list.
+func MigrateLegacyBonuses(db weave.KVStore, denom string) error {
+	key := []byte("_c:termdeposit")
+	raw, err := db.Get(key)
+	if err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+	if raw == nil {
+		return errors.Wrap(errors.ErrNotFound, "configuration")
+	}
+	var legacy LegacyConfiguration
+	if err := legacy.Unmarshal(raw); err != nil {
+		return errors.Wrap(err, "unmarshal legacy configuration")
+	}
+	if len(legacy.Bonuses) == 0 {
+		return nil
+	}
+	var conf Configuration
+	if err := conf.Unmarshal(raw); err != nil {
+		return errors.Wrap(err, "unmarshal configuration")
+	}
+	for _, b := range conf.Bonuses {
+		if b.Denom == denom {
+			return errors.Wrapf(errors.ErrDuplicate, "%s bonus ladder already declared", denom)
+		}
+	}
+	conf.Bonuses = append(conf.Bonuses, DenomBonuses{
+		Denom:   denom,
+		Bonuses: legacy.Bonuses,
+	})
+	if err := gconf.Save(db, "termdeposit", &conf); err != nil {
+		return errors.Wrap(err, "save configuration")
+	}
+	return nil
+}
+
+// Validate returns an error if this bonus ladder is not valid.
+func (d *DenomBonuses) Validate() error {
+	var errs error
+	if !coin.IsCC(d.Denom) {
+		errs = errors.AppendField(errs, "Denom", errors.Wrapf(errors.ErrCurrency, "invalid denomination %q", d.Denom))
+	}
+	if len(d.Bonuses) == 0 {
+		errs = errors.AppendField(errs, "Bonuses", errors.ErrEmpty)
+	}
+	periods := make(map[weave.UnixDuration]struct{})
+	for i, b := range d.Bonuses {
+		if b.LockinPeriod <= 0 {
+			errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d.LockinPeriod", i),
+				errors.Wrap(errors.ErrInput, "must be greater than zero"))
+		}
+		if _, ok := periods[b.LockinPeriod]; ok {
+			errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d.LockinPeriod", i), errors.ErrDuplicate)
+		}
+		periods[b.LockinPeriod] = struct{}{}
+		if err := b.Bonus.Validate(); err != nil {
+			errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d.Bonus", i), err)
+		}
+		if b.ValidUntil != 0 {
+			if err := b.ValidUntil.Validate(); err != nil {
+				errs = errors.AppendField(errs, fmt.Sprintf("Bonuses.%d.ValidUntil", i), err)
+			}
+		}
+	}
+	return errs
+}
+
+// denomBonuses returns the bonus ladder declared for given denomination,
+// excluding bonuses that expired at or before given time. This function returns nil
+// if no ladder was declared.
+func denomBonuses(conf Configuration, denom string, now time.Time) []DepositBonus {
+	for _, b := range conf.Bonuses {
+		if b.Denom != denom {
+			continue
+		}
+		active := make([]DepositBonus, 0, len(b.Bonuses))
+		for _, db := range b.Bonuses {
+			// Expiration is inclusive, see weave.IsExpired.
+			if db.ValidUntil != 0 && !now.Before(db.ValidUntil.Time()) {
+				continue
+			}
+			active = append(active, db)
+		}
+		return active
+	}
+	return nil
+}
+
+// bestDepositBonus returns the best available at given time for given
+// denomination and period deposit bonus value. This function returns nil if
+// no match was found.
+func bestDepositBonus(conf Configuration, denom string, duration weave.UnixDuration, now time.Time) *DepositBonus {
 	var best *DepositBonus
-	for _, b := range bonuses {
+	for _, b := range denomBonuses(conf, denom, now) {
 		if b.LockinPeriod > duration {
 			continue
 		}
 		if best == nil || b.Bonus.Compare(best.Bonus) > 0 {
-			best = &DepositBonus{LockinPeriod: b.LockinPeriod, Bonus: b.Bonus}
+			best = &DepositBonus{LockinPeriod: b.LockinPeriod, Bonus: b.Bonus, ValidUntil: b.ValidUntil}
 		}
 	}
 	return best
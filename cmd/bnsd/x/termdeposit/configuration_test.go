@@ -5,6 +5,8 @@ import (
 
 	weave "github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -21,8 +23,13 @@ func TestConfigurationValidate(t *testing.T) {
 				Metadata: &weave.Metadata{Schema: 1},
 				Owner:    weavetest.NewCondition().Address(),
 				Admin:    weavetest.NewCondition().Address(),
-				Bonuses: []DepositBonus{
-					{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}},
+				Bonuses: []DenomBonuses{
+					{
+						Denom: "IOV",
+						Bonuses: []DepositBonus{
+							{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}},
+						},
+					},
 				},
 			},
 			errs: map[string]*errors.Error{
@@ -43,6 +50,142 @@ func TestConfigurationValidate(t *testing.T) {
 				"BaseRate": nil,
 			},
 		},
+		"bonus ladder denomination must be unique": {
+			c: Configuration{
+				Bonuses: []DenomBonuses{
+					{Denom: "IOV", Bonuses: []DepositBonus{{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}}}},
+					{Denom: "IOV", Bonuses: []DepositBonus{{LockinPeriod: 200, Bonus: weave.Fraction{Numerator: 1, Denominator: 20}}}},
+				},
+			},
+			errs: map[string]*errors.Error{
+				"Bonuses.0.Denom": nil,
+				"Bonuses.1.Denom": errors.ErrDuplicate,
+			},
+		},
+		"bonus ladder must be valid": {
+			c: Configuration{
+				Bonuses: []DenomBonuses{
+					{Denom: "x", Bonuses: []DepositBonus{
+						{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}},
+						{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 20}},
+					}},
+					{Denom: "ETH"},
+				},
+			},
+			errs: map[string]*errors.Error{
+				"Bonuses.0":              errors.ErrCurrency,
+				"Denom":                  errors.ErrCurrency,
+				"Bonuses.1.LockinPeriod": errors.ErrDuplicate,
+				"Bonuses.1":              errors.ErrEmpty,
+			},
+		},
+		"bonus expiration time must be valid": {
+			c: Configuration{
+				Bonuses: []DenomBonuses{
+					{Denom: "IOV", Bonuses: []DepositBonus{
+						{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}, ValidUntil: 300000000000},
+						{LockinPeriod: 200, Bonus: weave.Fraction{Numerator: 1, Denominator: 20}, ValidUntil: 1000},
+					}},
+				},
+			},
+			errs: map[string]*errors.Error{
+				"Bonuses.0.ValidUntil": errors.ErrState,
+				"Bonuses.1.ValidUntil": nil,
+			},
+		},
+		"post maturity settings must be valid": {
+			c: Configuration{
+				PostMaturityGrace: -1,
+				PostMaturityRate:  weave.Fraction{Numerator: 3, Denominator: 2},
+			},
+			errs: map[string]*errors.Error{
+				"PostMaturityGrace": errors.ErrInput,
+				"PostMaturityRate":  errors.ErrInput,
+			},
+		},
+		"auto sweep duration must not be negative": {
+			c: Configuration{
+				AutoSweepAfter: -1,
+			},
+			errs: map[string]*errors.Error{
+				"AutoSweepAfter": errors.ErrInput,
+			},
+		},
+		"post maturity rate must not divide by zero": {
+			c: Configuration{
+				PostMaturityGrace: 100,
+				PostMaturityRate:  weave.Fraction{Numerator: 1},
+			},
+			errs: map[string]*errors.Error{
+				"PostMaturityGrace": nil,
+				"PostMaturityRate":  errors.ErrState,
+			},
+		},
+		"compounding period is not used by the simple interest mode": {
+			c: Configuration{
+				InterestMode:      InterestMode_SimpleInterest,
+				CompoundingPeriod: 100,
+			},
+			errs: map[string]*errors.Error{
+				"InterestMode":      nil,
+				"CompoundingPeriod": errors.ErrInput,
+			},
+		},
+		"compounding period is required by the compound interest mode": {
+			c: Configuration{
+				PostMaturityGrace: 100,
+				InterestMode:      InterestMode_CompoundInterest,
+			},
+			errs: map[string]*errors.Error{
+				"InterestMode":      nil,
+				"CompoundingPeriod": errors.ErrInput,
+			},
+		},
+		"number of compounding periods is limited": {
+			c: Configuration{
+				PostMaturityGrace: asDays(10),
+				InterestMode:      InterestMode_CompoundInterest,
+				CompoundingPeriod: 60,
+			},
+			errs: map[string]*errors.Error{
+				"CompoundingPeriod": errors.ErrInput,
+			},
+		},
+		"compound interest configuration": {
+			c: Configuration{
+				PostMaturityGrace: asDays(10),
+				InterestMode:      InterestMode_CompoundInterest,
+				CompoundingPeriod: asDays(1),
+			},
+			errs: map[string]*errors.Error{
+				"InterestMode":      nil,
+				"CompoundingPeriod": nil,
+			},
+		},
+		"interest mode must be known": {
+			c: Configuration{
+				InterestMode: 42,
+			},
+			errs: map[string]*errors.Error{
+				"InterestMode": errors.ErrInput,
+			},
+		},
+		"rounding mode must be known": {
+			c: Configuration{
+				RoundingMode: 42,
+			},
+			errs: map[string]*errors.Error{
+				"RoundingMode": errors.ErrInput,
+			},
+		},
+		"half even rounding mode": {
+			c: Configuration{
+				RoundingMode: RoundingMode_RoundHalfEven,
+			},
+			errs: map[string]*errors.Error{
+				"RoundingMode": nil,
+			},
+		},
 		"base rate address must be unique": {
 			c: Configuration{
 				BaseRates: []CustomRate{
@@ -65,3 +208,129 @@ func TestConfigurationValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigurationValidateStrict(t *testing.T) {
+	owner := weavetest.NewCondition().Address()
+	conf := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    owner,
+		Admin:    weavetest.NewCondition().Address(),
+		Bonuses: []DenomBonuses{
+			{
+				Denom: "IOV",
+				Bonuses: []DepositBonus{
+					{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}},
+				},
+			},
+		},
+	}
+	assert.Nil(t, conf.ValidateStrict())
+
+	conf.Admin = owner
+	assert.Nil(t, conf.Validate())
+	assert.FieldError(t, conf.ValidateStrict(), "Admin", errors.ErrInput)
+
+	// Errors of the permissive validation are returned as well.
+	conf.Metadata = nil
+	assert.FieldError(t, conf.ValidateStrict(), "Metadata", errors.ErrMetadata)
+	assert.FieldError(t, conf.ValidateStrict(), "Admin", errors.ErrInput)
+}
+
+func TestBestDepositBonus(t *testing.T) {
+	conf := Configuration{
+		Bonuses: []DenomBonuses{
+			{Denom: "IOV", Bonuses: []DepositBonus{
+				{LockinPeriod: 10, Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+				{LockinPeriod: 20, Bonus: weave.Fraction{Numerator: 2, Denominator: 10}, ValidUntil: 1000},
+				{LockinPeriod: 30, Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
+			}},
+		},
+	}
+
+	cases := map[string]struct {
+		denom    string
+		duration weave.UnixDuration
+		now      weave.UnixTime
+		want     *DepositBonus
+	}{
+		"best bonus that is not expired": {
+			denom:    "IOV",
+			duration: 25,
+			now:      999,
+			want:     &DepositBonus{LockinPeriod: 20, Bonus: weave.Fraction{Numerator: 2, Denominator: 10}, ValidUntil: 1000},
+		},
+		"expired bonus is ignored": {
+			denom:    "IOV",
+			duration: 25,
+			now:      1001,
+			want:     &DepositBonus{LockinPeriod: 10, Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+		},
+		"bonus expires at its valid until time": {
+			denom:    "IOV",
+			duration: 25,
+			now:      1000,
+			want:     &DepositBonus{LockinPeriod: 10, Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+		},
+		"no bonus for a short duration": {
+			denom:    "IOV",
+			duration: 5,
+			now:      1,
+			want:     nil,
+		},
+		"no bonus for unknown denomination": {
+			denom:    "ETH",
+			duration: 100,
+			now:      1,
+			want:     nil,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got := bestDepositBonus(conf, tc.denom, tc.duration, tc.now.Time())
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestMigrateLegacyBonuses(t *testing.T) {
+	db := store.MemStore()
+
+	if err := MigrateLegacyBonuses(db, "IOV"); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	conf := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+		Admin:    weavetest.NewCondition().Address(),
+	}
+	legacy := LegacyConfiguration{
+		Bonuses: []DepositBonus{
+			{LockinPeriod: 100, Bonus: weave.Fraction{Numerator: 1, Denominator: 50}},
+			{LockinPeriod: 200, Bonus: weave.Fraction{Numerator: 1, Denominator: 20}},
+		},
+	}
+	rawConf, err := conf.Marshal()
+	assert.Nil(t, err)
+	rawLegacy, err := legacy.Marshal()
+	assert.Nil(t, err)
+	// Configuration stored before the bonus list was removed contains both
+	// the current and the legacy fields.
+	assert.Nil(t, db.Set([]byte("_c:termdeposit"), append(rawConf, rawLegacy...)))
+
+	assert.Nil(t, MigrateLegacyBonuses(db, "IOV"))
+
+	var got Configuration
+	assert.Nil(t, gconf.Load(db, "termdeposit", &got))
+	assert.Nil(t, got.Validate())
+	assert.Equal(t, []DenomBonuses{{Denom: "IOV", Bonuses: legacy.Bonuses}}, got.Bonuses)
+
+	// Legacy list is removed, so running the migration again is a no-op.
+	raw, err := db.Get([]byte("_c:termdeposit"))
+	assert.Nil(t, err)
+	var left LegacyConfiguration
+	assert.Nil(t, left.Unmarshal(raw))
+	assert.Equal(t, 0, len(left.Bonuses))
+	assert.Nil(t, MigrateLegacyBonuses(db, "IOV"))
+}
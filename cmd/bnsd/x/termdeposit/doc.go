@@ -5,5 +5,21 @@ contract by depositing funds.
 This is a minimal implementation of term deposit functionality. Each deposit
 interest is computed offchain and transferred inteprendetly from this
 extension.
+
+A deposit is identified by the next value of a sequence. If a deposit message
+declares a nonce, the deposit ID is a hash of the message content instead.
+Submitting the same message again results in the same ID and is rejected as
+a duplicate, which allows clients to safely retry a deposit.
+
+A contract matures at its ValidUntil time and no longer accepts deposits
+after that, so released funds cannot be locked again within the same
+contract. Instead, the admin can create a successor of a contract by
+declaring the predecessor contract ID. A deposit created with the rollover
+flag is, when released, deposited again within the successor contract and
+linked to the released deposit via its predecessor ID. If the contract has
+no successor, if the successor no longer accepts deposits or if the
+depositor already holds the maximum number of not released deposits, the
+funds are paid out as usual. The rollover requires the termdeposit schema
+version 2.
 */
 package termdeposit
@@ -0,0 +1,9 @@
+package termdeposit
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+var (
+	ErrDepositLimit = errors.Register(140, "deposit limit reached")
+)
@@ -1,6 +1,10 @@
 package termdeposit
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
 	"math/big"
 	"sort"
 	"time"
@@ -15,9 +19,15 @@ import (
 	"github.com/iov-one/weave/x/cash"
 )
 
+// rolloverSchema is the schema version of the termdeposit package that
+// introduces the deposit rollover.
+const rolloverSchema = 2
+
 func RegisterQuery(qr weave.QueryRouter) {
 	NewDepositContractBucket().Register("depositcontracts", qr)
 	NewDepositBucket().Register("deposits", qr)
+	NewLockedQuery().RegisterQuery(qr)
+	NewBonusesQuery().RegisterQuery(qr)
 }
 
 func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
@@ -41,8 +51,91 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Contro
 		deposits:  deposits,
 		cashctrl:  cashctrl,
 	})
-	r.Handle(&UpdateConfigurationMsg{},
-		gconf.NewUpdateConfigurationHandler("termdeposit", &Configuration{}, auth, migration.CurrentAdmin))
+	r.Handle(&TopUpDepositMsg{}, &topUpDepositHandler{
+		auth:      auth,
+		contracts: contracts,
+		deposits:  deposits,
+		cashctrl:  cashctrl,
+	})
+	r.Handle(&SweepDepositsMsg{}, &sweepDepositsHandler{
+		contracts: contracts,
+		deposits:  deposits,
+		counters:  NewSweepCounterBucket(),
+		cashctrl:  cashctrl,
+	})
+	r.Handle(&UpdateConfigurationMsg{}, &updateConfigurationHandler{
+		UpdateConfigurationHandler: gconf.NewUpdateConfigurationHandler("termdeposit", &Configuration{}, auth, migration.CurrentAdmin),
+	})
+}
+
+// updateConfigurationHandler extends the generic configuration update with
+// checks that require the current block time.
+type updateConfigurationHandler struct {
+	gconf.UpdateConfigurationHandler
+}
+
+func (h *updateConfigurationHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.validate(ctx, tx); err != nil {
+		return nil, err
+	}
+	res, err := h.UpdateConfigurationHandler.Check(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStoredConf(db); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (h *updateConfigurationHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.validate(ctx, tx); err != nil {
+		return nil, err
+	}
+	res, err := h.UpdateConfigurationHandler.Deliver(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStoredConf(db); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// validateStoredConf returns an error if the stored configuration does not
+// pass the strict validation. The patch is merged with the current
+// configuration by the gconf handler, so only the stored result can be
+// validated. Returning an error discards the changes of the transaction.
+func validateStoredConf(db weave.KVStore) error {
+	conf, err := loadConf(db)
+	if err != nil {
+		return err
+	}
+	if err := conf.ValidateStrict(); err != nil {
+		return errors.Wrap(err, "invalid configuration after applying patch")
+	}
+	return nil
+}
+
+// validate returns an error if the configuration change declares a bonus that
+// is already expired. Such bonus would never be used.
+func (h *updateConfigurationHandler) validate(ctx weave.Context, tx weave.Tx) error {
+	var msg UpdateConfigurationMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return errors.Wrap(err, "load msg")
+	}
+	if msg.Patch == nil {
+		return nil
+	}
+	for i, denom := range msg.Patch.Bonuses {
+		for j, b := range denom.Bonuses {
+			if b.ValidUntil != 0 && weave.IsExpired(ctx, b.ValidUntil) {
+				return errors.Field(fmt.Sprintf("Patch.Bonuses.%d.Bonuses.%d.ValidUntil", i, j),
+					errors.ErrExpired, "bonus already expired")
+			}
+		}
+	}
+	return nil
 }
 
 type createDepositContractHandler struct {
@@ -51,14 +144,14 @@ type createDepositContractHandler struct {
 }
 
 func (h *createDepositContractHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, err := h.validate(ctx, db, tx); err != nil {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
 	return &weave.CheckResult{GasAllocated: 0}, nil
 }
 
 func (h *createDepositContractHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, db, tx)
+	msg, predecessor, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -71,34 +164,80 @@ func (h *createDepositContractHandler) Deliver(ctx weave.Context, db weave.KVSto
 	if err != nil {
 		return nil, errors.Wrap(err, "store contract")
 	}
+	if predecessor != nil {
+		predecessor.SuccessorID = key
+		if _, err := h.contracts.Put(db, msg.PredecessorID, predecessor); err != nil {
+			return nil, errors.Wrap(err, "store predecessor contract")
+		}
+	}
 	return &weave.DeliverResult{Data: key}, nil
 }
 
-func (h *createDepositContractHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateDepositContractMsg, error) {
+// validate returns the message and, if the message declares one, the
+// predecessor contract.
+func (h *createDepositContractHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateDepositContractMsg, *DepositContract, error) {
 	var msg CreateDepositContractMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, errors.Wrap(err, "load msg")
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
 	conf, err := loadConf(db)
 	if err != nil {
-		return nil, errors.Wrap(err, "load conf")
+		return nil, nil, errors.Wrap(err, "load conf")
 	}
 
 	if !h.auth.HasAddress(ctx, conf.Admin) {
-		return nil, errors.Wrap(errors.ErrUnauthorized, "admin signature missing")
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "admin signature missing")
 	}
 
 	now, err := weave.BlockTime(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "block time")
+		return nil, nil, errors.Wrap(err, "block time")
 	}
 	if !msg.ValidUntil.Time().After(now) {
-		return nil, errors.Wrap(errors.ErrExpired, "ValidUntil must be in the future")
+		return nil, nil, errors.Wrap(errors.ErrExpired, "ValidUntil must be in the future")
+	}
+
+	if len(msg.PredecessorID) == 0 {
+		return &msg, nil, nil
+	}
+	if err := ensureRolloverSchema(db); err != nil {
+		return nil, nil, err
+	}
+	var predecessor DepositContract
+	if err := h.contracts.One(db, msg.PredecessorID, &predecessor); err != nil {
+		return nil, nil, errors.Wrap(err, "get predecessor contract")
+	}
+	if len(predecessor.SuccessorID) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "predecessor contract already has a successor")
+	}
+	// Rollover deposits are released at or after the predecessor
+	// maturity, so the successor must be active at that time.
+	if msg.ValidSince > predecessor.ValidUntil {
+		return nil, nil, errors.Field("ValidSince", errors.ErrInput, "must not be after the predecessor contract maturity")
 	}
-	return &msg, nil
+	if msg.ValidUntil <= predecessor.ValidUntil {
+		return nil, nil, errors.Field("ValidUntil", errors.ErrInput, "must be after the predecessor contract maturity")
+	}
+	return &msg, &predecessor, nil
+}
+
+// ensureRolloverSchema returns an error if the termdeposit package schema does
+// not support the deposit rollover yet.
+func ensureRolloverSchema(db weave.ReadOnlyKVStore) error {
+	switch ver, err := migration.NewSchemaBucket().CurrentSchema(db, "termdeposit"); {
+	case err != nil:
+		return errors.Wrap(err, "cannot get schema version")
+	case ver < rolloverSchema:
+		return errors.Wrapf(errors.ErrSchema, "rollover requires termdeposit schema version %d", rolloverSchema)
+	}
+	return nil
 }
 
+// countDepositCost is the gas allocated for each open deposit that is
+// counted in order to apply Configuration.MaxDepositsPerAddress.
+const countDepositCost = 10
+
 type depositHandler struct {
 	auth      x.Authenticator
 	contracts orm.ModelBucket
@@ -107,14 +246,15 @@ type depositHandler struct {
 }
 
 func (h *depositHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, _, err := h.validate(ctx, db, tx); err != nil {
+	_, _, _, openDeposits, err := h.validate(ctx, db, tx)
+	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: 0}, nil
+	return &weave.CheckResult{GasAllocated: int64(openDeposits) * countDepositCost}, nil
 }
 
 func (h *depositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, contract, err := h.validate(ctx, db, tx)
+	msg, contract, conf, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,20 +262,16 @@ func (h *depositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.T
 	if err != nil {
 		return nil, errors.Wrap(err, "block time")
 	}
-	key, err := depositSeq.NextVal(db)
+	key, err := depositKey(db, msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot acquire key")
 	}
 	// Lock funds within the deposit account by moving them away from the
 	// depositor account.
-	if err := cash.MoveCoins(db, h.cashctrl, msg.Depositor, depositAccount(key), []*coin.Coin{&msg.Amount}); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.cashctrl, msg.Depositor, depositAccount(key), []*coin.Coin{&msg.Amount}); err != nil {
 		return nil, errors.Wrap(err, "deposit funds")
 	}
-	conf, err := loadConf(db)
-	if err != nil {
-		return nil, errors.Wrap(err, "load conf")
-	}
-	rate, err := depositRate(contract, conf, now)
+	rate, err := depositRate(contract, conf, msg.Amount.Ticker, now)
 	if err != nil {
 		return nil, errors.Wrap(err, "deposit rate")
 	}
@@ -147,6 +283,7 @@ func (h *depositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.T
 		Depositor:         msg.Depositor,
 		Released:          false,
 		CreatedAt:         weave.AsUnixTime(now),
+		Rollover:          msg.Rollover,
 	}
 	if _, err := h.deposits.Put(db, key, &deposit); err != nil {
 		return nil, errors.Wrap(err, "store deposit")
@@ -154,24 +291,58 @@ func (h *depositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.T
 	return &weave.DeliverResult{Data: key}, nil
 }
 
+// depositKey returns the key of a deposit created by given message. A message
+// without a nonce gets the next sequence value. Otherwise the key is a hash
+// of the message content, so that the same message always results in the
+// same key.
+func depositKey(db weave.KVStore, msg *DepositMsg) ([]byte, error) {
+	if len(msg.Nonce) == 0 {
+		return depositSeq.NextVal(db)
+	}
+	return contentDepositKey(msg), nil
+}
+
+// contentDepositKey returns a hash of the deposit message content. Each
+// variable length value is prefixed with its length, so that no two
+// different messages are serialized the same way.
+func contentDepositKey(msg *DepositMsg) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte("termdeposit/deposit"))
+	for _, b := range [][]byte{
+		msg.DepositContractID,
+		msg.Depositor,
+		[]byte(msg.Amount.Ticker),
+		msg.Nonce,
+	} {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(b)))
+		_, _ = h.Write(b)
+	}
+	_ = binary.Write(h, binary.BigEndian, msg.Amount.Whole)
+	_ = binary.Write(h, binary.BigEndian, msg.Amount.Fractional)
+	return h.Sum(nil)
+}
+
 func depositAccount(key []byte) weave.Address {
 	return weave.NewCondition("deposit", "seq", key).Address()
 }
 
-// depositRate returns rate for a deposit created within given contract at
-// given time.
+// depositRate returns rate for a deposit of given denomination created within
+// given contract at given time. Deposits of a denomination without a declared
+// bonus ladder have a zero rate.
 // This function returns an error if contract is not active or expired. It is
 // also taking into account overflow errors.
-func depositRate(contract *DepositContract, conf Configuration, now time.Time) (weave.Fraction, error) {
-	if now.After(contract.ValidUntil.Time()) {
+func depositRate(contract *DepositContract, conf Configuration, denom string, now time.Time) (weave.Fraction, error) {
+	// Expiration is inclusive, see weave.IsExpired.
+	if !now.Before(contract.ValidUntil.Time()) {
 		return weave.Fraction{}, errors.Wrap(errors.ErrExpired, "contract out of date")
 	}
 	if now.Before(contract.ValidSince.Time()) {
 		return weave.Fraction{}, errors.Wrap(errors.ErrState, "contract not yet active")
 	}
 
-	if len(conf.Bonuses) == 0 {
-		return weave.Fraction{}, errors.Wrap(errors.ErrInput, "no deposit bonuses declared")
+	bonuses := denomBonuses(conf, denom, now)
+	if len(bonuses) == 0 {
+		return weave.Fraction{}, nil
 	}
 
 	// r = (r+ - r-) / (T+ - T-) * (T - T-) + r-
@@ -182,7 +353,6 @@ func depositRate(contract *DepositContract, conf Configuration, now time.Time) (
 	// T- is the duration in the config table immediately inferior to T
 	// r+ and r- are the associated rate to T+ and T-
 
-	bonuses := conf.Bonuses
 	// From the shortest period to the longest (and the biggest bonus).
 	sort.Slice(bonuses, func(i, j int) bool {
 		return bonuses[i].LockinPeriod < bonuses[j].LockinPeriod
@@ -242,32 +412,151 @@ func depositRate(contract *DepositContract, conf Configuration, now time.Time) (
 	return result, nil
 }
 
-func (h *depositHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*DepositMsg, *DepositContract, error) {
+// PostMaturityAccrual returns the amount accrued by given deposit between the
+// contract maturity and given claim time. Only the time within the configured
+// post maturity grace period is taken into account, so a zero grace period
+// or a claim time before the maturity results in a zero value.
+//
+// The post maturity rate is the simple interest rate of the whole grace
+// period. In the compound interest mode, that rate is split evenly between
+// compounding periods and the value accrued within each completed period is
+// added to the principal.
+//
+// This function is used by the release handler and can be used to preview
+// the accrual of a deposit released at any time.
+func PostMaturityAccrual(conf Configuration, contract *DepositContract, deposit *Deposit, claimAt time.Time) (coin.Coin, error) {
+	accrual := coin.NewCoin(0, 0, deposit.Amount.Ticker)
+	if conf.PostMaturityGrace <= 0 || conf.PostMaturityRate.Numerator == 0 {
+		return accrual, nil
+	}
+	elapsed := int64(weave.AsUnixTime(claimAt) - contract.ValidUntil)
+	if elapsed <= 0 {
+		return accrual, nil
+	}
+	grace := int64(conf.PostMaturityGrace)
+	if elapsed > grace {
+		elapsed = grace
+	}
+
+	// Computation is done using the fractional units. Each partial result
+	// is rounded using the configured rounding mode.
+	principal := fracUnits(deposit.Amount)
+	balance := new(big.Int).Set(principal)
+	if conf.InterestMode == InterestMode_CompoundInterest {
+		period := int64(conf.CompoundingPeriod)
+		if period <= 0 {
+			return accrual, errors.Wrap(errors.ErrState, "compounding period must be greater than zero")
+		}
+		for ; elapsed >= period; elapsed -= period {
+			balance.Add(balance, simpleInterest(balance, conf.PostMaturityRate, period, grace, conf.RoundingMode))
+		}
+	}
+	balance.Add(balance, simpleInterest(balance, conf.PostMaturityRate, elapsed, grace, conf.RoundingMode))
+	amount := balance.Sub(balance, principal)
+
+	frac := big.NewInt(coin.FracUnit)
+	whole, fractional := new(big.Int).QuoRem(amount, frac, new(big.Int))
+	if !whole.IsInt64() {
+		return accrual, errors.Wrap(errors.ErrOverflow, "post maturity accrual")
+	}
+	accrual.Whole = whole.Int64()
+	accrual.Fractional = fractional.Int64()
+	return accrual, nil
+}
+
+// simpleInterest returns the value accrued by given amount over given time,
+// if the rate is the value accrued over the whole grace period. The result is
+// rounded using given mode.
+//
+//	interest = amount * rate * elapsed / grace
+func simpleInterest(amount *big.Int, rate weave.Fraction, elapsed, grace int64, mode RoundingMode) *big.Int {
+	v := new(big.Int).Mul(amount, big.NewInt(int64(rate.Numerator)))
+	v.Mul(v, big.NewInt(elapsed))
+	d := new(big.Int).Mul(big.NewInt(int64(rate.Denominator)), big.NewInt(grace))
+	return roundedQuo(v, d, mode)
+}
+
+// roundedQuo returns the quotient of given non negative values, rounded
+// using given mode. Computation is done using integers only, so that the
+// result does not depend on the platform.
+func roundedQuo(v, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(v, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	switch mode {
+	case RoundingMode_RoundCeil:
+		q.Add(q, big.NewInt(1))
+	case RoundingMode_RoundHalfEven:
+		switch r.Lsh(r, 1).Cmp(d) {
+		case 1:
+			q.Add(q, big.NewInt(1))
+		case 0:
+			if q.Bit(0) == 1 {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	return q
+}
+
+func (h *depositHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*DepositMsg, *DepositContract, Configuration, int, error) {
 	var msg DepositMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, nil, errors.Wrap(err, "load msg")
+		return nil, nil, Configuration{}, 0, errors.Wrap(err, "load msg")
 	}
 	if !h.auth.HasAddress(ctx, msg.Depositor) {
-		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "depositor signature is required")
+		return nil, nil, Configuration{}, 0, errors.Wrap(errors.ErrUnauthorized, "depositor signature is required")
 	}
-	var contract DepositContract
-	if err := h.contracts.One(db, msg.DepositContractID, &contract); err != nil {
-		return nil, nil, errors.Wrap(err, "get contract")
+	if msg.Rollover {
+		if err := ensureRolloverSchema(db); err != nil {
+			return nil, nil, Configuration{}, 0, err
+		}
 	}
 	now, err := weave.BlockTime(ctx)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "block time")
+		return nil, nil, Configuration{}, 0, errors.Wrap(err, "block time")
+	}
+
+	var contract DepositContract
+	if err := h.contracts.One(db, msg.DepositContractID, &contract); err != nil {
+		return nil, nil, Configuration{}, 0, errors.Wrap(err, "get contract")
 	}
 	if contract.ValidSince.Time().After(now) {
-		return nil, nil, errors.Wrap(errors.ErrState, "contract is not yet active")
+		return nil, nil, Configuration{}, 0, errors.Wrap(errors.ErrState, "contract is not yet active")
+	}
+	if !contract.ValidUntil.Time().After(now) {
+		return nil, nil, Configuration{}, 0, errors.Wrap(errors.ErrExpired, "contract has expired")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, Configuration{}, 0, errors.Wrap(err, "load conf")
+	}
+	if len(msg.Nonce) != 0 {
+		switch err := h.deposits.Has(db, contentDepositKey(&msg)); {
+		case err == nil:
+			return nil, nil, Configuration{}, 0, errors.Wrap(errors.ErrDuplicate, "deposit already exists")
+		case !errors.ErrNotFound.Is(err):
+			return nil, nil, Configuration{}, 0, errors.Wrap(err, "deposit")
+		}
 	}
-	if contract.ValidUntil.Time().Before(now) {
-		return nil, nil, errors.Wrap(errors.ErrExpired, "contract has expired")
+	var openDeposits int
+	if limit := conf.MaxDepositsPerAddress; limit != 0 {
+		// Only not released deposits are indexed and a deposit above
+		// the limit is rejected, so the count is bound by the limit.
+		n, err := h.deposits.CountByIndex(db, "owner", msg.Depositor)
+		if err != nil {
+			return nil, nil, Configuration{}, 0, errors.Wrap(err, "count deposits")
+		}
+		if uint32(n) >= limit {
+			return nil, nil, Configuration{}, 0, errors.Wrapf(ErrDepositLimit, "depositor cannot hold more than %d not released deposits", limit)
+		}
+		openDeposits = n
 	}
 	if err := hasFunds(db, h.cashctrl, msg.Depositor, msg.Amount); err != nil {
-		return nil, nil, err
+		return nil, nil, Configuration{}, 0, err
 	}
-	return &msg, &contract, nil
+	return &msg, &contract, conf, openDeposits, nil
 }
 
 // hasFunds returns no error if given wallet contains at least given amount of
@@ -295,56 +584,431 @@ type releaseDepositHandler struct {
 }
 
 func (h *releaseDepositHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, _, err := h.validate(ctx, db, tx); err != nil {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
 	return &weave.CheckResult{GasAllocated: 0}, nil
 }
 
 func (h *releaseDepositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, deposit, err := h.validate(ctx, db, tx)
+	msg, deposit, contract, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "load conf")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	successorKey, err := releaseDeposit(ctx, db, h.contracts, h.deposits, h.cashctrl, conf, contract, msg.DepositID, deposit, now)
+	if err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{Data: successorKey}, nil
+}
+
+// releaseDeposit marks given deposit as released and transfers all funds of
+// its wallet to the depositor. The deposited amount of a rollover deposit is
+// instead locked within a new deposit of the successor contract, if that
+// contract accepts it. See rolloverSuccessor. The key of the new deposit is
+// returned, or nil if the deposit was not rolled over.
+func releaseDeposit(
+	ctx weave.Context,
+	db weave.KVStore,
+	contracts orm.ModelBucket,
+	deposits orm.ModelBucket,
+	cashctrl cash.Controller,
+	conf Configuration,
+	contract *DepositContract,
+	depositID []byte,
+	deposit *Deposit,
+	now time.Time,
+) ([]byte, error) {
+	accrual, err := PostMaturityAccrual(conf, contract, deposit, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "post maturity accrual")
+	}
+	deposit.PostMaturityAccrual = accrual
+	// Mark deposit as released to avoid double releasing of the funds.
+	deposit.Released = true
+	if _, err := deposits.Put(db, depositID, deposit); err != nil {
+		return nil, errors.Wrap(err, "store deposit")
+	}
+
+	successor, err := rolloverSuccessor(db, contracts, deposits, conf, contract, deposit, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "rollover")
+	}
+	var successorKey []byte
+	if successor != nil {
+		successorKey, err = depositSeq.NextVal(db)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot acquire key")
+		}
+		if err := cash.MoveCoins(ctx, db, cashctrl, depositAccount(depositID), depositAccount(successorKey), []*coin.Coin{&deposit.Amount}); err != nil {
+			return nil, errors.Wrap(err, "rollover deposited funds")
+		}
+		rate, err := depositRate(successor, conf, deposit.Amount.Ticker, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "deposit rate")
+		}
+		rolled := Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: contract.SuccessorID,
+			Rate:              rate,
+			Amount:            deposit.Amount,
+			Depositor:         deposit.Depositor,
+			CreatedAt:         weave.AsUnixTime(now),
+			Rollover:          true,
+			PredecessorID:     depositID,
+		}
+		if _, err := deposits.Put(db, successorKey, &rolled); err != nil {
+			return nil, errors.Wrap(err, "store rollover deposit")
+		}
+	}
+
 	// Release locked by the deposit funds plus any additional token found
 	// in the wallet - transfer them all to the depositor account.
-	funds, err := h.cashctrl.Balance(db, depositAccount(msg.DepositID))
+	funds, err := cashctrl.Balance(db, depositAccount(depositID))
 	if err != nil {
 		return nil, errors.Wrap(err, "deposit wallet balance")
 	}
-	if err := cash.MoveCoins(db, h.cashctrl, depositAccount(msg.DepositID), deposit.Depositor, funds); err != nil {
-		return nil, errors.Wrap(err, "release deposited funds")
+	if len(funds) != 0 {
+		if err := cash.MoveCoins(ctx, db, cashctrl, depositAccount(depositID), deposit.Depositor, funds); err != nil {
+			return nil, errors.Wrap(err, "release deposited funds")
+		}
 	}
-	// Mark deposit as released to avoid double releasing of the funds.
-	deposit.Released = true
+	return successorKey, nil
+}
+
+// rolloverSuccessor returns the contract that given released deposit must be
+// rolled over into, or nil if the deposit must be paid out. A deposit is paid
+// out if it does not request a rollover, if its contract has no successor, if
+// the successor no longer accepts deposits or if the depositor already holds
+// the maximum number of not released deposits.
+func rolloverSuccessor(
+	db weave.KVStore,
+	contracts orm.ModelBucket,
+	deposits orm.ModelBucket,
+	conf Configuration,
+	contract *DepositContract,
+	deposit *Deposit,
+	now time.Time,
+) (*DepositContract, error) {
+	if !deposit.Rollover || len(contract.SuccessorID) == 0 {
+		return nil, nil
+	}
+	var successor DepositContract
+	if err := contracts.One(db, contract.SuccessorID, &successor); err != nil {
+		return nil, errors.Wrap(err, "get successor contract")
+	}
+	if now.Before(successor.ValidSince.Time()) || !successor.ValidUntil.Time().After(now) {
+		return nil, nil
+	}
+	if limit := conf.MaxDepositsPerAddress; limit != 0 {
+		// Released deposit is no longer counted.
+		n, err := deposits.CountByIndex(db, "owner", deposit.Depositor)
+		if err != nil {
+			return nil, errors.Wrap(err, "count deposits")
+		}
+		if uint32(n) >= limit {
+			return nil, nil
+		}
+	}
+	return &successor, nil
+}
+
+func (h *releaseDepositHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ReleaseDepositMsg, *Deposit, *DepositContract, error) {
+	var msg ReleaseDepositMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	var deposit Deposit
+	if err := h.deposits.One(db, msg.DepositID, &deposit); err != nil {
+		return nil, nil, nil, err
+	}
+	if deposit.Released {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "deposit already released")
+	}
+	var contract DepositContract
+	if err := h.contracts.One(db, deposit.DepositContractID, &contract); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "get contract")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "block time")
+	}
+	if contract.ValidUntil.Time().After(now) {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "contract is not expired")
+	}
+	return &msg, &deposit, &contract, nil
+}
+
+// maxSweepBatch is the maximum number of deposits processed by a single
+// SweepDepositsMsg. It bounds the cost of the transaction.
+const maxSweepBatch = 20
+
+// maxBlockSweeps is the maximum number of deposits released by all
+// SweepDepositsMsg messages of a single block. It bounds the cost of
+// sweeping a block.
+const maxBlockSweeps = 100
+
+// sweepDepositsHandler releases deposits that were not released long after
+// the contract maturity. Anyone can sweep deposits, because funds are always
+// returned to the depositor.
+type sweepDepositsHandler struct {
+	contracts orm.ModelBucket
+	deposits  orm.ModelBucket
+	counters  orm.ModelBucket
+	cashctrl  cash.Controller
+}
+
+func (h *sweepDepositsHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: 0}, nil
+}
+
+// Deliver releases up to maxSweepBatch deposits of the contract, but no more
+// than allowed by the maxBlockSweeps limit of the current block. Only not
+// released deposits are processed, in the order of the unreleased index. The
+// result data is the key of the last processed deposit, that must be used as
+// StartAfter of the next sweep. It is empty when there are no more deposits
+// to process.
+func (h *sweepDepositsHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, contract, conf, counter, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	limit := maxSweepBatch
+	if left := maxBlockSweeps - int(counter.Count); left < limit {
+		limit = left
+	}
+	var deposits []Deposit
+	nextAfter, keys, err := h.deposits.ByIndexPage(db, "unreleased", msg.DepositContractID, msg.StartAfter, limit, &deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "deposits")
+	}
+	for i := range deposits {
+		if _, err := releaseDeposit(ctx, db, h.contracts, h.deposits, h.cashctrl, conf, contract, keys[i], &deposits[i], now); err != nil {
+			return nil, errors.Wrapf(err, "deposit %x", keys[i])
+		}
+	}
+	if len(deposits) != 0 {
+		counter.Count += uint32(len(deposits))
+		if _, err := h.counters.Put(db, sweepCounterKey, counter); err != nil {
+			return nil, errors.Wrap(err, "save sweep counter")
+		}
+	}
+	return &weave.DeliverResult{Data: nextAfter}, nil
+}
+
+func (h *sweepDepositsHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*SweepDepositsMsg, *DepositContract, Configuration, *SweepCounter, error) {
+	var msg SweepDepositsMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, Configuration{}, nil, errors.Wrap(err, "load msg")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, Configuration{}, nil, errors.Wrap(err, "load conf")
+	}
+	if conf.AutoSweepAfter == 0 {
+		return nil, nil, Configuration{}, nil, errors.Wrap(errors.ErrState, "sweeping is disabled")
+	}
+	var contract DepositContract
+	if err := h.contracts.One(db, msg.DepositContractID, &contract); err != nil {
+		return nil, nil, Configuration{}, nil, errors.Wrap(err, "get contract")
+	}
+	if !weave.IsExpired(ctx, contract.ValidUntil.Add(conf.AutoSweepAfter.Duration())) {
+		return nil, nil, Configuration{}, nil, errors.Wrap(errors.ErrState, "deposits cannot be swept yet")
+	}
+	counter, err := h.blockCounter(ctx, db)
+	if err != nil {
+		return nil, nil, Configuration{}, nil, err
+	}
+	if counter.Count >= maxBlockSweeps {
+		return nil, nil, Configuration{}, nil, errors.Wrapf(errors.ErrOverflow, "limit of %d swept deposits per block reached", maxBlockSweeps)
+	}
+	return &msg, &contract, conf, counter, nil
+}
+
+// blockCounter returns the sweep counter of the current block. A counter of
+// a previous block is reset.
+func (h *sweepDepositsHandler) blockCounter(ctx weave.Context, db weave.ReadOnlyKVStore) (*SweepCounter, error) {
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "block height not present in the context")
+	}
+	var counter SweepCounter
+	switch err := h.counters.One(db, sweepCounterKey, &counter); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+	default:
+		return nil, errors.Wrap(err, "load sweep counter")
+	}
+	if counter.Height != height {
+		counter = SweepCounter{
+			Metadata: &weave.Metadata{Schema: 1},
+			Height:   height,
+		}
+	}
+	return &counter, nil
+}
+
+type topUpDepositHandler struct {
+	auth      x.Authenticator
+	contracts orm.ModelBucket
+	deposits  orm.ModelBucket
+	cashctrl  cash.Controller
+}
+
+func (h *topUpDepositHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: 0}, nil
+}
+
+func (h *topUpDepositHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, deposit, contract, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "load conf")
+	}
+	rate, err := TopUpRate(conf, contract, deposit, msg.Amount, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "top up rate")
+	}
+	if err := cash.MoveCoins(ctx, db, h.cashctrl, deposit.Depositor, depositAccount(msg.DepositID), []*coin.Coin{&msg.Amount}); err != nil {
+		return nil, errors.Wrap(err, "deposit funds")
+	}
+	amount, err := deposit.Amount.Add(msg.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "deposit amount")
+	}
+	deposit.Amount = amount
+	deposit.Rate = rate
 	if _, err := h.deposits.Put(db, msg.DepositID, deposit); err != nil {
 		return nil, errors.Wrap(err, "store deposit")
 	}
 	return &weave.DeliverResult{Data: nil}, nil
 }
 
-func (h *releaseDepositHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ReleaseDepositMsg, *Deposit, error) {
-	var msg ReleaseDepositMsg
+func (h *topUpDepositHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TopUpDepositMsg, *Deposit, *DepositContract, error) {
+	var msg TopUpDepositMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, nil, errors.Wrap(err, "load msg")
+		return nil, nil, nil, errors.Wrap(err, "load msg")
 	}
 	var deposit Deposit
 	if err := h.deposits.One(db, msg.DepositID, &deposit); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if deposit.Released {
-		return nil, nil, errors.Wrap(errors.ErrState, "deposit already released")
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "deposit already released")
+	}
+	if !h.auth.HasAddress(ctx, deposit.Depositor) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "depositor signature is required")
+	}
+	if msg.Amount.Ticker != deposit.Amount.Ticker {
+		return nil, nil, nil, errors.Wrapf(errors.ErrCurrency, "deposit is in %s", deposit.Amount.Ticker)
 	}
 	var contract DepositContract
 	if err := h.contracts.One(db, deposit.DepositContractID, &contract); err != nil {
-		return nil, nil, errors.Wrap(err, "get contract")
+		return nil, nil, nil, errors.Wrap(err, "get contract")
 	}
 	now, err := weave.BlockTime(ctx)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "block time")
+		return nil, nil, nil, errors.Wrap(err, "block time")
 	}
-	if contract.ValidUntil.Time().After(now) {
-		return nil, nil, errors.Wrap(errors.ErrState, "contract is not expired")
+	if !contract.ValidUntil.Time().After(now) {
+		return nil, nil, nil, errors.Wrap(errors.ErrExpired, "deposit matured")
+	}
+	if err := hasFunds(db, h.cashctrl, deposit.Depositor, msg.Amount); err != nil {
+		return nil, nil, nil, err
+	}
+	return &msg, &deposit, &contract, nil
+}
+
+// TopUpRate returns the rate of given deposit after it is topped up with given
+// amount at given time. The result is the amount weighted average of the
+// current deposit rate and the rate of a new deposit locked within the same
+// contract for the remaining period:
+//
+//	rate = (amount * r + topUp * r') / (amount + topUp)
+//
+// If the result cannot be represented exactly, it is truncated to the
+// precision of topUpRatePrecision.
+// This function can be used to preview the rate of a topped up deposit.
+func TopUpRate(conf Configuration, contract *DepositContract, deposit *Deposit, topUp coin.Coin, now time.Time) (weave.Fraction, error) {
+	if topUp.Ticker != deposit.Amount.Ticker {
+		return weave.Fraction{}, errors.Wrapf(errors.ErrCurrency, "deposit is in %s", deposit.Amount.Ticker)
+	}
+	topUpRate, err := depositRate(contract, conf, topUp.Ticker, now)
+	if err != nil {
+		return weave.Fraction{}, errors.Wrap(err, "deposit rate")
+	}
+
+	current := fracUnits(deposit.Amount)
+	added := fracUnits(topUp)
+	total := new(big.Int).Add(current, added)
+	if total.Sign() <= 0 {
+		return weave.Fraction{}, errors.Wrap(errors.ErrAmount, "total amount must be greater than zero")
+	}
+
+	weighted := new(big.Rat).Mul(new(big.Rat).SetInt(current), fractionRat(deposit.Rate))
+	weighted.Add(weighted, new(big.Rat).Mul(new(big.Rat).SetInt(added), fractionRat(topUpRate)))
+	rate := weighted.Quo(weighted, new(big.Rat).SetInt(total))
+
+	if rate.Num().IsUint64() && rate.Num().Uint64() <= math.MaxUint32 &&
+		rate.Denom().IsUint64() && rate.Denom().Uint64() <= math.MaxUint32 {
+		return weave.Fraction{
+			Numerator:   uint32(rate.Num().Uint64()),
+			Denominator: uint32(rate.Denom().Uint64()),
+		}, nil
+	}
+
+	// Truncate the precision, so that the rate is never rounded up.
+	num := new(big.Int).Mul(rate.Num(), big.NewInt(topUpRatePrecision))
+	num.Quo(num, rate.Denom())
+	if !num.IsUint64() || num.Uint64() > math.MaxUint32 {
+		return weave.Fraction{}, errors.Wrap(errors.ErrOverflow, "rate")
+	}
+	return weave.Fraction{
+		Numerator:   uint32(num.Uint64()),
+		Denominator: topUpRatePrecision,
+	}.Normalize(), nil
+}
+
+// topUpRatePrecision is the denominator used by a recomputed rate that cannot
+// be represented exactly.
+const topUpRatePrecision = 1000000000
+
+// fracUnits returns given coin value in fractional units.
+func fracUnits(c coin.Coin) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(c.Whole), big.NewInt(coin.FracUnit))
+	return n.Add(n, big.NewInt(c.Fractional))
+}
+
+// fractionRat returns given fraction as a rational number. A zero fraction is
+// zero.
+func fractionRat(f weave.Fraction) *big.Rat {
+	if f.Denominator == 0 {
+		return new(big.Rat)
 	}
-	return &msg, &deposit, nil
+	return big.NewRat(int64(f.Numerator), int64(f.Denominator))
 }
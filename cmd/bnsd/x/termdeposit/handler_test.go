@@ -1,7 +1,9 @@
 package termdeposit
 
 import (
+	"bytes"
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -44,6 +46,13 @@ func TestUseCases(t *testing.T) {
 		Funds     []AccountBalance
 		AfterTest func(t *testing.T, db weave.KVStore)
 		Bonuses   []DepositBonus
+		// Post maturity configuration.
+		Grace  weave.UnixDuration
+		Rate   weave.Fraction
+		Mode   InterestMode
+		Period weave.UnixDuration
+		// Zero means no limit.
+		MaxDeposits uint32
 	}{
 		"admin can create a contarct": {
 			Requests: []Request{
@@ -191,6 +200,94 @@ func TestUseCases(t *testing.T) {
 				}
 			},
 		},
+		"deposit with a nonce cannot be created twice": {
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
+			},
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+							Nonce:             []byte("first"),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+							Nonce:             []byte("first"),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrDuplicate,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+							Nonce:             []byte("second"),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				assertFunds(t, db, bobCond.Address(), coin.NewCoin(98, 0, "IOV"))
+
+				key := contentDepositKey(&DepositMsg{
+					DepositContractID: weavetest.SequenceID(1),
+					Amount:            coin.NewCoin(1, 0, "IOV"),
+					Depositor:         bobCond.Address(),
+					Nonce:             []byte("first"),
+				})
+				var d Deposit
+				if err := NewDepositBucket().One(db, key, &d); err != nil {
+					t.Fatalf("cannot get deposit: %s", err)
+				}
+				if d.CreatedAt != now+1 {
+					t.Fatalf("invalid created at time: %d != %d", d.CreatedAt, now+1)
+				}
+				assertFunds(t, db, depositAccount(key), coin.NewCoin(1, 0, "IOV"))
+
+				// Sequence is not used for deposits with a nonce.
+				if err := NewDepositBucket().Has(db, weavetest.SequenceID(2)); !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want not found error, got %+v", err)
+				}
+			},
+		},
 		"deposit cannot be created for a contract that is not yet active": {
 			Funds: []AccountBalance{
 				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
@@ -426,8 +523,13 @@ func TestUseCases(t *testing.T) {
 								Metadata: &weave.Metadata{Schema: 1},
 								Owner:    aliceCond.Address(),
 								Admin:    bobCond.Address(),
-								Bonuses: []DepositBonus{
-									{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+										},
+									},
 								},
 							},
 						},
@@ -445,8 +547,13 @@ func TestUseCases(t *testing.T) {
 								Metadata: &weave.Metadata{Schema: 1},
 								Owner:    aliceCond.Address(),
 								Admin:    bobCond.Address(),
-								Bonuses: []DepositBonus{
-									{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+										},
+									},
 								},
 							},
 						},
@@ -464,8 +571,13 @@ func TestUseCases(t *testing.T) {
 								Metadata: &weave.Metadata{Schema: 1},
 								Owner:    bobCond.Address(),
 								Admin:    charlieCond.Address(),
-								Bonuses: []DepositBonus{
-									{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+										},
+									},
 								},
 							},
 						},
@@ -475,6 +587,112 @@ func TestUseCases(t *testing.T) {
 				},
 			},
 		},
+		"configuration update cannot use the owner as the admin": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata: &weave.Metadata{Schema: 1},
+								Owner:    adminCond.Address(),
+								Admin:    adminCond.Address(),
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+										},
+									},
+								},
+							},
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     errors.ErrInput,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata: &weave.Metadata{Schema: 1},
+								Owner:    adminCond.Address(),
+								Admin:    bobCond.Address(),
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+										},
+									},
+								},
+							},
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+			},
+		},
+		"configuration update cannot declare an expired bonus": {
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata: &weave.Metadata{Schema: 1},
+								Owner:    adminCond.Address(),
+								Admin:    bobCond.Address(),
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+											{LockinPeriod: asDays(2), Bonus: weave.Fraction{Numerator: 2, Denominator: 10}, ValidUntil: now - 1},
+										},
+									},
+								},
+							},
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     errors.ErrExpired,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &UpdateConfigurationMsg{
+							Metadata: &weave.Metadata{Schema: 1},
+							Patch: &Configuration{
+								Metadata: &weave.Metadata{Schema: 1},
+								Owner:    adminCond.Address(),
+								Admin:    bobCond.Address(),
+								Bonuses: []DenomBonuses{
+									{
+										Denom: "IOV",
+										Bonuses: []DepositBonus{
+											{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+											{LockinPeriod: asDays(2), Bonus: weave.Fraction{Numerator: 2, Denominator: 10}, ValidUntil: now.Add(time.Hour)},
+										},
+									},
+								},
+							},
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+			},
+		},
 		"when a deposit is released all wallet funds are sent, not only originally allocated ones": {
 			Funds: []AccountBalance{
 				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
@@ -543,148 +761,648 @@ func TestUseCases(t *testing.T) {
 				assertFunds(t, db, charlieCond.Address(), coin.NewCoin(93, 0, "IOV"))
 			},
 		},
-	}
-
-	for testName, tc := range cases {
-		t.Run(testName, func(t *testing.T) {
-			db := store.MemStore()
-			migration.MustInitPkg(db, "termdeposit", "cash")
-
-			rt := app.NewRouter()
-			auth := &weavetest.CtxAuth{Key: "auth"}
-			ctrl := cash.NewController(cash.NewBucket())
-			RegisterRoutes(rt, auth, ctrl)
-
-			// Required for transferring tokens request.
-			cash.RegisterRoutes(rt, auth, ctrl)
-
-			for _, b := range tc.Funds {
-				if err := ctrl.CoinMint(db, b.Wallet, b.Amount); err != nil {
-					t.Fatalf("cannot mint coins for %q: %s", b.Wallet, err)
-				}
-			}
-
-			bonuses := tc.Bonuses
-			if len(bonuses) == 0 {
-				bonuses = []DepositBonus{{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}}}
-			}
-
-			config := Configuration{
-				Metadata: &weave.Metadata{Schema: 1},
-				Owner:    adminCond.Address(),
-				Admin:    adminCond.Address(),
-				Bonuses:  bonuses,
-			}
-			if err := gconf.Save(db, "termdeposit", &config); err != nil {
-				t.Fatalf("cannot save configuration: %s", err)
-			}
-
-			for i, req := range tc.Requests {
-				ctx := weave.WithHeight(context.Background(), req.BlockHeight)
-				ctx = weave.WithChainID(ctx, "testchain-123")
-				ctx = auth.SetConditions(ctx, req.Conditions...)
-				ctx = weave.WithBlockTime(ctx, req.Now.Time())
-
-				cache := db.CacheWrap()
-				if _, err := rt.Check(ctx, cache, req.Tx); !req.WantErr.Is(err) {
-					t.Fatalf("unexpected %d check error: want %q, got %+v", i, req.WantErr, err)
-				}
-				cache.Discard()
-				if _, err := rt.Deliver(ctx, db, req.Tx); !req.WantErr.Is(err) {
-					t.Fatalf("unexpected %d deliver error: want %q, got %+v", i, req.WantErr, err)
-				} else if err == nil {
-					if err := cache.Write(); err != nil {
-						t.Fatalf("cannot write cache: %s", err)
-					}
-				}
-			}
-
-			if tc.AfterTest != nil {
-				tc.AfterTest(t, db)
-			}
-		})
-	}
-}
-
-func assertFunds(t testing.TB, db weave.KVStore, wallet weave.Address, funds coin.Coin) {
-	t.Helper()
-
-	ctrl := cash.NewController(cash.NewBucket())
-	coins, err := ctrl.Balance(db, wallet)
-	if err != nil {
-		t.Fatalf("balance: %s", err)
-	}
-	if len(coins) != 1 {
-		t.Fatalf("want %q funds, found %d coins: %q", funds, len(coins), coins)
-	}
-	if !coins[0].Equals(funds) {
-		t.Fatalf("unexpected funds found: %q", coins[0])
-	}
-}
-
-func TestDepositRateComputation(t *testing.T) {
-	cases := map[string]struct {
-		contract DepositContract
-		conf     Configuration
-		now      time.Time
-		wantFrac weave.Fraction
-		wantErr  *errors.Error
-	}{
-		"deposit duration between bonuses": {
-			contract: DepositContract{
-				ValidSince: 946684800, // 1 Jan 2000
-				ValidUntil: 951004800, // 20 Feb 2000
+		"released deposit records the post maturity accrual": {
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
 			},
-			conf: Configuration{
-				Bonuses: []DepositBonus{
-					{LockinPeriod: asDays(10), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
-					{LockinPeriod: asDays(30), Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
-					{LockinPeriod: asDays(40), Bonus: weave.Fraction{Numerator: 5, Denominator: 10}},
-					{LockinPeriod: asDays(80), Bonus: weave.Fraction{Numerator: 8, Denominator: 10}},
+			Grace: asDays(10),
+			Rate:  weave.Fraction{Numerator: 1, Denominator: 100},
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
 				},
-			},
-			now: asTime(t, "1 Feb 2000"),
-
-			wantFrac: weave.Fraction{Numerator: 19, Denominator: 100},
-			wantErr:  nil,
-		},
-		"deposit duration below minimal bonus": {
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(50, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					// Half of the grace period after the maturity.
+					Now: now.Add(2*time.Hour + 5*24*time.Hour),
+					Tx: &weavetest.Tx{
+						Msg: &ReleaseDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				var d Deposit
+				if err := NewDepositBucket().One(db, weavetest.SequenceID(2), &d); err != nil {
+					t.Fatalf("cannot get deposit: %s", err)
+				}
+				if want := coin.NewCoin(0, 250000000, "IOV"); !d.PostMaturityAccrual.Equals(want) {
+					t.Fatalf("want %v post maturity accrual, got %v", want, d.PostMaturityAccrual)
+				}
+				// Accrual is paid offchain.
+				assertFunds(t, db, bobCond.Address(), coin.NewCoin(100, 0, "IOV"))
+			},
+		},
+		"compound post maturity accrual is equal to the preview": {
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
+			},
+			Grace:  asDays(10),
+			Rate:   weave.Fraction{Numerator: 1, Denominator: 100},
+			Mode:   InterestMode_CompoundInterest,
+			Period: asDays(1),
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(50, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					// Five and a half compounding periods after the maturity.
+					Now: now.Add(2*time.Hour + 5*24*time.Hour + 12*time.Hour),
+					Tx: &weavetest.Tx{
+						Msg: &ReleaseDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				var d Deposit
+				if err := NewDepositBucket().One(db, weavetest.SequenceID(2), &d); err != nil {
+					t.Fatalf("cannot get deposit: %s", err)
+				}
+				if want := coin.NewCoin(0, 275625750, "IOV"); !d.PostMaturityAccrual.Equals(want) {
+					t.Fatalf("want %v post maturity accrual, got %v", want, d.PostMaturityAccrual)
+				}
+
+				var c DepositContract
+				if err := NewDepositContractBucket().One(db, weavetest.SequenceID(1), &c); err != nil {
+					t.Fatalf("cannot get deposit contract: %s", err)
+				}
+				var conf Configuration
+				if err := gconf.Load(db, "termdeposit", &conf); err != nil {
+					t.Fatalf("cannot load configuration: %s", err)
+				}
+				preview, err := PostMaturityAccrual(conf, &c, &d, now.Add(2*time.Hour+5*24*time.Hour+12*time.Hour).Time())
+				if err != nil {
+					t.Fatalf("cannot preview accrual: %s", err)
+				}
+				if want := coin.NewCoin(0, 275625750, "IOV"); !preview.Equals(want) {
+					t.Fatalf("want %v preview, got %v", want, preview)
+				}
+			},
+		},
+		"deposit can be topped up by the depositor": {
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
+			},
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(10, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &TopUpDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+							Amount:    coin.NewCoin(5, 0, "IOV"),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrUnauthorized,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &TopUpDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+							Amount:    coin.NewCoin(5, 0, "ETH"),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     errors.ErrCurrency,
+				},
+				{
+					Now:        now + 4,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &TopUpDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+							Amount:    coin.NewCoin(5, 0, "IOV"),
+						},
+					},
+					BlockHeight: 104,
+					WantErr:     nil,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				assertFunds(t, db, bobCond.Address(), coin.NewCoin(85, 0, "IOV"))
+				assertFunds(t, db, depositAccount(weavetest.SequenceID(2)), coin.NewCoin(15, 0, "IOV"))
+
+				var d Deposit
+				if err := NewDepositBucket().One(db, weavetest.SequenceID(2), &d); err != nil {
+					t.Fatalf("cannot get deposit: %s", err)
+				}
+				if want := coin.NewCoin(15, 0, "IOV"); !d.Amount.Equals(want) {
+					t.Fatalf("want %v deposit amount, got %v", want, d.Amount)
+				}
+				if want := (weave.Fraction{Numerator: 1, Denominator: 10}); d.Rate != want {
+					t.Fatalf("want %v rate, got %v", want, d.Rate)
+				}
+			},
+		},
+		"matured deposit cannot be topped up": {
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(100, 0, "IOV")},
+			},
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(10, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now.Add(3 * time.Hour),
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &TopUpDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+							Amount:    coin.NewCoin(5, 0, "IOV"),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     errors.ErrExpired,
+				},
+				{
+					Now: now.Add(3 * time.Hour),
+					Tx: &weavetest.Tx{
+						Msg: &ReleaseDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     nil,
+				},
+				{
+					Now:        now.Add(3 * time.Hour),
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &TopUpDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(2),
+							Amount:    coin.NewCoin(5, 0, "IOV"),
+						},
+					},
+					BlockHeight: 104,
+					WantErr:     errors.ErrState,
+				},
+			},
+			AfterTest: func(t *testing.T, db weave.KVStore) {
+				assertFunds(t, db, bobCond.Address(), coin.NewCoin(100, 0, "IOV"))
+			},
+		},
+		"number of not released deposits of a single depositor is limited": {
+			MaxDeposits: 2,
+			Funds: []AccountBalance{
+				{Wallet: bobCond.Address(), Amount: coin.NewCoin(10, 0, "IOV")},
+				{Wallet: aliceCond.Address(), Amount: coin.NewCoin(10, 0, "IOV")},
+			},
+			Requests: []Request{
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(2 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now,
+					Conditions: []weave.Condition{adminCond},
+					Tx: &weavetest.Tx{
+						Msg: &CreateDepositContractMsg{
+							Metadata:   &weave.Metadata{Schema: 1},
+							ValidSince: now,
+							ValidUntil: now.Add(10 * time.Hour),
+						},
+					},
+					BlockHeight: 100,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 1,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 101,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 2,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(1),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 102,
+					WantErr:     nil,
+				},
+				{
+					Now:        now + 3,
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(2),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 103,
+					WantErr:     ErrDepositLimit,
+				},
+				{
+					// Limit is applied per depositor.
+					Now:        now + 4,
+					Conditions: []weave.Condition{aliceCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(2),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         aliceCond.Address(),
+						},
+					},
+					BlockHeight: 104,
+					WantErr:     nil,
+				},
+				{
+					Now: now.Add(3 * time.Hour),
+					Tx: &weavetest.Tx{
+						Msg: &ReleaseDepositMsg{
+							Metadata:  &weave.Metadata{Schema: 1},
+							DepositID: weavetest.SequenceID(3),
+						},
+					},
+					BlockHeight: 105,
+					WantErr:     nil,
+				},
+				{
+					// Released deposits are not counted.
+					Now:        now.Add(3 * time.Hour),
+					Conditions: []weave.Condition{bobCond},
+					Tx: &weavetest.Tx{
+						Msg: &DepositMsg{
+							Metadata:          &weave.Metadata{Schema: 1},
+							DepositContractID: weavetest.SequenceID(2),
+							Amount:            coin.NewCoin(1, 0, "IOV"),
+							Depositor:         bobCond.Address(),
+						},
+					},
+					BlockHeight: 106,
+					WantErr:     nil,
+				},
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "termdeposit", "cash")
+
+			rt := app.NewRouter()
+			auth := &weavetest.CtxAuth{Key: "auth"}
+			ctrl := cash.NewController(cash.NewBucket())
+			RegisterRoutes(rt, auth, ctrl)
+
+			// Required for transferring tokens request.
+			cash.RegisterRoutes(rt, auth, ctrl)
+
+			for _, b := range tc.Funds {
+				if err := ctrl.CoinMint(db, b.Wallet, b.Amount); err != nil {
+					t.Fatalf("cannot mint coins for %q: %s", b.Wallet, err)
+				}
+			}
+
+			bonuses := tc.Bonuses
+			if len(bonuses) == 0 {
+				bonuses = []DepositBonus{{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}}}
+			}
+
+			config := Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    adminCond.Address(),
+				Admin:    adminCond.Address(),
+				Bonuses: []DenomBonuses{
+					{Denom: "IOV", Bonuses: bonuses},
+				},
+				PostMaturityGrace:     tc.Grace,
+				PostMaturityRate:      tc.Rate,
+				InterestMode:          tc.Mode,
+				CompoundingPeriod:     tc.Period,
+				MaxDepositsPerAddress: tc.MaxDeposits,
+			}
+			if err := gconf.Save(db, "termdeposit", &config); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			if err := BuildOwnerIndex(db); err != nil {
+				t.Fatalf("cannot build owner index: %s", err)
+			}
+
+			for i, req := range tc.Requests {
+				ctx := weave.WithHeight(context.Background(), req.BlockHeight)
+				ctx = weave.WithChainID(ctx, "testchain-123")
+				ctx = auth.SetConditions(ctx, req.Conditions...)
+				ctx = weave.WithBlockTime(ctx, req.Now.Time())
+
+				cache := db.CacheWrap()
+				if _, err := rt.Check(ctx, cache, req.Tx); !req.WantErr.Is(err) {
+					t.Fatalf("unexpected %d check error: want %q, got %+v", i, req.WantErr, err)
+				}
+				cache.Discard()
+				if _, err := rt.Deliver(ctx, db, req.Tx); !req.WantErr.Is(err) {
+					t.Fatalf("unexpected %d deliver error: want %q, got %+v", i, req.WantErr, err)
+				} else if err == nil {
+					if err := cache.Write(); err != nil {
+						t.Fatalf("cannot write cache: %s", err)
+					}
+				}
+			}
+
+			if tc.AfterTest != nil {
+				tc.AfterTest(t, db)
+			}
+		})
+	}
+}
+
+func assertFunds(t testing.TB, db weave.KVStore, wallet weave.Address, funds coin.Coin) {
+	t.Helper()
+
+	ctrl := cash.NewController(cash.NewBucket())
+	coins, err := ctrl.Balance(db, wallet)
+	if err != nil {
+		t.Fatalf("balance: %s", err)
+	}
+	if len(coins) != 1 {
+		t.Fatalf("want %q funds, found %d coins: %q", funds, len(coins), coins)
+	}
+	if !coins[0].Equals(funds) {
+		t.Fatalf("unexpected funds found: %q", coins[0])
+	}
+}
+
+func TestDepositRateComputation(t *testing.T) {
+	cases := map[string]struct {
+		contract DepositContract
+		conf     Configuration
+		now      time.Time
+		wantFrac weave.Fraction
+		wantErr  *errors.Error
+	}{
+		"deposit duration between bonuses": {
 			contract: DepositContract{
 				ValidSince: 946684800, // 1 Jan 2000
 				ValidUntil: 951004800, // 20 Feb 2000
 			},
 			conf: Configuration{
-				Bonuses: []DepositBonus{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(10), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+					{LockinPeriod: asDays(30), Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
+					{LockinPeriod: asDays(40), Bonus: weave.Fraction{Numerator: 5, Denominator: 10}},
+					{LockinPeriod: asDays(80), Bonus: weave.Fraction{Numerator: 8, Denominator: 10}},
+				}}},
+			},
+			now: asTime(t, "1 Feb 2000"),
+
+			wantFrac: weave.Fraction{Numerator: 19, Denominator: 100},
+			wantErr:  nil,
+		},
+		"deposit duration below minimal bonus": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
+			},
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
 					{LockinPeriod: asDays(10), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
 					{LockinPeriod: asDays(20), Bonus: weave.Fraction{Numerator: 3, Denominator: 10}},
-				},
+				}}},
+			},
+			now: asTime(t, "15 Feb 2000"),
+
+			wantFrac: weave.Fraction{Numerator: 10, Denominator: 100},
+			wantErr:  nil,
+		},
+		"deposit duration above maximum bonus": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
+			},
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+					{LockinPeriod: asDays(4), Bonus: weave.Fraction{Numerator: 8, Denominator: 10}},
+				}}},
+			},
+			now: asTime(t, "2 Jan 2000"),
+
+			wantFrac: weave.Fraction{Numerator: 80, Denominator: 100},
+			wantErr:  nil,
+		},
+		"denomination without a bonus ladder": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
+			},
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "ETH", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+				}}},
+			},
+			now: asTime(t, "2 Jan 2000"),
+
+			wantFrac: weave.Fraction{},
+			wantErr:  nil,
+		},
+		"expired bonuses are ignored": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
+			},
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+					{LockinPeriod: asDays(4), Bonus: weave.Fraction{Numerator: 8, Denominator: 10}, ValidUntil: 946771200}, // 2 Jan 2000
+				}}},
+			},
+			now: asTime(t, "3 Jan 2000"),
+
+			wantFrac: weave.Fraction{Numerator: 10, Denominator: 100},
+			wantErr:  nil,
+		},
+		"all bonuses expired": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
+			},
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}, ValidUntil: 946771200}, // 2 Jan 2000
+				}}},
+			},
+			now: asTime(t, "3 Jan 2000"),
+
+			wantFrac: weave.Fraction{},
+			wantErr:  nil,
+		},
+		"bonus expires at its valid until time": {
+			contract: DepositContract{
+				ValidSince: 946684800, // 1 Jan 2000
+				ValidUntil: 951004800, // 20 Feb 2000
 			},
-			now: asTime(t, "15 Feb 2000"),
+			conf: Configuration{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}, ValidUntil: 946771200}, // 2 Jan 2000
+				}}},
+			},
+			now: asTime(t, "2 Jan 2000"),
 
-			wantFrac: weave.Fraction{Numerator: 10, Denominator: 100},
+			wantFrac: weave.Fraction{},
 			wantErr:  nil,
 		},
-		"deposit duration above maximum bonus": {
+		"contract expires at its valid until time": {
 			contract: DepositContract{
 				ValidSince: 946684800, // 1 Jan 2000
 				ValidUntil: 951004800, // 20 Feb 2000
 			},
 			conf: Configuration{
-				Bonuses: []DepositBonus{
+				Bonuses: []DenomBonuses{{Denom: "IOV", Bonuses: []DepositBonus{
 					{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
-					{LockinPeriod: asDays(4), Bonus: weave.Fraction{Numerator: 8, Denominator: 10}},
-				},
+				}}},
 			},
-			now: asTime(t, "2 Jan 2000"),
+			now: asTime(t, "20 Feb 2000"),
 
-			wantFrac: weave.Fraction{Numerator: 80, Denominator: 100},
-			wantErr:  nil,
+			wantFrac: weave.Fraction{},
+			wantErr:  errors.ErrExpired,
 		},
 	}
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			f, err := depositRate(&tc.contract, tc.conf, tc.now)
+			f, err := depositRate(&tc.contract, tc.conf, "IOV", tc.now)
 			if !tc.wantErr.Is(err) {
 				t.Fatalf("unexpected error: %+v", err)
 			}
@@ -707,3 +1425,800 @@ func asTime(t testing.TB, s string) time.Time {
 func asDays(days int) weave.UnixDuration {
 	return weave.AsUnixDuration(time.Duration(days) * 24 * time.Hour)
 }
+
+func TestPostMaturityAccrual(t *testing.T) {
+	contract := DepositContract{
+		ValidSince: 946684800, // 1 Jan 2000
+		ValidUntil: 951004800, // 20 Feb 2000
+	}
+	conf := Configuration{
+		PostMaturityGrace: asDays(10),
+		PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 100},
+	}
+
+	cases := map[string]struct {
+		conf    Configuration
+		amount  coin.Coin
+		claimAt time.Time
+		want    coin.Coin
+	}{
+		"zero grace period stops accrual at the maturity": {
+			conf:    Configuration{PostMaturityRate: weave.Fraction{Numerator: 1, Denominator: 100}},
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "25 Feb 2000"),
+			want:    coin.NewCoin(0, 0, "IOV"),
+		},
+		"zero rate does not accrue": {
+			conf:    Configuration{PostMaturityGrace: asDays(10)},
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "25 Feb 2000"),
+			want:    coin.NewCoin(0, 0, "IOV"),
+		},
+		"claim before the maturity": {
+			conf:    conf,
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "15 Feb 2000"),
+			want:    coin.NewCoin(0, 0, "IOV"),
+		},
+		"claim within the grace period": {
+			conf:    conf,
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "25 Feb 2000"),
+			want:    coin.NewCoin(0, 500000000, "IOV"),
+		},
+		"claim after the grace period": {
+			conf:    conf,
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(1, 0, "IOV"),
+		},
+		"result is truncated": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 2},
+			},
+			amount:  coin.NewCoin(0, 3, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 1, "IOV"),
+		},
+		"ceil rounding rounds the remainder up": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 10},
+				RoundingMode:      RoundingMode_RoundCeil,
+			},
+			amount:  coin.NewCoin(0, 3, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 1, "IOV"),
+		},
+		"half even rounding of a value below one half": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 10},
+				RoundingMode:      RoundingMode_RoundHalfEven,
+			},
+			amount:  coin.NewCoin(0, 3, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 0, "IOV"),
+		},
+		"half even rounding of a value above one half": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 10},
+				RoundingMode:      RoundingMode_RoundHalfEven,
+			},
+			amount:  coin.NewCoin(0, 7, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 1, "IOV"),
+		},
+		"half even rounding of one half to an even value": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 2},
+				RoundingMode:      RoundingMode_RoundHalfEven,
+			},
+			amount:  coin.NewCoin(0, 3, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 2, "IOV"),
+		},
+		"half even rounding of two and a half to an even value": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 2},
+				RoundingMode:      RoundingMode_RoundHalfEven,
+			},
+			amount:  coin.NewCoin(0, 5, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 2, "IOV"),
+		},
+		"compound interest is rounded in each period": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 100},
+				InterestMode:      InterestMode_CompoundInterest,
+				CompoundingPeriod: asDays(1),
+				RoundingMode:      RoundingMode_RoundCeil,
+			},
+			amount:  coin.NewCoin(0, 1, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(0, 10, "IOV"),
+		},
+		"compound interest of completed periods": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 100},
+				InterestMode:      InterestMode_CompoundInterest,
+				CompoundingPeriod: asDays(1),
+			},
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "25 Feb 2000"),
+			want:    coin.NewCoin(0, 501001000, "IOV"),
+		},
+		"compound interest after the grace period": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 100},
+				InterestMode:      InterestMode_CompoundInterest,
+				CompoundingPeriod: asDays(1),
+			},
+			amount:  coin.NewCoin(100, 0, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(1, 4512019, "IOV"),
+		},
+		"maximum amount does not overflow": {
+			conf: Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 1, Denominator: 1},
+			},
+			amount:  coin.NewCoin(coin.MaxInt, coin.MaxFrac, "IOV"),
+			claimAt: asTime(t, "1 Apr 2000"),
+			want:    coin.NewCoin(coin.MaxInt, coin.MaxFrac, "IOV"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			deposit := Deposit{Amount: tc.amount}
+			got, err := PostMaturityAccrual(tc.conf, &contract, &deposit, tc.claimAt)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !tc.want.Equals(got) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestPostMaturityAccrualIsDeterministic ensures that the accrual computed
+// for the same input is byte identical under each rounding mode, no matter
+// how many times and how concurrently it is computed. Any difference between
+// nodes is a consensus failure.
+func TestPostMaturityAccrualIsDeterministic(t *testing.T) {
+	contract := DepositContract{
+		ValidSince: 946684800, // 1 Jan 2000
+		ValidUntil: 951004800, // 20 Feb 2000
+	}
+	amounts := []coin.Coin{
+		coin.NewCoin(0, 1, "IOV"),
+		coin.NewCoin(0, 999999999, "IOV"),
+		coin.NewCoin(123, 456789, "IOV"),
+		coin.NewCoin(coin.MaxInt, coin.MaxFrac, "IOV"),
+	}
+	claims := []time.Time{
+		asTime(t, "21 Feb 2000"),
+		asTime(t, "25 Feb 2000").Add(7 * time.Second),
+		asTime(t, "1 Apr 2000"),
+	}
+
+	for _, mode := range []RoundingMode{RoundingMode_RoundFloor, RoundingMode_RoundCeil, RoundingMode_RoundHalfEven} {
+		for _, interest := range []InterestMode{InterestMode_SimpleInterest, InterestMode_CompoundInterest} {
+			conf := Configuration{
+				PostMaturityGrace: asDays(10),
+				PostMaturityRate:  weave.Fraction{Numerator: 7, Denominator: 333},
+				InterestMode:      interest,
+				RoundingMode:      mode,
+			}
+			if interest == InterestMode_CompoundInterest {
+				conf.CompoundingPeriod = asDays(1)
+			}
+
+			// Each node computes all payouts and serializes them.
+			const nodes = 8
+			results := make([][]byte, nodes)
+			var wg sync.WaitGroup
+			for n := 0; n < nodes; n++ {
+				wg.Add(1)
+				go func(n int) {
+					defer wg.Done()
+					for _, amount := range amounts {
+						for _, claimAt := range claims {
+							deposit := Deposit{Amount: amount}
+							accrual, err := PostMaturityAccrual(conf, &contract, &deposit, claimAt)
+							if err != nil {
+								t.Errorf("%s: cannot compute accrual: %s", mode, err)
+								return
+							}
+							raw, err := accrual.Marshal()
+							if err != nil {
+								t.Errorf("%s: cannot marshal accrual: %s", mode, err)
+								return
+							}
+							results[n] = append(results[n], raw...)
+						}
+					}
+				}(n)
+			}
+			wg.Wait()
+
+			for n := 1; n < nodes; n++ {
+				if !bytes.Equal(results[0], results[n]) {
+					t.Fatalf("%s, %s: node %d computed a different payout", mode, interest, n)
+				}
+			}
+		}
+	}
+}
+
+func TestTopUpRate(t *testing.T) {
+	contract := DepositContract{
+		ValidSince: 946684800, // 1 Jan 2000
+		ValidUntil: 951004800, // 20 Feb 2000
+	}
+	conf := Configuration{
+		Bonuses: []DenomBonuses{
+			{
+				Denom: "IOV",
+				Bonuses: []DepositBonus{
+					{LockinPeriod: asDays(10), Bonus: weave.Fraction{Numerator: 1, Denominator: 100}},
+					{LockinPeriod: asDays(30), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		deposit Deposit
+		topUp   coin.Coin
+		now     time.Time
+		want    weave.Fraction
+		wantErr *errors.Error
+	}{
+		"same rate is not changed": {
+			deposit: Deposit{Amount: coin.NewCoin(100, 0, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 10}},
+			topUp:   coin.NewCoin(100, 0, "IOV"),
+			now:     asTime(t, "2 Jan 2000"),
+			want:    weave.Fraction{Numerator: 1, Denominator: 10},
+		},
+		"equal amounts are averaged": {
+			deposit: Deposit{Amount: coin.NewCoin(100, 0, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 10}},
+			topUp:   coin.NewCoin(100, 0, "IOV"),
+			now:     asTime(t, "15 Feb 2000"),
+			want:    weave.Fraction{Numerator: 11, Denominator: 200},
+		},
+		"rates are weighted by the amount": {
+			deposit: Deposit{Amount: coin.NewCoin(300, 0, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 10}},
+			topUp:   coin.NewCoin(100, 0, "IOV"),
+			now:     asTime(t, "15 Feb 2000"),
+			want:    weave.Fraction{Numerator: 31, Denominator: 400},
+		},
+		"deposit without a rate": {
+			deposit: Deposit{Amount: coin.NewCoin(100, 0, "IOV")},
+			topUp:   coin.NewCoin(100, 0, "IOV"),
+			now:     asTime(t, "2 Jan 2000"),
+			want:    weave.Fraction{Numerator: 1, Denominator: 20},
+		},
+		"result is truncated": {
+			deposit: Deposit{Amount: coin.NewCoin(0, 1, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 3}},
+			topUp:   coin.NewCoin(2, 0, "IOV"),
+			now:     asTime(t, "15 Feb 2000"),
+			want:    weave.Fraction{Numerator: 1, Denominator: 100},
+		},
+		"denomination must match": {
+			deposit: Deposit{Amount: coin.NewCoin(100, 0, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 10}},
+			topUp:   coin.NewCoin(100, 0, "ETH"),
+			now:     asTime(t, "2 Jan 2000"),
+			wantErr: errors.ErrCurrency,
+		},
+		"matured contract": {
+			deposit: Deposit{Amount: coin.NewCoin(100, 0, "IOV"), Rate: weave.Fraction{Numerator: 1, Denominator: 10}},
+			topUp:   coin.NewCoin(100, 0, "IOV"),
+			now:     asTime(t, "21 Feb 2000"),
+			wantErr: errors.ErrExpired,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := TopUpRate(conf, &contract, &tc.deposit, tc.topUp, tc.now)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSweepDeposits(t *testing.T) {
+	var (
+		adminCond = weavetest.NewCondition()
+		aliceCond = weavetest.NewCondition()
+		bobCond   = weavetest.NewCondition()
+
+		now = weave.UnixTime(1572247483)
+	)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit", "cash")
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	ctrl := cash.NewController(cash.NewBucket())
+	RegisterRoutes(rt, auth, ctrl)
+
+	config := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    adminCond.Address(),
+		Admin:    adminCond.Address(),
+		Bonuses: []DenomBonuses{
+			{Denom: "IOV", Bonuses: []DepositBonus{{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}}}},
+		},
+		InterestMode: InterestMode_SimpleInterest,
+	}
+	if err := gconf.Save(db, "termdeposit", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	contracts := NewDepositContractBucket()
+	contractID, err := contracts.Put(db, nil, &DepositContract{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ValidSince: now.Add(-2 * time.Hour),
+		ValidUntil: now.Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("cannot store contract: %s", err)
+	}
+	otherContractID, err := contracts.Put(db, nil, &DepositContract{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ValidSince: now.Add(-2 * time.Hour),
+		ValidUntil: now.Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("cannot store contract: %s", err)
+	}
+
+	// One more deposit than can be swept by a single message, one deposit
+	// that was already released and one deposit of a different contract.
+	deposits := NewDepositBucket()
+	createDeposit := func(contractID []byte, depositor weave.Address, released bool) []byte {
+		t.Helper()
+		key, err := depositSeq.NextVal(db)
+		if err != nil {
+			t.Fatalf("cannot acquire key: %s", err)
+		}
+		amount := coin.NewCoin(1, 0, "IOV")
+		if !released {
+			if err := ctrl.CoinMint(db, depositAccount(key), amount); err != nil {
+				t.Fatalf("cannot mint coins: %s", err)
+			}
+		}
+		deposit := Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: contractID,
+			Rate:              weave.Fraction{Numerator: 1, Denominator: 10},
+			Amount:            amount,
+			Depositor:         depositor,
+			Released:          released,
+			CreatedAt:         now.Add(-90 * time.Minute),
+		}
+		if _, err := deposits.Put(db, key, &deposit); err != nil {
+			t.Fatalf("cannot store deposit: %s", err)
+		}
+		return key
+	}
+	for i := 0; i < maxSweepBatch; i++ {
+		createDeposit(contractID, aliceCond.Address(), false)
+	}
+	createDeposit(contractID, bobCond.Address(), true)
+	createDeposit(contractID, bobCond.Address(), false)
+	otherDeposit := createDeposit(otherContractID, bobCond.Address(), false)
+
+	// Deposits were created before the unreleased index.
+	if err := BuildUnreleasedIndex(db); err != nil {
+		t.Fatalf("cannot build unreleased index: %s", err)
+	}
+
+	sweepAt := func(height int64, after []byte, at weave.UnixTime) ([]byte, error) {
+		t.Helper()
+		ctx := weave.WithHeight(context.Background(), height)
+		ctx = weave.WithChainID(ctx, "testchain-123")
+		ctx = weave.WithBlockTime(ctx, at.Time())
+		tx := &weavetest.Tx{
+			Msg: &SweepDepositsMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				DepositContractID: contractID,
+				StartAfter:        after,
+			},
+		}
+		cache := db.CacheWrap()
+		if _, err := rt.Check(ctx, cache, tx); err != nil {
+			cache.Discard()
+			return nil, err
+		}
+		cache.Discard()
+		res, err := rt.Deliver(ctx, db, tx)
+		if err != nil {
+			return nil, err
+		}
+		return res.Data, nil
+	}
+	sweep := func(after []byte, at weave.UnixTime) ([]byte, error) {
+		t.Helper()
+		return sweepAt(100, after, at)
+	}
+
+	if _, err := sweep(nil, now); !errors.ErrState.Is(err) {
+		t.Fatalf("sweeping must be disabled by default: %+v", err)
+	}
+
+	config.AutoSweepAfter = weave.AsUnixDuration(2 * time.Hour)
+	if err := gconf.Save(db, "termdeposit", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if _, err := sweep(nil, now); !errors.ErrState.Is(err) {
+		t.Fatalf("deposits must not be swept too early: %+v", err)
+	}
+
+	after, err := sweep(nil, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("cannot sweep: %+v", err)
+	}
+	if len(after) == 0 {
+		t.Fatal("more deposits to sweep expected")
+	}
+	assertFunds(t, db, aliceCond.Address(), coin.NewCoin(maxSweepBatch, 0, "IOV"))
+	if coins, err := ctrl.Balance(db, bobCond.Address()); err == nil || len(coins) != 0 {
+		t.Fatalf("bob deposits must not be swept yet: %q, %v", coins, err)
+	}
+
+	after, err = sweep(after, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("cannot sweep: %+v", err)
+	}
+	if len(after) != 0 {
+		t.Fatalf("no more deposits to sweep expected, got %x", after)
+	}
+	assertFunds(t, db, bobCond.Address(), coin.NewCoin(1, 0, "IOV"))
+
+	var other Deposit
+	if err := deposits.One(db, otherDeposit, &other); err != nil {
+		t.Fatalf("cannot load deposit: %s", err)
+	}
+	if other.Released {
+		t.Fatal("deposit of a different contract must not be released")
+	}
+
+	// The number of deposits swept within a single block is limited.
+	for i := 0; i < maxBlockSweeps+1; i++ {
+		createDeposit(contractID, aliceCond.Address(), false)
+	}
+	for i := 0; i < maxBlockSweeps/maxSweepBatch; i++ {
+		if _, err := sweepAt(101, nil, now.Add(time.Hour)); err != nil {
+			t.Fatalf("cannot sweep: %+v", err)
+		}
+	}
+	if _, err := sweepAt(101, nil, now.Add(time.Hour)); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want overflow error, got %+v", err)
+	}
+	assertFunds(t, db, aliceCond.Address(), coin.NewCoin(maxSweepBatch+maxBlockSweeps, 0, "IOV"))
+	if after, err := sweepAt(102, nil, now.Add(time.Hour)); err != nil || len(after) != 0 {
+		t.Fatalf("cannot sweep the last deposit in the next block: %x, %+v", after, err)
+	}
+	assertFunds(t, db, aliceCond.Address(), coin.NewCoin(maxSweepBatch+maxBlockSweeps+1, 0, "IOV"))
+}
+
+func TestDepositRollover(t *testing.T) {
+	var (
+		adminCond = weavetest.NewCondition()
+		bobCond   = weavetest.NewCondition()
+
+		now = weave.UnixTime(1572247483)
+	)
+
+	cases := map[string]struct {
+		// KeepSchema leaves the termdeposit package at the schema
+		// version that does not support the rollover.
+		KeepSchema bool
+		// Successor is created with the predecessor contract ID set, if
+		// not nil.
+		Successor        *CreateDepositContractMsg
+		WantSuccessorErr *errors.Error
+		Rollover         bool
+		WantDepositErr   *errors.Error
+		// OtherDeposit creates another not released deposit of the
+		// depositor within the successor contract.
+		OtherDeposit bool
+		MaxDeposits  uint32
+		ReleaseAt    weave.UnixTime
+		WantRollover bool
+	}{
+		"rollover deposit is deposited within the successor contract": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			Rollover:     true,
+			ReleaseAt:    now.Add(time.Hour),
+			WantRollover: true,
+		},
+		"deposit without the rollover flag is paid out": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			Rollover:     false,
+			ReleaseAt:    now.Add(time.Hour),
+			WantRollover: false,
+		},
+		"rollover deposit of a contract without a successor is paid out": {
+			Rollover:     true,
+			ReleaseAt:    now.Add(time.Hour),
+			WantRollover: false,
+		},
+		"rollover deposit is paid out when the successor contract is closed": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			Rollover:     true,
+			ReleaseAt:    now.Add(3 * time.Hour),
+			WantRollover: false,
+		},
+		"rollover deposit is paid out when the depositor is at the deposit limit": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			Rollover:     true,
+			OtherDeposit: true,
+			MaxDeposits:  1,
+			ReleaseAt:    now.Add(time.Hour),
+			WantRollover: false,
+		},
+		"rollover deposit is deposited when the depositor is below the deposit limit": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			Rollover:     true,
+			OtherDeposit: true,
+			MaxDeposits:  2,
+			ReleaseAt:    now.Add(time.Hour),
+			WantRollover: true,
+		},
+		"successor must be active at the predecessor maturity": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now.Add(2 * time.Hour),
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			WantSuccessorErr: errors.ErrInput,
+		},
+		"successor must mature after the predecessor": {
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(time.Hour),
+			},
+			WantSuccessorErr: errors.ErrInput,
+		},
+		"successor requires the rollover schema": {
+			KeepSchema: true,
+			Successor: &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(3 * time.Hour),
+			},
+			WantSuccessorErr: errors.ErrSchema,
+		},
+		"rollover deposit requires the rollover schema": {
+			KeepSchema:     true,
+			Rollover:       true,
+			WantDepositErr: errors.ErrSchema,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "termdeposit", "cash")
+			if !tc.KeepSchema {
+				upgradeSchema(t, db)
+			}
+
+			rt := app.NewRouter()
+			auth := &weavetest.CtxAuth{Key: "auth"}
+			ctrl := cash.NewController(cash.NewBucket())
+			RegisterRoutes(rt, auth, ctrl)
+
+			if err := ctrl.CoinMint(db, bobCond.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
+				t.Fatalf("cannot mint coins: %s", err)
+			}
+			config := Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    adminCond.Address(),
+				Admin:    adminCond.Address(),
+				Bonuses: []DenomBonuses{
+					{Denom: "IOV", Bonuses: []DepositBonus{{LockinPeriod: asDays(1), Bonus: weave.Fraction{Numerator: 1, Denominator: 10}}}},
+				},
+			}
+			if err := gconf.Save(db, "termdeposit", &config); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			if err := BuildOwnerIndex(db); err != nil {
+				t.Fatalf("cannot build owner index: %s", err)
+			}
+
+			deliver := func(at weave.UnixTime, msg weave.Msg, conds ...weave.Condition) ([]byte, error) {
+				t.Helper()
+				ctx := weave.WithHeight(context.Background(), 100)
+				ctx = weave.WithChainID(ctx, "testchain-123")
+				ctx = auth.SetConditions(ctx, conds...)
+				ctx = weave.WithBlockTime(ctx, at.Time())
+				tx := &weavetest.Tx{Msg: msg}
+				cache := db.CacheWrap()
+				_, err := rt.Check(ctx, cache, tx)
+				cache.Discard()
+				if err != nil {
+					return nil, err
+				}
+				res, err := rt.Deliver(ctx, db, tx)
+				if err != nil {
+					return nil, err
+				}
+				return res.Data, nil
+			}
+
+			predecessorID, err := deliver(now, &CreateDepositContractMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ValidSince: now,
+				ValidUntil: now.Add(time.Hour),
+			}, adminCond)
+			if err != nil {
+				t.Fatalf("cannot create contract: %+v", err)
+			}
+
+			var successorID []byte
+			if tc.Successor != nil {
+				tc.Successor.PredecessorID = predecessorID
+				successorID, err = deliver(now, tc.Successor, adminCond)
+				if !tc.WantSuccessorErr.Is(err) {
+					t.Fatalf("want %q successor error, got %+v", tc.WantSuccessorErr, err)
+				}
+				if tc.WantSuccessorErr != nil {
+					return
+				}
+				// Only a single successor can be declared.
+				if _, err := deliver(now, tc.Successor, adminCond); !errors.ErrState.Is(err) {
+					t.Fatalf("want state error for a second successor, got %+v", err)
+				}
+			}
+
+			depositID, err := deliver(now, &DepositMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				DepositContractID: predecessorID,
+				Amount:            coin.NewCoin(10, 0, "IOV"),
+				Depositor:         bobCond.Address(),
+				Rollover:          tc.Rollover,
+			}, bobCond)
+			if !tc.WantDepositErr.Is(err) {
+				t.Fatalf("want %q deposit error, got %+v", tc.WantDepositErr, err)
+			}
+			if tc.WantDepositErr != nil {
+				return
+			}
+
+			wantBalance := coin.NewCoin(100, 0, "IOV")
+			if tc.OtherDeposit {
+				_, err := deliver(now, &DepositMsg{
+					Metadata:          &weave.Metadata{Schema: 1},
+					DepositContractID: successorID,
+					Amount:            coin.NewCoin(1, 0, "IOV"),
+					Depositor:         bobCond.Address(),
+				}, bobCond)
+				if err != nil {
+					t.Fatalf("cannot create deposit: %+v", err)
+				}
+				wantBalance = coin.NewCoin(99, 0, "IOV")
+			}
+			if tc.MaxDeposits != 0 {
+				config.MaxDepositsPerAddress = tc.MaxDeposits
+				if err := gconf.Save(db, "termdeposit", &config); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+
+			rolledID, err := deliver(tc.ReleaseAt, &ReleaseDepositMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				DepositID: depositID,
+			})
+			if err != nil {
+				t.Fatalf("cannot release deposit: %+v", err)
+			}
+
+			deposits := NewDepositBucket()
+			var released Deposit
+			if err := deposits.One(db, depositID, &released); err != nil {
+				t.Fatalf("cannot load deposit: %s", err)
+			}
+			if !released.Released {
+				t.Fatal("deposit must be released")
+			}
+			if coins, err := ctrl.Balance(db, depositAccount(depositID)); err != nil || len(coins) != 0 {
+				t.Fatalf("released deposit wallet must be empty: %q, %v", coins, err)
+			}
+
+			if !tc.WantRollover {
+				if len(rolledID) != 0 {
+					t.Fatalf("deposit must not be rolled over, got %x", rolledID)
+				}
+				assertFunds(t, db, bobCond.Address(), wantBalance)
+				return
+			}
+
+			if len(rolledID) == 0 {
+				t.Fatal("deposit must be rolled over")
+			}
+			wantBalance, err = wantBalance.Subtract(coin.NewCoin(10, 0, "IOV"))
+			if err != nil {
+				t.Fatalf("cannot compute balance: %s", err)
+			}
+			assertFunds(t, db, bobCond.Address(), wantBalance)
+			assertFunds(t, db, depositAccount(rolledID), coin.NewCoin(10, 0, "IOV"))
+
+			var rolled Deposit
+			if err := deposits.One(db, rolledID, &rolled); err != nil {
+				t.Fatalf("cannot load rolled over deposit: %s", err)
+			}
+			if !bytes.Equal(rolled.DepositContractID, successorID) {
+				t.Errorf("want %x contract, got %x", successorID, rolled.DepositContractID)
+			}
+			if !bytes.Equal(rolled.PredecessorID, depositID) {
+				t.Errorf("want %x predecessor, got %x", depositID, rolled.PredecessorID)
+			}
+			if !rolled.Amount.Equals(coin.NewCoin(10, 0, "IOV")) {
+				t.Errorf("unexpected amount: %v", rolled.Amount)
+			}
+			if !rolled.Depositor.Equals(bobCond.Address()) {
+				t.Errorf("unexpected depositor: %v", rolled.Depositor)
+			}
+			if !rolled.Rollover || rolled.Released {
+				t.Errorf("rolled over deposit must be a not released rollover deposit: %v", rolled)
+			}
+			if rolled.CreatedAt != tc.ReleaseAt {
+				t.Errorf("want %d creation time, got %d", tc.ReleaseAt, rolled.CreatedAt)
+			}
+		})
+	}
+}
+
+// upgradeSchema upgrades the termdeposit package schema to the version that
+// supports the deposit rollover.
+func upgradeSchema(t testing.TB, db weave.KVStore) {
+	t.Helper()
+	_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "termdeposit",
+		Version:  rolloverSchema,
+	})
+	if err != nil {
+		t.Fatalf("cannot upgrade schema: %s", err)
+	}
+}
@@ -1,7 +1,11 @@
 package termdeposit
 
 import (
+	"encoding/binary"
+	"math"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -10,7 +14,20 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer. Configuration can
+// reference contracts created by the multisig initializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration", "multisig"}
+}
+
+// genesisContract is the genesis file representation of a deposit contract.
+type genesisContract struct {
+	ValidSince weave.UnixTime `json:"valid_since"`
+	ValidUntil weave.UnixTime `json:"valid_until"`
+	Rate       weave.Fraction `json:"rate"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
@@ -22,17 +39,32 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	default:
 		// All good.
 	case errors.ErrNotFound.Is(err):
+		// Imported deposits would be silently dropped.
+		var deposits []genesisDeposit
+		if err := opts.ReadOptions("deposits", &deposits); err != nil {
+			return err
+		}
+		if len(deposits) != 0 {
+			return errors.Wrap(errors.ErrNotFound, "deposits cannot be imported without the configuration")
+		}
+		// Deposits can be created only after the configuration
+		// is set, but the indexes must be usable by then.
+		if err := BuildUnreleasedIndex(db); err != nil {
+			return errors.Wrap(err, "build unreleased index")
+		}
+		if err := BuildOwnerIndex(db); err != nil {
+			return errors.Wrap(err, "build owner index")
+		}
 		return nil
 	case err != nil:
 		return errors.Wrap(err, "cannot initialize gconf based configuration")
 	}
-
-	var contracts []struct {
-		ValidSince weave.UnixTime `json:"valid_since"`
-		ValidUntil weave.UnixTime `json:"valid_until"`
-		Rate       weave.Fraction `json:"rate"`
+	if err := conf.ValidateStrict(); err != nil {
+		return errors.Wrap(err, "configuration")
 	}
 
+	var contracts []genesisContract
+
 	if err := opts.ReadOptions("depositcontract", &contracts); err != nil {
 		return err
 	}
@@ -50,5 +82,94 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrapf(err, "store contract %d", i)
 		}
 	}
+
+	if err := importDeposits(opts, db); err != nil {
+		return errors.Wrap(err, "import deposits")
+	}
+	if err := BuildUnreleasedIndex(db); err != nil {
+		return errors.Wrap(err, "build unreleased index")
+	}
+	if err := BuildOwnerIndex(db); err != nil {
+		return errors.Wrap(err, "build owner index")
+	}
 	return nil
 }
+
+// genesisDeposit is the genesis file representation of an imported deposit.
+type genesisDeposit struct {
+	ID                uint64         `json:"id"`
+	DepositContractID uint64         `json:"deposit_contract_id"`
+	Amount            coin.Coin      `json:"amount"`
+	Rate              weave.Fraction `json:"rate"`
+	Depositor         weave.Address  `json:"depositor"`
+	Released          bool           `json:"released"`
+	CreatedAt         weave.UnixTime `json:"created_at"`
+}
+
+// importDeposits loads deposits that were created outside of this blockchain,
+// for example when migrating an existing product. Each deposit is stored
+// under its original ID and the ID sequence is updated so that IDs of
+// deposits created later do not collide with the imported ones.
+//
+// Funds locked by an imported deposit are not moved. They must be declared
+// separately, using the deposit account address as the wallet owner.
+func importDeposits(opts weave.Options, db weave.KVStore) error {
+	var deposits []genesisDeposit
+	if err := opts.ReadOptions("deposits", &deposits); err != nil {
+		return err
+	}
+
+	contracts := NewDepositContractBucket()
+	b := NewDepositBucket()
+	var maxID uint64
+	for i, d := range deposits {
+		if d.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "deposit %d ID", i)
+		}
+		// Sequence state is a signed integer and must not overflow
+		// when the ID of the next created deposit is allocated.
+		if d.ID >= math.MaxInt64 {
+			return errors.Wrapf(errors.ErrInput, "deposit %d ID %d must be less than %d", i, d.ID, int64(math.MaxInt64))
+		}
+		key := encodeSequence(d.ID)
+		switch err := b.Has(db, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "deposit %d ID %d", i, d.ID)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "deposit %d", i)
+		}
+		deposit := Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: encodeSequence(d.DepositContractID),
+			Amount:            d.Amount,
+			Rate:              d.Rate,
+			Depositor:         d.Depositor,
+			Released:          d.Released,
+			CreatedAt:         d.CreatedAt,
+		}
+		if err := deposit.Validate(); err != nil {
+			return errors.Wrapf(err, "deposit %d is invalid", i)
+		}
+		var contract DepositContract
+		if err := contracts.One(db, deposit.DepositContractID, &contract); err != nil {
+			return errors.Wrapf(err, "deposit %d contract", i)
+		}
+		if _, err := b.Put(db, key, &deposit); err != nil {
+			return errors.Wrapf(err, "store deposit %d", i)
+		}
+		if d.ID > maxID {
+			maxID = d.ID
+		}
+	}
+	// Next allocated ID is max(importedID) + 1.
+	if err := depositSeq.SetMin(db, int64(maxID)); err != nil {
+		return errors.Wrap(err, "deposit sequence")
+	}
+	return nil
+}
+
+func encodeSequence(val uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, val)
+	return bz
+}
@@ -0,0 +1,265 @@
+package termdeposit
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisImportDeposits(t *testing.T) {
+	const genesisTemplate = `
+	{
+		"conf": {
+			"termdeposit": {
+				"metadata": {"schema": 1},
+				"owner": "seq:test/owner/1",
+				"admin": "seq:test/admin/1",
+				"bonuses": [
+					{"denom": "IOV", "bonuses": [
+						{"lockin_period": "24h", "bonus": "1/2"}
+					]}
+				]
+			}
+		},
+		"depositcontract": [
+			{"valid_since": 1000, "valid_until": 2000}
+		],
+		"deposits": %s
+	}`
+
+	cases := map[string]struct {
+		Deposits string
+		WantErr  *errors.Error
+		WantIDs  []uint64
+		WantNext int64
+	}{
+		"no deposits": {
+			Deposits: `[]`,
+			WantNext: 2,
+		},
+		"deposits are imported with their original IDs": {
+			Deposits: `[
+				{
+					"id": 7,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"rate": "1/3",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				},
+				{
+					"id": 3,
+					"deposit_contract_id": 1,
+					"amount": "4 IOV",
+					"rate": "1/4",
+					"depositor": "seq:test/bob/1",
+					"released": true,
+					"created_at": 1200
+				}
+			]`,
+			WantIDs:  []uint64{7, 3},
+			WantNext: 8,
+		},
+		"invalid deposit rejects the genesis": {
+			Deposits: `[
+				{
+					"id": 7,
+					"deposit_contract_id": 1,
+					"amount": "0 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrAmount,
+		},
+		"missing contract rejects the genesis": {
+			Deposits: `[
+				{
+					"id": 7,
+					"deposit_contract_id": 99,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrNotFound,
+		},
+		"duplicated ID rejects the genesis": {
+			Deposits: `[
+				{
+					"id": 7,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				},
+				{
+					"id": 7,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/bob/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrDuplicate,
+		},
+		"ID greater than the sequence maximum rejects the genesis": {
+			Deposits: `[
+				{
+					"id": 9223372036854775808,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrInput,
+		},
+		"ID equal to the sequence maximum rejects the genesis": {
+			Deposits: `[
+				{
+					"id": 9223372036854775807,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrInput,
+		},
+		"greatest allowed ID leaves room for the next deposit": {
+			Deposits: `[
+				{
+					"id": 9223372036854775806,
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantIDs:  []uint64{9223372036854775806},
+			WantNext: 9223372036854775807,
+		},
+		"missing ID rejects the genesis": {
+			Deposits: `[
+				{
+					"deposit_contract_id": 1,
+					"amount": "10 IOV",
+					"depositor": "seq:test/alice/1",
+					"created_at": 1500
+				}
+			]`,
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var opts weave.Options
+			genesis := []byte(fmt.Sprintf(genesisTemplate, tc.Deposits))
+			assert.Nil(t, json.Unmarshal(genesis, &opts))
+
+			db := store.MemStore()
+			migration.MustInitPkg(db, "termdeposit")
+
+			var ini Initializer
+			if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+
+			deposits := NewDepositBucket()
+			for _, id := range tc.WantIDs {
+				var d Deposit
+				if err := deposits.One(db, weavetest.SequenceID(id), &d); err != nil {
+					t.Fatalf("deposit %d: %s", id, err)
+				}
+			}
+
+			next, err := depositSeq.NextInt(db)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.WantNext, next)
+		})
+	}
+}
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const contracts = `[
+		{"valid_since": 1572247483, "valid_until": "2034-11-10T23:00:00Z", "rate": {"numerator": 1, "denominator": 10}}
+	]`
+	assert.JSONRoundTrip(t, []byte(contracts), &[]genesisContract{})
+
+	const deposits = `[
+		{
+			"id": 3,
+			"deposit_contract_id": 1,
+			"amount": {"whole": 1000, "ticker": "IOV"},
+			"rate": {"numerator": 1, "denominator": 10},
+			"depositor": "seq:test/alice/1",
+			"released": true,
+			"created_at": 1572247483
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(deposits), &[]genesisDeposit{})
+}
+
+func TestGenesisRequiresSeparateOwnerAndAdmin(t *testing.T) {
+	const genesis = `
+	{
+		"conf": {
+			"termdeposit": {
+				"metadata": {"schema": 1},
+				"owner": "seq:test/owner/1",
+				"admin": "seq:test/owner/1",
+				"bonuses": [
+					{"denom": "IOV", "bonuses": [
+						{"lockin_period": "24h", "bonus": "1/2"}
+					]}
+				]
+			}
+		}
+	}`
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit")
+
+	var ini Initializer
+	err := ini.FromGenesis(opts, weave.GenesisParams{}, db)
+	assert.FieldError(t, err, "Admin", errors.ErrInput)
+}
+
+func TestGenesisDepositsRequireConfiguration(t *testing.T) {
+	const genesis = `
+	{
+		"deposits": [
+			{
+				"id": 7,
+				"deposit_contract_id": 1,
+				"amount": "10 IOV",
+				"depositor": "seq:test/alice/1",
+				"created_at": 1500
+			}
+		]
+	}`
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit")
+
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+}
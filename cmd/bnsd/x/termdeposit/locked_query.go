@@ -0,0 +1,79 @@
+package termdeposit
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+var _ weave.QueryHandler = (*LockedQuery)(nil)
+
+// LockedQuery allows querying the total value of all funds that are locked
+// by not yet released deposits.
+//
+// Computing the result requires a full scan of the deposit bucket, which
+// makes this query potentially expensive. If this becomes a problem, a
+// running total can be maintained in a singleton record that is updated on
+// each deposit and release.
+type LockedQuery struct{}
+
+func NewLockedQuery() *LockedQuery {
+	return &LockedQuery{}
+}
+
+// Query returns a model for each denomination that there are funds locked
+// in. Model key is the ticker and the value is a serialized coin.Coin with
+// the total locked amount. Models are sorted by the ticker.
+// If data is not empty, it is a ticker and only the total of that
+// denomination is returned.
+func (q *LockedQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	locked, err := Locked(db, string(data))
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, 0, len(locked))
+	for _, c := range locked {
+		raw, err := c.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal coin")
+		}
+		res = append(res, weave.Pair([]byte(c.Ticker), raw))
+	}
+	return res, nil
+}
+
+func (q *LockedQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/termdeposit/locked", q)
+}
+
+// Locked returns the total amount of funds locked by all not yet released
+// deposits, combined per denomination. If ticker is not empty, only the total
+// of deposits in that denomination is returned.
+func Locked(db weave.ReadOnlyKVStore, ticker string) (coin.Coins, error) {
+	var total coin.Coins
+	it := orm.IterAll("deposit")
+	for {
+		var d Deposit
+		switch _, err := it.Next(db, &d); {
+		case err == nil:
+			// Coins.Add drops the holdings when given a zero value.
+			if d.Released || d.Amount.IsZero() {
+				continue
+			}
+			if ticker != "" && d.Amount.Ticker != ticker {
+				continue
+			}
+			if total, err = total.Add(d.Amount); err != nil {
+				return nil, errors.Wrap(err, "combine deposit amount")
+			}
+		case errors.ErrIteratorDone.Is(err):
+			return total, nil
+		default:
+			return nil, errors.Wrap(err, "iterate deposits")
+		}
+	}
+}
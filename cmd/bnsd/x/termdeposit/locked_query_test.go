@@ -0,0 +1,80 @@
+package termdeposit
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestLockedQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "termdeposit")
+
+	deposits := NewDepositBucket()
+	for i, d := range []struct {
+		amount   coin.Coin
+		released bool
+	}{
+		{amount: coin.NewCoin(1, 500000000, "IOV")},
+		{amount: coin.NewCoin(2, 700000000, "IOV")},
+		{amount: coin.NewCoin(10, 0, "ETH")},
+		{amount: coin.NewCoin(100, 0, "IOV"), released: true},
+		{amount: coin.NewCoin(3, 0, "BTC")},
+	} {
+		_, err := deposits.Put(db, weavetest.SequenceID(uint64(i+1)), &Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: weavetest.SequenceID(1),
+			Amount:            d.amount,
+			Rate:              weave.Fraction{Numerator: 1, Denominator: 2},
+			Depositor:         weavetest.NewCondition().Address(),
+			Released:          d.released,
+			CreatedAt:         1,
+		})
+		assert.Nil(t, err)
+	}
+
+	cases := map[string]struct {
+		Ticker string
+		Want   []coin.Coin
+	}{
+		"all denominations": {
+			Want: []coin.Coin{
+				coin.NewCoin(3, 0, "BTC"),
+				coin.NewCoin(10, 0, "ETH"),
+				coin.NewCoin(4, 200000000, "IOV"),
+			},
+		},
+		"single denomination": {
+			Ticker: "IOV",
+			Want:   []coin.Coin{coin.NewCoin(4, 200000000, "IOV")},
+		},
+		"no deposits in denomination": {
+			Ticker: "DOGE",
+			Want:   []coin.Coin{},
+		},
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := qr.Handler("/termdeposit/locked").Query(db, weave.KeyQueryMod, []byte(tc.Ticker))
+			assert.Nil(t, err)
+
+			got := make([]coin.Coin, 0, len(models))
+			for _, m := range models {
+				var c coin.Coin
+				assert.Nil(t, c.Unmarshal(m.Value))
+				assert.Equal(t, c.Ticker, string(m.Key))
+				got = append(got, c)
+			}
+			assert.Equal(t, tc.Want, got)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package termdeposit
 
 import (
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -9,6 +10,13 @@ import (
 func init() {
 	migration.MustRegister(1, &DepositContract{}, migration.NoModification)
 	migration.MustRegister(1, &Deposit{}, migration.NoModification)
+	migration.MustRegister(1, &SweepCounter{}, migration.NoModification)
+
+	// Version 2 introduces the deposit rollover. Existing contracts have no
+	// successor and existing deposits are not rolled over.
+	migration.MustRegister(2, &DepositContract{}, migration.NoModification)
+	migration.MustRegister(2, &Deposit{}, migration.NoModification)
+	migration.MustRegister(2, &SweepCounter{}, migration.NoModification)
 }
 
 var _ orm.Model = (*DepositContract)(nil)
@@ -30,6 +38,7 @@ func (m *DepositContract) Validate() error {
 func NewDepositContractBucket() orm.ModelBucket {
 	b := orm.NewModelBucket("depcontr", &DepositContract{},
 		orm.WithIDSequence(depositSeq),
+		orm.WithFixedKeyLength(8),
 	)
 	return migration.NewModelBucket("termdeposit", b)
 }
@@ -51,17 +60,97 @@ func (m *Deposit) Validate() error {
 	}
 	errs = errors.AppendField(errs, "Depositor", m.Depositor.Validate())
 	errs = errors.AppendField(errs, "CreatedAt", m.CreatedAt.Validate())
+	if !m.PostMaturityAccrual.IsZero() {
+		if err := m.PostMaturityAccrual.Validate(); err != nil {
+			errs = errors.AppendField(errs, "PostMaturityAccrual", err)
+		} else if !m.PostMaturityAccrual.IsPositive() {
+			errs = errors.AppendField(errs, "PostMaturityAccrual", errors.Wrap(errors.ErrAmount, "must not be negative"))
+		}
+	}
 	return errs
 }
 
+// NewDepositBucket returns a bucket for storing deposits. Deposits can be
+// queried by the depositor, by the contract and, if not released yet, by the
+// contract using the "unreleased" index and by the depositor using the
+// "owner" index.
+//
+// The unreleased and owner indexes were added to a bucket that already
+// contained deposits, so they are built lazily. They cannot be used until
+// BuildUnreleasedIndex and BuildOwnerIndex are called.
 func NewDepositBucket() orm.ModelBucket {
+	// Deposit key is either a sequence value or a content derived hash.
+	// See depositKey.
 	b := orm.NewModelBucket("deposit", &Deposit{},
 		orm.WithNativeIndex("depositor", depositDepositor),
 		orm.WithNativeIndex("contract", depositContract),
+		orm.WithLazyNativeIndex("unreleased", depositUnreleased),
+		orm.WithLazyNativeIndex("owner", depositOwner),
 	)
 	return migration.NewModelBucket("termdeposit", b)
 }
 
+// lazyIndexChunk is the number of deposits indexed by a single
+// orm.RebuildIndexChunk call.
+const lazyIndexChunk = 1000
+
+// BuildUnreleasedIndex indexes all deposits that were not yet indexed by the
+// unreleased index and marks the index as ready to use. Calling it for an
+// index that is ready is a no-op. All deposits are processed within given
+// transaction.
+func BuildUnreleasedIndex(db weave.KVStore) error {
+	return buildLazyIndex(db, "unreleased")
+}
+
+// BuildOwnerIndex indexes all deposits that were not yet indexed by the owner
+// index and marks the index as ready to use. Calling it for an index that is
+// ready is a no-op. All deposits are processed within given transaction.
+func BuildOwnerIndex(db weave.KVStore) error {
+	return buildLazyIndex(db, "owner")
+}
+
+func buildLazyIndex(db weave.KVStore, indexName string) error {
+	b := NewDepositBucket()
+	var after []byte
+	for {
+		next, done, err := orm.RebuildIndexChunk(db, b, indexName, after, lazyIndexChunk)
+		if err != nil {
+			return errors.Wrapf(err, "rebuild %s index", indexName)
+		}
+		if done {
+			return nil
+		}
+		after = next
+	}
+}
+
+// depositUnreleased indexes a deposit that was not released yet by its
+// contract. Those are the deposits that are waiting for the contract maturity.
+func depositUnreleased(o orm.Object) ([][]byte, error) {
+	d, ok := o.Value().(*Deposit)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrType, "not a Deposit")
+	}
+	if d.Released {
+		return nil, nil
+	}
+	return [][]byte{d.DepositContractID}, nil
+}
+
+// depositOwner indexes a deposit that was not released yet by its depositor.
+// It allows to count open deposits of a depositor without loading those that
+// were already released.
+func depositOwner(o orm.Object) ([][]byte, error) {
+	d, ok := o.Value().(*Deposit)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrType, "not a Deposit")
+	}
+	if d.Released {
+		return nil, nil
+	}
+	return [][]byte{d.Depositor}, nil
+}
+
 func depositContract(o orm.Object) ([][]byte, error) {
 	d, ok := o.Value().(*Deposit)
 	if !ok {
@@ -77,3 +166,22 @@ func depositDepositor(o orm.Object) ([][]byte, error) {
 	}
 	return [][]byte{d.Depositor}, nil
 }
+
+var _ orm.Model = (*SweepCounter)(nil)
+
+func (m *SweepCounter) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.Height <= 0 {
+		errs = errors.AppendField(errs, "Height", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+// sweepCounterKey is the key under which the only SweepCounter is stored.
+var sweepCounterKey = []byte("current")
+
+func NewSweepCounterBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("sweepcnt", &SweepCounter{})
+	return migration.NewModelBucket("termdeposit", b)
+}
@@ -10,7 +10,17 @@ func init() {
 	migration.MustRegister(1, &CreateDepositContractMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DepositMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReleaseDepositMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TopUpDepositMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SweepDepositsMsg{}, migration.NoModification)
+
+	// Version 2 introduces the deposit rollover.
+	migration.MustRegister(2, &CreateDepositContractMsg{}, migration.NoModification)
+	migration.MustRegister(2, &DepositMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReleaseDepositMsg{}, migration.NoModification)
+	migration.MustRegister(2, &TopUpDepositMsg{}, migration.NoModification)
+	migration.MustRegister(2, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(2, &SweepDepositsMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateDepositContractMsg)(nil)
@@ -51,9 +61,14 @@ func (m *DepositMsg) Validate() error {
 		errs = errors.AppendField(errs, "Amount", errors.Wrap(errors.ErrAmount, "must be greater than zero"))
 	}
 	errs = errors.AppendField(errs, "Depositor", m.Depositor.Validate())
+	if len(m.Nonce) > maxNonceLength {
+		errs = errors.AppendField(errs, "Nonce", errors.Wrapf(errors.ErrInput, "must not be longer than %d bytes", maxNonceLength))
+	}
 	return errs
 }
 
+const maxNonceLength = 32
+
 var _ weave.Msg = (*ReleaseDepositMsg)(nil)
 
 func (ReleaseDepositMsg) Path() string {
@@ -69,6 +84,41 @@ func (m *ReleaseDepositMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*TopUpDepositMsg)(nil)
+
+func (TopUpDepositMsg) Path() string {
+	return "termdeposit/top_up_deposit"
+}
+
+func (m *TopUpDepositMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.DepositID) == 0 {
+		errs = errors.AppendField(errs, "DepositID", errors.ErrEmpty)
+	}
+	if err := m.Amount.Validate(); err != nil {
+		errs = errors.AppendField(errs, "Amount", err)
+	} else if !m.Amount.IsPositive() {
+		errs = errors.AppendField(errs, "Amount", errors.Wrap(errors.ErrAmount, "must be greater than zero"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*SweepDepositsMsg)(nil)
+
+func (SweepDepositsMsg) Path() string {
+	return "termdeposit/sweep_deposits"
+}
+
+func (m *SweepDepositsMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.DepositContractID) == 0 {
+		errs = errors.AppendField(errs, "DepositContractID", errors.ErrEmpty)
+	}
+	return errs
+}
+
 var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
 
 func (UpdateConfigurationMsg) Path() string {
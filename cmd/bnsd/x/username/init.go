@@ -10,16 +10,23 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisToken is the genesis file representation of a username token.
+type genesisToken struct {
+	Username string
+	Targets  []BlockchainAddress
+	Owner    weave.Address
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type TokenInput struct {
-		Username string
-		Targets  []BlockchainAddress
-		Owner    weave.Address
-	}
 	stream := opts.Stream("username")
 
 	var conf Configuration
@@ -29,11 +36,14 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 
 	bucket := NewTokenBucket()
 	for i := 0; ; i++ {
-		var t TokenInput
+		var t genesisToken
 
 		err := stream(&t)
 		switch {
 		case errors.ErrEmpty.Is(err):
+			if err := BuildTargetIndex(kv); err != nil {
+				return errors.Wrap(err, "cannot build target index")
+			}
 			return nil
 		case err != nil:
 			return errors.Wrap(err, "cannot load username token")
@@ -72,4 +72,31 @@ func TestGenesisInitializer(t *testing.T) {
 	assert.Equal(t, charlie.Owner, weave.NewCondition("test", "charlie", weavetest.SequenceID(1)).Address())
 	assert.Equal(t, charlie.Targets[0].BlockchainID, "block_1")
 	assert.Equal(t, charlie.Targets[0].Address, "1")
+
+	// Target index is built at genesis.
+	var targeting []Token
+	_, err := b.ByIndex(db, "target", TargetKey("block_1", "1"), &targeting)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(targeting))
+}
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{
+			"username": "alice*iov",
+			"owner": "seq:test/alice/1",
+			"targets": [
+				{"blockchain_id": "block_1", "address": "1"},
+				{"blockchain_id": "block_2", "address": "2"}
+			]
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisToken{})
+
+	const conf = `{
+		"valid_username_name": "^[a-z0-9\\-_.]{3,64}$",
+		"valid_username_label": "^iov$",
+		"owner": "cond:foo/bar/000000000000000001"
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
 }
@@ -68,12 +68,40 @@ func (t *Token) Copy() orm.CloneableData {
 // NewTokenBucket returns a ModelBucket instance limited to interacting with a
 // Token model only.
 // Only a valid Username instance should be used as a key. Alternatively tokens can
-// be queried by owner.
+// be queried by owner or by target (see TargetKey).
+//
+// The target index was added to a bucket that already contained tokens, so it
+// is built lazily. It cannot be used until BuildTargetIndex is called.
 func NewTokenBucket() orm.ModelBucket {
-	b := orm.NewModelBucket("tokens", &Token{}, orm.WithIndex("owner", idxOwner, false))
+	b := orm.NewModelBucket("tokens", &Token{},
+		orm.WithIndex("owner", idxOwner, false),
+		orm.WithLazyIndex("target", idxTargets, false),
+	)
 	return migration.NewModelBucket("username", b)
 }
 
+// targetIndexChunk is the number of tokens indexed by a single
+// orm.RebuildIndexChunk call.
+const targetIndexChunk = 1000
+
+// BuildTargetIndex indexes all tokens that were not yet indexed by the target
+// index and marks the index as ready to use. Calling it for an index that is
+// ready is a no-op. All tokens are processed within given transaction.
+func BuildTargetIndex(db weave.KVStore) error {
+	b := NewTokenBucket()
+	var after []byte
+	for {
+		next, done, err := orm.RebuildIndexChunk(db, b, "target", after, targetIndexChunk)
+		if err != nil {
+			return errors.Wrap(err, "rebuild target index")
+		}
+		if done {
+			return nil
+		}
+		after = next
+	}
+}
+
 // RegisterQuery expose tokens bucket to queries.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTokenBucket().Register("usernames", qr)
@@ -87,6 +115,38 @@ func idxOwner(obj orm.Object) ([]byte, error) {
 	return swp.Owner, nil
 }
 
+// idxTargets indexes a token by each of its targets. See TargetKey for the
+// index key format.
+func idxTargets(obj orm.Object) ([][]byte, error) {
+	t, err := getToken(obj)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([][]byte, 0, len(t.Targets))
+	seen := make(map[string]struct{}, len(t.Targets))
+	for _, ba := range t.Targets {
+		key := TargetKey(ba.BlockchainID, ba.Address)
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// TargetKey returns the target index key of given blockchain address. The key
+// is the blockchain ID and the address joined with a colon, which is not a
+// valid blockchain ID character. Use the blockchain ID followed by a colon as a
+// prefix query to find all tokens with a target on that blockchain.
+func TargetKey(blockchainID, address string) []byte {
+	key := make([]byte, 0, len(blockchainID)+len(address)+1)
+	key = append(key, blockchainID...)
+	key = append(key, ':')
+	key = append(key, address...)
+	return key
+}
+
 func getToken(obj orm.Object) (*Token, error) {
 	if obj == nil {
 		return nil, errors.Wrap(errors.ErrHuman, "Cannot take index of nil")
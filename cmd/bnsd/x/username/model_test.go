@@ -1,11 +1,15 @@
 package username
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -107,6 +111,115 @@ func TestQueryByOwner(t *testing.T) {
 	assert.Equal(t, token, retrievedTokens[0])
 }
 
+func TestQueryByTarget(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "username")
+	b := NewTokenBucket()
+
+	alice := weavetest.NewCondition()
+	many := make([]BlockchainAddress, 20)
+	for i := range many {
+		many[i] = BlockchainAddress{BlockchainID: fmt.Sprintf("chain-%02d", i), Address: "shared-address"}
+	}
+	tokens := map[string]*Token{
+		"alice*iov": {
+			Metadata: &weave.Metadata{Schema: 1},
+			Targets: []BlockchainAddress{
+				{BlockchainID: "hydracoin", Address: "hydra-alice"},
+				{BlockchainID: "pegasuscoin", Address: "pegasus-alice"},
+			},
+			Owner: alice.Address(),
+		},
+		"bobby*iov": {
+			Metadata: &weave.Metadata{Schema: 1},
+			Targets: []BlockchainAddress{
+				{BlockchainID: "hydracoin", Address: "hydra-bobby"},
+			},
+			Owner: weavetest.NewCondition().Address(),
+		},
+		"many*iov": {
+			Metadata: &weave.Metadata{Schema: 1},
+			Targets:  many,
+			Owner:    weavetest.NewCondition().Address(),
+		},
+	}
+	for name, token := range tokens {
+		_, err := b.Put(db, []byte(name), token)
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	// Tokens stored before the index is built are not indexed.
+	_, err := qr.Handler("/usernames/target").Query(db, weave.KeyQueryMod, TargetKey("hydracoin", "hydra-alice"))
+	if !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	assert.Nil(t, BuildTargetIndex(db))
+	// Building a ready index is a no-op.
+	assert.Nil(t, BuildTargetIndex(db))
+
+	// Duplicated blockchain entries are rejected before anything is indexed.
+	_, err = b.Put(db, []byte("dups*iov"), &Token{
+		Metadata: &weave.Metadata{Schema: 1},
+		Targets: []BlockchainAddress{
+			{BlockchainID: "hydracoin", Address: "hydra-dups"},
+			{BlockchainID: "hydracoin", Address: "hydra-dups-2"},
+		},
+		Owner: weavetest.NewCondition().Address(),
+	})
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+
+	query := func(mod string, data []byte) []string {
+		t.Helper()
+		models, err := qr.Handler("/usernames/target").Query(db, mod, data)
+		assert.Nil(t, err)
+		var names []string
+		for _, m := range models {
+			names = append(names, strings.TrimPrefix(string(m.Key), "tokens:"))
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	assert.Equal(t, []string{"alice*iov"}, query(weave.KeyQueryMod, TargetKey("hydracoin", "hydra-alice")))
+	assert.Equal(t, []string(nil), query(weave.KeyQueryMod, TargetKey("hydracoin", "pegasus-alice")))
+	assert.Equal(t, []string{"many*iov"}, query(weave.KeyQueryMod, TargetKey("chain-13", "shared-address")))
+	// Filter by blockchain using a prefix query.
+	assert.Equal(t, []string{"alice*iov", "bobby*iov"}, query(weave.PrefixQueryMod, []byte("hydracoin:")))
+
+	// Changing targets replaces all index entries of the token.
+	config := Configuration{
+		ValidUsernameName:  `[a-z0-9\-_.]{3,64}`,
+		ValidUsernameLabel: `[a-z0-9]{3,16}`,
+	}
+	if err := gconf.Save(db, "username", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	handler := &changeTokenTargetsHandler{auth: &weavetest.Auth{Signer: alice}, bucket: b}
+	tx := &weavetest.Tx{Msg: &ChangeTokenTargetsMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Username: "alice*iov",
+		NewTargets: []BlockchainAddress{
+			{BlockchainID: "unichain", Address: "unicorn-alice"},
+		},
+	}}
+	_, err = handler.Deliver(context.Background(), db, tx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string(nil), query(weave.KeyQueryMod, TargetKey("hydracoin", "hydra-alice")))
+	assert.Equal(t, []string(nil), query(weave.KeyQueryMod, TargetKey("pegasuscoin", "pegasus-alice")))
+	assert.Equal(t, []string{"alice*iov"}, query(weave.KeyQueryMod, TargetKey("unichain", "unicorn-alice")))
+	assert.Equal(t, []string{"bobby*iov"}, query(weave.PrefixQueryMod, []byte("hydracoin:")))
+
+	orphans, err := b.VerifyIndex(db, "target")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+}
+
 func TestTokenValidate(t *testing.T) {
 	cases := map[string]struct {
 		Token   Token
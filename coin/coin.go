@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 )
 
 //-------------- Coin -----------------------
@@ -311,6 +312,26 @@ func (c Coin) normalize() (Coin, error) {
 	return c, nil
 }
 
+func init() {
+	weavejson.Register(Coin{}, func(v interface{}) (interface{}, error) {
+		c := v.(Coin)
+		// Human readable format is used whenever it represents
+		// exactly the same value.
+		if h, err := ParseHumanFormat(c.String()); err == nil && h == c {
+			return c.String(), nil
+		}
+		return struct {
+			Whole      int64  `json:"whole,omitempty"`
+			Fractional int64  `json:"fractional,omitempty"`
+			Ticker     string `json:"ticker,omitempty"`
+		}{
+			Whole:      c.Whole,
+			Fractional: c.Fractional,
+			Ticker:     c.Ticker,
+		}, nil
+	})
+}
+
 func (c *Coin) UnmarshalJSON(raw []byte) error {
 	// Prioritize human readable format that is a string in format
 	// "<whole>[.<fractional>] <ticker>"
@@ -392,13 +413,19 @@ func ParseHumanFormat(h string) (Coin, error) {
 
 	var fract int64
 	if result[2] != "" {
-		val, err := strconv.ParseFloat(result[2], 64)
+		// Parse the fractional part as an integer to avoid floating
+		// point rounding errors. Any precision beyond the fractional
+		// unit is dropped.
+		digits := result[2][1:]
+		if len(digits) > 9 {
+			digits = digits[:9]
+		}
+		digits += strings.Repeat("0", 9-len(digits))
+		val, err := strconv.ParseInt(digits, 10, 64)
 		if err != nil {
 			return c, fmt.Errorf("invalid fractional value: %s", err)
 		}
-		// Max float64 value is around 1.7e+308 so I do not think we
-		// should bother with the overflow issue.
-		fract = int64(val * float64(FracUnit))
+		fract = val
 	}
 
 	ticker := result[3]
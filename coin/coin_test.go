@@ -572,6 +572,10 @@ func TestCoinDeserialization(t *testing.T) {
 			serialized: `"0.000000002IOV"`,
 			wantCoin:   NewCoin(0, 2, "IOV"),
 		},
+		"human readable format, fractional without rounding error": {
+			serialized: `"0.000000015 IOV"`,
+			wantCoin:   NewCoin(0, 15, "IOV"),
+		},
 		"human readable format, missing whole": {
 			serialized: `".0000000002IOV"`,
 			wantErr:    true,
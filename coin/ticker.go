@@ -0,0 +1,35 @@
+package coin
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+// TickerStore is a subset of weave.ReadOnlyKVStore, that is required to
+// validate a ticker.
+type TickerStore interface {
+	Get(key []byte) ([]byte, error)
+}
+
+// tickerKeyPrefix is the database key prefix of the token information,
+// as maintained by the x/currency extension.
+const tickerKeyPrefix = "tokeninfo:"
+
+// ValidateTicker returns an error if given ticker is not a valid currency
+// code or if it is not registered in the token registry kept by the
+// x/currency extension.
+//
+// This function does not know whether the token registry is in use. Use it
+// only when the application requires all tokens to be registered.
+func ValidateTicker(db TickerStore, ticker string) error {
+	if !IsCC(ticker) {
+		return errors.Wrapf(errors.ErrCurrency, "invalid ticker %q", ticker)
+	}
+	raw, err := db.Get([]byte(tickerKeyPrefix + ticker))
+	if err != nil {
+		return errors.Wrap(err, "cannot read token info")
+	}
+	if raw == nil {
+		return errors.Wrapf(errors.ErrCurrency, "ticker %s not registered", ticker)
+	}
+	return nil
+}
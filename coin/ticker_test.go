@@ -0,0 +1,32 @@
+package coin
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+)
+
+func TestValidateTicker(t *testing.T) {
+	db := mapStore{"tokeninfo:IOV": []byte("info")}
+
+	cases := map[string]*errors.Error{
+		"IOV":  nil,
+		"ETH":  errors.ErrCurrency,
+		"iov":  errors.ErrCurrency,
+		"":     errors.ErrCurrency,
+		"IOV2": errors.ErrCurrency,
+	}
+	for ticker, wantErr := range cases {
+		t.Run(ticker, func(t *testing.T) {
+			if err := ValidateTicker(db, ticker); !wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+type mapStore map[string][]byte
+
+func (s mapStore) Get(key []byte) ([]byte, error) {
+	return s[string(key)], nil
+}
@@ -0,0 +1,289 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/blockchain"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagTx         = "tx"
+	flagTime       = "time"
+	flagBlockstore = "blockstore"
+)
+
+type debugTxArgs struct {
+	height     int64
+	tx         string
+	blockTime  string
+	blockstore string
+	minFee     string
+}
+
+func parseDebugTxArgs(args []string) (debugTxArgs, error) {
+	var res debugTxArgs
+	fl := flag.NewFlagSet("debug-tx", flag.ExitOnError)
+	fl.Int64Var(&res.height, flagHeight, 0, "height of the block that the transaction was included in")
+	fl.StringVar(&res.tx, flagTx, "", "hex encoded transaction or a path to a file containing it")
+	fl.StringVar(&res.blockTime, flagTime, "", "block time in RFC3339 format (default time of the block)")
+	fl.StringVar(&res.blockstore, flagBlockstore, "", "path to the blockstore.db of the node")
+	fl.StringVar(&res.minFee, flagMinFee, "0 IOV", "minimal anti-spam fee, the same as the node was started with")
+	if err := fl.Parse(args); err != nil {
+		return res, err
+	}
+	if res.height < 2 || res.tx == "" || res.blockstore == "" {
+		return res, errors.Wrap(errors.ErrInput,
+			"usage: cmd debug-tx -height=H -tx=<hex|file> -blockstore=<path to blockstore.db> [-time=T] [-min_fee=F]")
+	}
+	return res, nil
+}
+
+// HandlerGenerator returns the transaction handler of the application
+// configured with given options.
+type HandlerGenerator func(*Options) weave.Handler
+
+// DebugTxCmd executes a single transaction against the state persisted at the
+// height preceding the given one and prints the outcome. The transaction is
+// processed by both Check and Deliver of the handler returned by gen. The
+// handler is configured the same way as by the start command, so the minimal
+// anti-spam fee must be the one the node was started with. All transactions
+// of the block at the given height that precede the debugged one are
+// delivered first, so that the result is the same as on the chain. If the
+// transaction is not part of that block, it is executed after all of the
+// block transactions.
+//
+// Both databases are opened in read only mode and are never modified. All
+// changes are made in a cache that is discarded and are printed instead.
+//
+// Block begin and end processing, for example cron tasks, is not executed.
+// The node must be stopped, because its database cannot be opened by two
+// processes at the same time.
+func DebugTxCmd(gen HandlerGenerator, decode weave.TxDecoder, dbPath string, args []string) error {
+	flags, err := parseDebugTxArgs(args)
+	if err != nil {
+		return err
+	}
+	minFee, err := coin.ParseHumanFormat(flags.minFee)
+	if err != nil {
+		return errors.Wrap(err, "min fee")
+	}
+	h := gen(&Options{MinFee: minFee})
+	raw, err := readTx(flags.tx)
+	if err != nil {
+		return errors.Wrap(err, "read transaction")
+	}
+	block, err := loadBlock(flags.blockstore, flags.height)
+	if err != nil {
+		return err
+	}
+	now := block.Time
+	if flags.blockTime != "" {
+		if now, err = time.Parse(time.RFC3339, flags.blockTime); err != nil {
+			return errors.Wrapf(errors.ErrInput, "time: %s", err)
+		}
+	}
+	db, err := openVersion(dbPath, flags.height-1)
+	if err != nil {
+		return err
+	}
+	prefix := blockPrefix(block.Txs, raw)
+	return debugTx(os.Stdout, h, decode, db, flags.height, now, prefix, raw)
+}
+
+// loadBlock returns the block at the given height, read from the blockstore
+// database opened in read only mode.
+func loadBlock(dbPath string, height int64) (*types.Block, error) {
+	dir, name, err := splitDbPath(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "blockstore: %s", err)
+	}
+	defer db.Close()
+	block := blockchain.NewBlockStore(db).LoadBlock(height)
+	if block == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "block %d", height)
+	}
+	return block, nil
+}
+
+// blockPrefix returns all transactions of the block that precede the given
+// one. If the transaction is not part of the block, all block transactions
+// are returned.
+func blockPrefix(txs types.Txs, raw []byte) [][]byte {
+	prefix := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		if bytes.Equal(tx, raw) {
+			break
+		}
+		prefix = append(prefix, tx)
+	}
+	return prefix
+}
+
+// splitDbPath returns the directory and the name of the leveldb database
+// stored under the given path.
+func splitDbPath(dbPath string) (string, string, error) {
+	path, err := filepath.Abs(dbPath)
+	if err != nil {
+		return "", "", errors.Wrapf(errors.ErrInput, "database path: %s", err)
+	}
+	path = strings.TrimSuffix(path, filepath.Ext(path))
+	return filepath.Dir(path), filepath.Base(path), nil
+}
+
+// readTx returns the transaction bytes declared by the given value. It is
+// either a hex encoded transaction, or a path to a file containing a raw or a
+// hex encoded transaction.
+func readTx(value string) ([]byte, error) {
+	if raw, err := hex.DecodeString(strings.TrimPrefix(value, "0x")); err == nil {
+		return raw, nil
+	}
+	content, err := ioutil.ReadFile(value)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "neither hex nor a readable file")
+	}
+	if raw, err := hex.DecodeString(strings.TrimSpace(string(content))); err == nil {
+		return raw, nil
+	}
+	return content, nil
+}
+
+// openVersion returns a read only access to the state persisted at the given
+// version. Database is opened in read only mode and must exist.
+func openVersion(dbPath string, version int64) (weave.ReadOnlyKVStore, error) {
+	dir, name, err := splitDbPath(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	kv, err := iavlstore.NewReadOnlyCommitStore(dir, name)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "database: %s", err)
+	}
+	return kv.ReadOnlyVersion(version)
+}
+
+// debugTx delivers the prefix transactions and then executes the transaction
+// on top of the resulting state and writes the outcome to out. All writes are
+// made in a cache wrap of a read only store, so the given state is never
+// modified. Only the writes of the debugged transaction are printed.
+func debugTx(
+	out io.Writer,
+	h weave.Handler,
+	decode weave.TxDecoder,
+	state weave.ReadOnlyKVStore,
+	height int64,
+	now time.Time,
+	prefix [][]byte,
+	raw []byte,
+) error {
+	tx, err := decode(raw)
+	if err != nil {
+		return errors.Wrap(err, "decode transaction")
+	}
+	chainID, err := app.LoadChainID(state)
+	if err != nil {
+		return errors.Wrap(err, "load chain ID")
+	}
+	ctx := weave.WithHeader(context.Background(), abci.Header{
+		ChainID: chainID,
+		Height:  height,
+		Time:    now,
+	})
+	ctx = weave.WithHeight(ctx, height)
+	ctx = weave.WithChainID(ctx, chainID)
+	ctx = weave.WithBlockTime(ctx, now)
+
+	db := store.NewReadOnlyStore(state).CacheWrap()
+	defer db.Discard()
+
+	// Transactions that failed on the chain fail here as well and do not
+	// modify the state, so their errors are only counted.
+	var failed int
+	for _, b := range prefix {
+		ptx, err := decode(b)
+		if err == nil {
+			_, err = h.Deliver(weave.WithLogInfo(ctx, "call", "deliver_tx"), db, ptx)
+		}
+		if err != nil {
+			failed++
+		}
+	}
+	fmt.Fprintf(out, "Block prefix\n")
+	fmt.Fprintf(out, "  transactions: %d\n", len(prefix))
+	fmt.Fprintf(out, "  failed: %d\n", failed)
+
+	check := db.CacheWrap()
+	cres, err := h.Check(weave.WithLogInfo(ctx, "call", "check_tx"), check, tx)
+	check.Discard()
+	fmt.Fprintf(out, "Check\n")
+	if err != nil {
+		fmt.Fprintf(out, "  error: %+v\n", err)
+	} else {
+		fmt.Fprintf(out, "  log: %s\n", cres.Log)
+		fmt.Fprintf(out, "  gas allocated: %d\n", cres.GasAllocated)
+		if !cres.RequiredFee.IsZero() {
+			fmt.Fprintf(out, "  required fee: %s\n", cres.RequiredFee)
+		}
+	}
+
+	deliver := db.CacheWrap()
+	recorder := store.NewRecordingStore(deliver)
+	dres, err := h.Deliver(weave.WithLogInfo(ctx, "call", "deliver_tx"), recorder, tx)
+	deliver.Discard()
+	fmt.Fprintf(out, "Deliver\n")
+	if err != nil {
+		fmt.Fprintf(out, "  error: %+v\n", err)
+	} else {
+		fmt.Fprintf(out, "  log: %s\n", dres.Log)
+		fmt.Fprintf(out, "  data: %X\n", dres.Data)
+		fmt.Fprintf(out, "  tags:\n")
+		for _, t := range dres.Tags {
+			fmt.Fprintf(out, "    %s=%s\n", t.Key, t.Value)
+		}
+	}
+	// Writes are recorded even if the transaction failed. They were
+	// made by the decorators that succeeded, for example a fee payment.
+	changes := recorder.(store.Recorder).KVPairs()
+	keys := make([]string, 0, len(changes))
+	for k := range changes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(out, "  writes:\n")
+	for _, k := range keys {
+		if v := changes[k]; v == nil {
+			fmt.Fprintf(out, "    delete %X\n", k)
+		} else {
+			fmt.Fprintf(out, "    set %X %X\n", k, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/blockchain"
+	"github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestDebugTxDoesNotWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debugtx-")
+	if err != nil {
+		t.Fatalf("cannot create a directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Databases can be opened only once, so they are closed before
+	// running the debugged transaction.
+	ldb, err := dbm.NewGoLevelDB("abci", dir)
+	if err != nil {
+		t.Fatalf("cannot create a database: %s", err)
+	}
+	commit := iavlstore.NewCommitStoreFromTree(iavl.NewMutableTree(ldb, iavlstore.DefaultCacheSize))
+	for _, v := range []string{"1", "2"} {
+		db := commit.CacheWrap()
+		if err := db.Set([]byte("_wv:chainID"), []byte("test-chain")); err != nil {
+			t.Fatalf("cannot set: %s", err)
+		}
+		if err := db.Set([]byte("a"), []byte(v)); err != nil {
+			t.Fatalf("cannot set: %s", err)
+		}
+		if err := db.Write(); err != nil {
+			t.Fatalf("cannot write: %s", err)
+		}
+		if _, err := commit.Commit(); err != nil {
+			t.Fatalf("cannot commit: %s", err)
+		}
+	}
+	before, err := commit.LatestVersion()
+	if err != nil {
+		t.Fatalf("cannot get version: %s", err)
+	}
+	ldb.Close()
+
+	blockTime := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	writeBlocks(t, filepath.Join(dir, "blockstore.db"), blockTime, []types.Tx{{0x02}, {0x01}, {0x03}})
+
+	block, err := loadBlock(filepath.Join(dir, "blockstore.db"), 2)
+	if err != nil {
+		t.Fatalf("cannot load block: %s", err)
+	}
+	if !block.Time.Equal(blockTime) {
+		t.Fatalf("unexpected block time: %s", block.Time)
+	}
+	prefix := blockPrefix(block.Txs, []byte{0x01})
+	if want := [][]byte{{0x02}}; !reflect.DeepEqual(want, prefix) {
+		t.Fatalf("want %X prefix, got %X", want, prefix)
+	}
+	if got := blockPrefix(block.Txs, []byte{0x04}); len(got) != 3 {
+		t.Fatalf("all transactions must precede a transaction that is not in the block: %X", got)
+	}
+
+	state, err := openVersion(filepath.Join(dir, "abci.db"), 1)
+	if err != nil {
+		t.Fatalf("cannot open version: %s", err)
+	}
+	decode := func(raw []byte) (weave.Tx, error) {
+		if bytes.Equal(raw, []byte{0x02}) {
+			return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/prefix"}}, nil
+		}
+		return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/write"}}, nil
+	}
+	var out bytes.Buffer
+	if err := debugTx(&out, &writingHandler{}, decode, state, 2, block.Time, prefix, []byte{0x01}); err != nil {
+		t.Fatalf("debug tx: %s", err)
+	}
+	for _, want := range []string{
+		"transactions: 1",
+		"set 62 70", // b=p, written to a by the preceding transaction
+		"delete 61", // a
+		"chain=test-chain",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "set 61 70") {
+		t.Errorf("writes of the block prefix must not be printed:\n%s", out.String())
+	}
+
+	// The debugged state is still open, so it can be opened again in read
+	// only mode only.
+	commit, err = iavlstore.NewReadOnlyCommitStore(dir, "abci")
+	if err != nil {
+		t.Fatalf("cannot open database: %s", err)
+	}
+	after, err := commit.LatestVersion()
+	if err != nil {
+		t.Fatalf("cannot get version: %s", err)
+	}
+	if after.Version != before.Version || !bytes.Equal(after.Hash, before.Hash) {
+		t.Fatalf("state changed from %+v to %+v", before, after)
+	}
+	db := commit.Adapter()
+	if v, err := db.Get([]byte("a")); err != nil || string(v) != "2" {
+		t.Fatalf("unexpected value of a: %q, %v", v, err)
+	}
+	if v, err := db.Get([]byte("b")); err != nil || v != nil {
+		t.Fatalf("unexpected value of b: %q, %v", v, err)
+	}
+
+	if _, err := openVersion(filepath.Join(dir, "missing.db"), 1); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "missing.db")); !os.IsNotExist(err) {
+		t.Fatalf("missing database must not be created: %v", err)
+	}
+	if _, err := loadBlock(filepath.Join(dir, "blockstore.db"), 3); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+}
+
+// writeBlocks creates a blockstore with two blocks. The second block
+// contains given transactions.
+func writeBlocks(t testing.TB, dbPath string, blockTime time.Time, txs []types.Tx) {
+	t.Helper()
+	dir, name, err := splitDbPath(dbPath)
+	if err != nil {
+		t.Fatalf("cannot split path: %s", err)
+	}
+	db, err := dbm.NewGoLevelDB(name, dir)
+	if err != nil {
+		t.Fatalf("cannot create a database: %s", err)
+	}
+	defer db.Close()
+	bs := blockchain.NewBlockStore(db)
+	for h, blockTxs := range [][]types.Tx{nil, txs} {
+		block := types.MakeBlock(int64(h+1), blockTxs, &types.Commit{}, nil)
+		block.Time = blockTime
+		bs.SaveBlock(block, block.MakePartSet(types.BlockPartSizeBytes), &types.Commit{})
+	}
+}
+
+// writingHandler copies the value of "a" to "b" and deletes "a". A
+// transaction with the "test/prefix" path sets the value of "a" to "p".
+type writingHandler struct{}
+
+func (writingHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return &weave.CheckResult{GasAllocated: 1}, nil
+}
+
+func (writingHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if weave.GetPath(tx) == "test/prefix" {
+		return &weave.DeliverResult{}, db.Set([]byte("a"), []byte("p"))
+	}
+	v, err := db.Get([]byte("a"))
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Set([]byte("b"), v); err != nil {
+		return nil, err
+	}
+	if err := db.Delete([]byte("a")); err != nil {
+		return nil, err
+	}
+	chainID := weave.GetChainID(ctx)
+	return &weave.DeliverResult{
+		Tags: []common.KVPair{{Key: []byte("chain"), Value: []byte(chainID)}},
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -127,7 +128,7 @@ func addGenesisOptions(filename string, options json.RawMessage, force, ignore b
 	doc[AppStateKey] = options
 	doc[GenesisTimeKey] = timeJSON
 
-	out, err := json.MarshalIndent(doc, "", "  ")
+	out, err := weavejson.MarshalIndent(doc, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,15 @@ const (
 	flagBind   = "bind"
 	flagDebug  = "debug"
 	flagMinFee = "min_fee"
+
+	flagCheckCacheSize = "check_cache_size"
+	flagCheckCacheTTL  = "check_cache_ttl"
+
+	flagTrackIterators = "track_iterators"
+
+	flagMaxTxBytes    = "max_tx_bytes"
+	flagMaxTxRepeated = "max_tx_repeated"
+	flagMaxTxDepth    = "max_tx_depth"
 )
 
 type Options struct {
@@ -21,6 +30,25 @@ type Options struct {
 	Debug  bool
 	Home   string
 	Logger log.Logger
+	// CheckCacheSize is the maximum number of cached CheckTx signature
+	// verifications. Zero disables the cache.
+	CheckCacheSize int
+	// CheckCacheTTL is the number of blocks after which a cached CheckTx
+	// signature verification is evicted. A cached verification never
+	// becomes invalid, so this only limits how long it is kept.
+	CheckCacheTTL int64
+	// TrackIterators enables logging of iterators that were not released
+	// before the commit.
+	TrackIterators bool
+	// MaxTxBytes is the maximum size of a serialized transaction accepted
+	// by CheckTx. Zero disables the limit.
+	MaxTxBytes int
+	// MaxTxRepeated is the maximum number of elements of a single repeated
+	// field of a transaction accepted by CheckTx. Zero disables the limit.
+	MaxTxRepeated int
+	// MaxTxDepth is the maximum nesting level of messages of a
+	// transaction accepted by CheckTx. Zero disables the limit.
+	MaxTxDepth int
 }
 
 func parseFlags(args []string) (string, *Options, error) {
@@ -35,6 +63,12 @@ func parseFlags(args []string) (string, *Options, error) {
 	startFlags.StringVar(&addr, flagBind, "tcp://localhost:26658", "address server listens on")
 	startFlags.StringVar(&minFeeStr, flagMinFee, "0 IOV", "minimal anti-spam fee")
 	startFlags.BoolVar(&options.Debug, flagDebug, false, "call stack returned on error")
+	startFlags.IntVar(&options.CheckCacheSize, flagCheckCacheSize, 0, "maximum number of cached CheckTx signature verifications, 0 disables the cache")
+	startFlags.Int64Var(&options.CheckCacheTTL, flagCheckCacheTTL, 2, "number of blocks after which a cached CheckTx signature verification is evicted")
+	startFlags.BoolVar(&options.TrackIterators, flagTrackIterators, false, "log iterators not released before commit (expensive, debug only)")
+	startFlags.IntVar(&options.MaxTxBytes, flagMaxTxBytes, 1<<20, "maximum size of a transaction in bytes accepted by CheckTx, 0 disables the limit")
+	startFlags.IntVar(&options.MaxTxRepeated, flagMaxTxRepeated, 2000, "maximum number of elements of a transaction repeated field accepted by CheckTx, 0 disables the limit")
+	startFlags.IntVar(&options.MaxTxDepth, flagMaxTxDepth, 32, "maximum nesting level of transaction messages accepted by CheckTx, 0 disables the limit")
 	err := startFlags.Parse(args)
 
 	if err != nil {
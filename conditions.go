@@ -13,6 +13,7 @@ import (
 
 	"github.com/iov-one/weave/crypto/bech32"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 )
 
 var (
@@ -81,6 +82,23 @@ func (c Condition) Validate() error {
 	return nil
 }
 
+func init() {
+	weavejson.Register(Condition{}, func(v interface{}) (interface{}, error) {
+		c := v.(Condition)
+		if c == nil {
+			return "", nil
+		}
+		ext, typ, data, err := c.Parse()
+		if err != nil {
+			return nil, err
+		}
+		return fmt.Sprintf("%s/%s/%X", ext, typ, data), nil
+	})
+	weavejson.Register(Address{}, func(v interface{}) (interface{}, error) {
+		return strings.ToUpper(hex.EncodeToString(v.(Address))), nil
+	})
+}
+
 func (c Condition) MarshalJSON() ([]byte, error) {
 	var serialized string
 	if c != nil {
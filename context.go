@@ -24,8 +24,10 @@ package weave
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/iov-one/weave/errors"
@@ -42,6 +44,8 @@ const (
 	contextKeyLogger
 	contextKeyTime
 	contextCommitInfo
+	contextKeyProposer
+	contextKeySignedValidators
 )
 
 var (
@@ -89,6 +93,56 @@ func GetCommitInfo(ctx Context) (CommitInfo, bool) {
 	return val, ok
 }
 
+// ConsensusAddress is the address of a validator consensus key, as declared
+// by Tendermint, for example as the block proposer address. It is a hash of
+// the validator consensus public key and not a weave account address: no
+// condition produces it and it cannot be used to authenticate or to hold
+// coins.
+type ConsensusAddress []byte
+
+// String returns a hex representation of the address, the same as used by
+// Tendermint.
+func (a ConsensusAddress) String() string {
+	if len(a) == 0 {
+		return "(nil)"
+	}
+	return strings.ToUpper(hex.EncodeToString(a))
+}
+
+// WithBlockProposer sets the consensus address of the validator that proposed
+// the current block. Panics if already set.
+func WithBlockProposer(ctx Context, proposer ConsensusAddress) Context {
+	if _, ok := BlockProposer(ctx); ok {
+		panic("Block proposer already set")
+	}
+	return context.WithValue(ctx, contextKeyProposer, proposer)
+}
+
+// BlockProposer returns the consensus address of the validator that proposed
+// the current block. Returns false if not present.
+func BlockProposer(ctx Context) (ConsensusAddress, bool) {
+	val, ok := ctx.Value(contextKeyProposer).(ConsensusAddress)
+	return val, ok
+}
+
+// WithSignedValidators sets the bitmap of validators that signed the previous
+// block. Value at each index refers to the validator declared at the same index
+// of the commit info votes (see GetCommitInfo). Panics if already set.
+func WithSignedValidators(ctx Context, signed []bool) Context {
+	if _, ok := SignedValidators(ctx); ok {
+		panic("Signed validators already set")
+	}
+	return context.WithValue(ctx, contextKeySignedValidators, signed)
+}
+
+// SignedValidators returns the bitmap of validators that signed the previous
+// block. Value at each index refers to the validator declared at the same index
+// of the commit info votes. Returns false if not present.
+func SignedValidators(ctx Context) ([]bool, bool) {
+	val, ok := ctx.Value(contextKeySignedValidators).([]bool)
+	return val, ok
+}
+
 // WithHeight sets the block height for the Context.
 // panics if called with height already set
 func WithHeight(ctx Context, height int64) Context {
@@ -48,6 +48,30 @@ func TestContext(t *testing.T) {
 	// TODO: test header context!
 }
 
+func TestBlockProposer(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := weave.BlockProposer(ctx)
+	assert.Equal(t, false, ok)
+	_, ok = weave.SignedValidators(ctx)
+	assert.Equal(t, false, ok)
+
+	proposer := weave.ConsensusAddress("proposer-address-1234")
+	ctx = weave.WithBlockProposer(ctx, proposer)
+	ctx = weave.WithSignedValidators(ctx, []bool{true, false, true})
+
+	got, ok := weave.BlockProposer(ctx)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, proposer, got)
+	signed, ok := weave.SignedValidators(ctx)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []bool{true, false, true}, signed)
+
+	// Both values can be set only once.
+	assert.Panics(t, func() { weave.WithBlockProposer(ctx, proposer) })
+	assert.Panics(t, func() { weave.WithSignedValidators(ctx, nil) })
+}
+
 func TestChainID(t *testing.T) {
 	cases := map[string]struct {
 		chainID string
@@ -40,6 +40,16 @@ type TickResult struct {
 	Diff []ValidatorUpdate
 }
 
+// EndBlockHook is called at the end of each block, after all transactions and
+// scheduled tasks were processed. It can be used to report a summary of the
+// whole block, for example the total of all collected fees. Returned tags are
+// included in the end block response.
+//
+// Changes written to the database are committed together with the block. If
+// the hook returns an error, its changes are discarded and its tags are not
+// included.
+type EndBlockHook func(ctx Context, db KVStore) ([]common.KVPair, error)
+
 // Scheduler is an interface implemented to allow scheduling message execution.
 type Scheduler interface {
 	// Schedule queues given message in the database to be executed at
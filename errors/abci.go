@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 )
 
 const (
@@ -102,3 +103,55 @@ func Redact(err error) error {
 	}
 	return err
 }
+
+// RedactForConsensus returns an error that carries the same ABCI code as the
+// given one, but its message is a canonical string derived only from the
+// error codes. Field names and the structure of multi errors are preserved.
+// Errors contained by a multi error are sorted by their redacted message.
+//
+// Error messages can embed data that is not deterministic, for example a map
+// iteration order or an operating system error text. Use this function before
+// storing an error message in the state or in any other result that is part
+// of the consensus. Errors that do not provide ABCICode information are
+// redacted to a generic internal error.
+func RedactForConsensus(err error) error {
+	if errIsNil(err) {
+		return nil
+	}
+	if ErrPanic.Is(err) || abciCode(err) == internalABCICode {
+		return errors.New(internalABCILog)
+	}
+
+	for {
+		switch e := err.(type) {
+		case multiError:
+			redacted := make(multiError, len(e))
+			for i, err := range e {
+				redacted[i] = RedactForConsensus(err)
+			}
+			sort.SliceStable(redacted, func(i, j int) bool {
+				return redacted[i].Error() < redacted[j].Error()
+			})
+			return redacted
+		case *fieldError:
+			return &fieldError{parent: RedactForConsensus(e.parent), field: e.field}
+		case coder:
+			return canonicalError(e.ABCICode())
+		}
+
+		c, ok := err.(causer)
+		if !ok {
+			return errors.New(internalABCILog)
+		}
+		err = c.Cause()
+	}
+}
+
+// canonicalError returns the registered error for the given code. An error
+// with a code that was not registered is described by that code only.
+func canonicalError(code uint32) error {
+	if e, ok := usedCodes[code]; ok && e != nil {
+		return e
+	}
+	return &Error{code: code, desc: fmt.Sprintf("error code %d", code)}
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"fmt"
 	"io"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -174,6 +175,78 @@ func TestRedact(t *testing.T) {
 	}
 }
 
+func TestRedactForConsensus(t *testing.T) {
+	if err := RedactForConsensus(ErrPanic); ErrPanic.Is(err) {
+		t.Error("reduct must not pass through panic error")
+	}
+	if err := RedactForConsensus(ErrNotFound); !ErrNotFound.Is(err) {
+		t.Error("reduct should pass through weave error")
+	}
+
+	if err := RedactForConsensus(Wrapf(ErrNotFound, "%p", t)); err.Error() != "not found" {
+		t.Errorf("reduct should replace the message, got %q", err)
+	}
+
+	var cerr customErr
+	if err := RedactForConsensus(cerr); abciCode(err) != 999 || err.Error() != "error code 999" {
+		t.Errorf("reduct should keep the ABCI code of a custom error, got %d: %q", abciCode(err), err)
+	}
+
+	serr := fmt.Errorf("stdlib error")
+	if err := RedactForConsensus(serr); err == serr {
+		t.Error("reduct must not pass through a stdlib error")
+	}
+
+	ferr := Field("Name", Wrap(ErrEmpty, "no name"), "details")
+	if err := RedactForConsensus(ferr); err.Error() != `field "Name": value is empty` || !ErrEmpty.Is(err) {
+		t.Errorf("reduct should keep the field name, got %q", err)
+	}
+
+	if err := RedactForConsensus(nil); err != nil {
+		t.Errorf("reduct of nil must return nil, got %q", err)
+	}
+}
+
+func TestRedactMultiErrorIsDeterministic(t *testing.T) {
+	errs := []error{
+		Field("Owner", ErrEmpty, "missing owner"),
+		Field("Amount", Wrapf(ErrAmount, "value %d", 42), ""),
+		Field("Tags.0", ErrInput, "tag %q", "x"),
+		Wrap(ErrState, "pointer %p"),
+		fmt.Errorf("stdlib %p", t),
+	}
+
+	want := RedactForConsensus(Append(errs...))
+	if code := abciCode(want); code != multiErrorABCICode {
+		t.Fatalf("want multi error code, got %d", code)
+	}
+	// Nested multi error is not flattened, but must be redacted as well.
+	wantNested := RedactForConsensus(Append(errs[0], Wrap(Append(errs[1:]...), "nested")))
+
+	for i := 0; i < 20; i++ {
+		shuffled := make([]error, len(errs))
+		for n, k := range rand.Perm(len(errs)) {
+			shuffled[n] = errs[k]
+		}
+		if got := RedactForConsensus(Append(shuffled...)); got.Error() != want.Error() {
+			t.Fatalf("redacted message depends on the order\nwant: %s\n got: %s", want, got)
+		}
+
+		rest := make([]error, 0, len(errs)-1)
+		for _, e := range shuffled {
+			if e != errs[0] {
+				rest = append(rest, e)
+			}
+		}
+		if got := RedactForConsensus(Append(Wrap(Append(rest...), "nested"), errs[0])); got.Error() != wantNested.Error() {
+			t.Fatalf("redacted nested message depends on the order\nwant: %s\n got: %s", wantNested, got)
+		}
+	}
+	if !ErrAmount.Is(want) || !ErrEmpty.Is(want) {
+		t.Fatal("redacted multi error must contain all redacted errors")
+	}
+}
+
 func TestABCIInfoSerializeErr(t *testing.T) {
 	var (
 		// Create errors with stacktrace for equal comparision.
@@ -33,6 +33,10 @@ var _ weave.Handler = (*UpdateConfigurationHandler)(nil)
 // To pass authentication step, each message must be signed by the current
 // configuration owner.
 //
+// Given configuration is used only to declare the configuration type and is
+// never modified. Each patch is merged with the stored configuration and the
+// result must pass validation, otherwise the message is rejected.
+//
 // A special chicken-egg problem appears when the configuration does not exist
 // (it was not created via genesis). This is an issue, because without
 // configuration we cannot configure configuration owner that can update the
@@ -74,11 +78,16 @@ func (h UpdateConfigurationHandler) Deliver(ctx weave.Context, store weave.KVSto
 }
 
 func (h UpdateConfigurationHandler) applyTx(ctx weave.Context, store weave.KVStore, tx weave.Tx) error {
-	switch err := Load(store, h.pkg, h.config); {
+	// Each update is applied to a new instance. Unmarshaling does not
+	// reset fields that are not present in the serialized form, so
+	// reusing an instance could carry over the values of a previously
+	// processed, possibly rejected, patch.
+	config := newConfig(h.config)
+	switch err := Load(store, h.pkg, config); {
 	case err == nil:
 		// Configuration owner must sign the transaction in order to
 		// authenticate the change.
-		owner := h.config.GetOwner()
+		owner := config.GetOwner()
 		if owner == nil {
 			return errors.Wrap(errors.ErrUnauthorized, "owner signature required")
 		}
@@ -107,16 +116,27 @@ func (h UpdateConfigurationHandler) applyTx(ctx weave.Context, store weave.KVSto
 	if err != nil {
 		return errors.Wrap(err, "cannot get message payload")
 	}
-	if err := patch(h.config, payload); err != nil {
+	if err := patch(config, payload); err != nil {
 		return errors.Wrap(err, "cannot patch config with message payload")
 	}
+	// Patch is validated on its own, but only the result of merging it
+	// with the current configuration is stored.
+	if err := config.Validate(); err != nil {
+		return errors.Wrap(err, "invalid configuration after applying patch")
+	}
 
-	if err := Save(store, h.pkg, h.config); err != nil {
+	if err := Save(store, h.pkg, config); err != nil {
 		return errors.Wrap(err, "cannot save updated config")
 	}
 	return nil
 }
 
+// newConfig returns a new, zero value instance of the same type as given
+// configuration.
+func newConfig(prototype OwnedConfig) OwnedConfig {
+	return reflect.New(reflect.TypeOf(prototype).Elem()).Interface().(OwnedConfig)
+}
+
 func patch(config OwnedConfig, payload OwnedConfig) error {
 	// We are guaranteed that config and payload are the same type from
 	// patchPayload.
@@ -153,11 +153,57 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 	}
 }
 
+func TestUpdateConfigurationHandlerValidatesMergedConfiguration(t *testing.T) {
+	cond := weavetest.NewCondition()
+
+	db := store.MemStore()
+	initial := &myconfig{
+		Owner: cond.Address(),
+		Str:   "limited",
+		Cn:    coin.NewCoin(1, 0, "IOV"),
+	}
+	if err := Save(db, "mypkg", initial); err != nil {
+		t.Fatalf("cannot save initial configuration: %s", err)
+	}
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	handler := NewUpdateConfigurationHandler("mypkg", &myconfig{}, auth, nil)
+	ctx := weave.WithHeight(context.Background(), 999)
+	ctx = auth.SetConditions(ctx, cond)
+
+	// Patch is valid on its own, but not when merged with the stored
+	// configuration.
+	tx := &weavetest.Tx{Msg: &myconfigMsg{
+		Patch: &myconfig{Owner: cond.Address(), Num: 5000, Cn: coin.NewCoin(1, 0, "IOV")},
+	}}
+	if _, err := handler.Deliver(ctx, db, tx); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	var got myconfig
+	assert.Nil(t, Load(db, "mypkg", &got))
+	assert.Equal(t, initial, &got)
+
+	// Values of the rejected patch are not carried over.
+	tx = &weavetest.Tx{Msg: &myconfigMsg{
+		Patch: &myconfig{Owner: cond.Address(), Str: "unlimited", Cn: coin.NewCoin(1, 0, "IOV")},
+	}}
+	_, err := handler.Deliver(ctx, db, tx)
+	assert.Nil(t, err)
+	got = myconfig{}
+	assert.Nil(t, Load(db, "mypkg", &got))
+	assert.Equal(t, &myconfig{
+		Owner: cond.Address(),
+		Str:   "unlimited",
+		Cn:    coin.NewCoin(1, 0, "IOV"),
+	}, &got)
+}
+
 type myconfig struct {
 	Owner weave.Address
-	Num   int64
-	Str   string
-	Cn    coin.Coin
+	// Zero values are not serialized, the same as with protobuf.
+	Num int64  `json:",omitempty"`
+	Str string `json:",omitempty"`
+	Cn  coin.Coin
 }
 
 func (c *myconfig) GetOwner() weave.Address    { return c.Owner }
@@ -171,6 +217,9 @@ func (c *myconfig) Validate() error {
 	if err := c.Cn.Validate(); err != nil {
 		return errors.Wrap(err, "coin")
 	}
+	if c.Str == "limited" && c.Num > 1000 {
+		return errors.Wrap(errors.ErrInput, "limited number too big")
+	}
 	return nil
 }
 
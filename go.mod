@@ -7,6 +7,7 @@ require (
 	github.com/etcd-io/bbolt v1.3.3 // indirect
 	github.com/fortytw2/leaktest v1.3.0 // indirect
 	github.com/gogo/protobuf v1.2.1
+	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db
 	github.com/google/btree v1.0.0
 	github.com/gorilla/websocket v1.4.0 // indirect
 	github.com/jmhodges/levigo v1.0.0 // indirect
@@ -20,7 +21,7 @@ require (
 	github.com/rs/cors v1.6.0 // indirect
 	github.com/stellar/go v0.0.0-20190723221356-14eed5a46caf
 	github.com/stellar/go-xdr v0.0.0-20180917104419-0bc96f33a18e // indirect
-	github.com/syndtr/goleveldb v1.0.0 // indirect
+	github.com/syndtr/goleveldb v1.0.0
 	github.com/tendermint/go-amino v0.15.0
 	github.com/tendermint/iavl v0.12.2
 	github.com/tendermint/tendermint v0.31.12
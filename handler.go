@@ -3,10 +3,12 @@ package weave
 import (
 	"bytes"
 	"encoding/json"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 )
 
 // Handler is a core engine that can process a few specific messages
@@ -60,7 +62,7 @@ func (o Options) ReadOptions(key string, obj interface{}) error {
 	if len(msg) == 0 {
 		return nil
 	}
-	return json.Unmarshal(msg, obj)
+	return weavejson.Unmarshal(msg, obj)
 }
 
 // Stream expects an array of json elements and allows to process them sequentially
@@ -111,6 +113,8 @@ func (o Options) Stream(key string) func(obj interface{}) error {
 // for some of the extensions.
 type GenesisParams struct {
 	Validators []abci.ValidatorUpdate
+	// Time is the genesis time of the chain.
+	Time time.Time
 }
 
 // FromInitChain initialises GenesisParams using abci.RequestInitChain
@@ -118,6 +122,7 @@ type GenesisParams struct {
 func FromInitChain(req abci.RequestInitChain) GenesisParams {
 	return GenesisParams{
 		Validators: req.Validators,
+		Time:       req.Time,
 	}
 }
 
@@ -126,3 +131,16 @@ func FromInitChain(req abci.RequestInitChain) GenesisParams {
 type Initializer interface {
 	FromGenesis(opts Options, params GenesisParams, kv KVStore) error
 }
+
+// DependentInitializer is implemented by an Initializer that must be run
+// after the initializers of other extensions, for example because it reads
+// data that they create. Implementing this interface is optional, an
+// Initializer that does not implement it has no dependencies.
+type DependentInitializer interface {
+	Initializer
+
+	// Dependencies returns the names of the extensions that must be
+	// initialized first. The name of an extension is the name of the
+	// package that its Initializer is declared in.
+	Dependencies() []string
+}
@@ -29,6 +29,20 @@ type Configuration struct {
 	// versions. If you wish to permit more than one entity to be an admin, use
 	// multisig.
 	Admin github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=admin,proto3,casttype=github.com/iov-one/weave.Address" json:"admin,omitempty"`
+	// TransitionWindow defines for how long after a schema upgrade a
+	// TransitionBucket is storing models in the previous schema version, in
+	// the format of a binary that was not upgraded yet. Zero value disables
+	// the transition window.
+	TransitionWindow github_com_iov_one_weave.UnixDuration `protobuf:"varint,3,opt,name=transition_window,json=transitionWindow,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"transition_window,omitempty"`
+	// AllowDowngrade is an unsafe flag that must be explicitly set in order to
+	// accept a DowngradeSchemaMsg. Schema downgrade is an emergency rollback
+	// tool and it should remain disabled unless needed.
+	AllowDowngrade bool `protobuf:"varint,4,opt,name=allow_downgrade,json=allowDowngrade,proto3" json:"allow_downgrade,omitempty"`
+	// MinUpgradeInterval is the minimal number of blocks that must be created
+	// between two schema upgrades of the same package. It limits how fast the
+	// schema of a package can be changed, for example when the admin key is
+	// compromised. Zero value does not limit upgrades.
+	MinUpgradeInterval int64 `protobuf:"varint,5,opt,name=min_upgrade_interval,json=minUpgradeInterval,proto3" json:"min_upgrade_interval,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -71,6 +85,27 @@ func (m *Configuration) GetAdmin() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Configuration) GetTransitionWindow() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.TransitionWindow
+	}
+	return 0
+}
+
+func (m *Configuration) GetAllowDowngrade() bool {
+	if m != nil {
+		return m.AllowDowngrade
+	}
+	return false
+}
+
+func (m *Configuration) GetMinUpgradeInterval() int64 {
+	if m != nil {
+		return m.MinUpgradeInterval
+	}
+	return 0
+}
+
 // Schema declares the maxiumum supported schema version for a package.
 type Schema struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -79,6 +114,13 @@ type Schema struct {
 	Pkg string `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
 	// Version holds the highest supported schema version.
 	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// UpgradedAt is the block time of the schema upgrade to this version. It is
+	// not set for schema versions initialized without an upgrade message.
+	UpgradedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=upgraded_at,json=upgradedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"upgraded_at,omitempty"`
+	// UpgradedHeight is the block height of the schema upgrade to this
+	// version. It is not set for schema versions initialized without an
+	// upgrade message.
+	UpgradedHeight int64 `protobuf:"varint,5,opt,name=upgraded_height,json=upgradedHeight,proto3" json:"upgraded_height,omitempty"`
 }
 
 func (m *Schema) Reset()         { *m = Schema{} }
@@ -135,6 +177,85 @@ func (m *Schema) GetVersion() uint32 {
 	return 0
 }
 
+func (m *Schema) GetUpgradedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.UpgradedAt
+	}
+	return 0
+}
+
+func (m *Schema) GetUpgradedHeight() int64 {
+	if m != nil {
+		return m.UpgradedHeight
+	}
+	return 0
+}
+
+// LastUpgrade records the block height of the most recent schema upgrade of a
+// package. It is stored independently of the schema versions so that a schema
+// downgrade does not reset the minimal upgrade interval.
+type LastUpgrade struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Pkg holds the name of the package that the schema is stored under.
+	Pkg string `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	// Height is the block height of the most recent schema upgrade.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LastUpgrade) Reset()         { *m = LastUpgrade{} }
+func (m *LastUpgrade) String() string { return proto.CompactTextString(m) }
+func (*LastUpgrade) ProtoMessage()    {}
+func (*LastUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{2}
+}
+func (m *LastUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastUpgrade.Merge(m, src)
+}
+func (m *LastUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastUpgrade proto.InternalMessageInfo
+
+func (m *LastUpgrade) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *LastUpgrade) GetPkg() string {
+	if m != nil {
+		return m.Pkg
+	}
+	return ""
+}
+
+func (m *LastUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // UpgradeSchemaMsg is a request to upgrade schema version of a given package
 // by one version.
 type UpgradeSchemaMsg struct {
@@ -154,7 +275,7 @@ func (m *UpgradeSchemaMsg) Reset()         { *m = UpgradeSchemaMsg{} }
 func (m *UpgradeSchemaMsg) String() string { return proto.CompactTextString(m) }
 func (*UpgradeSchemaMsg) ProtoMessage()    {}
 func (*UpgradeSchemaMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf669b5eede564b, []int{2}
+	return fileDescriptor_ecf669b5eede564b, []int{3}
 }
 func (m *UpgradeSchemaMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,33 +325,314 @@ func (m *UpgradeSchemaMsg) GetToVersion() uint32 {
 	return 0
 }
 
+// DowngradeSchemaMsg is a request to downgrade schema version of a given
+// package by one version. This is an emergency rollback tool. It is accepted
+// only if the configuration allows downgrades and a reverse migration from
+// the current schema version is registered for the package.
+type DowngradeSchemaMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name of the package that schema version downgrade is made for.
+	Pkg string `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	// From version defines which schema version is rolled back. It must be equal
+	// to the currently registered schema version. This ensures at most one
+	// delivery as downgrades from an invalid version are rejected.
+	FromVersion uint32 `protobuf:"varint,3,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+}
+
+func (m *DowngradeSchemaMsg) Reset()         { *m = DowngradeSchemaMsg{} }
+func (m *DowngradeSchemaMsg) String() string { return proto.CompactTextString(m) }
+func (*DowngradeSchemaMsg) ProtoMessage()    {}
+func (*DowngradeSchemaMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{4}
+}
+func (m *DowngradeSchemaMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowngradeSchemaMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowngradeSchemaMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowngradeSchemaMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowngradeSchemaMsg.Merge(m, src)
+}
+func (m *DowngradeSchemaMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowngradeSchemaMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowngradeSchemaMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowngradeSchemaMsg proto.InternalMessageInfo
+
+func (m *DowngradeSchemaMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DowngradeSchemaMsg) GetPkg() string {
+	if m != nil {
+		return m.Pkg
+	}
+	return ""
+}
+
+func (m *DowngradeSchemaMsg) GetFromVersion() uint32 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+// RenameSchemaMsg is a request to rename the stored schema of a package. All
+// schema versions stored under the old package name are moved to the new
+// package name. An alias between both names must be registered. Until renamed,
+// schema version of the new package name is tracked under the old name.
+type RenameSchemaMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Old package name that the schema is currently stored under.
+	OldPkg string `protobuf:"bytes,2,opt,name=old_pkg,json=oldPkg,proto3" json:"old_pkg,omitempty"`
+	// New package name that the schema is to be stored under.
+	NewPkg string `protobuf:"bytes,3,opt,name=new_pkg,json=newPkg,proto3" json:"new_pkg,omitempty"`
+}
+
+func (m *RenameSchemaMsg) Reset()         { *m = RenameSchemaMsg{} }
+func (m *RenameSchemaMsg) String() string { return proto.CompactTextString(m) }
+func (*RenameSchemaMsg) ProtoMessage()    {}
+func (*RenameSchemaMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{5}
+}
+func (m *RenameSchemaMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameSchemaMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameSchemaMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameSchemaMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameSchemaMsg.Merge(m, src)
+}
+func (m *RenameSchemaMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameSchemaMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameSchemaMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameSchemaMsg proto.InternalMessageInfo
+
+func (m *RenameSchemaMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RenameSchemaMsg) GetOldPkg() string {
+	if m != nil {
+		return m.OldPkg
+	}
+	return ""
+}
+
+func (m *RenameSchemaMsg) GetNewPkg() string {
+	if m != nil {
+		return m.NewPkg
+	}
+	return ""
+}
+
+// SchemaValue is a query result representation of a model that contains the
+// schema version that the model is serialized with. This allows a client to
+// decode the value without knowing which schema version the chain stores.
+type SchemaValue struct {
+	// Schema is the metadata schema version of the serialized model.
+	Schema uint32 `protobuf:"varint,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Value is the serialized model, exactly as stored in the database.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SchemaValue) Reset()         { *m = SchemaValue{} }
+func (m *SchemaValue) String() string { return proto.CompactTextString(m) }
+func (*SchemaValue) ProtoMessage()    {}
+func (*SchemaValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{6}
+}
+func (m *SchemaValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaValue.Merge(m, src)
+}
+func (m *SchemaValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaValue proto.InternalMessageInfo
+
+func (m *SchemaValue) GetSchema() uint32 {
+	if m != nil {
+		return m.Schema
+	}
+	return 0
+}
+
+func (m *SchemaValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// SchemaAlias is a query result representation of a registered package alias.
+// Both package names share the same schema version. It is tracked under the
+// old package name until the schema is renamed using RenameSchemaMsg.
+type SchemaAlias struct {
+	// Old package name.
+	OldPkg string `protobuf:"bytes,1,opt,name=old_pkg,json=oldPkg,proto3" json:"old_pkg,omitempty"`
+	// New package name.
+	NewPkg string `protobuf:"bytes,2,opt,name=new_pkg,json=newPkg,proto3" json:"new_pkg,omitempty"`
+	// Stored pkg is the package name that the schema is currently stored
+	// under. It is empty if the schema of neither package is initialized.
+	StoredPkg string `protobuf:"bytes,3,opt,name=stored_pkg,json=storedPkg,proto3" json:"stored_pkg,omitempty"`
+}
+
+func (m *SchemaAlias) Reset()         { *m = SchemaAlias{} }
+func (m *SchemaAlias) String() string { return proto.CompactTextString(m) }
+func (*SchemaAlias) ProtoMessage()    {}
+func (*SchemaAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{7}
+}
+func (m *SchemaAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaAlias.Merge(m, src)
+}
+func (m *SchemaAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaAlias proto.InternalMessageInfo
+
+func (m *SchemaAlias) GetOldPkg() string {
+	if m != nil {
+		return m.OldPkg
+	}
+	return ""
+}
+
+func (m *SchemaAlias) GetNewPkg() string {
+	if m != nil {
+		return m.NewPkg
+	}
+	return ""
+}
+
+func (m *SchemaAlias) GetStoredPkg() string {
+	if m != nil {
+		return m.StoredPkg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Configuration)(nil), "migration.Configuration")
 	proto.RegisterType((*Schema)(nil), "migration.Schema")
+	proto.RegisterType((*LastUpgrade)(nil), "migration.LastUpgrade")
 	proto.RegisterType((*UpgradeSchemaMsg)(nil), "migration.UpgradeSchemaMsg")
+	proto.RegisterType((*DowngradeSchemaMsg)(nil), "migration.DowngradeSchemaMsg")
+	proto.RegisterType((*RenameSchemaMsg)(nil), "migration.RenameSchemaMsg")
+	proto.RegisterType((*SchemaValue)(nil), "migration.SchemaValue")
+	proto.RegisterType((*SchemaAlias)(nil), "migration.SchemaAlias")
 }
 
 func init() { proto.RegisterFile("migration/codec.proto", fileDescriptor_ecf669b5eede564b) }
 
 var fileDescriptor_ecf669b5eede564b = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x17, 0x87, 0xd3, 0x7e, 0x73, 0x38, 0x8a, 0x42, 0x19, 0x18, 0x4b, 0xf1, 0x50, 0x10,
-	0x5b, 0xd0, 0x9b, 0x37, 0xe7, 0x51, 0x76, 0xa9, 0xe8, 0x55, 0xb2, 0x26, 0x66, 0x41, 0x9a, 0xaf,
-	0xa4, 0x59, 0xfd, 0x1b, 0xfe, 0x2c, 0x8f, 0x3b, 0x7a, 0x12, 0x69, 0xff, 0x85, 0x27, 0x59, 0xa3,
-	0x43, 0xcf, 0xde, 0xde, 0x3c, 0xe4, 0xe1, 0x81, 0x0f, 0x0e, 0x0b, 0x25, 0x0d, 0xb3, 0x0a, 0x75,
-	0x9a, 0x23, 0x17, 0x79, 0x52, 0x1a, 0xb4, 0xe8, 0x7b, 0x1b, 0x3c, 0x19, 0xfe, 0xe2, 0x93, 0x03,
-	0x89, 0x12, 0xbb, 0x99, 0xae, 0x97, 0xa3, 0xd1, 0x0d, 0x8c, 0xae, 0x51, 0x3f, 0x2a, 0xb9, 0x74,
-	0x8e, 0x7f, 0x09, 0xdb, 0x8c, 0x17, 0x4a, 0x07, 0x5b, 0x21, 0x89, 0xf7, 0xa6, 0x27, 0x9f, 0xef,
-	0xc7, 0xa1, 0x54, 0x76, 0xb1, 0x9c, 0x27, 0x39, 0x16, 0xa9, 0xc2, 0xfa, 0x0c, 0xb5, 0x48, 0x9f,
-	0x05, 0xab, 0x45, 0x72, 0xc5, 0xb9, 0x11, 0x55, 0x95, 0x39, 0x25, 0x62, 0x30, 0xb8, 0xcd, 0x17,
-	0xa2, 0x60, 0xfe, 0x29, 0xec, 0x16, 0xc2, 0x32, 0xce, 0x2c, 0x0b, 0x48, 0x48, 0xe2, 0xe1, 0xf9,
-	0x7e, 0xe2, 0x94, 0xd9, 0x37, 0xce, 0x36, 0x1f, 0xfc, 0x31, 0xf4, 0xcb, 0x27, 0xd9, 0x05, 0xbd,
-	0x6c, 0x3d, 0xfd, 0x00, 0x76, 0x6a, 0x61, 0x2a, 0x85, 0x3a, 0xe8, 0x87, 0x24, 0x1e, 0x65, 0x3f,
-	0xcf, 0xa8, 0x84, 0xf1, 0x5d, 0x29, 0x0d, 0xe3, 0xc2, 0x95, 0x66, 0x95, 0xfc, 0x6f, 0xec, 0x08,
-	0xc0, 0xe2, 0xc3, 0xdf, 0x9e, 0x67, 0xf1, 0xde, 0x81, 0x69, 0xf0, 0xda, 0x50, 0xb2, 0x6a, 0x28,
-	0xf9, 0x68, 0x28, 0x79, 0x69, 0x69, 0x6f, 0xd5, 0xd2, 0xde, 0x5b, 0x4b, 0x7b, 0xf3, 0x41, 0x77,
-	0xc2, 0x8b, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x95, 0x80, 0x6f, 0x89, 0x01, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x97, 0x95, 0x75, 0xab, 0xb3, 0xae, 0xc5, 0x2a, 0x10, 0x4d, 0x22, 0xed, 0x22, 0x26,
+	0x8a, 0x10, 0x2d, 0x82, 0x1b, 0x9c, 0x5a, 0x26, 0x04, 0x12, 0x93, 0x2a, 0xc3, 0xca, 0x8d, 0xc8,
+	0xab, 0x3d, 0xd7, 0x6a, 0x62, 0x57, 0x89, 0x9b, 0xf0, 0x67, 0xf0, 0x67, 0x71, 0x9c, 0x38, 0x71,
+	0xaa, 0x50, 0xfb, 0x5f, 0xf4, 0x80, 0x50, 0xec, 0xa4, 0x2b, 0x48, 0xe3, 0xc0, 0xb8, 0xbd, 0xf7,
+	0x7d, 0x7e, 0xef, 0xf3, 0x7e, 0x28, 0x01, 0x77, 0x42, 0xce, 0x22, 0xac, 0xb8, 0x14, 0xdd, 0x91,
+	0x24, 0x74, 0xd4, 0x99, 0x46, 0x52, 0x49, 0x58, 0x59, 0xcb, 0x87, 0xf6, 0x86, 0x7e, 0xd8, 0x60,
+	0x92, 0x49, 0x6d, 0x76, 0x33, 0xcb, 0xa8, 0xde, 0x4f, 0x0b, 0x54, 0x5f, 0x49, 0x71, 0xc1, 0xd9,
+	0xcc, 0x24, 0xc1, 0x17, 0x60, 0x07, 0x93, 0x90, 0x0b, 0x67, 0xbb, 0x65, 0xb5, 0xf7, 0xfb, 0x0f,
+	0x56, 0xf3, 0x66, 0x8b, 0x71, 0x35, 0x9e, 0x9d, 0x77, 0x46, 0x32, 0xec, 0x72, 0x99, 0x3c, 0x91,
+	0x82, 0x76, 0x53, 0x8a, 0x13, 0xda, 0xe9, 0x11, 0x12, 0xd1, 0x38, 0x46, 0x26, 0x05, 0x0e, 0xc1,
+	0x6d, 0x15, 0x61, 0x11, 0xf3, 0xac, 0x92, 0x9f, 0x72, 0x41, 0x64, 0xea, 0x94, 0x5a, 0x56, 0xbb,
+	0xd4, 0x7f, 0xb4, 0x9a, 0x37, 0x8f, 0xaf, 0xad, 0x73, 0x26, 0xf8, 0xe7, 0x93, 0xbc, 0x03, 0x54,
+	0xbf, 0xaa, 0xf1, 0x51, 0x97, 0x80, 0x0f, 0x41, 0x0d, 0x07, 0x81, 0x4c, 0x7d, 0x22, 0x53, 0xc1,
+	0x22, 0x4c, 0xa8, 0x73, 0xab, 0x65, 0xb5, 0xf7, 0xd0, 0x81, 0x96, 0x4f, 0x0a, 0x15, 0x3e, 0x05,
+	0x8d, 0x90, 0x0b, 0x7f, 0x36, 0xd5, 0xae, 0xcf, 0x85, 0xa2, 0x51, 0x82, 0x03, 0x67, 0x27, 0xeb,
+	0x01, 0xc1, 0x90, 0x8b, 0x33, 0x13, 0x7a, 0x9b, 0x47, 0xbc, 0x6f, 0x16, 0x28, 0xbf, 0x1f, 0x8d,
+	0x69, 0x88, 0xe1, 0x63, 0xb0, 0x17, 0x52, 0x85, 0x09, 0x56, 0xd8, 0xb1, 0x5a, 0x56, 0xdb, 0x7e,
+	0x56, 0xeb, 0x98, 0xf6, 0x4e, 0x73, 0x19, 0xad, 0x1f, 0xc0, 0x3a, 0x28, 0x4d, 0x27, 0x4c, 0x2f,
+	0xa9, 0x82, 0x32, 0x13, 0x3a, 0x60, 0x37, 0xa1, 0x51, 0xcc, 0xa5, 0xd0, 0x23, 0x57, 0x51, 0xe1,
+	0xc2, 0xd7, 0xc0, 0xce, 0x3b, 0x22, 0x3e, 0x56, 0xba, 0xf5, 0x52, 0xff, 0x78, 0x35, 0x6f, 0x1e,
+	0xfd, 0x75, 0x21, 0x1f, 0x78, 0x48, 0x11, 0x28, 0x32, 0x7b, 0x2a, 0x5b, 0xc3, 0xba, 0xce, 0x98,
+	0x72, 0x36, 0x56, 0xf9, 0x60, 0x07, 0x85, 0xfc, 0x46, 0xab, 0x1e, 0x01, 0xf6, 0x3b, 0x1c, 0xab,
+	0x7c, 0xd6, 0x9b, 0x0e, 0x76, 0x17, 0x94, 0x73, 0x9a, 0x3e, 0x25, 0xca, 0x3d, 0x6f, 0x0a, 0xea,
+	0x39, 0xc1, 0x2c, 0xf0, 0x34, 0x66, 0x37, 0x45, 0xdd, 0x07, 0x40, 0x49, 0xff, 0xf7, 0x35, 0x56,
+	0x94, 0x1c, 0x1a, 0xc1, 0x4b, 0x00, 0x5c, 0xdf, 0xfa, 0xbf, 0x31, 0x8f, 0xc0, 0xfe, 0x45, 0x24,
+	0xc3, 0x3f, 0xa8, 0x76, 0xa6, 0x15, 0xdc, 0x29, 0xa8, 0x21, 0x2a, 0x70, 0xf8, 0xaf, 0xd0, 0x7b,
+	0x60, 0x57, 0x06, 0xc4, 0xbf, 0x02, 0x97, 0x65, 0x40, 0x06, 0x13, 0x96, 0x05, 0x04, 0x4d, 0x75,
+	0xa0, 0x64, 0x02, 0x82, 0xa6, 0x83, 0x09, 0xf3, 0x5e, 0x02, 0xdb, 0xb0, 0x86, 0x38, 0x98, 0xd1,
+	0xec, 0x04, 0xb1, 0x76, 0x35, 0xab, 0x8a, 0x72, 0x0f, 0x36, 0xc0, 0x4e, 0x92, 0x3d, 0x30, 0x1f,
+	0x2b, 0x32, 0x8e, 0xf7, 0xa9, 0x48, 0xee, 0x05, 0x1c, 0xc7, 0x9b, 0x74, 0xeb, 0x3a, 0xfa, 0xf6,
+	0x26, 0x3d, 0x3b, 0x43, 0xac, 0x64, 0x44, 0xc9, 0x46, 0x67, 0x15, 0xa3, 0x0c, 0x26, 0xac, 0xef,
+	0x7c, 0x5d, 0xb8, 0xd6, 0xe5, 0xc2, 0xb5, 0x7e, 0x2c, 0x5c, 0xeb, 0xcb, 0xd2, 0xdd, 0xba, 0x5c,
+	0xba, 0x5b, 0xdf, 0x97, 0xee, 0xd6, 0x79, 0x59, 0xff, 0x55, 0x9e, 0xff, 0x1a, 0x00, 0x27, 0xbd,
+	0x18, 0x5e, 0x9c, 0x04, 0x00, 0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -254,6 +656,26 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
 		i += copy(dAtA[i:], m.Admin)
 	}
+	if m.TransitionWindow != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TransitionWindow))
+	}
+	if m.AllowDowngrade {
+		dAtA[i] = 0x20
+		i++
+		if m.AllowDowngrade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MinUpgradeInterval != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MinUpgradeInterval))
+	}
 	return i, nil
 }
 
@@ -293,10 +715,20 @@ func (m *Schema) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Version))
 	}
+	if m.UpgradedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpgradedAt))
+	}
+	if m.UpgradedHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpgradedHeight))
+	}
 	return i, nil
 }
 
-func (m *UpgradeSchemaMsg) Marshal() (dAtA []byte, err error) {
+func (m *LastUpgrade) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -306,7 +738,7 @@ func (m *UpgradeSchemaMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *LastUpgrade) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -327,35 +759,227 @@ func (m *UpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pkg)))
 		i += copy(dAtA[i:], m.Pkg)
 	}
-	if m.ToVersion != 0 {
+	if m.Height != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ToVersion))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
 	}
 	return i, nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpgradeSchemaMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Configuration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
-}
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Pkg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pkg)))
+		i += copy(dAtA[i:], m.Pkg)
+	}
+	if m.ToVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ToVersion))
+	}
+	return i, nil
+}
+
+func (m *DowngradeSchemaMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Pkg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pkg)))
+		i += copy(dAtA[i:], m.Pkg)
+	}
+	if m.FromVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FromVersion))
+	}
+	return i, nil
+}
+
+func (m *RenameSchemaMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.OldPkg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.OldPkg)))
+		i += copy(dAtA[i:], m.OldPkg)
+	}
+	if len(m.NewPkg) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.NewPkg)))
+		i += copy(dAtA[i:], m.NewPkg)
+	}
+	return i, nil
+}
+
+func (m *SchemaValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Schema))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *SchemaAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaAlias) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OldPkg) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.OldPkg)))
+		i += copy(dAtA[i:], m.OldPkg)
+	}
+	if len(m.NewPkg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.NewPkg)))
+		i += copy(dAtA[i:], m.NewPkg)
+	}
+	if len(m.StoredPkg) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.StoredPkg)))
+		i += copy(dAtA[i:], m.StoredPkg)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.TransitionWindow != 0 {
+		n += 1 + sovCodec(uint64(m.TransitionWindow))
+	}
+	if m.AllowDowngrade {
+		n += 2
+	}
+	if m.MinUpgradeInterval != 0 {
+		n += 1 + sovCodec(uint64(m.MinUpgradeInterval))
+	}
+	return n
+}
 
 func (m *Schema) Size() (n int) {
 	if m == nil {
@@ -374,6 +998,32 @@ func (m *Schema) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovCodec(uint64(m.Version))
 	}
+	if m.UpgradedAt != 0 {
+		n += 1 + sovCodec(uint64(m.UpgradedAt))
+	}
+	if m.UpgradedHeight != 0 {
+		n += 1 + sovCodec(uint64(m.UpgradedHeight))
+	}
+	return n
+}
+
+func (m *LastUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Pkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
 	return n
 }
 
@@ -394,23 +1044,704 @@ func (m *UpgradeSchemaMsg) Size() (n int) {
 	if m.ToVersion != 0 {
 		n += 1 + sovCodec(uint64(m.ToVersion))
 	}
-	return n
-}
+	return n
+}
+
+func (m *DowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Pkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FromVersion != 0 {
+		n += 1 + sovCodec(uint64(m.FromVersion))
+	}
+	return n
+}
+
+func (m *RenameSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.OldPkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.NewPkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != 0 {
+		n += 1 + sovCodec(uint64(m.Schema))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldPkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.NewPkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.StoredPkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionWindow", wireType)
+			}
+			m.TransitionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransitionWindow |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowDowngrade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowDowngrade = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUpgradeInterval", wireType)
+			}
+			m.MinUpgradeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUpgradeInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedAt", wireType)
+			}
+			m.UpgradedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedHeight", wireType)
+			}
+			m.UpgradedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeSchemaMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Configuration) Unmarshal(dAtA []byte) error {
+func (m *DowngradeSchemaMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -433,17 +1764,17 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowngradeSchemaMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowngradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -453,26 +1784,79 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -497,7 +1881,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Schema) Unmarshal(dAtA []byte) error {
+func (m *RenameSchemaMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -520,10 +1904,10 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameSchemaMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -564,7 +1948,7 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPkg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -592,13 +1976,13 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pkg = string(dAtA[iNdEx:postIndex])
+			m.OldPkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPkg", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -608,11 +1992,24 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -637,7 +2034,7 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
+func (m *SchemaValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -660,17 +2057,36 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeSchemaMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			m.Schema = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Schema |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -680,31 +2096,114 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPkg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -732,13 +2231,13 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pkg = string(dAtA[iNdEx:postIndex])
+			m.NewPkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredPkg", wireType)
 			}
-			m.ToVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -748,11 +2247,24 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToVersion |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredPkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -10,6 +10,12 @@ func (c *Configuration) Validate() error {
 	if err := c.Admin.Validate(); err != nil {
 		return errors.Wrap(err, "admin")
 	}
+	if c.TransitionWindow < 0 {
+		return errors.Wrap(errors.ErrInput, "transition window must not be negative")
+	}
+	if c.MinUpgradeInterval < 0 {
+		return errors.Wrap(errors.ErrInput, "min upgrade interval must not be negative")
+	}
 	return nil
 }
 
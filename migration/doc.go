@@ -42,6 +42,10 @@ that require no change. For example:
         }
     }
 
+When only some entities require a change, wrap the migration function with
+`migration.Conditional`. Entities that the predicate returns false for are
+passed through with only their schema version updated.
+
 3. change your bucket implementation to embed `migration.Bucket` instead of
 `orm.Bucket`
 
@@ -53,5 +57,45 @@ handler,
 This is not necessary for models as it will default to the current schema
 version.
 
+6. optionally, to support a rolling upgrade of a validator set running mixed
+binaries, wrap your model bucket with `migration.NewTransitionBucket`. For the
+duration of the transition window, configured via "migration" configuration
+`transition_window` attribute and counted from the schema upgrade, each saved
+model is stored under its key in the previous schema version, so that a binary
+that was not upgraded yet can read it. The model in the current schema version
+is stored in a separate bucket and is read by `TransitionBucket.One`. Once the
+window closes, models are stored in the current schema version and the models
+written during the window must be moved into place once, by calling
+`TransitionBucket.Purge`, for example from a data migration.
+
+7. optionally, to allow an emergency rollback of a schema upgrade, register a
+reverse migration function for your package using `migration.RegisterDowngrade`.
+A schema version can be rolled back with `DowngradeSchemaMsg` only if a
+reverse migration from that version is registered and the "migration"
+configuration `allow_downgrade` flag is set. Stored entities are not rewritten,
+instead the reverse migration is applied to each entity when it is read.
+
+8. optionally, before dropping support of an old schema version, declare it
+deprecated using `migration.RegisterDeprecated`. Reading an entity stored in a
+deprecated schema version is not failing, but the function registered with
+`migration.SetDeprecationObserver` is notified, so that the volume of not yet
+migrated data can be monitored.
+
+9. when renaming a package, register an alias between the old and the new
+package name using `migration.MustRegisterAlias`. Schema version lookups for
+the new name use the schema stored under the old name, until it is moved to
+the new name with `RenameSchemaMsg`. Registered aliases can be queried using
+the "/schemaaliases" path.
+
+The "migration" configuration `min_upgrade_interval` attribute declares the
+minimal number of blocks between two schema upgrades of the same package.
+Zero value, which is the default, does not limit upgrades. An upgrade that is
+processed too soon fails with `ErrUpgradeTooSoon`. This limits the damage that
+a compromised admin key can do. The height of the last upgrade of each package
+is stored independently of the schema versions, so a schema downgrade does not
+reset the interval. The limit applies to both `UpgradeSchemaMsg` and
+`EnsureSchemaAtLeast`. A single `EnsureSchemaAtLeast` call is limited as one
+upgrade, so that it can catch up by many versions at once.
+
 */
 package migration
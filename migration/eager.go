@@ -0,0 +1,286 @@
+package migration
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// eagerMigrator is implemented by the migrating buckets. It allows to migrate
+// all entities stored in a bucket at once, instead of on read.
+type eagerMigrator interface {
+	// pkg returns the name of the package that the bucket belongs to.
+	pkg() string
+
+	// indexNames returns the names of all indexes declared for the
+	// bucket.
+	indexNames() []string
+
+	// migrateAll saves again every entity that is not stored in the
+	// current schema version of the package. It returns the number of
+	// migrated entities.
+	migrateAll(db weave.KVStore) (int, error)
+}
+
+func newBucketRegister() *bucketRegister {
+	return &bucketRegister{
+		buckets: make(map[string]trackedBucket),
+	}
+}
+
+// bucketRegister tracks all migrating buckets created by the application.
+type bucketRegister struct {
+	mu sync.Mutex
+	// buckets maps the bucket prefix together with the declared index set
+	// to the bucket instance.
+	buckets map[string]trackedBucket
+}
+
+type trackedBucket struct {
+	prefix  string
+	indexes []string
+	bucket  eagerMigrator
+}
+
+// Track registers given bucket. Because a bucket is configured by making
+// copies of it, each copy declaring a different set of indexes is tracked
+// separately. A copy with the same prefix and index set registered before is
+// replaced.
+func (r *bucketRegister) Track(prefix []byte, b eagerMigrator) {
+	indexes := b.indexNames()
+	sort.Strings(indexes)
+	key := string(prefix) + "\x00" + strings.Join(indexes, "\x00")
+
+	r.mu.Lock()
+	r.buckets[key] = trackedBucket{
+		prefix:  string(prefix),
+		indexes: indexes,
+		bucket:  b,
+	}
+	r.mu.Unlock()
+}
+
+// MigrateAll migrates all entities of every tracked bucket. Buckets are
+// processed in the order of their prefix so that the result does not depend
+// on the order they were created in.
+//
+// For each prefix, the copy declaring all indexes declared by any other copy
+// is used, so that no index is left pointing to an old value. If no copy
+// declares all of them, an error is returned before anything is migrated.
+func (r *bucketRegister) MigrateAll(db weave.KVStore) (map[string]int, error) {
+	r.mu.Lock()
+	byPrefix := make(map[string][]trackedBucket)
+	for _, t := range r.buckets {
+		byPrefix[t.prefix] = append(byPrefix[t.prefix], t)
+	}
+	r.mu.Unlock()
+
+	prefixes := make([]string, 0, len(byPrefix))
+	for p := range byPrefix {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	complete := make([]eagerMigrator, len(prefixes))
+	for i, p := range prefixes {
+		b, err := completeCopy(byPrefix[p])
+		if err != nil {
+			return nil, errors.Wrapf(err, "bucket %q", p)
+		}
+		complete[i] = b
+	}
+
+	migrated := make(map[string]int)
+	for i, b := range complete {
+		n, err := b.migrateAll(db)
+		if err != nil {
+			return migrated, errors.Wrapf(err, "bucket %q of package %q", prefixes[i], b.pkg())
+		}
+		migrated[b.pkg()] += n
+	}
+	return migrated, nil
+}
+
+// completeCopy returns the copy of a bucket that declares all indexes
+// declared by any of given copies.
+func completeCopy(copies []trackedBucket) (eagerMigrator, error) {
+	best := copies[0]
+	for _, c := range copies[1:] {
+		if len(c.indexes) > len(best.indexes) {
+			best = c
+		}
+	}
+	declared := make(map[string]struct{}, len(best.indexes))
+	for _, name := range best.indexes {
+		declared[name] = struct{}{}
+	}
+	for _, c := range copies {
+		for _, name := range c.indexes {
+			if _, ok := declared[name]; !ok {
+				return nil, errors.Wrapf(errors.ErrState, "no bucket declares both %q and %q index sets", best.indexes, c.indexes)
+			}
+		}
+	}
+	return best.bucket, nil
+}
+
+// buckets is a globally available register of all migrating buckets created
+// by the application.
+var buckets = newBucketRegister()
+
+// EagerMigrateAll migrates all entities stored in any of the migrating buckets
+// to the current schema version of their package. It returns the number of
+// migrated entities, grouped by the package name. Every package that owns a
+// migrating bucket is reported, even if none of its entities was migrated.
+//
+// By default entities are migrated lazily, when read. This function allows a
+// small chain to trade the startup time for the guarantee that no entity is
+// stored in an old schema version. Only buckets that were created before this
+// function is called are migrated, therefore it must be called after the
+// application was fully assembled. Packages without a schema version are
+// skipped.
+//
+// This function modifies the state, so every node must call it on the same
+// state. It must be called either from a message handler, or by the
+// application at a block height agreed upon by all validators, for example
+// from a BeginBlock or EndBlock of a chosen height. Calling it at a node
+// startup results in a consensus failure. The whole state is processed at
+// once, so the chain must be small enough for it to fit in a single block.
+//
+// Only entities that are not in the current schema version are written, so
+// running this function again is safe and does not modify the state.
+func EagerMigrateAll(db weave.KVStore) (map[string]int, error) {
+	return buckets.MigrateAll(db)
+}
+
+// eagerVersion returns the current schema version of given package. It returns
+// zero if the package schema is not initialized.
+func eagerVersion(schema *SchemaBucket, db weave.ReadOnlyKVStore, pkg string) (uint32, error) {
+	version, err := schema.CurrentSchema(db, pkg)
+	switch {
+	case err == nil:
+		return version, nil
+	case errors.ErrNotFound.Is(err):
+		return 0, nil
+	default:
+		return 0, errors.Wrapf(err, "current schema version of package %q", pkg)
+	}
+}
+
+// isStale returns true if given entity is stored in a schema version other
+// than the current one.
+func isStale(model interface{}, version uint32) bool {
+	m, ok := model.(Migratable)
+	if !ok || m.GetMetadata() == nil {
+		return false
+	}
+	return m.GetMetadata().Schema != version
+}
+
+func (svb Bucket) pkg() string {
+	return svb.packageName
+}
+
+func (svb Bucket) indexNames() []string {
+	return append([]string{}, svb.indexes...)
+}
+
+func (svb Bucket) migrateAll(db weave.KVStore) (int, error) {
+	version, err := eagerVersion(svb.schema, db, svb.packageName)
+	if err != nil || version == 0 {
+		return 0, err
+	}
+
+	prefix := svb.DBKey(nil)
+	end := append(append([]byte{}, prefix...), 255, 255, 255, 255, 255, 255, 255)
+	iter, err := db.Iterator(prefix, end)
+	if err != nil {
+		return 0, errors.Wrap(err, "new iterator")
+	}
+	// Keys are collected first, because the database must not be
+	// modified while iterating.
+	var stale [][]byte
+	for {
+		key, value, err := iter.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			iter.Release()
+			return 0, errors.Wrap(err, "iterator next")
+		}
+		obj, err := svb.Bucket.Parse(nil, value)
+		if err != nil {
+			iter.Release()
+			return 0, errors.Wrapf(err, "parse %q", key)
+		}
+		if isStale(obj.Value(), version) {
+			stale = append(stale, append([]byte{}, key[len(prefix):]...))
+		}
+	}
+	iter.Release()
+
+	for _, key := range stale {
+		obj, err := svb.Bucket.Get(db, key)
+		if err != nil {
+			return 0, errors.Wrapf(err, "get %q", key)
+		}
+		if err := svb.Save(db, obj); err != nil {
+			return 0, errors.Wrapf(err, "save %q", key)
+		}
+	}
+	return len(stale), nil
+}
+
+func (m *ModelBucket) pkg() string {
+	return m.packageName
+}
+
+func (m *ModelBucket) indexNames() []string {
+	return m.b.IndexNames()
+}
+
+func (m *ModelBucket) migrateAll(db weave.KVStore) (int, error) {
+	version, err := eagerVersion(m.schema, db, m.packageName)
+	if err != nil || version == 0 {
+		return 0, err
+	}
+
+	prefix := m.b.BucketPrefix()
+	it := orm.IterAll(string(prefix[:len(prefix)-1]))
+	// Keys are collected first, because the database must not be
+	// modified while iterating.
+	var stale [][]byte
+	for {
+		model := m.b.NewModel()
+		key, err := it.Next(db, model)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		if isStale(model, version) {
+			stale = append(stale, append([]byte{}, key...))
+		}
+	}
+
+	for _, key := range stale {
+		model := m.b.NewModel()
+		if err := m.b.One(db, key, model); err != nil {
+			return 0, errors.Wrapf(err, "get %q", key)
+		}
+		// An entity stored under a reserved key can be written only
+		// using PutReserved, that rejects any other key with ErrInput.
+		err := m.PutReserved(db, key, model)
+		if errors.ErrInput.Is(err) {
+			_, err = m.Put(db, key, model)
+		}
+		if err != nil {
+			return 0, errors.Wrapf(err, "save %q", key)
+		}
+	}
+	return len(stale), nil
+}
@@ -0,0 +1,163 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestEagerMigrateAll(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt += 100
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{},
+		orm.WithIndex("cnt", func(obj orm.Object) ([]byte, error) {
+			return []byte{byte(obj.Value().(*MyModel).Cnt)}, nil
+		}, false),
+	))
+	mb.useRegister(reg)
+	b := NewBucket(thisPkgName, "mybucket", &MyModel{}).useRegister(reg)
+
+	tracked := newBucketRegister()
+	tracked.Track(mb.BucketPrefix(), mb)
+	tracked.Track(b.DBKey(nil), b)
+	// Bucket of a package without a schema version must be ignored.
+	tracked.Track([]byte("other:"), NewBucket("otherpkg", "other", &MyModel{}).useRegister(reg))
+
+	for _, key := range []string{"a", "b"} {
+		_, err := mb.Put(db, []byte(key), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, b.Save(db, orm.NewSimpleObj([]byte("c"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 2})))
+
+	// Nothing to migrate while the schema version is not changed.
+	migrated, err := tracked.MigrateAll(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{thisPkgName: 0, "otherpkg": 0}, migrated)
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	migrated, err = tracked.MigrateAll(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{thisPkgName: 3, "otherpkg": 0}, migrated)
+
+	// Migrated entities must be stored in the current schema version.
+	// Reading from the not migrating bucket ensures that no migration is
+	// done on read.
+	for _, key := range []string{"a", "b"} {
+		var m MyModel
+		assert.Nil(t, mb.b.One(db, []byte(key), &m))
+		assert.Equal(t, uint32(2), m.Metadata.Schema)
+		assert.Equal(t, 101, m.Cnt)
+	}
+	obj, err := b.Bucket.Get(db, []byte("c"))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), obj.Value().(*MyModel).Metadata.Schema)
+	assert.Equal(t, 102, obj.Value().(*MyModel).Cnt)
+
+	// Index must point to the migrated values only.
+	var found []MyModel
+	keys, err := mb.ByIndex(db, "cnt", []byte{101}, &found)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(keys))
+	keys, err = mb.ByIndex(db, "cnt", []byte{1}, &found)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	// Running the migration again must not modify anything.
+	migrated, err = tracked.MigrateAll(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{thisPkgName: 0, "otherpkg": 0}, migrated)
+}
+
+func TestEagerMigrateAllBucketCopies(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt += 100
+		return nil
+	})
+	indexCnt := func(obj orm.Object) ([]byte, error) {
+		return []byte{byte(obj.Value().(*MyModel).Cnt)}, nil
+	}
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	plain := NewBucket(thisPkgName, "mybucket", &MyModel{}).useRegister(reg)
+	indexed := plain.WithIndex("cnt", indexCnt, false).(Bucket)
+	assert.Nil(t, indexed.Save(db, orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})))
+
+	// The copy without an index is tracked last, but it must not be
+	// used as it would not update the index.
+	tracked := newBucketRegister()
+	tracked.Track(indexed.DBKey(nil), indexed)
+	tracked.Track(plain.DBKey(nil), plain)
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	migrated, err := tracked.MigrateAll(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{thisPkgName: 1}, migrated)
+
+	objs, err := indexed.GetIndexed(db, "cnt", []byte{101})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(objs))
+
+	// Copies declaring different index sets cannot be migrated, because
+	// none of them maintains all indexes.
+	other := plain.WithIndex("other", indexCnt, false).(Bucket)
+	tracked.Track(other.DBKey(nil), other)
+	if _, err := tracked.MigrateAll(db); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+}
+
+func TestEagerMigrateAllReservedKey(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt += 100
+		return nil
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{},
+		orm.WithReservedKeys([]byte("conf")),
+	))
+	mb.useRegister(reg)
+	assert.Nil(t, mb.PutReserved(db, []byte("conf"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1}))
+	_, err := mb.Put(db, []byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 2})
+	assert.Nil(t, err)
+
+	tracked := newBucketRegister()
+	tracked.Track(mb.BucketPrefix(), mb)
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	migrated, err := tracked.MigrateAll(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{thisPkgName: 2}, migrated)
+
+	var m MyModel
+	assert.Nil(t, mb.b.One(db, []byte("conf"), &m))
+	assert.Equal(t, uint32(2), m.Metadata.Schema)
+	assert.Equal(t, 101, m.Cnt)
+}
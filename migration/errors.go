@@ -0,0 +1,17 @@
+package migration
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+// Migration reserves 130~139 error codes
+
+// ErrUpgradeTooSoon is returned when a schema upgrade is requested before the
+// configured minimal number of blocks since the previous upgrade of the same
+// package was created.
+var ErrUpgradeTooSoon = errors.Register(130, "schema upgrade too soon")
+
+// ErrSchemaUnknownPackage is returned when a schema is declared for a package
+// that is not known to the binary. See MustRegisterPackage. This is most
+// likely a typo in the package name.
+var ErrSchemaUnknownPackage = errors.Register(131, "unknown schema package")
@@ -94,6 +94,15 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 		bucket: bucket,
 		auth:   auth,
 	})
+	r.Handle(&DowngradeSchemaMsg{}, &downgradeSchemaHandler{
+		bucket:     bucket,
+		auth:       auth,
+		migrations: reg,
+	})
+	r.Handle(&RenameSchemaMsg{}, &renameSchemaHandler{
+		bucket: bucket,
+		auth:   auth,
+	})
 }
 
 type upgradeSchemaHandler struct {
@@ -102,33 +111,74 @@ type upgradeSchemaHandler struct {
 }
 
 func (h *upgradeSchemaHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, err := h.validate(ctx, db, tx); err != nil {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
 	return &weave.CheckResult{}, nil
 }
 
 func (h *upgradeSchemaHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, db, tx)
+	msg, conf, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	schema := Schema{
-		Metadata: &weave.Metadata{Schema: 1},
-		Pkg:      msg.Pkg,
-		Version:  msg.ToVersion,
-	}
-	obj, err := h.bucket.Create(db, &schema)
+	obj, err := h.bucket.upgrade(ctx, db, conf, msg.Pkg, msg.ToVersion, true)
 	if err != nil {
-		return nil, errors.Wrap(err, "create schema version")
+		return nil, err
 	}
 
 	return &weave.DeliverResult{Data: obj.Key()}, nil
 }
 
-func (h *upgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpgradeSchemaMsg, error) {
+func (h *upgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpgradeSchemaMsg, *Configuration, error) {
 	var msg UpgradeSchemaMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load configuration")
+	}
+	if !h.auth.HasAddress(ctx, conf.Admin) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "admin signature required")
+	}
+	if err := h.bucket.validateUpgrade(ctx, db, conf, msg.Pkg, msg.ToVersion, true); err != nil {
+		return nil, nil, err
+	}
+	return &msg, conf, nil
+}
+
+type downgradeSchemaHandler struct {
+	bucket     *SchemaBucket
+	auth       x.Authenticator
+	migrations *register
+}
+
+func (h *downgradeSchemaHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *downgradeSchemaHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stored entities are not rewritten. Reverse migration is applied
+	// to each entity when it is read.
+	if err := h.bucket.deleteCurrent(db, msg.Pkg, msg.FromVersion); err != nil {
+		return nil, errors.Wrap(err, "delete schema version")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *downgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*DowngradeSchemaMsg, error) {
+	var msg DowngradeSchemaMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
 		return nil, errors.Wrap(err, "load msg")
 	}
@@ -140,19 +190,76 @@ func (h *upgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx
 	if !h.auth.HasAddress(ctx, conf.Admin) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "admin signature required")
 	}
+	if !conf.AllowDowngrade {
+		return nil, errors.Wrap(errors.ErrState, "schema downgrade is not allowed")
+	}
 
-	switch ver, err := h.bucket.CurrentSchema(db, msg.Pkg); {
-	case err == nil:
-		if ver+1 != msg.ToVersion {
-			return nil, errors.Wrapf(errors.ErrSchema, "the current schema version is %d", ver)
-		}
-	case errors.ErrNotFound.Is(err):
-		if msg.ToVersion != 1 {
-			return nil, errors.Wrap(errors.ErrSchema, "schema must be initialized with version 1")
-		}
-	default:
+	ver, err := h.bucket.CurrentSchema(db, msg.Pkg)
+	if err != nil {
 		return nil, errors.Wrap(err, "current schema version")
 	}
+	if ver != msg.FromVersion {
+		return nil, errors.Wrapf(errors.ErrSchema, "the current schema version is %d", ver)
+	}
+	if !h.migrations.HasDowngrade(msg.Pkg, msg.FromVersion) {
+		return nil, errors.Wrapf(errors.ErrSchema, "no downgrade from version %d registered", msg.FromVersion)
+	}
+
+	return &msg, nil
+}
+
+type renameSchemaHandler struct {
+	bucket *SchemaBucket
+	auth   x.Authenticator
+}
+
+func (h *renameSchemaHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *renameSchemaHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.bucket.rename(db, msg.OldPkg, msg.NewPkg); err != nil {
+		return nil, errors.Wrap(err, "rename schema")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *renameSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*RenameSchemaMsg, error) {
+	var msg RenameSchemaMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	if !h.auth.HasAddress(ctx, conf.Admin) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "admin signature required")
+	}
+
+	if h.bucket.migrations.aliases[msg.NewPkg] != msg.OldPkg {
+		return nil, errors.Wrapf(errors.ErrSchema, "%q is not registered as an alias of %q", msg.NewPkg, msg.OldPkg)
+	}
+	switch ok, err := h.bucket.initialized(db, msg.OldPkg); {
+	case err != nil:
+		return nil, err
+	case !ok:
+		return nil, errors.Wrapf(errors.ErrNotFound, "schema of %q not initialized", msg.OldPkg)
+	}
+	switch ok, err := h.bucket.initialized(db, msg.NewPkg); {
+	case err != nil:
+		return nil, err
+	case ok:
+		return nil, errors.Wrapf(errors.ErrDuplicate, "schema of %q already exists", msg.NewPkg)
+	}
 
 	return &msg, nil
 }
@@ -1,14 +1,19 @@
 package migration
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x"
 )
 
 func TestSchemaMigratingHandler(t *testing.T) {
@@ -79,6 +84,302 @@ func TestSchemaMigratingHandler(t *testing.T) {
 	assert.Equal(t, msg2.Content, "bar")
 }
 
+func TestUpgradeSchemaHandlerInterval(t *testing.T) {
+	// Only a package that registers migrations can be upgraded.
+	const thisPkgName = "migration"
+
+	admin := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		Interval   int64
+		Heights    []int64
+		WantErrs   []*errors.Error
+		WantSchema uint32
+	}{
+		"zero interval does not limit upgrades": {
+			Interval:   0,
+			Heights:    []int64{10, 10, 11},
+			WantErrs:   []*errors.Error{nil, nil, nil},
+			WantSchema: 5,
+		},
+		"only one upgrade per block": {
+			Interval:   1,
+			Heights:    []int64{10, 10, 11},
+			WantErrs:   []*errors.Error{nil, ErrUpgradeTooSoon, nil},
+			WantSchema: 4,
+		},
+		"upgrade within the interval is rejected": {
+			Interval:   5,
+			Heights:    []int64{10, 14, 15, 16},
+			WantErrs:   []*errors.Error{nil, ErrUpgradeTooSoon, nil, ErrUpgradeTooSoon},
+			WantSchema: 4,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			// Initial versions are not created by an upgrade and
+			// are not limited.
+			ensureSchemaVersion(t, db, thisPkgName, 2)
+			err := gconf.Save(db, "migration", &Configuration{
+				Admin:              admin.Address(),
+				MinUpgradeInterval: tc.Interval,
+			})
+			assert.Nil(t, err)
+
+			h := &upgradeSchemaHandler{
+				bucket: NewSchemaBucket(),
+				auth:   &weavetest.Auth{Signer: admin},
+			}
+			for i, height := range tc.Heights {
+				ver, err := NewSchemaBucket().CurrentSchema(db, thisPkgName)
+				assert.Nil(t, err)
+				tx := &weavetest.Tx{
+					Msg: &UpgradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, ToVersion: ver + 1},
+				}
+				ctx := weave.WithHeight(context.Background(), height)
+				ctx = weave.WithBlockTime(ctx, time.Now())
+
+				cache := db.CacheWrap()
+				if _, err := h.Check(ctx, cache, tx); !tc.WantErrs[i].Is(err) {
+					t.Fatalf("unexpected %d check error: %s", i, err)
+				}
+				cache.Discard()
+				if _, err := h.Deliver(ctx, db, tx); !tc.WantErrs[i].Is(err) {
+					t.Fatalf("unexpected %d deliver error: %s", i, err)
+				}
+			}
+
+			ver, err := NewSchemaBucket().CurrentSchema(db, thisPkgName)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.WantSchema, ver)
+		})
+	}
+}
+
+func TestUpgradeSchemaHandlerIntervalAfterDowngrade(t *testing.T) {
+	const thisPkgName = "migration"
+
+	admin := weavetest.NewCondition()
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	err := gconf.Save(db, "migration", &Configuration{
+		Admin:              admin.Address(),
+		AllowDowngrade:     true,
+		MinUpgradeInterval: 5,
+	})
+	assert.Nil(t, err)
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+	reg.MustRegister(3, &MyModel{}, NoModification)
+	reg.MustRegisterDowngrade(thisPkgName, 3, NoModification)
+	bucket := NewSchemaBucket()
+	bucket.migrations = reg
+
+	auth := &weavetest.Auth{Signer: admin}
+	upgrade := &upgradeSchemaHandler{bucket: bucket, auth: auth}
+	downgrade := &downgradeSchemaHandler{bucket: bucket, auth: auth, migrations: reg}
+	upgradeTx := &weavetest.Tx{
+		Msg: &UpgradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, ToVersion: 3},
+	}
+	downgradeTx := &weavetest.Tx{
+		Msg: &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 3},
+	}
+	atHeight := func(h int64) weave.Context {
+		ctx := weave.WithHeight(context.Background(), h)
+		return weave.WithBlockTime(ctx, time.Now())
+	}
+
+	_, err = upgrade.Deliver(atHeight(10), db, upgradeTx)
+	assert.Nil(t, err)
+	_, err = downgrade.Deliver(atHeight(11), db, downgradeTx)
+	assert.Nil(t, err)
+
+	// Downgrade must not reset the interval.
+	if _, err := upgrade.Deliver(atHeight(12), db, upgradeTx); !ErrUpgradeTooSoon.Is(err) {
+		t.Fatalf("want upgrade too soon error, got %+v", err)
+	}
+	_, err = upgrade.Deliver(atHeight(15), db, upgradeTx)
+	assert.Nil(t, err)
+
+	ver, err := NewSchemaBucket().CurrentSchema(db, thisPkgName)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), ver)
+}
+
+func TestUpgradeSchemaHandlerUnknownPackage(t *testing.T) {
+	admin := weavetest.NewCondition()
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegisterAlias("oldmigration", "migration")
+
+	db := store.MemStore()
+	err := gconf.Save(db, "migration", &Configuration{Admin: admin.Address(), MinUpgradeInterval: 1})
+	assert.Nil(t, err)
+
+	bucket := NewSchemaBucket()
+	bucket.migrations = reg
+	h := &upgradeSchemaHandler{bucket: bucket, auth: &weavetest.Auth{Signer: admin}}
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+
+	cases := map[string]struct {
+		Pkg     string
+		WantErr *errors.Error
+	}{
+		"registered package":            {Pkg: "migration", WantErr: nil},
+		"alias of a registered package": {Pkg: "oldmigration", WantErr: nil},
+		"package name with a typo":      {Pkg: "migrations", WantErr: ErrSchemaUnknownPackage},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tx := &weavetest.Tx{
+				Msg: &UpgradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: tc.Pkg, ToVersion: 1},
+			}
+			if _, err := h.Check(ctx, db.CacheWrap(), tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestDowngradeSchemaHandler(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	admin := weavetest.NewCondition()
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt *= 10
+		return nil
+	})
+	reg.MustRegisterDowngrade(thisPkgName, 2, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt /= 10
+		return nil
+	})
+
+	cases := map[string]struct {
+		AllowDowngrade bool
+		Auth           x.Authenticator
+		SchemaVersion  uint32
+		Msg            *DowngradeSchemaMsg
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		WantVersion    uint32
+	}{
+		"downgrade from the current version": {
+			AllowDowngrade: true,
+			Auth:           &weavetest.Auth{Signer: admin},
+			SchemaVersion:  2,
+			Msg:            &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 2},
+			WantVersion:    1,
+		},
+		"downgrade not allowed by the configuration": {
+			AllowDowngrade: false,
+			Auth:           &weavetest.Auth{Signer: admin},
+			SchemaVersion:  2,
+			Msg:            &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 2},
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+			WantVersion:    2,
+		},
+		"admin signature is required": {
+			AllowDowngrade: true,
+			Auth:           &weavetest.Auth{Signer: weavetest.NewCondition()},
+			SchemaVersion:  2,
+			Msg:            &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 2},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+			WantVersion:    2,
+		},
+		"downgrade from a version that is not the current one": {
+			AllowDowngrade: true,
+			Auth:           &weavetest.Auth{Signer: admin},
+			SchemaVersion:  3,
+			Msg:            &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 2},
+			WantCheckErr:   errors.ErrSchema,
+			WantDeliverErr: errors.ErrSchema,
+			WantVersion:    3,
+		},
+		"reverse migration is not registered": {
+			AllowDowngrade: true,
+			Auth:           &weavetest.Auth{Signer: admin},
+			SchemaVersion:  3,
+			Msg:            &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 3},
+			WantCheckErr:   errors.ErrSchema,
+			WantDeliverErr: errors.ErrSchema,
+			WantVersion:    3,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			ensureSchemaVersion(t, db, thisPkgName, tc.SchemaVersion)
+			err := gconf.Save(db, "migration", &Configuration{
+				Admin:              admin.Address(),
+				AllowDowngrade:     tc.AllowDowngrade,
+				MinUpgradeInterval: 1,
+			})
+			assert.Nil(t, err)
+
+			h := &downgradeSchemaHandler{
+				bucket:     NewSchemaBucket(),
+				auth:       tc.Auth,
+				migrations: reg,
+			}
+			tx := &weavetest.Tx{Msg: tc.Msg}
+			cache := db.CacheWrap()
+			if _, err := h.Check(nil, cache, tx); !tc.WantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			cache.Discard()
+			if _, err := h.Deliver(nil, db, tx); !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+
+			ver, err := NewSchemaBucket().CurrentSchema(db, thisPkgName)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.WantVersion, ver)
+		})
+	}
+
+	// Entities stored in the rolled back schema version are downgraded when
+	// read.
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	err := gconf.Save(db, "migration", &Configuration{
+		Admin:              admin.Address(),
+		AllowDowngrade:     true,
+		MinUpgradeInterval: 1,
+	})
+	assert.Nil(t, err)
+
+	b := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	b.useRegister(reg)
+	key, err := b.Put(db, nil, &MyModel{Metadata: &weave.Metadata{Schema: 2}, Cnt: 50})
+	assert.Nil(t, err)
+
+	h := &downgradeSchemaHandler{
+		bucket:     NewSchemaBucket(),
+		auth:       &weavetest.Auth{Signer: admin},
+		migrations: reg,
+	}
+	msg := &DowngradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: thisPkgName, FromVersion: 2}
+	_, err = h.Deliver(nil, db, &weavetest.Tx{Msg: msg})
+	assert.Nil(t, err)
+
+	var m MyModel
+	assert.Nil(t, b.One(db, key, &m))
+	assert.Equal(t, uint32(1), m.Metadata.Schema)
+	assert.Equal(t, 5, m.Cnt)
+}
+
 type MyMsg struct {
 	Metadata *weave.Metadata
 	Content  string
@@ -124,6 +425,147 @@ func useHandlerRegister(t testing.TB, h weave.Handler, r *register) {
 	handler.migrations = r
 }
 
+func TestRenameSchemaHandler(t *testing.T) {
+	admin := weavetest.NewCondition()
+
+	reg := newRegister()
+	reg.MustRegisterAlias("oldpkg", "newpkg")
+	reg.MustRegisterAlias("otherpkg", "otherpkgnew")
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, "oldpkg", 4)
+	assert.Nil(t, gconf.Save(db, "migration", &Configuration{Admin: admin.Address(), MinUpgradeInterval: 1}))
+
+	bucket := NewSchemaBucket()
+	bucket.migrations = reg
+
+	assertVersion := func(pkg string, want uint32) {
+		t.Helper()
+		ver, err := bucket.CurrentSchema(db, pkg)
+		assert.Nil(t, err)
+		assert.Equal(t, want, ver)
+	}
+	assertStored := func(pkg string, want bool) {
+		t.Helper()
+		ok, err := bucket.initialized(db, pkg)
+		assert.Nil(t, err)
+		assert.Equal(t, want, ok)
+	}
+
+	// Until renamed, the new package name is using the schema stored
+	// under the old name.
+	assertVersion("newpkg", 4)
+	assertVersion("oldpkg", 4)
+
+	upgrade := &upgradeSchemaHandler{bucket: bucket, auth: &weavetest.Auth{Signer: admin}}
+	ctx := weave.WithHeight(context.Background(), 10)
+	ctx = weave.WithBlockTime(ctx, time.Now())
+	tx := &weavetest.Tx{Msg: &UpgradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: "newpkg", ToVersion: 5}}
+	_, err := upgrade.Deliver(ctx, db, tx)
+	assert.Nil(t, err)
+	assertVersion("oldpkg", 5)
+	assertStored("newpkg", false)
+
+	// Initializing the new package name must not create a separate schema.
+	_, err = bucket.Create(db, &Schema{Metadata: &weave.Metadata{Schema: 1}, Pkg: "newpkg", Version: 1})
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+	assertStored("newpkg", false)
+
+	versions, err := bucket.CurrentSchemas(db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(5), versions["oldpkg"])
+	assert.Equal(t, uint32(5), versions["newpkg"])
+
+	cases := map[string]struct {
+		Auth    x.Authenticator
+		Msg     *RenameSchemaMsg
+		WantErr *errors.Error
+	}{
+		"admin signature is required": {
+			Auth:    &weavetest.Auth{Signer: weavetest.NewCondition()},
+			Msg:     &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "oldpkg", NewPkg: "newpkg"},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"alias must be registered": {
+			Auth:    &weavetest.Auth{Signer: admin},
+			Msg:     &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "oldpkg", NewPkg: "otherpkgnew"},
+			WantErr: errors.ErrSchema,
+		},
+		"alias direction matters": {
+			Auth:    &weavetest.Auth{Signer: admin},
+			Msg:     &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "newpkg", NewPkg: "oldpkg"},
+			WantErr: errors.ErrSchema,
+		},
+		"schema must be initialized": {
+			Auth:    &weavetest.Auth{Signer: admin},
+			Msg:     &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "otherpkg", NewPkg: "otherpkgnew"},
+			WantErr: errors.ErrNotFound,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			h := &renameSchemaHandler{bucket: bucket, auth: tc.Auth}
+			tx := &weavetest.Tx{Msg: tc.Msg}
+			cache := db.CacheWrap()
+			defer cache.Discard()
+			if _, err := h.Check(nil, cache, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if _, err := h.Deliver(nil, cache, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+		})
+	}
+
+	rename := &renameSchemaHandler{bucket: bucket, auth: &weavetest.Auth{Signer: admin}}
+	tx = &weavetest.Tx{Msg: &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "oldpkg", NewPkg: "newpkg"}}
+	_, err = rename.Deliver(nil, db, tx)
+	assert.Nil(t, err)
+	assertStored("oldpkg", false)
+	assertStored("newpkg", true)
+	assertVersion("newpkg", 5)
+	assertVersion("oldpkg", 5)
+
+	s, err := bucket.schemaVersion(db, "newpkg", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "newpkg", s.Pkg)
+
+	// Upgrade submitted using the old name targets the same schema. The
+	// last upgrade height is moved together with the schema.
+	tx = &weavetest.Tx{Msg: &UpgradeSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, Pkg: "oldpkg", ToVersion: 6}}
+	if _, err := upgrade.Deliver(ctx, db, tx); !ErrUpgradeTooSoon.Is(err) {
+		t.Fatalf("want upgrade too soon error, got %+v", err)
+	}
+	later := weave.WithBlockTime(weave.WithHeight(context.Background(), 11), time.Now())
+	_, err = upgrade.Deliver(later, db, tx)
+	assert.Nil(t, err)
+	assertVersion("newpkg", 6)
+	assertStored("oldpkg", false)
+
+	// Schema can be renamed only once.
+	tx = &weavetest.Tx{Msg: &RenameSchemaMsg{Metadata: &weave.Metadata{Schema: 1}, OldPkg: "oldpkg", NewPkg: "newpkg"}}
+	if _, err := rename.Check(nil, db, tx); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	q := &schemaAliasQuery{schema: bucket}
+	models, err := q.Query(db, weave.KeyQueryMod, []byte("oldpkg"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	var alias SchemaAlias
+	assert.Nil(t, alias.Unmarshal(models[0].Value))
+	assert.Equal(t, SchemaAlias{OldPkg: "oldpkg", NewPkg: "newpkg", StoredPkg: "newpkg"}, alias)
+
+	models, err = q.Query(db, weave.KeyQueryMod, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(models))
+	var other SchemaAlias
+	assert.Nil(t, other.Unmarshal(models[1].Value))
+	assert.Equal(t, SchemaAlias{OldPkg: "otherpkg", NewPkg: "otherpkgnew"}, other)
+}
+
 func TestSchemaRoutingHandlerCannotBeEmpty(t *testing.T) {
 	assert.Panics(t, func() {
 		SchemaRoutingHandler(nil)
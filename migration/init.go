@@ -12,6 +12,48 @@ type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
 
+// genesisSchema is the genesis file representation of a package schema
+// version that is initialized.
+type genesisSchema struct {
+	Ver uint32 `json:"ver"`
+	Pkg string `json:"pkg"`
+}
+
+// ValidateGenesisSchemas returns an error if any of given schemas declares a
+// package that this binary does not know or a schema version higher than the
+// binary supports. See SupportedSchemas and MustRegisterPackage.
+// A package that is not known, neither by its name nor by a registered alias,
+// is rejected with ErrSchemaUnknownPackage.
+// Only the package name and the version of each schema are validated.
+//
+// Initializer validates the initialize_schema genesis declaration using this
+// function. Use it to validate a genesis file before the chain is started.
+func ValidateGenesisSchemas(schemas []Schema) error {
+	return validateGenesisSchemas(reg, schemas)
+}
+
+func validateGenesisSchemas(r *register, schemas []Schema) error {
+	supported := r.Supported()
+	var errs error
+	for i, s := range schemas {
+		max := supported[s.Pkg]
+		if other, ok := r.Aliased(s.Pkg); ok && supported[other] > max {
+			max = supported[other]
+		}
+		switch {
+		case s.Pkg == "":
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrInput, "schema %d: pkg is required", i))
+		case s.Version < 1:
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrInput, "schema %d: %q version must be greater than zero", i, s.Pkg))
+		case !r.Known(s.Pkg):
+			errs = errors.Append(errs, errors.Wrapf(ErrSchemaUnknownPackage, "schema %d: %q", i, s.Pkg))
+		case s.Version > max:
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrSchema, "schema %d: %q version %d not supported, highest supported version is %d", i, s.Pkg, s.Version, max))
+		}
+	}
+	return errs
+}
+
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
 func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
@@ -19,13 +61,17 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		return errors.Wrap(err, "migration config")
 	}
 
-	var packages []struct {
-		Ver uint32 `json:"ver"`
-		Pkg string `json:"pkg"`
-	}
+	var packages []genesisSchema
 	if err := opts.ReadOptions("initialize_schema", &packages); err != nil {
 		return errors.Wrap(err, "initialize schema")
 	}
+	schemas := make([]Schema, 0, len(packages))
+	for _, p := range packages {
+		schemas = append(schemas, Schema{Pkg: p.Pkg, Version: p.Ver})
+	}
+	if err := validateGenesisSchemas(reg, schemas); err != nil {
+		return errors.Wrap(err, "initialize schema")
+	}
 
 	b := NewSchemaBucket()
 
@@ -5,10 +5,19 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestGenesisInitializeSchemaVersions(t *testing.T) {
+	defer func(r *register) { reg = r }(reg)
+	reg = newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+	reg.MustRegisterPackage("nomigrations")
+	reg.MustRegisterAlias("oldnomigrations", "nomigrations")
+
 	const genesis = `
 	{
 		"conf": {
@@ -17,9 +26,8 @@ func TestGenesisInitializeSchemaVersions(t *testing.T) {
 			}
 		},
 		"initialize_schema": [
-			{"pkg": "two",    "ver": 2},
-			{"pkg": "seven",  "ver": 7},
-			{"pkg": "eleven", "ver": 11}
+			{"pkg": "migration",       "ver": 2},
+			{"pkg": "oldnomigrations", "ver": 1}
 		]
 	}
 	`
@@ -36,13 +44,8 @@ func TestGenesisInitializeSchemaVersions(t *testing.T) {
 	}
 
 	wantSchemaVersions := map[string]uint32{
-		"two":    2,
-		"seven":  7,
-		"eleven": 11,
-
-		// Running the initializer must always ensure the migration
-		// package schema version is at least one.
-		"migration": 1,
+		"migration":       2,
+		"oldnomigrations": 1,
 	}
 	for pkgName, wantVer := range wantSchemaVersions {
 		ver, err := NewSchemaBucket().CurrentSchema(db, pkgName)
@@ -54,3 +57,116 @@ func TestGenesisInitializeSchemaVersions(t *testing.T) {
 		}
 	}
 }
+
+func TestGenesisRejectsUnsupportedSchemas(t *testing.T) {
+	defer func(r *register) { reg = r }(reg)
+	reg = newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegisterPackage("nomigrations")
+
+	cases := map[string]struct {
+		schemas string
+		wantErr *errors.Error
+	}{
+		"unknown package": {
+			schemas: `[{"pkg": "unknown", "ver": 1}]`,
+			wantErr: ErrSchemaUnknownPackage,
+		},
+		"version higher than supported": {
+			schemas: `[{"pkg": "migration", "ver": 2}]`,
+			wantErr: errors.ErrSchema,
+		},
+		"package without migrations supports only the first version": {
+			schemas: `[{"pkg": "nomigrations", "ver": 2}]`,
+			wantErr: errors.ErrSchema,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			genesis := `{
+				"conf": {
+					"migration": {
+						"admin": "6a4832947079b0a851ec4daa3dae69de1f7741eb"
+					}
+				},
+				"initialize_schema": ` + tc.schemas + `
+			}`
+			var opts weave.Options
+			if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+				t.Fatalf("cannot unmarshal genesis: %s", err)
+			}
+			var ini Initializer
+			if err := ini.FromGenesis(opts, weave.GenesisParams{}, store.MemStore()); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisSchemas(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+	reg.MustRegisterAlias("oldmigration", "migration")
+	reg.MustRegisterPackage("nomigrations")
+
+	cases := map[string]struct {
+		schemas []Schema
+		wantErr *errors.Error
+	}{
+		"no schemas": {
+			schemas: nil,
+		},
+		"supported versions": {
+			schemas: []Schema{
+				{Pkg: "migration", Version: 1},
+				{Pkg: "migration", Version: 2},
+			},
+		},
+		"version higher than supported": {
+			schemas: []Schema{
+				{Pkg: "migration", Version: 1},
+				{Pkg: "migration", Version: 3},
+			},
+			wantErr: errors.ErrSchema,
+		},
+		"unknown package": {
+			schemas: []Schema{{Pkg: "unknown", Version: 1}},
+			wantErr: ErrSchemaUnknownPackage,
+		},
+		"package alias": {
+			schemas: []Schema{{Pkg: "oldmigration", Version: 2}},
+		},
+		"package without migrations": {
+			schemas: []Schema{{Pkg: "nomigrations", Version: 1}},
+		},
+		"zero version": {
+			schemas: []Schema{{Pkg: "migration", Version: 0}},
+			wantErr: errors.ErrInput,
+		},
+		"missing package": {
+			schemas: []Schema{{Version: 1}},
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := validateGenesisSchemas(reg, tc.schemas); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{"pkg": "migration", "ver": 1},
+		{"pkg": "cash", "ver": 2}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisSchema{})
+
+	const conf = `{"admin": "seq:test/admin/1", "min_upgrade_interval": 100}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
+}
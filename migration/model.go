@@ -12,6 +12,7 @@ var _ orm.CloneableData = (*Schema)(nil)
 
 func init() {
 	MustRegister(1, &Schema{}, NoModification)
+	MustRegister(1, &LastUpgrade{}, NoModification)
 }
 
 func (s *Schema) Validate() error {
@@ -29,9 +30,34 @@ func (s *Schema) Validate() error {
 
 func (s *Schema) Copy() orm.CloneableData {
 	return &Schema{
-		Metadata: s.Metadata.Copy(),
-		Version:  s.Version,
-		Pkg:      s.Pkg,
+		Metadata:       s.Metadata.Copy(),
+		Version:        s.Version,
+		Pkg:            s.Pkg,
+		UpgradedAt:     s.UpgradedAt,
+		UpgradedHeight: s.UpgradedHeight,
+	}
+}
+
+var _ orm.CloneableData = (*LastUpgrade)(nil)
+
+func (u *LastUpgrade) Validate() error {
+	if err := u.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if u.Pkg == "" {
+		return errors.Wrap(errors.ErrModel, "pkg is required")
+	}
+	if u.Height <= 0 {
+		return errors.Wrap(errors.ErrModel, "height must be greater than zero")
+	}
+	return nil
+}
+
+func (u *LastUpgrade) Copy() orm.CloneableData {
+	return &LastUpgrade{
+		Metadata: u.Metadata.Copy(),
+		Pkg:      u.Pkg,
+		Height:   u.Height,
 	}
 }
 
@@ -47,6 +73,11 @@ func schemaID(pkg string, version uint32) []byte {
 
 type SchemaBucket struct {
 	orm.Bucket
+	// lastUpgrades keeps the height of the most recent upgrade of each
+	// package, using the stored package name as the key.
+	lastUpgrades orm.Bucket
+	// migrations is used to resolve package aliases.
+	migrations *register
 }
 
 func NewSchemaBucket() *SchemaBucket {
@@ -55,7 +86,8 @@ func NewSchemaBucket() *SchemaBucket {
 	// cannot use migration implementation bucket because it would cause
 	// circular dependency on itself.
 	b := orm.NewBucket("schema", &Schema{})
-	return &SchemaBucket{Bucket: b}
+	u := orm.NewBucket("lastupgr", &LastUpgrade{})
+	return &SchemaBucket{Bucket: b, lastUpgrades: u, migrations: reg}
 }
 
 // MustInitPkg initialize schema versioning for given package names. This
@@ -76,10 +108,197 @@ func MustInitPkg(db weave.KVStore, packageNames ...string) {
 	}
 }
 
+// EnsureSchemaAtLeast upgrades the schema of given package one version at a
+// time, until it reaches given version. This is a no-op if the current schema
+// version is already at or above given version. Not initialized package
+// schema is initialized with version 1.
+//
+// Given address must be the admin of the migration extension, the same as
+// for the UpgradeSchemaMsg. Each upgrade is applied the same way as by the
+// UpgradeSchemaMsg handler: the package must register migrations for the
+// version it is upgraded to and the minimal upgrade interval must have
+// passed since the previous upgrade of that package. The interval is checked
+// once per call, so that a single call can catch up by many versions. If the
+// interval did not pass yet, ErrUpgradeTooSoon is returned and no upgrade is
+// applied.
+func EnsureSchemaAtLeast(ctx weave.Context, db weave.KVStore, pkg string, version uint32, admin weave.Address) error {
+	return NewSchemaBucket().ensureAtLeast(ctx, db, pkg, version, admin)
+}
+
+func (b *SchemaBucket) ensureAtLeast(ctx weave.Context, db weave.KVStore, pkg string, version uint32, admin weave.Address) error {
+	if pkg == "" {
+		return errors.Wrap(errors.ErrInput, "package name is required")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+	if !conf.Admin.Equals(admin) {
+		return errors.Wrap(errors.ErrUnauthorized, "admin required")
+	}
+
+	current, err := b.CurrentSchema(db, pkg)
+	switch {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		current = 0
+	default:
+		return errors.Wrap(err, "current schema version")
+	}
+	if current >= version {
+		return nil
+	}
+
+	supported := b.migrations.Supported()
+	max := supported[pkg]
+	if other, ok := b.migrations.Aliased(pkg); ok && supported[other] > max {
+		max = supported[other]
+	}
+	if version > max {
+		return errors.Wrapf(errors.ErrSchema, "no migration registered for %s package version %d", pkg, version)
+	}
+
+	for v := current + 1; v <= version; v++ {
+		// All upgrades of a single call are applied at once and the
+		// interval applies to the first of them only.
+		checkInterval := v == current+1
+		if _, err := b.upgrade(ctx, db, conf, pkg, v, checkInterval); err != nil {
+			return errors.Wrapf(err, "upgrade to version %d", v)
+		}
+	}
+	return nil
+}
+
+// upgrade creates the next schema version of given package. All schema
+// upgrades must be done using this method so that the same rules apply to
+// each of them. Authorization is the responsibility of the caller. The
+// minimal upgrade interval is ensured only if checkInterval is true.
+func (b *SchemaBucket) upgrade(ctx weave.Context, db weave.KVStore, conf *Configuration, pkg string, toVersion uint32, checkInterval bool) (orm.Object, error) {
+	if err := b.validateUpgrade(ctx, db, conf, pkg, toVersion, checkInterval); err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "block height not present in the context")
+	}
+
+	schema := Schema{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Pkg:            pkg,
+		Version:        toVersion,
+		UpgradedAt:     weave.AsUnixTime(now),
+		UpgradedHeight: height,
+	}
+	obj, err := b.Create(db, &schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "create schema version")
+	}
+
+	// Initialization is not an upgrade and it is not limited.
+	if toVersion == 1 {
+		return obj, nil
+	}
+	if err := b.recordUpgrade(db, schema.Pkg, height); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// recordUpgrade stores the height of the most recent schema upgrade of given
+// package. Package name must be the name that the schema is stored under.
+func (b *SchemaBucket) recordUpgrade(db weave.KVStore, storedPkg string, height int64) error {
+	last := orm.NewSimpleObj([]byte(storedPkg), &LastUpgrade{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      storedPkg,
+		Height:   height,
+	})
+	if err := b.lastUpgrades.Save(db, last); err != nil {
+		return errors.Wrap(err, "save last upgrade")
+	}
+	return nil
+}
+
+// validateUpgrade returns an error if the schema of given package cannot be
+// upgraded to given version. The minimal upgrade interval is ensured only if
+// checkInterval is true.
+func (b *SchemaBucket) validateUpgrade(ctx weave.Context, db weave.ReadOnlyKVStore, conf *Configuration, pkg string, toVersion uint32, checkInterval bool) error {
+	// A schema of a package that is not known would never be used.
+	if !b.migrations.Known(pkg) {
+		return errors.Wrapf(ErrSchemaUnknownPackage, "%q", pkg)
+	}
+
+	switch ver, err := b.CurrentSchema(db, pkg); {
+	case err == nil:
+		if ver+1 != toVersion {
+			return errors.Wrapf(errors.ErrSchema, "the current schema version is %d", ver)
+		}
+		if !checkInterval {
+			return nil
+		}
+		return b.ensureUpgradeInterval(ctx, db, conf, pkg)
+	case errors.ErrNotFound.Is(err):
+		if toVersion != 1 {
+			return errors.Wrap(errors.ErrSchema, "schema must be initialized with version 1")
+		}
+		return nil
+	default:
+		return errors.Wrap(err, "current schema version")
+	}
+}
+
+// ensureUpgradeInterval returns ErrUpgradeTooSoon if the previous schema
+// upgrade of given package happened less than the configured minimal number
+// of blocks ago. The height of the previous upgrade is tracked independently
+// of the schema versions, so downgrading the schema does not reset it. Zero
+// interval does not limit upgrades.
+func (b *SchemaBucket) ensureUpgradeInterval(ctx weave.Context, db weave.ReadOnlyKVStore, conf *Configuration, pkg string) error {
+	if conf.MinUpgradeInterval == 0 {
+		return nil
+	}
+	stored, err := b.storedPkg(db, pkg)
+	if err != nil {
+		return err
+	}
+	obj, err := b.lastUpgrades.Get(db, []byte(stored))
+	if err != nil {
+		return errors.Wrap(err, "last upgrade")
+	}
+	if obj == nil {
+		// Never upgraded.
+		return nil
+	}
+	last, ok := obj.Value().(*LastUpgrade)
+	if !ok {
+		return errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return errors.Wrap(errors.ErrHuman, "block height not present in the context")
+	}
+	if next := last.Height + conf.MinUpgradeInterval; height < next {
+		return errors.Wrapf(ErrUpgradeTooSoon, "%s schema can be upgraded at height %d", pkg, next)
+	}
+	return nil
+}
+
 // CurrentSchema returns the current version of the schema for a given package.
 // It returns ErrNotFound if no schema version was registered for this package.
 // Minimum schema version is 1.
 func (b *SchemaBucket) CurrentSchema(db weave.ReadOnlyKVStore, packageName string) (uint32, error) {
+	stored, err := b.storedPkg(db, packageName)
+	if err != nil {
+		return 0, err
+	}
+	return b.currentVersion(db, stored)
+}
+
+// currentVersion returns the current schema version stored under given
+// package name. Package aliases are not resolved.
+func (b *SchemaBucket) currentVersion(db weave.ReadOnlyKVStore, packageName string) (uint32, error) {
 	for ver := uint32(1); ver < 10000; ver++ {
 		key := schemaID(packageName, ver)
 		obj, err := b.Bucket.Get(db, key)
@@ -97,6 +316,56 @@ func (b *SchemaBucket) CurrentSchema(db weave.ReadOnlyKVStore, packageName strin
 	return 0, errors.Wrap(errors.ErrState, "version too high")
 }
 
+// CurrentSchemas returns the current schema version of every initialized
+// package, indexed by the package name.
+func (b *SchemaBucket) CurrentSchemas(db weave.ReadOnlyKVStore) (map[string]uint32, error) {
+	versions := make(map[string]uint32)
+	it := orm.IterAll("schema")
+	for {
+		var s Schema
+		switch _, err := it.Next(db, &s); {
+		case err == nil:
+			if s.Version > versions[s.Pkg] {
+				versions[s.Pkg] = s.Version
+			}
+		case errors.ErrIteratorDone.Is(err):
+			// Both names of an aliased package share the same
+			// schema version.
+			for _, a := range b.migrations.Aliases() {
+				if v, ok := versions[a.OldPkg]; ok {
+					versions[a.NewPkg] = v
+				} else if v, ok := versions[a.NewPkg]; ok {
+					versions[a.OldPkg] = v
+				}
+			}
+			return versions, nil
+		default:
+			return nil, errors.Wrap(err, "iterator")
+		}
+	}
+}
+
+// schemaVersion returns the schema entity declared for given package and
+// version.
+func (b *SchemaBucket) schemaVersion(db weave.ReadOnlyKVStore, packageName string, version uint32) (*Schema, error) {
+	stored, err := b.storedPkg(db, packageName)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := b.Bucket.Get(db, schemaID(stored, version))
+	if err != nil {
+		return nil, errors.Wrap(err, "bucket get")
+	}
+	if obj == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "%s schema version %d", packageName, version)
+	}
+	s, ok := obj.Value().(*Schema)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	return s, nil
+}
+
 func (b *SchemaBucket) Get(db weave.KVStore, key []byte) error {
 	// Prevent direct access to the bucket content. Use CurrentSchema method instead.
 	return errors.Wrap(errors.ErrHuman, "this bucket does not allow for a direct value access")
@@ -115,8 +384,15 @@ func (b *SchemaBucket) Save(db weave.KVStore, obj orm.Object) error {
 }
 
 // Create adds given schema instance to the store and returns the ID of the
-// newly inserted entity.
+// newly inserted entity. Schema of an aliased package is stored under the
+// package name that the schema is already stored under and the package name
+// of given schema instance is updated accordingly.
 func (b *SchemaBucket) Create(db weave.KVStore, s *Schema) (orm.Object, error) {
+	stored, err := b.storedPkg(db, s.Pkg)
+	if err != nil {
+		return nil, err
+	}
+	s.Pkg = stored
 	if err := b.validateNextSchema(db, s); err != nil {
 		return nil, err
 	}
@@ -124,10 +400,117 @@ func (b *SchemaBucket) Create(db weave.KVStore, s *Schema) (orm.Object, error) {
 	return obj, b.Bucket.Save(db, obj)
 }
 
+// deleteCurrent removes the current schema version declaration of a package,
+// rolling back the package schema to the previous version. Given version must
+// be the current one and it must not be the initial version.
+func (b *SchemaBucket) deleteCurrent(db weave.KVStore, packageName string, version uint32) error {
+	ver, err := b.CurrentSchema(db, packageName)
+	if err != nil {
+		return errors.Wrap(err, "current schema")
+	}
+	if ver != version {
+		return errors.Wrapf(errors.ErrSchema, "the current schema version is %d", ver)
+	}
+	if ver < 2 {
+		return errors.Wrap(errors.ErrSchema, "initial schema version cannot be removed")
+	}
+	stored, err := b.storedPkg(db, packageName)
+	if err != nil {
+		return err
+	}
+	return b.Bucket.Delete(db, schemaID(stored, ver))
+}
+
+// storedPkg returns the package name that the schema of given package is
+// stored under. This is the given name, unless the package is part of a
+// registered alias. The old package name is used as long as any schema
+// version is stored under it.
+func (b *SchemaBucket) storedPkg(db weave.ReadOnlyKVStore, packageName string) (string, error) {
+	other, ok := b.migrations.Aliased(packageName)
+	if !ok {
+		return packageName, nil
+	}
+	oldPkg, newPkg := packageName, other
+	if _, isNew := b.migrations.aliases[packageName]; isNew {
+		oldPkg, newPkg = other, packageName
+	}
+	for _, name := range []string{oldPkg, newPkg} {
+		switch ok, err := b.initialized(db, name); {
+		case err != nil:
+			return "", err
+		case ok:
+			return name, nil
+		}
+	}
+	return packageName, nil
+}
+
+// initialized returns true if any schema version is stored under given
+// package name. Package aliases are not resolved.
+func (b *SchemaBucket) initialized(db weave.ReadOnlyKVStore, packageName string) (bool, error) {
+	obj, err := b.Bucket.Get(db, schemaID(packageName, 1))
+	if err != nil {
+		return false, errors.Wrap(err, "bucket get")
+	}
+	return obj != nil, nil
+}
+
+// rename moves all schema versions stored under the old package name to the
+// new package name. An alias between both package names must be registered.
+func (b *SchemaBucket) rename(db weave.KVStore, oldPkg, newPkg string) error {
+	if b.migrations.aliases[newPkg] != oldPkg {
+		return errors.Wrapf(errors.ErrSchema, "%q is not registered as an alias of %q", newPkg, oldPkg)
+	}
+	if ok, err := b.initialized(db, newPkg); err != nil {
+		return err
+	} else if ok {
+		return errors.Wrapf(errors.ErrDuplicate, "schema of %q already exists", newPkg)
+	}
+	ver, err := b.currentVersion(db, oldPkg)
+	if err != nil {
+		return errors.Wrapf(err, "current schema of %q", oldPkg)
+	}
+	for v := uint32(1); v <= ver; v++ {
+		obj, err := b.Bucket.Get(db, schemaID(oldPkg, v))
+		if err != nil {
+			return errors.Wrap(err, "bucket get")
+		}
+		s, ok := obj.Value().(*Schema)
+		if !ok {
+			return errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+		}
+		if err := b.Bucket.Delete(db, obj.Key()); err != nil {
+			return errors.Wrapf(err, "delete %d version", v)
+		}
+		s.Pkg = newPkg
+		if err := b.Bucket.Save(db, orm.NewSimpleObj(schemaID(newPkg, v), s)); err != nil {
+			return errors.Wrapf(err, "save %d version", v)
+		}
+	}
+
+	switch obj, err := b.lastUpgrades.Get(db, []byte(oldPkg)); {
+	case err != nil:
+		return errors.Wrap(err, "last upgrade")
+	case obj != nil:
+		last, ok := obj.Value().(*LastUpgrade)
+		if !ok {
+			return errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+		}
+		if err := b.lastUpgrades.Delete(db, obj.Key()); err != nil {
+			return errors.Wrap(err, "delete last upgrade")
+		}
+		last.Pkg = newPkg
+		if err := b.lastUpgrades.Save(db, orm.NewSimpleObj([]byte(newPkg), last)); err != nil {
+			return errors.Wrap(err, "save last upgrade")
+		}
+	}
+	return nil
+}
+
 // validateNextSchema returns an error if given Schema instance is does not
 // represent the next valid schema version.
 func (b *SchemaBucket) validateNextSchema(db weave.KVStore, next *Schema) error {
-	ver, err := b.CurrentSchema(db, next.Pkg)
+	ver, err := b.currentVersion(db, next.Pkg)
 	if err != nil {
 		if errors.ErrNotFound.Is(err) {
 			ver = 0
@@ -145,7 +528,45 @@ func (b *SchemaBucket) validateNextSchema(db weave.KVStore, next *Schema) error
 	return nil
 }
 
-// RegisterQuery registers schema bucket for querying.
+// RegisterQuery registers schema bucket and package aliases for querying.
 func RegisterQuery(qr weave.QueryRouter) {
-	NewSchemaBucket().Register("schemas", qr)
+	b := NewSchemaBucket()
+	b.Register("schemas", qr)
+	qr.Register("/schemaaliases", &schemaAliasQuery{schema: b})
+}
+
+// schemaAliasQuery returns registered package aliases. Each result key is the
+// new package name and the value is a serialized SchemaAlias. If a package
+// name is given, only the alias that the package is part of is returned.
+type schemaAliasQuery struct {
+	schema *SchemaBucket
+}
+
+var _ weave.QueryHandler = (*schemaAliasQuery)(nil)
+
+func (q *schemaAliasQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	var res []weave.Model
+	for _, a := range q.schema.migrations.Aliases() {
+		if len(data) != 0 && string(data) != a.OldPkg && string(data) != a.NewPkg {
+			continue
+		}
+		stored, err := q.schema.storedPkg(db, a.NewPkg)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := q.schema.initialized(db, stored); err != nil {
+			return nil, err
+		} else if ok {
+			a.StoredPkg = stored
+		}
+		raw, err := a.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal")
+		}
+		res = append(res, weave.Pair([]byte(a.NewPkg), raw))
+	}
+	return res, nil
 }
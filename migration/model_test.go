@@ -1,11 +1,16 @@
 package migration
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestMustInitPkgDuplication(t *testing.T) {
@@ -76,3 +81,151 @@ func ensureSchemaVersion(t testing.TB, db weave.KVStore, pkgName string, version
 		}
 	}
 }
+
+func TestEnsureSchemaAtLeast(t *testing.T) {
+	admin := weavetest.NewCondition().Address()
+
+	migrations := newRegister()
+	migrations.MustRegister(1, &Schema{}, NoModification)
+	migrations.MustRegister(2, &Schema{}, NoModification)
+	migrations.MustRegister(3, &Schema{}, NoModification)
+
+	cases := map[string]struct {
+		pkg     string
+		init    uint32
+		ensure  uint32
+		admin   weave.Address
+		wantErr *errors.Error
+		wantVer uint32
+	}{
+		"upgrade by one version": {
+			init:    1,
+			ensure:  2,
+			admin:   admin,
+			wantVer: 2,
+		},
+		"upgrade by many versions": {
+			init:    1,
+			ensure:  3,
+			admin:   admin,
+			wantVer: 3,
+		},
+		"not initialized schema": {
+			ensure:  2,
+			admin:   admin,
+			wantVer: 2,
+		},
+		"unknown package": {
+			pkg:     "notmigration",
+			init:    1,
+			ensure:  2,
+			admin:   admin,
+			wantErr: errors.ErrSchema,
+			wantVer: 1,
+		},
+		"already at the version": {
+			init:    2,
+			ensure:  2,
+			admin:   admin,
+			wantVer: 2,
+		},
+		"above the version": {
+			init:    3,
+			ensure:  1,
+			admin:   admin,
+			wantVer: 3,
+		},
+		"no migration registered": {
+			init:    1,
+			ensure:  4,
+			admin:   admin,
+			wantErr: errors.ErrSchema,
+			wantVer: 1,
+		},
+		"admin required": {
+			init:    1,
+			ensure:  2,
+			admin:   weavetest.NewCondition().Address(),
+			wantErr: errors.ErrUnauthorized,
+			wantVer: 1,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			conf := Configuration{
+				Admin:              admin,
+				MinUpgradeInterval: 1,
+			}
+			if err := gconf.Save(db, "migration", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			pkg := "migration"
+			if tc.pkg != "" {
+				pkg = tc.pkg
+			}
+			b := NewSchemaBucket()
+			b.migrations = migrations
+			for v := uint32(1); v <= tc.init; v++ {
+				if _, err := b.Create(db, &Schema{Metadata: &weave.Metadata{Schema: 1}, Pkg: pkg, Version: v}); err != nil {
+					t.Fatalf("cannot create %d schema: %s", v, err)
+				}
+			}
+
+			ctx := weave.WithHeight(context.Background(), 10)
+			ctx = weave.WithBlockTime(ctx, time.Unix(1e9, 0))
+			if err := b.ensureAtLeast(ctx, db, pkg, tc.ensure, tc.admin); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			// Calling it again must be a no-op.
+			if tc.wantErr == nil {
+				if err := b.ensureAtLeast(ctx, db, pkg, tc.ensure, tc.admin); err != nil {
+					t.Fatalf("second call: %+v", err)
+				}
+			}
+
+			ver, err := b.CurrentSchema(db, pkg)
+			if err != nil {
+				t.Fatalf("cannot get schema version: %s", err)
+			}
+			if ver != tc.wantVer {
+				t.Fatalf("want %d version, got %d", tc.wantVer, ver)
+			}
+		})
+	}
+}
+
+func TestEnsureSchemaAtLeastRecordsUpgrade(t *testing.T) {
+	admin := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	conf := Configuration{Admin: admin, MinUpgradeInterval: 5}
+	assert.Nil(t, gconf.Save(db, "migration", &conf))
+	ensureSchemaVersion(t, db, "migration", 1)
+
+	b := NewSchemaBucket()
+	b.migrations = newRegister()
+	b.migrations.MustRegister(1, &Schema{}, NoModification)
+	b.migrations.MustRegister(2, &Schema{}, NoModification)
+	b.migrations.MustRegister(3, &Schema{}, NoModification)
+
+	now := time.Unix(1e9, 0)
+	ctx := weave.WithHeight(context.Background(), 10)
+	ctx = weave.WithBlockTime(ctx, now)
+	assert.Nil(t, b.ensureAtLeast(ctx, db, "migration", 2, admin))
+
+	s, err := b.schemaVersion(db, "migration", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, weave.AsUnixTime(now), s.UpgradedAt)
+	assert.Equal(t, int64(10), s.UpgradedHeight)
+
+	// The interval applies to upgrades done by the helper as well.
+	ctx = weave.WithHeight(context.Background(), 14)
+	ctx = weave.WithBlockTime(ctx, now)
+	if err := b.ensureAtLeast(ctx, db, "migration", 3, admin); !ErrUpgradeTooSoon.Is(err) {
+		t.Fatalf("want upgrade too soon error, got %+v", err)
+	}
+	ver, err := b.CurrentSchema(db, "migration")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), ver)
+}
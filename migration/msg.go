@@ -7,6 +7,8 @@ import (
 
 func init() {
 	MustRegister(1, &UpgradeSchemaMsg{}, NoModification)
+	MustRegister(1, &DowngradeSchemaMsg{}, NoModification)
+	MustRegister(1, &RenameSchemaMsg{}, NoModification)
 }
 
 var _ weave.Msg = (*UpgradeSchemaMsg)(nil)
@@ -24,3 +26,38 @@ func (msg *UpgradeSchemaMsg) Validate() error {
 func (UpgradeSchemaMsg) Path() string {
 	return "migration/upgrade_schema"
 }
+
+var _ weave.Msg = (*DowngradeSchemaMsg)(nil)
+
+func (msg *DowngradeSchemaMsg) Validate() error {
+	if msg.Pkg == "" {
+		return errors.Wrap(errors.ErrEmpty, "pkg is required")
+	}
+	if msg.FromVersion < 2 {
+		return errors.Wrap(errors.ErrInput, "from version must be greater than 1")
+	}
+	return nil
+}
+
+func (DowngradeSchemaMsg) Path() string {
+	return "migration/downgrade_schema"
+}
+
+var _ weave.Msg = (*RenameSchemaMsg)(nil)
+
+func (msg *RenameSchemaMsg) Validate() error {
+	if msg.OldPkg == "" {
+		return errors.Wrap(errors.ErrEmpty, "old pkg is required")
+	}
+	if msg.NewPkg == "" {
+		return errors.Wrap(errors.ErrEmpty, "new pkg is required")
+	}
+	if msg.OldPkg == msg.NewPkg {
+		return errors.Wrap(errors.ErrInput, "old and new pkg must differ")
+	}
+	return nil
+}
+
+func (RenameSchemaMsg) Path() string {
+	return "migration/rename_schema"
+}
@@ -0,0 +1,53 @@
+package migration
+
+import "sync/atomic"
+
+// MigrationObserver is a function notified each time a model of given package
+// is migrated on read, from the schema version it was stored in to the
+// current schema version of the package. A downgraded model is reported with
+// from version greater than to version.
+type MigrationObserver func(pkg string, from, to uint32)
+
+var observer atomic.Value
+
+// SetMigrationObserver registers a function that is notified each time a model
+// is migrated when read from a migrating bucket. This allows to track the
+// volume of lazy migrations and tell when all data of a package is stored in
+// the current schema version.
+//
+// Observer must not modify the state and must not block. It is called for
+// every migrated model, including those read by the queries and during
+// CheckTx, therefore it must not be used to drive any consensus logic.
+// Setting nil removes the observer.
+func SetMigrationObserver(fn MigrationObserver) {
+	observer.Store(fn)
+}
+
+func notifyMigrated(pkg string, from, to uint32) {
+	if fn, ok := observer.Load().(MigrationObserver); ok && fn != nil {
+		fn(pkg, from, to)
+	}
+}
+
+// DeprecationObserver is a function notified each time a model of given
+// package, stored in a deprecated schema version, is read.
+type DeprecationObserver func(pkg string, version uint32)
+
+var deprecationObserver atomic.Value
+
+// SetDeprecationObserver registers a function that is notified each time a
+// model stored in a schema version registered as deprecated is read from a
+// migrating bucket. See RegisterDeprecated.
+//
+// The same rules as for the migration observer apply. Observer must not
+// modify the state, must not block and must not be used to drive any
+// consensus logic. Setting nil removes the observer.
+func SetDeprecationObserver(fn DeprecationObserver) {
+	deprecationObserver.Store(fn)
+}
+
+func notifyDeprecated(pkg string, version uint32) {
+	if fn, ok := deprecationObserver.Load().(DeprecationObserver); ok && fn != nil {
+		fn(pkg, version)
+	}
+}
@@ -21,6 +21,8 @@ type Bucket struct {
 	packageName string
 	schema      *SchemaBucket
 	migrations  *register
+	// indexes are the names of all indexes declared using this bucket.
+	indexes []string
 }
 
 var _ orm.Bucket = (*Bucket)(nil)
@@ -30,12 +32,14 @@ var _ orm.Bucket = (*Bucket)(nil)
 // for the stored entity. Model is the type of the entity this bucket is
 // maintaining.
 func NewBucket(packageName string, bucketName string, model orm.Model) Bucket {
-	return Bucket{
+	b := Bucket{
 		Bucket:      orm.NewBucket(bucketName, model),
 		packageName: packageName,
 		schema:      NewSchemaBucket(),
 		migrations:  reg,
 	}
+	buckets.Track(b.DBKey(nil), b)
+	return b
 }
 
 // useRegister will update this bucket to use a custom register instance
@@ -58,26 +62,52 @@ func (svb Bucket) Get(db weave.ReadOnlyKVStore, key []byte) (orm.Object, error)
 }
 
 func (svb Bucket) Save(db weave.KVStore, obj orm.Object) error {
-	if err := svb.migrate(db, obj); err != nil {
+	if err := migrate(svb.migrations, svb.schema, svb.packageName, db, obj.Value()); err != nil {
 		return errors.Wrap(err, "migrate")
 	}
 	return svb.Bucket.Save(db, obj)
 }
 
 func (svb Bucket) migrate(db weave.ReadOnlyKVStore, obj orm.Object) error {
-	return migrate(svb.migrations, svb.schema, svb.packageName, db, obj.Value())
+	return migrateOnRead(svb.migrations, svb.schema, svb.packageName, db, obj.Value())
 }
 
 func (svb Bucket) WithIndex(name string, indexer orm.Indexer, unique bool) orm.Bucket {
 	svb.Bucket = svb.Bucket.WithIndex(name, indexer, unique)
+	svb.indexes = withIndexName(svb.indexes, name)
+	buckets.Track(svb.DBKey(nil), svb)
 	return svb
 }
 
 func (svb Bucket) WithMultiKeyIndex(name string, indexer orm.MultiKeyIndexer, unique bool) orm.Bucket {
 	svb.Bucket = svb.Bucket.WithMultiKeyIndex(name, indexer, unique)
+	svb.indexes = withIndexName(svb.indexes, name)
+	buckets.Track(svb.DBKey(nil), svb)
+	return svb
+}
+
+func (svb Bucket) WithLazyIndex(name string, indexer orm.MultiKeyIndexer, unique bool) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithLazyIndex(name, indexer, unique)
+	svb.indexes = withIndexName(svb.indexes, name)
+	buckets.Track(svb.DBKey(nil), svb)
+	return svb
+}
+
+func (svb Bucket) WithLazyNativeIndex(name string, indexer orm.MultiKeyIndexer) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithLazyNativeIndex(name, indexer)
+	svb.indexes = withIndexName(svb.indexes, name)
+	buckets.Track(svb.DBKey(nil), svb)
 	return svb
 }
 
+// withIndexName returns a copy of given index names with the name added.
+// Buckets are configured by making copies, so the list must never be shared.
+func withIndexName(names []string, name string) []string {
+	cp := make([]string, len(names), len(names)+1)
+	copy(cp, names)
+	return append(cp, name)
+}
+
 // ModelBucket implements the orm.ModelBucket interface and provides the same
 // functionality with additional model schema migration.
 type ModelBucket struct {
@@ -90,16 +120,14 @@ type ModelBucket struct {
 var _ orm.ModelBucket = (*ModelBucket)(nil)
 
 func NewModelBucket(packageName string, b orm.ModelBucket) *ModelBucket {
-	return &ModelBucket{
+	mb := &ModelBucket{
 		b:           b,
 		packageName: packageName,
 		schema:      NewSchemaBucket(),
 		migrations:  reg,
 	}
-}
-
-func (m *ModelBucket) Register(name string, r weave.QueryRouter) {
-	m.b.Register(name, r)
+	buckets.Track(b.BucketPrefix(), mb)
+	return mb
 }
 
 func (m *ModelBucket) One(db weave.ReadOnlyKVStore, key []byte, dest orm.Model) error {
@@ -116,17 +144,93 @@ func (m *ModelBucket) Index(name string) (orm.Index, error) {
 	return m.b.Index(name)
 }
 
+func (m *ModelBucket) BucketPrefix() []byte {
+	return m.b.BucketPrefix()
+}
+
+func (m *ModelBucket) DBKey(key []byte) []byte {
+	return m.b.DBKey(key)
+}
+
+func (m *ModelBucket) IndexDBKey(indexName string, key []byte) ([]byte, error) {
+	return m.b.IndexDBKey(indexName, key)
+}
+
 func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest orm.ModelSlicePtr) ([][]byte, error) {
 	keys, err := m.b.ByIndex(db, indexName, key, dest)
 	if err != nil {
 		return nil, err
 	}
+	if err := m.migrateSlice(db, dest, 0); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
 
+func (m *ModelBucket) CountByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte) (int, error) {
+	return m.b.CountByIndex(db, indexName, key)
+}
+
+func (m *ModelBucket) ByIndexPage(db weave.ReadOnlyKVStore, indexName string, key []byte, after []byte, limit int, dest orm.ModelSlicePtr) ([]byte, [][]byte, error) {
+	// Only the elements appended by this call must be migrated. Invalid
+	// destination is rejected by the wrapped bucket.
+	var offset int
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		offset = v.Elem().Len()
+	}
+	nextAfter, keys, err := m.b.ByIndexPage(db, indexName, key, after, limit, dest)
+	if err != nil || len(keys) == 0 {
+		return nextAfter, keys, err
+	}
+	if err := m.migrateSlice(db, dest, offset); err != nil {
+		return nil, nil, err
+	}
+	return nextAfter, keys, nil
+}
+
+func (m *ModelBucket) Page(db weave.ReadOnlyKVStore, after []byte, limit int, dest orm.ModelSlicePtr) ([]byte, error) {
+	// Only the elements appended by this call must be migrated. Invalid
+	// destination is rejected by the wrapped bucket, unless there is
+	// nothing to append to it.
+	offset := -1
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		offset = v.Elem().Len()
+	}
+	nextAfter, err := m.b.Page(db, after, limit, dest)
+	if err != nil || offset < 0 {
+		return nextAfter, err
+	}
+	if err := m.migrateSlice(db, dest, offset); err != nil {
+		return nil, err
+	}
+	return nextAfter, nil
+}
+
+func (m *ModelBucket) Many(db weave.ReadOnlyKVStore, keys [][]byte, dest orm.ModelSlicePtr) ([][]byte, error) {
+	// Only the elements appended by this call must be migrated. Invalid
+	// destination is rejected by the wrapped bucket.
+	var offset int
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		offset = v.Elem().Len()
+	}
+	found, err := m.b.Many(db, keys, dest)
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+	if err := m.migrateSlice(db, dest, offset); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// migrateSlice migrates all models of the destination slice, starting with
+// the element at given offset.
+func (m *ModelBucket) migrateSlice(db weave.ReadOnlyKVStore, dest orm.ModelSlicePtr, offset int) error {
 	// The correct type of the dest was already validated by the
 	// ModelBucket when getting data by index. We can safely skip checks -
 	// dest is a slice of models.
 	slice := reflect.ValueOf(dest).Elem()
-	for i := 0; i < slice.Len(); i++ {
+	for i := offset; i < slice.Len(); i++ {
 		item := slice.Index(i)
 
 		// Slice can be both of values and pointer to values. This
@@ -139,27 +243,84 @@ func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []
 		}
 
 		if err := m.migrate(db, model); err != nil {
-			return nil, errors.Wrapf(err, "migrate %d element", i)
+			return errors.Wrapf(err, "migrate %d element", i)
 		}
 	}
-	return keys, nil
+	return nil
 }
 
 func (m *ModelBucket) Put(db weave.KVStore, key []byte, model orm.Model) ([]byte, error) {
-	if err := m.migrate(db, model); err != nil {
+	if err := migrate(m.migrations, m.schema, m.packageName, db, model); err != nil {
 		return nil, errors.Wrap(err, "migrate")
 	}
 	return m.b.Put(db, key, model)
 }
 
+func (m *ModelBucket) PutReserved(db weave.KVStore, key []byte, model orm.Model) error {
+	if err := migrate(m.migrations, m.schema, m.packageName, db, model); err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+	return m.b.PutReserved(db, key, model)
+}
+
+func (m *ModelBucket) PutBatch(db weave.KVStore, models []orm.Model) ([][]byte, error) {
+	for i, model := range models {
+		if err := migrate(m.migrations, m.schema, m.packageName, db, model); err != nil {
+			return nil, errors.Wrapf(err, "migrate %d model", i)
+		}
+	}
+	return m.b.PutBatch(db, models)
+}
+
+func (m *ModelBucket) DryRunPut(db weave.ReadOnlyKVStore, key []byte, model orm.Model) ([]byte, error) {
+	if err := migrate(m.migrations, m.schema, m.packageName, db, model); err != nil {
+		return nil, errors.Wrap(err, "migrate")
+	}
+	return m.b.DryRunPut(db, key, model)
+}
+
 func (m *ModelBucket) Delete(db weave.KVStore, key []byte) error {
 	return m.b.Delete(db, key)
 }
 
+func (m *ModelBucket) DeleteAndReturn(db weave.KVStore, key []byte, dest orm.Model) error {
+	if err := m.b.DeleteAndReturn(db, key, dest); err != nil {
+		return err
+	}
+	if err := m.migrate(db, dest); err != nil {
+		return errors.Wrap(err, "migrate")
+	}
+	return nil
+}
+
+func (m *ModelBucket) DeleteMany(db weave.KVStore, keys [][]byte) (int, error) {
+	return m.b.DeleteMany(db, keys)
+}
+
+// SwapKeys exchanges the keys of two entities. Entities are moved as stored in
+// the database, without schema migration.
+func (m *ModelBucket) SwapKeys(db weave.KVStore, keyA, keyB []byte) error {
+	return m.b.SwapKeys(db, keyA, keyB)
+}
+
 func (m *ModelBucket) Has(db weave.KVStore, key []byte) error {
 	return m.b.Has(db, key)
 }
 
+func (m *ModelBucket) IndexNames() []string {
+	return m.b.IndexNames()
+}
+
+func (m *ModelBucket) NewModel() orm.Model {
+	return m.b.NewModel()
+}
+
+// VerifyIndex checks the index consistency. Entities are indexed as stored in
+// the database, without schema migration.
+func (m *ModelBucket) VerifyIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	return m.b.VerifyIndex(db, indexName)
+}
+
 // useRegister will update this bucket to use a custom register instance
 // instead of the global one. This is a private method meant to be used for
 // tests only.
@@ -168,7 +329,7 @@ func (m *ModelBucket) useRegister(r *register) {
 }
 
 func (m *ModelBucket) migrate(db weave.ReadOnlyKVStore, model orm.Model) error {
-	return migrate(m.migrations, m.schema, m.packageName, db, model)
+	return migrateOnRead(m.migrations, m.schema, m.packageName, db, model)
 }
 
 // SerialModelBucket implements the orm.SerialModelBucket interface and provides the same
@@ -276,7 +437,7 @@ func (smb *SerialModelBucket) IndexScan(db weave.ReadOnlyKVStore, indexName stri
 }
 
 func (smb *SerialModelBucket) Save(db weave.KVStore, model orm.SerialModel) error {
-	if err := smb.migrate(db, model); err != nil {
+	if err := migrate(smb.migrations, smb.schema, smb.packageName, db, model); err != nil {
 		return errors.Wrap(err, "migrate")
 	}
 	return smb.b.Save(db, model)
@@ -298,7 +459,39 @@ func (smb *SerialModelBucket) useRegister(r *register) {
 }
 
 func (smb *SerialModelBucket) migrate(db weave.ReadOnlyKVStore, model orm.SerialModel) error {
-	return migrate(smb.migrations, smb.schema, smb.packageName, db, model)
+	return migrateOnRead(smb.migrations, smb.schema, smb.packageName, db, model)
+}
+
+// migrateOnRead migrates a model loaded from the database. The migration
+// observer is notified if the model schema version was changed. The
+// deprecation observer is notified if the model was stored in a deprecated
+// schema version.
+func migrateOnRead(
+	migrations *register,
+	schema *SchemaBucket,
+	packageName string,
+	db weave.ReadOnlyKVStore,
+	value interface{},
+) error {
+	var from uint32
+	if m, ok := value.(Migratable); ok && m.GetMetadata() != nil {
+		from = m.GetMetadata().Schema
+	}
+	if err := migrate(migrations, schema, packageName, db, value); err != nil {
+		return err
+	}
+	// Model without a schema version is assumed to be in the current
+	// version. This is not a migration.
+	if from == 0 {
+		return nil
+	}
+	if migrations.IsDeprecated(packageName, from) {
+		notifyDeprecated(packageName, from)
+	}
+	if to := value.(Migratable).GetMetadata().Schema; to != from {
+		notifyMigrated(packageName, from, to)
+	}
+	return nil
 }
 
 func migrate(
@@ -331,7 +524,16 @@ func migrate(
 	}
 
 	if meta.Schema > currSchemaVer {
-		return errors.Wrapf(errors.ErrSchema, "model schema higher than %d", currSchemaVer)
+		// Package schema version was downgraded. Only a registered
+		// reverse migration can bring the model back to the current
+		// schema version.
+		if !migrations.HasDowngrade(packageName, meta.Schema) {
+			return errors.Wrapf(errors.ErrSchema, "model schema higher than %d", currSchemaVer)
+		}
+		if err := migrations.ApplyDowngrade(db, packageName, m, currSchemaVer); err != nil {
+			return errors.Wrap(err, "schema downgrade")
+		}
+		return nil
 	}
 
 	// Migration is applied in place, directly modifying the instance.
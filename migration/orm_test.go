@@ -232,6 +232,28 @@ func TestSchemaVersionedModelBucket(t *testing.T) {
 	}
 	assert.Equal(t, wantv, setv)
 
+	// ByIndexPage must migrate each page of results.
+	var paged []*MyModel
+	next, _, err := b.ByIndexPage(db, "const", []byte("all"), nil, 1, &paged)
+	assert.Nil(t, err)
+	_, _, err = b.ByIndexPage(db, "const", []byte("all"), next, 1, &paged)
+	assert.Nil(t, err)
+	assert.Equal(t, wantp, paged)
+
+	// Page must migrate each page of results.
+	var all []MyModel
+	next, err = b.Page(db, nil, 1, &all)
+	assert.Nil(t, err)
+	_, err = b.Page(db, next, 1, &all)
+	assert.Nil(t, err)
+	assert.Equal(t, wantv, all)
+
+	// Many must migrate all loaded models.
+	var many []*MyModel
+	found, err := b.Many(db, [][]byte{k2, []byte("missing"), k1}, &many)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{k2, k1}, found)
+	assert.Equal(t, []*MyModel{wantp[1], wantp[0]}, many)
 }
 
 func assertMyModelState(t testing.TB, m *MyModel, wantSchemaVersion uint32, wantCnt int) {
@@ -931,3 +953,139 @@ func assertMySerialModelWithRefState(t testing.TB, m *MySerialModelWithRef, want
 		t.Fatalf("want cnt %d, got %d", wantCnt, m.Cnt)
 	}
 }
+
+func TestMigrationObserver(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+
+	type migration struct {
+		pkg      string
+		from, to uint32
+	}
+	var migrations []migration
+	SetMigrationObserver(func(pkg string, from, to uint32) {
+		migrations = append(migrations, migration{pkg: pkg, from: from, to: to})
+	})
+	defer SetMigrationObserver(nil)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	b := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	b.useRegister(reg)
+
+	_, err := b.Put(db, []byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}})
+	assert.Nil(t, err)
+	assert.Nil(t, b.One(db, []byte("a"), &MyModel{}))
+	// Model stored in the current schema version is not migrated.
+	assert.Equal(t, 0, len(migrations))
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	// Model saved in an old schema version is migrated on write, which is
+	// not reported.
+	_, err = b.Put(db, []byte("b"), &MyModel{Metadata: &weave.Metadata{Schema: 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(migrations))
+
+	var m MyModel
+	assert.Nil(t, b.One(db, []byte("a"), &m))
+	assert.Equal(t, uint32(2), m.Metadata.Schema)
+	assert.Nil(t, b.One(db, []byte("b"), &m))
+	assert.Equal(t, []migration{{pkg: thisPkgName, from: 1, to: 2}}, migrations)
+}
+
+func TestDeprecationObserver(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+	reg.MustRegister(3, &MyModel{}, NoModification)
+	reg.MustRegisterDeprecated(thisPkgName, 1)
+
+	var deprecated []uint32
+	SetDeprecationObserver(func(pkg string, version uint32) {
+		assert.Equal(t, thisPkgName, pkg)
+		deprecated = append(deprecated, version)
+	})
+	defer SetDeprecationObserver(nil)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	b := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	b.useRegister(reg)
+
+	_, err := b.Put(db, []byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}})
+	assert.Nil(t, err)
+
+	// Reading a model stored in a deprecated version is not failing, even
+	// if that version is the current one.
+	assert.Nil(t, b.One(db, []byte("a"), &MyModel{}))
+	assert.Equal(t, []uint32{1}, deprecated)
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	_, err = b.Put(db, []byte("b"), &MyModel{Metadata: &weave.Metadata{Schema: 2}})
+	assert.Nil(t, err)
+	ensureSchemaVersion(t, db, thisPkgName, 3)
+
+	var m MyModel
+	assert.Nil(t, b.One(db, []byte("a"), &m))
+	assert.Equal(t, uint32(3), m.Metadata.Schema)
+	assert.Nil(t, b.One(db, []byte("b"), &m))
+	assert.Equal(t, uint32(3), m.Metadata.Schema)
+	assert.Equal(t, []uint32{1, 1}, deprecated)
+}
+
+func TestSchemaQuery(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	mb.useRegister(reg)
+	b := NewBucket(thisPkgName, "mybucket", &MyModel{}).useRegister(reg)
+
+	_, err := mb.Put(db, []byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, b.Save(db, orm.NewSimpleObj([]byte("a"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 1})))
+
+	// Model saved in an old schema version is migrated on write.
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+	_, err = mb.Put(db, []byte("b"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, b.Save(db, orm.NewSimpleObj([]byte("b"), &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 2})))
+
+	qr := weave.NewQueryRouter()
+	mb.Register("mymodels", qr)
+	b.Register("mybuckets", qr)
+
+	for _, path := range []string{"/mymodels", "/mybuckets"} {
+		t.Run(path, func(t *testing.T) {
+			plain, err := qr.Handler(path).Query(db, weave.PrefixQueryMod, nil)
+			assert.Nil(t, err)
+			withSchema, err := qr.Handler(path+"/withschema").Query(db, weave.PrefixQueryMod, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(withSchema))
+
+			wantSchema := []uint32{1, 2}
+			for i, m := range withSchema {
+				assert.Equal(t, plain[i].Key, m.Key)
+				var sv SchemaValue
+				assert.Nil(t, sv.Unmarshal(m.Value))
+				assert.Equal(t, wantSchema[i], sv.Schema)
+				// Value must not be migrated.
+				assert.Equal(t, plain[i].Value, sv.Value)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package migration
 
 import (
+	"path"
 	"reflect"
+	"sort"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -35,14 +37,47 @@ func RefuseMigration(weave.ReadOnlyKVStore, Migratable) error {
 	return errors.Wrap(errors.ErrSchema, "no migration path from given schema version")
 }
 
+// Conditional returns a migration function that applies given migration only
+// to entities that the predicate returns true for. All other entities are not
+// modified and only their schema version is updated, the same way
+// NoModification does. This allows a migration to transform a subset of
+// entities, for example those with a value that is no longer valid, without
+// checking within the migration function if an entity requires a change.
+//
+// Predicate is called with the entity in the schema version preceding the
+// migration. This function panics if the predicate or the migration function
+// is nil.
+func Conditional(predicate func(Migratable) bool, fn Migrator) Migrator {
+	if predicate == nil || fn == nil {
+		panic("predicate and migration function are required")
+	}
+	return func(db weave.ReadOnlyKVStore, m Migratable) error {
+		if !predicate(m) {
+			return nil
+		}
+		return fn(db, m)
+	}
+}
+
 func newRegister() *register {
 	return &register{
-		migrateTo: make(map[payloadVersion]Migrator),
+		migrateTo:   make(map[payloadVersion]Migrator),
+		downgradeTo: make(map[packageVersion]Migrator),
+		deprecated:  make(map[packageVersion]struct{}),
+		aliases:     make(map[string]string),
+		packages:    make(map[string]struct{}),
 	}
 }
 
 type register struct {
-	migrateTo map[payloadVersion]Migrator
+	migrateTo   map[payloadVersion]Migrator
+	downgradeTo map[packageVersion]Migrator
+	deprecated  map[packageVersion]struct{}
+	// aliases maps the new package name to the old package name.
+	aliases map[string]string
+	// packages holds the names of packages that declare a schema without
+	// registering any migration.
+	packages map[string]struct{}
 }
 
 // payloadVersion references a message or a model at a given schema version.
@@ -51,6 +86,12 @@ type payloadVersion struct {
 	version uint32
 }
 
+// packageVersion references a package at a given schema version.
+type packageVersion struct {
+	pkg     string
+	version uint32
+}
+
 func (r *register) MustRegister(migrationTo uint32, msgOrModel Migratable, fn Migrator) {
 	if err := r.Register(migrationTo, msgOrModel, fn); err != nil {
 		panic(err)
@@ -61,16 +102,25 @@ func (r *register) Register(migrationTo uint32, msgOrModel Migratable, fn Migrat
 	if migrationTo < 1 {
 		return errors.Wrap(errors.ErrInput, "minimal allowed version is 1")
 	}
+	if msgOrModel == nil {
+		return errors.Wrap(errors.ErrInput, "message or model is required")
+	}
 
 	tp := reflect.TypeOf(msgOrModel)
 
+	if fn == nil {
+		return errors.Wrapf(errors.ErrInput,
+			"nil migration function: %s.%s:%d", tp.PkgPath(), tp.Name(), migrationTo)
+	}
+
 	if migrationTo > 1 {
 		prev := payloadVersion{
 			version: migrationTo - 1,
 			payload: tp,
 		}
 		if _, ok := r.migrateTo[prev]; !ok {
-			return errors.Wrapf(errors.ErrInput, "missing %d version migration", prev.version)
+			return errors.Wrapf(errors.ErrInput,
+				"missing %d version migration: %s.%s:%d", prev.version, tp.PkgPath(), tp.Name(), migrationTo)
 		}
 	}
 
@@ -86,6 +136,249 @@ func (r *register) Register(migrationTo uint32, msgOrModel Migratable, fn Migrat
 	return nil
 }
 
+// Registered returns all versions that a migration is registered for given
+// message or model, in ascending order.
+func (r *register) Registered(msgOrModel Migratable) []uint32 {
+	tp := reflect.TypeOf(msgOrModel)
+	var versions []uint32
+	// Versions must be registered in sequential order, starting with 1.
+	for v := uint32(1); ; v++ {
+		if _, ok := r.migrateTo[payloadVersion{payload: tp, version: v}]; !ok {
+			return versions
+		}
+		versions = append(versions, v)
+	}
+}
+
+// MustRegisterPackage registers a package schema. This function panics if
+// the registration fails. See RegisterPackage.
+func (r *register) MustRegisterPackage(pkg string) {
+	if err := r.RegisterPackage(pkg); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterPackage declares that given package owns a schema, even if it does
+// not register any migration. Such package supports schema version 1.
+func (r *register) RegisterPackage(pkg string) error {
+	if pkg == "" {
+		return errors.Wrap(errors.ErrInput, "package name is required")
+	}
+	if _, ok := r.packages[pkg]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "package already registered: %q", pkg)
+	}
+	r.packages[pkg] = struct{}{}
+	return nil
+}
+
+// Supported returns the highest registered schema version of each package.
+// Package name is the last element of the import path of a registered type.
+// A package registered using RegisterPackage supports at least version 1.
+func (r *register) Supported() map[string]uint32 {
+	supported := make(map[string]uint32)
+	for pv := range r.migrateTo {
+		pkg, _ := payloadName(pv.payload)
+		if pv.version > supported[pkg] {
+			supported[pkg] = pv.version
+		}
+	}
+	for pkg := range r.packages {
+		if supported[pkg] < 1 {
+			supported[pkg] = 1
+		}
+	}
+	return supported
+}
+
+// Known returns true if given package registers at least one migration, is
+// registered using RegisterPackage or is one of the names of a registered
+// package alias.
+func (r *register) Known(pkg string) bool {
+	if _, ok := r.Supported()[pkg]; ok {
+		return true
+	}
+	_, ok := r.Aliased(pkg)
+	return ok
+}
+
+// VersionInfo describes a single migration registered for a message or a
+// model.
+type VersionInfo struct {
+	// Version is the schema version that the migration upgrades to.
+	Version uint32
+	// TypeName is the name of the registered Go type, without the
+	// package name.
+	TypeName string
+}
+
+// All returns all registered migrations, grouped by the package name.
+// Migrations of each package are ordered by the type name and the version.
+func (r *register) All() map[string][]VersionInfo {
+	all := make(map[string][]VersionInfo)
+	for pv := range r.migrateTo {
+		pkg, name := payloadName(pv.payload)
+		all[pkg] = append(all[pkg], VersionInfo{Version: pv.version, TypeName: name})
+	}
+	for _, infos := range all {
+		sort.Slice(infos, func(i, j int) bool {
+			if infos[i].TypeName != infos[j].TypeName {
+				return infos[i].TypeName < infos[j].TypeName
+			}
+			return infos[i].Version < infos[j].Version
+		})
+	}
+	return all
+}
+
+// payloadName returns the package name and the type name of a registered
+// message or model. Package name is the last element of the import path.
+func payloadName(tp reflect.Type) (pkg, name string) {
+	for tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return path.Base(tp.PkgPath()), tp.Name()
+}
+
+func (r *register) MustRegisterDowngrade(pkg string, fromVersion uint32, fn Migrator) {
+	if err := r.RegisterDowngrade(pkg, fromVersion, fn); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterDowngrade(pkg string, fromVersion uint32, fn Migrator) error {
+	if pkg == "" {
+		return errors.Wrap(errors.ErrInput, "package name is required")
+	}
+	if fromVersion < 2 {
+		return errors.Wrap(errors.ErrInput, "minimal allowed version is 2")
+	}
+	pv := packageVersion{pkg: pkg, version: fromVersion}
+	if _, ok := r.downgradeTo[pv]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "downgrade already registered: %s:%d", pkg, fromVersion)
+	}
+	r.downgradeTo[pv] = fn
+	return nil
+}
+
+// HasDowngrade returns true if a downgrade from given schema version is
+// registered for the package.
+func (r *register) HasDowngrade(pkg string, fromVersion uint32) bool {
+	_, ok := r.downgradeTo[packageVersion{pkg: pkg, version: fromVersion}]
+	return ok
+}
+
+func (r *register) MustRegisterDeprecated(pkg string, version uint32) {
+	if err := r.RegisterDeprecated(pkg, version); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterDeprecated(pkg string, version uint32) error {
+	if pkg == "" {
+		return errors.Wrap(errors.ErrInput, "package name is required")
+	}
+	if version < 1 {
+		return errors.Wrap(errors.ErrInput, "minimal allowed version is 1")
+	}
+	pv := packageVersion{pkg: pkg, version: version}
+	if _, ok := r.deprecated[pv]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "deprecation already registered: %s:%d", pkg, version)
+	}
+	r.deprecated[pv] = struct{}{}
+	return nil
+}
+
+// IsDeprecated returns true if given schema version of the package was
+// registered as deprecated.
+func (r *register) IsDeprecated(pkg string, version uint32) bool {
+	_, ok := r.deprecated[packageVersion{pkg: pkg, version: version}]
+	return ok
+}
+
+func (r *register) MustRegisterAlias(oldPkg, newPkg string) {
+	if err := r.RegisterAlias(oldPkg, newPkg); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterAlias(oldPkg, newPkg string) error {
+	if oldPkg == "" || newPkg == "" {
+		return errors.Wrap(errors.ErrInput, "package name is required")
+	}
+	if oldPkg == newPkg {
+		return errors.Wrap(errors.ErrInput, "package cannot be an alias of itself")
+	}
+	// Only a single rename of a package is supported. Chained aliases
+	// would require resolving the stored name recursively.
+	for _, name := range []string{oldPkg, newPkg} {
+		if _, ok := r.Aliased(name); ok {
+			return errors.Wrapf(errors.ErrDuplicate, "alias already registered: %s", name)
+		}
+	}
+	r.aliases[newPkg] = oldPkg
+	return nil
+}
+
+// Aliased returns the other package name of a registered alias that given
+// package name is part of. Given package name can be either the old or the
+// new name.
+func (r *register) Aliased(pkg string) (string, bool) {
+	if old, ok := r.aliases[pkg]; ok {
+		return old, true
+	}
+	for newPkg, oldPkg := range r.aliases {
+		if oldPkg == pkg {
+			return newPkg, true
+		}
+	}
+	return "", false
+}
+
+// Aliases returns all registered aliases, ordered by the new package name.
+func (r *register) Aliases() []SchemaAlias {
+	aliases := make([]SchemaAlias, 0, len(r.aliases))
+	for newPkg, oldPkg := range r.aliases {
+		aliases = append(aliases, SchemaAlias{OldPkg: oldPkg, NewPkg: newPkg})
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].NewPkg < aliases[j].NewPkg })
+	return aliases
+}
+
+// ApplyDowngrade updates the object by applying all reverse migrations
+// registered for given package, starting with the object schema version and
+// ending with the downgradeTo version.
+//
+// Because changes are applied directly on the passed object (in place), even
+// if this function fails some of the reverse migrations might be applied.
+//
+// Validation method is called only on the final version of the object.
+func (r *register) ApplyDowngrade(db weave.ReadOnlyKVStore, pkg string, m Migratable, downgradeTo uint32) error {
+	if downgradeTo < 1 {
+		return errors.Wrap(errors.ErrInput, "minimal allowed version is 1")
+	}
+
+	meta := m.GetMetadata()
+	if err := meta.Validate(); err != nil {
+		return err
+	}
+
+	for v := meta.Schema; v > downgradeTo; v-- {
+		downgrade, ok := r.downgradeTo[packageVersion{pkg: pkg, version: v}]
+		if !ok {
+			return errors.Wrapf(errors.ErrSchema, "downgrade from version %d missing", v)
+		}
+		if err := downgrade(db, m); err != nil {
+			return errors.Wrapf(err, "downgrade from version %d", v)
+		}
+		meta.Schema = v - 1
+	}
+
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "validation")
+	}
+	return nil
+}
+
 // Apply updates the object by applying all missing data migrations. Even a no
 // modification migration is updating the metadata to point to the latest data
 // format version.
@@ -137,8 +430,90 @@ var reg *register = newRegister()
 // less than migrationTo value.
 // Minimal allowed migrationTo version is 1. Version upgrades for each type
 // must be registered in sequential order.
+// This function panics if the registration fails. See Register.
 func MustRegister(migrationTo uint32, msgOrModel Migratable, fn Migrator) {
-	reg.MustRegister(migrationTo, msgOrModel, fn)
+	if err := Register(migrationTo, msgOrModel, fn); err != nil {
+		panic(err)
+	}
+}
+
+// Register registers a migration function for a given message or model, the
+// same way MustRegister does. Instead of panicking, it returns an error if the
+// migration function is nil, if the version is already registered or if the
+// previous version is not registered yet. This allows an application that is
+// assembled dynamically to handle a registration conflict.
+func Register(migrationTo uint32, msgOrModel Migratable, fn Migrator) error {
+	return reg.Register(migrationTo, msgOrModel, fn)
+}
+
+// Registered returns all versions that a migration is registered for given
+// message or model, in ascending order.
+func Registered(msgOrModel Migratable) []uint32 {
+	return reg.Registered(msgOrModel)
+}
+
+// SupportedSchemas returns the highest schema version of each package that
+// this binary can handle. This is the highest version that a migration is
+// registered for any message or model of that package. The package name is
+// the last element of the import path of a registered type, which by
+// convention is the name used to declare the package schema. A package
+// registered using MustRegisterPackage supports at least version 1.
+func SupportedSchemas() map[string]uint32 {
+	return reg.Supported()
+}
+
+// RegisteredMigrations returns all migrations registered for messages and
+// models, grouped by the package name. Migrations of each package are ordered
+// by the type name and the version. This allows to generate a compatibility
+// reference of all supported schema versions.
+func RegisteredMigrations() map[string][]VersionInfo {
+	return reg.All()
+}
+
+// RegisterDowngrade registers a reverse migration function for all entities
+// of a given package. Reverse migration function is called when an entity
+// stored with fromVersion schema is read after the package schema version was
+// downgraded using DowngradeSchemaMsg. It must convert any entity of the
+// package, from fromVersion to the previous schema version.
+// This is an emergency rollback tool and registering a downgrade is
+// optional. Minimal allowed fromVersion is 2.
+func RegisterDowngrade(pkg string, fromVersion uint32, fn Migrator) {
+	reg.MustRegisterDowngrade(pkg, fromVersion, fn)
+}
+
+// RegisterDeprecated declares that given schema version of a package is
+// deprecated and its support is planned to be removed. Each time a model
+// stored in a deprecated schema version is read from a migrating bucket, the
+// deprecation observer is notified. See SetDeprecationObserver.
+// Deprecation is purely advisory. Reading a model stored in a deprecated
+// schema version succeeds as before. This allows operators to learn about
+// not yet migrated data, before it cannot be read anymore.
+// Minimal allowed version is 1. This function panics if the registration
+// fails.
+func RegisterDeprecated(pkg string, version uint32) {
+	reg.MustRegisterDeprecated(pkg, version)
+}
+
+// MustRegisterAlias declares that a package was renamed from oldPkg to newPkg.
+// Both names refer to the same schema. Until the stored schema is renamed
+// using RenameSchemaMsg, schema version lookups for the new package name use
+// the schema stored under the old name. Once renamed, schema version lookups
+// for the old package name use the schema stored under the new name. This
+// allows to rename a package without losing its schema version.
+// Each package name can be part of a single alias only. This function panics
+// if the registration fails.
+func MustRegisterAlias(oldPkg, newPkg string) {
+	reg.MustRegisterAlias(oldPkg, newPkg)
+}
+
+// MustRegisterPackage declares that given package owns a schema, even if it
+// does not register any migration for its messages or models. This allows a
+// package that does not store any versioned data, for example because it
+// only wraps messages of other packages, to initialize its schema in the
+// genesis file and to upgrade it. Such package supports schema version 1.
+// This function panics if the package name is empty or already registered.
+func MustRegisterPackage(pkg string) {
+	reg.MustRegisterPackage(pkg)
 }
 
 // Apply updates the object by applying all missing data migrations. Even a no
@@ -38,6 +38,91 @@ func TestRegisterMigrationMustBeSequential(t *testing.T) {
 	reg.MustRegister(4, &MyMsg{}, NoModification)
 }
 
+func TestRegisterErrors(t *testing.T) {
+	reg := newRegister()
+
+	if err := reg.Register(1, &MyMsg{}, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected nil migration function registration error: %s", err)
+	}
+	if err := reg.Register(1, nil, NoModification); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected nil model registration error: %s", err)
+	}
+
+	assert.Nil(t, reg.Register(1, &MyMsg{}, NoModification))
+	if err := reg.Register(1, &MyMsg{}, NoModification); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected duplicated registration error: %s", err)
+	}
+	assert.Panics(t, func() {
+		reg.MustRegister(1, &MyMsg{}, NoModification)
+	})
+}
+
+func TestRegistered(t *testing.T) {
+	reg := newRegister()
+
+	assert.Equal(t, []uint32(nil), reg.Registered(&MyMsg{}))
+
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+	reg.MustRegister(3, &MyMsg{}, NoModification)
+	reg.MustRegister(1, &MyModel{}, NoModification)
+
+	assert.Equal(t, []uint32{1, 2, 3}, reg.Registered(&MyMsg{}))
+	assert.Equal(t, []uint32{1}, reg.Registered(&MyModel{}))
+}
+
+func TestSupported(t *testing.T) {
+	reg := newRegister()
+
+	assert.Equal(t, map[string]uint32{}, reg.Supported())
+
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+	reg.MustRegister(1, &MyModel{}, NoModification)
+
+	assert.Equal(t, map[string]uint32{"migration": 2}, reg.Supported())
+
+	// A package registration does not lower the supported version.
+	reg.MustRegisterPackage("migration")
+	reg.MustRegisterPackage("nomigrations")
+	assert.Equal(t, map[string]uint32{"migration": 2, "nomigrations": 1}, reg.Supported())
+	assert.Equal(t, true, reg.Known("nomigrations"))
+	assert.Equal(t, false, reg.Known("unknown"))
+}
+
+func TestRegisterPackageErrors(t *testing.T) {
+	reg := newRegister()
+
+	if err := reg.RegisterPackage(""); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected empty package name registration error: %s", err)
+	}
+	assert.Nil(t, reg.RegisterPackage("nomigrations"))
+	if err := reg.RegisterPackage("nomigrations"); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected duplicated registration error: %s", err)
+	}
+	assert.Panics(t, func() {
+		reg.MustRegisterPackage("nomigrations")
+	})
+}
+
+func TestAll(t *testing.T) {
+	reg := newRegister()
+
+	assert.Equal(t, map[string][]VersionInfo{}, reg.All())
+
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+	reg.MustRegister(1, &MyModel{}, NoModification)
+
+	assert.Equal(t, map[string][]VersionInfo{
+		"migration": {
+			{Version: 1, TypeName: "MyModel"},
+			{Version: 1, TypeName: "MyMsg"},
+			{Version: 2, TypeName: "MyMsg"},
+		},
+	}, reg.All())
+}
+
 func TestApply(t *testing.T) {
 	reg := newRegister()
 	reg.MustRegister(1, &MyMsg{}, NoModification)
@@ -68,6 +153,46 @@ func TestApply(t *testing.T) {
 	assert.Equal(t, mymsg.Content, "init to2to4")
 }
 
+func TestApplyConditional(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	// Negative counter is no longer valid and must be reset.
+	negative := func(m Migratable) bool { return m.(*MyModel).Cnt < 0 }
+	var calls int
+	reg.MustRegister(2, &MyModel{}, Conditional(negative, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		calls++
+		m.(*MyModel).Cnt = 0
+		return nil
+	}))
+	reg.MustRegister(3, &MyModel{}, Conditional(negative, RefuseMigration))
+
+	models := []*MyModel{
+		{Metadata: &weave.Metadata{Schema: 1}, Cnt: -4},
+		{Metadata: &weave.Metadata{Schema: 1}, Cnt: 7},
+		{Metadata: &weave.Metadata{Schema: 1}, Cnt: -1},
+		{Metadata: &weave.Metadata{Schema: 1}, Cnt: 0},
+	}
+	for i, m := range models {
+		if err := reg.Apply(nil, m, 3); err != nil {
+			t.Fatalf("cannot migrate model %d: %s", i, err)
+		}
+	}
+	assert.Equal(t, 2, calls)
+	for i, want := range []int{0, 7, 0, 0} {
+		assert.Equal(t, uint32(3), models[i].Metadata.Schema)
+		assert.Equal(t, want, models[i].Cnt)
+	}
+
+	// Matching entity gets the full migration, including its failure.
+	failing := &MyModel{Metadata: &weave.Metadata{Schema: 2}, Cnt: -1}
+	if err := reg.Apply(nil, failing, 3); !errors.ErrSchema.Is(err) {
+		t.Fatalf("unexpected migration error: %+v", err)
+	}
+
+	assert.Panics(t, func() { Conditional(nil, NoModification) })
+	assert.Panics(t, func() { Conditional(negative, nil) })
+}
+
 func TestMigrateUnknownVersion(t *testing.T) {
 	reg := newRegister()
 	reg.MustRegister(1, &MyMsg{}, NoModification)
@@ -86,3 +211,105 @@ func TestMigrateUnknownVersion(t *testing.T) {
 	}
 	assert.Equal(t, mymsg.Metadata.Schema, uint32(3))
 }
+
+func TestRegisterDeprecated(t *testing.T) {
+	reg := newRegister()
+
+	if err := reg.RegisterDeprecated("", 1); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected missing package registration error: %s", err)
+	}
+	if err := reg.RegisterDeprecated("mypkg", 0); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected invalid version registration error: %s", err)
+	}
+
+	assert.Nil(t, reg.RegisterDeprecated("mypkg", 1))
+	if err := reg.RegisterDeprecated("mypkg", 1); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected duplicated registration error: %s", err)
+	}
+	assert.Panics(t, func() {
+		reg.MustRegisterDeprecated("mypkg", 1)
+	})
+
+	assert.Equal(t, true, reg.IsDeprecated("mypkg", 1))
+	assert.Equal(t, false, reg.IsDeprecated("mypkg", 2))
+	assert.Equal(t, false, reg.IsDeprecated("otherpkg", 1))
+}
+
+func TestRegisterAlias(t *testing.T) {
+	reg := newRegister()
+
+	if err := reg.RegisterAlias("", "newpkg"); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected missing package registration error: %s", err)
+	}
+	if err := reg.RegisterAlias("mypkg", "mypkg"); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected self alias registration error: %s", err)
+	}
+
+	assert.Nil(t, reg.RegisterAlias("oldpkg", "newpkg"))
+	// Each package can be part of a single alias only.
+	for _, names := range [][2]string{
+		{"oldpkg", "otherpkg"},
+		{"otherpkg", "newpkg"},
+		{"newpkg", "otherpkg"},
+		{"otherpkg", "oldpkg"},
+	} {
+		if err := reg.RegisterAlias(names[0], names[1]); !errors.ErrDuplicate.Is(err) {
+			t.Fatalf("unexpected %q duplicated registration error: %s", names, err)
+		}
+	}
+	assert.Panics(t, func() {
+		reg.MustRegisterAlias("oldpkg", "newpkg")
+	})
+
+	other, ok := reg.Aliased("oldpkg")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "newpkg", other)
+	other, ok = reg.Aliased("newpkg")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "oldpkg", other)
+	_, ok = reg.Aliased("otherpkg")
+	assert.Equal(t, false, ok)
+
+	assert.Equal(t, []SchemaAlias{{OldPkg: "oldpkg", NewPkg: "newpkg"}}, reg.Aliases())
+}
+
+func TestApplyDowngrade(t *testing.T) {
+	reg := newRegister()
+
+	if err := reg.RegisterDowngrade("mypkg", 1, NoModification); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected initial version downgrade registration error: %s", err)
+	}
+	reg.MustRegisterDowngrade("mypkg", 3, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		msg.Content += "from3"
+		return nil
+	})
+	if err := reg.RegisterDowngrade("mypkg", 3, NoModification); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected duplicated downgrade registration error: %s", err)
+	}
+	assert.Equal(t, true, reg.HasDowngrade("mypkg", 3))
+	assert.Equal(t, false, reg.HasDowngrade("mypkg", 2))
+	assert.Equal(t, false, reg.HasDowngrade("otherpkg", 3))
+
+	mymsg := &MyMsg{
+		Metadata: &weave.Metadata{Schema: 3},
+		Content:  "init ",
+	}
+
+	// Downgrade to a version without a full reverse migration path must
+	// fail. It will downgrade the message as far as possible.
+	if err := reg.ApplyDowngrade(nil, "mypkg", mymsg, 1); !errors.ErrSchema.Is(err) {
+		t.Fatalf("unexpected downgrade failure: %s", err)
+	}
+	assert.Equal(t, mymsg.Metadata.Schema, uint32(2))
+	assert.Equal(t, mymsg.Content, "init from3")
+
+	reg.MustRegisterDowngrade("mypkg", 2, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		msg.Content += "from2"
+		return nil
+	})
+	assert.Nil(t, reg.ApplyDowngrade(nil, "mypkg", mymsg, 1))
+	assert.Equal(t, mymsg.Metadata.Schema, uint32(1))
+	assert.Equal(t, mymsg.Content, "init from3from2")
+}
@@ -0,0 +1,95 @@
+package migration
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// schemaQuerySuffix is appended to the bucket query path in order to create
+// the path of the schema aware query.
+const schemaQuerySuffix = "/withschema"
+
+// schemaQuery wraps a bucket query handler. Each returned model value is
+// replaced with a serialized SchemaValue that contains the original value and
+// the schema version of the model it represents. Keys are not modified.
+//
+// Values are never migrated, so that the client can verify them against the
+// state.
+type schemaQuery struct {
+	query weave.QueryHandler
+	// decode deserializes a value returned by the query handler.
+	decode func(value []byte) (weave.Persistent, error)
+}
+
+var _ weave.QueryHandler = (*schemaQuery)(nil)
+
+func (q *schemaQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := q.query.Query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]weave.Model, len(models))
+	for i, m := range models {
+		entity, err := q.decode(m.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode %d model", i)
+		}
+		var schema uint32
+		if e, ok := entity.(Migratable); ok && e.GetMetadata() != nil {
+			schema = e.GetMetadata().Schema
+		}
+		raw, err := (&SchemaValue{Schema: schema, Value: m.Value}).Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal schema value")
+		}
+		res[i] = weave.Pair(m.Key, raw)
+	}
+	return res, nil
+}
+
+// registerSchemaQuery registers under the name based path a query handler
+// that returns models together with their schema version.
+func registerSchemaQuery(name string, r weave.QueryRouter, q *schemaQuery) {
+	r.Register("/"+name+schemaQuerySuffix, q)
+}
+
+// Register registers the bucket content to be accessible via query requests
+// under the given name. Additionally, a query that returns each value together
+// with its schema version is registered under the name with the
+// "/withschema" suffix. See SchemaValue.
+func (svb Bucket) Register(name string, r weave.QueryRouter) {
+	svb.Bucket.Register(name, r)
+	registerSchemaQuery(name, r, &schemaQuery{
+		query: svb.Bucket,
+		decode: func(value []byte) (weave.Persistent, error) {
+			obj, err := svb.Bucket.Parse(nil, value)
+			if err != nil {
+				return nil, err
+			}
+			return obj.Value(), nil
+		},
+	})
+}
+
+// Register registers the bucket content to be accessible via query requests
+// under the given name. Additionally, a query that returns each value together
+// with its schema version is registered under the name with the
+// "/withschema" suffix. See SchemaValue.
+func (m *ModelBucket) Register(name string, r weave.QueryRouter) {
+	m.b.Register(name, r)
+
+	// Model bucket does not expose its query handler. Register it with a
+	// private router in order to get access to it.
+	private := weave.NewQueryRouter()
+	m.b.Register(name, private)
+	registerSchemaQuery(name, r, &schemaQuery{
+		query: private.Handler("/" + name),
+		decode: func(value []byte) (weave.Persistent, error) {
+			entity := m.b.NewModel()
+			if err := entity.Unmarshal(value); err != nil {
+				return nil, errors.Wrap(errors.ErrState, err.Error())
+			}
+			return entity, nil
+		},
+	})
+}
@@ -0,0 +1,255 @@
+package migration
+
+import (
+	"reflect"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// TransitionBucket provides a dual-write functionality for a schema migrating
+// model bucket, meant to support rolling upgrades of a validator set running
+// mixed binaries.
+//
+// For the duration of the transition window, that starts with the package
+// schema upgrade and lasts for as long as configured by the
+// Configuration.TransitionWindow, each PutCompat stores the model under its
+// original key in the previous schema version, exactly as a binary that was
+// not upgraded yet reads it. The model in the current schema version is
+// additionally stored in a separate, pending bucket and One loads it instead
+// of the downgraded model.
+//
+// Once the transition window closes, PutCompat stores the model in the
+// current schema version under its original key. Pending models written
+// during the transition window must be moved to their original keys by
+// calling Purge, once, for example from a data migration.
+//
+// Models must be read using One. Other read methods, for example queries by
+// index, load the models stored under the original keys and, within the
+// transition window, return the downgraded models migrated to the current
+// schema version.
+type TransitionBucket struct {
+	*ModelBucket
+	pending     orm.ModelBucket
+	pendingName string
+	model       reflect.Type
+	downgrade   Migrator
+}
+
+// NewTransitionBucket returns a bucket that during the transition window
+// stores models in the current schema version in a pending bucket with given
+// name. Downgrade function is used to convert a copy of the model from the
+// current schema version to the previous one. Downgrade function must not
+// change the schema version declared by the model metadata.
+func NewTransitionBucket(mb *ModelBucket, pendingBucketName string, model orm.Model, downgrade Migrator) *TransitionBucket {
+	tp := reflect.TypeOf(model)
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return &TransitionBucket{
+		ModelBucket: mb,
+		pending:     orm.NewModelBucket(pendingBucketName, model),
+		pendingName: pendingBucketName,
+		model:       tp,
+		downgrade:   downgrade,
+	}
+}
+
+// PutCompat saves given model in the database. If the transition window is
+// open, the model is stored under its key in the previous schema version and
+// in the pending bucket in the current schema version. Otherwise the model is
+// stored under its key in the current schema version and its pending copy, if
+// any, is removed.
+func (b *TransitionBucket) PutCompat(ctx weave.Context, db weave.KVStore, key []byte, m orm.Model) ([]byte, error) {
+	prev, open, err := b.transition(ctx, db)
+	if err != nil {
+		return nil, errors.Wrap(err, "transition window")
+	}
+	if !open {
+		key, err := b.ModelBucket.Put(db, key, m)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.deletePending(db, key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	if err := migrate(b.migrations, b.schema, b.packageName, db, m); err != nil {
+		return nil, errors.Wrap(err, "migrate")
+	}
+	compat, err := b.downgradeCopy(db, m, prev)
+	if err != nil {
+		return nil, errors.Wrap(err, "downgrade")
+	}
+	key, err = b.ModelBucket.b.Put(db, key, compat)
+	if err != nil {
+		return nil, errors.Wrap(err, "store downgraded model")
+	}
+	if _, err := b.pending.Put(db, key, m); err != nil {
+		return nil, errors.Wrap(err, "store pending model")
+	}
+	return key, nil
+}
+
+// DeleteCompat removes an entity with given primary key from the database,
+// together with its pending copy if one exists.
+func (b *TransitionBucket) DeleteCompat(db weave.KVStore, key []byte) error {
+	if err := b.ModelBucket.Delete(db, key); err != nil {
+		return err
+	}
+	return b.deletePending(db, key)
+}
+
+func (b *TransitionBucket) deletePending(db weave.KVStore, key []byte) error {
+	switch err := b.pending.Delete(db, key); {
+	case err == nil, errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrap(err, "delete pending model")
+	}
+}
+
+// One loads an entity with given primary key. If a pending copy of the model
+// exists and its schema version is supported by this binary, the pending copy
+// is loaded. Otherwise the model stored under the key is loaded and migrated
+// the same way as by the ModelBucket.
+func (b *TransitionBucket) One(db weave.ReadOnlyKVStore, key []byte, dest orm.Model) error {
+	if reflect.TypeOf(dest) != reflect.PtrTo(b.model) {
+		return errors.Wrapf(errors.ErrType, "destination must be %T, got %T", reflect.New(b.model).Interface(), dest)
+	}
+	switch err := b.pending.One(db, key, dest); {
+	case err == nil:
+		if b.supported(dest) {
+			if err := b.ModelBucket.migrate(db, dest); err != nil {
+				return errors.Wrap(err, "migrate")
+			}
+			return nil
+		}
+	case errors.ErrNotFound.Is(err):
+	default:
+		return errors.Wrap(err, "pending model")
+	}
+	return b.ModelBucket.One(db, key, dest)
+}
+
+// supported returns true if this binary supports the schema version of given
+// model.
+func (b *TransitionBucket) supported(m orm.Model) bool {
+	migratable, ok := m.(Migratable)
+	if !ok || migratable.GetMetadata() == nil {
+		return true
+	}
+	return migratable.GetMetadata().Schema <= uint32(len(b.migrations.Registered(migratable)))
+}
+
+// OneCompat loads an entity with given primary key as it is stored under that
+// key. Within the transition window this is the model in the previous schema
+// version. Loaded model is not migrated.
+func (b *TransitionBucket) OneCompat(db weave.ReadOnlyKVStore, key []byte, dest orm.Model) error {
+	return b.ModelBucket.b.One(db, key, dest)
+}
+
+// Purge moves up to limit pending models under their original keys, replacing
+// the models stored there in the previous schema version. It returns the
+// number of moved models. All pending models are moved once the returned
+// number is lower than the limit.
+//
+// Purge must be called once the transition window is closed, for example
+// from a data migration. PutCompat never purges pending models. An error is
+// returned if the transition window is still open.
+func (b *TransitionBucket) Purge(ctx weave.Context, db weave.KVStore, limit int) (int, error) {
+	if limit < 1 {
+		return 0, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+	switch _, open, err := b.transition(ctx, db); {
+	case err != nil:
+		return 0, errors.Wrap(err, "transition window")
+	case open:
+		return 0, errors.Wrap(errors.ErrState, "transition window is open")
+	}
+
+	var moved int
+	it := orm.IterAll(b.pendingName)
+	for moved < limit {
+		m := reflect.New(b.model).Interface().(orm.Model)
+		switch key, err := it.Next(db, m); {
+		case err == nil:
+			if _, err := b.ModelBucket.Put(db, key, m); err != nil {
+				return moved, errors.Wrap(err, "store model")
+			}
+			if err := b.pending.Delete(db, key); err != nil {
+				return moved, errors.Wrap(err, "delete pending model")
+			}
+			moved++
+		case errors.ErrIteratorDone.Is(err):
+			return moved, nil
+		default:
+			return moved, errors.Wrap(err, "iterator")
+		}
+	}
+	return moved, nil
+}
+
+// transition returns the previous schema version of the package and the
+// transition window state. The transition window is closed if the migration
+// extension configuration does not exist.
+func (b *TransitionBucket) transition(ctx weave.Context, db weave.ReadOnlyKVStore) (uint32, bool, error) {
+	ver, err := b.schema.CurrentSchema(db, b.packageName)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "current schema")
+	}
+	if ver < 2 {
+		// There is no previous version.
+		return 0, false, nil
+	}
+	conf, err := loadConf(db)
+	switch {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		// Without a configuration there is no transition window.
+		return 0, false, nil
+	default:
+		return 0, false, errors.Wrap(err, "load configuration")
+	}
+	if conf.TransitionWindow == 0 {
+		return 0, false, nil
+	}
+	s, err := b.schema.schemaVersion(db, b.packageName, ver)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "schema")
+	}
+	if s.UpgradedAt == 0 {
+		return 0, false, nil
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "block time")
+	}
+	closesAt := s.UpgradedAt.Add(conf.TransitionWindow.Duration())
+	return ver - 1, now.Before(closesAt.Time()), nil
+}
+
+// downgradeCopy returns a copy of given model, converted to the given schema
+// version.
+func (b *TransitionBucket) downgradeCopy(db weave.ReadOnlyKVStore, m orm.Model, version uint32) (orm.Model, error) {
+	raw, err := m.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+	cp := reflect.New(b.model).Interface().(orm.Model)
+	if err := cp.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	migratable, ok := cp.(Migratable)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "%T is not migratable", cp)
+	}
+	if err := b.downgrade(db, migratable); err != nil {
+		return nil, err
+	}
+	migratable.GetMetadata().Schema = version
+	return cp, nil
+}
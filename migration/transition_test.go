@@ -0,0 +1,206 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestTransitionBucket(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt *= 10
+		return nil
+	})
+
+	upgradedAt := time.Now().Add(-time.Hour).UTC()
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+	_, err := NewSchemaBucket().Create(db, &Schema{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Pkg:        thisPkgName,
+		Version:    2,
+		UpgradedAt: weave.AsUnixTime(upgradedAt),
+	})
+	assert.Nil(t, err)
+
+	err = gconf.Save(db, "migration", &Configuration{
+		Admin:              weavetest.NewCondition().Address(),
+		TransitionWindow:   weave.AsUnixDuration(2 * time.Hour),
+		MinUpgradeInterval: 1,
+	})
+	assert.Nil(t, err)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	mb.useRegister(reg)
+	b := NewTransitionBucket(mb, "mymodel_v", &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		m.(*MyModel).Cnt /= 10
+		return nil
+	})
+
+	// Within the transition window the model is stored under its key in
+	// the previous schema version.
+	ctx := weave.WithBlockTime(context.Background(), upgradedAt.Add(time.Hour))
+	key, err := b.PutCompat(ctx, db, []byte("a"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 2},
+		Cnt:      50,
+	})
+	assert.Nil(t, err)
+
+	var current MyModel
+	assert.Nil(t, b.One(db, key, &current))
+	assertMyModelState(t, &current, 2, 50)
+
+	var compat MyModel
+	assert.Nil(t, b.OneCompat(db, key, &compat))
+	assertMyModelState(t, &compat, 1, 5)
+
+	// The model is stored under its original key in the format of the
+	// previous schema version, so that a binary that was not upgraded yet
+	// reads it from the same bucket.
+	var prev MyModel
+	assert.Nil(t, orm.NewModelBucket("mymodel", &MyModel{}).One(db, key, &prev))
+	assertMyModelState(t, &prev, 1, 5)
+
+	// Deleting an entity removes its pending copy as well.
+	assert.Nil(t, b.DeleteCompat(db, key))
+	if err := b.pending.One(db, key, &compat); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want pending copy to be deleted, got %v", err)
+	}
+
+	for _, k := range []string{"b", "c"} {
+		_, err = b.PutCompat(ctx, db, []byte(k), &MyModel{
+			Metadata: &weave.Metadata{Schema: 2},
+			Cnt:      70,
+		})
+		assert.Nil(t, err)
+	}
+
+	// Once the transition window is closed, the model is stored under its
+	// key in the current schema version and its pending copy is removed.
+	// Other pending copies are not purged by a write.
+	ctx = weave.WithBlockTime(context.Background(), upgradedAt.Add(3*time.Hour))
+	key, err = b.PutCompat(ctx, db, []byte("c"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 2},
+		Cnt:      90,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, b.OneCompat(db, key, &compat))
+	assertMyModelState(t, &compat, 2, 90)
+	if err := b.pending.One(db, key, &compat); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want pending copy to be deleted, got %v", err)
+	}
+	assert.Nil(t, b.One(db, key, &current))
+	assertMyModelState(t, &current, 2, 90)
+
+	assert.Nil(t, b.pending.One(db, []byte("b"), &compat))
+	assert.Nil(t, b.One(db, []byte("b"), &current))
+	assertMyModelState(t, &current, 2, 70)
+}
+
+func TestTransitionBucketPurge(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+
+	upgradedAt := time.Now().Add(-time.Hour).UTC()
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+	_, err := NewSchemaBucket().Create(db, &Schema{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Pkg:        thisPkgName,
+		Version:    2,
+		UpgradedAt: weave.AsUnixTime(upgradedAt),
+	})
+	assert.Nil(t, err)
+	err = gconf.Save(db, "migration", &Configuration{
+		Admin:              weavetest.NewCondition().Address(),
+		TransitionWindow:   weave.AsUnixDuration(2 * time.Hour),
+		MinUpgradeInterval: 1,
+	})
+	assert.Nil(t, err)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	mb.useRegister(reg)
+	b := NewTransitionBucket(mb, "mymodel_v", &MyModel{}, NoModification)
+
+	const copies = 15
+	ctx := weave.WithBlockTime(context.Background(), upgradedAt.Add(time.Hour))
+	for i := 0; i < copies; i++ {
+		_, err := b.PutCompat(ctx, db, []byte{byte(i)}, &MyModel{Metadata: &weave.Metadata{Schema: 2}, Cnt: i})
+		assert.Nil(t, err)
+	}
+
+	// Pending models cannot be moved while the transition window is open.
+	if _, err := b.Purge(ctx, db, copies); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+
+	ctx = weave.WithBlockTime(context.Background(), upgradedAt.Add(3*time.Hour))
+	n, err := b.Purge(ctx, db, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	n, err = b.Purge(ctx, db, copies)
+	assert.Nil(t, err)
+	assert.Equal(t, copies-2, n)
+	n, err = b.Purge(ctx, db, copies)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+
+	// All models are stored under their keys in the current schema
+	// version.
+	for i := 0; i < copies; i++ {
+		var m MyModel
+		assert.Nil(t, b.OneCompat(db, []byte{byte(i)}, &m))
+		assertMyModelState(t, &m, 2, i)
+	}
+}
+
+func TestTransitionBucketWithoutConfiguration(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, NoModification)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	mb := NewModelBucket(thisPkgName, orm.NewModelBucket("mymodel", &MyModel{}))
+	mb.useRegister(reg)
+	b := NewTransitionBucket(mb, "mymodel_v", &MyModel{}, NoModification)
+
+	// Without the migration configuration there is no transition window.
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	key, err := b.PutCompat(ctx, db, []byte("a"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 2},
+		Cnt:      5,
+	})
+	assert.Nil(t, err)
+
+	var m MyModel
+	assert.Nil(t, b.OneCompat(db, key, &m))
+	assertMyModelState(t, &m, 2, 5)
+	if err := b.pending.One(db, key, &m); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want no pending copy, got %v", err)
+	}
+
+	n, err := b.Purge(ctx, db, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+}
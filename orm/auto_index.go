@@ -0,0 +1,93 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// AutoIndexer returns an indexer that uses the value of the model field with
+// given name as the index key. The field must be a top level field of either
+// a string or a byte slice type (for example weave.Address). An empty field
+// value produces no index entry.
+//
+// The field is looked up using reflection each time an object is indexed. Use
+// WithAutoIndex to validate the field once, when the bucket is created.
+func AutoIndexer(fieldName string) Indexer {
+	return func(obj Object) ([]byte, error) {
+		v, err := indexedValue(obj)
+		if err != nil {
+			return nil, err
+		}
+		f, err := autoIndexField(v.Type(), fieldName)
+		if err != nil {
+			return nil, err
+		}
+		return autoIndexKey(v.FieldByIndex(f.Index)), nil
+	}
+}
+
+// WithAutoIndex configures the bucket to build an index with given name, using
+// the value of the model field with given name as the index key. See
+// AutoIndexer for the supported field types.
+// This function panics if the model does not declare a field with given name
+// or if the field type is not supported.
+func WithAutoIndex(name, fieldName string, unique bool) ModelBucketOption {
+	return func(mb *modelBucket) {
+		f, err := autoIndexField(mb.model, fieldName)
+		if err != nil {
+			panic(fmt.Sprintf("%s bucket %q index: %s", mb.name, name, err))
+		}
+		indexer := func(obj Object) ([]byte, error) {
+			if obj == nil {
+				return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+			}
+			v := reflect.ValueOf(obj.Value())
+			if v.Kind() == reflect.Ptr {
+				v = v.Elem()
+			}
+			if v.Type() != mb.model {
+				return nil, errors.Wrapf(errors.ErrType, "can only take index of %s", mb.model)
+			}
+			return autoIndexKey(v.FieldByIndex(f.Index)), nil
+		}
+		mb.b = mb.b.WithMultiKeyIndex(name, asMultiKeyIndexer(indexer), unique)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// autoIndexField returns the description of the model field that can be used
+// as an index key.
+func autoIndexField(model reflect.Type, fieldName string) (reflect.StructField, error) {
+	if model.Kind() != reflect.Struct {
+		return reflect.StructField{}, errors.Wrapf(errors.ErrType, "%s is not a structure", model)
+	}
+	f, ok := model.FieldByName(fieldName)
+	if !ok {
+		return f, errors.Wrapf(errors.ErrType, "%s model has no %q field", model, fieldName)
+	}
+	switch k := f.Type.Kind(); {
+	case k == reflect.String:
+	case k == reflect.Slice && f.Type.Elem().Kind() == reflect.Uint8:
+	default:
+		return f, errors.Wrapf(errors.ErrType, "%s model %q field of type %s cannot be indexed", model, fieldName, f.Type)
+	}
+	return f, nil
+}
+
+// autoIndexKey returns the index key for given field value or nil if the
+// value is empty and must not be indexed.
+func autoIndexKey(v reflect.Value) []byte {
+	if v.Len() == 0 {
+		return nil
+	}
+	if v.Kind() == reflect.String {
+		return []byte(v.String())
+	}
+	// Copy the value, so that the index key does not share memory with
+	// the model.
+	key := make([]byte, v.Len())
+	copy(key, v.Bytes())
+	return key
+}
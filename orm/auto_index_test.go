@@ -0,0 +1,104 @@
+package orm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestWithAutoIndex(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &CounterWithID{},
+		WithAutoIndex("pk", "PrimaryKey", true),
+	)
+
+	_, err := b.Put(db, []byte("a"), &CounterWithID{PrimaryKey: []byte("first"), Count: 1})
+	assert.Nil(t, err)
+	_, err = b.Put(db, []byte("b"), &CounterWithID{PrimaryKey: []byte("second"), Count: 2})
+	assert.Nil(t, err)
+
+	var found []CounterWithID
+	keys, err := b.ByIndex(db, "pk", []byte("second"), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("b")}, keys)
+	assert.Equal(t, int64(2), found[0].Count)
+
+	_, err = b.Put(db, []byte("c"), &CounterWithID{PrimaryKey: []byte("first"), Count: 3})
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unique index must reject a duplicate, got %+v", err)
+	}
+
+	// Empty values are not indexed, so they do not collide in a unique index.
+	_, err = b.Put(db, []byte("d"), &CounterWithID{Count: 4})
+	assert.Nil(t, err)
+	_, err = b.Put(db, []byte("e"), &CounterWithID{PrimaryKey: []byte{}, Count: 5})
+	assert.Nil(t, err)
+
+	orphans, err := b.VerifyIndex(db, "pk")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+}
+
+func TestWithAutoIndexInvalidField(t *testing.T) {
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &CounterWithID{}, WithAutoIndex("x", "Missing", false))
+	})
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &CounterWithID{}, WithAutoIndex("x", "Count", false))
+	})
+}
+
+func TestAutoIndexer(t *testing.T) {
+	indexer := AutoIndexer("PrimaryKey")
+
+	key, err := indexer(NewSimpleObj(nil, &CounterWithID{PrimaryKey: []byte("pk")}))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("pk"), key)
+
+	key, err = indexer(NewSimpleObj(nil, &CounterWithID{}))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(nil), key)
+
+	if _, err := indexer(NewSimpleObj(nil, &Counter{})); !errors.ErrType.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, err := indexer(nil); !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestAutoIndexField(t *testing.T) {
+	type model struct {
+		Name    string
+		Owner   weave.Address
+		Raw     []byte
+		Count   int64
+		Strings []string
+	}
+	tp := reflect.TypeOf(model{})
+
+	cases := map[string]*errors.Error{
+		"Name":    nil,
+		"Owner":   nil,
+		"Raw":     nil,
+		"Count":   errors.ErrType,
+		"Strings": errors.ErrType,
+		"Missing": errors.ErrType,
+	}
+	for field, wantErr := range cases {
+		t.Run(field, func(t *testing.T) {
+			if _, err := autoIndexField(tp, field); !wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+
+	v := reflect.ValueOf(model{Name: "alice", Owner: weave.Address("addr")})
+	assert.Equal(t, []byte("alice"), autoIndexKey(v.FieldByName("Name")))
+	assert.Equal(t, []byte("addr"), autoIndexKey(v.FieldByName("Owner")))
+	assert.Equal(t, []byte(nil), autoIndexKey(v.FieldByName("Raw")))
+}
@@ -67,6 +67,34 @@ type Bucket interface {
 	//
 	// Panics if it an index with that name is already registered.
 	WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket
+
+	// WithVirtualIndex returns a copy of this bucket with given index.
+	// Index is not stored. Each lookup computes index values of all
+	// bucket entities, which makes it O(n). This implementation is
+	// suitable only for rarely queried values.
+	//
+	// Panics if it an index with that name is already registered.
+	WithVirtualIndex(name string, indexer MultiKeyIndexer) Bucket
+
+	// WithLazyIndex returns a copy of this bucket with given index. Index
+	// is maintained as a single set, but it cannot be used until all
+	// entities are indexed using RebuildIndexChunk. This allows to add
+	// an index to a bucket that already contains many entities.
+	//
+	// Panics if it an index with that name is already registered.
+	WithLazyIndex(name string, indexer MultiKeyIndexer, unique bool) Bucket
+
+	// WithLazyNativeIndex returns a copy of this bucket with given index.
+	// Index is maintained using database native support, but it cannot
+	// be used until all entities are indexed using RebuildIndexChunk.
+	//
+	// Panics if it an index with that name is already registered.
+	WithLazyNativeIndex(name string, indexer MultiKeyIndexer) Bucket
+
+	// WithValueCompression returns a copy of this bucket that stores
+	// compressed values. Values of buckets that do not compress are
+	// decompressed when read as well.
+	WithValueCompression() Bucket
 }
 
 // bucket is a generic holder that stores data as well
@@ -83,6 +111,8 @@ type bucket struct {
 	model  reflect.Type
 	// index is a list of indexes sorted by
 	indexes boundIndexes
+	// compress is true if values are stored compressed.
+	compress bool
 }
 
 var _ Bucket = (*bucket)(nil)
@@ -132,12 +162,25 @@ func (b bucket) Register(name string, r weave.QueryRouter) {
 	root := "/" + name
 	r.Register(root, b)
 	for _, ni := range b.indexes {
-		r.Register(root+"/"+ni.publicName, ni.idx)
+		r.Register(root+"/"+ni.publicName, decompressingQuerier{ni.idx})
 	}
 }
 
 // Query handles queries from the QueryRouter.
 func (b bucket) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := b.query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	// The root bucket exposes raw database entries that are not
+	// necessarily models and therefore cannot be compressed.
+	if len(b.prefix) == 0 {
+		return models, nil
+	}
+	return decompressModels(models)
+}
+
+func (b bucket) query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
 	switch mod {
 	case weave.KeyQueryMod:
 		key := b.DBKey(data)
@@ -254,6 +297,10 @@ func (b bucket) Get(db weave.ReadOnlyKVStore, key []byte) (Object, error) {
 // It is exposed mainly as a test helper, but can work for
 // any code that wants to parse
 func (b bucket) Parse(key, value []byte) (Object, error) {
+	value, err := decompressValue(value)
+	if err != nil {
+		return nil, err
+	}
 	entity := reflect.New(b.model).Interface().(Model)
 	if err := entity.Unmarshal(value); err != nil {
 		// If the deserialization fails, this is due to corrupted data
@@ -276,6 +323,11 @@ func (b bucket) Save(db weave.KVStore, model Object) error {
 	if err != nil {
 		return err
 	}
+	if b.compress {
+		if bz, err = compressValue(bz); err != nil {
+			return errors.Wrap(err, "compress")
+		}
+	}
 	err = b.updateIndexes(db, model.Key(), model)
 	if err != nil {
 		return err
@@ -338,6 +390,65 @@ func (b bucket) WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket {
 	return b
 }
 
+func (b bucket) WithVirtualIndex(name string, indexer MultiKeyIndexer) Bucket {
+	if b.indexes.Has(name) {
+		panic(fmt.Sprintf("Index %s registered twice", name))
+	}
+
+	iname := b.name + "_" + name
+	idxs := append(b.indexes, bucketBoundIndex{
+		idx:        newVirtualIndex(iname, indexer, b.prefix, b.Parse),
+		publicName: name,
+	})
+	sort.Slice(idxs, func(i int, j int) bool {
+		return idxs[i].idx.Name() < idxs[j].idx.Name()
+	})
+	b.indexes = idxs
+	return b
+}
+
+func (b bucket) WithLazyIndex(name string, indexer MultiKeyIndexer, unique bool) Bucket {
+	if b.indexes.Has(name) {
+		panic(fmt.Sprintf("Index %s registered twice", name))
+	}
+
+	iname := b.name + "_" + name
+	idxs := append(b.indexes, bucketBoundIndex{
+		idx:        newLazyIndex(NewMultiKeyIndex(iname, indexer, unique, b.DBKey), b.prefix, b.Parse),
+		publicName: name,
+	})
+	sort.Slice(idxs, func(i int, j int) bool {
+		return idxs[i].idx.Name() < idxs[j].idx.Name()
+	})
+	b.indexes = idxs
+	return b
+}
+
+func (b bucket) WithLazyNativeIndex(name string, indexer MultiKeyIndexer) Bucket {
+	if b.indexes.Has(name) {
+		panic(fmt.Sprintf("Index %s registered twice", name))
+	}
+
+	iname := b.name + "_" + name
+	idxs := append(b.indexes, bucketBoundIndex{
+		idx:        newLazyIndex(NewNativeIndex(iname, indexer, b.DBKey), b.prefix, b.Parse),
+		publicName: name,
+	})
+	sort.Slice(idxs, func(i int, j int) bool {
+		return idxs[i].idx.Name() < idxs[j].idx.Name()
+	})
+	b.indexes = idxs
+	return b
+}
+
+// WithValueCompression returns a copy of this bucket that stores snappy
+// compressed values. See the WithValueCompression model bucket option for
+// the compatibility requirements.
+func (b bucket) WithValueCompression() Bucket {
+	b.compress = true
+	return b
+}
+
 // WithIndex returns a copy of this bucket with given index,
 // panics if it an index with that name is already registered.
 //
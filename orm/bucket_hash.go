@@ -0,0 +1,47 @@
+package orm
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// BucketHash returns a SHA-256 digest of all entities stored in the bucket
+// with the given name. Entities are processed in the key order and each key
+// (without the bucket prefix) and raw value is folded into the digest,
+// prefixed with its length. Two databases with the same bucket content
+// always produce the same digest.
+//
+// Only the primary data is hashed, index entries are not included. Use this
+// function to debug a divergence of a single bucket. It is not a replacement
+// for the application hash and its result cannot be proved.
+func BucketHash(db weave.ReadOnlyKVStore, bucketName string) ([]byte, error) {
+	// This is how Bucket.DBKey is implemented.
+	prefix := []byte(bucketName + ":")
+	start, end := prefixRange(prefix)
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	h := sha256.New()
+	var size [8]byte
+	for {
+		key, value, err := it.Next()
+		switch {
+		case err == nil:
+			for _, b := range [][]byte{key[len(prefix):], value} {
+				binary.BigEndian.PutUint64(size[:], uint64(len(b)))
+				_, _ = h.Write(size[:])
+				_, _ = h.Write(b)
+			}
+		case errors.ErrIteratorDone.Is(err):
+			return h.Sum(nil), nil
+		default:
+			return nil, errors.Wrap(err, "iterate")
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package orm
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBucketHash(t *testing.T) {
+	hash := func(entries ...string) []byte {
+		t.Helper()
+		db := store.MemStore()
+		for i := 0; i < len(entries); i += 2 {
+			assert.Nil(t, db.Set([]byte(entries[i]), []byte(entries[i+1])))
+		}
+		h, err := BucketHash(db, "cnt")
+		assert.Nil(t, err)
+		return h
+	}
+
+	empty := sha256.Sum256(nil)
+	assert.Equal(t, empty[:], hash())
+	assert.Equal(t, empty[:], hash("cnts:a", "1", "cn:a", "1", "_i.cnt_idx:a", "1"))
+
+	base := hash("cnt:a", "1", "cnt:b", "2")
+	// Insertion order and content of other buckets does not matter.
+	assert.Equal(t, base, hash("cnt:b", "2", "cnt:a", "1"))
+	assert.Equal(t, base, hash("cnt:a", "1", "cnts:a", "x", "cnt:b", "2", "_i.cnt_idx:a", "y"))
+
+	differ := map[string][]byte{
+		"other value":    hash("cnt:a", "1", "cnt:b", "3"),
+		"other key":      hash("cnt:a", "1", "cnt:c", "2"),
+		"missing entity": hash("cnt:a", "1"),
+		"moved boundary": hash("cnt:a", "12", "cnt:b", ""),
+	}
+	for name, h := range differ {
+		if string(h) == string(base) {
+			t.Errorf("%s: hash must differ", name)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// Operations reported to a ChangeListener.
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+)
+
+// ChangeListener is notified about every entity change done using a
+// ModelBucket. Operation is one of ChangeCreate, ChangeUpdate or ChangeDelete.
+// Old model is nil when an entity is created and new model is nil when an
+// entity is deleted.
+//
+// Listener is called within the same transaction as the change, with the
+// database that the change is written to, so that it can maintain a derived
+// state, for example a counter stored in another bucket. Returning an error
+// aborts the operation.
+type ChangeListener func(db weave.KVStore, op string, key []byte, old, new Model) error
+
+// WithChangeListener configures the bucket to call given listener on each
+// Put, Delete and DeleteMany. Many listeners can be registered. They are
+// called in the order of registration.
+//
+// If the database supports cache wrapping, a bucket with listeners executes
+// each operation within a cache wrap, that is written only if the operation
+// and all listeners succeeded. Otherwise listeners are called before each
+// change is written, so a listener error prevents the change, but not the
+// writes done by the listeners that were already called.
+func WithChangeListener(fn ChangeListener) ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.listeners = append(mb.listeners, fn)
+	}
+}
+
+// atomic calls given function. If the bucket has listeners and the database
+// supports it, the function is called with a cache wrap of the database, that
+// is written only if the function succeeded.
+func (mb *modelBucket) atomic(db weave.KVStore, fn func(weave.KVStore) error) error {
+	if len(mb.listeners) == 0 {
+		return fn(db)
+	}
+	c, ok := db.(weave.CacheableKVStore)
+	if !ok {
+		return fn(db)
+	}
+	cache := c.CacheWrap()
+	if err := fn(cache); err != nil {
+		cache.Discard()
+		return err
+	}
+	return cache.Write()
+}
+
+// applyChange writes the change of the entity with given key using given
+// function and notifies all listeners. New model is nil when the entity is
+// deleted.
+func (mb *modelBucket) applyChange(db weave.KVStore, key []byte, next Model, write func(weave.KVStore) error) error {
+	if len(mb.listeners) == 0 {
+		return write(db)
+	}
+
+	obj, err := mb.b.Get(db, key)
+	if err != nil {
+		return errors.Wrap(err, "cannot load stored entity")
+	}
+	var prev Model
+	if obj != nil && obj.Value() != nil {
+		prev = obj.Value().(Model)
+	}
+	op := ChangeUpdate
+	switch {
+	case next == nil:
+		op = ChangeDelete
+	case prev == nil:
+		op = ChangeCreate
+	}
+
+	if _, ok := db.(weave.CacheableKVStore); !ok {
+		// Changes cannot be rolled back, so the change is written
+		// only if all listeners succeeded.
+		if err := mb.notify(db, op, key, prev, next); err != nil {
+			return err
+		}
+		return write(db)
+	}
+	// Within a cache wrap created by atomic.
+	if err := write(db); err != nil {
+		return err
+	}
+	return mb.notify(db, op, key, prev, next)
+}
+
+// notify calls all listeners with given change.
+func (mb *modelBucket) notify(db weave.KVStore, op string, key []byte, prev, next Model) error {
+	for _, fn := range mb.listeners {
+		if err := fn(db, op, key, prev, next); err != nil {
+			return errors.Wrapf(err, "%s change listener", op)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package orm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestModelBucketChangeListener(t *testing.T) {
+	db := store.MemStore()
+
+	// Total of all counters is maintained in another bucket.
+	totals := NewModelBucket("totals", &Counter{})
+	totalKey := []byte("total")
+	var changes []string
+	maintainTotal := func(db weave.KVStore, op string, key []byte, old, new Model) error {
+		changes = append(changes, fmt.Sprintf("%s %x", op, key))
+		var total Counter
+		if err := totals.One(db, totalKey, &total); err != nil && !errors.ErrNotFound.Is(err) {
+			return err
+		}
+		if old != nil {
+			total.Count -= old.(*Counter).Count
+		}
+		if new != nil {
+			total.Count += new.(*Counter).Count
+		}
+		if total.Count == 0 {
+			// Total is removed once there are no counters.
+			return totals.Delete(db, totalKey)
+		}
+		_, err := totals.Put(db, totalKey, &total)
+		return err
+	}
+	rejectBig := func(db weave.KVStore, op string, key []byte, old, new Model) error {
+		if new != nil && new.(*Counter).Count > 100 {
+			return errors.Wrap(errors.ErrInput, "too big")
+		}
+		return nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithChangeListener(maintainTotal),
+		WithChangeListener(rejectBig),
+	)
+
+	assertTotal := func(want int64) {
+		t.Helper()
+		var total Counter
+		err := totals.One(db, totalKey, &total)
+		if want == 0 {
+			if !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want no total, got %v, %+v", total, err)
+			}
+			return
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, want, total.Count)
+	}
+
+	_, err := b.Put(db, nil, &Counter{Count: 10})
+	assert.Nil(t, err)
+	_, err = b.Put(db, nil, &Counter{Count: 20})
+	assert.Nil(t, err)
+	assertTotal(30)
+
+	_, err = b.Put(db, weavetest.SequenceID(1), &Counter{Count: 15})
+	assert.Nil(t, err)
+	assertTotal(35)
+
+	// Listener error aborts the change. Writes done by the listeners
+	// called before are not applied either.
+	if _, err := b.Put(db, weavetest.SequenceID(2), &Counter{Count: 500}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	if _, err := b.Put(db, nil, &Counter{Count: 500}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	assertTotal(35)
+	var c Counter
+	assert.Nil(t, b.One(db, weavetest.SequenceID(2), &c))
+	assert.Equal(t, int64(20), c.Count)
+
+	assert.Nil(t, b.Delete(db, weavetest.SequenceID(1)))
+	assertTotal(20)
+
+	n, err := b.DeleteMany(db, [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(9)})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+	assertTotal(0)
+
+	assert.Equal(t, []string{
+		"create 0000000000000001",
+		"create 0000000000000002",
+		"update 0000000000000001",
+		"update 0000000000000002",
+		"create 0000000000000003",
+		"delete 0000000000000001",
+		"delete 0000000000000002",
+	}, changes)
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
 	io "io"
 	math "math"
 )
@@ -220,33 +221,91 @@ func (m *CounterWithID) GetCount() int64 {
 	return 0
 }
 
+// TimedCounter is a counter with a creation time, mainly just for test
+type TimedCounter struct {
+	Count     int64                             `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+}
+
+func (m *TimedCounter) Reset()         { *m = TimedCounter{} }
+func (m *TimedCounter) String() string { return proto.CompactTextString(m) }
+func (*TimedCounter) ProtoMessage()    {}
+func (*TimedCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aef1e59ada91b17, []int{4}
+}
+func (m *TimedCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedCounter.Merge(m, src)
+}
+func (m *TimedCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedCounter proto.InternalMessageInfo
+
+func (m *TimedCounter) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TimedCounter) GetCreatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MultiRef)(nil), "orm.MultiRef")
 	proto.RegisterType((*Counter)(nil), "orm.Counter")
 	proto.RegisterType((*VersionedIDRef)(nil), "orm.VersionedIDRef")
 	proto.RegisterType((*CounterWithID)(nil), "orm.CounterWithID")
+	proto.RegisterType((*TimedCounter)(nil), "orm.TimedCounter")
 }
 
 func init() { proto.RegisterFile("orm/codec.proto", fileDescriptor_4aef1e59ada91b17) }
 
 var fileDescriptor_4aef1e59ada91b17 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcf, 0x2f, 0xca, 0xd5,
-	0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xce, 0x2f, 0xca,
-	0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xf3, 0xf5, 0x41, 0x2c, 0x88, 0x94, 0x92, 0x1c, 0x17,
-	0x87, 0x6f, 0x69, 0x4e, 0x49, 0x66, 0x50, 0x6a, 0x9a, 0x90, 0x10, 0x17, 0x4b, 0x51, 0x6a, 0x5a,
-	0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x4f, 0x10, 0x98, 0xad, 0x24, 0xcf, 0xc5, 0xee, 0x9c, 0x5f,
-	0x9a, 0x57, 0x92, 0x5a, 0x24, 0x24, 0xc2, 0xc5, 0x9a, 0x0c, 0x62, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0x30, 0x07, 0x41, 0x38, 0x4a, 0x4e, 0x5c, 0x7c, 0x61, 0xa9, 0x45, 0xc5, 0x99, 0xf9, 0x79, 0xa9,
-	0x29, 0x9e, 0x2e, 0x20, 0x63, 0xc4, 0xb8, 0x98, 0x32, 0x53, 0x24, 0x58, 0x14, 0x18, 0x35, 0x78,
-	0x9c, 0xd8, 0x1e, 0xdd, 0x93, 0x67, 0xf2, 0x74, 0x09, 0x62, 0xca, 0x4c, 0x11, 0x92, 0xe0, 0x62,
-	0x2f, 0x83, 0xa8, 0x94, 0x60, 0x55, 0x60, 0xd4, 0xe0, 0x0d, 0x82, 0x71, 0x95, 0xdc, 0xb8, 0x78,
-	0xa1, 0x96, 0x84, 0x67, 0x96, 0x64, 0x78, 0xba, 0x08, 0xc9, 0x73, 0x71, 0x17, 0x14, 0x65, 0xe6,
-	0x26, 0x16, 0x55, 0xc6, 0x67, 0xa7, 0x56, 0x82, 0x2d, 0xe4, 0x09, 0xe2, 0x82, 0x0a, 0x79, 0xa7,
-	0x56, 0x22, 0xdc, 0xc2, 0x84, 0xe4, 0x16, 0x27, 0x89, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x48, 0x62, 0x03, 0xfb, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x09, 0x01, 0x9a,
-	0x1d, 0x1b, 0x01, 0x00, 0x00,
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x3b, 0xe9, 0xd7, 0xff, 0x7f, 0x4d, 0x15, 0x86, 0x22, 0xc1, 0xc5, 0x24, 0x06, 0x84,
+	0x6c, 0x6c, 0x16, 0x3e, 0x81, 0x31, 0x08, 0x45, 0xdc, 0x0c, 0x7e, 0x2c, 0x4b, 0x9a, 0xdc, 0xb6,
+	0xa3, 0x26, 0x53, 0xa6, 0xd3, 0x6a, 0xdf, 0xc2, 0xc7, 0x72, 0xd9, 0xa5, 0xab, 0x22, 0xe9, 0x5b,
+	0xb8, 0x92, 0x7c, 0x88, 0xae, 0xdc, 0x9d, 0x33, 0x73, 0xef, 0x39, 0x3f, 0x2e, 0x1c, 0x48, 0x95,
+	0xfa, 0xb1, 0x4c, 0x30, 0x1e, 0xcc, 0x95, 0xd4, 0x92, 0x36, 0xa5, 0x4a, 0x8f, 0xfa, 0x53, 0x39,
+	0x95, 0xa5, 0xf7, 0x0b, 0x55, 0x7d, 0xb9, 0x0c, 0xfe, 0x5d, 0x2f, 0x9f, 0xb4, 0xe0, 0x38, 0xa1,
+	0x14, 0x5a, 0x0a, 0x27, 0x0b, 0x8b, 0x38, 0x4d, 0xcf, 0xe4, 0xa5, 0x76, 0x6d, 0xe8, 0x5e, 0xc8,
+	0x65, 0xa6, 0x51, 0xd1, 0x3e, 0xb4, 0xe3, 0x42, 0x5a, 0xc4, 0x21, 0x5e, 0x93, 0x57, 0xc6, 0x0d,
+	0x60, 0xff, 0x0e, 0xd5, 0x42, 0xc8, 0x0c, 0x93, 0x61, 0x58, 0xc4, 0x1c, 0x82, 0x21, 0x12, 0xab,
+	0xe5, 0x10, 0xcf, 0x0c, 0x3a, 0xf9, 0xd6, 0x36, 0x86, 0x21, 0x37, 0x44, 0x42, 0x2d, 0xe8, 0xae,
+	0xaa, 0x49, 0xab, 0xed, 0x10, 0xaf, 0xc7, 0xbf, 0xad, 0x7b, 0x09, 0xbd, 0xba, 0xe4, 0x5e, 0xe8,
+	0xd9, 0x30, 0xa4, 0x36, 0xec, 0xcd, 0x95, 0x48, 0x23, 0xb5, 0x1e, 0x3d, 0xe2, 0xba, 0x2c, 0x34,
+	0x39, 0xd4, 0x4f, 0x57, 0xb8, 0xfe, 0x61, 0x31, 0x7e, 0xb3, 0x3c, 0x80, 0x79, 0x23, 0x52, 0x4c,
+	0xfe, 0x24, 0xa6, 0x21, 0x40, 0xac, 0x30, 0xd2, 0x98, 0x8c, 0xa2, 0x3a, 0x20, 0x38, 0xf9, 0xdc,
+	0xda, 0xc7, 0x53, 0xa1, 0x67, 0xcb, 0xf1, 0x20, 0x96, 0xa9, 0x2f, 0xe4, 0xea, 0x54, 0x66, 0xe8,
+	0x3f, 0x63, 0xb4, 0xc2, 0xc1, 0x6d, 0x26, 0x5e, 0x8a, 0x60, 0xfe, 0xbf, 0x5e, 0x3c, 0xd7, 0x81,
+	0xf5, 0x96, 0x33, 0xb2, 0xc9, 0x19, 0xf9, 0xc8, 0x19, 0x79, 0xdd, 0xb1, 0xc6, 0x66, 0xc7, 0x1a,
+	0xef, 0x3b, 0xd6, 0x18, 0x77, 0xca, 0xcb, 0x9e, 0x7d, 0x0d, 0x00, 0xdb, 0xfd, 0x3b, 0x48, 0x87,
+	0x01, 0x00, 0x00,
 }
 
 func (m *MultiRef) Marshal() (dAtA []byte, err error) {
@@ -356,6 +415,34 @@ func (m *CounterWithID) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TimedCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedCounter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Count))
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -424,6 +511,21 @@ func (m *CounterWithID) Size() (n int) {
 	return n
 }
 
+func (m *TimedCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovCodec(uint64(m.Count))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -806,6 +908,97 @@ func (m *CounterWithID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimedCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
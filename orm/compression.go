@@ -0,0 +1,68 @@
+package orm
+
+import (
+	"github.com/golang/snappy"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// compressedValueFlag is the header byte of each compressed value. It is
+// never the first byte of a serialized protobuf message, because both the
+// field number zero and the wire type 7 are invalid. This allows compressed
+// and not compressed values to be stored in the same bucket.
+const compressedValueFlag byte = 0x07
+
+// compressValue returns the snappy block encoded representation of given
+// serialized model, prefixed with the compressed value header. If compression
+// does not reduce the size, the value is returned unchanged.
+//
+// Compressed values are part of the consensus state. The snappy block format
+// has no headers and the encoder depends only on the snappy version pinned
+// in go.mod, not on the Go version used to build the binary.
+func compressValue(raw []byte) ([]byte, error) {
+	value := make([]byte, 1+snappy.MaxEncodedLen(len(raw)))
+	value[0] = compressedValueFlag
+	encoded := snappy.Encode(value[1:], raw)
+	if 1+len(encoded) >= len(raw) {
+		return raw, nil
+	}
+	return value[:1+len(encoded)], nil
+}
+
+// decompressValue returns the serialized model stored as given value. Values
+// that are not compressed are returned unchanged.
+func decompressValue(value []byte) ([]byte, error) {
+	if len(value) == 0 || value[0] != compressedValueFlag {
+		return value, nil
+	}
+	raw, err := snappy.Decode(nil, value[1:])
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+	return raw, nil
+}
+
+// decompressingQuerier wraps a query handler so that all returned values are
+// decompressed.
+type decompressingQuerier struct {
+	weave.QueryHandler
+}
+
+func (q decompressingQuerier) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := q.QueryHandler.Query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+	return decompressModels(models)
+}
+
+func decompressModels(models []weave.Model) ([]weave.Model, error) {
+	for i, m := range models {
+		value, err := decompressValue(m.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value of %q", m.Key)
+		}
+		models[i].Value = value
+	}
+	return models, nil
+}
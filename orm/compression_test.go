@@ -0,0 +1,158 @@
+package orm
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestModelBucketValueCompression(t *testing.T) {
+	db := store.MemStore()
+
+	blob := &MultiRef{Refs: [][]byte{bytes.Repeat([]byte("compress me "), 100)}}
+	small := &MultiRef{Refs: [][]byte{[]byte("x")}}
+
+	plain := NewModelBucket("refs", &MultiRef{})
+	compressed := NewModelBucket("refs", &MultiRef{}, WithValueCompression())
+
+	if _, err := plain.Put(db, []byte("old"), blob); err != nil {
+		t.Fatalf("cannot store not compressed value: %s", err)
+	}
+	if _, err := compressed.Put(db, []byte("blob"), blob); err != nil {
+		t.Fatalf("cannot store compressed value: %s", err)
+	}
+	if _, err := compressed.Put(db, []byte("small"), small); err != nil {
+		t.Fatalf("cannot store small value: %s", err)
+	}
+
+	raw, err := blob.Marshal()
+	assert.Nil(t, err)
+
+	stored, err := db.Get(compressed.DBKey([]byte("blob")))
+	assert.Nil(t, err)
+	if stored[0] != compressedValueFlag {
+		t.Fatalf("want compressed value header, got %X", stored[0])
+	}
+	if len(stored) >= len(raw) {
+		t.Fatalf("want compressed value to be shorter than %d, got %d", len(raw), len(stored))
+	}
+
+	stored, err = db.Get(compressed.DBKey([]byte("small")))
+	assert.Nil(t, err)
+	if raw, _ := small.Marshal(); !bytes.Equal(stored, raw) {
+		t.Fatalf("small value must be stored unchanged, got %X", stored)
+	}
+
+	// Both buckets read all values, no matter how they were stored.
+	for _, b := range []ModelBucket{plain, compressed} {
+		for _, key := range []string{"old", "blob"} {
+			var got MultiRef
+			if err := b.One(db, []byte(key), &got); err != nil {
+				t.Fatalf("cannot get %q: %s", key, err)
+			}
+			assert.Equal(t, blob, &got)
+		}
+	}
+
+	qr := weave.NewQueryRouter()
+	compressed.Register("refs", qr)
+	models, err := qr.Handler("/refs").Query(db, weave.PrefixQueryMod, nil)
+	assert.Nil(t, err)
+	if len(models) != 3 {
+		t.Fatalf("want 3 models, got %d", len(models))
+	}
+	for _, m := range models {
+		if m.Value[0] == compressedValueFlag {
+			t.Fatalf("query returned compressed value of %q", m.Key)
+		}
+	}
+
+	// Root query returns raw database values, that are not decompressed.
+	assert.Nil(t, db.Set([]byte("raw"), []byte{compressedValueFlag, 1, 2}))
+	RegisterQuery(qr)
+	models, err = qr.Handler("/").Query(db, weave.KeyQueryMod, []byte("raw"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{compressedValueFlag, 1, 2}, models[0].Value)
+}
+
+func TestDecompressValue(t *testing.T) {
+	raw := bytes.Repeat([]byte{1, 2, 3, 4}, 100)
+	value, err := compressValue(raw)
+	assert.Nil(t, err)
+	got, err := decompressValue(value)
+	assert.Nil(t, err)
+	assert.Equal(t, raw, got)
+
+	if _, err := decompressValue(value[:len(value)/2]); err == nil {
+		t.Fatal("truncated value must not decompress")
+	}
+}
+
+// TestCompressValueIsStable pins the compressed representation of a value.
+// Compressed values are part of the consensus state. If this test fails, the
+// encoder output changed and nodes running the new and the old binary cannot
+// run the same network.
+func TestCompressValueIsStable(t *testing.T) {
+	value, err := compressValue(bytes.Repeat([]byte("weave"), 20))
+	assert.Nil(t, err)
+	const want = "0764107765617665fe05007a0500"
+	assert.Equal(t, want, hex.EncodeToString(value))
+}
+
+func BenchmarkModelBucketValueCompression(b *testing.B) {
+	random := make([]byte, 4096)
+	if _, err := rand.Read(random); err != nil {
+		b.Fatalf("cannot read random data: %s", err)
+	}
+	payloads := []struct {
+		name string
+		data []byte
+	}{
+		{name: "text", data: bytes.Repeat([]byte("a well compressing text blob "), 140)},
+		{name: "random", data: random},
+	}
+	for _, p := range payloads {
+		name, payload := p.name, p.data
+		for _, compress := range []bool{false, true} {
+			var opts []ModelBucketOption
+			if compress {
+				opts = append(opts, WithValueCompression())
+			}
+			bucket := NewModelBucket("refs", &MultiRef{}, opts...)
+			model := &MultiRef{Refs: [][]byte{payload}}
+
+			b.Run(fmt.Sprintf("%s_compress_%v_put", name, compress), func(b *testing.B) {
+				db := store.MemStore()
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := bucket.Put(db, []byte("key"), model); err != nil {
+						b.Fatalf("put: %s", err)
+					}
+				}
+				stored, _ := db.Get(bucket.DBKey([]byte("key")))
+				b.ReportMetric(float64(len(stored)), "stored-bytes")
+			})
+
+			b.Run(fmt.Sprintf("%s_compress_%v_one", name, compress), func(b *testing.B) {
+				db := store.MemStore()
+				if _, err := bucket.Put(db, []byte("key"), model); err != nil {
+					b.Fatalf("put: %s", err)
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					var got MultiRef
+					if err := bucket.One(db, []byte("key"), &got); err != nil {
+						b.Fatalf("one: %s", err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -12,3 +12,10 @@ func (c *CounterWithID) SetPrimaryKey(pk []byte) error {
 func (c *CounterWithID) Validate() error {
 	return nil
 }
+
+var _ Model = (*TimedCounter)(nil)
+
+// Validate is always succesful
+func (c *TimedCounter) Validate() error {
+	return nil
+}
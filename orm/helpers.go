@@ -1,8 +1,10 @@
 package orm
 
 import (
+	"math"
 	"reflect"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -67,3 +69,55 @@ func ToSlice(iter SerialModelIterator, destination SerialModelSlicePtr) error {
 		dest.Set(reflect.Append(dest, reflect.ValueOf(d)))
 	}
 }
+
+// IncrementField loads the model with given key, adds delta to the value of
+// its integer field with given name and stores the model. Model is validated
+// before storing. New value of the field is returned.
+// This function returns ErrNotFound if the model does not exist and ErrType if
+// the field is not an integer. ErrOverflow is returned if the new value
+// cannot be represented by the field type.
+func IncrementField(db weave.KVStore, mb ModelBucket, key []byte, field string, delta int64) (int64, error) {
+	m := mb.NewModel()
+	if err := mb.One(db, key, m); err != nil {
+		return 0, errors.Wrap(err, "load model")
+	}
+
+	v := reflect.ValueOf(m).Elem().FieldByName(field)
+	if !v.IsValid() {
+		return 0, errors.Wrapf(errors.ErrInput, "%T model has no %q field", m, field)
+	}
+
+	var value int64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		cur := v.Int()
+		if (delta > 0 && cur > math.MaxInt64-delta) || (delta < 0 && cur < math.MinInt64-delta) {
+			return 0, errors.Wrapf(errors.ErrOverflow, "%q field", field)
+		}
+		value = cur + delta
+		if v.OverflowInt(value) {
+			return 0, errors.Wrapf(errors.ErrOverflow, "%q field", field)
+		}
+		v.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		cur := v.Uint()
+		if cur > math.MaxInt64 {
+			return 0, errors.Wrapf(errors.ErrOverflow, "%q field", field)
+		}
+		if (delta > 0 && int64(cur) > math.MaxInt64-delta) || int64(cur)+delta < 0 {
+			return 0, errors.Wrapf(errors.ErrOverflow, "%q field", field)
+		}
+		value = int64(cur) + delta
+		if v.OverflowUint(uint64(value)) {
+			return 0, errors.Wrapf(errors.ErrOverflow, "%q field", field)
+		}
+		v.SetUint(uint64(value))
+	default:
+		return 0, errors.Wrapf(errors.ErrType, "%q field is %s, not an integer", field, v.Kind())
+	}
+
+	if _, err := mb.Put(db, key, m); err != nil {
+		return 0, errors.Wrap(err, "store model")
+	}
+	return value, nil
+}
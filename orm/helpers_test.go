@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -130,3 +131,95 @@ func TestToSlice(t *testing.T) {
 		t.Errorf("values do not match, expected: %+v, got: %+v", expected, dest)
 	}
 }
+
+func TestIncrementField(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &CounterWithID{})
+	_, err := b.Put(db, []byte("c1"), &CounterWithID{Count: 5})
+	assert.Nil(t, err)
+
+	// Test cases are applied in order, each one modifying the state.
+	cases := []struct {
+		Name      string
+		Key       []byte
+		Field     string
+		Delta     int64
+		WantValue int64
+		WantErr   *errors.Error
+	}{
+		{
+			Name:      "increment",
+			Key:       []byte("c1"),
+			Field:     "Count",
+			Delta:     3,
+			WantValue: 8,
+		},
+		{
+			Name:      "decrement",
+			Key:       []byte("c1"),
+			Field:     "Count",
+			Delta:     -10,
+			WantValue: -2,
+		},
+		{
+			Name:    "overflow",
+			Key:     []byte("c1"),
+			Field:   "Count",
+			Delta:   math.MinInt64,
+			WantErr: errors.ErrOverflow,
+		},
+		{
+			Name:    "not an integer field",
+			Key:     []byte("c1"),
+			Field:   "PrimaryKey",
+			Delta:   1,
+			WantErr: errors.ErrType,
+		},
+		{
+			Name:    "unknown field",
+			Key:     []byte("c1"),
+			Field:   "Unknown",
+			Delta:   1,
+			WantErr: errors.ErrInput,
+		},
+		{
+			Name:    "not found",
+			Key:     []byte("unknown"),
+			Field:   "Count",
+			Delta:   1,
+			WantErr: errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := IncrementField(db, b, tc.Key, tc.Field, tc.Delta)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.WantValue, got)
+
+			var c CounterWithID
+			assert.Nil(t, b.One(db, tc.Key, &c))
+			assert.Equal(t, tc.WantValue, c.Count)
+		})
+	}
+}
+
+func TestIncrementFieldValidates(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	_, err := b.Put(db, []byte("c1"), &Counter{Count: 1})
+	assert.Nil(t, err)
+
+	// Counter cannot be negative.
+	if _, err := IncrementField(db, b, []byte("c1"), "Count", -2); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var c Counter
+	assert.Nil(t, b.One(db, []byte("c1"), &c))
+	assert.Equal(t, int64(1), c.Count)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math"
+	"sort"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -34,6 +35,91 @@ type Index interface {
 	Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error)
 }
 
+// indexWalker is implemented by indexes that can enumerate all stored
+// entries. It is used to verify index consistency.
+type indexWalker interface {
+	// walk calls given function for every entity reference stored by the
+	// index. Function is called with the database key of the index entry,
+	// the indexed value and the referenced entity key.
+	walk(db weave.ReadOnlyKVStore, fn func(dbKey, value, ref []byte) error) error
+
+	// values returns all index values computed for given object.
+	values(obj Object) ([][]byte, error)
+}
+
+// seekIndex is implemented by indexes that can list the entity keys indexed
+// under a value starting from a given position, without iterating over the
+// keys before it.
+type seekIndex interface {
+	// keysAfter works like Keys, but returns only the keys that follow
+	// given key in the index order. An empty after value returns all keys.
+	keysAfter(db weave.ReadOnlyKVStore, value, after []byte) weave.Iterator
+}
+
+// isUniqueIndex returns true if given index enforces a unique constraint.
+func isUniqueIndex(idx Index) bool {
+	if l, ok := idx.(*lazyIndex); ok {
+		idx = l.Index
+	}
+	c, ok := idx.(compactIndex)
+	return ok && c.unique
+}
+
+// ensureUnique returns ErrDuplicate if saving given object would violate the
+// unique constraint of given index. The database is not modified. Indexes
+// that do not enforce a unique constraint never return an error.
+func ensureUnique(db weave.ReadOnlyKVStore, idx Index, obj Object) error {
+	if l, ok := idx.(*lazyIndex); ok {
+		ready, last, err := l.state(db)
+		if err != nil {
+			return err
+		}
+		if !ready && (len(last) == 0 || bytes.Compare(obj.Key(), last) > 0) {
+			// Not indexed yet, so the constraint is not enforced.
+			return nil
+		}
+		idx = l.Index
+	}
+	c, ok := idx.(compactIndex)
+	if !ok || !c.unique {
+		return nil
+	}
+	values, err := c.index(obj)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		ref, err := db.Get(c.indexKey(value))
+		if err != nil {
+			return err
+		}
+		if ref != nil && !bytes.Equal(ref, obj.Key()) {
+			return errors.Wrap(errors.ErrDuplicate, c.name)
+		}
+	}
+	return nil
+}
+
+// indexDBKey returns the raw database key under which given index stores an
+// entry for given value. Native index entries are suffixed with the
+// referenced entity key, so only their common prefix is returned.
+func indexDBKey(idx Index, value []byte) ([]byte, error) {
+	if l, ok := idx.(*lazyIndex); ok {
+		idx = l.Index
+	}
+	switch ix := idx.(type) {
+	case compactIndex:
+		return ix.indexKey(value), nil
+	case *nativeIndex:
+		return packNativeIdxKey([][]byte{[]byte(ix.name), value})
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "index %q is not stored in the database", idx.Name())
+	}
+}
+
 const compactIdxPrefix = "_i."
 
 // Indexer calculates the secondary index key for a given object
@@ -147,6 +233,41 @@ func (i compactIndex) Update(db weave.KVStore, prev Object, save Object) error {
 	return errors.Wrap(errors.ErrHuman, "you have violated the rules of boolean logic")
 }
 
+func (i compactIndex) walk(db weave.ReadOnlyKVStore, fn func(dbKey, value, ref []byte) error) error {
+	it, err := db.Iterator(prefixRange(i.id))
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	for {
+		key, val, err := it.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "iterator next")
+		}
+		refs := [][]byte{val}
+		if !i.unique {
+			var mref MultiRef
+			if err := mref.Unmarshal(val); err != nil {
+				return errors.Wrap(err, "unmarshal index MultiRef")
+			}
+			refs = mref.GetRefs()
+		}
+		for _, ref := range refs {
+			if err := fn(key, key[len(i.id):], ref); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (i compactIndex) values(obj Object) ([][]byte, error) {
+	return i.index(obj)
+}
+
 // Like calculates the index for the given pattern, and
 // returns a list of all pk that match (may be nil when empty), or an error
 func (i compactIndex) Like(db weave.ReadOnlyKVStore, pattern Object) ([][]byte, error) {
@@ -200,6 +321,36 @@ func (i compactIndex) Keys(db weave.ReadOnlyKVStore, index []byte) weave.Iterato
 	return &keysIterator{keys: data.GetRefs()}
 }
 
+// keysAfter returns entity keys indexed under given value that are greater
+// than after. Keys are returned in the ascending order.
+func (i compactIndex) keysAfter(db weave.ReadOnlyKVStore, index []byte, after []byte) weave.Iterator {
+	if len(after) == 0 {
+		return i.Keys(db, index)
+	}
+	val, err := db.Get(i.indexKey(index))
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+	if val == nil {
+		return &failedIterator{err: errors.ErrIteratorDone}
+	}
+	if i.unique {
+		if bytes.Compare(val, after) <= 0 {
+			return &failedIterator{err: errors.ErrIteratorDone}
+		}
+		return &keysIterator{keys: [][]byte{val}}
+	}
+
+	var data MultiRef
+	if err := data.Unmarshal(val); err != nil {
+		return &failedIterator{err: err}
+	}
+	// References are kept sorted.
+	refs := data.GetRefs()
+	pos := sort.Search(len(refs), func(n int) bool { return bytes.Compare(refs[n], after) > 0 })
+	return &keysIterator{keys: refs[pos:]}
+}
+
 type failedIterator struct {
 	err error
 }
@@ -660,6 +811,42 @@ func (ix *nativeIndex) Update(db weave.KVStore, prev Object, next Object) error
 	return nil
 }
 
+func (ix *nativeIndex) walk(db weave.ReadOnlyKVStore, fn func(dbKey, value, ref []byte) error) error {
+	prefix, err := packNativeIdxKey([][]byte{[]byte(ix.name)})
+	if err != nil {
+		return errors.Wrap(err, "build index key")
+	}
+	it, err := db.Iterator(prefixRange(prefix))
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	for {
+		key, _, err := it.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "iterator next")
+		}
+		chunks, err := unpackNativeIdxKey(key)
+		if err != nil {
+			return errors.Wrap(err, "unpack native index key")
+		}
+		if len(chunks) != 3 {
+			return errors.Wrapf(errors.ErrState, "malformed native index key %q", key)
+		}
+		if err := fn(key, chunks[1], chunks[2]); err != nil {
+			return err
+		}
+	}
+}
+
+func (ix *nativeIndex) values(obj Object) ([][]byte, error) {
+	return ix.indexer(obj)
+}
+
 func (ix *nativeIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterator {
 	lookupKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), value})
 	if err != nil {
@@ -701,6 +888,37 @@ func (ix *nativeIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterat
 	}
 }
 
+// keysAfter returns entity keys indexed under given value that follow after
+// in the index order. Native index keys are ordered by their length first and
+// then by their byte representation, so for keys of the same length this is
+// the ascending order.
+func (ix *nativeIndex) keysAfter(db weave.ReadOnlyKVStore, value, after []byte) weave.Iterator {
+	if len(after) == 0 {
+		return ix.Keys(db, value)
+	}
+	lookupKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), value})
+	if err != nil {
+		return &failedIterator{err: errors.Wrap(err, "build index key")}
+	}
+	afterKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), value, after})
+	if err != nil {
+		return &failedIterator{err: errors.Wrap(err, "build index key")}
+	}
+	// Entity key is the last chunk, so the next cursor of the after
+	// entry is the smallest key that follows it.
+	start := NextCursor(afterKey)
+	end := append(lookupKey, math.MaxUint8)
+
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+	return &nativeIndexIterator{
+		dbit:  it,
+		dbKey: func(b []byte) []byte { return b },
+	}
+}
+
 func (ix *nativeIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
 	switch mod {
 	case weave.KeyQueryMod:
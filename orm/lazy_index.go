@@ -0,0 +1,249 @@
+package orm
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// lazyIdxStatePrefix is the database key prefix of the build state of all
+// lazy indexes.
+const lazyIdxStatePrefix = "_ib."
+
+const (
+	// lazyIdxBuilding marks the build state of an index that is being
+	// built. It is followed by the primary key of the last indexed
+	// entity.
+	lazyIdxBuilding byte = 0
+	// lazyIdxReady marks the build state of an index that was fully built.
+	lazyIdxReady byte = 1
+)
+
+// newLazyIndex returns an index that is not usable until all entities stored
+// under given prefix are indexed using RebuildIndexChunk.
+func newLazyIndex(idx Index, prefix []byte, parse func(key, value []byte) (Object, error)) Index {
+	return &lazyIndex{
+		Index:    idx,
+		stateKey: []byte(lazyIdxStatePrefix + idx.Name()),
+		prefix:   prefix,
+		parse:    parse,
+	}
+}
+
+// lazyIndex is an index wrapper that allows to add an index to a bucket that
+// already contains entities. Entities are indexed in chunks by
+// RebuildIndexChunk, in the order of their primary keys. Until all entities
+// are indexed, a lookup fails with ErrState.
+//
+// While the index is being built, only entities that were already processed
+// by a rebuild are indexed on update. Remaining entities are indexed by a
+// later rebuild call.
+type lazyIndex struct {
+	Index
+	// stateKey is the database key under which the build state is stored.
+	stateKey []byte
+	// prefix is the database key prefix of all bucket entities.
+	prefix []byte
+	// parse deserializes an entity stored in the bucket. Given key is the
+	// entity key without the prefix.
+	parse func(key, value []byte) (Object, error)
+}
+
+var _ Index = (*lazyIndex)(nil)
+
+// state returns true if the index was fully built. If it was not, the
+// primary key of the last indexed entity is returned.
+func (ix *lazyIndex) state(db weave.ReadOnlyKVStore) (ready bool, last []byte, err error) {
+	raw, err := db.Get(ix.stateKey)
+	if err != nil {
+		return false, nil, errors.Wrap(err, "cannot load index state")
+	}
+	switch {
+	case len(raw) == 0:
+		// No rebuild was done yet.
+		return false, nil, nil
+	case raw[0] == lazyIdxReady:
+		return true, nil, nil
+	case raw[0] == lazyIdxBuilding:
+		return false, raw[1:], nil
+	default:
+		return false, nil, errors.Wrapf(errors.ErrState, "index %q: unknown state %d", ix.Name(), raw[0])
+	}
+}
+
+// notReady returns an error for a lookup of an index that was not fully
+// built yet.
+func (ix *lazyIndex) notReady() error {
+	return errors.Wrapf(errors.ErrState, "index %q not ready", ix.Name())
+}
+
+func (ix *lazyIndex) Update(db weave.KVStore, prev Object, save Object) error {
+	ready, last, err := ix.state(db)
+	if err != nil {
+		return err
+	}
+	if !ready {
+		var key []byte
+		if prev != nil {
+			key = prev.Key()
+		} else if save != nil {
+			key = save.Key()
+		}
+		if len(last) == 0 || bytes.Compare(key, last) > 0 {
+			// Not indexed yet. It is going to be indexed by
+			// a rebuild.
+			return nil
+		}
+	}
+	return ix.Index.Update(db, prev, save)
+}
+
+func (ix *lazyIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterator {
+	switch ready, _, err := ix.state(db); {
+	case err != nil:
+		return &failedIterator{err: err}
+	case !ready:
+		return &failedIterator{err: ix.notReady()}
+	}
+	return ix.Index.Keys(db, value)
+}
+
+func (ix *lazyIndex) keysAfter(db weave.ReadOnlyKVStore, value, after []byte) weave.Iterator {
+	si, ok := ix.Index.(seekIndex)
+	if !ok {
+		return &failedIterator{err: errors.Wrapf(errors.ErrHuman, "%T index cannot be paginated", ix.Index)}
+	}
+	switch ready, _, err := ix.state(db); {
+	case err != nil:
+		return &failedIterator{err: err}
+	case !ready:
+		return &failedIterator{err: ix.notReady()}
+	}
+	return si.keysAfter(db, value, after)
+}
+
+func (ix *lazyIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	switch ready, _, err := ix.state(db); {
+	case err != nil:
+		return nil, err
+	case !ready:
+		return nil, ix.notReady()
+	}
+	return ix.Index.Query(db, mod, data)
+}
+
+func (ix *lazyIndex) walk(db weave.ReadOnlyKVStore, fn func(dbKey, value, ref []byte) error) error {
+	w, ok := ix.Index.(indexWalker)
+	if !ok {
+		return errors.Wrapf(errors.ErrHuman, "%T index cannot be verified", ix.Index)
+	}
+	switch ready, _, err := ix.state(db); {
+	case err != nil:
+		return err
+	case !ready:
+		return ix.notReady()
+	}
+	return w.walk(db, fn)
+}
+
+func (ix *lazyIndex) values(obj Object) ([][]byte, error) {
+	w, ok := ix.Index.(indexWalker)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrHuman, "%T index cannot be verified", ix.Index)
+	}
+	return w.values(obj)
+}
+
+// indexedBucket is implemented by both Bucket and ModelBucket.
+type indexedBucket interface {
+	Index(name string) (Index, error)
+}
+
+// RebuildIndexChunk indexes at most n entities of given bucket, using the
+// lazy index with given name. Entities are processed in the order of their
+// primary keys, starting after the given key. Use an empty after value to
+// start a rebuild. Returned nextAfter value must be used as after to continue
+// the rebuild with the next call. Once all entities are indexed, the index is
+// marked as ready and done is true.
+//
+// This function allows to spread building of an index over many blocks, so
+// that an index can be added to a bucket that contains too many entities to be
+// indexed within a single transaction. The index must be declared using
+// WithLazyIndex or WithLazyNativeIndex. Calling this function for an index that is ready is a no-op.
+// Given bucket can be either a Bucket or a ModelBucket.
+func RebuildIndexChunk(db weave.KVStore, b indexedBucket, indexName string, after []byte, n int) (nextAfter []byte, done bool, err error) {
+	if n < 1 {
+		return nil, false, errors.Wrap(errors.ErrInput, "chunk size must be greater than zero")
+	}
+	idx, err := b.Index(indexName)
+	if err != nil {
+		return nil, false, err
+	}
+	ix, ok := idx.(*lazyIndex)
+	if !ok {
+		return nil, false, errors.Wrapf(ErrInvalidIndex, "%q is not a lazy index", indexName)
+	}
+	ready, last, err := ix.state(db)
+	switch {
+	case err != nil:
+		return nil, false, err
+	case ready:
+		return nil, true, nil
+	case !bytes.Equal(last, after):
+		return nil, false, errors.Wrapf(errors.ErrInput, "index %q rebuild must continue after %s", indexName, boundedHex(last))
+	}
+
+	start, end := prefixRange(ix.prefix)
+	if len(after) != 0 {
+		start = NextCursor(append(append([]byte{}, ix.prefix...), after...))
+	}
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "iterator")
+	}
+	// Entities are first read and only then indexed, because the
+	// database must not be modified while iterating.
+	objs := make([]Object, 0, n+1)
+	for len(objs) <= n {
+		dbKey, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Release()
+			return nil, false, errors.Wrap(err, "iterator next")
+		}
+		obj, err := ix.parse(dbKey[len(ix.prefix):], value)
+		if err != nil {
+			it.Release()
+			return nil, false, err
+		}
+		objs = append(objs, obj)
+	}
+	it.Release()
+
+	// One extra entity is read to determine if there is more to index.
+	more := len(objs) > n
+	if more {
+		objs = objs[:n]
+	}
+	for _, obj := range objs {
+		if err := ix.Index.Update(db, nil, obj); err != nil {
+			return nil, false, errors.Wrapf(err, "index %s", boundedHex(obj.Key()))
+		}
+	}
+
+	if !more {
+		if err := db.Set(ix.stateKey, []byte{lazyIdxReady}); err != nil {
+			return nil, false, errors.Wrap(err, "cannot save index state")
+		}
+		return nil, true, nil
+	}
+	nextAfter = objs[len(objs)-1].Key()
+	state := append([]byte{lazyIdxBuilding}, nextAfter...)
+	if err := db.Set(ix.stateKey, state); err != nil {
+		return nil, false, errors.Wrap(err, "cannot save index state")
+	}
+	return nextAfter, false, nil
+}
@@ -0,0 +1,195 @@
+package orm
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestModelBucketLazyIndex(t *testing.T) {
+	db := store.MemStore()
+
+	countParity := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		raw := make([]byte, 8)
+		binary.BigEndian.PutUint64(raw, uint64(c.Count%2))
+		return raw, nil
+	}
+	parity := func(n uint64) []byte {
+		raw := make([]byte, 8)
+		binary.BigEndian.PutUint64(raw, n)
+		return raw
+	}
+
+	// Entities are stored before the index is declared.
+	plain := NewModelBucket("cnts", &Counter{})
+	for _, c := range []int64{1, 2, 3, 4, 5} {
+		_, err := plain.Put(db, nil, &Counter{Count: c})
+		assert.Nil(t, err)
+	}
+
+	b := NewModelBucket("cnts", &Counter{}, WithLazyIndex("parity", countParity, false))
+
+	var found []Counter
+	if _, err := b.ByIndex(db, "parity", parity(1), &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want index not ready error, got %+v", err)
+	}
+
+	after, done, err := RebuildIndexChunk(db, b, "parity", nil, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, false, done)
+	assert.Equal(t, weavetest.SequenceID(2), after)
+
+	// Rebuild must continue where it stopped.
+	if _, _, err := RebuildIndexChunk(db, b, "parity", nil, 2); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+
+	// Changes of both indexed and not yet indexed entities are applied.
+	_, err = b.Put(db, weavetest.SequenceID(1), &Counter{Count: 11})
+	assert.Nil(t, err)
+	assert.Nil(t, b.Delete(db, weavetest.SequenceID(2)))
+	_, err = b.Put(db, weavetest.SequenceID(4), &Counter{Count: 41})
+	assert.Nil(t, err)
+	_, err = b.Put(db, nil, &Counter{Count: 6})
+	assert.Nil(t, err)
+
+	if _, err := b.ByIndex(db, "parity", parity(1), &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want index not ready error, got %+v", err)
+	}
+
+	after, done, err = RebuildIndexChunk(db, b, "parity", after, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, false, done)
+	assert.Equal(t, weavetest.SequenceID(4), after)
+
+	after, done, err = RebuildIndexChunk(db, b, "parity", after, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, done)
+	assert.Nil(t, after)
+
+	refs, err := b.ByIndex(db, "parity", parity(1), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3), weavetest.SequenceID(4), weavetest.SequenceID(5)}, refs)
+	assert.Equal(t, []Counter{{Count: 11}, {Count: 3}, {Count: 41}, {Count: 5}}, found)
+
+	found = nil
+	refs, err = b.ByIndex(db, "parity", parity(0), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(6)}, refs)
+	assert.Equal(t, []Counter{{Count: 6}}, found)
+
+	orphans, err := b.VerifyIndex(db, "parity")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+
+	// Once ready, the index is maintained as any other.
+	assert.Nil(t, b.Delete(db, weavetest.SequenceID(6)))
+	found = nil
+	refs, err = b.ByIndex(db, "parity", parity(0), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(refs))
+
+	after, done, err = RebuildIndexChunk(db, b, "parity", nil, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, done)
+	assert.Nil(t, after)
+}
+
+func TestModelBucketLazyNativeIndex(t *testing.T) {
+	db := store.MemStore()
+
+	countParity := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return [][]byte{encodeSequence(c.Count % 2)}, nil
+	}
+
+	plain := NewModelBucket("cnts", &Counter{})
+	for _, c := range []int64{1, 2, 3} {
+		_, err := plain.Put(db, nil, &Counter{Count: c})
+		assert.Nil(t, err)
+	}
+
+	b := NewModelBucket("cnts", &Counter{}, WithLazyNativeIndex("parity", countParity))
+
+	var found []Counter
+	if _, _, err := b.ByIndexPage(db, "parity", encodeSequence(1), nil, 10, &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want index not ready error, got %+v", err)
+	}
+
+	after, done, err := RebuildIndexChunk(db, b, "parity", nil, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, false, done)
+
+	// Entity that was not indexed yet is indexed by the rebuild.
+	_, err = b.Put(db, weavetest.SequenceID(3), &Counter{Count: 31})
+	assert.Nil(t, err)
+
+	_, done, err = RebuildIndexChunk(db, b, "parity", after, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, true, done)
+
+	next, refs, err := b.ByIndexPage(db, "parity", encodeSequence(1), nil, 1, &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1)}, refs)
+	_, refs, err = b.ByIndexPage(db, "parity", encodeSequence(1), next, 1, &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(3)}, refs)
+	assert.Equal(t, []Counter{{Count: 1}, {Count: 31}}, found)
+
+	orphans, err := b.VerifyIndex(db, "parity")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+}
+
+func TestRebuildIndexChunkEmptyBucket(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{},
+		WithLazyIndex("count", func(obj Object) ([]byte, error) {
+			return encodeSequence(obj.Value().(*Counter).Count), nil
+		}, true),
+	)
+
+	var found []Counter
+	if _, err := b.ByIndex(db, "count", encodeSequence(1), &found); !errors.ErrState.Is(err) {
+		t.Fatalf("want index not ready error, got %+v", err)
+	}
+
+	after, done, err := RebuildIndexChunk(db, b, "count", nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, true, done)
+	assert.Nil(t, after)
+
+	// Unique index returns not found once ready.
+	if _, err := b.ByIndex(db, "count", encodeSequence(1), &found); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+}
+
+func TestRebuildIndexChunkRequiresLazyIndex(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("count", func(obj Object) ([]byte, error) {
+			return encodeSequence(obj.Value().(*Counter).Count), nil
+		}, false),
+	)
+	if _, _, err := RebuildIndexChunk(db, b, "count", nil, 10); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %+v", err)
+	}
+	if _, _, err := RebuildIndexChunk(db, b, "missing", nil, 10); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %+v", err)
+	}
+	if _, _, err := RebuildIndexChunk(db, b, "count", nil, 0); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+}
@@ -2,9 +2,13 @@ package orm
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -46,9 +50,43 @@ type ModelBucket interface {
 	// return more than one value for the same key.
 	// All matching entities are appended to given destination slice. If no
 	// result was found, no error is returned and destination slice is not
-	// modified.
+	// modified, unless the index is unique. Querying a unique index for a
+	// value that is not indexed returns ErrNotFound.
 	ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest ModelSlicePtr) (keys [][]byte, err error)
 
+	// CountByIndex returns the number of entities that secondary index
+	// with given name references under given key. Entities are not
+	// loaded, so counting is cheaper than ByIndex, but it still iterates
+	// over all index entries of given key.
+	CountByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte) (int, error)
+
+	// ByIndexPage works like ByIndex but returns at most limit entities,
+	// allowing for a keyset pagination of the index query result.
+	// Entities are returned in the index order, which is the ascending
+	// order of primary keys of the same length. Native indexes return
+	// shorter keys first; use WithFixedKeyLength to get the byte order.
+	// Only entities that follow the one with the after primary key are
+	// returned. Iteration seeks to after, so the cost of a page does not
+	// depend on its position. Use an empty after value to request the first page. Returned
+	// nextAfter value must be used as after to request the next page. It
+	// is empty if there are no more results.
+	ByIndexPage(db weave.ReadOnlyKVStore, indexName string, key []byte, after []byte, limit int, dest ModelSlicePtr) (nextAfter []byte, keys [][]byte, err error)
+
+	// Page returns at most limit entities of this bucket, allowing for a
+	// keyset pagination over all entities.
+	// Entities are returned in the ascending order of their primary keys.
+	// Only entities with the primary key greater than after are returned.
+	// Use an empty after value to request the first page. Returned
+	// nextAfter value must be used as after to request the next page. It
+	// is empty if there are no more results.
+	Page(db weave.ReadOnlyKVStore, after []byte, limit int, dest ModelSlicePtr) (nextAfter []byte, err error)
+
+	// Many loads the entities with given primary keys and appends them to
+	// the destination, in the order of the keys. Keys that do not exist
+	// are skipped. Keys of the loaded entities are returned, so that the
+	// caller can compare them with the requested ones.
+	Many(db weave.ReadOnlyKVStore, keys [][]byte, dest ModelSlicePtr) (found [][]byte, err error)
+
 	// Index returns the index with given name that is maintained for this
 	// bucket. This function can return ErrInvalidIndex if an index with
 	// requested name does not exist.
@@ -64,13 +102,65 @@ type ModelBucket interface {
 	// If the key is nil or zero length then a sequence generator is used
 	// to create a unique key value.
 	// Using a key that already exists in the database cause the value to
-	// be overwritten.
+	// be overwritten, unless the bucket was configured with immutable
+	// fields and any of them differs from the stored value. In such case
+	// ErrImmutable is returned. ErrImmutable is returned as well if the
+	// key is reserved. See WithReservedKeys.
 	Put(db weave.KVStore, key []byte, m Model) ([]byte, error)
 
+	// PutReserved saves given model under a key declared as reserved
+	// using WithReservedKeys. Put and delete operations reject reserved
+	// keys, so this is the only way to write such entity. It returns
+	// ErrInput if given key is not reserved. Apart from that, it works
+	// the same way as Put.
+	PutReserved(db weave.KVStore, key []byte, m Model) error
+
+	// PutBatch saves all given models in the database, each under a new
+	// key generated by the sequence. Keys are reserved with a single
+	// sequence update, which makes it cheaper than calling Put for each
+	// model. Returned keys are in the order of the models.
+	// All models are validated before any key is reserved. If saving any
+	// of the models fails later, reserved keys that were not used are
+	// never reused.
+	PutBatch(db weave.KVStore, models []Model) ([][]byte, error)
+
+	// DryRunPut does all the checks that Put does and returns the key that
+	// the model would be saved under, without writing to the database.
+	// If the key is nil or zero length, the next sequence value is
+	// returned, but the sequence is not incremented, so each call returns
+	// the same key until the sequence is used. Change listeners are not
+	// called.
+	DryRunPut(db weave.ReadOnlyKVStore, key []byte, m Model) ([]byte, error)
+
 	// Delete removes an entity with given primary key from the database.
 	// It returns ErrNotFound if an entity with given key does not exist.
+	// It returns ErrImmutable if given key is reserved.
 	Delete(db weave.KVStore, key []byte) error
 
+	// DeleteAndReturn removes an entity with given primary key from the
+	// database, together with all its index entries, and loads the
+	// removed entity into given destination. Destination type is checked
+	// the same way as by One method.
+	// It returns ErrNotFound if an entity with given key does not exist.
+	DeleteAndReturn(db weave.KVStore, key []byte, dest Model) error
+
+	// DeleteMany removes all entities with given primary keys from the
+	// database and returns the number of entities that existed and were
+	// deleted. Missing keys are skipped, unless the bucket was configured
+	// using WithStrictDeleteMany. In such case ErrNotFound is returned and
+	// no entity is deleted if any of the keys does not exist.
+	DeleteMany(db weave.KVStore, keys [][]byte) (deleted int, err error)
+
+	// SwapKeys exchanges the primary keys of two entities, so that the
+	// entity stored under keyA is stored under keyB and the other way
+	// round. All indexes are updated. Unique index values are never
+	// violated, because both entities are removed before any is saved.
+	// Both stored entities are validated before they are moved and must
+	// satisfy the immutable fields configuration of the bucket. Change
+	// listeners are notified about an update of both keys.
+	// It returns ErrNotFound if any of the entities does not exist.
+	SwapKeys(db weave.KVStore, keyA, keyB []byte) error
+
 	// Has returns nil if an entity with given primary key value exists. It
 	// returns ErrNotFound if no entity can be found.
 	// Has is a cheap operation that that does not read the data and only
@@ -80,6 +170,43 @@ type ModelBucket interface {
 	// Register registers this buckets content to be accessible via query
 	// requests under the given name.
 	Register(name string, r weave.QueryRouter)
+
+	// BucketPrefix returns the prefix of all database keys that entities
+	// of this bucket are stored under. The prefix is the bucket name
+	// followed by a colon.
+	BucketPrefix() []byte
+
+	// DBKey returns the raw database key that an entity with given primary
+	// key is stored under. This is the key that must be used when
+	// requesting or verifying a Merkle proof of an entity.
+	DBKey(key []byte) []byte
+
+	// IndexDBKey returns the raw database key of an entry that the index
+	// with given name maintains for given index value. For a native index
+	// the returned key is the prefix shared by entries of all entities
+	// indexed with given value. ErrInvalidIndex is returned if the index
+	// does not exist and ErrInput if the index is not kept in the
+	// database.
+	IndexDBKey(indexName string, key []byte) ([]byte, error)
+
+	// IndexNames returns the names of all indexes maintained for this
+	// bucket, sorted alphabetically. Each returned name can be used to
+	// query the bucket using ByIndex or to access the index using Index
+	// method.
+	IndexNames() []string
+
+	// VerifyIndex walks through all entries of the index with given name
+	// and confirms that every referenced entity exists and that indexing
+	// it again results in the same index value. Database keys of all
+	// orphaned or inconsistent index entries are returned.
+	// This is a diagnostic method, meant to detect an index drift caused
+	// for example by a buggy migration. It reads the whole index and
+	// should not be used by the handlers.
+	VerifyIndex(db weave.ReadOnlyKVStore, indexName string) (orphans [][]byte, err error)
+
+	// NewModel returns a new, zero value instance of the model maintained
+	// by this bucket.
+	NewModel() Model
 }
 
 // IterAll returns an iterator instance that loops through all entities kept by
@@ -134,18 +261,101 @@ func (it *ModelBucketIterator) Next(db weave.ReadOnlyKVStore, dest Model) ([]byt
 		return nil, errors.Wrap(err, "iterator next")
 	}
 
+	value, err = decompressValue(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "decompress model value")
+	}
 	if err := dest.Unmarshal(value); err != nil {
 		return nil, errors.Wrap(err, "cannot unmarshal model value")
 	}
 
 	// Key was consumed. Iterator is inclusive, so we must use the very
-	// next possible key. Which is this very key with zero appended.
-	it.cursor = make([]byte, len(key)+1)
-	copy(it.cursor, key)
+	// next possible key.
+	it.cursor = NextCursor(key)
 
 	return key[it.dbprefix:], nil
 }
 
+// NextCursor returns the smallest key that is strictly greater than given
+// key. This is the given key with zero byte appended.
+// Because iterators are inclusive, this function should be used to compute
+// the start of the next iteration range when resuming an iteration after the
+// given key was consumed, for example when building pagination tokens.
+// Returned slice never shares memory with the given key.
+func NextCursor(key []byte) []byte {
+	next := make([]byte, len(key)+1)
+	copy(next, key)
+	return next
+}
+
+// StreamModels writes all entities of given bucket to w as a stream of length
+// delimited protobuf messages, in the order of their keys. Each entity is
+// decoded into a fresh instance returned by newModel and passed to transform,
+// so that it can be converted into a message of a different schema. If
+// transform is nil, models are written unchanged and must implement the
+// proto.Message interface.
+// Similar to IterAll, schema migration is not supported and entities are
+// decoded in the version they are stored in the database.
+func StreamModels(
+	db weave.ReadOnlyKVStore,
+	bucketName string,
+	newModel func() Model,
+	w io.Writer,
+	transform func(Model) (proto.Message, error),
+) error {
+	prefix := []byte(bucketName + ":")
+	start, end := prefixRange(prefix)
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	for {
+		key, value, err := it.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "iterator next")
+		}
+
+		value, err = decompressValue(value)
+		if err != nil {
+			return errors.Wrapf(err, "decompress %q", key[len(prefix):])
+		}
+		m := newModel()
+		if err := m.Unmarshal(value); err != nil {
+			return errors.Wrapf(err, "unmarshal %q", key[len(prefix):])
+		}
+
+		var msg proto.Message
+		if transform == nil {
+			pm, ok := m.(proto.Message)
+			if !ok {
+				return errors.Wrapf(errors.ErrType, "%T is not a protobuf message", m)
+			}
+			msg = pm
+		} else {
+			msg, err = transform(m)
+			if err != nil {
+				return errors.Wrapf(err, "transform %q", key[len(prefix):])
+			}
+		}
+
+		raw, err := proto.Marshal(msg)
+		if err != nil {
+			return errors.Wrapf(err, "marshal %q", key[len(prefix):])
+		}
+		if _, err := w.Write(proto.EncodeVarint(uint64(len(raw)))); err != nil {
+			return errors.Wrap(err, "write")
+		}
+		if _, err := w.Write(raw); err != nil {
+			return errors.Wrap(err, "write")
+		}
+	}
+}
+
 // NewModelBucket returns a ModelBucket instance. This implementation relies on
 // a bucket instance. Final implementation should operate directly on the
 // KVStore instead.
@@ -156,9 +366,13 @@ func NewModelBucket(name string, m Model, opts ...ModelBucketOption) ModelBucket
 	if tp.Kind() == reflect.Ptr {
 		tp = tp.Elem()
 	}
+	if err := verifyCodec(tp); err != nil {
+		panic(fmt.Sprintf("%s bucket model %s codec is broken: %s", name, tp, err))
+	}
 
 	mb := &modelBucket{
 		b:     b,
+		name:  name,
 		idSeq: b.Sequence("id"),
 		model: tp,
 	}
@@ -168,6 +382,117 @@ func NewModelBucket(name string, m Model, opts ...ModelBucketOption) ModelBucket
 	return mb
 }
 
+// verifyCodec ensures that the zero value of the model type can be
+// serialized and deserialized. This allows to detect wiring bugs, for example
+// a missing generated method, when the bucket is created and not when it is
+// used for the first time.
+func verifyCodec(tp reflect.Type) error {
+	m, ok := reflect.New(tp).Interface().(Model)
+	if !ok {
+		return errors.Wrapf(errors.ErrType, "%s does not implement Model", tp)
+	}
+	raw, err := m.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	dest := reflect.New(tp).Interface().(Model)
+	if err := dest.Unmarshal(raw); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+	return nil
+}
+
+// verifyRoundTrip returns an error if given model deserialized from its own
+// serialized representation is not equal to the original.
+func verifyRoundTrip(tp reflect.Type, m Model) error {
+	raw, err := m.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	decoded := reflect.New(tp).Interface().(Model)
+	if err := decoded.Unmarshal(raw); err != nil {
+		return errors.Wrap(errors.ErrState, err.Error())
+	}
+
+	var equal bool
+	if e, ok := m.(interface{ Equal(interface{}) bool }); ok {
+		equal = e.Equal(decoded)
+	} else {
+		// Generated protobuf code does not provide an Equal method and
+		// protobuf reflection based comparison does not support custom
+		// types, for example weave.Address.
+		again, err := decoded.Marshal()
+		if err != nil {
+			return errors.Wrap(errors.ErrState, err.Error())
+		}
+		equal = bytes.Equal(raw, again)
+	}
+	if !equal {
+		return errors.Wrapf(errors.ErrState, "%T model does not survive the serialization round trip", m)
+	}
+	return nil
+}
+
+// deepCopy returns a copy of given value that does not share any memory with
+// the original. Unexported struct fields are copied shallow.
+func deepCopy(src reflect.Value) reflect.Value {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(src.Type().Elem())
+		dst.Elem().Set(deepCopy(src.Elem()))
+		return dst
+	case reflect.Interface:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(deepCopy(src.Elem()))
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(src)
+		for i := 0; i < dst.NumField(); i++ {
+			if f := dst.Field(i); f.CanSet() {
+				f.Set(deepCopy(src.Field(i)))
+			}
+		}
+		return dst
+	case reflect.Slice:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		if src.Type().Elem().Kind() == reflect.Uint8 {
+			reflect.Copy(dst, src)
+			return dst
+		}
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopy(src.Index(i)))
+		}
+		return dst
+	case reflect.Array:
+		dst := reflect.New(src.Type()).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopy(src.Index(i)))
+		}
+		return dst
+	case reflect.Map:
+		if src.IsNil() {
+			return src
+		}
+		dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+		for _, k := range src.MapKeys() {
+			dst.SetMapIndex(deepCopy(k), deepCopy(src.MapIndex(k)))
+		}
+		return dst
+	default:
+		return src
+	}
+}
+
 // ModelBucketOption is implemented by any function that can configure
 // ModelBucket during creation.
 type ModelBucketOption func(mb *modelBucket)
@@ -179,27 +504,32 @@ type ModelBucketOption func(mb *modelBucket)
 // Indexer value must be a function that implements either Indexer or
 // MultiKeyIndexer interface.
 func WithIndex(name string, indexer interface{}, unique bool) ModelBucketOption {
-	var idx MultiKeyIndexer
+	idx := toMultiKeyIndexer(indexer)
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithMultiKeyIndex(name, idx, unique)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// toMultiKeyIndexer returns given indexer as a MultiKeyIndexer. It panics if
+// the indexer implements neither Indexer nor MultiKeyIndexer interface.
+func toMultiKeyIndexer(indexer interface{}) MultiKeyIndexer {
 	switch fn := indexer.(type) {
 	case MultiKeyIndexer:
-		idx = fn
+		return fn
 	case func(Object) ([][]byte, error):
-		idx = fn
+		return fn
 	case Indexer:
 		// Indexer is a subset of a MultiKeyIndexer but to be backward
 		// compatible, we must support this type as well.
-		idx = asMultiKeyIndexer(fn)
+		return asMultiKeyIndexer(fn)
 	case func(Object) ([]byte, error):
 		// This is indexer interface
-		idx = asMultiKeyIndexer(fn)
+		return asMultiKeyIndexer(fn)
 	default:
 		text := fmt.Sprintf("indexer must implement either Indexer or MultiKeyIndexer interface, got %T", indexer)
 		panic(text)
 	}
-
-	return func(mb *modelBucket) {
-		mb.b = mb.b.WithMultiKeyIndex(name, idx, unique)
-	}
 }
 
 // WithNativeIndex configures a bucket to maintain an index. Used index
@@ -211,6 +541,53 @@ func WithIndex(name string, indexer interface{}, unique bool) ModelBucketOption
 func WithNativeIndex(name string, indexer MultiKeyIndexer) ModelBucketOption {
 	return func(mb *modelBucket) {
 		mb.b = mb.b.WithNativeIndex(name, indexer)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// WithVirtualIndex configures the bucket to provide an index with given name
+// that is not stored. Index values are computed on each lookup for all
+// entities stored in the bucket, which makes every lookup O(n). Use it only
+// for rarely queried values that are not worth the storage cost of a
+// maintained index, for example a hash of a field. A virtual index is queried
+// using the same API as any other index.
+// Indexer value must be a function that implements either Indexer or
+// MultiKeyIndexer interface.
+func WithVirtualIndex(name string, indexer interface{}) ModelBucketOption {
+	idx := toMultiKeyIndexer(indexer)
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithVirtualIndex(name, idx)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// WithLazyIndex configures the bucket to build an index with given name, that
+// can be added to a bucket that already contains entities. The index cannot be
+// used until all entities are indexed using RebuildIndexChunk. Until then, an
+// index lookup returns ErrState. Rebuild can be spread over many calls, so that
+// indexing a big bucket does not have to happen within a single transaction.
+//
+// A unique constraint is enforced only for the entities that were already
+// indexed. A rebuild fails if an entity that it indexes violates it.
+// Indexer value must be a function that implements either Indexer or
+// MultiKeyIndexer interface.
+func WithLazyIndex(name string, indexer interface{}, unique bool) ModelBucketOption {
+	idx := toMultiKeyIndexer(indexer)
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithLazyIndex(name, idx, unique)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// WithLazyNativeIndex configures the bucket to build a native index with given
+// name, that can be added to a bucket that already contains entities. Same as
+// for WithLazyIndex, the index cannot be used until all entities are indexed
+// using RebuildIndexChunk. Use it instead of WithLazyIndex for big
+// collections.
+func WithLazyNativeIndex(name string, indexer MultiKeyIndexer) ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithLazyNativeIndex(name, indexer)
+		mb.indexNames = append(mb.indexNames, name)
 	}
 }
 
@@ -222,10 +599,147 @@ func WithIDSequence(s Sequence) ModelBucketOption {
 	}
 }
 
+// WithImmutableFields configures the bucket to reject any Put that would
+// change the value of any of the given fields of an already stored entity.
+// Field names refer to the top level fields of the model structure. This
+// function panics if the model does not declare a field with given name.
+func WithImmutableFields(fields ...string) ModelBucketOption {
+	return func(mb *modelBucket) {
+		for _, name := range fields {
+			f, ok := mb.model.FieldByName(name)
+			if !ok {
+				panic(fmt.Sprintf("%s model has no %q field", mb.model, name))
+			}
+			mb.immutable = append(mb.immutable, f)
+		}
+	}
+}
+
+// WithReservedKeys configures the bucket to protect entities stored under
+// given keys, for example a singleton configuration kept next to sequence
+// keyed entities. Put, PutBatch, DryRunPut, Delete, DeleteAndReturn,
+// DeleteMany and SwapKeys return ErrImmutable when used with a reserved key.
+// Entity with a reserved key can be written only using PutReserved.
+// This function panics if any of the keys is empty.
+func WithReservedKeys(keys ...[]byte) ModelBucketOption {
+	reserved := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		if len(k) == 0 {
+			panic("reserved key must not be empty")
+		}
+		reserved = append(reserved, append([]byte{}, k...))
+	}
+	return func(mb *modelBucket) {
+		mb.reserved = append(mb.reserved, reserved...)
+	}
+}
+
+// WithFixedKeyLength configures the bucket to accept only primary keys of given
+// length. Has, One, Delete and Put with an explicit key return ErrInput when
+// used with a key of a different length. This is useful for buckets that use
+// a sequence to generate keys, to provide a helpful error when a malformed key
+// is used instead of ErrNotFound.
+// This function panics if given length is not greater than zero.
+func WithFixedKeyLength(n int) ModelBucketOption {
+	if n <= 0 {
+		panic(fmt.Sprintf("invalid key length: %d", n))
+	}
+	return func(mb *modelBucket) {
+		mb.keyLength = n
+	}
+}
+
+// WithStrictDeleteMany configures the bucket to reject a DeleteMany call if
+// any of the given keys does not exist. By default missing keys are skipped.
+func WithStrictDeleteMany() ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.strictDeleteMany = true
+	}
+}
+
+// WithRoundTripCheck configures the bucket to verify in Put that each model
+// deserialized from its serialized representation is equal to the original.
+// A model that does not survive the round trip is rejected with ErrState
+// before anything is written. This protects against codec bugs, for example a
+// protobuf field declaration that was not regenerated on both sides, at the
+// cost of an additional serialization for every Put.
+//
+// Models are compared using their Equal method if they provide one. Otherwise
+// the deserialized model is serialized again and both serialized
+// representations are compared. Model serialization must be deterministic.
+func WithRoundTripCheck() ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.roundTripCheck = true
+	}
+}
+
+// WithDefensiveCopy configures the bucket to deep copy each model returned by
+// One, ByIndex, ByIndexPage, Page and Many. Byte slices, slices, maps and
+// nested messages of a returned model are then never shared with any other
+// model instance, for example one held by a cache, so that a caller can
+// safely modify the returned model. Copying has a cost proportional to the
+// model size.
+func WithDefensiveCopy() ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.defensiveCopy = true
+	}
+}
+
+// WithValueCompression configures the bucket to store snappy compressed values.
+// This is useful for models holding big, well compressing data. Compression
+// is transparent: a value is decompressed when read and any validation,
+// including size limits, applies to the model and not to its stored form.
+// A value that does not shrink when compressed is stored unchanged. Each
+// compressed value starts with a header byte, so that compressed and not
+// compressed values can coexist. This allows to enable compression for an
+// existing bucket without migrating its content. Entities are compressed
+// when they are written next time.
+//
+// Compressed values are part of the consensus state, so compression must
+// produce the same output on all nodes. The snappy encoder is pinned in
+// go.mod and its output does not depend on the Go version. Upgrading the
+// snappy dependency must be treated as a consensus change.
+func WithValueCompression() ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithValueCompression()
+	}
+}
+
 type modelBucket struct {
 	b     Bucket
+	name  string
 	idSeq Sequence
 
+	// defensiveCopy is true if each returned model must be deep copied.
+	defensiveCopy bool
+
+	// roundTripCheck is true if each stored model must be verified to
+	// survive the serialization round trip.
+	roundTripCheck bool
+
+	// strictDeleteMany is true if DeleteMany must fail when any of the
+	// keys does not exist.
+	strictDeleteMany bool
+
+	// keyLength is the required length of the primary key. Zero means
+	// that keys of any length are accepted.
+	keyLength int
+
+	// immutable is a list of model fields that must not change once the
+	// entity is stored.
+	immutable []reflect.StructField
+
+	// reserved is a list of primary keys that can be written only using
+	// PutReserved.
+	reserved [][]byte
+
+	// indexNames are the names of all indexes configured for this
+	// bucket, in the order of registration.
+	indexNames []string
+
+	// listeners are notified about every entity change.
+	listeners []ChangeListener
+
 	// model is referencing the structure type. Event if the structure
 	// pointer is implementing Model interface, this variable references
 	// the structure directly and not the structure's pointer type.
@@ -237,12 +751,15 @@ func (mb *modelBucket) Register(name string, r weave.QueryRouter) {
 }
 
 func (mb *modelBucket) One(db weave.ReadOnlyKVStore, key []byte, dest Model) error {
+	if err := mb.validateKey(key); err != nil {
+		return err
+	}
 	obj, err := mb.b.Get(db, key)
 	if err != nil {
 		return err
 	}
 	if obj == nil || obj.Value() == nil {
-		return errors.Wrapf(errors.ErrNotFound, "%T not in the store", dest)
+		return errors.Wrapf(mb.notFound(key), "%T not in the store", dest)
 	}
 	res := obj.Value()
 
@@ -250,7 +767,11 @@ func (mb *modelBucket) One(db weave.ReadOnlyKVStore, key []byte, dest Model) err
 		return errors.Wrapf(errors.ErrType, "%T cannot be represented as %T", res, dest)
 	}
 
-	reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(res).Elem())
+	val := reflect.ValueOf(res).Elem()
+	if mb.defensiveCopy {
+		val = deepCopy(val)
+	}
+	reflect.ValueOf(dest).Elem().Set(val)
 	return nil
 }
 
@@ -258,15 +779,200 @@ func (mb *modelBucket) Index(name string) (Index, error) {
 	return mb.b.Index(name)
 }
 
+func (mb *modelBucket) IndexNames() []string {
+	names := make([]string, len(mb.indexNames))
+	copy(names, mb.indexNames)
+	sort.Strings(names)
+	return names
+}
+
+func (mb *modelBucket) BucketPrefix() []byte {
+	return mb.b.DBKey(nil)
+}
+
+func (mb *modelBucket) DBKey(key []byte) []byte {
+	return mb.b.DBKey(key)
+}
+
+func (mb *modelBucket) IndexDBKey(indexName string, key []byte) ([]byte, error) {
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, err
+	}
+	return indexDBKey(idx, key)
+}
+
 func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, destination ModelSlicePtr) ([][]byte, error) {
 	objs, err := mb.b.GetIndexed(db, indexName, key)
 	if err != nil {
 		return nil, err
 	}
 	if len(objs) == 0 {
+		if idx, err := mb.b.Index(indexName); err == nil && isUniqueIndex(idx) {
+			return nil, errors.Wrapf(errors.ErrNotFound, "bucket %q, index %q, key %s", mb.name, indexName, boundedHex(key))
+		}
+		return nil, nil
+	}
+
+	return mb.appendObjects(objs, destination)
+}
+
+func (mb *modelBucket) CountByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte) (int, error) {
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return 0, err
+	}
+	it := idx.Keys(db, key)
+	defer it.Release()
+	var n int
+	for {
+		switch _, _, err := it.Next(); {
+		case err == nil:
+			n++
+		case errors.ErrIteratorDone.Is(err):
+			return n, nil
+		default:
+			return 0, errors.Wrap(err, "iterator next")
+		}
+	}
+}
+
+func (mb *modelBucket) ByIndexPage(db weave.ReadOnlyKVStore, indexName string, key []byte, after []byte, limit int, destination ModelSlicePtr) ([]byte, [][]byte, error) {
+	if limit < 1 {
+		return nil, nil, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	si, ok := idx.(seekIndex)
+	if !ok {
+		return nil, nil, errors.Wrapf(errors.ErrHuman, "%T index cannot be paginated", idx)
+	}
+
+	// Iteration starts right after the cursor, so each page costs only
+	// the keys it returns. One extra key is read to determine if there
+	// is a next page.
+	it := si.keysAfter(db, key, after)
+	defer it.Release()
+	refs := make([][]byte, 0, limit+1)
+	for len(refs) <= limit {
+		ref, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "iterator next")
+		}
+		refs = append(refs, ref)
+	}
+	more := len(refs) > limit
+	if more {
+		refs = refs[:limit]
+	}
+
+	if len(refs) == 0 {
+		if len(after) == 0 && isUniqueIndex(idx) {
+			return nil, nil, errors.Wrapf(errors.ErrNotFound, "bucket %q, index %q, key %s", mb.name, indexName, boundedHex(key))
+		}
+		return nil, nil, nil
+	}
+
+	objs := make([]Object, len(refs))
+	for i, ref := range refs {
+		if objs[i], err = mb.b.Get(db, ref); err != nil {
+			return nil, nil, err
+		}
+	}
+	keys, err := mb.appendObjects(objs, destination)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !more {
+		return nil, keys, nil
+	}
+	return refs[len(refs)-1], keys, nil
+}
+
+func (mb *modelBucket) Page(db weave.ReadOnlyKVStore, after []byte, limit int, destination ModelSlicePtr) ([]byte, error) {
+	if limit < 1 {
+		return nil, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+
+	prefix := mb.b.DBKey(nil)
+	start, end := prefixRange(prefix)
+	if len(after) != 0 {
+		start = NextCursor(mb.b.DBKey(after))
+	}
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	// One extra entity is read to determine if there is a next page.
+	objs := make([]Object, 0, limit+1)
+	for len(objs) <= limit {
+		dbKey, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "iterator next")
+		}
+		obj, err := mb.b.Parse(dbKey[len(prefix):], value)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	more := len(objs) > limit
+	if more {
+		objs = objs[:limit]
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+
+	keys, err := mb.appendObjects(objs, destination)
+	if err != nil {
+		return nil, err
+	}
+	if !more {
+		return nil, nil
+	}
+	return keys[len(keys)-1], nil
+}
+
+func (mb *modelBucket) Many(db weave.ReadOnlyKVStore, keys [][]byte, destination ModelSlicePtr) ([][]byte, error) {
+	objs := make([]Object, 0, len(keys))
+	for _, key := range keys {
+		if err := mb.validateKey(key); err != nil {
+			return nil, err
+		}
+		obj, err := mb.b.Get(db, key)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+	// Destination is validated even if nothing was found.
+	found, err := mb.appendObjects(objs, destination)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
 		return nil, nil
 	}
+	return found, nil
+}
 
+// appendObjects appends values of all given objects to the destination slice.
+// It returns the keys of appended objects.
+func (mb *modelBucket) appendObjects(objs []Object, destination ModelSlicePtr) ([][]byte, error) {
 	dest := reflect.ValueOf(destination)
 	if dest.Kind() != reflect.Ptr {
 		return nil, errors.Wrap(errors.ErrType, "destination must be a pointer to slice of models")
@@ -296,6 +1002,9 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 			continue
 		}
 		val := reflect.ValueOf(obj.Value())
+		if mb.defensiveCopy {
+			val = deepCopy(val)
+		}
 		if !sliceOfPointers {
 			val = val.Elem()
 		}
@@ -303,20 +1012,36 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 		keys = append(keys, obj.Key())
 	}
 	return keys, nil
-
 }
 
 func (mb *modelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error) {
-	mTp := reflect.TypeOf(m)
-	if mTp.Kind() != reflect.Ptr {
-		return nil, errors.Wrap(errors.ErrType, "model destination must be a pointer")
+	var saved []byte
+	err := mb.atomic(db, func(db weave.KVStore) error {
+		var err error
+		saved, err = mb.put(db, key, m, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if mb.model != mTp.Elem() {
-		return nil, errors.Wrapf(errors.ErrType, "cannot store %T type in this bucket", m)
+	return saved, nil
+}
+
+func (mb *modelBucket) PutReserved(db weave.KVStore, key []byte, m Model) error {
+	if !mb.isReserved(key) {
+		return errors.Wrapf(errors.ErrInput, "key %s is not reserved", boundedHex(key))
 	}
+	return mb.atomic(db, func(db weave.KVStore) error {
+		_, err := mb.put(db, key, m, true)
+		return err
+	})
+}
 
-	if err := m.Validate(); err != nil {
-		return nil, errors.Wrap(err, "invalid model")
+// put saves given model. Unless allowReserved is true, a reserved key is
+// rejected.
+func (mb *modelBucket) put(db weave.KVStore, key []byte, m Model, allowReserved bool) ([]byte, error) {
+	if err := mb.validModel(m); err != nil {
+		return nil, err
 	}
 
 	if len(key) == 0 {
@@ -325,40 +1050,443 @@ func (mb *modelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error
 		if err != nil {
 			return nil, errors.Wrap(err, "ID sequence")
 		}
+	} else if err := mb.validateKey(key); err != nil {
+		return nil, err
+	}
+
+	if !allowReserved {
+		if err := mb.ensureNotReserved(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mb.ensureImmutable(db, key, m); err != nil {
+		return nil, err
+	}
+
+	if mb.roundTripCheck {
+		if err := verifyRoundTrip(mb.model, m); err != nil {
+			return nil, err
+		}
 	}
 
 	obj := NewSimpleObj(key, m)
-	if err := mb.b.Save(db, obj); err != nil {
+	save := func(db weave.KVStore) error { return mb.b.Save(db, obj) }
+	if err := mb.applyChange(db, key, m, save); err != nil {
 		return nil, errors.Wrap(err, "cannot store in the database")
 	}
 	return key, nil
 }
 
+func (mb *modelBucket) DryRunPut(db weave.ReadOnlyKVStore, key []byte, m Model) ([]byte, error) {
+	if err := mb.validModel(m); err != nil {
+		return nil, err
+	}
+
+	if len(key) == 0 {
+		var err error
+		key, err = mb.idSeq.Peek(db)
+		if err != nil {
+			return nil, errors.Wrap(err, "ID sequence")
+		}
+	} else if err := mb.validateKey(key); err != nil {
+		return nil, err
+	}
+
+	if err := mb.ensureNotReserved(key); err != nil {
+		return nil, err
+	}
+
+	if err := mb.ensureImmutable(db, key, m); err != nil {
+		return nil, err
+	}
+
+	if mb.roundTripCheck {
+		if err := verifyRoundTrip(mb.model, m); err != nil {
+			return nil, err
+		}
+	}
+
+	obj := NewSimpleObj(key, m)
+	for _, name := range mb.indexNames {
+		idx, err := mb.b.Index(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := ensureUnique(db, idx, obj); err != nil {
+			return nil, errors.Wrap(err, "cannot store in the database")
+		}
+	}
+	return key, nil
+}
+
+func (mb *modelBucket) PutBatch(db weave.KVStore, models []Model) ([][]byte, error) {
+	if len(models) == 0 {
+		return nil, nil
+	}
+	for i, m := range models {
+		if err := mb.validModel(m); err != nil {
+			return nil, errors.Wrapf(err, "model %d", i)
+		}
+	}
+	keys := make([][]byte, 0, len(models))
+	err := mb.atomic(db, func(db weave.KVStore) error {
+		first, err := mb.idSeq.NextN(db, len(models))
+		if err != nil {
+			return errors.Wrap(err, "ID sequence")
+		}
+		for i, m := range models {
+			key := encodeSequence(int64(first) + int64(i))
+			if _, err := mb.put(db, key, m, false); err != nil {
+				return errors.Wrapf(err, "model %d", i)
+			}
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// validModel returns an error if given model cannot be stored in this bucket.
+func (mb *modelBucket) validModel(m Model) error {
+	mTp := reflect.TypeOf(m)
+	if mTp.Kind() != reflect.Ptr {
+		return errors.Wrap(errors.ErrType, "model destination must be a pointer")
+	}
+	if mb.model != mTp.Elem() {
+		return errors.Wrapf(errors.ErrType, "cannot store %T type in this bucket", m)
+	}
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "invalid model")
+	}
+	return nil
+}
+
+// ensureImmutable returns ErrImmutable if an entity with given key exists and
+// any of its immutable fields differs from the value declared by given model.
+func (mb *modelBucket) ensureImmutable(db weave.ReadOnlyKVStore, key []byte, m Model) error {
+	if len(mb.immutable) == 0 {
+		return nil
+	}
+	obj, err := mb.b.Get(db, key)
+	if err != nil {
+		return errors.Wrap(err, "cannot load stored entity")
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil
+	}
+
+	// Serialization does not preserve the difference between for example
+	// a nil and an empty slice. Compare the stored entity with the
+	// serialized form of the new one.
+	raw, err := m.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	next := reflect.New(mb.model)
+	if err := next.Interface().(Model).Unmarshal(raw); err != nil {
+		return errors.Wrap(err, "unmarshal")
+	}
+
+	stored := reflect.ValueOf(obj.Value()).Elem()
+	var errs error
+	for _, f := range mb.immutable {
+		a := stored.FieldByIndex(f.Index).Interface()
+		b := next.Elem().FieldByIndex(f.Index).Interface()
+		if !reflect.DeepEqual(a, b) {
+			errs = errors.AppendField(errs, f.Name, errors.ErrImmutable)
+		}
+	}
+	return errs
+}
+
+// isReserved returns true if given key was declared as reserved.
+func (mb *modelBucket) isReserved(key []byte) bool {
+	for _, r := range mb.reserved {
+		if bytes.Equal(r, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureNotReserved returns ErrImmutable if given key is reserved.
+func (mb *modelBucket) ensureNotReserved(key []byte) error {
+	if mb.isReserved(key) {
+		return errors.Wrapf(errors.ErrImmutable, "key %s is reserved", boundedHex(key))
+	}
+	return nil
+}
+
 func (mb *modelBucket) Delete(db weave.KVStore, key []byte) error {
 	if err := mb.Has(db, key); err != nil {
 		return err
 	}
-	return mb.b.Delete(db, key)
+	if err := mb.ensureNotReserved(key); err != nil {
+		return err
+	}
+	return mb.atomic(db, func(db weave.KVStore) error {
+		return mb.applyChange(db, key, nil, mb.deleteFn(key))
+	})
+}
+
+func (mb *modelBucket) DeleteAndReturn(db weave.KVStore, key []byte, dest Model) error {
+	if err := mb.ensureNotReserved(key); err != nil {
+		return err
+	}
+	if err := mb.One(db, key, dest); err != nil {
+		return err
+	}
+	return mb.atomic(db, func(db weave.KVStore) error {
+		return mb.applyChange(db, key, nil, mb.deleteFn(key))
+	})
+}
+
+// deleteFn returns a function that deletes the entity with given key.
+func (mb *modelBucket) deleteFn(key []byte) func(weave.KVStore) error {
+	return func(db weave.KVStore) error { return mb.b.Delete(db, key) }
+}
+
+func (mb *modelBucket) DeleteMany(db weave.KVStore, keys [][]byte) (int, error) {
+	var deleted int
+	err := mb.atomic(db, func(db weave.KVStore) error {
+		var err error
+		deleted, err = mb.deleteMany(db, keys)
+		return err
+	})
+	return deleted, err
+}
+
+func (mb *modelBucket) deleteMany(db weave.KVStore, keys [][]byte) (int, error) {
+	// Check all keys first, so that an invalid key does not cause a
+	// partial delete.
+	for _, key := range keys {
+		if err := mb.validateKey(key); err != nil {
+			return 0, err
+		}
+		if err := mb.ensureNotReserved(key); err != nil {
+			return 0, err
+		}
+		if !mb.strictDeleteMany {
+			continue
+		}
+		if err := mb.Has(db, key); err != nil {
+			return 0, err
+		}
+	}
+
+	var deleted int
+	for _, key := range keys {
+		switch err := mb.Has(db, key); {
+		case err == nil:
+		case errors.ErrNotFound.Is(err):
+			// Missing or repeated key.
+			continue
+		default:
+			return deleted, err
+		}
+		if err := mb.applyChange(db, key, nil, mb.deleteFn(key)); err != nil {
+			return deleted, errors.Wrapf(err, "delete %s", boundedHex(key))
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (mb *modelBucket) SwapKeys(db weave.KVStore, keyA, keyB []byte) error {
+	a, err := mb.stored(db, keyA)
+	if err != nil {
+		return err
+	}
+	b, err := mb.stored(db, keyB)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(keyA, keyB) {
+		return nil
+	}
+	if err := mb.ensureNotReserved(keyA); err != nil {
+		return err
+	}
+	if err := mb.ensureNotReserved(keyB); err != nil {
+		return err
+	}
+	if err := mb.validModel(a); err != nil {
+		return errors.Wrapf(err, "entity %s", boundedHex(keyA))
+	}
+	if err := mb.validModel(b); err != nil {
+		return errors.Wrapf(err, "entity %s", boundedHex(keyB))
+	}
+	if err := mb.ensureImmutable(db, keyA, b); err != nil {
+		return err
+	}
+	if err := mb.ensureImmutable(db, keyB, a); err != nil {
+		return err
+	}
+
+	write := func(db weave.KVStore) error {
+		// Both entities must be removed before any is saved. Otherwise
+		// an entity would be indexed under both keys and a unique
+		// index would reject it.
+		if err := mb.b.Delete(db, keyA); err != nil {
+			return errors.Wrapf(err, "delete %s", boundedHex(keyA))
+		}
+		if err := mb.b.Delete(db, keyB); err != nil {
+			return errors.Wrapf(err, "delete %s", boundedHex(keyB))
+		}
+		if err := mb.b.Save(db, NewSimpleObj(keyA, b)); err != nil {
+			return errors.Wrapf(err, "save %s", boundedHex(keyA))
+		}
+		if err := mb.b.Save(db, NewSimpleObj(keyB, a)); err != nil {
+			return errors.Wrapf(err, "save %s", boundedHex(keyB))
+		}
+		return nil
+	}
+	notify := func(db weave.KVStore) error {
+		if err := mb.notify(db, ChangeUpdate, keyA, a, b); err != nil {
+			return err
+		}
+		return mb.notify(db, ChangeUpdate, keyB, b, a)
+	}
+
+	return mb.atomic(db, func(db weave.KVStore) error {
+		if _, ok := db.(weave.CacheableKVStore); !ok {
+			// Changes cannot be rolled back, so the change is
+			// written only if all listeners succeeded.
+			if err := notify(db); err != nil {
+				return err
+			}
+			return write(db)
+		}
+		if err := write(db); err != nil {
+			return err
+		}
+		return notify(db)
+	})
+}
+
+// stored returns the entity stored under given key or ErrNotFound.
+func (mb *modelBucket) stored(db weave.ReadOnlyKVStore, key []byte) (Model, error) {
+	if err := mb.validateKey(key); err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, mb.notFound(key)
+	}
+	obj, err := mb.b.Get(db, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load stored entity")
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, mb.notFound(key)
+	}
+	return obj.Value().(Model), nil
+}
+
+func (mb *modelBucket) NewModel() Model {
+	return reflect.New(mb.model).Interface().(Model)
 }
 
 func (mb *modelBucket) Has(db weave.KVStore, key []byte) error {
+	if err := mb.validateKey(key); err != nil {
+		return err
+	}
 	if key == nil {
 		// nil key is a special case that would cause the store API to panic.
-		return errors.ErrNotFound
+		return mb.notFound(key)
 	}
 
 	// As long as we rely on the Bucket implementation to access the
 	// database, we must refine the key.
-	key = mb.b.DBKey(key)
+	dbKey := mb.b.DBKey(key)
 
-	ok, err := db.Has(key)
+	ok, err := db.Has(dbKey)
 	if err != nil {
 		return err
 	}
 	if !ok {
-		return errors.ErrNotFound
+		return mb.notFound(key)
 	}
 	return nil
 }
 
+// validateKey returns ErrInput if the bucket is configured to use keys of a
+// fixed length and given key is of a different length.
+func (mb *modelBucket) validateKey(key []byte) error {
+	if mb.keyLength == 0 || len(key) == mb.keyLength {
+		return nil
+	}
+	return errors.Wrapf(errors.ErrInput, "bucket %q, malformed key %s: expected %d bytes, got %d",
+		mb.name, boundedHex(key), mb.keyLength, len(key))
+}
+
+// notFound returns an ErrNotFound error describing the missing entity.
+func (mb *modelBucket) notFound(key []byte) error {
+	return errors.Wrapf(errors.ErrNotFound, "bucket %q, key %s", mb.name, boundedHex(key))
+}
+
+// maxErrKeyLength is the maximum number of key bytes rendered in an error
+// message. Longer keys are truncated.
+const maxErrKeyLength = 32
+
+// boundedHex returns a hex representation of given key, suitable for an error
+// message. Long keys are truncated.
+func boundedHex(key []byte) string {
+	if len(key) > maxErrKeyLength {
+		return hex.EncodeToString(key[:maxErrKeyLength]) + "..."
+	}
+	return hex.EncodeToString(key)
+}
+
+func (mb *modelBucket) VerifyIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, err
+	}
+	w, ok := idx.(indexWalker)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrHuman, "%T index cannot be verified", idx)
+	}
+
+	var orphans [][]byte
+	err = w.walk(db, func(dbKey, value, ref []byte) error {
+		obj, err := mb.b.Get(db, ref)
+		if err != nil {
+			return errors.Wrapf(err, "get %q", ref)
+		}
+		if obj == nil || obj.Value() == nil {
+			orphans = appendOrphan(orphans, dbKey)
+			return nil
+		}
+		values, err := w.values(obj)
+		if err != nil {
+			return errors.Wrapf(err, "index %q", ref)
+		}
+		for _, v := range values {
+			if bytes.Equal(v, value) {
+				return nil
+			}
+		}
+		orphans = appendOrphan(orphans, dbKey)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orphans, nil
+}
+
+// appendOrphan appends given key to the list unless it is already the last
+// element. A single compact index entry can reference many inconsistent
+// entities and must be reported only once.
+func appendOrphan(orphans [][]byte, key []byte) [][]byte {
+	if n := len(orphans); n > 0 && bytes.Equal(orphans[n-1], key) {
+		return orphans
+	}
+	return append(orphans, key)
+}
+
 var _ ModelBucket = (*modelBucket)(nil)
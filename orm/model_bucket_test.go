@@ -2,10 +2,14 @@ package orm
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
@@ -86,6 +90,132 @@ func TestModelBucketPutSequence(t *testing.T) {
 	}
 }
 
+func TestModelBucketPutBatch(t *testing.T) {
+	db := store.MemStore()
+
+	seq := NewSequence("cnts", "id")
+	b := NewModelBucket("cnts", &Counter{}, WithIDSequence(seq))
+
+	keys, err := b.PutBatch(db, []Model{&Counter{Count: 1}, &Counter{Count: 2}})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)}, keys)
+
+	// Reservation that is only partially used leaves a gap.
+	first, err := seq.NextN(db, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), first)
+	_, err = b.Put(db, weavetest.SequenceID(3), &Counter{Count: 3})
+	assert.Nil(t, err)
+
+	keys, err = b.PutBatch(db, []Model{&Counter{Count: 6}})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(6)}, keys)
+
+	for _, id := range []uint64{4, 5} {
+		if err := b.Has(db, weavetest.SequenceID(id)); !errors.ErrNotFound.Is(err) {
+			t.Fatalf("want not found error for %d, got %+v", id, err)
+		}
+		var c Counter
+		if err := b.One(db, weavetest.SequenceID(id), &c); !errors.ErrNotFound.Is(err) {
+			t.Fatalf("want not found error for %d, got %+v", id, err)
+		}
+	}
+
+	var all []Counter
+	_, err = b.Page(db, nil, 10, &all)
+	assert.Nil(t, err)
+	assert.Equal(t, []Counter{{Count: 1}, {Count: 2}, {Count: 3}, {Count: 6}}, all)
+
+	// An invalid model rejects the whole batch before any key is reserved.
+	if _, err := b.PutBatch(db, []Model{&Counter{Count: 7}, &Counter{Count: -1}}); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	key, err := b.Put(db, nil, &Counter{Count: 7})
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(7), key)
+
+	keys, err = b.PutBatch(db, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
+func TestModelBucketDryRunPut(t *testing.T) {
+	db := store.MemStore()
+
+	b := NewModelBucket("cnts", &CounterWithID{}, WithImmutableFields("PrimaryKey"))
+
+	// Sequence is not consumed, so the same key is returned each time.
+	for i := 0; i < 2; i++ {
+		key, err := b.DryRunPut(db, nil, &CounterWithID{Count: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, weavetest.SequenceID(1), key)
+	}
+	if err := b.Has(db, weavetest.SequenceID(1)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+	key, err := b.Put(db, nil, &CounterWithID{PrimaryKey: []byte("a"), Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(1), key)
+
+	key, err = b.DryRunPut(db, nil, &CounterWithID{Count: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(2), key)
+
+	key, err = b.DryRunPut(db, []byte("c1"), &CounterWithID{Count: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("c1"), key)
+	if err := b.Has(db, []byte("c1")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	cnts := NewModelBucket("other", &Counter{})
+	if _, err := cnts.DryRunPut(db, nil, &Counter{Count: -1}); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	if _, err := b.DryRunPut(db, nil, &Counter{Count: 1}); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+	_, err = b.DryRunPut(db, weavetest.SequenceID(1), &CounterWithID{PrimaryKey: []byte("b"), Count: 1})
+	if !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+
+	var c CounterWithID
+	assert.Nil(t, b.One(db, weavetest.SequenceID(1), &c))
+	assert.Equal(t, []byte("a"), c.PrimaryKey)
+
+	// Unique index constraint is checked the same as by Put.
+	byCount := func(obj Object) ([]byte, error) {
+		return encodeSequence(obj.Value().(*Counter).Count), nil
+	}
+	uniq := NewModelBucket("uniq", &Counter{},
+		WithIndex("count", byCount, true),
+		WithLazyIndex("lazy", byCount, true),
+	)
+	key, err = uniq.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	if _, err := uniq.DryRunPut(db, nil, &Counter{Count: 1}); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+	_, err = uniq.DryRunPut(db, key, &Counter{Count: 1})
+	assert.Nil(t, err)
+	_, err = uniq.DryRunPut(db, nil, &Counter{Count: 2})
+	assert.Nil(t, err)
+
+	// Lazy index constraint is enforced only once the entity is indexed.
+	lazy := NewModelBucket("lazy", &Counter{}, WithLazyIndex("lazy", byCount, true))
+	_, err = lazy.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	_, err = lazy.DryRunPut(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	_, done, err := RebuildIndexChunk(db, lazy, "lazy", nil, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, true, done)
+	if _, err := lazy.DryRunPut(db, nil, &Counter{Count: 1}); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+}
+
 func TestModelBucketByIndex(t *testing.T) {
 	cases := map[string]struct {
 		QueryKey   string
@@ -181,12 +311,451 @@ func TestModelBucketByIndex(t *testing.T) {
 					}
 					assert.Equal(t, tc.WantKeys, keys)
 					assert.Equal(t, tc.WantResPtr, destPtr)
+
+					n, err := b.CountByIndex(db, indexName, []byte(tc.QueryKey))
+					if err != nil {
+						t.Fatalf("unexpected count error: %s", err)
+					}
+					assert.Equal(t, len(tc.WantKeys), n)
 				})
 			}
 		})
 	}
 }
 
+func TestModelBucketByIndexPage(t *testing.T) {
+	db := store.MemStore()
+
+	indexByBigValue := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		// Index by the value, ignoring anything below 1k.
+		raw := strconv.FormatInt(c.Count/1000, 10)
+		return [][]byte{[]byte(raw)}, nil
+	}
+
+	b := NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", indexByBigValue),
+		WithIndex("compact", indexByBigValue, false),
+	)
+
+	// Keys of different length are ordered differently by native and
+	// compact indexes.
+	for i, key := range []string{"b", "aa", "c", "ab", "a"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: 4001 + int64(i)}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+	if _, err := b.Put(db, []byte("x"), &Counter{Count: 1001}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	cases := map[string]struct {
+		wantPages [][][]byte
+		wantDest  []Counter
+		lastKey   []byte
+	}{
+		"native": {
+			// Shorter keys come first.
+			wantPages: [][][]byte{
+				{[]byte("a"), []byte("b")},
+				{[]byte("c"), []byte("aa")},
+				{[]byte("ab")},
+			},
+			wantDest: []Counter{
+				{Count: 4005}, {Count: 4001},
+				{Count: 4003}, {Count: 4002},
+				{Count: 4004},
+			},
+			lastKey: []byte("ab"),
+		},
+		"compact": {
+			wantPages: [][][]byte{
+				{[]byte("a"), []byte("aa")},
+				{[]byte("ab"), []byte("b")},
+				{[]byte("c")},
+			},
+			wantDest: []Counter{
+				{Count: 4005}, {Count: 4002},
+				{Count: 4004}, {Count: 4001},
+				{Count: 4003},
+			},
+			lastKey: []byte("c"),
+		},
+	}
+
+	for indexName, tc := range cases {
+		t.Run(indexName, func(t *testing.T) {
+			var (
+				after []byte
+				pages [][][]byte
+				dest  []Counter
+			)
+			for {
+				next, keys, err := b.ByIndexPage(db, indexName, []byte("4"), after, 2, &dest)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				pages = append(pages, keys)
+				if len(next) == 0 {
+					break
+				}
+				after = next
+			}
+			assert.Equal(t, tc.wantPages, pages)
+			assert.Equal(t, tc.wantDest, dest)
+
+			// Exactly one page of results does not return a
+			// resumption token.
+			var ptrs []*Counter
+			next, keys, err := b.ByIndexPage(db, indexName, []byte("1"), nil, 1, &ptrs)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(next))
+			assert.Equal(t, [][]byte{[]byte("x")}, keys)
+			assert.Equal(t, []*Counter{{Count: 1001}}, ptrs)
+
+			// Resuming after the last key returns no result.
+			next, keys, err = b.ByIndexPage(db, indexName, []byte("4"), tc.lastKey, 2, &ptrs)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(next))
+			assert.Equal(t, 0, len(keys))
+
+			if _, _, err := b.ByIndexPage(db, indexName, []byte("4"), nil, 0, &ptrs); !errors.ErrInput.Is(err) {
+				t.Fatalf("want input error for zero limit, got %+v", err)
+			}
+		})
+	}
+
+	var dest []Counter
+	if _, _, err := b.ByIndexPage(db, "unknown", []byte("4"), nil, 2, &dest); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %+v", err)
+	}
+}
+
+func TestModelBucketPage(t *testing.T) {
+	db := store.MemStore()
+
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("value", func(obj Object) ([]byte, error) {
+			return []byte("all"), nil
+		}, false),
+	)
+
+	var dest []Counter
+	next, err := b.Page(db, nil, 2, &dest)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(next))
+	assert.Equal(t, 0, len(dest))
+
+	for i, key := range []string{"b", "aa", "c", "ab", "a"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i + 1)}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+	// Entities of a bucket with a name sharing the prefix must not be
+	// returned.
+	other := NewModelBucket("cntsx", &Counter{})
+	if _, err := other.Put(db, []byte("a"), &Counter{Count: 100}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	var (
+		after []byte
+		pages [][]byte
+	)
+	for {
+		next, err := b.Page(db, after, 2, &dest)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(next) == 0 {
+			break
+		}
+		pages = append(pages, next)
+		after = next
+	}
+	assert.Equal(t, [][]byte{[]byte("aa"), []byte("b")}, pages)
+	assert.Equal(t, []Counter{
+		{Count: 5}, {Count: 2},
+		{Count: 4}, {Count: 1},
+		{Count: 3},
+	}, dest)
+
+	// Exactly one page of results does not return a resumption token.
+	var ptrs []*Counter
+	next, err = b.Page(db, []byte("ab"), 2, &ptrs)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(next))
+	assert.Equal(t, []*Counter{{Count: 1}, {Count: 3}}, ptrs)
+
+	// Resuming after the last key returns no result.
+	next, err = b.Page(db, []byte("c"), 2, &ptrs)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(next))
+	assert.Equal(t, 2, len(ptrs))
+
+	if _, err := b.Page(db, nil, 0, &ptrs); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for zero limit, got %+v", err)
+	}
+	var wrong []Sequence
+	if _, err := b.Page(db, nil, 2, &wrong); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+}
+
+func TestModelBucketMany(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	for i, key := range []string{"a", "b", "c"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i + 1)}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	var dest []Counter
+	found, err := b.Many(db, [][]byte{[]byte("c"), []byte("missing"), []byte("a")}, &dest)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("c"), []byte("a")}, found)
+	assert.Equal(t, []Counter{{Count: 3}, {Count: 1}}, dest)
+
+	// Results are appended to the destination.
+	var ptrs []*Counter
+	_, err = b.Many(db, [][]byte{[]byte("b")}, &ptrs)
+	assert.Nil(t, err)
+	_, err = b.Many(db, [][]byte{[]byte("a")}, &ptrs)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Counter{{Count: 2}, {Count: 1}}, ptrs)
+
+	found, err = b.Many(db, [][]byte{[]byte("missing")}, &ptrs)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(found))
+	assert.Equal(t, 2, len(ptrs))
+
+	// Destination is validated even if nothing is found.
+	var wrong []Sequence
+	if _, err := b.Many(db, [][]byte{[]byte("missing")}, &wrong); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+	if _, err := b.Many(db, [][]byte{[]byte("a")}, dest); !errors.ErrType.Is(err) {
+		t.Fatalf("want type error, got %+v", err)
+	}
+}
+
+func TestModelBucketVerifyIndex(t *testing.T) {
+	db := store.MemStore()
+
+	indexByBigValue := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		raw := strconv.FormatInt(c.Count/1000, 10)
+		return [][]byte{[]byte(raw)}, nil
+	}
+	indexByValue := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return []byte(strconv.FormatInt(c.Count, 10)), nil
+	}
+
+	b := NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", indexByBigValue),
+		WithIndex("compact", indexByBigValue, false),
+		WithIndex("unique", indexByValue, true),
+	)
+	for _, cnt := range []int64{1001, 2001, 4001, 4002} {
+		if _, err := b.Put(db, nil, &Counter{Count: cnt}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	indexes := []string{"native", "compact", "unique"}
+	for _, name := range indexes {
+		orphans, err := b.VerifyIndex(db, name)
+		if err != nil {
+			t.Fatalf("cannot verify %q index: %s", name, err)
+		}
+		if len(orphans) != 0 {
+			t.Fatalf("want %q index to be consistent, got %q", name, orphans)
+		}
+	}
+
+	if _, err := b.VerifyIndex(db, "unknown"); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %v", err)
+	}
+
+	// Modify the state without updating indexes, to simulate index drift.
+	noindex := NewModelBucket("cnts", &Counter{})
+	if err := noindex.Delete(db, weavetest.SequenceID(2)); err != nil {
+		t.Fatalf("cannot delete counter: %s", err)
+	}
+	if _, err := noindex.Put(db, weavetest.SequenceID(3), &Counter{Count: 5001}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	nativeKey := func(value string, id uint64) []byte {
+		key, err := packNativeIdxKey([][]byte{[]byte("cnts_native"), []byte(value), weavetest.SequenceID(id)})
+		if err != nil {
+			t.Fatalf("cannot build native index key: %s", err)
+		}
+		return key
+	}
+	want := map[string][][]byte{
+		"native": {nativeKey("2", 2), nativeKey("4", 3)},
+		// A compact index entry referencing many entities is reported
+		// once.
+		"compact": {[]byte("_i.cnts_compact:2"), []byte("_i.cnts_compact:4")},
+		"unique":  {[]byte("_i.cnts_unique:2001"), []byte("_i.cnts_unique:4001")},
+	}
+	for _, name := range indexes {
+		orphans, err := b.VerifyIndex(db, name)
+		if err != nil {
+			t.Fatalf("cannot verify %q index: %s", name, err)
+		}
+		assert.Equal(t, want[name], orphans)
+	}
+}
+
+// TestModelBucketDBKeys locks the format of the raw database keys. Light
+// clients rely on it when requesting and verifying Merkle proofs, so any
+// change must be considered a breaking change.
+func TestModelBucketDBKeys(t *testing.T) {
+	db := store.MemStore()
+
+	byValue := func(obj Object) ([]byte, error) {
+		return []byte{byte(obj.Value().(*Counter).Count)}, nil
+	}
+	multiByValue := func(obj Object) ([][]byte, error) {
+		return [][]byte{{byte(obj.Value().(*Counter).Count)}}, nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("value", byValue, true),
+		WithLazyIndex("lazy", byValue, false),
+		WithNativeIndex("native", multiByValue),
+		WithVirtualIndex("virtual", multiByValue),
+	)
+
+	assert.Equal(t, []byte("cnts:"), b.BucketPrefix())
+	assert.Equal(t, []byte("cnts:abc"), b.DBKey([]byte("abc")))
+
+	key, err := b.Put(db, nil, &Counter{Count: 7})
+	assert.Nil(t, err)
+	raw, err := db.Get(b.DBKey(key))
+	assert.Nil(t, err)
+	if len(raw) == 0 {
+		t.Fatal("entity not found under its database key")
+	}
+
+	cases := map[string]struct {
+		IndexName string
+		WantKey   []byte
+		WantErr   *errors.Error
+	}{
+		"compact index": {
+			IndexName: "value",
+			WantKey:   []byte("_i.cnts_value:\x07"),
+		},
+		"lazy index": {
+			IndexName: "lazy",
+			WantKey:   []byte("_i.cnts_lazy:\x07"),
+		},
+		"native index": {
+			IndexName: "native",
+			WantKey:   []byte("_x.\x0bcnts_native\x01\x07"),
+		},
+		"virtual index is not stored": {
+			IndexName: "virtual",
+			WantErr:   errors.ErrInput,
+		},
+		"unknown index": {
+			IndexName: "unknown",
+			WantErr:   ErrInvalidIndex,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key, err := b.IndexDBKey(tc.IndexName, []byte{7})
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.WantKey, key)
+		})
+	}
+
+	// Index keys of stored indexes must point to existing database entries.
+	compactKey, err := b.IndexDBKey("value", []byte{7})
+	assert.Nil(t, err)
+	if ok, err := db.Has(compactKey); err != nil || !ok {
+		t.Fatalf("compact index entry not found: %v", err)
+	}
+	nativeKey, err := b.IndexDBKey("native", []byte{7})
+	assert.Nil(t, err)
+	if ok, err := db.Has(append(nativeKey, append([]byte{byte(len(key))}, key...)...)); err != nil || !ok {
+		t.Fatalf("native index entry not found: %v", err)
+	}
+}
+
+func TestModelBucketImmutableFields(t *testing.T) {
+	db := store.MemStore()
+
+	b := NewModelBucket("cnts", &CounterWithID{}, WithImmutableFields("PrimaryKey"))
+
+	if _, err := b.Put(db, []byte("c1"), &CounterWithID{PrimaryKey: []byte("c1"), Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+	// Mutable fields can be modified.
+	if _, err := b.Put(db, []byte("c1"), &CounterWithID{PrimaryKey: []byte("c1"), Count: 2}); err != nil {
+		t.Fatalf("cannot update counter instance: %s", err)
+	}
+	_, err := b.Put(db, []byte("c1"), &CounterWithID{PrimaryKey: []byte("xx"), Count: 3})
+	if !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	assert.FieldError(t, err, "PrimaryKey", errors.ErrImmutable)
+
+	var c1 CounterWithID
+	if err := b.One(db, []byte("c1"), &c1); err != nil {
+		t.Fatalf("cannot get c1 counter: %s", err)
+	}
+	if c1.Count != 2 {
+		t.Fatalf("unexpected counter state: %d", c1.Count)
+	}
+
+	// Immutable fields are not restricting creation.
+	if _, err := b.Put(db, []byte("c2"), &CounterWithID{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+	// Nil and empty values are serialized the same way.
+	if _, err := b.Put(db, []byte("c2"), &CounterWithID{PrimaryKey: []byte{}, Count: 2}); err != nil {
+		t.Fatalf("cannot update counter instance: %s", err)
+	}
+}
+
+func TestModelBucketImmutableUnknownField(t *testing.T) {
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &CounterWithID{}, WithImmutableFields("Unknown"))
+	})
+}
+
+func TestModelBucketBrokenCodec(t *testing.T) {
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &brokenCodecCounter{})
+	})
+}
+
+// brokenCodecCounter is a model that cannot be deserialized.
+type brokenCodecCounter struct {
+	Counter
+}
+
+func (*brokenCodecCounter) Unmarshal([]byte) error {
+	return errors.Wrap(errors.ErrState, "broken codec")
+}
+
 func TestModelBucketPutWrongModelType(t *testing.T) {
 	db := store.MemStore()
 	b := NewModelBucket("cnts", &Counter{})
@@ -256,6 +825,355 @@ func TestModelBucketHas(t *testing.T) {
 	}
 }
 
+func TestModelBucketDeleteMany(t *testing.T) {
+	cases := map[string]struct {
+		opts        []ModelBucketOption
+		keys        [][]byte
+		wantErr     *errors.Error
+		wantDeleted int
+		wantLeft    []string
+	}{
+		"delete all existing": {
+			keys:        [][]byte{[]byte("a"), []byte("c")},
+			wantDeleted: 2,
+			wantLeft:    []string{"b"},
+		},
+		"missing keys are skipped": {
+			keys:        [][]byte{[]byte("a"), []byte("x"), nil, []byte("b")},
+			wantDeleted: 2,
+			wantLeft:    []string{"c"},
+		},
+		"repeated key is deleted once": {
+			keys:        [][]byte{[]byte("a"), []byte("a")},
+			wantDeleted: 1,
+			wantLeft:    []string{"b", "c"},
+		},
+		"no keys": {
+			keys:        nil,
+			wantDeleted: 0,
+			wantLeft:    []string{"a", "b", "c"},
+		},
+		"strict delete of existing keys": {
+			opts:        []ModelBucketOption{WithStrictDeleteMany()},
+			keys:        [][]byte{[]byte("a"), []byte("b"), []byte("a")},
+			wantDeleted: 2,
+			wantLeft:    []string{"c"},
+		},
+		"strict delete with a missing key does not delete anything": {
+			opts:     []ModelBucketOption{WithStrictDeleteMany()},
+			keys:     [][]byte{[]byte("a"), []byte("x"), []byte("b")},
+			wantErr:  errors.ErrNotFound,
+			wantLeft: []string{"a", "b", "c"},
+		},
+		"malformed key does not delete anything": {
+			opts:     []ModelBucketOption{WithFixedKeyLength(1)},
+			keys:     [][]byte{[]byte("a"), []byte("xx")},
+			wantErr:  errors.ErrInput,
+			wantLeft: []string{"a", "b", "c"},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			opts := append([]ModelBucketOption{
+				WithIndex("value", func(obj Object) ([]byte, error) {
+					c, _ := obj.Value().(*Counter)
+					return []byte(strconv.FormatInt(c.Count%2, 10)), nil
+				}, false),
+			}, tc.opts...)
+			b := NewModelBucket("cnts", &Counter{}, opts...)
+
+			for i, key := range []string{"a", "b", "c"} {
+				if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i)}); err != nil {
+					t.Fatalf("cannot save %q counter: %s", key, err)
+				}
+			}
+
+			deleted, err := b.DeleteMany(db, tc.keys)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.wantDeleted, deleted)
+
+			var left []string
+			it := IterAll("cnts")
+			for {
+				var c Counter
+				key, err := it.Next(db, &c)
+				if errors.ErrIteratorDone.Is(err) {
+					break
+				}
+				assert.Nil(t, err)
+				left = append(left, string(key))
+			}
+			assert.Equal(t, tc.wantLeft, left)
+
+			orphans, err := b.VerifyIndex(db, "value")
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(orphans))
+		})
+	}
+}
+
+func TestModelBucketIndexNames(t *testing.T) {
+	indexer := func(obj Object) ([]byte, error) {
+		c, _ := obj.Value().(*Counter)
+		return []byte(strconv.FormatInt(c.Count, 10)), nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("value", indexer, false),
+		WithNativeIndex("native", asMultiKeyIndexer(indexer)),
+		WithVirtualIndex("virtual", indexer),
+		WithLazyIndex("lazy", indexer, true),
+	)
+
+	names := b.IndexNames()
+	assert.Equal(t, []string{"lazy", "native", "value", "virtual"}, names)
+	for _, name := range names {
+		if _, err := b.Index(name); err != nil {
+			t.Fatalf("cannot access %q index: %s", name, err)
+		}
+	}
+
+	// Returned slice is a copy.
+	names[0] = "modified"
+	assert.Equal(t, "lazy", b.IndexNames()[0])
+
+	assert.Equal(t, 0, len(NewModelBucket("cnts", &Counter{}).IndexNames()))
+}
+
+func TestModelBucketDeleteAndReturn(t *testing.T) {
+	db := store.MemStore()
+
+	indexByValue := func(obj Object) ([]byte, error) {
+		c, _ := obj.Value().(*Counter)
+		return []byte(strconv.FormatInt(c.Count, 10)), nil
+	}
+	indexByParity := func(obj Object) ([][]byte, error) {
+		c, _ := obj.Value().(*Counter)
+		return [][]byte{[]byte(strconv.FormatInt(c.Count%2, 10))}, nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("value", indexByValue, true),
+		WithNativeIndex("parity", indexByParity),
+	)
+	for i, key := range []string{"a", "b", "c"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i + 1)}); err != nil {
+			t.Fatalf("cannot save %q counter: %s", key, err)
+		}
+	}
+
+	var deleted Counter
+	assert.Nil(t, b.DeleteAndReturn(db, []byte("a"), &deleted))
+	assert.Equal(t, int64(1), deleted.Count)
+	assert.IsErr(t, errors.ErrNotFound, b.Has(db, []byte("a")))
+
+	// All index entries of the deleted entity are removed.
+	var found []Counter
+	if _, err := b.ByIndex(db, "value", []byte("1"), &found); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found, got %+v", err)
+	}
+	keys, err := b.ByIndex(db, "parity", []byte("1"), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("c")}, keys)
+	for _, name := range []string{"value", "parity"} {
+		orphans, err := b.VerifyIndex(db, name)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(orphans))
+	}
+
+	assert.IsErr(t, errors.ErrNotFound, b.DeleteAndReturn(db, []byte("a"), &deleted))
+
+	// Entity is not deleted if it cannot be loaded into the destination.
+	assert.IsErr(t, errors.ErrType, b.DeleteAndReturn(db, []byte("b"), &CounterWithID{}))
+	assert.Nil(t, b.Has(db, []byte("b")))
+}
+
+func TestModelBucketSwapKeys(t *testing.T) {
+	db := store.MemStore()
+
+	var changes []string
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("value", func(obj Object) ([]byte, error) {
+			c, _ := obj.Value().(*Counter)
+			return []byte(strconv.FormatInt(c.Count, 10)), nil
+		}, true),
+		WithChangeListener(func(db weave.KVStore, op string, key []byte, old, new Model) error {
+			if op == ChangeUpdate {
+				changes = append(changes, fmt.Sprintf("%s %d->%d", key, old.(*Counter).Count, new.(*Counter).Count))
+			}
+			return nil
+		}),
+	)
+	for i, key := range []string{"a", "b"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i + 1)}); err != nil {
+			t.Fatalf("cannot save %q counter: %s", key, err)
+		}
+	}
+	changes = nil
+
+	if err := b.SwapKeys(db, []byte("a"), []byte("b")); err != nil {
+		t.Fatalf("cannot swap keys: %s", err)
+	}
+	assert.Equal(t, []string{"a 1->2", "b 2->1"}, changes)
+
+	var c Counter
+	assert.Nil(t, b.One(db, []byte("a"), &c))
+	assert.Equal(t, int64(2), c.Count)
+	assert.Nil(t, b.One(db, []byte("b"), &c))
+	assert.Equal(t, int64(1), c.Count)
+
+	// Unique index is referencing the new keys.
+	var found []Counter
+	keys, err := b.ByIndex(db, "value", []byte("1"), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("b")}, keys)
+	orphans, err := b.VerifyIndex(db, "value")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+
+	// Swapping a key with itself does not change anything.
+	changes = nil
+	assert.Nil(t, b.SwapKeys(db, []byte("a"), []byte("a")))
+	assert.Equal(t, 0, len(changes))
+
+	assert.IsErr(t, errors.ErrNotFound, b.SwapKeys(db, []byte("a"), []byte("x")))
+	assert.IsErr(t, errors.ErrNotFound, b.SwapKeys(db, []byte("x"), []byte("a")))
+	assert.IsErr(t, errors.ErrNotFound, b.SwapKeys(db, nil, []byte("a")))
+	assert.Nil(t, b.One(db, []byte("a"), &c))
+	assert.Equal(t, int64(2), c.Count)
+}
+
+func TestModelBucketSwapKeysInvalidEntity(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	if _, err := b.Put(db, []byte("a"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter: %s", err)
+	}
+	// Invalid entity can be stored only by writing directly to the
+	// database, for example by an older version of the code.
+	raw, err := (&Counter{Count: -1}).Marshal()
+	assert.Nil(t, err)
+	assert.Nil(t, db.Set(NewBucket("cnts", &Counter{}).DBKey([]byte("b")), raw))
+
+	assert.IsErr(t, errors.ErrState, b.SwapKeys(db, []byte("a"), []byte("b")))
+	var c Counter
+	assert.Nil(t, b.One(db, []byte("a"), &c))
+	assert.Equal(t, int64(1), c.Count)
+}
+
+func TestModelBucketSwapKeysImmutableFields(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{}, WithImmutableFields("Count"))
+	for i, key := range []string{"a", "b"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(i)}); err != nil {
+			t.Fatalf("cannot save %q counter: %s", key, err)
+		}
+	}
+	assert.IsErr(t, errors.ErrImmutable, b.SwapKeys(db, []byte("a"), []byte("b")))
+}
+
+func TestModelBucketFixedKeyLength(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{}, WithFixedKeyLength(8))
+
+	key, err := b.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(key))
+
+	malformed := []byte(hex.EncodeToString(key))
+
+	var c Counter
+	assert.Nil(t, b.One(db, key, &c))
+	assert.IsErr(t, errors.ErrInput, b.One(db, malformed, &c))
+
+	assert.Nil(t, b.Has(db, key))
+	assert.IsErr(t, errors.ErrInput, b.Has(db, malformed))
+	assert.IsErr(t, errors.ErrInput, b.Has(db, nil))
+	assert.IsErr(t, errors.ErrNotFound, b.Has(db, weavetest.SequenceID(1234)))
+
+	_, err = b.Put(db, malformed, &Counter{Count: 2})
+	assert.IsErr(t, errors.ErrInput, err)
+	_, err = b.Put(db, weavetest.SequenceID(1234), &Counter{Count: 2})
+	assert.Nil(t, err)
+
+	assert.IsErr(t, errors.ErrInput, b.Delete(db, malformed))
+	assert.Nil(t, b.Delete(db, key))
+
+	assert.Panics(t, func() { WithFixedKeyLength(0) })
+}
+
+func TestModelBucketNotFoundErrors(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("unique", func(obj Object) ([]byte, error) {
+			return []byte("x"), nil
+		}, true),
+		WithIndex("multi", func(obj Object) ([]byte, error) {
+			return []byte("x"), nil
+		}, false),
+	)
+
+	longKey := bytes.Repeat([]byte{0xab}, 40)
+
+	cases := map[string]struct {
+		Fn      func() error
+		WantMsg string
+	}{
+		"one": {
+			Fn: func() error {
+				var c Counter
+				return b.One(db, []byte("abc"), &c)
+			},
+			WantMsg: `*orm.Counter not in the store: bucket "cnts", key 616263: not found`,
+		},
+		"one with a long key": {
+			Fn: func() error {
+				var c Counter
+				return b.One(db, longKey, &c)
+			},
+			WantMsg: `*orm.Counter not in the store: bucket "cnts", key ` + strings.Repeat("ab", 32) + `...: not found`,
+		},
+		"delete": {
+			Fn:      func() error { return b.Delete(db, []byte("abc")) },
+			WantMsg: `bucket "cnts", key 616263: not found`,
+		},
+		"has": {
+			Fn:      func() error { return b.Has(db, []byte("abc")) },
+			WantMsg: `bucket "cnts", key 616263: not found`,
+		},
+		"has nil key": {
+			Fn:      func() error { return b.Has(db, nil) },
+			WantMsg: `bucket "cnts", key : not found`,
+		},
+		"unique index": {
+			Fn: func() error {
+				var dest []Counter
+				_, err := b.ByIndex(db, "unique", []byte("abc"), &dest)
+				return err
+			},
+			WantMsg: `bucket "cnts", index "unique", key 616263: not found`,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Fn()
+			if !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+			assert.Equal(t, tc.WantMsg, err.Error())
+		})
+	}
+
+	// A non unique index miss is not an error.
+	var dest []Counter
+	keys, err := b.ByIndex(db, "multi", []byte("abc"), &dest)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+}
+
 func TestIterAll(t *testing.T) {
 	type obj struct {
 		Key   string
@@ -327,6 +1245,90 @@ func TestIterAll(t *testing.T) {
 	}
 }
 
+func TestStreamModels(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	for _, key := range []string{"b", "a", "c"} {
+		c := Counter{Count: int64(key[0])}
+		if _, err := b.Put(db, []byte(key), &c); err != nil {
+			t.Fatalf("cannot save %q: %s", key, err)
+		}
+	}
+	// Entities of a bucket sharing the name prefix must not be streamed.
+	other := NewModelBucket("cntsx", &Counter{})
+	if _, err := other.Put(db, []byte("a"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save: %s", err)
+	}
+
+	newCounter := func() Model { return &Counter{} }
+
+	t.Run("unchanged", func(t *testing.T) {
+		var w bytes.Buffer
+		assert.Nil(t, StreamModels(db, "cnts", newCounter, &w, nil))
+
+		var got []int64
+		for _, raw := range splitDelimited(t, w.Bytes()) {
+			var c Counter
+			assert.Nil(t, c.Unmarshal(raw))
+			got = append(got, c.Count)
+		}
+		assert.Equal(t, []int64{'a', 'b', 'c'}, got)
+	})
+
+	t.Run("transformed", func(t *testing.T) {
+		var w bytes.Buffer
+		toCounterWithID := func(m Model) (proto.Message, error) {
+			c := m.(*Counter)
+			return &CounterWithID{PrimaryKey: []byte{byte(c.Count)}, Count: c.Count * 10}, nil
+		}
+		assert.Nil(t, StreamModels(db, "cnts", newCounter, &w, toCounterWithID))
+
+		var got []CounterWithID
+		for _, raw := range splitDelimited(t, w.Bytes()) {
+			var c CounterWithID
+			assert.Nil(t, c.Unmarshal(raw))
+			got = append(got, c)
+		}
+		assert.Equal(t, []CounterWithID{
+			{PrimaryKey: []byte("a"), Count: 'a' * 10},
+			{PrimaryKey: []byte("b"), Count: 'b' * 10},
+			{PrimaryKey: []byte("c"), Count: 'c' * 10},
+		}, got)
+	})
+
+	t.Run("transform failure", func(t *testing.T) {
+		var w bytes.Buffer
+		fail := func(Model) (proto.Message, error) {
+			return nil, errors.ErrHuman
+		}
+		if err := StreamModels(db, "cnts", newCounter, &w, fail); !errors.ErrHuman.Is(err) {
+			t.Fatalf("want transform error, got %+v", err)
+		}
+		assert.Equal(t, 0, w.Len())
+	})
+
+	t.Run("empty bucket", func(t *testing.T) {
+		var w bytes.Buffer
+		assert.Nil(t, StreamModels(db, "empty", newCounter, &w, nil))
+		assert.Equal(t, 0, w.Len())
+	})
+}
+
+// splitDelimited returns all messages of a length delimited protobuf stream.
+func splitDelimited(t testing.TB, stream []byte) [][]byte {
+	t.Helper()
+	var msgs [][]byte
+	for len(stream) > 0 {
+		size, n := proto.DecodeVarint(stream)
+		if n == 0 || uint64(len(stream)-n) < size {
+			t.Fatalf("malformed stream: %x", stream)
+		}
+		msgs = append(msgs, stream[n:n+int(size)])
+		stream = stream[n+int(size):]
+	}
+	return msgs
+}
+
 func consumeIterAll(t testing.TB, db weave.ReadOnlyKVStore, it *ModelBucketIterator) ([]string, []Counter) {
 	t.Helper()
 
@@ -347,3 +1349,251 @@ func consumeIterAll(t testing.TB, db weave.ReadOnlyKVStore, it *ModelBucketItera
 		}
 	}
 }
+
+func TestModelBucketReleasesIterators(t *testing.T) {
+	tracker := store.NewIteratorTracker()
+	db := tracker.Wrap(store.MemStore().CacheWrap())
+
+	b := NewModelBucket("cnts", &Counter{},
+		WithIndex("compact", func(obj Object) ([]byte, error) {
+			return []byte("x"), nil
+		}, false),
+		WithNativeIndex("native", func(obj Object) ([][]byte, error) {
+			return [][]byte{[]byte("y")}, nil
+		}),
+	)
+	for i := 1; i <= 3; i++ {
+		_, err := b.Put(db, nil, &Counter{Count: int64(i)})
+		assert.Nil(t, err)
+	}
+
+	var counters []Counter
+	_, err := b.ByIndex(db, "compact", []byte("x"), &counters)
+	assert.Nil(t, err)
+	_, err = b.ByIndex(db, "native", []byte("y"), &counters)
+	assert.Nil(t, err)
+	_, _, err = b.ByIndexPage(db, "native", []byte("y"), nil, 1, &counters)
+	assert.Nil(t, err)
+	_, err = b.VerifyIndex(db, "compact")
+	assert.Nil(t, err)
+	_, err = b.VerifyIndex(db, "native")
+	assert.Nil(t, err)
+
+	it := IterAll("cnts")
+	for {
+		var c Counter
+		_, err := it.Next(db, &c)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	b.Register("cnts", qr)
+	_, err = qr.Handler("/cnts").Query(db, weave.PrefixQueryMod, nil)
+	assert.Nil(t, err)
+
+	if leaks := tracker.Leaks(); len(leaks) != 0 {
+		t.Fatalf("%d iterators not released, first created at:\n%s", len(leaks), leaks[0])
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	cases := map[string]struct {
+		Key  []byte
+		Want []byte
+	}{
+		"nil key": {
+			Key:  nil,
+			Want: []byte{0},
+		},
+		"non empty key": {
+			Key:  []byte("abc"),
+			Want: []byte("abc\x00"),
+		},
+		"max byte value": {
+			Key:  []byte{255, 255},
+			Want: []byte{255, 255, 0},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got := NextCursor(tc.Key)
+			if !bytes.Equal(tc.Want, got) {
+				t.Fatalf("want %q, got %q", tc.Want, got)
+			}
+			if bytes.Compare(tc.Key, got) >= 0 {
+				t.Fatal("cursor must be greater than the key")
+			}
+			// Modifying the result must not modify the original key.
+			got[0] = 'X'
+			if bytes.HasPrefix(tc.Key, []byte("X")) {
+				t.Fatal("cursor shares memory with the key")
+			}
+		})
+	}
+}
+
+func TestModelBucketRoundTripCheck(t *testing.T) {
+	db := store.MemStore()
+
+	b := NewModelBucket("cnts", &Counter{}, WithRoundTripCheck())
+	key, err := b.Put(db, nil, &Counter{Count: 42})
+	assert.Nil(t, err)
+	var c Counter
+	assert.Nil(t, b.One(db, key, &c))
+	assert.Equal(t, int64(42), c.Count)
+
+	lossy := NewModelBucket("lossy", &lossyCounter{}, WithRoundTripCheck())
+	if _, err := lossy.Put(db, nil, &lossyCounter{Counter: Counter{Count: 42}}); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// Nothing was written.
+	it, err := db.Iterator(prefixRange([]byte("lossy:")))
+	assert.Nil(t, err)
+	keys, err := consumeIteratorKeys(it)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	// The check is disabled by default.
+	lossy = NewModelBucket("lossy", &lossyCounter{})
+	_, err = lossy.Put(db, nil, &lossyCounter{Counter: Counter{Count: 42}})
+	assert.Nil(t, err)
+}
+
+// lossyCounter is a model with a broken codec that drops the count value when
+// deserialized.
+type lossyCounter struct {
+	Counter
+}
+
+func (c *lossyCounter) Unmarshal(raw []byte) error {
+	if err := c.Counter.Unmarshal(raw); err != nil {
+		return err
+	}
+	c.Count = 0
+	return nil
+}
+
+func TestModelBucketDefensiveCopy(t *testing.T) {
+	db := store.MemStore()
+
+	b := NewModelBucket("als", &aliasingModel{}, WithDefensiveCopy())
+	key, err := b.Put(db, nil, &aliasingModel{Value: []byte("value")})
+	assert.Nil(t, err)
+
+	var m aliasingModel
+	assert.Nil(t, b.One(db, key, &m))
+	m.Value[0] = 'X'
+	var all []*aliasingModel
+	_, err = b.Many(db, [][]byte{key}, &all)
+	assert.Nil(t, err)
+	all[0].Value[1] = 'X'
+
+	assert.Nil(t, b.One(db, key, &m))
+	assert.Equal(t, []byte("value"), m.Value)
+}
+
+func TestDeepCopy(t *testing.T) {
+	type nested struct {
+		Raw []byte
+	}
+	type model struct {
+		Raw    []byte
+		Many   [][]byte
+		Nested *nested
+		Items  []nested
+		Tags   map[string][]byte
+		Any    interface{}
+		Empty  []byte
+	}
+	orig := model{
+		Raw:    []byte("raw"),
+		Many:   [][]byte{[]byte("many")},
+		Nested: &nested{Raw: []byte("nested")},
+		Items:  []nested{{Raw: []byte("item")}},
+		Tags:   map[string][]byte{"tag": []byte("tag")},
+		Any:    &nested{Raw: []byte("any")},
+	}
+	want := model{
+		Raw:    []byte("raw"),
+		Many:   [][]byte{[]byte("many")},
+		Nested: &nested{Raw: []byte("nested")},
+		Items:  []nested{{Raw: []byte("item")}},
+		Tags:   map[string][]byte{"tag": []byte("tag")},
+		Any:    &nested{Raw: []byte("any")},
+	}
+
+	cp := deepCopy(reflect.ValueOf(orig)).Interface().(model)
+	assert.Equal(t, want, cp)
+
+	cp.Raw[0] = 'X'
+	cp.Many[0][0] = 'X'
+	cp.Nested.Raw[0] = 'X'
+	cp.Items[0].Raw[0] = 'X'
+	cp.Tags["tag"][0] = 'X'
+	cp.Any.(*nested).Raw[0] = 'X'
+	assert.Equal(t, want, orig)
+}
+
+// aliasingModel is a model with a codec that does not copy the serialized
+// data, so that the deserialized model shares memory with its source.
+type aliasingModel struct {
+	Value []byte
+}
+
+var _ Model = (*aliasingModel)(nil)
+
+func (m *aliasingModel) Marshal() ([]byte, error) { return m.Value, nil }
+func (m *aliasingModel) Unmarshal(raw []byte) error {
+	m.Value = raw
+	return nil
+}
+func (m *aliasingModel) Validate() error { return nil }
+
+func TestModelBucketReservedKeys(t *testing.T) {
+	db := store.MemStore()
+
+	conf := []byte("config")
+	b := NewModelBucket("cnts", &Counter{}, WithReservedKeys(conf))
+
+	if _, err := b.Put(db, conf, &Counter{Count: 1}); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	if _, err := b.DryRunPut(db, conf, &Counter{Count: 1}); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	assert.Nil(t, b.PutReserved(db, conf, &Counter{Count: 1}))
+	// Reserved entity can be updated using the dedicated method only.
+	assert.Nil(t, b.PutReserved(db, conf, &Counter{Count: 2}))
+	var c Counter
+	assert.Nil(t, b.One(db, conf, &c))
+	assert.Equal(t, int64(2), c.Count)
+
+	key, err := b.Put(db, nil, &Counter{Count: 3})
+	assert.Nil(t, err)
+	if err := b.PutReserved(db, key, &Counter{Count: 3}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+
+	if err := b.Delete(db, conf); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	if err := b.DeleteAndReturn(db, conf, &c); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	if _, err := b.DeleteMany(db, [][]byte{key, conf}); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+	if err := b.SwapKeys(db, key, conf); !errors.ErrImmutable.Is(err) {
+		t.Fatalf("want immutable error, got %+v", err)
+	}
+
+	// Nothing was modified by the rejected operations.
+	assert.Nil(t, b.One(db, conf, &c))
+	assert.Equal(t, int64(2), c.Count)
+	assert.Nil(t, b.One(db, key, &c))
+	assert.Equal(t, int64(3), c.Count)
+}
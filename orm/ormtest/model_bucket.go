@@ -0,0 +1,581 @@
+/*
+Package ormtest provides test doubles of the orm package interfaces, so that
+code depending on them can be unit tested without a real store.
+*/
+package ormtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// MockModelBucket is an in-memory implementation of the orm.ModelBucket
+// interface. Entities are kept in a map, so the database argument of every
+// method is ignored. Entities are stored serialized, which means that a
+// returned model never shares memory with the stored one.
+//
+// Type checks and ErrNotFound semantics are the same as in the bucket
+// returned by orm.NewModelBucket. Indexes are computed from the stored
+// entities on each lookup, so they never drift. Direct index access and query
+// registration are not supported.
+type MockModelBucket struct {
+	model    reflect.Type
+	entities map[string][]byte
+	indexes  map[string]mockIndex
+	reserved map[string]struct{}
+	seq      int64
+}
+
+var _ orm.ModelBucket = (*MockModelBucket)(nil)
+
+type mockIndex struct {
+	indexer orm.MultiKeyIndexer
+	unique  bool
+}
+
+// MockModelBucketOption is implemented by any function that can configure
+// MockModelBucket during creation.
+type MockModelBucketOption func(mb *MockModelBucket)
+
+// WithIndex configures the bucket to provide an index with given name. It
+// works the same way as orm.WithIndex. Indexer value must be a function that
+// implements either orm.Indexer or orm.MultiKeyIndexer interface.
+func WithIndex(name string, indexer interface{}, unique bool) MockModelBucketOption {
+	idx := toMultiKeyIndexer(indexer)
+	return func(mb *MockModelBucket) {
+		mb.indexes[name] = mockIndex{indexer: idx, unique: unique}
+	}
+}
+
+// WithReservedKeys configures the bucket to reject writes to given keys, unless
+// PutReserved is used. It works the same way as orm.WithReservedKeys.
+func WithReservedKeys(keys ...[]byte) MockModelBucketOption {
+	return func(mb *MockModelBucket) {
+		for _, k := range keys {
+			mb.reserved[string(k)] = struct{}{}
+		}
+	}
+}
+
+func toMultiKeyIndexer(indexer interface{}) orm.MultiKeyIndexer {
+	switch fn := indexer.(type) {
+	case orm.MultiKeyIndexer:
+		return fn
+	case func(orm.Object) ([][]byte, error):
+		return fn
+	case orm.Indexer:
+		return singleKeyIndexer(fn)
+	case func(orm.Object) ([]byte, error):
+		return singleKeyIndexer(fn)
+	default:
+		panic(fmt.Sprintf("indexer must implement either Indexer or MultiKeyIndexer interface, got %T", indexer))
+	}
+}
+
+func singleKeyIndexer(fn orm.Indexer) orm.MultiKeyIndexer {
+	return func(obj orm.Object) ([][]byte, error) {
+		key, err := fn(obj)
+		if err != nil || key == nil {
+			return nil, err
+		}
+		return [][]byte{key}, nil
+	}
+}
+
+// NewMockModelBucket returns an empty MockModelBucket instance that stores
+// models of the same type as given one.
+func NewMockModelBucket(m orm.Model, opts ...MockModelBucketOption) *MockModelBucket {
+	tp := reflect.TypeOf(m)
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	mb := &MockModelBucket{
+		model:    tp,
+		entities: make(map[string][]byte),
+		indexes:  make(map[string]mockIndex),
+		reserved: make(map[string]struct{}),
+	}
+	for _, fn := range opts {
+		fn(mb)
+	}
+	return mb
+}
+
+func (mb *MockModelBucket) NewModel() orm.Model {
+	return reflect.New(mb.model).Interface().(orm.Model)
+}
+
+func (mb *MockModelBucket) One(db weave.ReadOnlyKVStore, key []byte, dest orm.Model) error {
+	raw, ok := mb.entities[string(key)]
+	if !ok {
+		return errors.Wrapf(errors.ErrNotFound, "%T not in the store", dest)
+	}
+	if !reflect.PtrTo(mb.model).AssignableTo(reflect.TypeOf(dest)) {
+		return errors.Wrapf(errors.ErrType, "%s cannot be represented as %T", reflect.PtrTo(mb.model), dest)
+	}
+	m, err := mb.load(raw)
+	if err != nil {
+		return err
+	}
+	reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(m).Elem())
+	return nil
+}
+
+func (mb *MockModelBucket) Has(db weave.KVStore, key []byte) error {
+	if _, ok := mb.entities[string(key)]; !ok {
+		return errors.Wrapf(errors.ErrNotFound, "key %X", key)
+	}
+	return nil
+}
+
+func (mb *MockModelBucket) Many(db weave.ReadOnlyKVStore, keys [][]byte, dest orm.ModelSlicePtr) ([][]byte, error) {
+	found := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := mb.entities[string(key)]; ok {
+			found = append(found, key)
+		}
+	}
+	// Destination is validated even if nothing was found.
+	if err := mb.appendModels(found, dest); err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return found, nil
+}
+
+func (mb *MockModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest orm.ModelSlicePtr) ([][]byte, error) {
+	idx, ok := mb.indexes[indexName]
+	if !ok {
+		return nil, errors.Wrap(orm.ErrInvalidIndex, indexName)
+	}
+	keys, err := mb.indexed(idx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		if idx.unique {
+			return nil, errors.Wrapf(errors.ErrNotFound, "index %q, key %X", indexName, key)
+		}
+		return nil, nil
+	}
+	if err := mb.appendModels(keys, dest); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (mb *MockModelBucket) CountByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte) (int, error) {
+	idx, ok := mb.indexes[indexName]
+	if !ok {
+		return 0, errors.Wrap(orm.ErrInvalidIndex, indexName)
+	}
+	keys, err := mb.indexed(idx, key)
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+func (mb *MockModelBucket) ByIndexPage(db weave.ReadOnlyKVStore, indexName string, key []byte, after []byte, limit int, dest orm.ModelSlicePtr) ([]byte, [][]byte, error) {
+	if limit < 1 {
+		return nil, nil, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+	idx, ok := mb.indexes[indexName]
+	if !ok {
+		return nil, nil, errors.Wrap(orm.ErrInvalidIndex, indexName)
+	}
+	keys, err := mb.indexed(idx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keys) == 0 && len(after) == 0 && idx.unique {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "index %q, key %X", indexName, key)
+	}
+	page, more := paginate(keys, after, limit)
+	if len(page) == 0 {
+		return nil, nil, nil
+	}
+	if err := mb.appendModels(page, dest); err != nil {
+		return nil, nil, err
+	}
+	if !more {
+		return nil, page, nil
+	}
+	return page[len(page)-1], page, nil
+}
+
+func (mb *MockModelBucket) Page(db weave.ReadOnlyKVStore, after []byte, limit int, dest orm.ModelSlicePtr) ([]byte, error) {
+	if limit < 1 {
+		return nil, errors.Wrap(errors.ErrInput, "limit must be greater than zero")
+	}
+	page, more := paginate(mb.keys(), after, limit)
+	if len(page) == 0 {
+		return nil, nil
+	}
+	if err := mb.appendModels(page, dest); err != nil {
+		return nil, err
+	}
+	if !more {
+		return nil, nil
+	}
+	return page[len(page)-1], nil
+}
+
+// paginate returns at most limit of the given, ordered keys that are greater
+// than after. It also returns true if more keys are available.
+func paginate(keys [][]byte, after []byte, limit int) ([][]byte, bool) {
+	start := 0
+	if len(after) != 0 {
+		start = sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], after) > 0 })
+	}
+	keys = keys[start:]
+	if len(keys) > limit {
+		return keys[:limit], true
+	}
+	return keys, false
+}
+
+// Index always returns an error, because the mock does not maintain any index
+// that could be accessed directly.
+func (mb *MockModelBucket) Index(name string) (orm.Index, error) {
+	return nil, errors.Wrapf(orm.ErrInvalidIndex, "%s: direct index access is not supported by the mock", name)
+}
+
+func (mb *MockModelBucket) IndexNames() []string {
+	names := make([]string, 0, len(mb.indexes))
+	for name := range mb.indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VerifyIndex never finds an orphan, because the mock indexes are computed on
+// each lookup.
+func (mb *MockModelBucket) VerifyIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	if _, ok := mb.indexes[indexName]; !ok {
+		return nil, errors.Wrap(orm.ErrInvalidIndex, indexName)
+	}
+	return nil, nil
+}
+
+// Register does nothing, because the mock content cannot be queried.
+func (mb *MockModelBucket) Register(name string, r weave.QueryRouter) {}
+
+// BucketPrefix returns an empty prefix, because the mock does not store
+// entities in the database.
+func (mb *MockModelBucket) BucketPrefix() []byte {
+	return []byte{}
+}
+
+// DBKey returns a copy of given key, because the mock does not store entities
+// in the database and therefore does not prefix their keys.
+func (mb *MockModelBucket) DBKey(key []byte) []byte {
+	return append([]byte{}, key...)
+}
+
+// IndexDBKey always returns an error, because the mock indexes are computed on
+// each lookup and never stored in the database.
+func (mb *MockModelBucket) IndexDBKey(indexName string, key []byte) ([]byte, error) {
+	if _, ok := mb.indexes[indexName]; !ok {
+		return nil, errors.Wrap(orm.ErrInvalidIndex, indexName)
+	}
+	return nil, errors.Wrapf(errors.ErrInput, "index %q is not stored in the database", indexName)
+}
+
+func (mb *MockModelBucket) Put(db weave.KVStore, key []byte, m orm.Model) ([]byte, error) {
+	return mb.put(key, m, false)
+}
+
+func (mb *MockModelBucket) PutReserved(db weave.KVStore, key []byte, m orm.Model) error {
+	if _, ok := mb.reserved[string(key)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "key %x is not reserved", key)
+	}
+	_, err := mb.put(key, m, true)
+	return err
+}
+
+func (mb *MockModelBucket) put(key []byte, m orm.Model, allowReserved bool) ([]byte, error) {
+	generated := len(key) == 0
+	key, err := mb.dryRunPut(key, m, allowReserved)
+	if err != nil {
+		return nil, err
+	}
+	if err := mb.save(key, m); err != nil {
+		return nil, err
+	}
+	if generated {
+		mb.seq++
+	}
+	return key, nil
+}
+
+func (mb *MockModelBucket) DryRunPut(db weave.ReadOnlyKVStore, key []byte, m orm.Model) ([]byte, error) {
+	return mb.dryRunPut(key, m, false)
+}
+
+func (mb *MockModelBucket) dryRunPut(key []byte, m orm.Model, allowReserved bool) ([]byte, error) {
+	if err := mb.validModel(m); err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		key = encodeSequence(mb.seq + 1)
+	}
+	if !allowReserved {
+		if err := mb.ensureNotReserved(key); err != nil {
+			return nil, err
+		}
+	}
+	if err := mb.ensureUnique(key, m); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ensureNotReserved returns ErrImmutable if given key is reserved.
+func (mb *MockModelBucket) ensureNotReserved(key []byte) error {
+	if _, ok := mb.reserved[string(key)]; ok {
+		return errors.Wrapf(errors.ErrImmutable, "key %x is reserved", key)
+	}
+	return nil
+}
+
+func (mb *MockModelBucket) PutBatch(db weave.KVStore, models []orm.Model) ([][]byte, error) {
+	if len(models) == 0 {
+		return nil, nil
+	}
+	for i, m := range models {
+		if err := mb.validModel(m); err != nil {
+			return nil, errors.Wrapf(err, "model %d", i)
+		}
+	}
+	keys := make([][]byte, 0, len(models))
+	for i, m := range models {
+		key, err := mb.Put(db, nil, m)
+		if err != nil {
+			for _, k := range keys {
+				delete(mb.entities, string(k))
+			}
+			return nil, errors.Wrapf(err, "model %d", i)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (mb *MockModelBucket) Delete(db weave.KVStore, key []byte) error {
+	if err := mb.Has(db, key); err != nil {
+		return err
+	}
+	if err := mb.ensureNotReserved(key); err != nil {
+		return err
+	}
+	delete(mb.entities, string(key))
+	return nil
+}
+
+func (mb *MockModelBucket) DeleteAndReturn(db weave.KVStore, key []byte, dest orm.Model) error {
+	if err := mb.ensureNotReserved(key); err != nil {
+		return err
+	}
+	if err := mb.One(db, key, dest); err != nil {
+		return err
+	}
+	delete(mb.entities, string(key))
+	return nil
+}
+
+func (mb *MockModelBucket) DeleteMany(db weave.KVStore, keys [][]byte) (int, error) {
+	for _, key := range keys {
+		if err := mb.ensureNotReserved(key); err != nil {
+			return 0, err
+		}
+	}
+	var deleted int
+	for _, key := range keys {
+		if _, ok := mb.entities[string(key)]; !ok {
+			continue
+		}
+		delete(mb.entities, string(key))
+		deleted++
+	}
+	return deleted, nil
+}
+
+// SwapKeys exchanges the entities stored under given keys. Indexes are
+// computed on each lookup, so no index update is necessary.
+func (mb *MockModelBucket) SwapKeys(db weave.KVStore, keyA, keyB []byte) error {
+	if err := mb.Has(db, keyA); err != nil {
+		return err
+	}
+	if err := mb.Has(db, keyB); err != nil {
+		return err
+	}
+	if bytes.Equal(keyA, keyB) {
+		return nil
+	}
+	if err := mb.ensureNotReserved(keyA); err != nil {
+		return err
+	}
+	if err := mb.ensureNotReserved(keyB); err != nil {
+		return err
+	}
+	for _, key := range [][]byte{keyA, keyB} {
+		m, err := mb.load(mb.entities[string(key)])
+		if err != nil {
+			return err
+		}
+		if err := mb.validModel(m); err != nil {
+			return errors.Wrapf(err, "entity %x", key)
+		}
+	}
+	a, b := string(keyA), string(keyB)
+	mb.entities[a], mb.entities[b] = mb.entities[b], mb.entities[a]
+	return nil
+}
+
+// validModel returns an error if given model cannot be stored in this bucket.
+func (mb *MockModelBucket) validModel(m orm.Model) error {
+	tp := reflect.TypeOf(m)
+	if tp.Kind() != reflect.Ptr {
+		return errors.Wrap(errors.ErrType, "model destination must be a pointer")
+	}
+	if mb.model != tp.Elem() {
+		return errors.Wrapf(errors.ErrType, "cannot store %T type in this bucket", m)
+	}
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "invalid model")
+	}
+	return nil
+}
+
+// ensureUnique returns ErrDuplicate if storing given model under given key
+// would break any unique index constraint.
+func (mb *MockModelBucket) ensureUnique(key []byte, m orm.Model) error {
+	for name, idx := range mb.indexes {
+		if !idx.unique {
+			continue
+		}
+		values, err := idx.indexer(orm.NewSimpleObj(key, m))
+		if err != nil {
+			return errors.Wrapf(err, "index %q", name)
+		}
+		for _, v := range values {
+			keys, err := mb.indexed(idx, v)
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				if !bytes.Equal(k, key) {
+					return errors.Wrap(errors.ErrDuplicate, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (mb *MockModelBucket) save(key []byte, m orm.Model) error {
+	raw, err := m.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	mb.entities[string(key)] = raw
+	return nil
+}
+
+func (mb *MockModelBucket) load(raw []byte) (orm.Model, error) {
+	m := mb.NewModel()
+	if err := m.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return m, nil
+}
+
+// keys returns the keys of all stored entities in ascending order.
+func (mb *MockModelBucket) keys() [][]byte {
+	keys := make([][]byte, 0, len(mb.entities))
+	for k := range mb.entities {
+		keys = append(keys, []byte(k))
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys
+}
+
+// indexed returns in ascending order the keys of all entities that given
+// index references under given value.
+func (mb *MockModelBucket) indexed(idx mockIndex, value []byte) ([][]byte, error) {
+	var found [][]byte
+	for _, key := range mb.keys() {
+		m, err := mb.load(mb.entities[string(key)])
+		if err != nil {
+			return nil, err
+		}
+		values, err := idx.indexer(orm.NewSimpleObj(key, m))
+		if err != nil {
+			return nil, errors.Wrap(err, "indexer")
+		}
+		for _, v := range values {
+			if bytes.Equal(v, value) {
+				found = append(found, key)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+// appendModels appends entities with given keys to the destination slice.
+func (mb *MockModelBucket) appendModels(keys [][]byte, destination orm.ModelSlicePtr) error {
+	dest := reflect.ValueOf(destination)
+	if dest.Kind() != reflect.Ptr {
+		return errors.Wrap(errors.ErrType, "destination must be a pointer to slice of models")
+	}
+	if dest.IsNil() {
+		return errors.Wrap(errors.ErrImmutable, "got nil pointer")
+	}
+	dest = dest.Elem()
+	if dest.Kind() != reflect.Slice {
+		return errors.Wrap(errors.ErrType, "destination must be a pointer to slice of models")
+	}
+
+	// It is allowed to pass destination as both []MyModel and []*MyModel
+	sliceOfPointers := dest.Type().Elem().Kind() == reflect.Ptr
+	allowed := dest.Type().Elem()
+	if sliceOfPointers {
+		allowed = allowed.Elem()
+	}
+	if mb.model != allowed {
+		return errors.Wrapf(errors.ErrType, "this bucket operates on %s model and cannot return %s", mb.model, allowed)
+	}
+
+	for _, key := range keys {
+		m, err := mb.load(mb.entities[string(key)])
+		if err != nil {
+			return err
+		}
+		val := reflect.ValueOf(m)
+		if !sliceOfPointers {
+			val = val.Elem()
+		}
+		dest.Set(reflect.Append(dest, val))
+	}
+	return nil
+}
+
+// encodeSequence returns given sequence value encoded the same way as the
+// orm.Sequence does.
+func encodeSequence(val int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(val))
+	return bz
+}
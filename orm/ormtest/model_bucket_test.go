@@ -0,0 +1,197 @@
+package ormtest
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+// TestMockModelBucket runs the same operations against the mock and a real
+// bucket to ensure that the mock behaves the same way.
+func TestMockModelBucket(t *testing.T) {
+	parity := func(obj orm.Object) ([]byte, error) {
+		c := obj.Value().(*orm.Counter)
+		if c.Count%2 == 0 {
+			return []byte("even"), nil
+		}
+		return []byte("odd"), nil
+	}
+	count := func(obj orm.Object) ([]byte, error) {
+		c := obj.Value().(*orm.Counter)
+		return []byte{byte(c.Count)}, nil
+	}
+
+	buckets := map[string]orm.ModelBucket{
+		"real": orm.NewModelBucket("cnts", &orm.Counter{},
+			orm.WithIndex("parity", parity, false),
+			orm.WithIndex("count", count, true),
+			orm.WithReservedKeys([]byte("conf")),
+		),
+		"mock": NewMockModelBucket(&orm.Counter{},
+			WithIndex("parity", parity, false),
+			WithIndex("count", count, true),
+			WithReservedKeys([]byte("conf")),
+		),
+	}
+
+	for name, b := range buckets {
+		t.Run(name, func(t *testing.T) {
+			db := store.MemStore()
+
+			assert.Equal(t, []string{"count", "parity"}, b.IndexNames())
+
+			if err := b.One(db, []byte("missing"), &orm.Counter{}); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			if err := b.Has(db, []byte("missing")); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			if err := b.Delete(db, []byte("missing")); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+
+			if _, err := b.Put(db, nil, &orm.CounterWithID{Count: 1}); !errors.ErrType.Is(err) {
+				t.Fatalf("want type error, got %+v", err)
+			}
+			if _, err := b.Put(db, nil, &orm.Counter{Count: -1}); !errors.ErrState.Is(err) {
+				t.Fatalf("want invalid model error, got %+v", err)
+			}
+
+			key, err := b.DryRunPut(db, nil, &orm.Counter{Count: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, weavetest.SequenceID(1), key)
+			key, err = b.Put(db, nil, &orm.Counter{Count: 1})
+			assert.Nil(t, err)
+			assert.Equal(t, weavetest.SequenceID(1), key)
+			keys, err := b.PutBatch(db, []orm.Model{&orm.Counter{Count: 2}, &orm.Counter{Count: 3}})
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(3)}, keys)
+			_, err = b.Put(db, []byte("x"), &orm.Counter{Count: 4})
+			assert.Nil(t, err)
+			if _, err := b.Put(db, []byte("y"), &orm.Counter{Count: 4}); !errors.ErrDuplicate.Is(err) {
+				t.Fatalf("want duplicate error, got %+v", err)
+			}
+
+			var c orm.Counter
+			assert.Nil(t, b.One(db, weavetest.SequenceID(2), &c))
+			assert.Equal(t, int64(2), c.Count)
+			if err := b.One(db, weavetest.SequenceID(2), &orm.CounterWithID{}); !errors.ErrType.Is(err) {
+				t.Fatalf("want type error, got %+v", err)
+			}
+
+			var odd []orm.Counter
+			keys, err = b.ByIndex(db, "parity", []byte("odd"), &odd)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3)}, keys)
+			assert.Equal(t, []orm.Counter{{Count: 1}, {Count: 3}}, odd)
+			var none []*orm.Counter
+			keys, err = b.ByIndex(db, "parity", []byte("none"), &none)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(keys))
+			if _, err := b.ByIndex(db, "count", []byte{9}, &none); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			if _, err := b.ByIndex(db, "unknown", []byte{1}, &none); !orm.ErrInvalidIndex.Is(err) {
+				t.Fatalf("want invalid index, got %+v", err)
+			}
+			if _, err := b.ByIndex(db, "parity", []byte("odd"), &[]orm.CounterWithID{}); !errors.ErrType.Is(err) {
+				t.Fatalf("want type error, got %+v", err)
+			}
+
+			n, err := b.CountByIndex(db, "parity", []byte("odd"))
+			assert.Nil(t, err)
+			assert.Equal(t, 2, n)
+			n, err = b.CountByIndex(db, "count", []byte{9})
+			assert.Nil(t, err)
+			assert.Equal(t, 0, n)
+			if _, err := b.CountByIndex(db, "unknown", []byte{1}); !orm.ErrInvalidIndex.Is(err) {
+				t.Fatalf("want invalid index, got %+v", err)
+			}
+
+			var even []orm.Counter
+			next, keys, err := b.ByIndexPage(db, "parity", []byte("even"), nil, 1, &even)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{weavetest.SequenceID(2)}, keys)
+			next, keys, err = b.ByIndexPage(db, "parity", []byte("even"), next, 1, &even)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("x")}, keys)
+			assert.Equal(t, 0, len(next))
+			assert.Equal(t, []orm.Counter{{Count: 2}, {Count: 4}}, even)
+
+			var all []orm.Counter
+			next, err = b.Page(db, nil, 3, &all)
+			assert.Nil(t, err)
+			assert.Equal(t, weavetest.SequenceID(3), next)
+			next, err = b.Page(db, next, 3, &all)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(next))
+			assert.Equal(t, 4, len(all))
+
+			var many []orm.Counter
+			keys, err = b.Many(db, [][]byte{[]byte("x"), []byte("missing"), weavetest.SequenceID(1)}, &many)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("x"), weavetest.SequenceID(1)}, keys)
+			assert.Equal(t, []orm.Counter{{Count: 4}, {Count: 1}}, many)
+
+			assert.Nil(t, b.SwapKeys(db, []byte("x"), weavetest.SequenceID(1)))
+			var swapped []orm.Counter
+			keys, err = b.ByIndex(db, "count", []byte{4}, &swapped)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{weavetest.SequenceID(1)}, keys)
+			assert.Nil(t, b.SwapKeys(db, weavetest.SequenceID(1), []byte("x")))
+			if err := b.SwapKeys(db, []byte("x"), []byte("missing")); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+
+			var removed orm.Counter
+			assert.Nil(t, b.DeleteAndReturn(db, []byte("x"), &removed))
+			assert.Equal(t, int64(4), removed.Count)
+			if _, err := b.ByIndex(db, "count", []byte{4}, &none); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			if err := b.DeleteAndReturn(db, []byte("x"), &removed); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			_, err = b.Put(db, []byte("x"), &orm.Counter{Count: 4})
+			assert.Nil(t, err)
+			assert.Nil(t, b.Delete(db, []byte("x")))
+			deleted, err := b.DeleteMany(db, [][]byte{weavetest.SequenceID(1), []byte("missing")})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, deleted)
+			if err := b.Has(db, weavetest.SequenceID(1)); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want not found, got %+v", err)
+			}
+			assert.Nil(t, b.Has(db, weavetest.SequenceID(2)))
+
+			if _, err := b.Put(db, []byte("conf"), &orm.Counter{Count: 100}); !errors.ErrImmutable.Is(err) {
+				t.Fatalf("want immutable error, got %+v", err)
+			}
+			assert.Nil(t, b.PutReserved(db, []byte("conf"), &orm.Counter{Count: 100}))
+			if err := b.PutReserved(db, []byte("y"), &orm.Counter{Count: 101}); !errors.ErrInput.Is(err) {
+				t.Fatalf("want input error, got %+v", err)
+			}
+			if err := b.Delete(db, []byte("conf")); !errors.ErrImmutable.Is(err) {
+				t.Fatalf("want immutable error, got %+v", err)
+			}
+			if err := b.SwapKeys(db, []byte("conf"), weavetest.SequenceID(2)); !errors.ErrImmutable.Is(err) {
+				t.Fatalf("want immutable error, got %+v", err)
+			}
+		})
+	}
+}
+
+func TestMockModelBucketDoesNotShareMemory(t *testing.T) {
+	b := NewMockModelBucket(&orm.CounterWithID{})
+	m := orm.CounterWithID{PrimaryKey: []byte("abc"), Count: 1}
+	key, err := b.Put(nil, nil, &m)
+	assert.Nil(t, err)
+	m.PrimaryKey[0] = 'x'
+
+	var stored orm.CounterWithID
+	assert.Nil(t, b.One(nil, key, &stored))
+	assert.Equal(t, []byte("abc"), stored.PrimaryKey)
+}
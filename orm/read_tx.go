@@ -0,0 +1,49 @@
+package orm
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+)
+
+// ReadTx calls given function with a read only view of the database. Use it
+// when passing a store to code that must not change the state, for example a
+// query or a client helper that reuses functions declared with a writable
+// store.
+//
+// The view is a cache wrap layered on top of the database, that is always
+// discarded once the function returns. Given store is never the database
+// itself, so nothing done within the function can change the state. The view
+// does not isolate the reads any further than the given database does. A
+// handler is executed against a single store of its transaction already, so
+// it does not need this function to observe a consistent state.
+//
+// Given store implements weave.KVStore so that it can be passed to the
+// functions that declare a writable store but only read from it, like a
+// balance check. Any write to that store panics. A write attempt is a
+// programming error and must never be silently ignored.
+//
+// Error returned by the function is returned unchanged.
+func ReadTx(db weave.ReadOnlyKVStore, fn func(db weave.KVStore) error) error {
+	cache := store.NewReadOnlyStore(db).CacheWrap()
+	defer cache.Discard()
+	return fn(readOnlyStore{ReadOnlyKVStore: cache})
+}
+
+// readOnlyStore implements weave.KVStore but panics on any write operation.
+type readOnlyStore struct {
+	weave.ReadOnlyKVStore
+}
+
+var _ weave.KVStore = readOnlyStore{}
+
+func (readOnlyStore) Set(key, value []byte) error {
+	panic("write to a read only store")
+}
+
+func (readOnlyStore) Delete(key []byte) error {
+	panic("delete from a read only store")
+}
+
+func (readOnlyStore) NewBatch() weave.Batch {
+	panic("batch of a read only store")
+}
@@ -0,0 +1,65 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestReadTx(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	_, err := b.Put(db, []byte("a"), &Counter{Count: 1})
+	assert.Nil(t, err)
+
+	var c Counter
+	err = ReadTx(db, func(db weave.KVStore) error {
+		return b.One(db, []byte("a"), &c)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), c.Count)
+
+	// Function error is returned unchanged.
+	err = ReadTx(db, func(db weave.KVStore) error {
+		return b.One(db, []byte("missing"), &c)
+	})
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	// A store that does not support cache wrapping can be used as well.
+	err = ReadTx(readOnlyStore{ReadOnlyKVStore: db}, func(db weave.KVStore) error {
+		return b.One(db, []byte("a"), &c)
+	})
+	assert.Nil(t, err)
+}
+
+func TestReadTxWritePanics(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+
+	assert.Panics(t, func() {
+		_ = ReadTx(db, func(db weave.KVStore) error {
+			_, err := b.Put(db, []byte("a"), &Counter{Count: 1})
+			return err
+		})
+	})
+	assert.Panics(t, func() {
+		_ = ReadTx(db, func(db weave.KVStore) error {
+			return db.Delete([]byte("a"))
+		})
+	})
+	assert.Panics(t, func() {
+		_ = ReadTx(db, func(db weave.KVStore) error {
+			db.NewBatch()
+			return nil
+		})
+	})
+
+	if err := b.Has(db, []byte("a")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("nothing must be written, got %+v", err)
+	}
+}
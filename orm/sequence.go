@@ -36,6 +36,48 @@ func (s *Sequence) NextInt(db weave.KVStore) (int64, error) {
 	return val, err
 }
 
+// Peek returns the value that the next call to NextVal would return, without
+// changing the sequence state.
+func (s *Sequence) Peek(db weave.ReadOnlyKVStore) ([]byte, error) {
+	raw, err := db.Get(s.id)
+	if err != nil {
+		return nil, err
+	}
+	return encodeSequence(decodeSequence(raw) + 1), nil
+}
+
+// NextN reserves n consecutive values of the sequence with a single write and
+// returns the first of them. The sequence state is set to the last reserved
+// value, so values that end up unused leave a gap.
+// Sequence state must be changed only when delivering a transaction, as with
+// any other state change, so that all validators reserve the same values.
+func (s *Sequence) NextN(db weave.KVStore, n int) (uint64, error) {
+	if n < 1 {
+		return 0, errors.Wrapf(errors.ErrInput, "cannot reserve %d values", n)
+	}
+	last, _, err := s.increment(db, int64(n))
+	if err != nil {
+		return 0, err
+	}
+	return uint64(last - int64(n) + 1), nil
+}
+
+// SetMin ensures that the sequence state is at least given value. Next call to
+// NextVal or NextInt is guaranteed to return a value greater than val. The
+// sequence state is never decreased.
+// This is useful when entities with explicitly set IDs are imported, for
+// example from the genesis file.
+func (s *Sequence) SetMin(db weave.KVStore, val int64) error {
+	current, _, err := s.increment(db, 0)
+	if err != nil {
+		return err
+	}
+	if current >= val {
+		return nil
+	}
+	return db.Set(s.id, encodeSequence(val))
+}
+
 func (s *Sequence) increment(db weave.KVStore, inc int64) (int64, []byte, error) {
 	raw, err := db.Get(s.id)
 	if err != nil {
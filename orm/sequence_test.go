@@ -32,6 +32,22 @@ func TestSequence(t *testing.T) {
 	}
 }
 
+func TestSequenceSetMin(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	assert.Nil(t, s.SetMin(db, 10))
+	got, err := s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), got)
+
+	// Sequence state must never be decreased.
+	assert.Nil(t, s.SetMin(db, 5))
+	got, err = s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), got)
+}
+
 func TestSequenceKeyFormat(t *testing.T) {
 	db := store.MemStore()
 	s := NewSequence("bucket", "name")
@@ -79,3 +95,46 @@ func TestValidateSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestSequenceNextN(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	first, err := s.NextN(db, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), first)
+
+	first, err = s.NextN(db, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(6), first)
+
+	// The whole reservation is consumed, even if not all values are used.
+	next, err := s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), next)
+
+	if _, err := s.NextN(db, 0); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	next, err = s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), next)
+}
+
+func TestSequencePeek(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("a", "b")
+
+	for i := 0; i < 2; i++ {
+		val, err := s.Peek(db)
+		assert.Nil(t, err)
+		assert.Equal(t, encodeSequence(1), val)
+	}
+	val, err := s.NextVal(db)
+	assert.Nil(t, err)
+	assert.Equal(t, encodeSequence(1), val)
+
+	val, err = s.Peek(db)
+	assert.Nil(t, err)
+	assert.Equal(t, encodeSequence(2), val)
+}
@@ -0,0 +1,137 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// TimeBucketIndexer returns an indexer that truncates the value of the model
+// field with given name to given granularity and uses the result as the index
+// key. All objects with the time within the same bucket (for example the same
+// day or hour) share the same index key. Use TimeBucketKey to build the key
+// for a query.
+//
+// The field must be a top level field of weave.UnixTime type. A zero field
+// value produces no index entry.
+//
+// The field is looked up using reflection each time an object is indexed. Use
+// WithTimeBucketIndex to validate the field once, when the bucket is created.
+// This function panics if the granularity is not a positive, whole number of
+// seconds.
+func TimeBucketIndexer(fieldName string, granularity time.Duration) MultiKeyIndexer {
+	seconds := timeBucketSeconds(granularity)
+	return func(obj Object) ([][]byte, error) {
+		v, err := indexedValue(obj)
+		if err != nil {
+			return nil, err
+		}
+		f, err := timeBucketField(v.Type(), fieldName)
+		if err != nil {
+			return nil, err
+		}
+		return timeBucketKeys(v.FieldByIndex(f.Index), seconds), nil
+	}
+}
+
+// WithTimeBucketIndex configures the bucket to build a non unique index with
+// given name, using the value of the model field with given name truncated to
+// given granularity as the index key. See TimeBucketIndexer for details.
+// This function panics if the model does not declare a field with given name,
+// if the field is not of weave.UnixTime type or if the granularity is not
+// valid.
+func WithTimeBucketIndex(name, fieldName string, granularity time.Duration) ModelBucketOption {
+	seconds := timeBucketSeconds(granularity)
+	return func(mb *modelBucket) {
+		f, err := timeBucketField(mb.model, fieldName)
+		if err != nil {
+			panic(fmt.Sprintf("%s bucket %q index: %s", mb.name, name, err))
+		}
+		indexer := func(obj Object) ([][]byte, error) {
+			v, err := indexedValue(obj)
+			if err != nil {
+				return nil, err
+			}
+			if v.Type() != mb.model {
+				return nil, errors.Wrapf(errors.ErrType, "can only take index of %s", mb.model)
+			}
+			return timeBucketKeys(v.FieldByIndex(f.Index), seconds), nil
+		}
+		mb.b = mb.b.WithMultiKeyIndex(name, indexer, false)
+		mb.indexNames = append(mb.indexNames, name)
+	}
+}
+
+// TimeBucketKey returns the index key of the bucket that given time belongs
+// to. Use it to query an index created with TimeBucketIndexer or
+// WithTimeBucketIndex. Granularity must be the same as the one used by the
+// index.
+// This function panics if the granularity is not a positive, whole number of
+// seconds.
+func TimeBucketKey(t weave.UnixTime, granularity time.Duration) []byte {
+	return timeBucketKey(t, timeBucketSeconds(granularity))
+}
+
+func timeBucketKey(t weave.UnixTime, seconds int64) []byte {
+	bucket := int64(t) / seconds * seconds
+	// Truncate towards the past for times before the epoch as well.
+	if int64(t) < 0 && int64(t)%seconds != 0 {
+		bucket -= seconds
+	}
+	return encodeSequence(bucket)
+}
+
+// timeBucketSeconds returns the granularity as a number of seconds. It panics
+// if the granularity cannot be represented as weave.UnixTime duration.
+func timeBucketSeconds(granularity time.Duration) int64 {
+	if granularity < time.Second || granularity%time.Second != 0 {
+		panic(fmt.Sprintf("time bucket granularity must be a positive, whole number of seconds, got %s", granularity))
+	}
+	return int64(granularity / time.Second)
+}
+
+// timeBucketKeys returns the index keys for given weave.UnixTime field value.
+// A zero value is not indexed.
+func timeBucketKeys(v reflect.Value, seconds int64) [][]byte {
+	t := weave.UnixTime(v.Int())
+	if t == 0 {
+		return nil
+	}
+	return [][]byte{timeBucketKey(t, seconds)}
+}
+
+var unixTimeType = reflect.TypeOf(weave.UnixTime(0))
+
+// timeBucketField returns the description of the model field that can be
+// used as a time bucket index key.
+func timeBucketField(model reflect.Type, fieldName string) (reflect.StructField, error) {
+	if model.Kind() != reflect.Struct {
+		return reflect.StructField{}, errors.Wrapf(errors.ErrType, "%s is not a structure", model)
+	}
+	f, ok := model.FieldByName(fieldName)
+	if !ok {
+		return f, errors.Wrapf(errors.ErrType, "%s model has no %q field", model, fieldName)
+	}
+	if f.Type != unixTimeType {
+		return f, errors.Wrapf(errors.ErrType, "%s model %q field of type %s is not %s", model, fieldName, f.Type, unixTimeType)
+	}
+	return f, nil
+}
+
+// indexedValue returns the structure value of given object.
+func indexedValue(obj Object) (reflect.Value, error) {
+	if obj == nil {
+		return reflect.Value{}, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	v := reflect.ValueOf(obj.Value())
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+		}
+		v = v.Elem()
+	}
+	return v, nil
+}
@@ -0,0 +1,97 @@
+package orm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestWithTimeBucketIndex(t *testing.T) {
+	const day = 24 * time.Hour
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &TimedCounter{},
+		WithTimeBucketIndex("day", "CreatedAt", day),
+	)
+
+	times := map[string]weave.UnixTime{
+		"a": 86400,         // Start of the second day.
+		"b": 86400*2 - 1,   // End of the second day.
+		"c": 86400 * 2,     // Start of the third day.
+		"d": 86400*2 + 100, // Third day.
+		"e": 0,             // Not indexed.
+	}
+	for key, createdAt := range times {
+		_, err := b.Put(db, []byte(key), &TimedCounter{CreatedAt: createdAt})
+		assert.Nil(t, err)
+	}
+
+	cases := map[string]struct {
+		at   weave.UnixTime
+		want [][]byte
+	}{
+		"first day":               {at: 1, want: nil},
+		"second day":              {at: 86400 + 500, want: [][]byte{[]byte("a"), []byte("b")}},
+		"third day":               {at: 86400 * 2, want: [][]byte{[]byte("c"), []byte("d")}},
+		"end of the third day":    {at: 86400*3 - 1, want: [][]byte{[]byte("c"), []byte("d")}},
+		"start of the fourth day": {at: 86400 * 3, want: nil},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var found []TimedCounter
+			keys, err := b.ByIndex(db, "day", TimeBucketKey(tc.at, day), &found)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, keys)
+		})
+	}
+
+	orphans, err := b.VerifyIndex(db, "day")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+}
+
+func TestWithTimeBucketIndexInvalid(t *testing.T) {
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &TimedCounter{}, WithTimeBucketIndex("x", "Missing", time.Hour))
+	})
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &TimedCounter{}, WithTimeBucketIndex("x", "Count", time.Hour))
+	})
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &TimedCounter{}, WithTimeBucketIndex("x", "CreatedAt", 0))
+	})
+	assert.Panics(t, func() {
+		TimeBucketIndexer("CreatedAt", 1500*time.Millisecond)
+	})
+}
+
+func TestTimeBucketIndexer(t *testing.T) {
+	indexer := TimeBucketIndexer("CreatedAt", time.Hour)
+
+	keys, err := indexer(NewSimpleObj(nil, &TimedCounter{CreatedAt: 3600*5 + 12}))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{TimeBucketKey(3600*5, time.Hour)}, keys)
+
+	keys, err = indexer(NewSimpleObj(nil, &TimedCounter{}))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	if _, err := indexer(NewSimpleObj(nil, &Counter{})); !errors.ErrType.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, err := indexer(nil); !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestTimeBucketKey(t *testing.T) {
+	assert.Equal(t, TimeBucketKey(0, time.Minute), TimeBucketKey(59, time.Minute))
+	assert.Equal(t, TimeBucketKey(-60, time.Minute), TimeBucketKey(-1, time.Minute))
+	assert.Equal(t, TimeBucketKey(-60, time.Minute), TimeBucketKey(-60, time.Minute))
+	if string(TimeBucketKey(-61, time.Minute)) == string(TimeBucketKey(-60, time.Minute)) {
+		t.Fatal("times before the epoch must be truncated towards the past")
+	}
+}
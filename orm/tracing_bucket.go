@@ -0,0 +1,187 @@
+package orm
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// NewTracingModelBucket returns a ModelBucket that logs every operation
+// executed on the wrapped bucket. For each operation its name, the key (hex
+// encoded), the result error and the duration is logged at the debug level.
+//
+// This is a development tool, meant to help understanding how a handler
+// interacts with the state. It should not be used in production.
+func NewTracingModelBucket(mb ModelBucket, logger log.Logger) ModelBucket {
+	return &tracingModelBucket{
+		mb:  mb,
+		log: logger,
+	}
+}
+
+type tracingModelBucket struct {
+	mb  ModelBucket
+	log log.Logger
+}
+
+var _ ModelBucket = (*tracingModelBucket)(nil)
+
+// trace logs a single operation that started at given time.
+func (t *tracingModelBucket) trace(op string, start time.Time, err error, keyvals ...interface{}) {
+	keyvals = append(keyvals, "err", err, "duration", time.Since(start))
+	t.log.Debug("orm "+op, keyvals...)
+}
+
+func (t *tracingModelBucket) One(db weave.ReadOnlyKVStore, key []byte, dest Model) error {
+	start := time.Now()
+	err := t.mb.One(db, key, dest)
+	t.trace("one", start, err, "key", hex.EncodeToString(key))
+	return err
+}
+
+func (t *tracingModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest ModelSlicePtr) ([][]byte, error) {
+	start := time.Now()
+	keys, err := t.mb.ByIndex(db, indexName, key, dest)
+	t.trace("by index", start, err, "index", indexName, "key", hex.EncodeToString(key), "results", len(keys))
+	return keys, err
+}
+
+func (t *tracingModelBucket) CountByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte) (int, error) {
+	start := time.Now()
+	n, err := t.mb.CountByIndex(db, indexName, key)
+	t.trace("count by index", start, err, "index", indexName, "key", hex.EncodeToString(key), "results", n)
+	return n, err
+}
+
+func (t *tracingModelBucket) ByIndexPage(db weave.ReadOnlyKVStore, indexName string, key []byte, after []byte, limit int, dest ModelSlicePtr) ([]byte, [][]byte, error) {
+	start := time.Now()
+	nextAfter, keys, err := t.mb.ByIndexPage(db, indexName, key, after, limit, dest)
+	t.trace("by index page", start, err,
+		"index", indexName, "key", hex.EncodeToString(key), "after", hex.EncodeToString(after),
+		"limit", limit, "results", len(keys))
+	return nextAfter, keys, err
+}
+
+func (t *tracingModelBucket) Page(db weave.ReadOnlyKVStore, after []byte, limit int, dest ModelSlicePtr) ([]byte, error) {
+	start := time.Now()
+	nextAfter, err := t.mb.Page(db, after, limit, dest)
+	t.trace("page", start, err, "after", hex.EncodeToString(after), "limit", limit)
+	return nextAfter, err
+}
+
+func (t *tracingModelBucket) Many(db weave.ReadOnlyKVStore, keys [][]byte, dest ModelSlicePtr) ([][]byte, error) {
+	start := time.Now()
+	found, err := t.mb.Many(db, keys, dest)
+	t.trace("many", start, err, "keys", len(keys), "results", len(found))
+	return found, err
+}
+
+func (t *tracingModelBucket) Index(name string) (Index, error) {
+	start := time.Now()
+	idx, err := t.mb.Index(name)
+	t.trace("index", start, err, "index", name)
+	return idx, err
+}
+
+func (t *tracingModelBucket) BucketPrefix() []byte {
+	return t.mb.BucketPrefix()
+}
+
+func (t *tracingModelBucket) DBKey(key []byte) []byte {
+	return t.mb.DBKey(key)
+}
+
+func (t *tracingModelBucket) IndexDBKey(indexName string, key []byte) ([]byte, error) {
+	return t.mb.IndexDBKey(indexName, key)
+}
+
+func (t *tracingModelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error) {
+	start := time.Now()
+	res, err := t.mb.Put(db, key, m)
+	// When no key is given, the bucket generates one.
+	logKey := res
+	if err != nil {
+		logKey = key
+	}
+	t.trace("put", start, err, "key", hex.EncodeToString(logKey))
+	return res, err
+}
+
+func (t *tracingModelBucket) PutBatch(db weave.KVStore, models []Model) ([][]byte, error) {
+	start := time.Now()
+	keys, err := t.mb.PutBatch(db, models)
+	t.trace("put batch", start, err, "models", len(models))
+	return keys, err
+}
+
+func (t *tracingModelBucket) DryRunPut(db weave.ReadOnlyKVStore, key []byte, m Model) ([]byte, error) {
+	start := time.Now()
+	key, err := t.mb.DryRunPut(db, key, m)
+	t.trace("dry run put", start, err, "key", hex.EncodeToString(key))
+	return key, err
+}
+
+func (t *tracingModelBucket) Delete(db weave.KVStore, key []byte) error {
+	start := time.Now()
+	err := t.mb.Delete(db, key)
+	t.trace("delete", start, err, "key", hex.EncodeToString(key))
+	return err
+}
+
+func (t *tracingModelBucket) DeleteAndReturn(db weave.KVStore, key []byte, dest Model) error {
+	start := time.Now()
+	err := t.mb.DeleteAndReturn(db, key, dest)
+	t.trace("delete and return", start, err, "key", hex.EncodeToString(key))
+	return err
+}
+
+func (t *tracingModelBucket) DeleteMany(db weave.KVStore, keys [][]byte) (int, error) {
+	start := time.Now()
+	deleted, err := t.mb.DeleteMany(db, keys)
+	t.trace("delete many", start, err, "keys", len(keys), "deleted", deleted)
+	return deleted, err
+}
+
+func (t *tracingModelBucket) SwapKeys(db weave.KVStore, keyA, keyB []byte) error {
+	start := time.Now()
+	err := t.mb.SwapKeys(db, keyA, keyB)
+	t.trace("swap keys", start, err, "keyA", hex.EncodeToString(keyA), "keyB", hex.EncodeToString(keyB))
+	return err
+}
+
+func (t *tracingModelBucket) PutReserved(db weave.KVStore, key []byte, m Model) error {
+	start := time.Now()
+	err := t.mb.PutReserved(db, key, m)
+	t.trace("put reserved", start, err, "key", hex.EncodeToString(key))
+	return err
+}
+
+func (t *tracingModelBucket) Has(db weave.KVStore, key []byte) error {
+	start := time.Now()
+	err := t.mb.Has(db, key)
+	t.trace("has", start, err, "key", hex.EncodeToString(key))
+	return err
+}
+
+func (t *tracingModelBucket) Register(name string, r weave.QueryRouter) {
+	start := time.Now()
+	t.mb.Register(name, r)
+	t.trace("register", start, nil, "name", name)
+}
+
+func (t *tracingModelBucket) VerifyIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	start := time.Now()
+	orphans, err := t.mb.VerifyIndex(db, indexName)
+	t.trace("verify index", start, err, "index", indexName, "orphans", len(orphans))
+	return orphans, err
+}
+
+func (t *tracingModelBucket) IndexNames() []string {
+	return t.mb.IndexNames()
+}
+
+func (t *tracingModelBucket) NewModel() Model {
+	return t.mb.NewModel()
+}
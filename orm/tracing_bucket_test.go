@@ -0,0 +1,77 @@
+package orm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestTracingModelBucket(t *testing.T) {
+	db := store.MemStore()
+	logger := &recordingLogger{}
+	b := NewTracingModelBucket(NewModelBucket("cnts", &Counter{},
+		WithIndex("value", func(obj Object) ([]byte, error) {
+			return []byte("x"), nil
+		}, false),
+	), logger)
+
+	key, err := b.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+
+	var c Counter
+	assert.Nil(t, b.One(db, key, &c))
+	assert.Equal(t, int64(1), c.Count)
+
+	var counters []Counter
+	keys, err := b.ByIndex(db, "value", []byte("x"), &counters)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(keys))
+
+	assert.IsErr(t, errors.ErrNotFound, b.Has(db, []byte("missing")))
+	assert.Nil(t, b.Delete(db, key))
+	deleted, err := b.DeleteMany(db, [][]byte{key})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, deleted)
+
+	want := []string{
+		fmt.Sprintf("orm put key=%s err=<nil>", hex.EncodeToString(key)),
+		fmt.Sprintf("orm one key=%s err=<nil>", hex.EncodeToString(key)),
+		"orm by index index=value key=78 results=1 err=<nil>",
+		fmt.Sprintf("orm has key=%s err=", hex.EncodeToString([]byte("missing"))),
+		fmt.Sprintf("orm delete key=%s err=<nil>", hex.EncodeToString(key)),
+		"orm delete many keys=1 deleted=0 err=<nil>",
+	}
+	if len(logger.entries) != len(want) {
+		t.Fatalf("want %d log entries, got %d: %q", len(want), len(logger.entries), logger.entries)
+	}
+	for i, w := range want {
+		if got := logger.entries[i]; len(got) < len(w) || got[:len(w)] != w {
+			t.Errorf("unexpected %d log entry: %q", i, got)
+		}
+	}
+}
+
+// recordingLogger keeps a text representation of all debug messages, without
+// the duration, which is always the last key value pair.
+type recordingLogger struct {
+	entries []string
+}
+
+var _ log.Logger = (*recordingLogger)(nil)
+
+func (l *recordingLogger) Debug(msg string, keyvals ...interface{}) {
+	entry := msg
+	for i := 0; i < len(keyvals)-2; i += 2 {
+		entry += fmt.Sprintf(" %v=%v", keyvals[i], keyvals[i+1])
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func (l *recordingLogger) Info(msg string, keyvals ...interface{})  {}
+func (l *recordingLogger) Error(msg string, keyvals ...interface{}) {}
+func (l *recordingLogger) With(keyvals ...interface{}) log.Logger   { return l }
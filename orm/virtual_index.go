@@ -0,0 +1,149 @@
+package orm
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// newVirtualIndex returns an index that does not store any data. Index values
+// are computed on each lookup, by scanning all entities stored under given
+// prefix.
+func newVirtualIndex(name string, indexer MultiKeyIndexer, prefix []byte, parse func(key, value []byte) (Object, error)) Index {
+	return &virtualIndex{
+		name:    name,
+		indexer: indexer,
+		prefix:  prefix,
+		parse:   parse,
+	}
+}
+
+// virtualIndex is an index implementation that does not maintain any state.
+// Each lookup iterates over all entities of the bucket, which makes its cost
+// O(n). This implementation should be used only for rarely queried values
+// that are not worth the storage cost of a maintained index.
+type virtualIndex struct {
+	name    string
+	indexer MultiKeyIndexer
+	// prefix is the database key prefix of all bucket entities.
+	prefix []byte
+	// parse deserializes an entity stored in the bucket. Given key is the
+	// entity key without the prefix.
+	parse func(key, value []byte) (Object, error)
+}
+
+var _ Index = (*virtualIndex)(nil)
+
+func (ix *virtualIndex) Name() string {
+	return ix.name
+}
+
+// Update is a no-op, because a virtual index does not store any data.
+func (ix *virtualIndex) Update(db weave.KVStore, prev Object, next Object) error {
+	if next == nil && prev == nil {
+		return errors.Wrap(errors.ErrInput, "update requires at least one non-nil object")
+	}
+	return nil
+}
+
+// Keys returns an iterator over keys of all entities that the indexer
+// computes given value for. Each call scans the whole bucket.
+func (ix *virtualIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterator {
+	it, err := db.Iterator(prefixRange(ix.prefix))
+	if err != nil {
+		return &failedIterator{err: errors.Wrap(err, "iterator")}
+	}
+	return &virtualIndexIterator{
+		dbit:  it,
+		index: ix,
+		value: value,
+	}
+}
+
+// keysAfter works like Keys, but the scan starts right after the entity with
+// given key. Keys are returned in the ascending order.
+func (ix *virtualIndex) keysAfter(db weave.ReadOnlyKVStore, value, after []byte) weave.Iterator {
+	start, end := prefixRange(ix.prefix)
+	if len(after) != 0 {
+		start = NextCursor(append(append([]byte{}, ix.prefix...), after...))
+	}
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return &failedIterator{err: errors.Wrap(err, "iterator")}
+	}
+	return &virtualIndexIterator{
+		dbit:  it,
+		index: ix,
+		value: value,
+	}
+}
+
+// walk does not call given function, because a virtual index has no entries
+// that could get out of sync with the bucket content.
+func (ix *virtualIndex) walk(db weave.ReadOnlyKVStore, fn func(dbKey, value, ref []byte) error) error {
+	return nil
+}
+
+func (ix *virtualIndex) values(obj Object) ([][]byte, error) {
+	return ix.indexer(obj)
+}
+
+func (ix *virtualIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	switch mod {
+	case weave.KeyQueryMod:
+		keys, err := consumeIteratorKeys(ix.Keys(db, data))
+		if err != nil {
+			return nil, err
+		}
+		models := make([]weave.Model, len(keys))
+		for i, key := range keys {
+			dbKey := append(append([]byte{}, ix.prefix...), key...)
+			value, err := db.Get(dbKey)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot get %q value", key)
+			}
+			models[i] = weave.Model{Key: dbKey, Value: value}
+		}
+		return models, nil
+	default:
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+}
+
+// virtualIndexIterator returns keys of all entities returned by the database
+// iterator, that are indexed with the expected value.
+type virtualIndexIterator struct {
+	dbit  weave.Iterator
+	index *virtualIndex
+	value []byte
+}
+
+var _ weave.Iterator = (*virtualIndexIterator)(nil)
+
+func (it *virtualIndexIterator) Next() ([]byte, []byte, error) {
+	for {
+		dbKey, raw, err := it.dbit.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := dbKey[len(it.index.prefix):]
+		obj, err := it.index.parse(key, raw)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "parse %q", key)
+		}
+		values, err := it.index.indexer(obj)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "indexer")
+		}
+		for _, v := range values {
+			if bytes.Equal(v, it.value) {
+				return key, nil, nil
+			}
+		}
+	}
+}
+
+func (it *virtualIndexIterator) Release() {
+	it.dbit.Release()
+}
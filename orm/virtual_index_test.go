@@ -0,0 +1,105 @@
+package orm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestModelBucketVirtualIndex(t *testing.T) {
+	db := store.MemStore()
+
+	// Index by the hash of the count, a value that is not worth storing.
+	countHash := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		raw := make([]byte, 8)
+		binary.BigEndian.PutUint64(raw, uint64(c.Count%10))
+		hash := sha256.Sum256(raw)
+		return hash[:], nil
+	}
+	hashOf := func(n uint64) []byte {
+		raw := make([]byte, 8)
+		binary.BigEndian.PutUint64(raw, n)
+		hash := sha256.Sum256(raw)
+		return hash[:]
+	}
+
+	b := NewModelBucket("cnts", &Counter{}, WithVirtualIndex("hash", countHash))
+	for _, c := range []int64{11, 22, 31, 41, 55} {
+		_, err := b.Put(db, nil, &Counter{Count: c})
+		assert.Nil(t, err)
+	}
+
+	// Nothing but the entities and the ID sequence is stored.
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	keys, err := consumeIteratorKeys(it)
+	assert.Nil(t, err)
+	for _, k := range keys {
+		if !bytes.HasPrefix(k, []byte("cnts:")) && !bytes.HasPrefix(k, []byte("_s.")) {
+			t.Fatalf("unexpected key stored: %q", k)
+		}
+	}
+
+	var found []Counter
+	refs, err := b.ByIndex(db, "hash", hashOf(1), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3), weavetest.SequenceID(4)}, refs)
+	assert.Equal(t, []Counter{{Count: 11}, {Count: 31}, {Count: 41}}, found)
+
+	found = nil
+	refs, err = b.ByIndex(db, "hash", hashOf(9), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(refs))
+
+	var page []Counter
+	next, refs, err := b.ByIndexPage(db, "hash", hashOf(1), nil, 2, &page)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3)}, refs)
+	assert.Equal(t, weavetest.SequenceID(3), next)
+	next, refs, err = b.ByIndexPage(db, "hash", hashOf(1), next, 2, &page)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(4)}, refs)
+	assert.Equal(t, 0, len(next))
+	assert.Equal(t, []Counter{{Count: 11}, {Count: 31}, {Count: 41}}, page)
+
+	// Updates are reflected immediately.
+	assert.Nil(t, b.Delete(db, weavetest.SequenceID(3)))
+	_, err = b.Put(db, weavetest.SequenceID(5), &Counter{Count: 51})
+	assert.Nil(t, err)
+	found = nil
+	refs, err = b.ByIndex(db, "hash", hashOf(1), &found)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(4), weavetest.SequenceID(5)}, refs)
+
+	orphans, err := b.VerifyIndex(db, "hash")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orphans))
+
+	qr := weave.NewQueryRouter()
+	b.Register("cnts", qr)
+	models, err := qr.Handler("/cnts/hash").Query(db, weave.KeyQueryMod, hashOf(2))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	assert.Equal(t, append([]byte("cnts:"), weavetest.SequenceID(2)...), models[0].Key)
+}
+
+func TestModelBucketVirtualIndexDuplicate(t *testing.T) {
+	indexer := func(Object) ([]byte, error) { return nil, nil }
+	assert.Panics(t, func() {
+		NewModelBucket("cnts", &Counter{},
+			WithIndex("x", indexer, false),
+			WithVirtualIndex("x", indexer),
+		)
+	})
+}
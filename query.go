@@ -2,6 +2,7 @@ package weave
 
 import (
 	"fmt"
+	"sort"
 )
 
 const (
@@ -88,3 +89,13 @@ func (r QueryRouter) Register(path string, h QueryHandler) {
 func (r QueryRouter) Handler(path string) QueryHandler {
 	return r.routes[path]
 }
+
+// Paths returns all registered paths, sorted in lexicographical order.
+func (r QueryRouter) Paths() []string {
+	paths := make([]string, 0, len(r.routes))
+	for p := range r.routes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/tendermint/iavl"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
@@ -42,6 +43,26 @@ func NewCommitStore(path, name string) CommitStore {
 	return commit
 }
 
+// NewReadOnlyCommitStore opens an existing store with disk backing. The
+// underlying database is opened in read only mode, so it is never modified
+// and a missing database is not created. Any attempt to commit panics.
+func NewReadOnlyCommitStore(path, name string) (CommitStore, error) {
+	db, err := dbm.NewGoLevelDBWithOpts(name, path, &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+	})
+	if err != nil {
+		return CommitStore{}, errors.Wrapf(errors.ErrDatabase, "open %s: %s", name, err)
+	}
+	tree := iavl.NewMutableTree(db, DefaultCacheSize)
+	commit := CommitStore{tree, DefaultHistory}
+	if err := commit.LoadLatestVersion(); err != nil {
+		db.Close()
+		return CommitStore{}, err
+	}
+	return commit, nil
+}
+
 // NewCommitStoreFromTree accepts a preloaded MutableTree and wraps it
 // Mainly designed for test code... or devs who want full control
 func NewCommitStoreFromTree(tree *iavl.MutableTree) CommitStore {
@@ -133,6 +154,16 @@ func (s CommitStore) CacheWrap() store.KVCacheWrap {
 	return s.Adapter().CacheWrap()
 }
 
+// ReadOnlyVersion returns a reader of the state persisted at the given
+// version. The state cannot be modified using the returned store.
+func (s CommitStore) ReadOnlyVersion(version int64) (store.ReadOnlyKVStore, error) {
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "version %d: %s", version, err)
+	}
+	return immutableAdapter{tree: tree}, nil
+}
+
 // func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
 //   return b.Tree.GetVersionedWithProof(key, uint64(version))
 // }
@@ -199,3 +230,42 @@ func (a adapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
 
 	return iter, nil
 }
+
+// immutableAdapter converts a persisted version of the iavl.Tree to match
+// these interfaces.
+type immutableAdapter struct {
+	tree *iavl.ImmutableTree
+}
+
+var _ store.ReadOnlyKVStore = immutableAdapter{}
+
+// Get returns nil iff key doesn't exist. Panics on nil key.
+func (a immutableAdapter) Get(key []byte) ([]byte, error) {
+	_, val := a.tree.Get(key)
+	return val, nil
+}
+
+// Has checks if a key exists. Panics on nil key.
+func (a immutableAdapter) Has(key []byte) (bool, error) {
+	return a.tree.Has(key), nil
+}
+
+// Iterator over a domain of keys in ascending order. End is exclusive.
+func (a immutableAdapter) Iterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, true, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
+
+// ReverseIterator over a domain of keys in descending order. End is exclusive.
+func (a immutableAdapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, false, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
@@ -4,8 +4,13 @@ import (
 	"crypto/rand"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -143,3 +148,83 @@ func randBytes(length int) []byte {
 	rand.Read(res)
 	return res
 }
+
+func TestReadOnlyVersion(t *testing.T) {
+	commit, close := makeCommitStore()
+	defer close()
+
+	for _, v := range []string{"one", "two"} {
+		db := commit.CacheWrap()
+		assert.Nil(t, db.Set([]byte("key"), []byte(v)))
+		assert.Nil(t, db.Set([]byte("key-"+v), []byte(v)))
+		assert.Nil(t, db.Write())
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+
+	db, err := commit.ReadOnlyVersion(1)
+	assert.Nil(t, err)
+	suite.AssertGetHas(t, db, []byte("key"), []byte("one"), true)
+	suite.AssertGetHas(t, db, []byte("key-two"), nil, false)
+
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	var keys []string
+	for {
+		key, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		assert.Nil(t, err)
+		keys = append(keys, string(key))
+	}
+	it.Release()
+	assert.Equal(t, []string{"key", "key-one"}, keys)
+
+	db, err = commit.ReadOnlyVersion(2)
+	assert.Nil(t, err)
+	suite.AssertGetHas(t, db, []byte("key"), []byte("two"), true)
+
+	if _, err := commit.ReadOnlyVersion(3); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+}
+
+func TestReadOnlyCommitStore(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("/tmp", "iavl-adapter-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	if _, err := NewReadOnlyCommitStore(tmpDir, "missing"); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want database error, got %+v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "missing.db")); !os.IsNotExist(err) {
+		t.Fatalf("missing database must not be created: %v", err)
+	}
+
+	// Database can be opened only once, so it is closed before opening
+	// it again in read only mode.
+	ldb, err := dbm.NewGoLevelDB("base", tmpDir)
+	assert.Nil(t, err)
+	commit := NewCommitStoreFromTree(iavl.NewMutableTree(ldb, DefaultCacheSize))
+	for _, v := range []string{"one", "two"} {
+		db := commit.CacheWrap()
+		assert.Nil(t, db.Set([]byte("key"), []byte(v)))
+		assert.Nil(t, db.Write())
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+	want, err := commit.LatestVersion()
+	assert.Nil(t, err)
+	ldb.Close()
+
+	commit, err = NewReadOnlyCommitStore(tmpDir, "base")
+	assert.Nil(t, err)
+	got, err := commit.LatestVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, want, got)
+
+	db, err := commit.ReadOnlyVersion(1)
+	assert.Nil(t, err)
+	suite.AssertGetHas(t, db, []byte("key"), []byte("one"), true)
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"runtime/debug"
+	"sort"
+	"sync"
+)
+
+// IteratorTracker records every iterator created by the stores it wraps until
+// that iterator is released. An iterator that is never released holds a
+// database snapshot and leaks memory. This is a debugging tool, because
+// collecting the creation stack of each iterator is expensive.
+type IteratorTracker struct {
+	mu   sync.Mutex
+	open map[*trackedIterator]struct{}
+}
+
+// NewIteratorTracker returns a tracker with no iterators recorded.
+func NewIteratorTracker() *IteratorTracker {
+	return &IteratorTracker{
+		open: make(map[*trackedIterator]struct{}),
+	}
+}
+
+// Wrap returns a cache wrap that records with this tracker every iterator
+// created by it or by any cache wrap layered on top of it.
+func (t *IteratorTracker) Wrap(kv KVCacheWrap) KVCacheWrap {
+	return &trackingCacheWrap{KVCacheWrap: kv, tracker: t}
+}
+
+// Leaks returns the creation stack of each recorded iterator that was not
+// released yet. Returned iterators are forgotten, so that each leak is
+// reported only once.
+func (t *IteratorTracker) Leaks() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stacks := make([]string, 0, len(t.open))
+	for it := range t.open {
+		stacks = append(stacks, it.stack)
+		delete(t.open, it)
+	}
+	sort.Strings(stacks)
+	return stacks
+}
+
+func (t *IteratorTracker) track(it Iterator) Iterator {
+	tracked := &trackedIterator{
+		Iterator: it,
+		tracker:  t,
+		stack:    string(debug.Stack()),
+	}
+	t.mu.Lock()
+	t.open[tracked] = struct{}{}
+	t.mu.Unlock()
+	return tracked
+}
+
+func (t *IteratorTracker) release(it *trackedIterator) {
+	t.mu.Lock()
+	delete(t.open, it)
+	t.mu.Unlock()
+}
+
+type trackingCacheWrap struct {
+	KVCacheWrap
+	tracker *IteratorTracker
+}
+
+var _ KVCacheWrap = (*trackingCacheWrap)(nil)
+
+func (w *trackingCacheWrap) Iterator(start, end []byte) (Iterator, error) {
+	it, err := w.KVCacheWrap.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return w.tracker.track(it), nil
+}
+
+func (w *trackingCacheWrap) ReverseIterator(start, end []byte) (Iterator, error) {
+	it, err := w.KVCacheWrap.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return w.tracker.track(it), nil
+}
+
+// CacheWrap layers another cache on top of this one. Each iterator of the
+// returned cache wrap creates an iterator of this store, so that it is
+// tracked as well.
+func (w *trackingCacheWrap) CacheWrap() KVCacheWrap {
+	return NewBTreeCacheWrap(w, w.NewBatch(), nil)
+}
+
+// NewBatch returns a batch that writes through this store.
+func (w *trackingCacheWrap) NewBatch() Batch {
+	return NewNonAtomicBatch(w)
+}
+
+type trackedIterator struct {
+	Iterator
+	tracker *IteratorTracker
+	stack   string
+}
+
+func (it *trackedIterator) Release() {
+	it.tracker.release(it)
+	it.Iterator.Release()
+}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+)
+
+func TestIteratorTracker(t *testing.T) {
+	tracker := NewIteratorTracker()
+	db := tracker.Wrap(MemStore().CacheWrap())
+	if err := db.Set([]byte("a"), []byte("1")); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+
+	released, err := db.Iterator(nil, nil)
+	if err != nil {
+		t.Fatalf("cannot create iterator: %s", err)
+	}
+	if _, _, err := released.Next(); err != nil {
+		t.Fatalf("cannot iterate: %s", err)
+	}
+	released.Release()
+
+	// An iterator of a cache layered on top of the tracked store must be
+	// tracked as well.
+	cache := db.CacheWrap()
+	if _, err := cache.ReverseIterator(nil, nil); err != nil {
+		t.Fatalf("cannot create reverse iterator: %s", err)
+	}
+	leaked := func() {
+		if _, err := db.Iterator([]byte("a"), nil); err != nil {
+			t.Fatalf("cannot create iterator: %s", err)
+		}
+	}
+	leaked()
+
+	leaks := tracker.Leaks()
+	if len(leaks) != 2 {
+		t.Fatalf("want 2 leaks, got %d: %q", len(leaks), leaks)
+	}
+	for _, stack := range leaks {
+		if !strings.Contains(stack, "TestIteratorTracker") {
+			t.Errorf("creation stack does not point to the test: %s", stack)
+		}
+	}
+	if leaks := tracker.Leaks(); len(leaks) != 0 {
+		t.Fatalf("leaks must be reported only once, got %q", leaks)
+	}
+
+	// Writes go through the tracked store.
+	if err := cache.Set([]byte("b"), []byte("2")); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+	if err := cache.Write(); err != nil {
+		t.Fatalf("cannot write cache: %s", err)
+	}
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		t.Fatalf("cannot create iterator: %s", err)
+	}
+	defer it.Release()
+	var keys []string
+	for {
+		key, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("cannot iterate: %s", err)
+		}
+		keys = append(keys, string(key))
+	}
+	if strings.Join(keys, ",") != "a,b" {
+		t.Fatalf("unexpected keys: %q", keys)
+	}
+}
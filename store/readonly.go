@@ -0,0 +1,35 @@
+package store
+
+import "github.com/iov-one/weave/errors"
+
+// NewReadOnlyStore returns a store that reads from the given store and
+// rejects every write with ErrDatabase.
+//
+// A cache wrap of the returned store accepts writes, so that code that
+// expects a writable store can be executed against it. Writing such a cache
+// wrap back to the read only store fails.
+func NewReadOnlyStore(kv ReadOnlyKVStore) CacheableKVStore {
+	return readOnlyStore{ReadOnlyKVStore: kv}
+}
+
+type readOnlyStore struct {
+	ReadOnlyKVStore
+}
+
+var _ CacheableKVStore = readOnlyStore{}
+
+func (readOnlyStore) Set(key, value []byte) error {
+	return errors.Wrap(errors.ErrDatabase, "read only store")
+}
+
+func (readOnlyStore) Delete(key []byte) error {
+	return errors.Wrap(errors.ErrDatabase, "read only store")
+}
+
+func (r readOnlyStore) NewBatch() Batch {
+	return NewNonAtomicBatch(r)
+}
+
+func (r readOnlyStore) CacheWrap() KVCacheWrap {
+	return NewBTreeCacheWrap(r, r.NewBatch(), nil)
+}
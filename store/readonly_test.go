@@ -0,0 +1,64 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+)
+
+func TestReadOnlyStore(t *testing.T) {
+	back := MemStore()
+	if err := back.Set([]byte("a"), []byte("1")); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+	db := NewReadOnlyStore(back)
+
+	if v, err := db.Get([]byte("a")); err != nil || string(v) != "1" {
+		t.Fatalf("unexpected get result: %q, %v", v, err)
+	}
+	if err := db.Set([]byte("b"), []byte("2")); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want database error, got %+v", err)
+	}
+	if err := db.Delete([]byte("a")); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want database error, got %+v", err)
+	}
+	batch := db.NewBatch()
+	if err := batch.Set([]byte("b"), []byte("2")); err != nil {
+		t.Fatalf("cannot set batch: %s", err)
+	}
+	if err := batch.Write(); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want database error, got %+v", err)
+	}
+
+	// Cache wrap accepts writes, but cannot be written back.
+	cache := db.CacheWrap()
+	if err := cache.Set([]byte("b"), []byte("2")); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+	if err := cache.Delete([]byte("a")); err != nil {
+		t.Fatalf("cannot delete: %s", err)
+	}
+	if has, err := cache.Has([]byte("a")); err != nil || has {
+		t.Fatalf("unexpected has result: %v, %v", has, err)
+	}
+	nested := cache.CacheWrap()
+	if err := nested.Set([]byte("c"), []byte("3")); err != nil {
+		t.Fatalf("cannot set: %s", err)
+	}
+	if err := nested.Write(); err != nil {
+		t.Fatalf("cannot write nested cache: %s", err)
+	}
+	if err := cache.Write(); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want database error, got %+v", err)
+	}
+
+	for key, want := range map[string]string{"a": "1", "b": "", "c": ""} {
+		v, err := back.Get([]byte(key))
+		if err != nil {
+			t.Fatalf("cannot get %q: %s", key, err)
+		}
+		if string(v) != want {
+			t.Fatalf("backing store %q value changed to %q", key, v)
+		}
+	}
+}
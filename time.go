@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 )
 
 const (
@@ -48,6 +49,18 @@ func AsUnixTime(t time.Time) UnixTime {
 	return UnixTime(t.Unix())
 }
 
+func init() {
+	// Canonical representation of a time is a string as it is much easier
+	// to read than a number.
+	weavejson.Register(UnixTime(0), func(v interface{}) (interface{}, error) {
+		t := v.(UnixTime)
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+		return t.Time().UTC().Format(time.RFC3339), nil
+	})
+}
+
 // UnmarshalJSON supports unmarshaling both as time.Time and from a number.
 // Usually a number is used as a representation of this time in JSON but it is
 // convenient to use a string format in configurations (ie genesis file).
@@ -2,3 +2,6 @@ package weave
 
 // Version should be set by build flags: `git describe --tags`
 var Version = "please set in makefile"
+
+// GitCommit should be set by build flags: `git rev-parse HEAD`
+var GitCommit = "please set in makefile"
@@ -0,0 +1,369 @@
+/*
+Package weavejson provides a deterministic JSON serialization that should be
+used for all data that is read from or written to the genesis file.
+
+The standard library encoding is used for everything except of the types that
+have a canonical encoder registered. A canonical encoder is registered by the
+package that declares the type, for example weave registers encoders for the
+Address, Condition and UnixTime and coin package registers an encoder for the
+Coin. This way serializing the same value always produces the same JSON
+representation and deserializing and serializing again a document that was
+created by this package does not change it.
+*/
+package weavejson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// Encoder returns the canonical representation of given value. Returned value
+// is serialized using the standard library JSON encoding. Value given to the
+// encoder is always of the type that the encoder was registered for.
+type Encoder func(value interface{}) (interface{}, error)
+
+var (
+	encodersMu sync.RWMutex
+	encoders   = make(map[reflect.Type]Encoder)
+)
+
+// Register declares the canonical encoder for the type of the given example
+// value. The encoder is used for both the values and pointers of that type.
+// Registering more than one encoder for the same type panics.
+func Register(example interface{}, enc Encoder) {
+	tp := reflect.TypeOf(example)
+	if tp == nil || enc == nil {
+		panic("weavejson: register requires an example value and an encoder")
+	}
+
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	if _, ok := encoders[tp]; ok {
+		panic("weavejson: encoder already registered for " + tp.String())
+	}
+	encoders[tp] = enc
+}
+
+func encoderFor(tp reflect.Type) (Encoder, bool) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	enc, ok := encoders[tp]
+	return enc, ok
+}
+
+// Marshal returns the canonical JSON representation of given value.
+func Marshal(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := encode(&b, reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// MarshalIndent is like Marshal but applies indent to format the output.
+func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	raw, err := Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := json.Indent(&b, raw, prefix, indent); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return b.Bytes(), nil
+}
+
+// Unmarshal deserialize given JSON representation into dest. Every type that
+// has a canonical encoder registered is expected to accept that
+// representation when deserializing, therefore the standard library decoding
+// is used.
+func Unmarshal(raw []byte, dest interface{}) error {
+	if err := json.Unmarshal(raw, dest); err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return nil
+}
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+func encode(b *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		b.WriteString("null")
+		return nil
+	}
+
+	if enc, ok := encoderFor(v.Type()); ok {
+		return encodeCanonical(b, enc, v)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		if v.Kind() == reflect.Ptr && v.Type().Implements(marshalerType) {
+			if _, ok := encoderFor(v.Type().Elem()); !ok {
+				return encodeMarshaler(b, v)
+			}
+		}
+		return encode(b, v.Elem())
+	}
+
+	if v.Type().Implements(marshalerType) {
+		return encodeMarshaler(b, v)
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(marshalerType) {
+		return encodeMarshaler(b, v.Addr())
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return encodeStruct(b, v)
+	case reflect.Map:
+		return encodeMap(b, v)
+	case reflect.Slice:
+		if v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return encodeStd(b, v.Interface())
+		}
+		return encodeList(b, v)
+	case reflect.Array:
+		return encodeList(b, v)
+	default:
+		return encodeStd(b, v.Interface())
+	}
+}
+
+func encodeCanonical(b *bytes.Buffer, enc Encoder, v reflect.Value) error {
+	val, err := enc(v.Interface())
+	if err != nil {
+		return errors.Wrapf(err, "canonical %s encoding", v.Type())
+	}
+	return encodeStd(b, val)
+}
+
+func encodeMarshaler(b *bytes.Buffer, v reflect.Value) error {
+	raw, err := v.Interface().(json.Marshaler).MarshalJSON()
+	if err != nil {
+		return errors.Wrapf(err, "%s json marshal", v.Type())
+	}
+	if err := json.Compact(b, raw); err != nil {
+		return errors.Wrapf(errors.ErrInput, "%s json marshal: %s", v.Type(), err)
+	}
+	return nil
+}
+
+func encodeStd(b *bytes.Buffer, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	b.Write(raw)
+	return nil
+}
+
+func encodeList(b *bytes.Buffer, v reflect.Value) error {
+	b.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		if err := encode(b, v.Index(i)); err != nil {
+			return errors.Wrapf(err, "index %d", i)
+		}
+	}
+	b.WriteByte(']')
+	return nil
+}
+
+func encodeMap(b *bytes.Buffer, v reflect.Value) error {
+	if v.IsNil() {
+		b.WriteString("null")
+		return nil
+	}
+
+	type entry struct {
+		name  string
+		value reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		var name string
+		switch k.Kind() {
+		case reflect.String:
+			name = k.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			name = strconv.FormatInt(k.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			name = strconv.FormatUint(k.Uint(), 10)
+		default:
+			return errors.Wrapf(errors.ErrType, "unsupported map key type %s", k.Type())
+		}
+		entries = append(entries, entry{name: name, value: v.MapIndex(k)})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+
+	b.WriteByte('{')
+	for i, e := range entries {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		if err := encodeStd(b, e.name); err != nil {
+			return err
+		}
+		b.WriteByte(':')
+		if err := encode(b, e.value); err != nil {
+			return errors.Wrapf(err, "key %q", e.name)
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+func encodeStruct(b *bytes.Buffer, v reflect.Value) error {
+	b.WriteByte('{')
+	var written int
+	for _, f := range structFields(v.Type()) {
+		fv, ok := fieldByIndex(v, f.index)
+		if !ok || (f.omitEmpty && isEmptyValue(fv)) {
+			continue
+		}
+		if written != 0 {
+			b.WriteByte(',')
+		}
+		written++
+		if err := encodeStd(b, f.name); err != nil {
+			return err
+		}
+		b.WriteByte(':')
+		if err := encode(b, fv); err != nil {
+			return errors.Wrapf(err, "field %q", f.name)
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields returns the list of serialized fields of given struct type,
+// following the same naming rules as the standard library encoding. Fields
+// of embedded structures are inlined. When more than one field declares the
+// same name, the least nested one is used.
+func structFields(tp reflect.Type) []field {
+	var (
+		fields []field
+		depths = make(map[string]int)
+	)
+
+	var collect func(tp reflect.Type, index []int)
+	collect = func(tp reflect.Type, index []int) {
+		for i := 0; i < tp.NumField(); i++ {
+			sf := tp.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, opts := tag, ""
+			if n := strings.Index(tag, ","); n >= 0 {
+				name, opts = tag[:n], tag[n+1:]
+			}
+			fieldIndex := append(append([]int{}, index...), i)
+
+			if sf.Anonymous && name == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					collect(ft, fieldIndex)
+					continue
+				}
+			}
+			if sf.PkgPath != "" {
+				// Not exported.
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			if d, ok := depths[name]; ok && d <= len(fieldIndex) {
+				continue
+			}
+			depths[name] = len(fieldIndex)
+			fields = append(fields, field{
+				name:      name,
+				index:     fieldIndex,
+				omitEmpty: hasOption(opts, "omitempty"),
+			})
+		}
+	}
+	collect(tp, nil)
+
+	// Remove fields shadowed by a less nested one declared later.
+	result := fields[:0]
+	for _, f := range fields {
+		if depths[f.name] == len(f.index) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func hasOption(opts, name string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByIndex returns the nested field value. It returns false if the value
+// cannot be reached because of a nil embedded pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, n := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(n)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package weavejson_test
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavejson"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMarshal(t *testing.T) {
+	type embedded struct {
+		Name  string `json:"name"`
+		Inner string `json:"inner"`
+	}
+	type document struct {
+		embedded
+		Name      string            `json:"name"`
+		Skipped   string            `json:"-"`
+		Empty     string            `json:"empty,omitempty"`
+		NoTag     int               `json:",omitempty"`
+		Addr      weave.Address     `json:"addr"`
+		Cond      weave.Condition   `json:"cond"`
+		Time      weave.UnixTime    `json:"time"`
+		Coins     []*coin.Coin      `json:"coins"`
+		Frac      weave.Fraction    `json:"frac"`
+		Bytes     []byte            `json:"bytes"`
+		Labels    map[string]string `json:"labels"`
+		NilCoin   *coin.Coin        `json:"nil_coin"`
+		unexposed string
+	}
+
+	cases := map[string]struct {
+		value interface{}
+		want  string
+	}{
+		"address": {
+			value: weave.Address(fromHex(t, "d6bb2e0d6cefb81c41d0ba3e92ad8c5c7fe1dc35")),
+			want:  `"D6BB2E0D6CEFB81C41D0BA3E92AD8C5C7FE1DC35"`,
+		},
+		"condition": {
+			value: weave.NewCondition("sigs", "ed25519", []byte{0xab, 0x01}),
+			want:  `"sigs/ed25519/AB01"`,
+		},
+		"nil condition": {
+			value: weave.Condition(nil),
+			want:  `""`,
+		},
+		"unix time": {
+			value: weave.UnixTime(1572247483),
+			want:  `"2019-10-28T07:24:43Z"`,
+		},
+		"coin": {
+			value: coin.NewCoin(4, 200000000, "IOV"),
+			want:  `"4.2 IOV"`,
+		},
+		"coin pointer": {
+			value: &coin.Coin{Whole: 1, Fractional: 3, Ticker: "IOV"},
+			want:  `"1.000000003 IOV"`,
+		},
+		"coin without a ticker cannot be represented in human format": {
+			value: coin.Coin{Whole: 7},
+			want:  `{"whole":7}`,
+		},
+		"not normalized coin cannot be represented in human format": {
+			value: coin.Coin{Whole: 1, Fractional: -1, Ticker: "IOV"},
+			want:  `{"whole":1,"fractional":-1,"ticker":"IOV"}`,
+		},
+		"map keys are sorted": {
+			value: map[string]int{"b": 2, "c": 3, "a": 1},
+			want:  `{"a":1,"b":2,"c":3}`,
+		},
+		"structure": {
+			value: document{
+				embedded:  embedded{Name: "embedded", Inner: "inner"},
+				Name:      "outer",
+				Skipped:   "skipped",
+				Addr:      weave.Address(fromHex(t, "0000000000000000000000000000000000000001")),
+				Cond:      weave.NewCondition("sigs", "ed25519", []byte{1}),
+				Time:      0,
+				Coins:     []*coin.Coin{coin.NewCoinp(1, 0, "IOV"), coin.NewCoinp(0, 5, "ETH")},
+				Frac:      weave.Fraction{Numerator: 1, Denominator: 3},
+				Bytes:     []byte("ab"),
+				Labels:    map[string]string{"z": "last", "a": "first"},
+				unexposed: "x",
+			},
+			want: `{"inner":"inner","name":"outer",` +
+				`"addr":"0000000000000000000000000000000000000001",` +
+				`"cond":"sigs/ed25519/01","time":"1970-01-01T00:00:00Z",` +
+				`"coins":["1 IOV","0.000000005 ETH"],` +
+				`"frac":{"numerator":1,"denominator":3},` +
+				`"bytes":"YWI=","labels":{"a":"first","z":"last"},"nil_coin":null}`,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			raw, err := weavejson.Marshal(tc.value)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, string(raw))
+		})
+	}
+}
+
+func TestMarshalInvalidValue(t *testing.T) {
+	if _, err := weavejson.Marshal(weave.UnixTime(300000000000)); err == nil {
+		t.Fatal("time out of range must not be serialized")
+	}
+	if _, err := weavejson.Marshal(weave.Condition("invalid")); err == nil {
+		t.Fatal("invalid condition must not be serialized")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	// Non canonical representation is accepted but serialized to the
+	// canonical form.
+	const doc = `
+		{
+			"address": "d6bb2e0d6cefb81c41d0ba3e92ad8c5c7fe1dc35",
+			"time": 1572247483,
+			"coin": {"whole": 3, "fractional": 100000000, "ticker": "IOV"},
+			"cond": "sigs/ed25519/ab01"
+		}
+	`
+	var got struct {
+		Address weave.Address   `json:"address"`
+		Time    weave.UnixTime  `json:"time"`
+		Coin    coin.Coin       `json:"coin"`
+		Cond    weave.Condition `json:"cond"`
+	}
+	assert.JSONRoundTrip(t, []byte(doc), &got)
+
+	raw, err := weavejson.Marshal(got)
+	assert.Nil(t, err)
+	const want = `{"address":"D6BB2E0D6CEFB81C41D0BA3E92AD8C5C7FE1DC35","time":"2019-10-28T07:24:43Z","coin":"3.1 IOV","cond":"sigs/ed25519/AB01"}`
+	assert.Equal(t, want, string(raw))
+}
+
+func TestMarshalIndent(t *testing.T) {
+	raw, err := weavejson.MarshalIndent(map[string]coin.Coin{"fee": coin.NewCoin(1, 0, "IOV")}, "", "  ")
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"fee\": \"1 IOV\"\n}", string(raw))
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	assert.Panics(t, func() {
+		weavejson.Register(weave.Address{}, func(interface{}) (interface{}, error) { return nil, nil })
+	})
+}
+
+func fromHex(t testing.TB, s string) []byte {
+	t.Helper()
+	a, err := weave.ParseAddress(s)
+	if err != nil {
+		t.Fatalf("cannot parse address: %s", err)
+	}
+	return a
+}
@@ -45,6 +45,15 @@ type WeaveRunner struct {
 	height  int64
 	t       testing.TB
 	app     abci.Application
+
+	// blockTime is the time declared by the next created block. It is
+	// advanced by BlockInterval with every block, so that tests do not
+	// depend on the wall clock.
+	blockTime time.Time
+
+	// proposer and lastCommit are declared by every created block.
+	proposer   weave.ConsensusAddress
+	lastCommit weave.CommitInfo
 }
 
 // NewWeaveRunner creates a WeaveRunner instance that can be used to process
@@ -52,13 +61,22 @@ type WeaveRunner struct {
 // all operations to succeed. Any error results in test failure.
 func NewWeaveRunner(t testing.TB, app abci.Application, chainID string) *WeaveRunner {
 	return &WeaveRunner{
-		chainID: chainID,
-		height:  0,
-		t:       t,
-		app:     app,
+		chainID:   chainID,
+		height:    0,
+		t:         t,
+		app:       app,
+		blockTime: GenesisTime,
 	}
 }
 
+// GenesisTime is the time declared by the genesis and the first block created
+// by a WeaveRunner.
+var GenesisTime = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// BlockInterval is the time between two consecutive blocks created by a
+// WeaveRunner.
+const BlockInterval = 5 * time.Second
+
 // WeaveApp is implemented by a weave application. This is the minimal
 // interface required by the WeaveRunner to be able to connect ABCI and weave
 // APIs together.
@@ -67,6 +85,29 @@ type WeaveApp interface {
 	CheckTx(weave.Tx) error
 }
 
+// SetBlockProposer sets the consensus address of the validator declared as
+// the proposer of all blocks created after this call.
+func (w *WeaveRunner) SetBlockProposer(proposer weave.ConsensusAddress) {
+	w.proposer = proposer
+}
+
+// SetBlockTime sets the time declared by the next created block. Each
+// following block is declared BlockInterval later than the previous one.
+func (w *WeaveRunner) SetBlockTime(t time.Time) {
+	w.blockTime = t
+}
+
+// BlockTime returns the time that will be declared by the next created block.
+func (w *WeaveRunner) BlockTime() time.Time {
+	return w.blockTime
+}
+
+// SetLastCommitInfo sets the information about validators that signed the
+// previous block, declared by all blocks created after this call.
+func (w *WeaveRunner) SetLastCommitInfo(info weave.CommitInfo) {
+	w.lastCommit = info
+}
+
 // InitChain serialize to JSON given genesis and loads it. Loading a genesis is
 // causing a block creation.
 func (w *WeaveRunner) InitChain(genesis interface{}) {
@@ -81,7 +122,7 @@ func (w *WeaveRunner) InitChain(genesis interface{}) {
 		w.t.Fatalf("cannot initialize after a block, height=%d", lastHeight)
 	}
 	w.app.InitChain(abci.RequestInitChain{
-		Time:          time.Now(),
+		Time:          w.blockTime,
 		ChainId:       w.chainID,
 		AppStateBytes: raw,
 	})
@@ -126,15 +167,20 @@ func (w *WeaveRunner) InBlock(executeTx func(WeaveApp) error) bool {
 	w.t.Helper()
 
 	w.height++
+	now := w.blockTime
+	w.blockTime = w.blockTime.Add(BlockInterval)
 
 	initialHash := w.app.Info(abci.RequestInfo{}).LastBlockAppHash
 
 	// BeginBlock will panic on error.
 	w.app.BeginBlock(abci.RequestBeginBlock{
 		Header: abci.Header{
-			ChainID: w.chainID,
-			Height:  w.height,
+			ChainID:         w.chainID,
+			Height:          w.height,
+			Time:            now,
+			ProposerAddress: w.proposer,
 		},
+		LastCommitInfo: w.lastCommit,
 	})
 
 	if err := executeTx(w); err != nil {
@@ -1,10 +1,16 @@
 package assert
 
 import (
+	"encoding/hex"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavejson"
 )
 
 // Tester is the minimal subset of testing.TB needed to run most assert commands
@@ -43,11 +49,130 @@ func isNil(value interface{}) (isnil bool) {
 }
 
 // Equal fails the test if two values are not equal.
+// If both values are protobuf messages of the same type, only the differing
+// fields are reported.
 func Equal(t Tester, want, got interface{}) {
 	t.Helper()
-	if !reflect.DeepEqual(want, got) {
-		t.Fatalf("values not equal \nwant %T %v\n got %T %v", want, want, got, got)
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+	if isProto(want) && isProto(got) && reflect.TypeOf(want) == reflect.TypeOf(got) {
+		if diffs := diff("", reflect.ValueOf(want), reflect.ValueOf(got)); len(diffs) != 0 {
+			t.Fatalf("values not equal, %T fields differ (want != got)\n\t%s", want, strings.Join(diffs, "\n\t"))
+			return
+		}
+	}
+	t.Fatalf("values not equal \nwant %T %v\n got %T %v", want, want, got, got)
+}
+
+// isProto returns true if given value is a protobuf message or a structure
+// that pointer of is a protobuf message.
+func isProto(v interface{}) bool {
+	if _, ok := v.(proto.Message); ok {
+		return true
+	}
+	tp := reflect.TypeOf(v)
+	return tp != nil && tp.Kind() == reflect.Struct && reflect.PtrTo(tp).Implements(protoMessageType)
+}
+
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// diff walks both values and returns a description of every path that the
+// values differ at. Both values must be of the same type.
+func diff(path string, want, got reflect.Value) []string {
+	if want.Type() != got.Type() {
+		return []string{fmt.Sprintf("%s: %s != %s", pathName(path), want.Type(), got.Type())}
+	}
+
+	switch want.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() && got.IsNil() {
+				return nil
+			}
+			return []string{fmt.Sprintf("%s: %s != %s", pathName(path), render(want), render(got))}
+		}
+		return diff(path, want.Elem(), got.Elem())
+	case reflect.Struct:
+		var diffs []string
+		for i := 0; i < want.NumField(); i++ {
+			f := want.Type().Field(i)
+			// Unexported fields cannot be accessed. Protobuf
+			// internal fields are not relevant.
+			if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+				continue
+			}
+			diffs = append(diffs, diff(joinPath(path, f.Name), want.Field(i), got.Field(i))...)
+		}
+		return diffs
+	case reflect.Slice, reflect.Array:
+		if want.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		var diffs []string
+		if want.Len() != got.Len() {
+			diffs = append(diffs, fmt.Sprintf("%s: length %d != %d", pathName(path), want.Len(), got.Len()))
+		}
+		for i := 0; i < want.Len() && i < got.Len(); i++ {
+			diffs = append(diffs, diff(fmt.Sprintf("%s[%d]", path, i), want.Index(i), got.Index(i))...)
+		}
+		return diffs
+	case reflect.Map:
+		var diffs []string
+		for _, k := range want.MapKeys() {
+			p := fmt.Sprintf("%s[%v]", path, k)
+			if v := got.MapIndex(k); v.IsValid() {
+				diffs = append(diffs, diff(p, want.MapIndex(k), v)...)
+			} else {
+				diffs = append(diffs, fmt.Sprintf("%s: %s != <missing>", p, render(want.MapIndex(k))))
+			}
+		}
+		for _, k := range got.MapKeys() {
+			if !want.MapIndex(k).IsValid() {
+				diffs = append(diffs, fmt.Sprintf("%s[%v]: <missing> != %s", path, k, render(got.MapIndex(k))))
+			}
+		}
+		sort.Strings(diffs)
+		return diffs
 	}
+
+	if reflect.DeepEqual(want.Interface(), got.Interface()) {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %s != %s", pathName(path), render(want), render(got))}
+}
+
+// render returns a human readable representation of given value. Addresses
+// are rendered in the hex format.
+func render(v reflect.Value) string {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return "<nil>"
+	}
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 && isAddress(v.Type()) {
+		return hex.EncodeToString(v.Bytes())
+	}
+	// Protobuf text representation contains trailing whitespaces.
+	return strings.TrimSpace(fmt.Sprintf("%v", v.Interface()))
+}
+
+// isAddress returns true if given type is weave.Address. Type is compared by
+// name, because weave package tests are using this package.
+func isAddress(tp reflect.Type) bool {
+	return tp.PkgPath() == "github.com/iov-one/weave" && tp.Name() == "Address"
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func pathName(path string) string {
+	if path == "" {
+		return "<root>"
+	}
+	return path
 }
 
 // Panics will run given function and recover any panic. It will fail the test
@@ -132,3 +257,32 @@ func IsErr(t testing.TB, want, got error) {
 
 	t.Fatalf("want %q, got %+v", want, got)
 }
+
+// JSONRoundTrip deserializes given JSON document into dest and ensures that
+// serializing it back using the canonical weavejson encoding is stable. dest
+// must be a pointer.
+func JSONRoundTrip(t testing.TB, raw []byte, dest interface{}) {
+	t.Helper()
+
+	if err := weavejson.Unmarshal(raw, dest); err != nil {
+		t.Fatalf("cannot unmarshal: %s", err)
+	}
+	first, err := weavejson.Marshal(dest)
+	if err != nil {
+		t.Fatalf("cannot marshal: %s", err)
+	}
+
+	again := reflect.New(reflect.TypeOf(dest).Elem()).Interface()
+	if err := weavejson.Unmarshal(first, again); err != nil {
+		t.Fatalf("cannot unmarshal canonical representation: %s\n%s", err, first)
+	}
+	Equal(t, dest, again)
+
+	second, err := weavejson.Marshal(again)
+	if err != nil {
+		t.Fatalf("cannot marshal again: %s", err)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("serialization is not stable\n%s\n%s", first, second)
+	}
+}
@@ -1,7 +1,13 @@
 package assert
 
-import "testing"
-import "github.com/iov-one/weave/errors"
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
 
 func TestIsErr(t *testing.T) {
 	cases := map[string]struct {
@@ -92,11 +98,112 @@ func TestFieldErrors(t *testing.T) {
 	}
 }
 
-// tmock mocks testing.TB and only counts failure calls. It ignores all other
-// input.
+func TestEqualProtoDiff(t *testing.T) {
+	base := func() *protoConf {
+		return &protoConf{
+			Metadata: &weave.Metadata{Schema: 1},
+			Owner:    weave.Address{0x01, 0xab},
+			Bonuses: []protoBonus{
+				{LockinPeriod: 60},
+				{LockinPeriod: 3600},
+				{LockinPeriod: 7200},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		Want      interface{}
+		Got       interface{}
+		WantFail  bool
+		WantDiffs []string
+	}{
+		"equal messages": {
+			Want:     base(),
+			Got:      base(),
+			WantFail: false,
+		},
+		"nested field differs": {
+			Want: base(),
+			Got: func() *protoConf {
+				c := base()
+				c.Bonuses[2].LockinPeriod = 3600
+				return c
+			}(),
+			WantFail:  true,
+			WantDiffs: []string{"Bonuses[2].LockinPeriod: 7200 != 3600"},
+		},
+		"address is rendered as hex": {
+			Want: base(),
+			Got: func() *protoConf {
+				c := base()
+				c.Owner = weave.Address{0x02, 0xcd}
+				c.Metadata = nil
+				return c
+			}(),
+			WantFail: true,
+			WantDiffs: []string{
+				"Metadata: schema:1 != <nil>",
+				"Owner: 01ab != 02cd",
+			},
+		},
+		"slice length differs": {
+			Want: base(),
+			Got: func() *protoConf {
+				c := base()
+				c.Bonuses = c.Bonuses[:2]
+				return c
+			}(),
+			WantFail:  true,
+			WantDiffs: []string{"Bonuses: length 3 != 2"},
+		},
+		"non protobuf value": {
+			Want:     []int{1, 2},
+			Got:      []int{1, 3},
+			WantFail: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			mock := &tmock{TB: t}
+			Equal(mock, tc.Want, tc.Got)
+			failed := mock.failcalls > 0
+			if tc.WantFail != failed {
+				t.Fatalf("unlexpected failed call state: %d failures", mock.failcalls)
+			}
+			for _, d := range tc.WantDiffs {
+				if !strings.Contains(mock.output, "\t"+d+"\n") && !strings.HasSuffix(mock.output, "\t"+d) {
+					t.Errorf("%q not reported in %q", d, mock.output)
+				}
+			}
+			if want, got := len(tc.WantDiffs), strings.Count(mock.output, "\n\t"); want != got {
+				t.Errorf("want %d differences reported, got %d", want, got)
+			}
+		})
+	}
+}
+
+// protoConf is a protobuf message like structure.
+type protoConf struct {
+	Metadata *weave.Metadata
+	Owner    weave.Address
+	Bonuses  []protoBonus
+}
+
+func (*protoConf) Reset()         {}
+func (*protoConf) String() string { return "protoConf" }
+func (*protoConf) ProtoMessage()  {}
+
+type protoBonus struct {
+	LockinPeriod int64
+}
+
+// tmock mocks testing.TB and only counts failure calls. Failure messages are
+// collected. It ignores all other input.
 type tmock struct {
 	testing.TB
 	failcalls int
+	output    string
 }
 
 func (t *tmock) Error(args ...interface{}) {
@@ -116,5 +223,6 @@ func (t *tmock) Fatal(args ...interface{}) {
 
 func (t *tmock) Fatalf(s string, args ...interface{}) {
 	t.TB.Logf(s, args...)
+	t.output += fmt.Sprintf(s, args...)
 	t.failcalls++
 }
@@ -0,0 +1,151 @@
+package weavetest
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// Chain drives an ABCI application through a sequence of blocks, the same way
+// a consensus engine does. It allows to test the interaction of decorators,
+// handlers, cron tasks and end block hooks across many blocks.
+//
+// Unlike WeaveRunner, Chain does not fail on a transaction error. Results of
+// all operations are returned so that they can be inspected by the test.
+type Chain struct {
+	app     abci.Application
+	chainID string
+	height  int64
+	blocks  []BlockResult
+}
+
+// NewChain returns a chain that is delivering blocks to given application.
+// Application state must be already initialized, for example by calling
+// InitChain.
+func NewChain(app abci.Application) *Chain {
+	return &Chain{
+		app:    app,
+		height: app.Info(abci.RequestInfo{}).LastBlockHeight,
+	}
+}
+
+// WithChainID configures the chain ID declared in the header of all blocks
+// created after this call.
+func (c *Chain) WithChainID(chainID string) *Chain {
+	c.chainID = chainID
+	return c
+}
+
+// BlockResult contains the result of processing a single block.
+type BlockResult struct {
+	Height int64
+	// BeginBlockTags are the tags returned by BeginBlock. This includes
+	// tags of executed cron tasks.
+	BeginBlockTags []common.KVPair
+	// Txs contains the result of each delivered transaction, in the
+	// order of delivery.
+	Txs []abci.ResponseDeliverTx
+	// EndBlockTags are the tags returned by EndBlock. This includes tags
+	// of all end block hooks.
+	EndBlockTags []common.KVPair
+	// ValidatorUpdates are the validator changes returned by EndBlock.
+	ValidatorUpdates []abci.ValidatorUpdate
+	// AppHash is the application hash returned by Commit.
+	AppHash []byte
+}
+
+// TxErr returns the error of the transaction delivered at given position or
+// nil if the transaction succeeded. A registered error code is converted back
+// to the registered error, so that its Is method can be used.
+func (r BlockResult) TxErr(i int) error {
+	res := r.Txs[i]
+	if res.Code == 0 {
+		return nil
+	}
+	return errors.ABCIError(res.Code, res.Log)
+}
+
+// DeliverBlock creates a block of given height and time that contains given
+// transactions. BeginBlock, DeliverTx for each transaction, EndBlock and
+// Commit are called, in that order.
+// A transaction that cannot be serialized is not delivered and its failure is
+// included in the result as if the delivery failed.
+//
+// Block heights must increase. Gaps are allowed, so that a test can jump
+// forward in time. This function panics if given height is not greater than
+// the height of the last block.
+func (c *Chain) DeliverBlock(height int64, now time.Time, txs ...weave.Tx) BlockResult {
+	if height <= c.height {
+		panic(fmt.Sprintf("block height %d is not greater than the last block height %d", height, c.height))
+	}
+	c.height = height
+
+	res := BlockResult{Height: height}
+
+	begin := c.app.BeginBlock(abci.RequestBeginBlock{
+		Header: abci.Header{
+			ChainID: c.chainID,
+			Height:  height,
+			Time:    now,
+		},
+	})
+	res.BeginBlockTags = begin.Tags
+
+	for _, tx := range txs {
+		raw, err := tx.Marshal()
+		if err != nil {
+			res.Txs = append(res.Txs, weave.DeliverTxError(errors.Wrap(err, "marshal"), false))
+			continue
+		}
+		res.Txs = append(res.Txs, c.app.DeliverTx(raw))
+	}
+
+	end := c.app.EndBlock(abci.RequestEndBlock{Height: height})
+	res.EndBlockTags = end.Tags
+	res.ValidatorUpdates = end.ValidatorUpdates
+
+	res.AppHash = c.app.Commit().Data
+
+	c.blocks = append(c.blocks, res)
+	return res
+}
+
+// Blocks returns the results of all blocks delivered by this chain, in the
+// order of delivery.
+func (c *Chain) Blocks() []BlockResult {
+	return c.blocks
+}
+
+// AssertStableAppHash runs given scenario twice, each time using a chain
+// created for a new application instance returned by newApp. Test fails if
+// both runs did not produce blocks with the same heights and the same
+// application hashes. Use it to ensure that state transitions are
+// deterministic, for example that they do not depend on a map iteration order
+// or the wall clock.
+func AssertStableAppHash(t testing.TB, newApp func() abci.Application, scenario func(*Chain)) {
+	t.Helper()
+
+	first := NewChain(newApp())
+	scenario(first)
+	second := NewChain(newApp())
+	scenario(second)
+
+	a, b := first.Blocks(), second.Blocks()
+	if len(a) != len(b) {
+		t.Fatalf("first run created %d blocks, second run created %d blocks", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].Height != b[i].Height {
+			t.Fatalf("block %d: height %d != %d", i, a[i].Height, b[i].Height)
+		}
+		if !bytes.Equal(a[i].AppHash, b[i].AppHash) {
+			t.Fatalf("block %d: app hash %X != %X", a[i].Height, a[i].AppHash, b[i].AppHash)
+		}
+	}
+}
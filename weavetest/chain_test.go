@@ -0,0 +1,133 @@
+package weavetest
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestChain(t *testing.T) {
+	app := &hashingApp{}
+	chain := NewChain(app)
+	now := time.Now()
+
+	ok := &Tx{Msg: &Msg{Serialized: []byte("ok")}}
+	fail := &Tx{Msg: &Msg{Serialized: []byte("fail")}}
+	broken := &Tx{Msg: &Msg{Err: errors.ErrInput}}
+
+	res := chain.DeliverBlock(1, now, ok, fail, broken)
+	if n := len(res.Txs); n != 3 {
+		t.Fatalf("want 3 transaction results, got %d", n)
+	}
+	if err := res.TxErr(0); err != nil {
+		t.Fatalf("want first transaction to succeed, got %s", err)
+	}
+	if err := res.TxErr(1); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if err := res.TxErr(2); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+	if app.delivered != 1 {
+		t.Fatalf("want 1 transaction delivered, got %d", app.delivered)
+	}
+	if len(res.BeginBlockTags) != 1 || string(res.BeginBlockTags[0].Value) != "1" {
+		t.Fatalf("unexpected begin block tags: %v", res.BeginBlockTags)
+	}
+	if len(res.EndBlockTags) != 1 || string(res.EndBlockTags[0].Value) != "1" {
+		t.Fatalf("unexpected end block tags: %v", res.EndBlockTags)
+	}
+
+	// Heights can skip but must increase.
+	chain.DeliverBlock(5, now.Add(time.Hour))
+	if n := len(chain.Blocks()); n != 2 {
+		t.Fatalf("want 2 blocks, got %d", n)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("delivering a block with not increasing height must panic")
+			}
+		}()
+		chain.DeliverBlock(5, now.Add(2*time.Hour))
+	}()
+}
+
+func TestAssertStableAppHash(t *testing.T) {
+	var tb testing.TB = &failureRecorder{TB: t}
+	AssertStableAppHash(tb,
+		func() abci.Application { return &hashingApp{} },
+		func(c *Chain) {
+			c.DeliverBlock(1, time.Now(), &Tx{Msg: &Msg{Serialized: []byte("ok")}})
+		})
+	if tb.(*failureRecorder).failed {
+		t.Fatal("identical runs must produce the same app hash")
+	}
+
+	var run int
+	tb = &failureRecorder{TB: t}
+	AssertStableAppHash(tb,
+		func() abci.Application { return &hashingApp{} },
+		func(c *Chain) {
+			run++
+			c.DeliverBlock(1, time.Now(), &Tx{Msg: &Msg{Serialized: []byte(strconv.Itoa(run))}})
+		})
+	if !tb.(*failureRecorder).failed {
+		t.Fatal("different runs must not produce the same app hash")
+	}
+}
+
+// failureRecorder records a test failure instead of failing the test.
+type failureRecorder struct {
+	testing.TB
+	failed bool
+}
+
+func (f *failureRecorder) Fatalf(string, ...interface{}) {
+	f.failed = true
+}
+
+// hashingApp is an application that computes the app hash from all delivered
+// transactions. Delivery of the "fail" transaction fails.
+type hashingApp struct {
+	abci.BaseApplication
+
+	height    int64
+	delivered int
+	state     []byte
+}
+
+func (a *hashingApp) Info(abci.RequestInfo) abci.ResponseInfo {
+	return abci.ResponseInfo{LastBlockHeight: a.height}
+}
+
+func (a *hashingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	a.height = req.Header.Height
+	tag := common.KVPair{Key: []byte("height"), Value: []byte(strconv.FormatInt(a.height, 10))}
+	return abci.ResponseBeginBlock{Tags: []common.KVPair{tag}}
+}
+
+func (a *hashingApp) DeliverTx(raw []byte) abci.ResponseDeliverTx {
+	if string(raw) == "fail" {
+		return weave.DeliverTxError(errors.ErrUnauthorized, false)
+	}
+	a.delivered++
+	a.state = append(a.state, raw...)
+	return abci.ResponseDeliverTx{}
+}
+
+func (a *hashingApp) EndBlock(abci.RequestEndBlock) abci.ResponseEndBlock {
+	tag := common.KVPair{Key: []byte("delivered"), Value: []byte(strconv.Itoa(a.delivered))}
+	return abci.ResponseEndBlock{Tags: []common.KVPair{tag}}
+}
+
+func (a *hashingApp) Commit() abci.ResponseCommit {
+	hash := sha256.Sum256(a.state)
+	return abci.ResponseCommit{Data: hash[:]}
+}
@@ -21,6 +21,12 @@ didn't time out.
 swapID.
 6. Swap is deleted on successful retrieval for either step 4 or step 5.
 
+When a swap is created, a return task is scheduled for its timeout. If the swap
+still exists when the task is executed, funds are returned to the sender
+automatically. Releasing or returning a swap cancels its return task. Swaps
+created before the automatic return was introduced have no task scheduled and
+must be returned manually.
+
 
 */
 package aswap
@@ -21,13 +21,13 @@ const (
 
 // RegisterRoutes will instantiate and register
 // all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("aswap", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl, scheduler})
 }
 
 // RegisterQuery will register this bucket as "/aswaps"
@@ -37,9 +37,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateSwapHandler creates a swap
 type CreateSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateSwapHandler{}
@@ -77,10 +78,23 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 		PreimageHash: msg.PreimageHash,
 		Address:      swapAddr(key, msg.PreimageHash),
 	}
+
+	// Schedule the return of the coins to the source, so that funds of an
+	// expired swap are not locked forever. Returning an expired swap does
+	// not require any authentication.
+	returnMsg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		SwapID:   key,
+	}
+	swap.ReturnTaskID, err = h.scheduler.Schedule(db, swap.Timeout.Time(), nil, returnMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+
 	if _, err := h.bucket.Put(db, key, swap); err != nil {
 		return nil, errors.Wrap(err, "cannot save swap entity")
 	}
-	if err := cash.MoveCoins(db, h.bank, swap.Source, swap.Address, msg.Amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Source, swap.Address, msg.Amount); err != nil {
 		return nil, errors.Wrap(err, "cannot deposit funds")
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -109,9 +123,10 @@ func (h CreateSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 
 // ReleaseSwapHandler releases the amount to destination.
 type ReleaseSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseSwapHandler{}
@@ -141,7 +156,7 @@ func (h ReleaseSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	}
 
 	// withdraw the money from swap to destination
-	if err := cash.MoveCoins(db, h.bank, swap.Address, swap.Destination, amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Address, swap.Destination, amount); err != nil {
 		return nil, err
 	}
 
@@ -149,6 +164,9 @@ func (h ReleaseSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	if err := h.bucket.Delete(db, swapID); err != nil {
 		return nil, err
 	}
+	if err := cancelReturnTask(db, h.scheduler, swap); err != nil {
+		return nil, err
+	}
 
 	return &weave.DeliverResult{}, nil
 }
@@ -180,9 +198,10 @@ func (h ReleaseSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 
 // ReturnSwapHandler returns funds to the sender when swap timed out.
 type ReturnSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnSwapHandler{}
@@ -212,12 +231,15 @@ func (h ReturnSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	}
 
 	// withdraw all coins from swap to the defined "sender"
-	if err := cash.MoveCoins(db, h.bank, swap.Address, swap.Source, available); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Address, swap.Source, available); err != nil {
 		return nil, err
 	}
 	if err := h.bucket.Delete(db, msg.SwapID); err != nil {
 		return nil, err
 	}
+	if err := cancelReturnTask(db, h.scheduler, swap); err != nil {
+		return nil, err
+	}
 
 	return &weave.DeliverResult{}, nil
 }
@@ -241,6 +263,20 @@ func (h ReturnSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 	return &msg, &swap, nil
 }
 
+// cancelReturnTask removes the return task scheduled for given swap. Swaps
+// created before the automatic return was introduced have no task scheduled.
+func cancelReturnTask(db weave.KVStore, scheduler weave.Scheduler, swap *Swap) error {
+	if len(swap.ReturnTaskID) == 0 {
+		return nil
+	}
+	switch err := scheduler.Delete(db, swap.ReturnTaskID); {
+	case err == nil, errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrap(err, "cannot delete return task")
+	}
+}
+
 func HashBytes(preimage []byte) []byte {
 	hash := sha256.Sum256(preimage)
 	return hash[:]
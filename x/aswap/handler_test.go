@@ -34,10 +34,11 @@ var (
 	r             = app.NewRouter()
 	authenticator = &weavetest.CtxAuth{Key: "auth"}
 	auth          = x.ChainAuth(authenticator)
+	scheduler     = &weavetest.Cron{}
 )
 
 func init() {
-	RegisterRoutes(r, auth, ctrl)
+	RegisterRoutes(r, auth, ctrl, scheduler)
 }
 
 func TestCreateHandler(t *testing.T) {
@@ -69,6 +70,9 @@ func TestCreateHandler(t *testing.T) {
 				amt, err := coin.CombineCoins(swapAmount)
 				assert.Nil(t, err)
 				assert.Equal(t, true, coins.Equals(amt))
+				if len(swap.ReturnTaskID) == 0 {
+					t.Fatal("return task must be scheduled")
+				}
 			},
 		},
 		"happy path, timeout can be in the past": {
@@ -368,6 +372,114 @@ func TestReturnHandler(t *testing.T) {
 
 }
 
+func TestReturnTaskIsCancelled(t *testing.T) {
+	initialCoins, err := coin.CombineCoins(swapAmount)
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		BlockAt time.Time
+	}{
+		"release": {
+			Msg: &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   defaultSequenceId,
+				Preimage: preimage,
+			},
+			BlockAt: blockNow,
+		},
+		"return": {
+			Msg: &ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   defaultSequenceId,
+			},
+			BlockAt: blockNow.Add(2 * time.Hour),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "aswap", "cash")
+
+			ctx := weave.WithHeight(context.Background(), 500)
+			ctx = weave.WithBlockTime(ctx, blockNow)
+			setBalance(t, db, alice.Address(), initialCoins)
+			createMsg := &CreateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Source:       alice.Address(),
+				Destination:  bob.Address(),
+				PreimageHash: preimageHash,
+				Amount:       []*coin.Coin{&swapAmount},
+				Timeout:      weave.AsUnixTime(blockNow.Add(time.Hour)),
+			}
+			createCtx := authenticator.SetConditions(ctx, alice)
+			_, err := r.Deliver(createCtx, db, &weavetest.Tx{Msg: createMsg})
+			assert.Nil(t, err)
+
+			var swap Swap
+			assert.Nil(t, bucket.One(db, defaultSequenceId, &swap))
+
+			ctx = weave.WithBlockTime(ctx, tc.BlockAt)
+			_, err = r.Deliver(ctx, db, &weavetest.Tx{Msg: tc.Msg})
+			assert.Nil(t, err)
+
+			if err := scheduler.Delete(db, swap.ReturnTaskID); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want return task to be cancelled, got %v", err)
+			}
+		})
+	}
+}
+
+// Swaps created before the automatic return was introduced have no return
+// task scheduled and must still be returned manually.
+func TestReturnSwapWithoutReturnTask(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "aswap", "cash")
+
+	key, err := swapSeq.NextVal(db)
+	assert.Nil(t, err)
+	swap := &Swap{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Source:       alice.Address(),
+		Destination:  bob.Address(),
+		Timeout:      weave.AsUnixTime(blockNow.Add(time.Hour)),
+		PreimageHash: preimageHash,
+		Address:      swapAddr(key, preimageHash),
+	}
+	_, err = bucket.Put(db, key, swap)
+	assert.Nil(t, err)
+	initialCoins, err := coin.CombineCoins(swapAmount)
+	assert.Nil(t, err)
+	setBalance(t, db, swap.Address, initialCoins)
+
+	// Upgrading the schema migrates the swap but does not schedule a task.
+	_, err = migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "aswap",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+
+	var migrated Swap
+	assert.Nil(t, bucket.One(db, key, &migrated))
+	assert.Equal(t, uint32(2), migrated.Metadata.Schema)
+	assert.Equal(t, 0, len(migrated.ReturnTaskID))
+
+	ctx := weave.WithHeight(context.Background(), 500)
+	ctx = weave.WithBlockTime(ctx, blockNow.Add(2*time.Hour))
+	returnMsg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 2},
+		SwapID:   key,
+	}
+	_, err = r.Deliver(ctx, db, &weavetest.Tx{Msg: returnMsg})
+	assert.Nil(t, err)
+
+	assert.IsErr(t, errors.ErrNotFound, bucket.Has(db, key))
+	coins := checkBalance(t, db, alice.Address())
+	assert.Equal(t, true, coins.Equals(initialCoins))
+}
+
 func setBalance(t testing.TB, db weave.KVStore, addr weave.Address, coins coin.Coins) {
 	t.Helper()
 
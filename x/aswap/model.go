@@ -8,6 +8,10 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Swap{}, migration.NoModification)
+	// Version 2 introduces the automatic return of expired swaps. Swaps
+	// created before have no return task scheduled and must be returned
+	// manually.
+	migration.MustRegister(2, &Swap{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*Swap)(nil)
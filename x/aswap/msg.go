@@ -11,6 +11,9 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReleaseMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReturnMsg{}, migration.NoModification)
+	migration.MustRegister(2, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReleaseMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReturnMsg{}, migration.NoModification)
 }
 
 const (
@@ -10,6 +10,8 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
@@ -58,6 +60,9 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	}
 
 	msgList, _ := batchMsg.MsgList()
+	if err := validateFeePayers(tx, msgList); err != nil {
+		return nil, err
+	}
 
 	checks := make([]*weave.CheckResult, len(msgList))
 	for i, msg := range msgList {
@@ -69,6 +74,28 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	return d.combineChecks(checks)
 }
 
+// validateFeePayers ensures that none of the batched messages declares a fee
+// payer different from the payer of the whole transaction. Fees are always
+// charged once, for the whole batch, from the transaction payer.
+func validateFeePayers(tx weave.Tx, msgs []weave.Msg) error {
+	var payer weave.Address
+	if ftx, ok := tx.(cash.FeeTx); ok {
+		payer = ftx.GetFees().GetPayer()
+	}
+	for i, msg := range msgs {
+		fmsg, ok := msg.(cash.FeeTx)
+		if !ok {
+			continue
+		}
+		p := fmsg.GetFees().GetPayer()
+		if len(p) != 0 && !p.Equals(payer) {
+			return errors.Wrapf(errors.ErrUnauthorized,
+				"batch message %d fee payer %s is different from the transaction fee payer", i, p)
+		}
+	}
+	return nil
+}
+
 // combines all data bytes as protobuf.
 // joins all log messages with \n
 func (*Decorator) combineChecks(checks []*weave.CheckResult) (*weave.CheckResult, error) {
@@ -121,6 +148,9 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	}
 
 	msgList, _ := batchMsg.MsgList()
+	if err := validateFeePayers(tx, msgList); err != nil {
+		return nil, err
+	}
 
 	delivers := make([]*weave.DeliverResult, len(msgList))
 	for i, msg := range msgList {
@@ -11,6 +11,7 @@ import (
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/batch"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
@@ -303,3 +304,80 @@ func TestDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoratorFeePayers(t *testing.T) {
+	payer := weavetest.NewCondition().Address()
+	other := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		TxPayer weave.Address
+		Msgs    []weave.Msg
+		WantErr *errors.Error
+	}{
+		"messages without fee information": {
+			TxPayer: payer,
+			Msgs:    []weave.Msg{&weavetest.Msg{}, &weavetest.Msg{}},
+		},
+		"message declaring the transaction payer": {
+			TxPayer: payer,
+			Msgs: []weave.Msg{
+				&weavetest.Msg{},
+				&feeMsg{Msg: weavetest.Msg{}, fees: &cash.FeeInfo{Payer: payer}},
+			},
+		},
+		"message declaring no payer": {
+			TxPayer: payer,
+			Msgs:    []weave.Msg{&feeMsg{Msg: weavetest.Msg{}, fees: &cash.FeeInfo{}}},
+		},
+		"message declaring a different payer": {
+			TxPayer: payer,
+			Msgs: []weave.Msg{
+				&weavetest.Msg{},
+				&feeMsg{Msg: weavetest.Msg{}, fees: &cash.FeeInfo{Payer: other}},
+			},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"message declaring a payer when the transaction uses the default one": {
+			Msgs:    []weave.Msg{&feeMsg{Msg: weavetest.Msg{}, fees: &cash.FeeInfo{Payer: payer}}},
+			WantErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tx := &feeTx{
+				Tx:   weavetest.Tx{Msg: &mockMsg{list: tc.Msgs}},
+				fees: &cash.FeeInfo{Payer: tc.TxPayer},
+			}
+			decorator := batch.NewDecorator()
+			handler := &weavetest.Handler{}
+
+			_, err := decorator.Check(nil, nil, tx, handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			_, err = decorator.Deliver(nil, nil, tx, handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+		})
+	}
+}
+
+type feeTx struct {
+	weavetest.Tx
+	fees *cash.FeeInfo
+}
+
+func (tx *feeTx) GetFees() *cash.FeeInfo {
+	return tx.fees
+}
+
+type feeMsg struct {
+	weavetest.Msg
+	fees *cash.FeeInfo
+}
+
+func (m *feeMsg) GetFees() *cash.FeeInfo {
+	return m.fees
+}
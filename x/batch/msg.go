@@ -3,8 +3,15 @@ package batch
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
 )
 
+func init() {
+	// Batch does not store any versioned data, but applications
+	// initialize its schema in the genesis file.
+	migration.MustRegisterPackage("batch")
+}
+
 const (
 	PathExecuteBatchMsg = "batch/execute_batch"
 )
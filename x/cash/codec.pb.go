@@ -170,6 +170,125 @@ func (m *SendMsg) GetRef() []byte {
 	return nil
 }
 
+// WalletConfig holds the optional settings of a wallet, declared by the
+// wallet owner. WalletConfig is stored under the wallet address.
+type WalletConfig struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// RequireMemo set to true rejects all incoming transfers that are not
+	// providing a memo. This is useful for exchange deposit addresses, where
+	// the memo is used to identify the depositor.
+	RequireMemo bool `protobuf:"varint,2,opt,name=require_memo,json=requireMemo,proto3" json:"require_memo,omitempty"`
+}
+
+func (m *WalletConfig) Reset()         { *m = WalletConfig{} }
+func (m *WalletConfig) String() string { return proto.CompactTextString(m) }
+func (*WalletConfig) ProtoMessage()    {}
+func (*WalletConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{2}
+}
+func (m *WalletConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WalletConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WalletConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WalletConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletConfig.Merge(m, src)
+}
+func (m *WalletConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *WalletConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletConfig proto.InternalMessageInfo
+
+func (m *WalletConfig) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *WalletConfig) GetRequireMemo() bool {
+	if m != nil {
+		return m.RequireMemo
+	}
+	return false
+}
+
+// UpdateWalletConfigMsg is a request to set the configuration of a wallet.
+// It must be signed by the owner of the wallet address.
+type UpdateWalletConfigMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Address     github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	RequireMemo bool                             `protobuf:"varint,3,opt,name=require_memo,json=requireMemo,proto3" json:"require_memo,omitempty"`
+}
+
+func (m *UpdateWalletConfigMsg) Reset()         { *m = UpdateWalletConfigMsg{} }
+func (m *UpdateWalletConfigMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateWalletConfigMsg) ProtoMessage()    {}
+func (*UpdateWalletConfigMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{3}
+}
+func (m *UpdateWalletConfigMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWalletConfigMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWalletConfigMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWalletConfigMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWalletConfigMsg.Merge(m, src)
+}
+func (m *UpdateWalletConfigMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWalletConfigMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWalletConfigMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWalletConfigMsg proto.InternalMessageInfo
+
+func (m *UpdateWalletConfigMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateWalletConfigMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *UpdateWalletConfigMsg) GetRequireMemo() bool {
+	if m != nil {
+		return m.RequireMemo
+	}
+	return false
+}
+
 // FeeInfo records who pays what fees to have this
 // message processed
 type FeeInfo struct {
@@ -186,7 +305,7 @@ func (m *FeeInfo) Reset()         { *m = FeeInfo{} }
 func (m *FeeInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeInfo) ProtoMessage()    {}
 func (*FeeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{2}
+	return fileDescriptor_7149e4b58e322390, []int{4}
 }
 func (m *FeeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,13 +356,21 @@ type Configuration struct {
 	Owner            github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	CollectorAddress github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/iov-one/weave.Address" json:"collector_address,omitempty"`
 	MinimalFee       coin.Coin                        `protobuf:"bytes,4,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// Dust thresholds declare, per ticker, the smallest balance that a
+	// transfer can leave in the source wallet. A transfer that would leave a
+	// non zero balance lower than the threshold of the transferred ticker is
+	// rejected. The whole balance must be transferred instead.
+	DustThresholds []coin.Coin `protobuf:"bytes,5,rep,name=dust_thresholds,json=dustThresholds,proto3" json:"dust_thresholds"`
+	// Delete empty wallets set to true removes a wallet from the store once
+	// all its balances are zero, instead of storing an empty wallet.
+	DeleteEmptyWallets bool `protobuf:"varint,6,opt,name=delete_empty_wallets,json=deleteEmptyWallets,proto3" json:"delete_empty_wallets,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{3}
+	return fileDescriptor_7149e4b58e322390, []int{5}
 }
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,6 +427,20 @@ func (m *Configuration) GetMinimalFee() coin.Coin {
 	return coin.Coin{}
 }
 
+func (m *Configuration) GetDustThresholds() []coin.Coin {
+	if m != nil {
+		return m.DustThresholds
+	}
+	return nil
+}
+
+func (m *Configuration) GetDeleteEmptyWallets() bool {
+	if m != nil {
+		return m.DeleteEmptyWallets
+	}
+	return false
+}
+
 type UpdateConfigurationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -309,7 +450,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{4}
+	return fileDescriptor_7149e4b58e322390, []int{6}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,6 +496,8 @@ func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
 func init() {
 	proto.RegisterType((*Set)(nil), "cash.Set")
 	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
+	proto.RegisterType((*WalletConfig)(nil), "cash.WalletConfig")
+	proto.RegisterType((*UpdateWalletConfigMsg)(nil), "cash.UpdateWalletConfigMsg")
 	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
 	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
@@ -363,35 +506,42 @@ func init() {
 func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
 
 var fileDescriptor_7149e4b58e322390 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x13, 0x27, 0x85, 0x31, 0x88, 0xb0, 0x20, 0x64, 0xe5, 0xe0, 0x5a, 0x16, 0x87, 0x20,
-	0x84, 0x2d, 0xc2, 0xad, 0xe2, 0x42, 0x2a, 0x45, 0xe2, 0xd0, 0x03, 0x2e, 0x9c, 0xab, 0xed, 0x7a,
-	0xec, 0xac, 0x14, 0xef, 0x44, 0xf6, 0xa6, 0x85, 0x1f, 0xe0, 0xcc, 0x67, 0xf5, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0xc1, 0x01, 0xa1, 0x5d, 0x5b, 0x55, 0xa2, 0x9e, 0x7c, 0x1b, 0xbf, 0x99, 0xf7,
-	0x3c, 0xef, 0xed, 0x2e, 0xb0, 0xef, 0x89, 0xe0, 0xf5, 0x32, 0x11, 0x94, 0xa1, 0x88, 0xd7, 0x15,
-	0x69, 0x62, 0xae, 0x41, 0x26, 0xde, 0x1e, 0x34, 0x19, 0x0b, 0x92, 0x6a, 0x7f, 0x68, 0xf2, 0xb2,
-	0xa0, 0x82, 0x6c, 0x99, 0x98, 0xaa, 0x41, 0xa3, 0xaf, 0x30, 0x38, 0x47, 0xcd, 0xde, 0xc2, 0xa3,
-	0x12, 0x35, 0xcf, 0xb8, 0xe6, 0xbe, 0x13, 0x3a, 0x53, 0x6f, 0xf6, 0x2c, 0xbe, 0x46, 0x7e, 0x85,
-	0xf1, 0x59, 0x0b, 0xa7, 0xf7, 0x03, 0x2c, 0x84, 0xa1, 0x51, 0xaf, 0xfd, 0x7e, 0x38, 0x98, 0x7a,
-	0x33, 0x88, 0xcd, 0x57, 0x7c, 0x4a, 0x52, 0xa5, 0x4d, 0x23, 0xfa, 0xd9, 0x87, 0xa3, 0x73, 0x54,
-	0xd9, 0x59, 0x5d, 0x74, 0x93, 0xfe, 0x08, 0xa3, 0x9a, 0x36, 0x95, 0x40, 0xbf, 0x1f, 0x3a, 0xd3,
-	0x27, 0xf3, 0xd7, 0x7f, 0xef, 0x8e, 0xc3, 0x42, 0xea, 0xe5, 0xe6, 0x32, 0x16, 0x54, 0x26, 0x92,
-	0xae, 0xde, 0x91, 0xc2, 0xa4, 0x11, 0xf8, 0x94, 0x65, 0x15, 0xd6, 0x75, 0xda, 0x72, 0xd8, 0x02,
-	0xbc, 0x0c, 0x6b, 0x2d, 0x15, 0xd7, 0x92, 0x94, 0x3f, 0xe8, 0x20, 0xb1, 0x4f, 0x64, 0x11, 0x8c,
-	0x78, 0x49, 0x1b, 0xa5, 0x7d, 0xd7, 0x2e, 0xbc, 0xef, 0xb0, 0xed, 0x30, 0x06, 0x6e, 0x89, 0x25,
-	0xf9, 0xc3, 0xd0, 0x99, 0x3e, 0x4e, 0x6d, 0xcd, 0xc6, 0x30, 0xa8, 0x30, 0xf7, 0x47, 0xe6, 0xbf,
-	0xa9, 0x29, 0x23, 0x84, 0xa3, 0x05, 0xe2, 0x67, 0x95, 0x13, 0x3b, 0x81, 0xe1, 0x9a, 0xff, 0xc0,
-	0xaa, 0x93, 0xb3, 0x86, 0xc2, 0x02, 0x70, 0x73, 0xc4, 0xda, 0x3a, 0x3a, 0x5c, 0xc7, 0xe2, 0xd1,
-	0x3f, 0x07, 0x9e, 0x9e, 0x92, 0xca, 0x65, 0xb1, 0xa9, 0x1a, 0x0b, 0x9d, 0x52, 0x3f, 0x81, 0x21,
-	0x5d, 0xab, 0xae, 0xab, 0x59, 0x0a, 0xfb, 0x02, 0xcf, 0x05, 0xad, 0x56, 0x28, 0x34, 0x55, 0x17,
-	0xbc, 0xe9, 0x75, 0x4a, 0x7e, 0x7c, 0x4f, 0x6f, 0x11, 0xf6, 0x1e, 0xbc, 0x52, 0x2a, 0x59, 0xf2,
-	0xd5, 0x45, 0x8e, 0xf8, 0xf0, 0x0c, 0xe6, 0xee, 0xcd, 0xdd, 0x71, 0x2f, 0x85, 0x76, 0x68, 0x81,
-	0x18, 0xad, 0xe1, 0xd5, 0xb7, 0x75, 0xc6, 0x35, 0x1e, 0xa4, 0xd0, 0xf9, 0xfa, 0xbd, 0x31, 0x67,
-	0xa4, 0xc5, 0xd2, 0x06, 0xe1, 0xcd, 0x5e, 0xc4, 0xe6, 0x61, 0xc5, 0x07, 0x9a, 0x69, 0x33, 0x31,
-	0xf7, 0x6f, 0xb6, 0x81, 0x73, 0xbb, 0x0d, 0x9c, 0x3f, 0xdb, 0xc0, 0xf9, 0xb5, 0x0b, 0x7a, 0xb7,
-	0xbb, 0xa0, 0xf7, 0x7b, 0x17, 0xf4, 0x2e, 0x47, 0xf6, 0x65, 0x7d, 0xf8, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0x8c, 0x17, 0xa5, 0xaa, 0x03, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0x8d, 0xe3, 0x24, 0xcd, 0x37, 0xce, 0x47, 0xc3, 0x52, 0x90, 0x95, 0x83, 0x6b, 0x2c, 0x0e,
+	0x41, 0x08, 0x07, 0xc2, 0x89, 0x0a, 0x21, 0x91, 0x8a, 0x48, 0x1c, 0x72, 0xc0, 0x2d, 0xe2, 0x86,
+	0xb5, 0xb5, 0x27, 0x89, 0x25, 0xdb, 0x1b, 0xbc, 0xeb, 0x86, 0xfe, 0x01, 0xce, 0xfc, 0x0b, 0xfe,
+	0x4a, 0x8f, 0x3d, 0x72, 0xaa, 0x50, 0x72, 0xe4, 0x1f, 0x70, 0x42, 0xeb, 0x75, 0xa3, 0x94, 0x70,
+	0xf1, 0x6d, 0xfc, 0xe6, 0xbd, 0x99, 0xf5, 0xdb, 0xa7, 0x05, 0xf2, 0x65, 0x10, 0x50, 0x3e, 0x1f,
+	0x04, 0x2c, 0xc4, 0xc0, 0x5d, 0x64, 0x4c, 0x30, 0xd2, 0x90, 0x48, 0xcf, 0xd8, 0x82, 0x7a, 0xdd,
+	0x80, 0x45, 0xe9, 0x36, 0xa9, 0x77, 0x30, 0x63, 0x33, 0x56, 0x94, 0x03, 0x59, 0x29, 0xd4, 0x39,
+	0x05, 0xfd, 0x04, 0x05, 0x79, 0x02, 0xed, 0x04, 0x05, 0x0d, 0xa9, 0xa0, 0xa6, 0x66, 0x6b, 0x7d,
+	0x63, 0xb8, 0xef, 0x2e, 0x91, 0x9e, 0xa3, 0x3b, 0x29, 0x61, 0x6f, 0x43, 0x20, 0x36, 0x34, 0xe5,
+	0x74, 0x6e, 0xd6, 0x6d, 0xbd, 0x6f, 0x0c, 0xc1, 0x95, 0x5f, 0xee, 0x31, 0x8b, 0x52, 0x4f, 0x35,
+	0x9c, 0xaf, 0x75, 0xd8, 0x3b, 0xc1, 0x34, 0x9c, 0xf0, 0x59, 0xb5, 0xd1, 0xaf, 0xa0, 0xc5, 0x59,
+	0x9e, 0x05, 0x68, 0xd6, 0x6d, 0xad, 0xdf, 0x19, 0x3d, 0xfa, 0x7d, 0x7d, 0x68, 0xcf, 0x22, 0x31,
+	0xcf, 0xcf, 0xdc, 0x80, 0x25, 0x83, 0x88, 0x9d, 0x3f, 0x65, 0x29, 0x0e, 0xd4, 0x80, 0x37, 0x61,
+	0x98, 0x21, 0xe7, 0x5e, 0xa9, 0x21, 0x63, 0x30, 0x42, 0xe4, 0x22, 0x4a, 0xa9, 0x88, 0x58, 0x6a,
+	0xea, 0x15, 0x46, 0x6c, 0x0b, 0x89, 0x03, 0x2d, 0x9a, 0xb0, 0x3c, 0x15, 0x66, 0xc3, 0xd6, 0xfe,
+	0xfa, 0xc3, 0xb2, 0x43, 0x08, 0x34, 0x12, 0x4c, 0x98, 0xd9, 0xb4, 0xb5, 0xfe, 0x7f, 0x5e, 0x51,
+	0x93, 0x2e, 0xe8, 0x19, 0x4e, 0xcd, 0x96, 0xdc, 0xeb, 0xc9, 0xd2, 0xf9, 0x04, 0x9d, 0x8f, 0x34,
+	0x8e, 0x51, 0x1c, 0xb3, 0x74, 0x1a, 0x55, 0x34, 0xe3, 0x21, 0x74, 0x32, 0xfc, 0x9c, 0x47, 0x19,
+	0xfa, 0xc5, 0x2a, 0x69, 0x49, 0xdb, 0x33, 0x4a, 0x6c, 0x82, 0x09, 0x73, 0xbe, 0x6b, 0x70, 0xff,
+	0xc3, 0x22, 0xa4, 0x02, 0xb7, 0xd7, 0x54, 0xb6, 0xfd, 0x35, 0xec, 0x51, 0x65, 0x44, 0x25, 0xdf,
+	0x6f, 0x44, 0x3b, 0x27, 0xd5, 0x77, 0x4f, 0x8a, 0xb0, 0x37, 0x46, 0x7c, 0x97, 0x4e, 0x19, 0x39,
+	0x82, 0xe6, 0x82, 0x5e, 0x60, 0x56, 0x69, 0x97, 0x92, 0x10, 0x0b, 0x1a, 0x53, 0x44, 0x6e, 0xea,
+	0x3b, 0x17, 0x53, 0xe0, 0xce, 0xaf, 0x3a, 0xfc, 0xaf, 0x4c, 0xc8, 0x33, 0x75, 0x99, 0x95, 0x8c,
+	0x38, 0x82, 0x26, 0x5b, 0xa6, 0x55, 0x8f, 0x56, 0x48, 0xc8, 0x7b, 0xb8, 0x1b, 0xb0, 0x38, 0xc6,
+	0x40, 0xb0, 0xcc, 0xbf, 0xb1, 0xb3, 0x4a, 0x06, 0xbb, 0x1b, 0x79, 0x89, 0x90, 0xe7, 0x60, 0x24,
+	0x51, 0x1a, 0x25, 0x34, 0xf6, 0xa7, 0x88, 0xbb, 0x69, 0x1c, 0x35, 0x2e, 0xaf, 0x0f, 0x6b, 0x1e,
+	0x94, 0xa4, 0x31, 0x22, 0x79, 0x09, 0xfb, 0x61, 0xce, 0x85, 0x2f, 0xe6, 0x19, 0xf2, 0x39, 0x8b,
+	0x43, 0x6e, 0x36, 0x6d, 0xfd, 0x9f, 0xb2, 0x3b, 0x92, 0x78, 0xba, 0xe1, 0x91, 0x67, 0x70, 0x10,
+	0x62, 0x8c, 0x02, 0x7d, 0x4c, 0x16, 0xe2, 0xc2, 0x5f, 0x16, 0x91, 0xe2, 0x45, 0x9e, 0xdb, 0x1e,
+	0x51, 0xbd, 0xb7, 0xb2, 0xa5, 0xc2, 0xc6, 0x9d, 0x05, 0x3c, 0x50, 0xe9, 0xbb, 0x65, 0x79, 0xe5,
+	0xf8, 0x3d, 0x96, 0x81, 0x10, 0xc1, 0xbc, 0x70, 0xdd, 0x18, 0xde, 0x73, 0xe5, 0x7b, 0xe6, 0xde,
+	0x9a, 0xe9, 0x29, 0xc6, 0xc8, 0xbc, 0x5c, 0x59, 0xda, 0xd5, 0xca, 0xd2, 0x7e, 0xae, 0x2c, 0xed,
+	0xdb, 0xda, 0xaa, 0x5d, 0xad, 0xad, 0xda, 0x8f, 0xb5, 0x55, 0x3b, 0x6b, 0x15, 0x0f, 0xda, 0x8b,
+	0x3f, 0x03, 0x00, 0x37, 0xef, 0xb8, 0x36, 0x21, 0x05, 0x00, 0x00,
 }
 
 func (m *Set) Marshal() (dAtA []byte, err error) {
@@ -496,6 +646,88 @@ func (m *SendMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *WalletConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalletConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.RequireMemo {
+		dAtA[i] = 0x10
+		i++
+		if m.RequireMemo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateWalletConfigMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWalletConfigMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.RequireMemo {
+		dAtA[i] = 0x18
+		i++
+		if m.RequireMemo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func (m *FeeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -521,11 +753,11 @@ func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n4, err := m.Fees.MarshalTo(dAtA[i:])
+		n6, err := m.Fees.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	return i, nil
 }
@@ -549,11 +781,11 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Owner) > 0 {
 		dAtA[i] = 0x12
@@ -570,11 +802,33 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
-	n6, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	n8, err := m.MinimalFee.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
+	if len(m.DustThresholds) > 0 {
+		for _, msg := range m.DustThresholds {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DeleteEmptyWallets {
+		dAtA[i] = 0x30
+		i++
+		if m.DeleteEmptyWallets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -597,21 +851,21 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n8, err := m.Patch.MarshalTo(dAtA[i:])
+		n10, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	return i, nil
 }
@@ -677,6 +931,42 @@ func (m *SendMsg) Size() (n int) {
 	return n
 }
 
+func (m *WalletConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RequireMemo {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateWalletConfigMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RequireMemo {
+		n += 2
+	}
+	return n
+}
+
 func (m *FeeInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -714,6 +1004,15 @@ func (m *Configuration) Size() (n int) {
 	}
 	l = m.MinimalFee.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	if len(m.DustThresholds) > 0 {
+		for _, e := range m.DustThresholds {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.DeleteEmptyWallets {
+		n += 2
+	}
 	return n
 }
 
@@ -1129,6 +1428,258 @@ func (m *SendMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WalletConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireMemo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireMemo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWalletConfigMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWalletConfigMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWalletConfigMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireMemo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireMemo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1418,6 +1969,60 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThresholds = append(m.DustThresholds, coin.Coin{})
+			if err := m.DustThresholds[len(m.DustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteEmptyWallets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteEmptyWallets = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package cash
 
 import (
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -27,9 +28,46 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(errors.ErrState, "minimal fee cannot be negative")
 		}
 	}
+
+	tickers := make(map[string]struct{})
+	for _, t := range c.DustThresholds {
+		if err := t.Validate(); err != nil {
+			return errors.Wrap(err, "dust threshold")
+		}
+		if !t.IsPositive() {
+			return errors.Wrapf(errors.ErrState, "dust threshold for %s must be greater than zero", t.Ticker)
+		}
+		if _, ok := tickers[t.Ticker]; ok {
+			return errors.Wrapf(errors.ErrDuplicate, "dust threshold for %s", t.Ticker)
+		}
+		tickers[t.Ticker] = struct{}{}
+	}
 	return nil
 }
 
+// loadReservePolicy returns the configuration declaring the wallet reserve
+// policy. A missing configuration declares no policy, so that the controller
+// can be used without the cash configuration.
+func loadReservePolicy(db gconf.Store) (Configuration, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "cash", &conf); err != nil && !errors.ErrNotFound.Is(err) {
+		return conf, errors.Wrap(err, "load configuration")
+	}
+	return conf, nil
+}
+
+// dustThreshold returns the smallest non zero balance of given ticker that a
+// transfer can leave in the source wallet. Zero value means there is no
+// limit.
+func dustThreshold(conf Configuration, ticker string) coin.Coin {
+	for _, t := range conf.DustThresholds {
+		if t.Ticker == ticker {
+			return t
+		}
+	}
+	return coin.NewCoin(0, 0, ticker)
+}
+
 func mustLoadConf(db gconf.Store) Configuration {
 	var conf Configuration
 	if err := gconf.Load(db, "cash", &conf); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -95,3 +96,46 @@ func TestConfigurationHandler(t *testing.T) {
 	}
 
 }
+
+func TestConfigurationValidateDustThresholds(t *testing.T) {
+	collector := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		thresholds []coin.Coin
+		wantErr    *errors.Error
+	}{
+		"no thresholds": {
+			thresholds: nil,
+			wantErr:    nil,
+		},
+		"valid thresholds": {
+			thresholds: []coin.Coin{coin.NewCoin(0, 100, "IOV"), coin.NewCoin(1, 0, "ETH")},
+			wantErr:    nil,
+		},
+		"zero threshold": {
+			thresholds: []coin.Coin{coin.NewCoin(0, 0, "IOV")},
+			wantErr:    errors.ErrState,
+		},
+		"duplicated ticker": {
+			thresholds: []coin.Coin{coin.NewCoin(0, 100, "IOV"), coin.NewCoin(0, 200, "IOV")},
+			wantErr:    errors.ErrDuplicate,
+		},
+		"invalid ticker": {
+			thresholds: []coin.Coin{coin.NewCoin(0, 100, "x")},
+			wantErr:    errors.ErrCurrency,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: collector,
+				DustThresholds:   tc.thresholds,
+			}
+			if err := c.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
@@ -4,13 +4,14 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // CoinsMover is an interface for moving coins between accounts.
 type CoinMover interface {
 	// Moving coins must happen from the source to the destination address.
 	// Zero or negative values must result in an error.
-	MoveCoins(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
+	MoveCoins(ctx weave.Context, store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
 }
 
 // CoinMinter is an interface to create new coins.
@@ -38,6 +39,10 @@ type Controller interface {
 // storage engine. Wallet must return something that supports AsSet.
 type BaseController struct {
 	bucket WalletBucket
+	// hooks is shared by all copies of the controller, so that a hook
+	// registered after the controller was passed to other extensions is
+	// still called.
+	hooks *moveHooks
 }
 
 var _ Controller = BaseController{}
@@ -45,7 +50,27 @@ var _ Controller = BaseController{}
 // NewController returns a base controller implementation.
 func NewController(bucket WalletBucket) BaseController {
 	ValidateWalletBucket(bucket)
-	return BaseController{bucket: bucket}
+	return BaseController{bucket: bucket, hooks: &moveHooks{}}
+}
+
+// MoveHook is a function called for every transfer made by the controller,
+// after the balances of both the source and the destination account were
+// updated. The context is the one of the transaction that made the transfer.
+// Returning an error aborts the transfer.
+type MoveHook func(ctx weave.Context, db weave.KVStore, src, dst weave.Address, amount coin.Coin) error
+
+type moveHooks struct {
+	list []MoveHook
+}
+
+// OnMove registers a hook that is called for every transfer made by this
+// controller. Hooks are called in the order of registration. The first hook
+// returning an error stops the execution and the transfer is aborted.
+//
+// Hooks should be registered when the application is built. Registration is
+// not safe for concurrent use with MoveCoins.
+func (c BaseController) OnMove(hook MoveHook) {
+	c.hooks.list = append(c.hooks.list, hook)
 }
 
 // Balance returns the amount of funds stored under given account address.
@@ -63,7 +88,40 @@ func (c BaseController) Balance(store weave.KVStore, src weave.Address) (coin.Co
 // MoveCoins moves the given amount from src to dest.
 // If src doesn't exist, or doesn't have sufficient
 // coins, it fails.
-func (c BaseController) MoveCoins(store weave.KVStore,
+// All registered move hooks are called after the balances are updated. If any
+// of them fails, the transfer is aborted and the store is not modified, given
+// the store supports cache wrapping.
+func (c BaseController) MoveCoins(ctx weave.Context, store weave.KVStore,
+	src weave.Address, dest weave.Address, amount coin.Coin) error {
+	if c.hooks == nil || len(c.hooks.list) == 0 {
+		return c.moveCoins(store, src, dest, amount)
+	}
+	cacheable, ok := store.(weave.CacheableKVStore)
+	if !ok {
+		return c.moveCoinsWithHooks(ctx, store, src, dest, amount)
+	}
+	cache := cacheable.CacheWrap()
+	if err := c.moveCoinsWithHooks(ctx, cache, src, dest, amount); err != nil {
+		cache.Discard()
+		return err
+	}
+	return cache.Write()
+}
+
+func (c BaseController) moveCoinsWithHooks(ctx weave.Context, store weave.KVStore,
+	src weave.Address, dest weave.Address, amount coin.Coin) error {
+	if err := c.moveCoins(store, src, dest, amount); err != nil {
+		return err
+	}
+	for i, hook := range c.hooks.list {
+		if err := hook(ctx, store, src, dest, amount); err != nil {
+			return errors.Wrapf(err, "move hook %d", i)
+		}
+	}
+	return nil
+}
+
+func (c BaseController) moveCoins(store weave.KVStore,
 	src weave.Address, dest weave.Address, amount coin.Coin) error {
 
 	if amount.IsZero() {
@@ -73,6 +131,11 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 		return errors.Wrapf(errors.ErrAmount, "non-positive SendMsg: %#v", &amount)
 	}
 
+	conf, err := loadReservePolicy(store)
+	if err != nil {
+		return err
+	}
+
 	// load sender, subtract funds, and save
 	sender, err := c.bucket.Get(store, src)
 	if err != nil {
@@ -88,7 +151,7 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if err != nil {
 		return err
 	}
-	err = c.bucket.Save(store, sender)
+	err = c.saveWallet(store, conf, sender)
 	if err != nil {
 		return err
 	}
@@ -102,7 +165,16 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if err != nil {
 		return err
 	}
-	return c.bucket.Save(store, recipient)
+	return c.saveWallet(store, conf, recipient)
+}
+
+// saveWallet stores given wallet. If the configuration requires it, a wallet
+// without any funds is deleted instead.
+func (c BaseController) saveWallet(store weave.KVStore, conf Configuration, wallet orm.Object) error {
+	if conf.DeleteEmptyWallets && len(AsCoins(wallet)) == 0 {
+		return c.bucket.Delete(store, wallet.Key())
+	}
+	return c.bucket.Save(store, wallet)
 }
 
 // CoinMint attempts to add the given amount of coins to
@@ -113,6 +185,10 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 func (c BaseController) CoinMint(store weave.KVStore,
 	dest weave.Address, amount coin.Coin) error {
 
+	conf, err := loadReservePolicy(store)
+	if err != nil {
+		return err
+	}
 	recipient, err := c.bucket.GetOrCreate(store, dest)
 	if err != nil {
 		return err
@@ -122,5 +198,5 @@ func (c BaseController) CoinMint(store weave.KVStore,
 		return err
 	}
 
-	return c.bucket.Save(store, recipient)
+	return c.saveWallet(store, conf, recipient)
 }
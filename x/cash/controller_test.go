@@ -1,11 +1,14 @@
 package cash
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -146,6 +149,7 @@ func TestMoveCoins(t *testing.T) {
 	addr2 := weavetest.NewCondition().Address()
 	addr3 := weavetest.NewCondition().Address()
 
+	ctx := context.Background()
 	controller := NewController(NewBucket())
 
 	cc := "MONY"
@@ -216,7 +220,7 @@ func TestMoveCoins(t *testing.T) {
 				t.Fatalf("unexpected coin minting error: %+v", err)
 			}
 
-			if err := controller.MoveCoins(kv, tc.move.sender, tc.move.recipient, tc.move.amount); !tc.move.wantErr.Is(err) {
+			if err := controller.MoveCoins(ctx, kv, tc.move.sender, tc.move.recipient, tc.move.amount); !tc.move.wantErr.Is(err) {
 				t.Fatalf("unexpected coin transfer error: %+v", err)
 			}
 
@@ -242,6 +246,140 @@ func TestMoveCoins(t *testing.T) {
 	}
 }
 
+func TestMoveCoinsHooks(t *testing.T) {
+	src := weavetest.NewCondition().Address()
+	dst := weavetest.NewCondition().Address()
+	amount := coin.NewCoin(3, 0, "IOV")
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	controller := NewController(NewBucket())
+	if err := controller.CoinMint(kv, src, coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	ctx := weave.WithHeight(context.Background(), 42)
+
+	var calls []string
+	controller.OnMove(func(ctx weave.Context, db weave.KVStore, s, d weave.Address, c coin.Coin) error {
+		calls = append(calls, "first")
+		if !s.Equals(src) || !d.Equals(dst) || !c.Equals(amount) {
+			t.Errorf("unexpected transfer: %s -> %s: %v", s, d, c)
+		}
+		// Hook is called with the context of the transfer.
+		if h, ok := weave.GetHeight(ctx); !ok || h != 42 {
+			t.Errorf("unexpected height: %d", h)
+		}
+		// Balances are updated before the hook is called.
+		if w := wallet(t, db, dst); !w.Contains(amount) {
+			t.Errorf("destination balance not updated: %v", w)
+		}
+		return nil
+	})
+	// A hook registered with any copy of the controller is called.
+	copied := controller
+	fail := true
+	copied.OnMove(func(ctx weave.Context, db weave.KVStore, s, d weave.Address, c coin.Coin) error {
+		calls = append(calls, "second")
+		if fail {
+			return errors.Wrap(errors.ErrState, "second hook failure")
+		}
+		return nil
+	})
+
+	if err := controller.MoveCoins(ctx, kv, src, dst, amount); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected coin transfer error: %+v", err)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(want, calls) {
+		t.Fatalf("want %q hook calls, got %q", want, calls)
+	}
+	// Transfer was aborted.
+	if w := wallet(t, kv, src); !w.Equals(coin.Coins{coin.NewCoinp(10, 0, "IOV")}) {
+		t.Fatalf("unexpected source wallet state: %v", w)
+	}
+	if w := wallet(t, kv, dst); w != nil {
+		t.Fatalf("unexpected destination wallet state: %v", w)
+	}
+
+	calls = nil
+	fail = false
+	if err := controller.MoveCoins(ctx, kv, src, dst, amount); err != nil {
+		t.Fatalf("unexpected coin transfer error: %+v", err)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(want, calls) {
+		t.Fatalf("want %q hook calls, got %q", want, calls)
+	}
+	if w := wallet(t, kv, dst); !w.Equals(coin.Coins{&amount}) {
+		t.Fatalf("unexpected destination wallet state: %v", w)
+	}
+}
+
+func TestMoveCoinsReservePolicy(t *testing.T) {
+	src := weavetest.NewCondition().Address()
+	dst := weavetest.NewCondition().Address()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	config := Configuration{
+		Metadata:           &weave.Metadata{Schema: 1},
+		CollectorAddress:   weavetest.NewCondition().Address(),
+		DustThresholds:     []coin.Coin{coin.NewCoin(1, 0, "IOV")},
+		DeleteEmptyWallets: true,
+	}
+	if err := gconf.Save(kv, "cash", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	controller := NewController(NewBucket())
+	if err := controller.CoinMint(kv, src, coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	if err := controller.CoinMint(kv, src, coin.NewCoin(1, 0, "ETH")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	ctx := context.Background()
+
+	// Dust threshold is enforced by the SendMsg handler only. The
+	// controller moves any amount.
+	if err := controller.MoveCoins(ctx, kv, src, dst, coin.NewCoin(9, 0, "IOV")); err != nil {
+		t.Fatalf("cannot move coins: %+v", err)
+	}
+	if err := controller.MoveCoins(ctx, kv, src, dst, coin.NewCoin(0, 999999999, "ETH")); err != nil {
+		t.Fatalf("cannot move coins: %+v", err)
+	}
+
+	// Moving everything removes the wallet.
+	if err := controller.MoveCoins(ctx, kv, src, dst, coin.NewCoin(1, 0, "IOV")); err != nil {
+		t.Fatalf("cannot move coins: %+v", err)
+	}
+	if err := controller.MoveCoins(ctx, kv, src, dst, coin.NewCoin(0, 1, "ETH")); err != nil {
+		t.Fatalf("cannot move coins: %+v", err)
+	}
+	if w, err := NewBucket().Get(kv, src); err != nil || w != nil {
+		t.Fatalf("empty wallet must be deleted: %v, %v", w, err)
+	}
+	if _, err := controller.Balance(kv, src); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	// Deleted wallet can be funded again.
+	if err := controller.MoveCoins(ctx, kv, dst, src, coin.NewCoin(2, 0, "IOV")); err != nil {
+		t.Fatalf("cannot move coins: %+v", err)
+	}
+	if w := wallet(t, kv, src); !w.Equals(coin.Coins{coin.NewCoinp(2, 0, "IOV")}) {
+		t.Fatalf("unexpected source wallet state: %v", w)
+	}
+	if w := wallet(t, kv, dst); !w.Equals(coin.Coins{coin.NewCoinp(1, 0, "ETH"), coin.NewCoinp(8, 0, "IOV")}) {
+		t.Fatalf("unexpected destination wallet state: %v", w)
+	}
+
+	// Burning all funds removes the wallet as well.
+	if err := controller.CoinMint(kv, src, coin.NewCoin(-2, 0, "IOV")); err != nil {
+		t.Fatalf("cannot burn coins: %+v", err)
+	}
+	if w, err := NewBucket().Get(kv, src); err != nil || w != nil {
+		t.Fatalf("empty wallet must be deleted: %v, %v", w, err)
+	}
+}
+
 func TestBalance(t *testing.T) {
 	store := store.MemStore()
 	migration.MustInitPkg(store, "cash")
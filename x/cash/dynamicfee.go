@@ -43,6 +43,8 @@ import (
 type DynamicFeeDecorator struct {
 	auth x.Authenticator
 	ctrl CoinMover
+	// summary counts collected fees, if set.
+	summary *FeeSummary
 }
 
 var _ weave.Decorator = DynamicFeeDecorator{}
@@ -56,6 +58,14 @@ func NewDynamicFeeDecorator(auth x.Authenticator, ctrl Controller) DynamicFeeDec
 	}
 }
 
+// WithFeeSummary configures the decorator to count all fees collected in
+// DeliverTx, including the minimal fee charged for a failed transaction, so
+// that their total is reported at the end of the block by the given summary.
+func (d DynamicFeeDecorator) WithFeeSummary(s *FeeSummary) DynamicFeeDecorator {
+	d.summary = s
+	return d
+}
+
 // Check verifies and deducts fees before calling down the stack
 func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (cres *weave.CheckResult, cerr error) {
 	fee, payer, cache, err := d.prepare(ctx, store, tx)
@@ -78,11 +88,11 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			_ = d.chargeMinimalFee(ctx, store, payer)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(ctx, cache, payer, fee); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	cres, err = next.Check(ctx, cache, tx)
@@ -113,14 +123,18 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 				cache.Discard()
 				dres = nil
 				derr = err
+			} else {
+				d.summary.count(fee)
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			if err := d.chargeMinimalFee(ctx, store, payer); err == nil {
+				d.summary.count(mustLoadConf(store).MinimalFee)
+			}
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(ctx, cache, payer, fee); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	res, err := next.Deliver(ctx, cache, tx)
@@ -134,16 +148,16 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 	return res, nil
 }
 
-func (d DynamicFeeDecorator) chargeFee(store weave.KVStore, src weave.Address, amount coin.Coin) error {
+func (d DynamicFeeDecorator) chargeFee(ctx weave.Context, store weave.KVStore, src weave.Address, amount coin.Coin) error {
 	if amount.IsZero() {
 		return nil
 	}
 	dest := mustLoadConf(store).CollectorAddress
-	return d.ctrl.MoveCoins(store, src, dest, amount)
+	return d.ctrl.MoveCoins(ctx, store, src, dest, amount)
 }
 
 // chargeMinimalFee deduct an anty span fee from a given account.
-func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Address) error {
+func (d DynamicFeeDecorator) chargeMinimalFee(ctx weave.Context, store weave.KVStore, src weave.Address) error {
 	fee := mustLoadConf(store).MinimalFee
 	if fee.IsZero() {
 		return nil
@@ -151,7 +165,7 @@ func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Add
 	if fee.Ticker == "" {
 		return errors.Wrap(errors.ErrHuman, "minimal fee without a ticker")
 	}
-	return d.chargeFee(store, src, fee)
+	return d.chargeFee(ctx, store, src, fee)
 }
 
 // prepare is all shared setup between Check and Deliver. It computes the fee
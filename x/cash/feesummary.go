@@ -0,0 +1,71 @@
+package cash
+
+import (
+	"sync"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// FeeSummaryKey is used by FeeSummary as the Key of each Tag it returns.
+const FeeSummaryKey = "collected_fee"
+
+// FeeSummary counts the fees collected within a block by the fee decorators
+// configured using WithFeeSummary. The count is kept in memory only and
+// never becomes a part of the state. Only fees collected in DeliverTx are
+// counted.
+//
+// FeeSummary.EndBlockHook must be registered in the application, so that the
+// count is reported and reset at the end of each block.
+type FeeSummary struct {
+	mu    sync.Mutex
+	total Set
+	// err is the first error that occurred while counting fees within
+	// the current block.
+	err error
+}
+
+// NewFeeSummary returns a FeeSummary with no fees counted.
+func NewFeeSummary() *FeeSummary {
+	return &FeeSummary{}
+}
+
+// count adds the fee to the total collected within the current block. It is
+// safe to call it on a nil FeeSummary, in which case it is a no-op.
+func (s *FeeSummary) count(fee coin.Coin) {
+	if s == nil || fee.IsZero() {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return
+	}
+	if err := Add(&s.total, fee); err != nil {
+		s.err = errors.Wrap(err, "cannot add fee")
+	}
+}
+
+// EndBlockHook is a weave.EndBlockHook that reports the total of all fees
+// collected within the block, with a tag for each currency, and resets the
+// count.
+func (s *FeeSummary) EndBlockHook(ctx weave.Context, db weave.KVStore) ([]common.KVPair, error) {
+	s.mu.Lock()
+	total, err := s.total, s.err
+	s.total, s.err = Set{}, nil
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]common.KVPair, 0, len(total.Coins))
+	for _, c := range total.Coins {
+		tags = append(tags, common.KVPair{
+			Key:   []byte(FeeSummaryKey),
+			Value: []byte(c.String()),
+		})
+	}
+	return tags, nil
+}
@@ -0,0 +1,74 @@
+package cash
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestFeeSummary(t *testing.T) {
+	payer := weavetest.NewCondition()
+	collector := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	config := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: collector.Address(),
+		MinimalFee:       coin.NewCoin(0, 10, "IOV"),
+	}
+	if err := gconf.Save(db, "cash", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	wallet, err := WalletWith(payer.Address(), coin.NewCoinp(10, 0, "IOV"))
+	assert.Nil(t, err)
+	ensureWallets(t, db, []orm.Object{wallet})
+
+	auth := &weavetest.Auth{Signers: []weave.Condition{payer}}
+	ctrl := NewController(NewBucket())
+	summary := NewFeeSummary()
+	dynamic := NewDynamicFeeDecorator(auth, ctrl).WithFeeSummary(summary)
+	static := NewFeeDecorator(auth, ctrl).WithFeeSummary(summary)
+
+	feeTx := func(fee coin.Coin) weave.Tx {
+		return &txMock{info: &FeeInfo{Fees: &fee}}
+	}
+
+	// A block with many transactions.
+	_, err = dynamic.Deliver(nil, db, feeTx(coin.NewCoin(1, 0, "IOV")), &weavetest.Handler{})
+	assert.Nil(t, err)
+	_, err = static.Deliver(nil, db, feeTx(coin.NewCoin(0, 500000000, "IOV")), &weavetest.Handler{})
+	assert.Nil(t, err)
+	// Failed transaction is charged only the minimal fee.
+	_, err = dynamic.Deliver(nil, db, feeTx(coin.NewCoin(2, 0, "IOV")), &weavetest.Handler{DeliverErr: errors.ErrHuman})
+	if !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// Fees paid in CheckTx are not collected.
+	_, err = dynamic.Check(nil, db, feeTx(coin.NewCoin(4, 0, "IOV")), &weavetest.Handler{})
+	assert.Nil(t, err)
+	// Fee collected by a decorator that does not count fees is not
+	// reported.
+	_, err = NewDynamicFeeDecorator(auth, ctrl).Deliver(nil, db, feeTx(coin.NewCoin(3, 0, "IOV")), &weavetest.Handler{})
+	assert.Nil(t, err)
+
+	tags, err := summary.EndBlockHook(nil, db)
+	assert.Nil(t, err)
+	assert.Equal(t, []common.KVPair{
+		{Key: []byte(FeeSummaryKey), Value: []byte("1.50000001 IOV")},
+	}, tags)
+
+	// The count is reset at the end of the block.
+	tags, err = summary.EndBlockHook(nil, db)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tags))
+}
@@ -2,9 +2,11 @@ package cash
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
@@ -15,17 +17,21 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 
 	r.Handle(&SendMsg{}, NewSendHandler(auth, control))
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+	r.Handle(&UpdateWalletConfigMsg{}, NewWalletConfigHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterQuery will register this bucket as "/wallets" and the wallet
+// configuration bucket as "/walletconfigs"
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("wallets", qr)
+	NewWalletConfigBucket().Register("walletconfigs", qr)
 }
 
 // SendHandler will handle sending coins
 type SendHandler struct {
 	auth    x.Authenticator
 	control Controller
+	configs WalletConfigBucket
 }
 
 var _ weave.Handler = SendHandler{}
@@ -35,6 +41,7 @@ func NewSendHandler(auth x.Authenticator, control Controller) SendHandler {
 	return SendHandler{
 		auth:    auth,
 		control: control,
+		configs: NewWalletConfigBucket(),
 	}
 }
 
@@ -51,6 +58,13 @@ func (h SendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx)
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
 
+	if err := h.ensureMemo(store, &msg); err != nil {
+		return nil, err
+	}
+	if err := h.ensureNoDust(ctx, store, &msg); err != nil {
+		return nil, err
+	}
+
 	res := weave.CheckResult{
 		GasAllocated: sendTxCost,
 	}
@@ -70,13 +84,147 @@ func (h SendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
 
-	if err := h.control.MoveCoins(store, msg.Source, msg.Destination, *msg.Amount); err != nil {
+	if err := h.ensureMemo(store, &msg); err != nil {
+		return nil, err
+	}
+	if err := h.ensureNoDust(ctx, store, &msg); err != nil {
+		return nil, err
+	}
+
+	if err := h.control.MoveCoins(ctx, store, msg.Source, msg.Destination, *msg.Amount); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{}, nil
 }
 
+// ensureMemo returns an error if the destination wallet requires a memo and
+// the message does not provide one.
+func (h SendHandler) ensureMemo(db weave.KVStore, msg *SendMsg) error {
+	if msg.Memo != "" {
+		return nil
+	}
+	// Most wallets have no configuration. Checking the presence is
+	// cheaper than loading and unmarshaling the configuration.
+	if ok, err := h.configs.Has(db, msg.Destination); err != nil {
+		return errors.Wrap(err, "cannot check destination wallet configuration")
+	} else if !ok {
+		return nil
+	}
+	conf, err := h.configs.GetWalletConfig(db, msg.Destination)
+	if err != nil {
+		return errors.Wrap(err, "cannot load destination wallet configuration")
+	}
+	if conf != nil && conf.RequireMemo {
+		return errors.Field("Memo", errors.ErrInput, "destination wallet requires a memo")
+	}
+	return nil
+}
+
+// ensureNoDust returns an error if the transfer would leave in the source
+// wallet a non zero balance lower than the configured dust threshold.
+//
+// The reserve policy applies only to the user accounts, authenticated by a
+// signature. Fees, escrows and other contracts can move any amount.
+func (h SendHandler) ensureNoDust(ctx weave.Context, db weave.KVStore, msg *SendMsg) error {
+	if !isUserAccount(h.auth.GetConditions(ctx), msg.Source) {
+		return nil
+	}
+	conf, err := loadReservePolicy(db)
+	if err != nil {
+		return err
+	}
+	threshold := dustThreshold(conf, msg.Amount.Ticker)
+	if threshold.IsZero() {
+		return nil
+	}
+	balance, err := h.control.Balance(db, msg.Source)
+	if err != nil {
+		if errors.ErrNotFound.Is(err) {
+			// Moving coins from a missing wallet fails anyway.
+			return nil
+		}
+		return errors.Wrap(err, "cannot load source balance")
+	}
+	for _, c := range balance {
+		if c.Ticker != msg.Amount.Ticker {
+			continue
+		}
+		left, err := c.Subtract(*msg.Amount)
+		if err != nil {
+			return errors.Wrap(err, "cannot compute remaining balance")
+		}
+		if left.IsPositive() && left.Compare(threshold) < 0 {
+			return errors.Wrapf(errors.ErrAmount, "remaining balance %s is below the dust threshold %s, transfer the whole balance instead", left, threshold)
+		}
+	}
+	return nil
+}
+
+// isUserAccount returns true if given address belongs to one of the signature
+// conditions.
+func isUserAccount(conds []weave.Condition, addr weave.Address) bool {
+	for _, c := range conds {
+		ext, _, _, err := c.Parse()
+		if err != nil || ext != crypto.ExtensionName {
+			continue
+		}
+		if c.Address().Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func NewConfigHandler(auth x.Authenticator) weave.Handler {
 	var conf Configuration
 	return gconf.NewUpdateConfigurationHandler("cash", &conf, auth, migration.CurrentAdmin)
 }
+
+// WalletConfigHandler will handle setting a wallet configuration.
+type WalletConfigHandler struct {
+	auth    x.Authenticator
+	configs WalletConfigBucket
+}
+
+var _ weave.Handler = WalletConfigHandler{}
+
+// NewWalletConfigHandler creates a handler for UpdateWalletConfigMsg.
+func NewWalletConfigHandler(auth x.Authenticator) WalletConfigHandler {
+	return WalletConfigHandler{
+		auth:    auth,
+		configs: NewWalletConfigBucket(),
+	}
+}
+
+func (h WalletConfigHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: updateWalletConfigCost}, nil
+}
+
+func (h WalletConfigHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	obj := orm.NewSimpleObj(msg.Address, &WalletConfig{
+		Metadata:    &weave.Metadata{Schema: 1},
+		RequireMemo: msg.RequireMemo,
+	})
+	if err := h.configs.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "cannot store wallet configuration")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h WalletConfigHandler) validate(ctx weave.Context, tx weave.Tx) (*UpdateWalletConfigMsg, error) {
+	var msg UpdateWalletConfigMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Address) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "wallet owner signature missing")
+	}
+	return &msg, nil
+}
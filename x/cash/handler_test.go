@@ -1,11 +1,14 @@
 package cash
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
@@ -26,6 +29,7 @@ func TestSend(t *testing.T) {
 	cases := map[string]struct {
 		signers        []weave.Condition
 		initState      []orm.Object
+		initConfigs    map[string]*WalletConfig
 		msg            weave.Msg
 		wantCheckErr   *errors.Error
 		wantDeliverErr *errors.Error
@@ -80,6 +84,51 @@ func TestSend(t *testing.T) {
 				Destination: perm2.Address(),
 			},
 		},
+		"destination requires a memo": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			initConfigs: map[string]*WalletConfig{
+				string(perm2.Address()): {Metadata: &weave.Metadata{Schema: 1}, RequireMemo: true},
+			},
+			msg: &SendMsg{
+				Amount:      &foo,
+				Source:      perm.Address(),
+				Destination: perm2.Address(),
+			},
+			wantCheckErr:   errors.ErrInput,
+			wantDeliverErr: errors.ErrInput,
+		},
+		"destination requires a memo and memo is provided": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			initConfigs: map[string]*WalletConfig{
+				string(perm2.Address()): {Metadata: &weave.Metadata{Schema: 1}, RequireMemo: true},
+			},
+			msg: &SendMsg{
+				Amount:      &foo,
+				Source:      perm.Address(),
+				Destination: perm2.Address(),
+				Memo:        "deposit 42",
+			},
+		},
+		"destination configuration does not require a memo": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			initConfigs: map[string]*WalletConfig{
+				string(perm2.Address()): {Metadata: &weave.Metadata{Schema: 1}, RequireMemo: false},
+			},
+			msg: &SendMsg{
+				Amount:      &foo,
+				Source:      perm.Address(),
+				Destination: perm2.Address(),
+			},
+		},
 	}
 
 	for testName, tc := range cases {
@@ -96,6 +145,12 @@ func TestSend(t *testing.T) {
 					t.Fatalf("cannot save %q wallet: %s", wallet.Key(), err)
 				}
 			}
+			configs := NewWalletConfigBucket()
+			for addr, conf := range tc.initConfigs {
+				if err := configs.Save(kv, orm.NewSimpleObj([]byte(addr), conf)); err != nil {
+					t.Fatalf("cannot save wallet configuration: %s", err)
+				}
+			}
 
 			tx := &weavetest.Tx{Msg: tc.msg}
 
@@ -108,3 +163,203 @@ func TestSend(t *testing.T) {
 		})
 	}
 }
+
+func TestSendDustThreshold(t *testing.T) {
+	user := weave.NewCondition(crypto.ExtensionName, "ed25519", []byte{1, 2, 3})
+	contract := weave.NewCondition("escrow", "seq", []byte{0, 0, 0, 0, 0, 0, 0, 1})
+	dst := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		source  weave.Condition
+		amount  coin.Coin
+		wantErr *errors.Error
+	}{
+		"user cannot leave a balance below the threshold": {
+			source:  user,
+			amount:  coin.NewCoin(9, 1, "IOV"),
+			wantErr: errors.ErrAmount,
+		},
+		"user can leave a balance equal to the threshold": {
+			source: user,
+			amount: coin.NewCoin(9, 0, "IOV"),
+		},
+		"user can transfer the whole balance": {
+			source: user,
+			amount: coin.NewCoin(10, 0, "IOV"),
+		},
+		"user can leave any balance of a currency without a threshold": {
+			source: user,
+			amount: coin.NewCoin(0, 1, "ETH"),
+		},
+		"contract can leave a balance below the threshold": {
+			source: contract,
+			amount: coin.NewCoin(9, 1, "IOV"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			config := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				DustThresholds:   []coin.Coin{coin.NewCoin(1, 0, "IOV")},
+			}
+			if err := gconf.Save(kv, "cash", &config); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			wallet, err := WalletWith(tc.source.Address(), coin.NewCoinp(10, 0, "IOV"), coin.NewCoinp(1, 0, "ETH"))
+			if err != nil {
+				t.Fatalf("cannot create wallet: %s", err)
+			}
+			if err := NewBucket().Save(kv, wallet); err != nil {
+				t.Fatalf("cannot save wallet: %s", err)
+			}
+
+			auth := &weavetest.Auth{Signers: []weave.Condition{tc.source}}
+			h := NewSendHandler(auth, NewController(NewBucket()))
+			tx := &weavetest.Tx{Msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      &tc.amount,
+				Source:      tc.source.Address(),
+				Destination: dst,
+			}}
+			if _, err := h.Check(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateWalletConfig(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer         weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantConfig     *WalletConfig
+	}{
+		"owner can require a memo": {
+			signer: owner,
+			msg: &UpdateWalletConfigMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Address:     owner.Address(),
+				RequireMemo: true,
+			},
+			wantConfig: &WalletConfig{
+				Metadata:    &weave.Metadata{Schema: 1},
+				RequireMemo: true,
+			},
+		},
+		"owner signature is required": {
+			signer: weavetest.NewCondition(),
+			msg: &UpdateWalletConfigMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Address:     owner.Address(),
+				RequireMemo: true,
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			h := NewWalletConfigHandler(auth)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(nil, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			conf, err := NewWalletConfigBucket().GetWalletConfig(kv, owner.Address())
+			if err != nil {
+				t.Fatalf("cannot load configuration: %s", err)
+			}
+			if !reflect.DeepEqual(tc.wantConfig, conf) {
+				t.Fatalf("unexpected configuration: %+v", conf)
+			}
+		})
+	}
+}
+
+func BenchmarkSendHandler(b *testing.B) {
+	source := weavetest.NewCondition()
+	plain := weavetest.NewCondition()
+	configured := weavetest.NewCondition()
+	exchange := weavetest.NewCondition()
+	amount := coin.NewCoin(1, 0, "FOO")
+
+	cases := map[string]struct {
+		msg *SendMsg
+	}{
+		"destination without a configuration": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      &amount,
+				Source:      source.Address(),
+				Destination: plain.Address(),
+			},
+		},
+		"destination configuration does not require a memo": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      &amount,
+				Source:      source.Address(),
+				Destination: configured.Address(),
+			},
+		},
+		"destination requires a memo": {
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Amount:      &amount,
+				Source:      source.Address(),
+				Destination: exchange.Address(),
+				Memo:        "deposit 42",
+			},
+		},
+	}
+
+	for benchName, bc := range cases {
+		b.Run(benchName, func(b *testing.B) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+			if err := NewBucket().Save(db, must(WalletWith(source.Address(), coin.NewCoinp(1000000000, 0, "FOO")))); err != nil {
+				b.Fatalf("cannot save source wallet: %s", err)
+			}
+			configs := NewWalletConfigBucket()
+			conf := orm.NewSimpleObj(exchange.Address(), &WalletConfig{Metadata: &weave.Metadata{Schema: 1}, RequireMemo: true})
+			if err := configs.Save(db, conf); err != nil {
+				b.Fatalf("cannot save wallet configuration: %s", err)
+			}
+			conf = orm.NewSimpleObj(configured.Address(), &WalletConfig{Metadata: &weave.Metadata{Schema: 1}, RequireMemo: false})
+			if err := configs.Save(db, conf); err != nil {
+				b.Fatalf("cannot save wallet configuration: %s", err)
+			}
+
+			h := NewSendHandler(&weavetest.Auth{Signer: source}, NewController(NewBucket()))
+			tx := &weavetest.Tx{Msg: bc.msg}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := h.Check(nil, db, tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
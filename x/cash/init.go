@@ -17,7 +17,12 @@ type GenesisAccount struct {
 // the genesis file
 type Initializer struct{}
 
-var _ weave.Initializer = Initializer{}
+var _ weave.DependentInitializer = Initializer{}
+
+// Dependencies implements weave.DependentInitializer.
+func (Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
@@ -97,3 +97,23 @@ func mustCombineCoins(cs ...coin.Coin) coin.Coins {
 	}
 	return s
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{
+			"address": "0102030405060708090021222324252627282930",
+			"coins": [
+				{"whole": 50, "fractional": 1234567, "ticker": "FOO"},
+				"10 IOV"
+			]
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]GenesisAccount{})
+
+	const conf = `{
+		"owner": "0102030405060708090021222324252627282930",
+		"collector_address": "seq:test/coll/1",
+		"minimal_fee": {"whole": 0, "fractional": 20, "ticker": "IOV"}
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
+}
@@ -12,6 +12,7 @@ import (
 func init() {
 	migration.MustRegister(1, &Set{}, migration.NoModification)
 	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegister(1, &WalletConfig{}, migration.NoModification)
 }
 
 // BucketName is where we store the balances
@@ -139,6 +140,7 @@ type WalletBucket interface {
 	GetOrCreate(db weave.KVStore, key weave.Address) (orm.Object, error)
 	Get(db weave.ReadOnlyKVStore, key []byte) (orm.Object, error)
 	Save(db weave.KVStore, obj orm.Object) error
+	Delete(db weave.KVStore, key []byte) error
 }
 
 // ValidateWalletBucket makes sure that it supports AsCoinage
@@ -159,3 +161,48 @@ func ValidateWalletBucket(bucket WalletBucket) {
 	// this panics if bad type
 	AsCoinage(obj)
 }
+
+var _ orm.CloneableData = (*WalletConfig)(nil)
+
+// Validate ensures the wallet configuration is valid.
+func (c *WalletConfig) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	return errs
+}
+
+// WalletConfigBucket is a type-safe wrapper around orm.Bucket that stores
+// wallet configurations under the wallet address.
+type WalletConfigBucket struct {
+	orm.Bucket
+}
+
+// NewWalletConfigBucket initializes a WalletConfigBucket with default name.
+func NewWalletConfigBucket() WalletConfigBucket {
+	return WalletConfigBucket{
+		Bucket: migration.NewBucket("cash", "walletconf", &WalletConfig{}),
+	}
+}
+
+// Has returns true if a configuration is stored for given address. This is
+// cheaper than loading the configuration, as no value is read or unmarshaled.
+func (b WalletConfigBucket) Has(db weave.ReadOnlyKVStore, addr weave.Address) (bool, error) {
+	return db.Has(b.DBKey(addr))
+}
+
+// GetWalletConfig returns the configuration of a wallet with given address or
+// nil if the wallet is not configured.
+func (b WalletConfigBucket) GetWalletConfig(db weave.ReadOnlyKVStore, addr weave.Address) (*WalletConfig, error) {
+	obj, err := b.Get(db, addr)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	c, ok := obj.Value().(*WalletConfig)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	return c, nil
+}
@@ -10,10 +10,12 @@ import (
 func init() {
 	migration.MustRegister(1, &SendMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateWalletConfigMsg{}, migration.NoModification)
 }
 
 const (
-	sendTxCost int64 = 100
+	sendTxCost             int64 = 100
+	updateWalletConfigCost int64 = 50
 
 	maxMemoSize int = 128
 	maxRefSize  int = 64
@@ -130,3 +132,17 @@ func (m *UpdateConfigurationMsg) Validate() error {
 func (*UpdateConfigurationMsg) Path() string {
 	return "cash/update_configuration"
 }
+
+var _ weave.Msg = (*UpdateWalletConfigMsg)(nil)
+
+// Validate makes sure that this is sensible.
+func (m *UpdateWalletConfigMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	return errs
+}
+
+func (*UpdateWalletConfigMsg) Path() string {
+	return "cash/update_wallet_config"
+}
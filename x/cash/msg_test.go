@@ -145,3 +145,39 @@ func TestValidateFeeTx(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpdateWalletConfigMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &UpdateWalletConfigMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Address:     weavetest.NewCondition().Address(),
+				RequireMemo: true,
+			},
+			wantErr: nil,
+		},
+		"missing metadata": {
+			msg: &UpdateWalletConfigMsg{
+				Address: weavetest.NewCondition().Address(),
+			},
+			wantErr: errors.ErrMetadata,
+		},
+		"missing address": {
+			msg: &UpdateWalletConfigMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@ import (
 type FeeDecorator struct {
 	auth x.Authenticator
 	ctrl CoinMover
+	// summary counts collected fees, if set.
+	summary *FeeSummary
 }
 
 var _ weave.Decorator = FeeDecorator{}
@@ -39,6 +41,14 @@ func NewFeeDecorator(auth x.Authenticator, ctrl CoinMover) FeeDecorator {
 	}
 }
 
+// WithFeeSummary configures the decorator to count all fees collected by a
+// successfully delivered transaction, so that their total is reported at the
+// end of the block by the given summary.
+func (d FeeDecorator) WithFeeSummary(s *FeeSummary) FeeDecorator {
+	d.summary = s
+	return d
+}
+
 // Check verifies and deducts fees before calling down the stack
 func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
 	finfo, err := d.extractFee(ctx, tx, store)
@@ -58,7 +68,7 @@ func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	}
 	// and have enough
 	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
+	err = d.ctrl.MoveCoins(ctx, store, finfo.Payer, collector, *fee)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +102,16 @@ func (d FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.T
 	}
 	// and subtract it from the account
 	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
+	err = d.ctrl.MoveCoins(ctx, store, finfo.Payer, collector, *fee)
 	if err != nil {
 		return nil, err
 	}
 
-	return next.Deliver(ctx, store, tx)
+	res, err := next.Deliver(ctx, store, tx)
+	if err == nil {
+		d.summary.count(*fee)
+	}
+	return res, err
 }
 
 func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVStore) (*FeeInfo, error) {
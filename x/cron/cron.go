@@ -230,10 +230,14 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				ExecHeight: blockHeight,
 			}
 
+			// Task results are stored in the state, so only a
+			// redacted error message is included. The full error is
+			// written to the local log.
 			auth, msg, err := t.enc.UnmarshalTask(raw)
 			if err != nil {
+				weave.GetLogger(ctx).Error("cannot unmarshal cron task", "err", err)
 				res.Successful = false
-				res.Info = fmt.Sprintf("cannot unmarshal task: %+v", err)
+				res.Info = fmt.Sprintf("cannot unmarshal task: %s", errors.RedactForConsensus(err))
 			} else {
 				taskCtx := withAuth(ctx, auth)
 				tx := &taskTx{msg: msg}
@@ -242,8 +246,9 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				// only when the Deliver call is successful.
 				sp := utils.NewSavepoint().OnDeliver()
 				if r, err := sp.Deliver(taskCtx, cache, tx, t.hn); err != nil {
+					weave.GetLogger(ctx).Info("cron task failed", "path", msg.Path(), "err", err)
 					res.Successful = false
-					res.Info = err.Error()
+					res.Info = errors.RedactForConsensus(err).Error()
 				} else {
 					taskTags = r.Tags
 					taskDiff = r.Diff
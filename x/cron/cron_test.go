@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -190,7 +189,7 @@ func TestTicker(t *testing.T) {
 					Msg:             weavetest.Msg{RoutePath: "test/1"},
 					WantExec:        true,
 					WantExecSuccess: false,
-					WantInfo:        fmt.Sprintf("%q condition (%q) not found: unauthorized", conditionB, conditionB.Address()),
+					WantInfo:        errors.ErrUnauthorized.Error(),
 				},
 			},
 			Handler: cronHandler{
@@ -251,6 +250,24 @@ func TestTicker(t *testing.T) {
 				},
 			},
 		},
+		"a failed task result contains only the redacted error message": {
+			Tasks: []*task{
+				{
+					RunAt:           now.Add(-time.Hour),
+					Auth:            nil,
+					Msg:             weavetest.Msg{RoutePath: "test/1"},
+					WantExec:        true,
+					WantExecSuccess: false,
+					WantInfo:        errors.ErrState.Error(),
+				},
+			},
+			WantTickerErr: nil,
+			Handler: cronHandler{
+				errs: map[string]error{
+					"test/1": errors.Wrapf(errors.ErrState, "pointer %p", &now),
+				},
+			},
+		},
 		"a mixture of tasks, some are due and some are successful": {
 			Tasks: []*task{
 				{
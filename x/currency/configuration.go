@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegister(2, &Configuration{}, migration.NoModification)
+}
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	errs = errors.AppendField(errs, "Governance", c.Governance.Validate())
+	return errs
+}
+
+func loadConf(db gconf.ReadStore) (*Configuration, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "currency", &conf); err != nil {
+		return nil, errors.Wrap(err, "gconf")
+	}
+	return &conf, nil
+}
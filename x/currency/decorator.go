@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"reflect"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// TickerDecorator rejects in CheckTx a transaction with a message that
+// references a coin of a ticker that is not registered. All coin values of the
+// message are checked, including those of nested structures, so any message
+// that declares an amount, for example cash, escrow, aswap or termdeposit
+// messages, is covered.
+//
+// The check is disabled as long as no token is registered, so that an
+// application that does not use the token registry keeps working.
+// DeliverTx is not affected.
+type TickerDecorator struct {
+	bucket *TokenInfoBucket
+}
+
+var _ weave.Decorator = TickerDecorator{}
+
+// NewTickerDecorator returns a decorator that validates tickers of all coins
+// referenced by a transaction message.
+func NewTickerDecorator() TickerDecorator {
+	return TickerDecorator{
+		bucket: NewTokenInfoBucket(),
+	}
+}
+
+func (d TickerDecorator) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get message")
+	}
+	tickers := msgTickers(msg)
+	if len(tickers) == 0 {
+		return next.Check(ctx, db, tx)
+	}
+	switch inUse, err := d.registryInUse(db); {
+	case err != nil:
+		return nil, err
+	case !inUse:
+		return next.Check(ctx, db, tx)
+	}
+	for _, ticker := range tickers {
+		if err := coin.ValidateTicker(db, ticker); err != nil {
+			return nil, err
+		}
+	}
+	return next.Check(ctx, db, tx)
+}
+
+func (d TickerDecorator) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	return next.Deliver(ctx, db, tx)
+}
+
+// registryInUse returns true if at least one token is registered.
+func (d TickerDecorator) registryInUse(db weave.ReadOnlyKVStore) (bool, error) {
+	start := d.bucket.DBKey(nil)
+	end := d.bucket.DBKey([]byte{255})
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return false, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+	switch _, _, err := it.Next(); {
+	case err == nil:
+		return true, nil
+	case errors.ErrIteratorDone.Is(err):
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "iterator next")
+	}
+}
+
+var coinType = reflect.TypeOf(coin.Coin{})
+
+// msgTickers returns the tickers of all non empty coins found in given
+// message. Each ticker is returned once.
+func msgTickers(msg weave.Msg) []string {
+	seen := make(map[string]struct{})
+	var tickers []string
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice, reflect.Array:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			if v.Type() == coinType {
+				t := v.Interface().(coin.Coin).Ticker
+				if _, ok := seen[t]; !ok && t != "" {
+					seen[t] = struct{}{}
+					tickers = append(tickers, t)
+				}
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).PkgPath != "" {
+					// Unexported field.
+					continue
+				}
+				walk(v.Field(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(msg))
+	return tickers
+}
@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/escrow"
+)
+
+func TestTickerDecorator(t *testing.T) {
+	send := func(ticker string) weave.Msg {
+		return &cash.SendMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Amount:   coin.NewCoinp(1, 0, ticker),
+		}
+	}
+	escrowMsg := &escrow.CreateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Amount:   []*coin.Coin{coin.NewCoinp(1, 0, "IOV"), coin.NewCoinp(2, 0, "ETH")},
+	}
+
+	cases := map[string]struct {
+		tokens   []string
+		msg      weave.Msg
+		wantErr  *errors.Error
+		wantNext bool
+	}{
+		"registered ticker": {
+			tokens:   []string{"IOV"},
+			msg:      send("IOV"),
+			wantNext: true,
+		},
+		"unknown ticker": {
+			tokens:  []string{"IOV"},
+			msg:     send("ETH"),
+			wantErr: errors.ErrCurrency,
+		},
+		"unknown ticker in a list of coins": {
+			tokens:  []string{"IOV"},
+			msg:     escrowMsg,
+			wantErr: errors.ErrCurrency,
+		},
+		"all tickers in a list of coins registered": {
+			tokens:   []string{"IOV", "ETH"},
+			msg:      escrowMsg,
+			wantNext: true,
+		},
+		"check is disabled when no token is registered": {
+			msg:      send("ETH"),
+			wantNext: true,
+		},
+		"message without coins": {
+			tokens:   []string{"IOV"},
+			msg:      &weavetest.Msg{RoutePath: "test/msg"},
+			wantNext: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "currency")
+			bucket := NewTokenInfoBucket()
+			for _, ticker := range tc.tokens {
+				assert.Nil(t, bucket.Save(db, NewTokenInfo(ticker, "Token "+ticker)))
+			}
+
+			handler := &weavetest.Handler{}
+			d := NewTickerDecorator()
+			tx := &weavetest.Tx{Msg: tc.msg}
+			if _, err := d.Check(context.Background(), db, tx, handler); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			assert.Equal(t, tc.wantNext, handler.CheckCallCount() == 1)
+
+			// Deliver is never affected.
+			_, err := d.Deliver(context.Background(), db, tx, handler)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestTokenInfoBucketMatchesValidateTicker(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency")
+	assert.Nil(t, NewTokenInfoBucket().Save(db, NewTokenInfo("IOV", "Token IOV")))
+
+	assert.Nil(t, coin.ValidateTicker(db, "IOV"))
+	if err := coin.ValidateTicker(db, "ETH"); !errors.ErrCurrency.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
@@ -2,6 +2,17 @@
 Package currency provides an implementation of a token registry. It allows to
 keep keep track of token/currency configuration.
 
-Once configured, token declaration cannot be altered.
+Once configured, token ticker cannot be altered. Token name and decimals can be
+updated by the token issuer. Tokens that were registered without an issuer can
+be updated only by the governance address declared in the extension
+configuration (Configuration.Governance).
+
+Token issuer and decimals are available starting with the currency schema
+version 2. Until the schema is upgraded, tokens can be registered only without
+them and UpdateTokenInfoMsg is rejected.
+
+An application can require all tokens to be registered by adding the
+TickerDecorator to its decorator chain. A transaction with a message that
+references an unknown ticker is then rejected in CheckTx.
 */
 package currency
@@ -3,12 +3,18 @@ package currency
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
 const newTokenInfoCost = 100
 
+// issuerSchema is the schema version of the currency package that introduces
+// the token issuer and decimals.
+const issuerSchema = 2
+
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTokenInfoBucket().Register("tokens", qr)
 }
@@ -17,6 +23,9 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, issuer weave.Address
 	r = migration.SchemaMigratingRegistry("currency", r)
 
 	r.Handle(&CreateMsg{}, newCreateTokenInfoHandler(auth, issuer))
+	r.Handle(&UpdateTokenInfoMsg{}, newUpdateTokenInfoHandler(auth))
+	r.Handle(&UpdateConfigurationMsg{},
+		gconf.NewUpdateConfigurationHandler("currency", &Configuration{}, auth, migration.CurrentAdmin))
 }
 
 func newCreateTokenInfoHandler(auth x.Authenticator, issuer weave.Address) weave.Handler {
@@ -46,6 +55,9 @@ func (h *createTokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx
 		return nil, err
 	}
 	obj := NewTokenInfo(msg.Ticker, msg.Name)
+	info := obj.Value().(*TokenInfo)
+	info.Issuer = msg.Issuer
+	info.Decimals = msg.Decimals
 	return &weave.DeliverResult{}, h.bucket.Save(db, obj)
 }
 
@@ -54,13 +66,22 @@ func (h *createTokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, t
 	if err := weave.LoadMsg(tx, &msg); err != nil {
 		return nil, errors.Wrap(err, "load msg")
 	}
+	if len(msg.Issuer) != 0 || msg.Decimals != 0 {
+		if err := ensureIssuerSchema(db); err != nil {
+			return nil, err
+		}
+	}
 
 	// Ensure we have permission if the issuer is provided.
 	if h.issuer != nil && !h.auth.HasAddress(ctx, h.issuer) {
 		return nil, errors.Wrapf(errors.ErrUnauthorized, "Token only issued by %s", h.issuer)
 	}
+	// Declared token issuer must sign the creation.
+	if len(msg.Issuer) != 0 && !h.auth.HasAddress(ctx, msg.Issuer) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "issuer signature required")
+	}
 
-	// Token can be registered only once and must not be updated.
+	// Token can be registered only once. Use UpdateTokenInfoMsg to update.
 	switch obj, err := h.bucket.Get(db, msg.Ticker); {
 	case err != nil:
 		return nil, err
@@ -70,3 +91,84 @@ func (h *createTokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, t
 
 	return &msg, nil
 }
+
+func newUpdateTokenInfoHandler(auth x.Authenticator) weave.Handler {
+	return &updateTokenInfoHandler{
+		auth:   auth,
+		bucket: NewTokenInfoBucket(),
+	}
+}
+
+type updateTokenInfoHandler struct {
+	auth   x.Authenticator
+	bucket *TokenInfoBucket
+}
+
+func (h *updateTokenInfoHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *updateTokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, obj, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	info := obj.Value().(*TokenInfo)
+	info.Name = msg.Name
+	info.Decimals = msg.Decimals
+	if err := h.bucket.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "save token info")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *updateTokenInfoHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateTokenInfoMsg, orm.Object, error) {
+	var msg UpdateTokenInfoMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if err := ensureIssuerSchema(db); err != nil {
+		return nil, nil, err
+	}
+
+	obj, err := h.bucket.Get(db, msg.Ticker)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get token info")
+	}
+	if obj == nil {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "ticker %s", msg.Ticker)
+	}
+	info, ok := obj.Value().(*TokenInfo)
+	if !ok {
+		return nil, nil, errors.WithType(errors.ErrModel, obj.Value())
+	}
+
+	// Token without an issuer can be updated only via governance.
+	issuer := info.Issuer
+	if len(issuer) == 0 {
+		conf, err := loadConf(db)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "load configuration")
+		}
+		issuer = conf.Governance
+	}
+	if !h.auth.HasAddress(ctx, issuer) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "issuer signature required")
+	}
+	return &msg, obj, nil
+}
+
+// ensureIssuerSchema returns an error if the currency package schema does not
+// support the token issuer and decimals yet.
+func ensureIssuerSchema(db weave.ReadOnlyKVStore) error {
+	switch ver, err := migration.NewSchemaBucket().CurrentSchema(db, "currency"); {
+	case err != nil:
+		return errors.Wrap(err, "cannot get schema version")
+	case ver < issuerSchema:
+		return errors.Wrapf(errors.ErrSchema, "issuer and decimals require currency schema version %d", issuerSchema)
+	}
+	return nil
+}
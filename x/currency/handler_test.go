@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
@@ -25,6 +27,7 @@ func TestNewTokenInfoHandler(t *testing.T) {
 		wantDeliverErr  *errors.Error
 		query           string
 		wantQueryResult orm.Object
+		legacySchema    bool
 	}{
 		"updating token info": {
 			signers: []weave.Condition{permA, permB},
@@ -65,26 +68,102 @@ func TestNewTokenInfoHandler(t *testing.T) {
 			query:           "UNK",
 			wantQueryResult: nil,
 		},
-		"ok": {
+		"issuer must sign the creation": {
+			signers: []weave.Condition{permA},
+			issuer:  permA.Address(),
+			msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Doge Coin",
+				Issuer:   permB.Address(),
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"ok with issuer and decimals": {
 			signers: []weave.Condition{permA, permB},
 			issuer:  permA.Address(),
 			msg: &CreateMsg{
 				Metadata: &weave.Metadata{Schema: 1},
 				Ticker:   "TKR",
 				Name:     "tikr",
+				Issuer:   permB.Address(),
+				Decimals: 3,
 			},
 			query: "TKR",
+			wantQueryResult: orm.NewSimpleObj([]byte("TKR"), &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 2},
+				Name:     "tikr",
+				Issuer:   permB.Address(),
+				Decimals: 3,
+			}),
+		},
+		"issuer requires schema version 2": {
+			signers: []weave.Condition{permA, permB},
+			issuer:  permA.Address(),
+			msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "TKR",
+				Name:     "tikr",
+				Issuer:   permB.Address(),
+			},
+			legacySchema:   true,
+			wantCheckErr:   errors.ErrSchema,
+			wantDeliverErr: errors.ErrSchema,
+			query:          "TKR",
+		},
+		"decimals require schema version 2": {
+			signers: []weave.Condition{permA, permB},
+			issuer:  permA.Address(),
+			msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "TKR",
+				Name:     "tikr",
+				Decimals: 3,
+			},
+			legacySchema:   true,
+			wantCheckErr:   errors.ErrSchema,
+			wantDeliverErr: errors.ErrSchema,
+			query:          "TKR",
+		},
+		"ok with schema version 1": {
+			signers: []weave.Condition{permA, permB},
+			issuer:  permA.Address(),
+			msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "TKR",
+				Name:     "tikr",
+			},
+			legacySchema: true,
+			query:        "TKR",
 			wantQueryResult: orm.NewSimpleObj([]byte("TKR"), &TokenInfo{
 				Metadata: &weave.Metadata{Schema: 1},
 				Name:     "tikr",
 			}),
 		},
+		"ok": {
+			signers: []weave.Condition{permA, permB},
+			issuer:  permA.Address(),
+			msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "TKR",
+				Name:     "tikr",
+			},
+			query: "TKR",
+			wantQueryResult: orm.NewSimpleObj([]byte("TKR"), &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 2},
+				Name:     "tikr",
+			}),
+		},
 	}
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
 			db := store.MemStore()
 			migration.MustInitPkg(db, "currency")
+			if !tc.legacySchema {
+				upgradeSchema(t, db)
+			}
 			bucket := NewTokenInfoBucket()
 			for _, obj := range tc.initState {
 				if err := bucket.Save(db, obj); err != nil {
@@ -118,3 +197,265 @@ func TestNewTokenInfoHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTokenInfoHandler(t *testing.T) {
+	issuer := weavetest.NewCondition()
+	governance := weavetest.NewCondition()
+	admin := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signers        []weave.Condition
+		legacySchema   bool
+		noConf         bool
+		initState      []orm.Object
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantInfo       *TokenInfo
+	}{
+		"issuer can update token info": {
+			signers: []weave.Condition{issuer},
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+					Issuer:   issuer.Address(),
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+				Decimals: 6,
+			},
+			wantInfo: &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 2},
+				Name:     "Much Doge",
+				Issuer:   issuer.Address(),
+				Decimals: 6,
+			},
+		},
+		"only issuer can update token info": {
+			signers: []weave.Condition{stranger, governance},
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+					Issuer:   issuer.Address(),
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"token without an issuer can be updated by governance": {
+			signers: []weave.Condition{governance},
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+				Decimals: 9,
+			},
+			wantInfo: &TokenInfo{
+				Metadata: &weave.Metadata{Schema: 2},
+				Name:     "Much Doge",
+				Decimals: 9,
+			},
+		},
+		"token without an issuer cannot be updated by anyone else": {
+			signers: []weave.Condition{stranger},
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"token without an issuer cannot be updated by the migration admin": {
+			signers: []weave.Condition{admin},
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"token without an issuer requires a configured governance": {
+			signers: []weave.Condition{governance},
+			noConf:  true,
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"update requires schema version 2": {
+			signers:      []weave.Condition{issuer},
+			legacySchema: true,
+			initState: []orm.Object{
+				orm.NewSimpleObj([]byte("DOGE"), &TokenInfo{
+					Metadata: &weave.Metadata{Schema: 1},
+					Name:     "Doge Coin",
+				}),
+			},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrSchema,
+			wantDeliverErr: errors.ErrSchema,
+		},
+		"unknown token": {
+			signers: []weave.Condition{issuer, governance},
+			msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "DOGE",
+				Name:     "Much Doge",
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "currency")
+			if !tc.legacySchema {
+				upgradeSchema(t, db)
+			}
+			mconf := migration.Configuration{Admin: admin.Address()}
+			if err := gconf.Save(db, "migration", &mconf); err != nil {
+				t.Fatalf("cannot save migration configuration: %s", err)
+			}
+			if !tc.noConf {
+				conf := Configuration{
+					Metadata:   &weave.Metadata{Schema: 1},
+					Owner:      admin.Address(),
+					Governance: governance.Address(),
+				}
+				if err := gconf.Save(db, "currency", &conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+			bucket := NewTokenInfoBucket()
+			for _, obj := range tc.initState {
+				if err := bucket.Save(db, obj); err != nil {
+					t.Fatalf("init state: cannot save: %s", err)
+				}
+			}
+
+			auth := &weavetest.Auth{Signers: tc.signers}
+			h := newUpdateTokenInfoHandler(auth)
+			tx := &weavetest.Tx{Msg: tc.msg}
+			if _, err := h.Check(nil, db.CacheWrap(), tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("check error: want %v, got %+v", tc.wantCheckErr, err)
+			}
+			if _, err := h.Deliver(nil, db, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("deliver error: want %v, got %+v", tc.wantDeliverErr, err)
+			}
+			if tc.wantInfo == nil {
+				return
+			}
+
+			obj, err := bucket.Get(db, "DOGE")
+			if err != nil {
+				t.Fatalf("query failed: %s", err)
+			}
+			if got := obj.Value(); !reflect.DeepEqual(got, tc.wantInfo) {
+				t.Logf("want: %#v", tc.wantInfo)
+				t.Logf(" got: %#v", got)
+				t.Fatal("unexpected token info")
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationWithUpgradedSchema(t *testing.T) {
+	owner := weavetest.NewCondition()
+	governance := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency")
+	upgradeSchema(t, db)
+	conf := Configuration{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Owner:      owner.Address(),
+		Governance: governance.Address(),
+	}
+	if err := gconf.Save(db, "currency", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	rt := app.NewRouter()
+	auth := &weavetest.Auth{Signer: owner}
+	RegisterRoutes(rt, auth, nil)
+	tx := &weavetest.Tx{Msg: &UpdateConfigurationMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Patch: &Configuration{
+			Metadata:   &weave.Metadata{Schema: 1},
+			Governance: owner.Address(),
+		},
+	}}
+	if _, err := rt.Check(nil, db.CacheWrap(), tx); err != nil {
+		t.Fatalf("check: %+v", err)
+	}
+	if _, err := rt.Deliver(nil, db, tx); err != nil {
+		t.Fatalf("deliver: %+v", err)
+	}
+	got, err := loadConf(db)
+	if err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if !got.Governance.Equals(owner.Address()) {
+		t.Fatalf("governance not updated: %s", got.Governance)
+	}
+}
+
+// upgradeSchema upgrades the currency package schema to the version that
+// supports the token issuer and decimals.
+func upgradeSchema(t testing.TB, db weave.KVStore) {
+	t.Helper()
+	_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "currency",
+		Version:  issuerSchema,
+	})
+	if err != nil {
+		t.Fatalf("cannot upgrade schema: %s", err)
+	}
+}
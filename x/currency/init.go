@@ -2,28 +2,53 @@ package currency
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisToken is the genesis file representation of a token.
+type genesisToken struct {
+	Ticker   string        `json:"ticker"`
+	Name     string        `json:"name"`
+	Issuer   weave.Address `json:"issuer"`
+	Decimals uint32        `json:"decimals"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var tokens []struct {
-		Ticker string `json:"ticker"`
-		Name   string `json:"name"`
+	// We allow to initialize configuration but it is not required.
+	if err := gconf.InitConfig(kv, opts, "currency", &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
 	}
+
+	var tokens []genesisToken
 	if err := opts.ReadOptions("currencies", &tokens); err != nil {
 		return err
 	}
 
 	bucket := NewTokenInfoBucket()
 	for _, t := range tokens {
+		if len(t.Issuer) != 0 || t.Decimals != 0 {
+			if err := ensureIssuerSchema(kv); err != nil {
+				return errors.Wrapf(err, "token %s", t.Ticker)
+			}
+		}
 		obj := NewTokenInfo(t.Ticker, t.Name)
+		info := obj.Value().(*TokenInfo)
+		info.Issuer = t.Issuer
+		info.Decimals = t.Decimals
 		if err := bucket.Save(kv, obj); err != nil {
 			return err
 		}
@@ -1,12 +1,15 @@
 package currency
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestGenesisKey(t *testing.T) {
@@ -44,3 +47,60 @@ func TestGenesisKey(t *testing.T) {
 		t.Errorf("invalid token name: %q", info.Name)
 	}
 }
+
+func TestGenesisIssuerRequiresSchema(t *testing.T) {
+	const genesis = `
+		{
+			"conf": {
+				"currency": {
+					"owner": "0102030405060708090021222324252627282930",
+					"governance": "1112131415161718191021222324252627282930"
+				}
+			},
+			"currencies": [
+				{"ticker": "ALX", "name": "Alx", "issuer": "0102030405060708090021222324252627282930"}
+			]
+		}
+	`
+
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !errors.ErrSchema.Is(err) {
+		t.Fatalf("want schema error, got %+v", err)
+	}
+
+	upgradeSchema(t, db)
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if want := weave.Address(fromHex(t, "1112131415161718191021222324252627282930")); !conf.Governance.Equals(want) {
+		t.Fatalf("unexpected governance address: %s", conf.Governance)
+	}
+}
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{"ticker": "ALX", "name": "Alx", "issuer": "0102030405060708090021222324252627282930", "decimals": 6},
+		{"ticker": "IOV", "name": "IOV"}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisToken{})
+}
+
+func fromHex(t testing.TB, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("cannot decode hex: %s", err)
+	}
+	return b
+}
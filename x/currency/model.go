@@ -12,8 +12,15 @@ import (
 
 func init() {
 	migration.MustRegister(1, &TokenInfo{}, migration.NoModification)
+	// Version 2 introduces issuer and decimals fields. Existing tokens have
+	// no issuer and can be updated only via governance.
+	migration.MustRegister(2, &TokenInfo{}, migration.NoModification)
 }
 
+// maxDecimals is the maximal allowed decimals value. It matches the coin
+// fractional precision (coin.FracUnit).
+const maxDecimals = 9
+
 var isTokenName = regexp.MustCompile(`^[A-Za-z0-9 \-_:]{3,32}$`).MatchString
 
 var _ orm.CloneableData = (*TokenInfo)(nil)
@@ -33,9 +40,20 @@ func (t *TokenInfo) Validate() error {
 	if !isTokenName(t.Name) {
 		errs = errors.AppendField(errs, "Name", errors.ErrState)
 	}
+	if len(t.Issuer) != 0 {
+		errs = errors.AppendField(errs, "Issuer", t.Issuer.Validate())
+	}
+	errs = errors.AppendField(errs, "Decimals", validateDecimals(t.Decimals))
 	return errs
 }
 
+func validateDecimals(d uint32) error {
+	if d > maxDecimals {
+		return errors.Wrapf(errors.ErrInput, "must not be greater than %d", maxDecimals)
+	}
+	return nil
+}
+
 // TokenInfoBucket stores TokenInfo instances, using ticker name (currency
 // symbol) as the key.
 type TokenInfoBucket struct {
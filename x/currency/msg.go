@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -8,6 +9,11 @@ import (
 
 func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateTokenInfoMsg{}, migration.NoModification)
+	migration.MustRegister(2, &UpdateTokenInfoMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(2, &UpdateConfigurationMsg{}, migration.NoModification)
 }
 
 func (CreateMsg) Path() string {
@@ -23,5 +29,47 @@ func (msg *CreateMsg) Validate() error {
 	if !isTokenName(msg.Name) {
 		errs = errors.AppendField(errs, "Name", errors.ErrState)
 	}
+	if len(msg.Issuer) != 0 {
+		errs = errors.AppendField(errs, "Issuer", msg.Issuer.Validate())
+	}
+	errs = errors.AppendField(errs, "Decimals", validateDecimals(msg.Decimals))
 	return errs
 }
+
+func (UpdateTokenInfoMsg) Path() string {
+	return "currency/update_token_info"
+}
+
+func (msg *UpdateTokenInfoMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if !coin.IsCC(msg.Ticker) {
+		errs = errors.AppendField(errs, "Ticker", errors.ErrCurrency)
+	}
+	if !isTokenName(msg.Name) {
+		errs = errors.AppendField(errs, "Name", errors.ErrState)
+	}
+	errs = errors.AppendField(errs, "Decimals", validateDecimals(msg.Decimals))
+	return errs
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (msg *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.Patch == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(msg.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", msg.Patch.Owner.Validate())
+	}
+	if len(msg.Patch.Governance) != 0 {
+		errs = errors.AppendField(errs, "Patch.Governance", msg.Patch.Governance.Validate())
+	}
+	return errs
+}
+
+func (UpdateConfigurationMsg) Path() string {
+	return "currency/update_configuration"
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
 )
 
 func TestValidateCreateMsg(t *testing.T) {
@@ -27,6 +28,24 @@ func TestValidateCreateMsg(t *testing.T) {
 			},
 			WantErr: errors.ErrMetadata,
 		},
+		"too many decimals": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Name:     "mytoken",
+				Decimals: 10,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"invalid issuer": {
+			Msg: &CreateMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Name:     "mytoken",
+				Issuer:   weave.Address{1, 2, 3},
+			},
+			WantErr: errors.ErrInput,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -38,3 +57,94 @@ func TestValidateCreateMsg(t *testing.T) {
 	}
 
 }
+
+func TestValidateUpdateTokenInfoMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Name:     "mytoken",
+				Decimals: 9,
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &UpdateTokenInfoMsg{
+				Ticker: "IOV",
+				Name:   "mytoken",
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"invalid ticker": {
+			Msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "not a ticker",
+				Name:     "mytoken",
+			},
+			WantErr: errors.ErrCurrency,
+		},
+		"too many decimals": {
+			Msg: &UpdateTokenInfoMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Ticker:   "IOV",
+				Name:     "mytoken",
+				Decimals: 10,
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateConfigurationMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Metadata:   &weave.Metadata{Schema: 1},
+					Governance: weavetest.NewCondition().Address(),
+				},
+			},
+			WantErr: nil,
+		},
+		"missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"invalid governance": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Metadata:   &weave.Metadata{Schema: 1},
+					Governance: weave.Address{1, 2, 3},
+				},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -38,6 +39,17 @@ type Revenue struct {
 	Destinations []*Destination `protobuf:"bytes,3,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Pull declares the accounting mode of this revenue. Set during creation and
+	// does not change.
+	// By default collected funds are transferred to the destinations during the
+	// distribution. In pull mode distribution only accrues the share of each
+	// destination and funds are kept on the revenue account until the
+	// destination claims them.
+	Pull bool `protobuf:"varint,5,opt,name=pull,proto3" json:"pull,omitempty"`
+	// Accrued is the total amount of funds that were accrued to the
+	// destinations and not claimed yet. Only a revenue in pull mode can accrue
+	// funds.
+	Accrued []*coin.Coin `protobuf:"bytes,6,rep,name=accrued,proto3" json:"accrued,omitempty"`
 }
 
 func (m *Revenue) Reset()         { *m = Revenue{} }
@@ -101,6 +113,94 @@ func (m *Revenue) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Revenue) GetPull() bool {
+	if m != nil {
+		return m.Pull
+	}
+	return false
+}
+
+func (m *Revenue) GetAccrued() []*coin.Coin {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+// Accrual represents funds accrued by a single destination of a revenue in
+// pull mode. Accrued funds are kept on the revenue account until claimed.
+type Accrual struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Revenue ID references an ID of a revenue instance that the funds were
+	// accrued by.
+	RevenueID []byte `protobuf:"bytes,2,opt,name=revenue_id,json=revenueId,proto3" json:"revenue_id,omitempty"`
+	// Destination is the address that the funds were accrued for.
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+	// Amount is the total value of the funds that can be claimed.
+	Amount []*coin.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *Accrual) Reset()         { *m = Accrual{} }
+func (m *Accrual) String() string { return proto.CompactTextString(m) }
+func (*Accrual) ProtoMessage()    {}
+func (*Accrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_186299c22854933b, []int{1}
+}
+func (m *Accrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Accrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Accrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Accrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Accrual.Merge(m, src)
+}
+func (m *Accrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *Accrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_Accrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Accrual proto.InternalMessageInfo
+
+func (m *Accrual) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Accrual) GetRevenueID() []byte {
+	if m != nil {
+		return m.RevenueID
+	}
+	return nil
+}
+
+func (m *Accrual) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *Accrual) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 type Destination struct {
 	// An address that the funds should be transferred to.
 	// This should not be the validator addresses, as the keys used to sign
@@ -119,7 +219,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_186299c22854933b, []int{1}
+	return fileDescriptor_186299c22854933b, []int{2}
 }
 func (m *Destination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,13 +272,17 @@ type CreateMsg struct {
 	// Destinations holds any number of addresses that the collected revenue is
 	// distributed to. Must be at least one.
 	Destinations []*Destination `protobuf:"bytes,3,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Pull declares that the revenue is created in pull mode. Distribution of
+	// a revenue in pull mode only accrues funds that each destination can
+	// claim using ClaimMsg.
+	Pull bool `protobuf:"varint,4,opt,name=pull,proto3" json:"pull,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_186299c22854933b, []int{2}
+	return fileDescriptor_186299c22854933b, []int{3}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,9 +332,18 @@ func (m *CreateMsg) GetDestinations() []*Destination {
 	return nil
 }
 
+func (m *CreateMsg) GetPull() bool {
+	if m != nil {
+		return m.Pull
+	}
+	return false
+}
+
 // DistributeMsg is a request to distribute all funds collected within a single
 // revenue instance. Revenue is distributed between destinations. Request must be
 // signed using admin key.
+// Distribution of a revenue in pull mode does not transfer any funds. Instead
+// the share of each destination is accrued and can be claimed using ClaimMsg.
 type DistributeMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Revenue ID reference an ID of a revenue instance that the collected fees
@@ -242,7 +355,7 @@ func (m *DistributeMsg) Reset()         { *m = DistributeMsg{} }
 func (m *DistributeMsg) String() string { return proto.CompactTextString(m) }
 func (*DistributeMsg) ProtoMessage()    {}
 func (*DistributeMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_186299c22854933b, []int{3}
+	return fileDescriptor_186299c22854933b, []int{4}
 }
 func (m *DistributeMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +403,9 @@ func (m *DistributeMsg) GetRevenueID() []byte {
 // forcing funds distribution. Before applying any change all funds stored by
 // the revenue account are distributed using old configuration. Only when the
 // collected revenue amount is equal to zero the change is applied.
+// For a revenue in pull mode, before applying any change all funds are accrued
+// using old configuration. Funds accrued by a destination that is removed can
+// still be claimed.
 type ResetMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Revenue ID reference an ID of a revenue instance that is updated.
@@ -303,7 +419,7 @@ func (m *ResetMsg) Reset()         { *m = ResetMsg{} }
 func (m *ResetMsg) String() string { return proto.CompactTextString(m) }
 func (*ResetMsg) ProtoMessage()    {}
 func (*ResetMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_186299c22854933b, []int{4}
+	return fileDescriptor_186299c22854933b, []int{5}
 }
 func (m *ResetMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,40 +469,114 @@ func (m *ResetMsg) GetDestinations() []*Destination {
 	return nil
 }
 
+// ClaimMsg is a request to transfer all funds accrued by a destination of a
+// revenue in pull mode. Request must be signed by the destination.
+type ClaimMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Revenue ID reference an ID of a revenue instance that the funds were
+	// accrued by.
+	RevenueID []byte `protobuf:"bytes,2,opt,name=revenue_id,json=revenueId,proto3" json:"revenue_id,omitempty"`
+	// Destination is the address that claims accrued funds. Funds are
+	// transferred to this address.
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+}
+
+func (m *ClaimMsg) Reset()         { *m = ClaimMsg{} }
+func (m *ClaimMsg) String() string { return proto.CompactTextString(m) }
+func (*ClaimMsg) ProtoMessage()    {}
+func (*ClaimMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_186299c22854933b, []int{6}
+}
+func (m *ClaimMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimMsg.Merge(m, src)
+}
+func (m *ClaimMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimMsg proto.InternalMessageInfo
+
+func (m *ClaimMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ClaimMsg) GetRevenueID() []byte {
+	if m != nil {
+		return m.RevenueID
+	}
+	return nil
+}
+
+func (m *ClaimMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Revenue)(nil), "distribution.Revenue")
+	proto.RegisterType((*Accrual)(nil), "distribution.Accrual")
 	proto.RegisterType((*Destination)(nil), "distribution.Destination")
 	proto.RegisterType((*CreateMsg)(nil), "distribution.CreateMsg")
 	proto.RegisterType((*DistributeMsg)(nil), "distribution.DistributeMsg")
 	proto.RegisterType((*ResetMsg)(nil), "distribution.ResetMsg")
+	proto.RegisterType((*ClaimMsg)(nil), "distribution.ClaimMsg")
 }
 
 func init() { proto.RegisterFile("x/distribution/codec.proto", fileDescriptor_186299c22854933b) }
 
 var fileDescriptor_186299c22854933b = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0xcd, 0x4a, 0xc3, 0x40,
-	0x10, 0xee, 0x5a, 0xfb, 0x37, 0x69, 0x11, 0x82, 0x48, 0xec, 0x21, 0x0d, 0xc1, 0x43, 0x41, 0x4d,
-	0xa0, 0xde, 0x04, 0x05, 0x6b, 0x2f, 0x3d, 0xf4, 0xb2, 0x2f, 0x20, 0xdb, 0xee, 0x90, 0xae, 0xd0,
-	0xac, 0x64, 0xb7, 0xad, 0x8f, 0xe1, 0x43, 0xf8, 0x06, 0xbe, 0x84, 0xc7, 0x1e, 0x3d, 0x15, 0x49,
-	0x9f, 0xc0, 0xab, 0x27, 0x69, 0x92, 0x6a, 0x3c, 0x56, 0xf1, 0xe0, 0x6d, 0x76, 0xe6, 0xfb, 0xbe,
-	0xf9, 0xf8, 0x98, 0x85, 0xe6, 0xbd, 0xcf, 0x85, 0xd2, 0x91, 0x18, 0x4e, 0xb5, 0x90, 0xa1, 0x3f,
-	0x92, 0x1c, 0x47, 0xde, 0x5d, 0x24, 0xb5, 0x34, 0xeb, 0xf9, 0x49, 0xd3, 0xc8, 0x8d, 0x9a, 0xfb,
-	0x81, 0x0c, 0x64, 0x52, 0xfa, 0xeb, 0x2a, 0xed, 0xba, 0x6f, 0x04, 0x2a, 0x14, 0x67, 0x18, 0x4e,
-	0xd1, 0x3c, 0x86, 0xea, 0x04, 0x35, 0xe3, 0x4c, 0x33, 0x8b, 0x38, 0xa4, 0x6d, 0x74, 0xf6, 0xbc,
-	0x39, 0xb2, 0x19, 0x7a, 0x83, 0xac, 0x4d, 0x3f, 0x01, 0xe6, 0x39, 0x94, 0x18, 0x9f, 0x88, 0xd0,
-	0xda, 0x71, 0x48, 0xbb, 0xde, 0x3d, 0x7a, 0x5f, 0xb6, 0x9c, 0x40, 0xe8, 0xf1, 0x74, 0xe8, 0x8d,
-	0xe4, 0xc4, 0x17, 0x72, 0x76, 0x2a, 0x43, 0xf4, 0x53, 0xfe, 0x15, 0xe7, 0x11, 0x2a, 0x45, 0x53,
-	0x8a, 0x79, 0x01, 0x75, 0x8e, 0x4a, 0x8b, 0x90, 0xad, 0x6d, 0x2a, 0xab, 0xe8, 0x14, 0xdb, 0x46,
-	0xe7, 0xd0, 0xcb, 0x9b, 0xf7, 0x7a, 0x5f, 0x08, 0xfa, 0x0d, 0x6e, 0x5e, 0x42, 0x85, 0xa5, 0x82,
-	0xd6, 0xee, 0x16, 0xcb, 0x37, 0x24, 0x17, 0xc1, 0xc8, 0x89, 0xe7, 0xe5, 0xc8, 0x0f, 0xe4, 0xcc,
-	0x03, 0x28, 0xcf, 0x51, 0x04, 0x63, 0x9d, 0x44, 0x51, 0xa2, 0xd9, 0xcb, 0x7d, 0x22, 0x50, 0xbb,
-	0x8e, 0x90, 0x69, 0x1c, 0xa8, 0xe0, 0xbf, 0x84, 0xeb, 0xde, 0x42, 0xa3, 0xb7, 0x41, 0x6e, 0x6f,
-	0xfc, 0x04, 0x20, 0x4a, 0xaf, 0xe9, 0x46, 0xf0, 0xcc, 0x7d, 0x23, 0x5e, 0xb6, 0x6a, 0xd9, 0x8d,
-	0xf5, 0x7b, 0xb4, 0x96, 0x01, 0xfa, 0xdc, 0x7d, 0x24, 0x50, 0xa5, 0xa8, 0x50, 0xff, 0xed, 0x9e,
-	0x5f, 0x46, 0xd2, 0xb5, 0x9e, 0x63, 0x9b, 0x2c, 0x62, 0x9b, 0xbc, 0xc6, 0x36, 0x79, 0x58, 0xd9,
-	0x85, 0xc5, 0xca, 0x2e, 0xbc, 0xac, 0xec, 0xc2, 0xb0, 0x9c, 0x7c, 0xa2, 0xb3, 0x8f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xad, 0xdc, 0xe2, 0x23, 0x93, 0x03, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0xcd, 0xff, 0x71, 0x2a, 0xd0, 0x0a, 0xa1, 0x25, 0x07, 0xd7, 0xb2, 0x7a, 0x88,
+	0x04, 0xac, 0xa5, 0x72, 0x43, 0x02, 0xa9, 0x4d, 0x84, 0xd4, 0x43, 0x2f, 0xfb, 0x02, 0x68, 0xe3,
+	0x1d, 0xb9, 0x8b, 0x62, 0x6f, 0x65, 0xaf, 0x53, 0x1e, 0x83, 0x87, 0xe0, 0x86, 0x78, 0x0e, 0x38,
+	0xe6, 0xc8, 0xa9, 0x42, 0xc9, 0x5b, 0x70, 0x42, 0xf1, 0x1f, 0xea, 0x8a, 0x93, 0x81, 0x1e, 0xb8,
+	0x8d, 0x77, 0xbe, 0xcf, 0x3b, 0xdf, 0x4f, 0x63, 0xc3, 0xf4, 0x7d, 0xa0, 0x74, 0x66, 0x53, 0xbd,
+	0xcc, 0xad, 0x36, 0x49, 0x10, 0x1a, 0x85, 0x21, 0xbf, 0x4a, 0x8d, 0x35, 0x74, 0xd2, 0xec, 0x4c,
+	0x9d, 0x46, 0x6b, 0xfa, 0x30, 0x34, 0xfa, 0x8e, 0x78, 0xfa, 0x28, 0x32, 0x91, 0x29, 0xca, 0x60,
+	0x5f, 0x95, 0xa7, 0xfe, 0xa7, 0x03, 0x18, 0x0a, 0x5c, 0x63, 0x92, 0x23, 0x7d, 0x0a, 0xa3, 0x18,
+	0xad, 0x54, 0xd2, 0x4a, 0x46, 0x3c, 0x32, 0x73, 0x4e, 0x1e, 0xf0, 0x6b, 0x94, 0x6b, 0xe4, 0x17,
+	0xd5, 0xb1, 0xf8, 0x25, 0xa0, 0x2f, 0xa1, 0x2f, 0x55, 0xac, 0x13, 0x76, 0xe0, 0x91, 0xd9, 0xe4,
+	0xec, 0xf8, 0xc7, 0xcd, 0x91, 0x17, 0x69, 0x7b, 0x99, 0x2f, 0x79, 0x68, 0xe2, 0x40, 0x9b, 0xf5,
+	0x73, 0x93, 0x60, 0x50, 0xfa, 0x4f, 0x95, 0x4a, 0x31, 0xcb, 0x44, 0x69, 0xa1, 0xaf, 0x60, 0xa2,
+	0x30, 0xb3, 0x3a, 0x91, 0xfb, 0xc1, 0x33, 0xd6, 0xf5, 0xba, 0x33, 0xe7, 0xe4, 0x09, 0x6f, 0xc6,
+	0xe1, 0x8b, 0x5b, 0x85, 0xb8, 0x23, 0xa7, 0xaf, 0x61, 0x28, 0xcb, 0x17, 0xb2, 0x5e, 0x8b, 0xcb,
+	0x6b, 0x13, 0xa5, 0xd0, 0xbb, 0xca, 0x57, 0x2b, 0xd6, 0xf7, 0xc8, 0x6c, 0x24, 0x8a, 0x9a, 0x1e,
+	0xc3, 0x50, 0x86, 0x61, 0x9a, 0xa3, 0x62, 0x83, 0x62, 0x1a, 0xe0, 0x7b, 0x82, 0x7c, 0x6e, 0x74,
+	0x22, 0xea, 0x96, 0xbf, 0x21, 0x30, 0x3c, 0xdd, 0xd7, 0x72, 0xd5, 0x8e, 0xd6, 0x33, 0x80, 0xb4,
+	0xa4, 0xfc, 0x56, 0xab, 0x0a, 0xd9, 0xe1, 0xf6, 0xe6, 0x68, 0x5c, 0xb1, 0x3f, 0x5f, 0x88, 0x71,
+	0x25, 0x38, 0x57, 0xf4, 0x0d, 0x38, 0x8d, 0xc0, 0xac, 0xdb, 0x22, 0x64, 0xd3, 0x48, 0x7d, 0x18,
+	0xc8, 0xd8, 0xe4, 0x89, 0x65, 0xbd, 0xdf, 0x32, 0x55, 0x1d, 0x1f, 0xc1, 0x69, 0x90, 0x6e, 0xb2,
+	0x25, 0x7f, 0xc2, 0xf6, 0x31, 0x0c, 0xae, 0x51, 0x47, 0x97, 0xb6, 0x08, 0xd9, 0x17, 0xd5, 0x93,
+	0xff, 0x85, 0xc0, 0x78, 0x9e, 0xa2, 0xb4, 0x78, 0x91, 0x45, 0xff, 0xcd, 0xa6, 0xd5, 0x9b, 0xd2,
+	0xbb, 0xdd, 0x14, 0xff, 0x1d, 0x1c, 0x2e, 0x6a, 0x77, 0xfb, 0x30, 0xad, 0x16, 0xc1, 0xff, 0x48,
+	0x60, 0x24, 0x30, 0x43, 0x7b, 0xbf, 0xf7, 0xfc, 0x25, 0x26, 0xff, 0x33, 0x81, 0xd1, 0x7c, 0x25,
+	0x75, 0x7c, 0xcf, 0x63, 0xfe, 0xa3, 0xef, 0xe2, 0x8c, 0x7d, 0xdd, 0xba, 0x64, 0xb3, 0x75, 0xc9,
+	0xf7, 0xad, 0x4b, 0x3e, 0xec, 0xdc, 0xce, 0x66, 0xe7, 0x76, 0xbe, 0xed, 0xdc, 0xce, 0x72, 0x50,
+	0xfc, 0x15, 0x5f, 0xfc, 0x1c, 0x00, 0x34, 0xf8, 0x27, 0x27, 0x76, 0x05, 0x00, 0x00,
 }
 
 func (m *Revenue) Marshal() (dAtA []byte, err error) {
@@ -438,6 +628,80 @@ func (m *Revenue) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.Pull {
+		dAtA[i] = 0x28
+		i++
+		if m.Pull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Accrued) > 0 {
+		for _, msg := range m.Accrued {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Accrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Accrual) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.RevenueID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RevenueID)))
+		i += copy(dAtA[i:], m.RevenueID)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -489,11 +753,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if len(m.Admin) > 0 {
 		dAtA[i] = 0x12
@@ -513,6 +777,16 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Pull {
+		dAtA[i] = 0x20
+		i++
+		if m.Pull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -535,11 +809,11 @@ func (m *DistributeMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if len(m.RevenueID) > 0 {
 		dAtA[i] = 0x12
@@ -569,11 +843,11 @@ func (m *ResetMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if len(m.RevenueID) > 0 {
 		dAtA[i] = 0x12
@@ -596,6 +870,46 @@ func (m *ResetMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ClaimMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.RevenueID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RevenueID)))
+		i += copy(dAtA[i:], m.RevenueID)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -629,6 +943,42 @@ func (m *Revenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.Pull {
+		n += 2
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Accrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RevenueID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -668,6 +1018,9 @@ func (m *CreateMsg) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if m.Pull {
+		n += 2
+	}
 	return n
 }
 
@@ -711,6 +1064,27 @@ func (m *ResetMsg) Size() (n int) {
 	return n
 }
 
+func (m *ClaimMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RevenueID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -891,6 +1265,251 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pull = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, &coin.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Accrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Accrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Accrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevenueID == nil {
+				m.RevenueID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1154,6 +1773,26 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pull = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1458,6 +2097,163 @@ func (m *ResetMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClaimMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevenueID == nil {
+				m.RevenueID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
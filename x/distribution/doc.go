@@ -12,6 +12,12 @@ collected funds.
 Only an admin can alter a revenue configuration. It is a good idea to use a
 multisig contract as an admin address value.
 
+A revenue can be created in pull mode. Distribution of such revenue does not
+transfer any coins. Instead the share of each destination is accrued and kept
+on the revenue account, until the destination claims it. This allows to avoid
+paying fees for frequent small transfers that recipients do not need.
+Configuration change accrues all collected coins using the old configuration.
+
 This functionality can be used to pay validators for their work. It is a
 transparent and trustful way to split income.
 
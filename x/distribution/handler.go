@@ -13,11 +13,13 @@ const (
 	newRevenueCost                 = 0
 	distributePerDestinationCost   = 0
 	resetRevenuePerDestinationCost = 0
+	claimCost                      = 0
 )
 
 // RegisterQuery registers feedlist buckets for querying.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewRevenueBucket().Register("revenues", qr)
+	NewAccrualBucket().Register("accruals", qr)
 }
 
 // CashController allows to manage coins stored by the accounts without the
@@ -25,27 +27,36 @@ func RegisterQuery(qr weave.QueryRouter) {
 // Required functionality is implemented by the x/cash extension.
 type CashController interface {
 	Balance(weave.KVStore, weave.Address) (coin.Coins, error)
-	MoveCoins(weave.KVStore, weave.Address, weave.Address, coin.Coin) error
+	MoveCoins(weave.Context, weave.KVStore, weave.Address, weave.Address, coin.Coin) error
 }
 
 // RegisterRoutes registers handlers for feedlist message processing.
 func RegisterRoutes(r weave.Registry, auth x.Authenticator, ctrl CashController) {
 	r = migration.SchemaMigratingRegistry("distribution", r)
 	bucket := NewRevenueBucket()
+	accruals := NewAccrualBucket()
 	r.Handle(&CreateMsg{}, &createRevenueHandler{
 		auth:   auth,
 		bucket: bucket,
 		ctrl:   ctrl,
 	})
 	r.Handle(&DistributeMsg{}, &distributeHandler{
-		auth:   auth,
-		bucket: bucket,
-		ctrl:   ctrl,
+		auth:     auth,
+		bucket:   bucket,
+		accruals: accruals,
+		ctrl:     ctrl,
 	})
 	r.Handle(&ResetMsg{}, &resetRevenueHandler{
-		auth:   auth,
-		bucket: bucket,
-		ctrl:   ctrl,
+		auth:     auth,
+		bucket:   bucket,
+		accruals: accruals,
+		ctrl:     ctrl,
+	})
+	r.Handle(&ClaimMsg{}, &claimHandler{
+		auth:     auth,
+		bucket:   bucket,
+		accruals: accruals,
+		ctrl:     ctrl,
 	})
 }
 
@@ -77,6 +88,7 @@ func (h *createRevenueHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		Admin:        msg.Admin,
 		Destinations: msg.Destinations,
 		Address:      RevenueAccount(key),
+		Pull:         msg.Pull,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot store revenue")
@@ -93,9 +105,10 @@ func (h *createRevenueHandler) validate(ctx weave.Context, db weave.KVStore, tx
 }
 
 type distributeHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	ctrl   CashController
+	auth     x.Authenticator
+	bucket   orm.ModelBucket
+	accruals orm.ModelBucket
+	ctrl     CashController
 }
 
 func (h *distributeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
@@ -124,8 +137,18 @@ func (h *distributeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	if err := h.bucket.One(db, msg.RevenueID, &rev); err != nil {
 		return nil, errors.Wrap(err, "cannot load revenue from the store")
 	}
-	if err := distribute(db, h.ctrl, rev.Address, rev.Destinations); err != nil {
-		return nil, errors.Wrap(err, "cannot distribute")
+	if !rev.Pull {
+		if err := distribute(ctx, db, h.ctrl, rev.Address, rev.Destinations); err != nil {
+			return nil, errors.Wrap(err, "cannot distribute")
+		}
+		return &weave.DeliverResult{}, nil
+	}
+
+	if err := accrue(db, h.ctrl, h.accruals, msg.RevenueID, &rev); err != nil {
+		return nil, errors.Wrap(err, "cannot accrue")
+	}
+	if _, err := h.bucket.Put(db, msg.RevenueID, &rev); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
 	}
 	return &weave.DeliverResult{}, nil
 }
@@ -142,9 +165,10 @@ func (h *distributeHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 }
 
 type resetRevenueHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	ctrl   CashController
+	auth     x.Authenticator
+	bucket   orm.ModelBucket
+	accruals orm.ModelBucket
+	ctrl     CashController
 }
 
 func (h *resetRevenueHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
@@ -179,8 +203,16 @@ func (h *resetRevenueHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 	// revenue with no funds can be updated, so that destinations trust us.
 	// Otherwise an admin could change who receives the money without the
 	// previously selected destinations ever being paid.
-	if err := distribute(db, h.ctrl, rev.Address, rev.Destinations); err != nil {
-		return nil, errors.Wrap(err, "cannot distribute")
+	// A revenue in pull mode must accrue all funds instead, so that the
+	// weights change applies only to the funds collected afterwards.
+	if rev.Pull {
+		if err := accrue(db, h.ctrl, h.accruals, msg.RevenueID, &rev); err != nil {
+			return nil, errors.Wrap(err, "cannot accrue")
+		}
+	} else {
+		if err := distribute(ctx, db, h.ctrl, rev.Address, rev.Destinations); err != nil {
+			return nil, errors.Wrap(err, "cannot distribute")
+		}
 	}
 	rev.Destinations = msg.Destinations
 	if _, err := h.bucket.Put(db, msg.RevenueID, &rev); err != nil {
@@ -197,13 +229,159 @@ func (h *resetRevenueHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 	return &msg, nil
 }
 
+type claimHandler struct {
+	auth     x.Authenticator
+	bucket   orm.ModelBucket
+	accruals orm.ModelBucket
+	ctrl     CashController
+}
+
+func (h *claimHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: claimCost}, nil
+}
+
+func (h *claimHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, rev, accrual, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range accrual.Amount {
+		if err := h.ctrl.MoveCoins(ctx, db, rev.Address, msg.Destination, *c); err != nil {
+			return nil, errors.Wrap(err, "cannot move coins")
+		}
+		accrued, err := coin.Coins(rev.Accrued).Subtract(*c)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot update accrued total")
+		}
+		rev.Accrued = accrued
+	}
+	if err := h.accruals.Delete(db, accrualKey(msg.RevenueID, msg.Destination)); err != nil {
+		return nil, errors.Wrap(err, "cannot delete accrual")
+	}
+	if _, err := h.bucket.Put(db, msg.RevenueID, rev); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *claimHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ClaimMsg, *Revenue, *Accrual, error) {
+	var msg ClaimMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Destination) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "destination signature required")
+	}
+	var rev Revenue
+	if err := h.bucket.One(db, msg.RevenueID, &rev); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load revenue from the store")
+	}
+	if !rev.Pull {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "revenue is not in pull mode")
+	}
+	var accrual Accrual
+	if err := h.accruals.One(db, accrualKey(msg.RevenueID, msg.Destination), &accrual); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "no accrued funds")
+	}
+	return &msg, &rev, &accrual, nil
+}
+
 // distribute split the funds stored under the revenue address and distribute
 // them according to destinations proportions. When successful, revenue account
 // has no funds left after this call.
 //
 // It might be that not all funds can be distributed equally. Because of that a
 // small leftover can remain on the revenue account after this operation.
-func distribute(db weave.KVStore, ctrl CashController, source weave.Address, destinations []*Destination) error {
+func distribute(ctx weave.Context, db weave.KVStore, ctrl CashController, source weave.Address, destinations []*Destination) error {
+	balance, err := revenueBalance(db, ctrl, source)
+	if err != nil {
+		return err
+	}
+	return split(balance, destinations, func(dst weave.Address, amount coin.Coin) error {
+		if err := ctrl.MoveCoins(ctx, db, source, dst, amount); err != nil {
+			return errors.Wrap(err, "cannot move coins")
+		}
+		return nil
+	})
+}
+
+// accrue splits the funds stored under the address of a revenue in pull mode,
+// that were not accrued yet, according to destinations proportions. Share of
+// each destination is added to its accrual and to the accrued total of the
+// revenue. Funds are not transferred and remain on the revenue account. It is
+// the caller responsibility to save the updated revenue.
+//
+// Same as with distribute, a small leftover that cannot be split remains on the
+// revenue account. It is not accrued and can be split during the next call.
+func accrue(db weave.KVStore, ctrl CashController, accruals orm.ModelBucket, revenueID []byte, rev *Revenue) error {
+	balance, err := revenueBalance(db, ctrl, rev.Address)
+	if err != nil {
+		return err
+	}
+	available := balance.Clone()
+	for _, c := range rev.Accrued {
+		available, err = available.Subtract(*c)
+		if err != nil {
+			return errors.Wrap(err, "cannot compute not accrued funds")
+		}
+	}
+	return split(available, rev.Destinations, func(dst weave.Address, amount coin.Coin) error {
+		key := accrualKey(revenueID, dst)
+		var accrual Accrual
+		switch err := accruals.One(db, key, &accrual); {
+		case err == nil:
+		case errors.ErrNotFound.Is(err):
+			accrual = Accrual{
+				Metadata:    &weave.Metadata{},
+				RevenueID:   revenueID,
+				Destination: dst,
+			}
+		default:
+			return errors.Wrap(err, "cannot load accrual")
+		}
+		amt, err := coin.Coins(accrual.Amount).Add(amount)
+		if err != nil {
+			return errors.Wrap(err, "cannot accrue funds")
+		}
+		accrual.Amount = amt
+		if _, err := accruals.Put(db, key, &accrual); err != nil {
+			return errors.Wrap(err, "cannot save accrual")
+		}
+		accrued, err := coin.Coins(rev.Accrued).Add(amount)
+		if err != nil {
+			return errors.Wrap(err, "cannot update accrued total")
+		}
+		rev.Accrued = accrued
+		return nil
+	})
+}
+
+// revenueBalance returns normalized funds stored under the revenue address.
+func revenueBalance(db weave.KVStore, ctrl CashController, source weave.Address) (coin.Coins, error) {
+	balance, err := ctrl.Balance(db, source)
+	switch {
+	case err == nil:
+		balance, err = coin.NormalizeCoins(balance)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot normalize balance")
+		}
+		return balance, nil
+	case errors.ErrNotFound.Is(err):
+		// Account does not exist, so there is are no funds to split.
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "cannot acquire revenue account balance")
+	}
+}
+
+// split divides the funds according to destinations proportions and calls fn
+// with the share of each destination. Share that is too small to be split is
+// skipped.
+func split(funds coin.Coins, destinations []*Destination, fn func(weave.Address, coin.Coin) error) error {
 	var chunks int64
 	for _, r := range destinations {
 		chunks += int64(r.Weight)
@@ -221,24 +399,10 @@ func distribute(db weave.KVStore, ctrl CashController, source weave.Address, des
 
 	chunks = chunks / int64(div)
 
-	balance, err := ctrl.Balance(db, source)
-	switch {
-	case err == nil:
-		balance, err = coin.NormalizeCoins(balance)
-		if err != nil {
-			return errors.Wrap(err, "cannot normalize balance")
-		}
-	case errors.ErrNotFound.Is(err):
-		// Account does not exist, so there is are no funds to split.
-		return nil
-	default:
-		return errors.Wrap(err, "cannot acquire revenue account balance")
-	}
-
 	// For each currency, distribute the coins equally to the weight of
 	// each destination. This can leave small amount of coins on the original
 	// account.
-	for _, c := range balance {
+	for _, c := range funds {
 		// Ignore those coins that have a negative value. This
 		// functionality is supposed to be distributing value from
 		// revenue account, not collect it. Otherwise this could be
@@ -264,8 +428,8 @@ func distribute(db weave.KVStore, ctrl CashController, source weave.Address, des
 			if amount.IsZero() {
 				continue
 			}
-			if err := ctrl.MoveCoins(db, source, r.Address, amount); err != nil {
-				return errors.Wrap(err, "cannot move coins")
+			if err := fn(r.Address, amount); err != nil {
+				return err
 			}
 		}
 	}
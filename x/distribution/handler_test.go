@@ -12,6 +12,7 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/cash"
 )
 
@@ -387,6 +388,229 @@ func TestHandlers(t *testing.T) {
 	}
 }
 
+func TestPullMode(t *testing.T) {
+	admin := weavetest.NewCondition()
+	dest1 := weavetest.NewCondition()
+	dest2 := weavetest.NewCondition()
+	dest3 := weavetest.NewCondition()
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	ctrl := cash.NewController(cash.NewBucket())
+	RegisterRoutes(rt, auth, ctrl)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash", "distribution")
+
+	revID := weavetest.SequenceID(1)
+	revAddr := RevenueAccount(revID)
+	revenues := NewRevenueBucket()
+	accruals := NewAccrualBucket()
+	participants := []weave.Address{revAddr, dest1.Address(), dest2.Address(), dest3.Address()}
+
+	var minted coin.Coins
+	mint := func(c coin.Coin) {
+		t.Helper()
+		assert.Nil(t, ctrl.CoinMint(db, revAddr, c))
+		var err error
+		minted, err = minted.Combine(coin.Coins{&c})
+		assert.Nil(t, err)
+	}
+
+	deliver := func(msg weave.Msg, signer weave.Condition) error {
+		t.Helper()
+		a := action{conditions: []weave.Condition{signer}, msg: msg, blocksize: 100}
+		if _, err := rt.Check(a.ctx(), db.CacheWrap(), a.tx()); err != nil {
+			return err
+		}
+		_, err := rt.Deliver(a.ctx(), db, a.tx())
+		return err
+	}
+
+	balance := func(addr weave.Address) coin.Coins {
+		t.Helper()
+		coins, err := ctrl.Balance(db, addr)
+		if errors.ErrNotFound.Is(err) {
+			return nil
+		}
+		assert.Nil(t, err)
+		return coins
+	}
+
+	// checkInvariants ensures that the accrued total of the revenue is
+	// equal to the sum of all accruals, that the revenue account holds at
+	// least the accrued total and that no funds were created or lost.
+	checkInvariants := func() {
+		t.Helper()
+
+		var rev Revenue
+		assert.Nil(t, revenues.One(db, revID, &rev))
+
+		var all []Accrual
+		_, err := accruals.ByIndex(db, "destination", dest1.Address(), &all)
+		assert.Nil(t, err)
+		for _, dst := range []weave.Condition{dest2, dest3} {
+			var found []Accrual
+			_, err := accruals.ByIndex(db, "destination", dst.Address(), &found)
+			assert.Nil(t, err)
+			all = append(all, found...)
+		}
+		var total coin.Coins
+		for _, a := range all {
+			total, err = total.Combine(a.Amount)
+			assert.Nil(t, err)
+		}
+		if !total.Equals(rev.Accrued) {
+			t.Fatalf("accrued total %v is not equal to the sum of accruals %v", rev.Accrued, total)
+		}
+
+		// Dust that cannot be split stays on the revenue account and
+		// cannot be negative.
+		dust := balance(revAddr)
+		for _, c := range rev.Accrued {
+			dust, err = dust.Subtract(*c)
+			assert.Nil(t, err)
+		}
+		if !dust.IsNonNegative() {
+			t.Fatalf("revenue account holds less than accrued: %v", dust)
+		}
+
+		var supply coin.Coins
+		for _, addr := range participants {
+			supply, err = supply.Combine(balance(addr))
+			assert.Nil(t, err)
+		}
+		if !supply.Equals(minted) {
+			t.Fatalf("want total supply %v, got %v", minted, supply)
+		}
+	}
+
+	err := deliver(&CreateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Admin:    admin.Address(),
+		Destinations: []*Destination{
+			{Weight: 1, Address: dest1.Address()},
+			{Weight: 2, Address: dest2.Address()},
+		},
+		Pull: true,
+	}, admin)
+	assert.Nil(t, err)
+	checkInvariants()
+
+	// Nothing was accrued yet.
+	err = deliver(&ClaimMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID, Destination: dest1.Address()}, dest1)
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	mint(coin.NewCoin(10, 0, "BTC"))
+	err = deliver(&DistributeMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID}, admin)
+	assert.Nil(t, err)
+	checkInvariants()
+	// Funds are accrued, not transferred.
+	assert.Equal(t, coin.Coins{coin.NewCoinp(10, 0, "BTC")}, balance(revAddr))
+	assert.Equal(t, coin.Coins(nil), balance(dest1.Address()))
+
+	// Accruing again must not account the same funds twice.
+	err = deliver(&DistributeMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID}, admin)
+	assert.Nil(t, err)
+	checkInvariants()
+
+	// Only the destination can claim its funds.
+	err = deliver(&ClaimMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID, Destination: dest1.Address()}, dest2)
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	err = deliver(&ClaimMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID, Destination: dest1.Address()}, dest1)
+	assert.Nil(t, err)
+	checkInvariants()
+	assert.Equal(t, coin.Coins{coin.NewCoinp(3, 333333333, "BTC")}, balance(dest1.Address()))
+
+	// Weights change settles all funds collected so far using the old
+	// weights. Removed destination can still claim its accrued funds.
+	mint(coin.NewCoin(0, 300, "BTC"))
+	err = deliver(&ResetMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		RevenueID: revID,
+		Destinations: []*Destination{
+			{Weight: 1, Address: dest2.Address()},
+			{Weight: 3, Address: dest3.Address()},
+		},
+	}, admin)
+	assert.Nil(t, err)
+	checkInvariants()
+
+	mint(coin.NewCoin(4, 0, "BTC"))
+	mint(coin.NewCoin(0, 7, "ETH"))
+	err = deliver(&DistributeMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID}, admin)
+	assert.Nil(t, err)
+	checkInvariants()
+
+	for _, dst := range []weave.Condition{dest1, dest2, dest3} {
+		err = deliver(&ClaimMsg{Metadata: &weave.Metadata{Schema: 1}, RevenueID: revID, Destination: dst.Address()}, dst)
+		assert.Nil(t, err)
+		checkInvariants()
+	}
+
+	assert.Equal(t, coin.Coins{coin.NewCoinp(3, 333333433, "BTC")}, balance(dest1.Address()))
+	assert.Equal(t, coin.Coins{
+		coin.NewCoinp(7, 666666866, "BTC"),
+		coin.NewCoinp(0, 1, "ETH"),
+	}, balance(dest2.Address()))
+	assert.Equal(t, coin.Coins{
+		coin.NewCoinp(3, 0, "BTC"),
+		coin.NewCoinp(0, 3, "ETH"),
+	}, balance(dest3.Address()))
+	// Only the dust that could not be split is left.
+	assert.Equal(t, coin.Coins{
+		coin.NewCoinp(0, 1, "BTC"),
+		coin.NewCoinp(0, 3, "ETH"),
+	}, balance(revAddr))
+
+	var rev Revenue
+	assert.Nil(t, revenues.One(db, revID, &rev))
+	assert.Equal(t, 0, len(rev.Accrued))
+	if err := accruals.Has(db, accrualKey(revID, dest1.Address())); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("claimed accrual must be deleted, got %+v", err)
+	}
+}
+
+func TestClaimPushModeRevenue(t *testing.T) {
+	admin := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash", "distribution")
+
+	create := action{
+		conditions: []weave.Condition{admin},
+		msg: &CreateMsg{
+			Metadata:     &weave.Metadata{Schema: 1},
+			Admin:        admin.Address(),
+			Destinations: []*Destination{{Weight: 1, Address: dest.Address()}},
+		},
+	}
+	_, err := rt.Deliver(create.ctx(), db, create.tx())
+	assert.Nil(t, err)
+
+	claim := action{
+		conditions: []weave.Condition{dest},
+		msg: &ClaimMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			RevenueID:   weavetest.SequenceID(1),
+			Destination: dest.Address(),
+		},
+	}
+	if _, err := rt.Check(claim.ctx(), db, claim.tx()); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+}
+
 // account represents a single account state - the coins/funds it holds.
 type account struct {
 	address weave.Address
@@ -539,7 +763,7 @@ func TestDistribute(t *testing.T) {
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
 			source := weave.Address("address-source")
-			err := distribute(nil, tc.ctrl, source, tc.destinations)
+			err := distribute(context.Background(), nil, tc.ctrl, source, tc.destinations)
 			if !tc.wantErr.Is(err) {
 				t.Errorf("want %q error, got %q", tc.wantErr, err)
 			}
@@ -569,7 +793,7 @@ func (tc *testController) Balance(weave.KVStore, weave.Address) (coin.Coins, err
 	return tc.balance, tc.err
 }
 
-func (tc *testController) MoveCoins(db weave.KVStore, source, dst weave.Address, amount coin.Coin) error {
+func (tc *testController) MoveCoins(ctx weave.Context, db weave.KVStore, source, dst weave.Address, amount coin.Coin) error {
 	tc.moves = append(tc.moves, movecall{dst: dst, amount: amount})
 	return tc.err
 }
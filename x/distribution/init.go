@@ -9,19 +9,27 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisRevenue is the genesis file representation of a revenue.
+type genesisRevenue struct {
+	Admin        weave.Address `json:"admin"`
+	Destinations []struct {
+		Address weave.Address `json:"address"`
+		Weight  int32         `json:"weight"`
+	} `json:"destinations"`
+	Pull bool `json:"pull"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type destination struct {
-		Address weave.Address `json:"address"`
-		Weight  int32         `json:"weight"`
-	}
-	var revenues []struct {
-		Admin        weave.Address `json:"admin"`
-		Destinations []destination `json:"destinations"`
-	}
+	var revenues []genesisRevenue
 	if err := opts.ReadOptions("distribution", &revenues); err != nil {
 		return errors.Wrap(err, "cannot load distribution")
 	}
@@ -44,6 +52,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Admin:        r.Admin,
 			Destinations: destinations,
 			Address:      RevenueAccount(key),
+			Pull:         r.Pull,
 		}
 		if _, err := bucket.Put(kv, key, &revenue); err != nil {
 			return errors.Wrapf(err, "cannot store #%d revenue", i)
@@ -9,6 +9,7 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestGenesisKey(t *testing.T) {
@@ -66,3 +67,16 @@ func TestGenesisKey(t *testing.T) {
 		t.Fatalf("unexected address: %q", r.Address)
 	}
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{
+			"admin": "seq:test/admin/1",
+			"destinations": [
+				{"address": "0102030405060708090021222324252627282930", "weight": 2},
+				{"address": "seq:test/dest/1", "weight": 1}
+			]
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisRevenue{})
+}
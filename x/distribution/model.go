@@ -4,6 +4,7 @@ import (
 	"math"
 
 	weave "github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -11,6 +12,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Revenue{}, migration.NoModification)
+	migration.MustRegister(1, &Accrual{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*Revenue)(nil)
@@ -22,10 +24,29 @@ func (rev *Revenue) Validate() error {
 	errs = errors.AppendField(errs, "Admin", rev.Admin.Validate())
 	errs = errors.AppendField(errs, "Destinatinos", validateDestinations(rev.Destinations, errors.ErrModel))
 	errs = errors.AppendField(errs, "Address", rev.Address.Validate())
+	if len(rev.Accrued) != 0 {
+		if !rev.Pull {
+			errs = errors.AppendField(errs, "Accrued", errors.Wrap(errors.ErrModel, "only pull mode revenue can accrue funds"))
+		}
+		errs = errors.AppendField(errs, "Accrued", validateFunds(rev.Accrued))
+	}
 
 	return errs
 }
 
+// validateFunds returns an error if given coins are not a valid, positive
+// amount.
+func validateFunds(funds []*coin.Coin) error {
+	c := coin.Coins(funds)
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if !c.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must be positive")
+	}
+	return nil
+}
+
 // validateDestinations returns an error if given list of destinations is not
 // valid. This functionality is used in many places (model and messages),
 // having it abstracted saves repeating validation code.
@@ -90,6 +111,47 @@ func NewRevenueBucket() orm.ModelBucket {
 
 var revenueSeq = orm.NewSequence("revenue", "id")
 
+var _ orm.Model = (*Accrual)(nil)
+
+func (a *Accrual) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", a.Metadata.Validate())
+	if len(a.RevenueID) == 0 {
+		errs = errors.Append(errs, errors.Field("RevenueID", errors.ErrModel, "revenue ID is required"))
+	}
+	errs = errors.AppendField(errs, "Destination", a.Destination.Validate())
+	errs = errors.AppendField(errs, "Amount", validateFunds(a.Amount))
+
+	return errs
+}
+
+// NewAccrualBucket returns a bucket for managing funds accrued by the
+// destinations of revenues in pull mode. Each accrual is stored under a key
+// created by accrualKey function.
+func NewAccrualBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("accrual", &Accrual{},
+		orm.WithIndex("destination", accrualDestinationIndexer, false),
+	)
+	return migration.NewModelBucket("distribution", b)
+}
+
+// accrualKey returns the key of an accrual of given destination, for a
+// revenue with given ID. Revenue ID is a sequence value of a constant length.
+func accrualKey(revenueID []byte, destination weave.Address) []byte {
+	key := make([]byte, 0, len(revenueID)+len(destination))
+	key = append(key, revenueID...)
+	return append(key, destination...)
+}
+
+func accrualDestinationIndexer(obj orm.Object) ([]byte, error) {
+	a, ok := obj.Value().(*Accrual)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+	}
+	return a.Destination, nil
+}
+
 func RevenueAccount(key []byte) weave.Address {
 	return weave.NewCondition("dist", "revenue", key).Address()
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 )
@@ -80,6 +81,44 @@ func TestRevenueValidate(t *testing.T) {
 			},
 			wantErr: errors.ErrInput,
 		},
+		"pull mode revenue can accrue funds": {
+			model: Revenue{
+				Metadata: &weave.Metadata{Schema: 1},
+				Admin:    addr,
+				Destinations: []*Destination{
+					{Weight: 1, Address: addr},
+				},
+				Address: addr,
+				Pull:    true,
+				Accrued: []*coin.Coin{coin.NewCoinp(1, 0, "IOV")},
+			},
+			wantErr: nil,
+		},
+		"push mode revenue cannot accrue funds": {
+			model: Revenue{
+				Metadata: &weave.Metadata{Schema: 1},
+				Admin:    addr,
+				Destinations: []*Destination{
+					{Weight: 1, Address: addr},
+				},
+				Address: addr,
+				Accrued: []*coin.Coin{coin.NewCoinp(1, 0, "IOV")},
+			},
+			wantErr: errors.ErrModel,
+		},
+		"accrued funds must be positive": {
+			model: Revenue{
+				Metadata: &weave.Metadata{Schema: 1},
+				Admin:    addr,
+				Destinations: []*Destination{
+					{Weight: 1, Address: addr},
+				},
+				Address: addr,
+				Pull:    true,
+				Accrued: []*coin.Coin{coin.NewCoinp(-1, 0, "IOV")},
+			},
+			wantErr: errors.ErrAmount,
+		},
 	}
 
 	for testName, tc := range cases {
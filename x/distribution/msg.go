@@ -10,6 +10,7 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DistributeMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ResetMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ClaimMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -59,3 +60,21 @@ func (msg *ResetMsg) Validate() error {
 func (ResetMsg) Path() string {
 	return "distribution/reset"
 }
+
+var _ weave.Msg = (*ClaimMsg)(nil)
+
+func (msg *ClaimMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if len(msg.RevenueID) == 0 {
+		errs = errors.Append(errs, errors.Field("RevenueID", errors.ErrMsg, "revenue ID is required"))
+	}
+	errs = errors.AppendField(errs, "Destination", msg.Destination.Validate())
+
+	return errs
+}
+
+func (ClaimMsg) Path() string {
+	return "distribution/claim"
+}
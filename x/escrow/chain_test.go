@@ -0,0 +1,230 @@
+package escrow
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/utils"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// TestEscrowLifecycleOnChain is processing the escrow lifecycle using a real
+// application, where each operation is delivered in a separate block.
+func TestEscrowLifecycleOnChain(t *testing.T) {
+	source := weavetest.NewCondition()
+	recipient := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeout := weave.AsUnixTime(now.Add(2 * time.Hour))
+	escrowID := weavetest.SequenceID(1)
+
+	newApp := func() abci.Application {
+		return newEscrowApp(t, source.Address(), coin.NewCoin(100, 0, "IOV"))
+	}
+
+	scenario := func(chain *weavetest.Chain) {
+		res := chain.DeliverBlock(1, now, &signedTx{
+			Signers: []weave.Condition{source},
+			Msg:     NewCreateMsg(source.Address(), recipient.Address(), arbiter.Address(), coin.Coins{coin.NewCoinp(100, 0, "IOV")}, timeout, "lifecycle"),
+		})
+		assert.Nil(t, res.TxErr(0))
+
+		res = chain.DeliverBlock(2, now.Add(time.Hour), &signedTx{
+			Signers: []weave.Condition{arbiter},
+			Msg: &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+				Amount:   coin.Coins{coin.NewCoinp(30, 0, "IOV")},
+			},
+		})
+		assert.Nil(t, res.TxErr(0))
+
+		// Once the escrow expired, it can no longer be released but
+		// the remaining funds can be returned to the source.
+		res = chain.DeliverBlock(5, timeout.Time().Add(time.Hour),
+			&signedTx{
+				Signers: []weave.Condition{arbiter},
+				Msg: &ReleaseMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+				},
+			},
+			&signedTx{
+				Msg: &ReturnMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+				},
+			},
+		)
+		if err := res.TxErr(0); !errors.ErrExpired.Is(err) {
+			t.Fatalf("want expired error, got %+v", err)
+		}
+		assert.Nil(t, res.TxErr(1))
+	}
+
+	weavetest.AssertStableAppHash(t, newApp, scenario)
+
+	application := newApp()
+	scenario(weavetest.NewChain(application))
+
+	db := app.NewABCIStore(application)
+	assertBalance(t, db, source.Address(), coin.NewCoin(70, 0, "IOV"))
+	assertBalance(t, db, recipient.Address(), coin.NewCoin(30, 0, "IOV"))
+	assertBalance(t, db, Condition(escrowID).Address(), coin.Coin{})
+
+	if obj, err := rawBucket().Get(db, escrowID); err != nil || obj != nil {
+		t.Fatalf("escrow must be deleted, got %v, %v", obj, err)
+	}
+}
+
+// newEscrowApp returns an application with the escrow extension, where given
+// address is funded with given amount.
+func newEscrowApp(t testing.TB, funded weave.Address, amount coin.Coin) abci.Application {
+	t.Helper()
+
+	ctrl := cash.NewController(cash.NewBucket())
+	rt := app.NewRouter()
+	RegisterRoutes(rt, authenticator(), ctrl)
+	handler := app.ChainDecorators(
+		signersDecorator{},
+		utils.NewSavepoint().OnDeliver(),
+	).WithHandler(rt)
+
+	qr := weave.NewQueryRouter()
+	orm.RegisterQuery(qr)
+	RegisterQuery(qr)
+	cash.RegisterQuery(qr)
+
+	store := app.NewStoreApp("escrow", iavl.MockCommitStore(), qr, context.Background()).
+		WithInit(app.ChainInitializers(&migration.Initializer{}, &cash.Initializer{}))
+	base := app.NewBaseApp(store, decodeSignedTx, handler, nil, false)
+
+	genesis, err := json.Marshal(map[string]interface{}{
+		"initialize_schema": []interface{}{
+			map[string]interface{}{"pkg": "escrow", "ver": 1},
+			map[string]interface{}{"pkg": "cash", "ver": 1},
+		},
+		"cash": []interface{}{
+			map[string]interface{}{"address": funded, "coins": []interface{}{amount}},
+		},
+		"conf": map[string]interface{}{
+			"migration": migration.Configuration{
+				Admin: weave.NewCondition("test", "admin", []byte{1}).Address(),
+			},
+			"cash": cash.Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weave.NewCondition("test", "collector", []byte{1}).Address(),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	base.InitChain(abci.RequestInitChain{ChainId: "escrow-chain", AppStateBytes: genesis})
+	return base
+}
+
+func assertBalance(t testing.TB, db weave.ReadOnlyKVStore, addr weave.Address, want coin.Coin) {
+	t.Helper()
+	obj, err := cash.NewBucket().Get(db, addr)
+	assert.Nil(t, err)
+	var got coin.Coin
+	if obj != nil {
+		if coins := cash.AsCoins(obj); len(coins) != 0 {
+			got = *coins[0]
+		}
+	}
+	if !got.Equals(want) {
+		t.Fatalf("%s: want %v balance, got %v", addr, want, got)
+	}
+}
+
+// signedTx is a transaction that declares its signers. Signers are trusted,
+// because this transaction is only a test helper.
+type signedTx struct {
+	Signers []weave.Condition
+	Msg     weave.Msg
+}
+
+var _ weave.Tx = (*signedTx)(nil)
+
+func (tx *signedTx) GetMsg() (weave.Msg, error) {
+	return tx.Msg, nil
+}
+
+type serializedTx struct {
+	Signers []weave.Condition `json:"signers"`
+	Path    string            `json:"path"`
+	Msg     []byte            `json:"msg"`
+}
+
+func (tx *signedTx) Marshal() ([]byte, error) {
+	raw, err := tx.Msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(serializedTx{Signers: tx.Signers, Path: tx.Msg.Path(), Msg: raw})
+}
+
+func (tx *signedTx) Unmarshal(raw []byte) error {
+	var s serializedTx
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	var msg weave.Msg
+	switch s.Path {
+	case "escrow/create":
+		msg = &CreateMsg{}
+	case "escrow/release":
+		msg = &ReleaseMsg{}
+	case "escrow/return":
+		msg = &ReturnMsg{}
+	default:
+		return errors.Wrapf(errors.ErrInput, "unknown message path %q", s.Path)
+	}
+	if err := msg.Unmarshal(s.Msg); err != nil {
+		return errors.Wrap(err, "message")
+	}
+	tx.Signers = s.Signers
+	tx.Msg = msg
+	return nil
+}
+
+func decodeSignedTx(raw []byte) (weave.Tx, error) {
+	var tx signedTx
+	if err := tx.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// signersDecorator authenticates all signers declared by a signedTx.
+type signersDecorator struct{}
+
+var _ weave.Decorator = signersDecorator{}
+
+func (signersDecorator) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	return next.Check(withSigners(ctx, tx), db, tx)
+}
+
+func (signersDecorator) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	return next.Deliver(withSigners(ctx, tx), db, tx)
+}
+
+func withSigners(ctx weave.Context, tx weave.Tx) weave.Context {
+	if stx, ok := tx.(*signedTx); ok {
+		return authenticator().SetConditions(ctx, stx.Signers...)
+	}
+	return ctx
+}
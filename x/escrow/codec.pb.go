@@ -46,6 +46,17 @@ type Escrow struct {
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Fundings is a list of contributions made using FundEscrowMsg by anyone
+	// other than the source. Upon return, each contributor receives their own
+	// share. Remaining funds are returned to the source.
+	Fundings []Funding `protobuf:"bytes,8,rep,name=fundings,proto3" json:"fundings"`
+	// Arbiter fee is the part of the fee declared on creation that was not yet
+	// settled. Each release settles the share of the fee proportional to the
+	// released fraction of the escrow balance of the fee currency. The settled
+	// share is deducted from the released amount and paid to the arbiter if the
+	// arbiter signed the release. Otherwise the destination receives it.
+	// Funds returned after the timeout are never charged.
+	ArbiterFee coin.Coin `protobuf:"bytes,9,opt,name=arbiter_fee,json=arbiterFee,proto3" json:"arbiter_fee"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -130,6 +141,73 @@ func (m *Escrow) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Escrow) GetFundings() []Funding {
+	if m != nil {
+		return m.Fundings
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiterFee() coin.Coin {
+	if m != nil {
+		return m.ArbiterFee
+	}
+	return coin.Coin{}
+}
+
+// Funding is a share of the escrow funds that belongs to a single contributor.
+type Funding struct {
+	Contributor github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=contributor,proto3,casttype=github.com/iov-one/weave.Address" json:"contributor,omitempty"`
+	Amount      []*coin.Coin                     `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *Funding) Reset()         { *m = Funding{} }
+func (m *Funding) String() string { return proto.CompactTextString(m) }
+func (*Funding) ProtoMessage()    {}
+func (*Funding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{1}
+}
+func (m *Funding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Funding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Funding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Funding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Funding.Merge(m, src)
+}
+func (m *Funding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Funding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Funding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Funding proto.InternalMessageInfo
+
+func (m *Funding) GetContributor() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Contributor
+	}
+	return nil
+}
+
+func (m *Funding) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // CreateMsg is a request to create an Escrow with some tokens.
 // Message must be authorized by the source.
 type CreateMsg struct {
@@ -143,13 +221,16 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Optional fee paid to the arbiter for releasing the funds. It must not be
+	// greater than the amount of the same currency.
+	ArbiterFee coin.Coin `protobuf:"bytes,8,opt,name=arbiter_fee,json=arbiterFee,proto3" json:"arbiter_fee"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{1}
+	return fileDescriptor_36017ee554579951, []int{2}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +308,83 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetArbiterFee() coin.Coin {
+	if m != nil {
+		return m.ArbiterFee
+	}
+	return coin.Coin{}
+}
+
+// FundEscrowMsg is a request to add funds to an existing, not expired escrow.
+// Anyone can fund an escrow. Message must be authorized by the sender.
+type FundEscrowMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId []byte                           `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Sender   github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=sender,proto3,casttype=github.com/iov-one/weave.Address" json:"sender,omitempty"`
+	Amount   []*coin.Coin                     `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *FundEscrowMsg) Reset()         { *m = FundEscrowMsg{} }
+func (m *FundEscrowMsg) String() string { return proto.CompactTextString(m) }
+func (*FundEscrowMsg) ProtoMessage()    {}
+func (*FundEscrowMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{3}
+}
+func (m *FundEscrowMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundEscrowMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundEscrowMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundEscrowMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundEscrowMsg.Merge(m, src)
+}
+func (m *FundEscrowMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundEscrowMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundEscrowMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundEscrowMsg proto.InternalMessageInfo
+
+func (m *FundEscrowMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FundEscrowMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
+	}
+	return nil
+}
+
+func (m *FundEscrowMsg) GetSender() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *FundEscrowMsg) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // ReleaseMsg releases the content to the destination.
 // Message must be authorized by the source or arbiter.
 // If amount not provided, defaults to entire escrow, May be a subset of the
@@ -241,7 +399,7 @@ func (m *ReleaseMsg) Reset()         { *m = ReleaseMsg{} }
 func (m *ReleaseMsg) String() string { return proto.CompactTextString(m) }
 func (*ReleaseMsg) ProtoMessage()    {}
 func (*ReleaseMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{2}
+	return fileDescriptor_36017ee554579951, []int{4}
 }
 func (m *ReleaseMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +460,7 @@ func (m *ReturnMsg) Reset()         { *m = ReturnMsg{} }
 func (m *ReturnMsg) String() string { return proto.CompactTextString(m) }
 func (*ReturnMsg) ProtoMessage()    {}
 func (*ReturnMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{3}
+	return fileDescriptor_36017ee554579951, []int{5}
 }
 func (m *ReturnMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +520,7 @@ func (m *UpdatePartiesMsg) Reset()         { *m = UpdatePartiesMsg{} }
 func (m *UpdatePartiesMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdatePartiesMsg) ProtoMessage()    {}
 func (*UpdatePartiesMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{4}
+	return fileDescriptor_36017ee554579951, []int{6}
 }
 func (m *UpdatePartiesMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +586,9 @@ func (m *UpdatePartiesMsg) GetDestination() github_com_iov_one_weave.Address {
 
 func init() {
 	proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
+	proto.RegisterType((*Funding)(nil), "escrow.Funding")
 	proto.RegisterType((*CreateMsg)(nil), "escrow.CreateMsg")
+	proto.RegisterType((*FundEscrowMsg)(nil), "escrow.FundEscrowMsg")
 	proto.RegisterType((*ReleaseMsg)(nil), "escrow.ReleaseMsg")
 	proto.RegisterType((*ReturnMsg)(nil), "escrow.ReturnMsg")
 	proto.RegisterType((*UpdatePartiesMsg)(nil), "escrow.UpdatePartiesMsg")
@@ -437,34 +597,41 @@ func init() {
 func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptor_36017ee554579951) }
 
 var fileDescriptor_36017ee554579951 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0xb4, 0x2c, 0x47, 0x8e, 0x9f, 0x0b, 0x0d, 0x22, 0x07, 0xe1, 0x82, 0xa2, 0x8a, 0x16, 0x04,
-	0xa5, 0x12, 0xa4, 0xd7, 0xd2, 0x52, 0x87, 0x16, 0x7a, 0x08, 0x94, 0xa5, 0x3e, 0x97, 0xb5, 0xf6,
-	0xe1, 0x2e, 0x54, 0xfb, 0xc2, 0xee, 0x2a, 0x09, 0xfd, 0x8a, 0x7c, 0x43, 0xbf, 0xa6, 0xc7, 0x1c,
-	0x7b, 0x0a, 0xc5, 0xfe, 0x88, 0x42, 0x4e, 0xc5, 0x5a, 0xa7, 0xd1, 0x25, 0x07, 0x35, 0xbe, 0xe5,
-	0x36, 0x8c, 0x76, 0xde, 0x32, 0x6f, 0x46, 0x0b, 0xfb, 0xe7, 0x05, 0x9a, 0x52, 0xd3, 0x59, 0x51,
-	0x92, 0xc0, 0x32, 0x3f, 0xd1, 0x64, 0x29, 0x0c, 0x1c, 0x37, 0x19, 0xb7, 0xc8, 0xc9, 0x5e, 0x49,
-	0x52, 0xb5, 0x8f, 0x4d, 0xf6, 0x17, 0xb4, 0xa0, 0x06, 0x16, 0x6b, 0xe4, 0xd8, 0xf4, 0xc2, 0x87,
-	0xe0, 0x7d, 0xa3, 0x0f, 0x5f, 0xc0, 0x6e, 0x85, 0x96, 0x0b, 0x6e, 0x79, 0xe4, 0x25, 0x5e, 0x36,
-	0x3e, 0x7c, 0x9c, 0x9f, 0x21, 0x3f, 0xc5, 0xfc, 0x78, 0x43, 0xb3, 0x7f, 0x07, 0xc2, 0xd7, 0x10,
-	0x18, 0xaa, 0x75, 0x89, 0x51, 0x3f, 0xf1, 0xb2, 0x47, 0xd3, 0x67, 0xd7, 0x57, 0x07, 0xc9, 0x42,
-	0xda, 0xaf, 0xf5, 0x3c, 0x2f, 0xa9, 0x2a, 0x24, 0x9d, 0xbe, 0x24, 0x85, 0x85, 0x1b, 0xf0, 0x4e,
-	0x08, 0x8d, 0xc6, 0xb0, 0x8d, 0x26, 0x7c, 0x03, 0x43, 0xae, 0xe7, 0xd2, 0xa2, 0x8e, 0xfc, 0x0e,
-	0xf2, 0x1b, 0x51, 0xf8, 0x01, 0xc6, 0x02, 0x8d, 0x95, 0x8a, 0x5b, 0x49, 0x2a, 0x1a, 0x74, 0x98,
-	0xd1, 0x16, 0x86, 0x6f, 0x61, 0x68, 0x65, 0x85, 0x54, 0xdb, 0x68, 0x27, 0xf1, 0x32, 0x7f, 0xfa,
-	0xfc, 0xfa, 0xea, 0xe0, 0xe9, 0x9d, 0x33, 0x66, 0x4a, 0x9e, 0x7f, 0x96, 0x15, 0xb2, 0x1b, 0x55,
-	0x18, 0xc2, 0xa0, 0xc2, 0x8a, 0xa2, 0x20, 0xf1, 0xb2, 0x11, 0x6b, 0x70, 0x63, 0xce, 0x5d, 0x16,
-	0x0d, 0x3b, 0x99, 0x73, 0x20, 0xfd, 0xd3, 0x87, 0xd1, 0x91, 0x46, 0x6e, 0xf1, 0xd8, 0x2c, 0x1e,
-	0x62, 0x2a, 0x29, 0x04, 0xbc, 0xa2, 0x5a, 0xad, 0x43, 0xf1, 0xb3, 0xf1, 0x21, 0xe4, 0xeb, 0x32,
-	0xe7, 0x47, 0x24, 0x15, 0xdb, 0x7c, 0x69, 0x27, 0x17, 0xdc, 0x2b, 0xb9, 0xe1, 0x6d, 0x72, 0xe9,
-	0x77, 0x00, 0x86, 0xdf, 0x90, 0x9b, 0xee, 0x9b, 0x7f, 0x02, 0x23, 0xf7, 0x1b, 0x7e, 0x91, 0xc2,
-	0x2d, 0x9f, 0xed, 0x3a, 0xe2, 0xa3, 0x68, 0x19, 0xf2, 0xef, 0x32, 0x94, 0xce, 0x60, 0xc4, 0xd0,
-	0xd6, 0x5a, 0x6d, 0xf5, 0xea, 0xf4, 0x47, 0x1f, 0xf6, 0x66, 0x27, 0x82, 0x5b, 0xfc, 0xc4, 0xb5,
-	0x95, 0x68, 0xb6, 0xeb, 0xec, 0xb6, 0x70, 0xfe, 0xfd, 0x0a, 0x37, 0xd8, 0x42, 0xe1, 0x76, 0xfe,
-	0xb3, 0x70, 0xd3, 0xe8, 0xe7, 0x32, 0xf6, 0x2e, 0x97, 0xb1, 0xf7, 0x7b, 0x19, 0x7b, 0x17, 0xab,
-	0xb8, 0x77, 0xb9, 0x8a, 0x7b, 0xbf, 0x56, 0x71, 0x6f, 0x1e, 0x34, 0xaf, 0xe4, 0xab, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4a, 0x80, 0x06, 0xba, 0x7a, 0x05, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x66, 0x93, 0x4d, 0xf2, 0xa2, 0x58, 0x86, 0x1e, 0x86, 0x08, 0x9b, 0x35, 0x28, 0x04,
+	0xc4, 0x0d, 0xd5, 0xab, 0x28, 0xa6, 0x18, 0xf0, 0x50, 0x90, 0xc5, 0x9c, 0xcb, 0x64, 0xe7, 0x35,
+	0x0e, 0xb8, 0x33, 0x65, 0x76, 0xb6, 0x2d, 0xfe, 0x0a, 0x7f, 0x83, 0x47, 0xff, 0x82, 0x7f, 0xa0,
+	0xc7, 0x1e, 0x3d, 0x05, 0x49, 0xfe, 0x83, 0x87, 0x9e, 0x24, 0x3b, 0xdb, 0x76, 0x15, 0x02, 0xae,
+	0xcd, 0xad, 0xb7, 0xc7, 0x97, 0xf7, 0xbd, 0xf7, 0xe6, 0x7d, 0xdf, 0xcb, 0xc2, 0xee, 0xd9, 0x08,
+	0xd3, 0x58, 0xab, 0xd3, 0x51, 0xac, 0x38, 0xc6, 0xe1, 0xb1, 0x56, 0x46, 0x11, 0xcf, 0x62, 0xbd,
+	0x6e, 0x09, 0xec, 0xed, 0xc4, 0x4a, 0xc8, 0x72, 0x5a, 0x6f, 0x77, 0xae, 0xe6, 0x2a, 0x0f, 0x47,
+	0xeb, 0xc8, 0xa2, 0x83, 0x5f, 0x2e, 0x78, 0x6f, 0x73, 0x3e, 0x79, 0x0a, 0xed, 0x04, 0x0d, 0xe3,
+	0xcc, 0x30, 0xea, 0x04, 0xce, 0xb0, 0xfb, 0xfc, 0x41, 0x78, 0x8a, 0xec, 0x04, 0xc3, 0x83, 0x02,
+	0x8e, 0xae, 0x13, 0xc8, 0x4b, 0xf0, 0x52, 0x95, 0xe9, 0x18, 0x69, 0x3d, 0x70, 0x86, 0xf7, 0xc6,
+	0x8f, 0x2f, 0x17, 0xfd, 0x60, 0x2e, 0xcc, 0xc7, 0x6c, 0x16, 0xc6, 0x2a, 0x19, 0x09, 0x75, 0xf2,
+	0x4c, 0x49, 0x1c, 0xd9, 0x02, 0x6f, 0x38, 0xd7, 0x98, 0xa6, 0x51, 0xc1, 0x21, 0xaf, 0xa0, 0xc5,
+	0xf4, 0x4c, 0x18, 0xd4, 0xd4, 0xad, 0x40, 0xbf, 0x22, 0x91, 0x09, 0x74, 0x39, 0xa6, 0x46, 0x48,
+	0x66, 0x84, 0x92, 0xb4, 0x51, 0xa1, 0x46, 0x99, 0x48, 0x5e, 0x43, 0xcb, 0x88, 0x04, 0x55, 0x66,
+	0x68, 0x33, 0x70, 0x86, 0xee, 0xf8, 0xc9, 0xe5, 0xa2, 0xff, 0x68, 0x63, 0x8d, 0xa9, 0x14, 0x67,
+	0x1f, 0x44, 0x82, 0xd1, 0x15, 0x8b, 0x10, 0x68, 0x24, 0x98, 0x28, 0xea, 0x05, 0xce, 0xb0, 0x13,
+	0xe5, 0x71, 0xfe, 0x38, 0xdb, 0x8c, 0xb6, 0x2a, 0x3d, 0xce, 0x06, 0x64, 0x0f, 0xda, 0x47, 0x99,
+	0xe4, 0x42, 0xce, 0x53, 0xda, 0x0e, 0xdc, 0x5c, 0x07, 0x2b, 0x71, 0x38, 0xb1, 0xf8, 0xb8, 0x71,
+	0xbe, 0xe8, 0xd7, 0xa2, 0xeb, 0x34, 0xb2, 0x07, 0xdd, 0x62, 0x35, 0x87, 0x47, 0x88, 0xb4, 0x93,
+	0xab, 0x07, 0xe1, 0xda, 0x03, 0xe1, 0xbe, 0x12, 0xb2, 0x20, 0x40, 0x91, 0x34, 0x41, 0x1c, 0x64,
+	0xd0, 0x2a, 0xaa, 0xad, 0xb7, 0x19, 0x2b, 0x69, 0xb4, 0x98, 0x65, 0x46, 0x69, 0xea, 0x54, 0x18,
+	0xba, 0x4c, 0x24, 0x03, 0xf0, 0x58, 0xa2, 0x32, 0x69, 0x68, 0x3d, 0x70, 0xff, 0x1c, 0x20, 0x2a,
+	0x7e, 0x19, 0x7c, 0x73, 0xa1, 0xb3, 0xaf, 0x91, 0x19, 0x3c, 0x48, 0xe7, 0x77, 0xd1, 0x72, 0x37,
+	0x4b, 0x6a, 0x6e, 0x5a, 0x52, 0xd9, 0x96, 0xde, 0xad, 0x6c, 0xd9, 0x2a, 0xd9, 0xf2, 0x2f, 0x8f,
+	0xb4, 0xff, 0xc1, 0x23, 0xdf, 0x1d, 0xb8, 0xbf, 0x36, 0x89, 0xfd, 0x83, 0xa8, 0x2c, 0xd8, 0x43,
+	0xe8, 0x58, 0xdf, 0x1e, 0x0a, 0x6e, 0x35, 0x8b, 0xda, 0x16, 0x78, 0xc7, 0x73, 0x35, 0x51, 0xf2,
+	0x8a, 0x72, 0x14, 0x9c, 0xd2, 0x16, 0x1b, 0x1b, 0xad, 0xf6, 0x19, 0x20, 0xc2, 0x4f, 0xc8, 0x52,
+	0xdc, 0xee, 0xe4, 0x37, 0xbd, 0xdd, 0x8d, 0xbd, 0xa7, 0xd0, 0x89, 0xd0, 0x64, 0x5a, 0x6e, 0xb5,
+	0xf5, 0xe0, 0x6b, 0x1d, 0x76, 0xa6, 0xc7, 0x9c, 0x19, 0x7c, 0xcf, 0xb4, 0x11, 0x98, 0x6e, 0x5f,
+	0x13, 0x7b, 0x61, 0xee, 0xed, 0x2e, 0xac, 0xb1, 0x85, 0x0b, 0x6b, 0xfe, 0xe7, 0x85, 0x8d, 0xe9,
+	0xf9, 0xd2, 0x77, 0x2e, 0x96, 0xbe, 0xf3, 0x73, 0xe9, 0x3b, 0x5f, 0x56, 0x7e, 0xed, 0x62, 0xe5,
+	0xd7, 0x7e, 0xac, 0xfc, 0xda, 0xcc, 0xcb, 0xbf, 0x79, 0x2f, 0x7e, 0x0f, 0x00, 0x56, 0x51, 0x78,
+	0x40, 0x48, 0x07, 0x00, 0x00,
 }
 
 func (m *Escrow) Marshal() (dAtA []byte, err error) {
@@ -527,6 +694,62 @@ func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if len(m.Fundings) > 0 {
+		for _, msg := range m.Fundings {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterFee.Size()))
+	n2, err := m.ArbiterFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	return i, nil
+}
+
+func (m *Funding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Funding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contributor) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Contributor)))
+		i += copy(dAtA[i:], m.Contributor)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -549,11 +772,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if len(m.Source) > 0 {
 		dAtA[i] = 0x12
@@ -596,6 +819,66 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterFee.Size()))
+	n4, err := m.ArbiterFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	return i, nil
+}
+
+func (m *FundEscrowMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundEscrowMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.Sender) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Sender)))
+		i += copy(dAtA[i:], m.Sender)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -618,11 +901,11 @@ func (m *ReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n6
 	}
 	if len(m.EscrowId) > 0 {
 		dAtA[i] = 0x12
@@ -664,11 +947,11 @@ func (m *ReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n7
 	}
 	if len(m.EscrowId) > 0 {
 		dAtA[i] = 0x12
@@ -698,11 +981,11 @@ func (m *UpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	if len(m.EscrowId) > 0 {
 		dAtA[i] = 0x12
@@ -773,6 +1056,33 @@ func (m *Escrow) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = m.ArbiterFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *Funding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -811,6 +1121,35 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = m.ArbiterFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *FundEscrowMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1148,24 +1487,212 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fundings = append(m.Fundings, Funding{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArbiterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Funding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = append(m.Contributor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Contributor == nil {
+				m.Contributor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1424,6 +1951,230 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArbiterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundEscrowMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundEscrowMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundEscrowMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
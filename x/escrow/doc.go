@@ -13,6 +13,25 @@ The arbiter or source (sender) can release them to the recipient.
 The recipient (destination) can return them to the sender (source).
 Upon timeout, they will be returned to the sender (source).
 
+Anyone can add funds to an escrow that has not expired yet. Funds added by
+anyone other than the source are recorded as that contributor's share. Upon
+timeout, each contributor receives their own share and the source receives
+the rest. A partial release reduces every share, including the source share,
+by the released fraction. Contributor shares are rounded down to the smallest
+coin unit and the rounding remainder is added to the source share, so that the
+contributor shares never exceed the escrow balance. An escrow can have at most
+20 contributors.
+
+An escrow can declare an arbiter fee of one of the escrowed currencies. The fee
+cannot be greater than the escrowed amount of that currency. Each release
+settles the part of the fee proportional to the released fraction of the
+balance of the fee currency, rounded down to the smallest coin unit. Releasing
+the whole balance settles the rest of the fee. The settled part is deducted
+from the released amount and paid to the arbiter, but only if the arbiter
+signed the release. Otherwise the destination receives the whole released
+amount and the settled part of the fee is forfeited. Funds returned after the
+timeout are never charged.
+
 
 */
 package escrow
@@ -16,6 +16,7 @@ const (
 	returnEscrowCost  int64 = 0
 	releaseEscrowCost int64 = 0
 	updateEscrowCost  int64 = 50
+	fundEscrowCost    int64 = 50
 )
 
 // RegisterRoutes will instantiate and register
@@ -28,6 +29,7 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Contro
 	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl})
 	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl})
 	r.Handle(&UpdatePartiesMsg{}, UpdateEscrowHandler{auth, bucket})
+	r.Handle(&FundEscrowMsg{}, FundEscrowHandler{auth, bucket, cashctrl})
 }
 
 // RegisterQuery will register this bucket as "/escrows"
@@ -80,13 +82,14 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 		Timeout:     msg.Timeout,
 		Memo:        msg.Memo,
 		Address:     Condition(key).Address(),
+		ArbiterFee:  msg.ArbiterFee,
 	}
 	if _, err := h.bucket.Put(db, key, escrow); err != nil {
 		return nil, errors.Wrap(err, "cannot store escrow")
 	}
 
 	// Deposit to the escrow account.
-	if err := cash.MoveCoins(db, h.bank, escrow.Source, escrow.Address, msg.Amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, escrow.Source, escrow.Address, msg.Amount); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -145,8 +148,29 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 		request = available
 	}
 
+	balance, err := h.bank.Balance(db, escrow.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The arbiter is paid only for actively releasing the funds. Otherwise
+	// the settled fee is released to the destination.
+	fee, err := escrow.releaseArbiterFee(balance, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "release arbiter fee")
+	}
+	payout := request
+	if fee.IsPositive() && h.auth.HasAddress(ctx, escrow.Arbiter) {
+		if payout, err = request.Clone().Subtract(fee); err != nil {
+			return nil, errors.Wrap(err, "deduct arbiter fee")
+		}
+		if err := h.bank.MoveCoins(ctx, db, escrow.Address, escrow.Arbiter, fee); err != nil {
+			return nil, errors.Wrap(err, "pay arbiter fee")
+		}
+	}
+
 	// withdraw the money from escrow to recipient
-	if err := cash.MoveCoins(db, h.bank, escrow.Address, escrow.Destination, request); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, escrow.Address, escrow.Destination, payout); err != nil {
 		return nil, err
 	}
 
@@ -155,6 +179,14 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 		return nil, err
 	}
 	if remainingCoins.IsPositive() {
+		if len(escrow.Fundings) > 0 || fee.IsPositive() {
+			if err := escrow.releaseFundings(balance, request); err != nil {
+				return nil, errors.Wrap(err, "release fundings")
+			}
+			if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+				return nil, errors.Wrap(err, "cannot save escrow")
+			}
+		}
 		return &weave.DeliverResult{Data: msg.EscrowId}, nil
 	}
 	// Delete escrow when empty.
@@ -208,8 +240,10 @@ func (h ReturnEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave
 	return &weave.CheckResult{GasAllocated: returnEscrowCost}, nil
 }
 
-// Deliver moves all the tokens from the escrow to the defined source if
-// all preconditions are met. The escrow is deleted afterwards.
+// Deliver moves all the tokens from the escrow to the contributors and the
+// defined source if all preconditions are met. Each contributor receives
+// their own share and the source receives the rest. The escrow is deleted
+// afterwards.
 func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
 	key, escrow, err := h.validate(ctx, db, tx)
 	if err != nil {
@@ -221,10 +255,14 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 		return nil, err
 	}
 
-	// withdraw all coins from escrow to the defined "source"
-	dest := weave.Address(escrow.Source)
-	if err := cash.MoveCoins(db, h.bank, escrow.Address, dest, available); err != nil {
-		return nil, err
+	shares, err := escrow.returnShares(available)
+	if err != nil {
+		return nil, errors.Wrap(err, "return shares")
+	}
+	for _, s := range shares {
+		if err := cash.MoveCoins(ctx, db, h.bank, escrow.Address, s.Contributor, s.Amount); err != nil {
+			return nil, err
+		}
 	}
 	if err := h.bucket.Delete(db, key); err != nil {
 		return nil, err
@@ -332,3 +370,70 @@ func (h UpdateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 	return &msg, &escrow, nil
 }
+
+// FundEscrowHandler adds funds to an existing escrow.
+type FundEscrowHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+	bank   cash.CoinMover
+}
+
+var _ weave.Handler = FundEscrowHandler{}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h FundEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: fundEscrowCost}, nil
+}
+
+// Deliver moves the tokens from the sender to the escrow account if all
+// preconditions are met. Unless the sender is the escrow source, the amount
+// is recorded as the sender contribution.
+func (h FundEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Sender.Equals(escrow.Source) {
+		if err := escrow.addFunding(msg.Sender, msg.Amount); err != nil {
+			return nil, errors.Wrap(err, "add funding")
+		}
+		if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+			return nil, errors.Wrap(err, "cannot save escrow")
+		}
+	}
+
+	if err := cash.MoveCoins(ctx, db, h.bank, msg.Sender, escrow.Address, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{Data: msg.EscrowId}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h FundEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*FundEscrowMsg, *Escrow, error) {
+	var msg FundEscrowMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+
+	if weave.IsExpired(ctx, escrow.Timeout) {
+		return nil, nil, errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+	}
+
+	if !h.auth.HasAddress(ctx, msg.Sender) {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	return &msg, &escrow, nil
+}
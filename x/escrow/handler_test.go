@@ -626,3 +626,280 @@ func mo(obj orm.Object, err error) orm.Object {
 	}
 	return obj
 }
+
+func TestFundEscrow(t *testing.T) {
+	source := weavetest.NewCondition()
+	destination := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+	alice := weavetest.NewCondition()
+	bobby := weavetest.NewCondition()
+	escrowID := weavetest.SequenceID(1)
+
+	bank := cash.NewBucket()
+	ctrl := cash.NewController(bank)
+	router := app.NewRouter()
+	RegisterRoutes(router, authenticator(), ctrl)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+	for _, w := range []struct {
+		owner  weave.Condition
+		amount coin.Coins
+	}{
+		{owner: source, amount: mustCombineCoins(coin.NewCoin(20, 0, "FOO"))},
+		{owner: alice, amount: mustCombineCoins(coin.NewCoin(20, 0, "FOO"))},
+		{owner: bobby, amount: mustCombineCoins(coin.NewCoin(20, 0, "FOO"), coin.NewCoin(20, 0, "BAR"))},
+	} {
+		acct, err := cash.WalletWith(w.owner.Address(), w.amount...)
+		assert.Nil(t, err)
+		assert.Nil(t, bank.Save(db, acct))
+	}
+
+	fund := func(sender weave.Condition, amount ...coin.Coin) action {
+		return action{
+			perms: []weave.Condition{sender},
+			msg: &FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+				Sender:   sender.Address(),
+				Amount:   mustCombineCoins(amount...),
+			},
+		}
+	}
+	deliver := func(a action) error {
+		cache := db.CacheWrap()
+		if _, err := router.Check(a.ctx(), cache, a.tx()); err != nil {
+			return err
+		}
+		cache.Discard()
+		_, err := router.Deliver(a.ctx(), db, a.tx())
+		return err
+	}
+	assertBalance := func(t testing.TB, owner weave.Address, want ...coin.Coin) {
+		t.Helper()
+		got, err := ctrl.Balance(db, owner)
+		if !errors.ErrNotFound.Is(err) {
+			assert.Nil(t, err)
+		}
+		if len(want) == 0 {
+			assert.Equal(t, true, got.IsEmpty())
+			return
+		}
+		assert.Equal(t, true, mustCombineCoins(want...).Equals(got))
+	}
+
+	assert.Nil(t, deliver(createAction(source, destination, arbiter, mustCombineCoins(coin.NewCoin(10, 0, "FOO")), "")))
+
+	// Anyone can fund an escrow, but only using their own funds.
+	assert.Nil(t, deliver(fund(alice, coin.NewCoin(4, 0, "FOO"))))
+	assert.Nil(t, deliver(fund(bobby, coin.NewCoin(5, 0, "FOO"), coin.NewCoin(1, 0, "BAR"))))
+	assert.Nil(t, deliver(fund(bobby, coin.NewCoin(1, 0, "FOO"))))
+	// Source contribution is not tracked separately.
+	assert.Nil(t, deliver(fund(source, coin.NewCoin(2, 0, "FOO"))))
+	stolen := fund(alice, coin.NewCoin(1, 0, "FOO"))
+	stolen.perms = []weave.Condition{bobby}
+	assert.IsErr(t, errors.ErrUnauthorized, deliver(stolen))
+
+	var esc Escrow
+	assert.Nil(t, NewBucket().One(db, escrowID, &esc))
+	assert.Equal(t, []Funding{
+		{Contributor: alice.Address(), Amount: mustCombineCoins(coin.NewCoin(4, 0, "FOO"))},
+		{Contributor: bobby.Address(), Amount: mustCombineCoins(coin.NewCoin(1, 0, "BAR"), coin.NewCoin(6, 0, "FOO"))},
+	}, esc.Fundings)
+	assertBalance(t, esc.Address, coin.NewCoin(22, 0, "FOO"), coin.NewCoin(1, 0, "BAR"))
+
+	// Release of half of the FOO tokens reduces all shares by half.
+	release := action{
+		perms: []weave.Condition{arbiter},
+		msg: &ReleaseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: escrowID,
+			Amount:   mustCombineCoins(coin.NewCoin(11, 0, "FOO")),
+		},
+	}
+	assert.Nil(t, deliver(release))
+	assert.Nil(t, NewBucket().One(db, escrowID, &esc))
+	assert.Equal(t, []Funding{
+		{Contributor: alice.Address(), Amount: mustCombineCoins(coin.NewCoin(2, 0, "FOO"))},
+		{Contributor: bobby.Address(), Amount: mustCombineCoins(coin.NewCoin(1, 0, "BAR"), coin.NewCoin(3, 0, "FOO"))},
+	}, esc.Fundings)
+
+	// Expired escrow cannot be funded.
+	expired := fund(alice, coin.NewCoin(1, 0, "FOO"))
+	expired.blockTime = Timeout.Time()
+	assert.IsErr(t, errors.ErrExpired, deliver(expired))
+
+	// On return, each contributor gets their own share back. Source receives
+	// the rest.
+	assert.Nil(t, deliver(action{
+		msg:       &ReturnMsg{Metadata: &weave.Metadata{Schema: 1}, EscrowId: escrowID},
+		blockTime: Timeout.Time(),
+	}))
+	assertBalance(t, destination.Address(), coin.NewCoin(11, 0, "FOO"))
+	assertBalance(t, alice.Address(), coin.NewCoin(18, 0, "FOO"))
+	assertBalance(t, bobby.Address(), coin.NewCoin(17, 0, "FOO"), coin.NewCoin(20, 0, "BAR"))
+	assertBalance(t, source.Address(), coin.NewCoin(14, 0, "FOO"))
+	assertBalance(t, esc.Address)
+}
+
+func TestEscrowReleaseFundingsRounding(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+	esc := Escrow{
+		Fundings: []Funding{
+			{Contributor: alice, Amount: mustCombineCoins(coin.NewCoin(1, 0, "FOO"))},
+		},
+	}
+	// One third of the balance is released. Contributor share is rounded
+	// down, the remainder is added to the source share.
+	balance := mustCombineCoins(coin.NewCoin(3, 0, "FOO"))
+	released := mustCombineCoins(coin.NewCoin(1, 0, "FOO"))
+	assert.Nil(t, esc.releaseFundings(balance, released))
+	assert.Equal(t, []Funding{
+		{Contributor: alice, Amount: mustCombineCoins(coin.NewCoin(0, 666666666, "FOO"))},
+	}, esc.Fundings)
+
+	// Share of a fully released denomination is removed.
+	assert.Nil(t, esc.releaseFundings(mustCombineCoins(coin.NewCoin(2, 0, "FOO")), mustCombineCoins(coin.NewCoin(2, 0, "FOO"))))
+	assert.Equal(t, 0, len(esc.Fundings))
+
+	// When contributors hold the whole balance, their shares still do
+	// not exceed the balance left after the release.
+	bobby := weavetest.NewCondition().Address()
+	esc = Escrow{
+		Fundings: []Funding{
+			{Contributor: alice, Amount: mustCombineCoins(coin.NewCoin(1, 0, "FOO"))},
+			{Contributor: bobby, Amount: mustCombineCoins(coin.NewCoin(2, 0, "FOO"))},
+		},
+	}
+	assert.Nil(t, esc.releaseFundings(balance, released))
+	assert.Equal(t, []Funding{
+		{Contributor: alice, Amount: mustCombineCoins(coin.NewCoin(0, 666666666, "FOO"))},
+		{Contributor: bobby, Amount: mustCombineCoins(coin.NewCoin(1, 333333333, "FOO"))},
+	}, esc.Fundings)
+}
+
+func TestEscrowFundingsAreLimited(t *testing.T) {
+	var esc Escrow
+	for i := 0; i < maxFundings; i++ {
+		assert.Nil(t, esc.addFunding(weavetest.NewCondition().Address(), mustCombineCoins(coin.NewCoin(1, 0, "FOO"))))
+	}
+	// Existing contributor can still add funds.
+	assert.Nil(t, esc.addFunding(esc.Fundings[0].Contributor, mustCombineCoins(coin.NewCoin(1, 0, "FOO"))))
+	err := esc.addFunding(weavetest.NewCondition().Address(), mustCombineCoins(coin.NewCoin(1, 0, "FOO")))
+	assert.IsErr(t, errors.ErrState, err)
+	assert.Equal(t, maxFundings, len(esc.Fundings))
+}
+
+func TestArbiterFee(t *testing.T) {
+	source := weavetest.NewCondition()
+	destination := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	bank := cash.NewBucket()
+	ctrl := cash.NewController(bank)
+	router := app.NewRouter()
+	RegisterRoutes(router, authenticator(), ctrl)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+	acct, err := cash.WalletWith(source.Address(), mustCombineCoins(coin.NewCoin(20, 0, "FOO"), coin.NewCoin(5, 0, "BAR"))...)
+	assert.Nil(t, err)
+	assert.Nil(t, bank.Save(db, acct))
+
+	deliver := func(a action) error {
+		cache := db.CacheWrap()
+		if _, err := router.Check(a.ctx(), cache, a.tx()); err != nil {
+			return err
+		}
+		cache.Discard()
+		_, err := router.Deliver(a.ctx(), db, a.tx())
+		return err
+	}
+	assertBalance := func(t testing.TB, owner weave.Address, want ...coin.Coin) {
+		t.Helper()
+		got, err := ctrl.Balance(db, owner)
+		if !errors.ErrNotFound.Is(err) {
+			assert.Nil(t, err)
+		}
+		if len(want) == 0 {
+			assert.Equal(t, true, got.IsEmpty())
+			return
+		}
+		assert.Equal(t, true, mustCombineCoins(want...).Equals(got))
+	}
+	create := func(fee coin.Coin, amount ...coin.Coin) action {
+		a := createAction(source, destination, arbiter, mustCombineCoins(amount...), "")
+		a.msg.(*CreateMsg).ArbiterFee = fee
+		return a
+	}
+	release := func(signer weave.Condition, id []byte, amount ...coin.Coin) action {
+		msg := &ReleaseMsg{Metadata: &weave.Metadata{Schema: 1}, EscrowId: id}
+		if len(amount) > 0 {
+			msg.Amount = mustCombineCoins(amount...)
+		}
+		return action{perms: []weave.Condition{signer}, msg: msg}
+	}
+
+	assert.IsErr(t, errors.ErrAmount, deliver(create(coin.NewCoin(11, 0, "FOO"), coin.NewCoin(10, 0, "FOO"))))
+
+	escrowID := weavetest.SequenceID(1)
+	assert.Nil(t, deliver(create(coin.NewCoin(2, 0, "FOO"), coin.NewCoin(10, 0, "FOO"), coin.NewCoin(2, 0, "BAR"))))
+
+	// Releasing half of the balance settles half of the fee.
+	assert.Nil(t, deliver(release(arbiter, escrowID, coin.NewCoin(5, 0, "FOO"))))
+	assertBalance(t, arbiter.Address(), coin.NewCoin(1, 0, "FOO"))
+	assertBalance(t, destination.Address(), coin.NewCoin(4, 0, "FOO"))
+
+	// Release of another currency does not settle any fee.
+	assert.Nil(t, deliver(release(arbiter, escrowID, coin.NewCoin(1, 0, "BAR"))))
+	assertBalance(t, arbiter.Address(), coin.NewCoin(1, 0, "FOO"))
+	assertBalance(t, destination.Address(), coin.NewCoin(4, 0, "FOO"), coin.NewCoin(1, 0, "BAR"))
+
+	// Release signed by the source settles the fee share, but the
+	// arbiter is not paid for it.
+	assert.Nil(t, deliver(release(source, escrowID, coin.NewCoin(1, 0, "FOO"))))
+	assertBalance(t, arbiter.Address(), coin.NewCoin(1, 0, "FOO"))
+	assertBalance(t, destination.Address(), coin.NewCoin(5, 0, "FOO"), coin.NewCoin(1, 0, "BAR"))
+	var esc Escrow
+	assert.Nil(t, NewBucket().One(db, escrowID, &esc))
+	assert.Equal(t, coin.NewCoin(0, 800000000, "FOO"), esc.ArbiterFee)
+
+	// Releasing the rest settles the rest of the fee.
+	assert.Nil(t, deliver(release(arbiter, escrowID)))
+	assertBalance(t, arbiter.Address(), coin.NewCoin(1, 800000000, "FOO"))
+	assertBalance(t, destination.Address(), coin.NewCoin(8, 200000000, "FOO"), coin.NewCoin(2, 0, "BAR"))
+	assertBalance(t, esc.Address)
+	assert.IsErr(t, errors.ErrNotFound, NewBucket().Has(db, escrowID))
+
+	// Funds returned after the timeout are not charged.
+	assert.Nil(t, deliver(create(coin.NewCoin(1, 0, "FOO"), coin.NewCoin(3, 0, "FOO"))))
+	assert.Nil(t, deliver(action{
+		msg:       &ReturnMsg{Metadata: &weave.Metadata{Schema: 1}, EscrowId: weavetest.SequenceID(2)},
+		blockTime: Timeout.Time(),
+	}))
+	assertBalance(t, arbiter.Address(), coin.NewCoin(1, 800000000, "FOO"))
+	assertBalance(t, source.Address(), coin.NewCoin(10, 0, "FOO"), coin.NewCoin(3, 0, "BAR"))
+}
+
+func TestEscrowReleaseArbiterFeeRounding(t *testing.T) {
+	esc := Escrow{ArbiterFee: coin.NewCoin(1, 0, "FOO")}
+
+	// One third of the balance is released. Settled fee is rounded down.
+	balance := mustCombineCoins(coin.NewCoin(3, 0, "FOO"))
+	released := mustCombineCoins(coin.NewCoin(1, 0, "FOO"))
+	fee, err := esc.releaseArbiterFee(balance, released)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(0, 333333333, "FOO"), fee)
+	assert.Equal(t, coin.NewCoin(0, 666666667, "FOO"), esc.ArbiterFee)
+
+	// Releasing the whole balance settles the whole remaining fee.
+	balance = mustCombineCoins(coin.NewCoin(2, 0, "FOO"))
+	fee, err = esc.releaseArbiterFee(balance, balance)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(0, 666666667, "FOO"), fee)
+	assert.Equal(t, true, esc.ArbiterFee.IsZero())
+
+	fee, err = esc.releaseArbiterFee(balance, balance)
+	assert.Nil(t, err)
+	assert.Equal(t, true, fee.IsZero())
+}
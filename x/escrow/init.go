@@ -7,22 +7,30 @@ import (
 	"github.com/pkg/errors"
 )
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
 
 // Initializer fulfils the Initializer interface to load data from the genesis file
 type Initializer struct {
 	Minter cash.CoinMinter
 }
 
+// genesisEscrow is the genesis file representation of an escrow.
+type genesisEscrow struct {
+	Source      weave.Address  `json:"source"`
+	Arbiter     weave.Address  `json:"arbiter"`
+	Destination weave.Address  `json:"destination"`
+	Timeout     weave.UnixTime `json:"timeout"`
+	Amount      []*coin.Coin   `json:"amount"`
+}
+
 // FromGenesis will parse initial escrow  info from genesis and save it in the database.
 func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var escrows []struct {
-		Source      weave.Address  `json:"source"`
-		Arbiter     weave.Address  `json:"arbiter"`
-		Destination weave.Address  `json:"destination"`
-		Timeout     weave.UnixTime `json:"timeout"`
-		Amount      []*coin.Coin   `json:"amount"`
-	}
+	var escrows []genesisEscrow
 
 	if err := opts.ReadOptions("escrow", &escrows); err != nil {
 		return err
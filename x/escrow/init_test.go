@@ -63,3 +63,19 @@ func TestGenesisKey(t *testing.T) {
 	assert.Equal(t, coin.Coin{Ticker: "ALX", Whole: 987654321}, *balance[0])
 	assert.Equal(t, coin.Coin{Ticker: "IOV", Whole: 123456789}, *balance[1])
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{
+			"amount": [
+				{"ticker": "ALX", "whole": 987654321},
+				"1.5 IOV"
+			],
+			"arbiter": "0000000000000000000000000000000000000001",
+			"destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+			"source": "0000000000000000000000000000000000000000",
+			"timeout": 2046639600
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisEscrow{})
+}
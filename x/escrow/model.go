@@ -1,6 +1,9 @@
 package escrow
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -32,9 +35,212 @@ func (e *Escrow) Validate() error {
 	if len(e.Memo) > maxMemoSize {
 		errs = errors.Append(errs, errors.Field("Memo", errors.ErrInput, "cannot be longer than %d", maxMemoSize))
 	}
+	if len(e.Fundings) > maxFundings {
+		errs = errors.Append(errs, errors.Field("Fundings", errors.ErrInput, "cannot have more than %d contributors", maxFundings))
+	}
+	for i, f := range e.Fundings {
+		errs = errors.AppendField(errs, fmt.Sprintf("Fundings.%d", i), f.Validate())
+	}
+	errs = errors.AppendField(errs, "ArbiterFee", validateArbiterFee(e.ArbiterFee))
+	return errs
+}
+
+// validateArbiterFee returns an error if given arbiter fee is not a valid
+// optional amount.
+func validateArbiterFee(fee coin.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	if !fee.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must not be negative")
+	}
+	return nil
+}
+
+// Validate ensures the funding is valid.
+func (f *Funding) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Contributor", f.Contributor.Validate())
+	errs = errors.AppendField(errs, "Amount", validateAmount(f.Amount))
 	return errs
 }
 
+// maxFundings is the maximum number of contributors of a single escrow. It
+// bounds the cost of releasing and returning the escrow, which process all
+// fundings.
+const maxFundings = 20
+
+// addFunding records given amount as contributed by given address. A new
+// contributor is rejected if the escrow already has maxFundings contributors.
+func (e *Escrow) addFunding(contributor weave.Address, amount coin.Coins) error {
+	for i, f := range e.Fundings {
+		if !f.Contributor.Equals(contributor) {
+			continue
+		}
+		total, err := coin.Coins(f.Amount).Combine(amount)
+		if err != nil {
+			return errors.Wrap(err, "combine funding")
+		}
+		e.Fundings[i].Amount = total
+		return nil
+	}
+	if len(e.Fundings) >= maxFundings {
+		return errors.Wrapf(errors.ErrState, "escrow cannot have more than %d contributors", maxFundings)
+	}
+	total, err := coin.NormalizeCoins(amount.Clone())
+	if err != nil {
+		return errors.Wrap(err, "normalize funding")
+	}
+	e.Fundings = append(e.Fundings, Funding{Contributor: contributor, Amount: total})
+	return nil
+}
+
+// releaseFundings reduces the share of each contributor after the released
+// amount was paid out of the escrow holding the given balance. Each
+// denomination is released proportionally from all shares, including the
+// source share. A contributor share is reduced by the released fraction,
+// rounded up to the smallest coin unit, so that the sum of contributor shares
+// never exceeds the balance. Any rounding remainder is added to the source
+// share.
+func (e *Escrow) releaseFundings(balance, released coin.Coins) error {
+	fundings := e.Fundings[:0]
+	for _, f := range e.Fundings {
+		var remaining coin.Coins
+		for _, c := range f.Amount {
+			cut, err := releasedShare(*c, balance, released, true)
+			if err != nil {
+				return errors.Wrap(err, "released share")
+			}
+			left, err := c.Subtract(cut)
+			if err != nil {
+				return errors.Wrap(err, "subtract released share")
+			}
+			if left.IsPositive() {
+				remaining = append(remaining, &left)
+			}
+		}
+		if len(remaining) > 0 {
+			fundings = append(fundings, Funding{Contributor: f.Contributor, Amount: remaining})
+		}
+	}
+	e.Fundings = fundings
+	return nil
+}
+
+// releaseArbiterFee settles the part of the arbiter fee that corresponds to
+// the released amount, given the balance before the release. The settled part
+// is the remaining fee multiplied by the released fraction of the balance of
+// the fee currency, rounded down to the smallest coin unit. Releasing the
+// whole balance settles the whole remaining fee. The settled part is returned
+// and removed from the remaining fee.
+//
+// Because the remaining fee is never greater than the balance, the settled
+// part is never greater than the released amount of the fee currency.
+func (e *Escrow) releaseArbiterFee(balance, released coin.Coins) (coin.Coin, error) {
+	if e.ArbiterFee.IsZero() {
+		return coin.Coin{}, nil
+	}
+	settled, err := releasedShare(e.ArbiterFee, balance, released, false)
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "released share")
+	}
+	left, err := e.ArbiterFee.Subtract(settled)
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "subtract settled fee")
+	}
+	if left.IsZero() {
+		left = coin.Coin{}
+	}
+	e.ArbiterFee = left
+	return settled, nil
+}
+
+// releasedShare returns the part of the given share that was released, given
+// the balance before the release and the released amount.
+// The result is share * released / balance, rounded down or, if roundUp is
+// set, rounded up. It is never greater than the share.
+func releasedShare(share coin.Coin, balance, released coin.Coins, roundUp bool) (coin.Coin, error) {
+	zero := coin.NewCoin(0, 0, share.Ticker)
+	total := coinsOf(balance, share.Ticker)
+	out := coinsOf(released, share.Ticker)
+	if !total.IsPositive() || !out.IsPositive() {
+		return zero, nil
+	}
+	if out.IsGTE(total) {
+		return share, nil
+	}
+	u := new(big.Int).Mul(coinUnits(share), coinUnits(out))
+	u, rem := u.QuoRem(u, coinUnits(total), new(big.Int))
+	if roundUp && rem.Sign() != 0 {
+		u.Add(u, big.NewInt(1))
+	}
+
+	whole, frac := new(big.Int).QuoRem(u, big.NewInt(coin.FracUnit), new(big.Int))
+	if !whole.IsInt64() {
+		return zero, errors.Wrap(errors.ErrOverflow, "released share")
+	}
+	return coin.NewCoin(whole.Int64(), frac.Int64(), share.Ticker), nil
+}
+
+// coinsOf returns the total amount of coins with given ticker.
+func coinsOf(cs coin.Coins, ticker string) coin.Coin {
+	total := coin.NewCoin(0, 0, ticker)
+	for _, c := range cs {
+		if c.Ticker != ticker {
+			continue
+		}
+		if sum, err := total.Add(*c); err == nil {
+			total = sum
+		}
+	}
+	return total
+}
+
+// coinUnits returns the value of the coin expressed in the smallest coin
+// units.
+func coinUnits(c coin.Coin) *big.Int {
+	u := new(big.Int).Mul(big.NewInt(c.Whole), big.NewInt(coin.FracUnit))
+	return u.Add(u, big.NewInt(c.Fractional))
+}
+
+// returnShares returns the amount that each party receives when the escrow
+// holding given balance is returned. Each contributor receives their own
+// share. The source receives whatever remains.
+func (e *Escrow) returnShares(balance coin.Coins) ([]Funding, error) {
+	remaining := balance.Clone()
+	var shares []Funding
+	for _, f := range e.Fundings {
+		var pay coin.Coins
+		for _, c := range f.Amount {
+			// Balance is never expected to be lower than the sum of
+			// all contributions, but if it is, contributors are
+			// paid in the order of funding.
+			amount := *c
+			if avail := coinsOf(remaining, c.Ticker); !avail.IsGTE(amount) {
+				amount = avail
+			}
+			if !amount.IsPositive() {
+				continue
+			}
+			var err error
+			if remaining, err = remaining.Subtract(amount); err != nil {
+				return nil, errors.Wrap(err, "subtract share")
+			}
+			pay = append(pay, &amount)
+		}
+		if len(pay) > 0 {
+			shares = append(shares, Funding{Contributor: f.Contributor, Amount: pay})
+		}
+	}
+	if remaining.IsPositive() {
+		shares = append(shares, Funding{Contributor: e.Source, Amount: remaining})
+	}
+	return shares, nil
+}
+
 // AsEscrow extracts an *Escrow value or nil from the object
 // Must be called on a Bucket result that is an *Escrow,
 // will panic on bad type.
@@ -76,6 +282,7 @@ func Condition(key []byte) weave.Condition {
 func NewBucket() orm.ModelBucket {
 	b := orm.NewModelBucket("esc", &Escrow{},
 		orm.WithIDSequence(escrowSeq),
+		orm.WithFixedKeyLength(8),
 		orm.WithIndex("source", idxSource, false),
 		orm.WithIndex("destination", idxDestination, false),
 		orm.WithIndex("arbiter", idxArbiter, false),
@@ -12,6 +12,7 @@ func init() {
 	migration.MustRegister(1, &ReleaseMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReturnMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdatePartiesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &FundEscrowMsg{}, migration.NoModification)
 }
 
 const (
@@ -62,6 +63,27 @@ func (m *CreateMsg) Validate() error {
 		errs = errors.Append(errs, errors.Field("Memo", errors.ErrInput, "cannot be longer than %d", maxMemoSize))
 	}
 	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	if err := validateArbiterFee(m.ArbiterFee); err != nil {
+		errs = errors.AppendField(errs, "ArbiterFee", err)
+	} else if !m.ArbiterFee.IsZero() && !coinsOf(m.Amount, m.ArbiterFee.Ticker).IsGTE(m.ArbiterFee) {
+		errs = errors.AppendField(errs, "ArbiterFee", errors.Wrap(errors.ErrAmount, "greater than the escrow amount"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*FundEscrowMsg)(nil)
+
+func (FundEscrowMsg) Path() string {
+	return "escrow/fund"
+}
+
+// Validate makes sure that this is sensible
+func (m *FundEscrowMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	errs = errors.AppendField(errs, "Sender", m.Sender.Validate())
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
 	return errs
 }
 
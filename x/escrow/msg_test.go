@@ -108,6 +108,54 @@ func TestCreateMsg(t *testing.T) {
 			},
 			errors.ErrInput,
 		},
+		"arbiter fee within the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      a.Address(),
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  coin.NewCoin(100, 0, "FOO"),
+			},
+			nil,
+		},
+		"arbiter fee greater than the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      a.Address(),
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  coin.NewCoin(100, 1, "FOO"),
+			},
+			errors.ErrAmount,
+		},
+		"arbiter fee in a currency not in the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      a.Address(),
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  coin.NewCoin(1, 0, "BAR"),
+			},
+			errors.ErrAmount,
+		},
+		"negative arbiter fee": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      a.Address(),
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  coin.NewCoin(-1, 0, "FOO"),
+			},
+			errors.ErrAmount,
+		},
 		"zero timeout": {
 			&CreateMsg{
 				Metadata:    &weave.Metadata{Schema: 1},
@@ -246,6 +294,58 @@ func TestReturnMsg(t *testing.T) {
 	}
 }
 
+func TestFundEscrowMsg(t *testing.T) {
+	escrow := []byte{0xff, 0, 1, 3, 6, 6, 6, 6}
+	sender := weavetest.NewCondition().Address()
+	plus := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+
+	cases := map[string]struct {
+		msg   *FundEscrowMsg
+		check error
+	}{
+		"valid": {
+			&FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrow,
+				Sender:   sender,
+				Amount:   plus,
+			},
+			nil,
+		},
+		"missing id": {
+			&FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Sender:   sender,
+				Amount:   plus,
+			},
+			errors.ErrInput,
+		},
+		"missing sender": {
+			&FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrow,
+				Amount:   plus,
+			},
+			errors.ErrEmpty,
+		},
+		"missing amount": {
+			&FundEscrowMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrow,
+				Sender:   sender,
+			},
+			errors.ErrAmount,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
 func TestUpdateEscrowMsg(t *testing.T) {
 	// valid: fixed 8 byte id
 	escrow := []byte{0xf, 0, 0, 0xb, 0xa, 0xd, 7, 7}
@@ -105,8 +105,23 @@ func proposalElectorateIDIndexer(obj orm.Object) ([]byte, error) {
 	return p.ElectorateRef.ID, nil
 }
 
-// GetProposal loads the proposal for the given id. If it does not exist then ErrNotFound is returned.
+// validProposalID returns ErrInput if given ID is not a sequence value. This
+// is the equivalent of orm.WithFixedKeyLength, which is a model bucket
+// option.
+func validProposalID(id []byte) error {
+	if len(id) != proposalIDLength {
+		return errors.Wrapf(errors.ErrInput, "malformed proposal ID %X: expected %d bytes, got %d",
+			id, proposalIDLength, len(id))
+	}
+	return nil
+}
+
+// GetProposal loads the proposal for the given id. If it does not exist then
+// ErrNotFound is returned. ErrInput is returned if the id is not 8 bytes long.
 func (b *ProposalBucket) GetProposal(db weave.KVStore, id []byte) (*Proposal, error) {
+	if err := validProposalID(id); err != nil {
+		return nil, err
+	}
 	obj, err := b.Get(db, id)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load proposal")
@@ -127,6 +142,9 @@ func asProposal(obj orm.Object) (*Proposal, error) {
 
 // Update stores the given proposal and id in the persistence store.
 func (b *ProposalBucket) Update(db weave.KVStore, id []byte, obj *Proposal) error {
+	if err := validProposalID(id); err != nil {
+		return err
+	}
 	if err := b.Save(db, orm.NewSimpleObj(id, obj)); err != nil {
 		return errors.Wrap(err, "failed to save")
 	}
@@ -181,6 +199,11 @@ func asResolution(obj orm.Object) (*Resolution, error) {
 const (
 	indexNameProposal = "proposals"
 	indexNameElector  = "electors"
+	// indexNameProposalVoter is a unique compound index of the proposal ID
+	// and the voter address. Because index values of a single proposal
+	// share a prefix, it allows to iterate over votes of a proposal
+	// ordered by the voter address.
+	indexNameProposalVoter = "proposalvoter"
 )
 
 // VoteBucket is the persistence bucket for votes.
@@ -192,12 +215,36 @@ type VoteBucket struct {
 func NewVoteBucket() *VoteBucket {
 	b := migration.NewBucket(packageName, "vote", &Vote{}).
 		WithIndex(indexNameProposal, indexProposal, false).
-		WithIndex(indexNameElector, indexElector, false)
+		WithIndex(indexNameElector, indexElector, false).
+		WithLazyIndex(indexNameProposalVoter, indexProposalVoter, true)
 	return &VoteBucket{
 		Bucket: b,
 	}
 }
 
+// proposalVoterIndexChunk is the number of votes indexed by a single
+// orm.RebuildIndexChunk call.
+const proposalVoterIndexChunk = 1000
+
+// BuildProposalVoterIndex indexes all votes that were not yet indexed by the
+// proposal voter index and marks the index as ready to use. Calling it for an
+// index that is ready is a no-op. All votes are processed within given
+// transaction.
+func BuildProposalVoterIndex(db weave.KVStore) error {
+	b := NewVoteBucket()
+	var after []byte
+	for {
+		next, done, err := orm.RebuildIndexChunk(db, b, indexNameProposalVoter, after, proposalVoterIndexChunk)
+		if err != nil {
+			return errors.Wrap(err, "rebuild proposal voter index")
+		}
+		if done {
+			return nil
+		}
+		after = next
+	}
+}
+
 func indexElector(obj orm.Object) (bytes []byte, e error) {
 	if obj == nil {
 		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
@@ -221,6 +268,25 @@ func indexProposal(obj orm.Object) (bytes []byte, e error) {
 	return proposalID, nil
 }
 
+func indexProposalVoter(obj orm.Object) ([][]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	compositeKey := obj.Key()
+	if len(compositeKey) <= weave.AddressLength {
+		return nil, errors.Wrap(errors.ErrInput, "unsupported key type")
+	}
+	key := proposalVoterKey(compositeKey[weave.AddressLength:], compositeKey[:weave.AddressLength])
+	return [][]byte{key}, nil
+}
+
+// proposalVoterKey returns the value of the proposal voter index.
+func proposalVoterKey(proposalID []byte, voter weave.Address) []byte {
+	key := make([]byte, 0, len(proposalID)+len(voter))
+	key = append(key, proposalID...)
+	return append(key, voter...)
+}
+
 // Build creates the orm object without storing it.
 func (b *VoteBucket) Build(db weave.KVStore, proposalID []byte, vote Vote) orm.Object {
 	compositeKey := compositeKey(proposalID, vote.Elector.Address)
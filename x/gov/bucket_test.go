@@ -1,11 +1,13 @@
 package gov
 
 import (
+	"bytes"
 	"reflect"
 	"sort"
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
@@ -134,6 +136,93 @@ func TestQueryVotes(t *testing.T) {
 		})
 	}
 }
+func TestQueryProposalVotes(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	vBucket := NewVoteBucket()
+	proposalID := weavetest.SequenceID(1)
+	otherProposalID := weavetest.SequenceID(2)
+
+	// More votes than a single query returns, so that the result is
+	// paginated.
+	var voters []weave.Address
+	for i := 0; i < 70; i++ {
+		voters = append(voters, weavetest.NewCondition().Address())
+	}
+	sort.Slice(voters, func(i, j int) bool { return bytes.Compare(voters[i], voters[j]) < 0 })
+	for _, voter := range voters {
+		for _, pid := range [][]byte{proposalID, otherProposalID} {
+			obj := vBucket.Build(db, pid, Vote{
+				Metadata: &weave.Metadata{Schema: 1},
+				Voted:    VoteOption_Yes,
+				Elector:  Elector{Address: voter, Weight: 1},
+			})
+			assert.Nil(t, vBucket.Save(db, obj))
+		}
+	}
+
+	// Changed vote must reflect only the latest option.
+	changed := Vote{
+		Metadata: &weave.Metadata{Schema: 1},
+		Voted:    VoteOption_No,
+		Elector:  Elector{Address: voters[3], Weight: 1},
+	}
+	assert.Nil(t, vBucket.Save(db, vBucket.Build(db, proposalID, changed)))
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	// Votes stored before the index was built are not available until
+	// the index is backfilled.
+	voterKey := append(append([]byte{}, proposalID...), voters[3]...)
+	if _, err := qr.Handler("/votes/proposalvoter").Query(db, weave.KeyQueryMod, voterKey); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error before the index is built, got %v", err)
+	}
+	assert.Nil(t, BuildProposalVoterIndex(db))
+
+	models, err := qr.Handler("/votes/proposalvoter").Query(db, weave.KeyQueryMod, voterKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	var receipt Vote
+	assert.Nil(t, receipt.Unmarshal(models[0].Value))
+	assert.Equal(t, changed, receipt)
+
+	var got []Vote
+	var after []byte
+	for pages := 0; ; pages++ {
+		if pages > len(voters) {
+			t.Fatal("pagination does not end")
+		}
+		data := append(append([]byte{}, proposalID...), after...)
+		models, err := qr.Handler("/votes/proposal").Query(db, weave.KeyQueryMod, data)
+		assert.Nil(t, err)
+		if len(models) == 0 {
+			break
+		}
+		for _, m := range models {
+			var v Vote
+			assert.Nil(t, v.Unmarshal(m.Value))
+			got = append(got, v)
+		}
+		after = got[len(got)-1].Elector.Address
+	}
+
+	assert.Equal(t, len(voters), len(got))
+	for i, v := range got {
+		assert.Equal(t, voters[i], v.Elector.Address)
+		want := VoteOption_Yes
+		if i == 3 {
+			want = VoteOption_No
+		}
+		assert.Equal(t, want, v.Voted)
+	}
+
+	_, err = qr.Handler("/votes/proposal").Query(db, weave.KeyQueryMod, []byte{0x1})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %s", err)
+	}
+}
+
 func TestQueryElectorate(t *testing.T) {
 	alice := weavetest.NewCondition().Address()
 	bobby := weavetest.NewCondition().Address()
@@ -247,3 +336,29 @@ func mustMarshal(t *testing.T, m marshaller) []byte {
 	assert.Nil(t, err)
 	return b
 }
+
+func TestProposalBucketKeyLength(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	b := NewProposalBucket()
+
+	p := proposalFixture(t, weavetest.NewCondition().Address())
+	obj, err := b.Create(db, &p)
+	if err != nil {
+		t.Fatalf("cannot create a proposal: %s", err)
+	}
+	if _, err := b.GetProposal(db, obj.Key()); err != nil {
+		t.Fatalf("cannot load the proposal: %s", err)
+	}
+	if _, err := b.GetProposal(db, weavetest.SequenceID(2)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want ErrNotFound for a missing proposal, got %+v", err)
+	}
+	for _, id := range [][]byte{nil, []byte("1"), append(obj.Key(), 0)} {
+		if _, err := b.GetProposal(db, id); !errors.ErrInput.Is(err) {
+			t.Errorf("want ErrInput for %X, got %+v", id, err)
+		}
+		if err := b.Update(db, id, &p); !errors.ErrInput.Is(err) {
+			t.Errorf("want ErrInput when updating %X, got %+v", id, err)
+		}
+	}
+}
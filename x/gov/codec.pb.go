@@ -69,6 +69,9 @@ const (
 	// A proposal can be deleted before the voting start time by the owner. When this happens the final status
 	// is Withdrawn.
 	Proposal_Withdrawn Proposal_Status = 3
+	// Status of an accepted proposal that is waiting for the execution
+	// delay, as defined by the election rule, to pass.
+	Proposal_PendingExecution Proposal_Status = 4
 )
 
 var Proposal_Status_name = map[int32]string{
@@ -76,13 +79,15 @@ var Proposal_Status_name = map[int32]string{
 	1: "PROPOSAL_STATUS_SUBMITTED",
 	2: "PROPOSAL_STATUS_CLOSED",
 	3: "PROPOSAL_STATUS_WITHDRAWN",
+	4: "PROPOSAL_STATUS_PENDING_EXECUTION",
 }
 
 var Proposal_Status_value = map[string]int32{
-	"PROPOSAL_STATUS_INVALID":   0,
-	"PROPOSAL_STATUS_SUBMITTED": 1,
-	"PROPOSAL_STATUS_CLOSED":    2,
-	"PROPOSAL_STATUS_WITHDRAWN": 3,
+	"PROPOSAL_STATUS_INVALID":           0,
+	"PROPOSAL_STATUS_SUBMITTED":         1,
+	"PROPOSAL_STATUS_CLOSED":            2,
+	"PROPOSAL_STATUS_WITHDRAWN":         3,
+	"PROPOSAL_STATUS_PENDING_EXECUTION": 4,
 }
 
 func (x Proposal_Status) String() string {
@@ -341,6 +346,22 @@ type ElectionRule struct {
 	Quorum *Fraction `protobuf:"bytes,8,opt,name=quorum,proto3" json:"quorum,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,9,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Duration in seconds of how long the execution of an accepted proposal is
+	// delayed. When zero, an accepted proposal is executed immediately during
+	// the tally.
+	ExecutionDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,10,opt,name=execution_delay,json=executionDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"execution_delay,omitempty"`
+	// When set, abstain votes are included in the turnout that is compared
+	// against the quorum. Abstain votes never count toward the acceptance
+	// threshold. This flag has no effect if the quorum is not set.
+	AbstainCountsForQuorum bool `protobuf:"varint,11,opt,name=abstain_counts_for_quorum,json=abstainCountsForQuorum,proto3" json:"abstain_counts_for_quorum,omitempty"`
+	// Max title length is the greatest length, in bytes, of a title of a
+	// proposal created using this rule. Zero value means the package default
+	// of 128.
+	MaxTitleLength uint32 `protobuf:"varint,12,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	// Max description length is the greatest length, in bytes, of a
+	// description of a proposal created using this rule, measured after the
+	// description is sanitized. Zero value means the package default of 5000.
+	MaxDescriptionLength uint32 `protobuf:"varint,13,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
 }
 
 func (m *ElectionRule) Reset()         { *m = ElectionRule{} }
@@ -439,6 +460,34 @@ func (m *ElectionRule) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *ElectionRule) GetExecutionDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *ElectionRule) GetAbstainCountsForQuorum() bool {
+	if m != nil {
+		return m.AbstainCountsForQuorum
+	}
+	return false
+}
+
+func (m *ElectionRule) GetMaxTitleLength() uint32 {
+	if m != nil {
+		return m.MaxTitleLength
+	}
+	return 0
+}
+
+func (m *ElectionRule) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
 // The Fraction type represents a numerator and denominator to enable higher precision thresholds in
 // the election rules. For example:
 // numerator: 1, denominator: 2 => > 50%
@@ -536,6 +585,13 @@ type Proposal struct {
 	// Tally task ID holds the ID of the asynchronous task that is scheduled to
 	// create the tally once the voting period is over.
 	TallyTaskID []byte `protobuf:"bytes,15,opt,name=tally_task_id,json=tallyTaskId,proto3" json:"tally_task_id,omitempty"`
+	// Execution task ID holds the ID of the asynchronous task that is
+	// scheduled to execute an accepted proposal once the execution delay is
+	// over. Set only if the execution is delayed.
+	ExecutionTaskID []byte `protobuf:"bytes,16,opt,name=execution_task_id,json=executionTaskId,proto3" json:"execution_task_id,omitempty"`
+	// Unix timestamp of the block time after which an accepted proposal is
+	// executed. Set only if the execution is delayed.
+	ExecutionTime github_com_iov_one_weave.UnixTime `protobuf:"varint,17,opt,name=execution_time,json=executionTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -676,6 +732,20 @@ func (m *Proposal) GetTallyTaskID() []byte {
 	return nil
 }
 
+func (m *Proposal) GetExecutionTaskID() []byte {
+	if m != nil {
+		return m.ExecutionTaskID
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
 // Resolution contains TextResolution and an electorate reference.
 type Resolution struct {
 	Metadata      *weave.Metadata    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -760,6 +830,11 @@ type TallyResult struct {
 	// Threshold is the fraction of Yes votes of a base value that needs to be exceeded to accept the proposal.
 	// The base value is either the total electorate weight or the sum of Yes/No weights when a quorum is defined.
 	Threshold Fraction `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold"`
+	// AbstainExcludedFromQuorum when set excludes the abstain votes from the
+	// total votes weight that must exceed the quorum. The zero value includes
+	// abstain votes, which is the behaviour of all tally results created
+	// before the election rule flag was introduced.
+	AbstainExcludedFromQuorum bool `protobuf:"varint,7,opt,name=abstain_excluded_from_quorum,json=abstainExcludedFromQuorum,proto3" json:"abstain_excluded_from_quorum,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
@@ -837,6 +912,13 @@ func (m *TallyResult) GetThreshold() Fraction {
 	return Fraction{}
 }
 
+func (m *TallyResult) GetAbstainExcludedFromQuorum() bool {
+	if m != nil {
+		return m.AbstainExcludedFromQuorum
+	}
+	return false
+}
+
 // Vote combines the elector and their voted option to archive them.
 // The proposalID and address is stored within the key.
 type Vote struct {
@@ -1192,6 +1274,118 @@ func (m *TallyMsg) GetProposalID() []byte {
 	return nil
 }
 
+// ExecuteProposalMsg is executed by the scheduler once the execution delay of
+// an accepted proposal is over. It executes the proposal options.
+type ExecuteProposalMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ProposalID is UUID of the proposal to execute.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *ExecuteProposalMsg) Reset()         { *m = ExecuteProposalMsg{} }
+func (m *ExecuteProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalMsg) ProtoMessage()    {}
+func (*ExecuteProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
+}
+func (m *ExecuteProposalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalMsg.Merge(m, src)
+}
+func (m *ExecuteProposalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteProposalMsg proto.InternalMessageInfo
+
+func (m *ExecuteProposalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExecuteProposalMsg) GetProposalID() []byte {
+	if m != nil {
+		return m.ProposalID
+	}
+	return nil
+}
+
+// CancelProposalExecutionMsg is only intended to be dispatched internally
+// from election results. It cancels the delayed execution of an accepted
+// proposal. To allow a timely cancellation, use an election rule with a short
+// voting period and no execution delay.
+type CancelProposalExecutionMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ProposalID is UUID of the proposal which execution is cancelled.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *CancelProposalExecutionMsg) Reset()         { *m = CancelProposalExecutionMsg{} }
+func (m *CancelProposalExecutionMsg) String() string { return proto.CompactTextString(m) }
+func (*CancelProposalExecutionMsg) ProtoMessage()    {}
+func (*CancelProposalExecutionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
+}
+func (m *CancelProposalExecutionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelProposalExecutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelProposalExecutionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelProposalExecutionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelProposalExecutionMsg.Merge(m, src)
+}
+func (m *CancelProposalExecutionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelProposalExecutionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelProposalExecutionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelProposalExecutionMsg proto.InternalMessageInfo
+
+func (m *CancelProposalExecutionMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CancelProposalExecutionMsg) GetProposalID() []byte {
+	if m != nil {
+		return m.ProposalID
+	}
+	return nil
+}
+
 // TextResolutionMsg is only intended to be dispatched internally from election
 // results. It adds a resolution to the list of "approved" resolutions,
 // with a reference to the electorate that approved it
@@ -1204,7 +1398,7 @@ func (m *CreateTextResolutionMsg) Reset()         { *m = CreateTextResolutionMsg
 func (m *CreateTextResolutionMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateTextResolutionMsg) ProtoMessage()    {}
 func (*CreateTextResolutionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
 }
 func (m *CreateTextResolutionMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1454,7 @@ func (m *UpdateElectorateMsg) Reset()         { *m = UpdateElectorateMsg{} }
 func (m *UpdateElectorateMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateElectorateMsg) ProtoMessage()    {}
 func (*UpdateElectorateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{15}
 }
 func (m *UpdateElectorateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,13 +1524,25 @@ type UpdateElectionRuleMsg struct {
 	// The valid range for the threshold value is `0.5` to `1` (inclusive) which
 	// allows any value between half and all of the eligible voters.
 	Quorum *Fraction `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Duration in seconds of how long the execution of an accepted proposal is
+	// delayed. Zero value keeps the current delay of the rule.
+	ExecutionDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"execution_delay,omitempty"`
+	// When set, abstain votes are included in the turnout that is compared
+	// against the quorum. Not set keeps the current value of the rule.
+	AbstainCountsForQuorum bool `protobuf:"varint,7,opt,name=abstain_counts_for_quorum,json=abstainCountsForQuorum,proto3" json:"abstain_counts_for_quorum,omitempty"`
+	// Max title length is the greatest length, in bytes, of a proposal title.
+	// Zero value keeps the current limit of the rule.
+	MaxTitleLength uint32 `protobuf:"varint,8,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	// Max description length is the greatest length, in bytes, of a proposal
+	// description. Zero value keeps the current limit of the rule.
+	MaxDescriptionLength uint32 `protobuf:"varint,9,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
 }
 
 func (m *UpdateElectionRuleMsg) Reset()         { *m = UpdateElectionRuleMsg{} }
 func (m *UpdateElectionRuleMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateElectionRuleMsg) ProtoMessage()    {}
 func (*UpdateElectionRuleMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{16}
 }
 func (m *UpdateElectionRuleMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,6 +1606,34 @@ func (m *UpdateElectionRuleMsg) GetQuorum() *Fraction {
 	return nil
 }
 
+func (m *UpdateElectionRuleMsg) GetExecutionDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *UpdateElectionRuleMsg) GetAbstainCountsForQuorum() bool {
+	if m != nil {
+		return m.AbstainCountsForQuorum
+	}
+	return false
+}
+
+func (m *UpdateElectionRuleMsg) GetMaxTitleLength() uint32 {
+	if m != nil {
+		return m.MaxTitleLength
+	}
+	return 0
+}
+
+func (m *UpdateElectionRuleMsg) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gov.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("gov.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
@@ -1417,6 +1651,8 @@ func init() {
 	proto.RegisterType((*DeleteProposalMsg)(nil), "gov.DeleteProposalMsg")
 	proto.RegisterType((*VoteMsg)(nil), "gov.VoteMsg")
 	proto.RegisterType((*TallyMsg)(nil), "gov.TallyMsg")
+	proto.RegisterType((*ExecuteProposalMsg)(nil), "gov.ExecuteProposalMsg")
+	proto.RegisterType((*CancelProposalExecutionMsg)(nil), "gov.CancelProposalExecutionMsg")
 	proto.RegisterType((*CreateTextResolutionMsg)(nil), "gov.CreateTextResolutionMsg")
 	proto.RegisterType((*UpdateElectorateMsg)(nil), "gov.UpdateElectorateMsg")
 	proto.RegisterType((*UpdateElectionRuleMsg)(nil), "gov.UpdateElectionRuleMsg")
@@ -1425,104 +1661,119 @@ func init() {
 func init() { proto.RegisterFile("x/gov/codec.proto", fileDescriptor_24f6e3c5f1b82a85) }
 
 var fileDescriptor_24f6e3c5f1b82a85 = []byte{
-	// 1547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0xe3, 0xc8,
-	0x11, 0x36, 0x25, 0x59, 0x3f, 0xa5, 0x5f, 0xf7, 0xcc, 0xee, 0x68, 0xb5, 0x13, 0x8b, 0x61, 0x66,
-	0x02, 0x67, 0x33, 0x91, 0xb3, 0x5e, 0x6c, 0x02, 0x04, 0x8b, 0x20, 0xfa, 0xe1, 0x20, 0x5c, 0x78,
-	0x24, 0xa7, 0x49, 0xd9, 0xd9, 0x13, 0x41, 0x8b, 0x6d, 0x99, 0x59, 0x89, 0xed, 0x25, 0x9b, 0xb2,
-	0xf7, 0x98, 0x5b, 0x60, 0x20, 0x40, 0x90, 0xbb, 0x1f, 0x20, 0x48, 0x4e, 0x79, 0x8a, 0x39, 0x04,
-	0xc1, 0x1c, 0x93, 0x8b, 0x10, 0x78, 0xde, 0x62, 0x90, 0x43, 0xc0, 0x6e, 0x4a, 0xa2, 0x6d, 0x8d,
-	0x62, 0x25, 0xbb, 0xc0, 0xdc, 0xc4, 0xea, 0xaf, 0xaa, 0xab, 0xab, 0xbe, 0xae, 0xaa, 0x16, 0x6c,
-	0x5d, 0xec, 0x0e, 0xe9, 0x64, 0x77, 0x40, 0x6d, 0x32, 0x68, 0x9c, 0x79, 0x94, 0x51, 0x94, 0x1c,
-	0xd2, 0x49, 0x2d, 0x1f, 0x93, 0xd4, 0x1e, 0x0e, 0xe9, 0x90, 0xf2, 0x9f, 0xbb, 0xe1, 0xaf, 0x48,
-	0x5a, 0xa6, 0xde, 0x38, 0xae, 0xa8, 0xfc, 0x3e, 0x01, 0xa0, 0x8e, 0xc8, 0x80, 0x51, 0xcf, 0x62,
-	0x04, 0xfd, 0x10, 0xb2, 0x63, 0xc2, 0x2c, 0xdb, 0x62, 0x56, 0x55, 0x92, 0xa5, 0x9d, 0xfc, 0x5e,
-	0xb9, 0x71, 0x4e, 0xac, 0x09, 0x69, 0xbc, 0x88, 0xc4, 0x78, 0x0e, 0x40, 0x55, 0xc8, 0x4c, 0x88,
-	0xe7, 0x3b, 0xd4, 0xad, 0x26, 0x64, 0x69, 0xa7, 0x88, 0x67, 0x9f, 0xe8, 0x67, 0xb0, 0x69, 0xd9,
-	0x63, 0xc7, 0xad, 0x26, 0x65, 0x69, 0xa7, 0xd0, 0x7a, 0xf2, 0x66, 0x5a, 0x97, 0x87, 0x0e, 0x3b,
-	0x0d, 0x8e, 0x1b, 0x03, 0x3a, 0xde, 0x75, 0xe8, 0xe4, 0x47, 0xd4, 0x25, 0xbb, 0xc2, 0x72, 0xd3,
-	0xb6, 0x3d, 0xe2, 0xfb, 0x58, 0xa8, 0xa0, 0x87, 0xb0, 0xc9, 0x1c, 0x36, 0x22, 0xd5, 0x94, 0x2c,
-	0xed, 0xe4, 0xb0, 0xf8, 0x40, 0x0d, 0xc8, 0x12, 0xe1, 0xa6, 0x5f, 0xdd, 0x94, 0x93, 0x3b, 0xf9,
-	0xbd, 0x42, 0x63, 0x48, 0x27, 0x8d, 0xc8, 0xf7, 0x56, 0xea, 0xe5, 0xb4, 0xbe, 0x81, 0xe7, 0x18,
-	0xf4, 0x13, 0x78, 0xc4, 0x28, 0xb3, 0x46, 0x26, 0x99, 0x1f, 0xce, 0x3c, 0x27, 0xce, 0xf0, 0x94,
-	0x55, 0xd3, 0xb2, 0xb4, 0x93, 0xc2, 0xef, 0xf1, 0xe5, 0xc5, 0xd1, 0x8f, 0xf8, 0xa2, 0x62, 0x41,
-	0x26, 0x92, 0xa1, 0x9f, 0x43, 0xc6, 0x12, 0xae, 0xf1, 0x50, 0xdc, 0xf7, 0x18, 0x33, 0x25, 0xf4,
-	0x3e, 0xa4, 0xa3, 0x1d, 0x45, 0x74, 0xa2, 0x2f, 0xe5, 0x65, 0x12, 0x0a, 0x7c, 0x0f, 0x87, 0xba,
-	0x38, 0x18, 0xbd, 0x13, 0x41, 0xff, 0x14, 0x8a, 0xb1, 0x40, 0x39, 0x36, 0x0f, 0x7e, 0xa1, 0x55,
-	0xb9, 0x9e, 0xd6, 0x0b, 0x8b, 0x18, 0x69, 0x1d, 0x5c, 0x58, 0xc0, 0x34, 0x7b, 0x91, 0xab, 0xcd,
-	0x78, 0xae, 0xba, 0x50, 0x9c, 0x50, 0xe6, 0xb8, 0x43, 0xf3, 0x8c, 0x78, 0x0e, 0xb5, 0x79, 0xc4,
-	0x8b, 0xad, 0x1f, 0xbc, 0x99, 0xd6, 0x9f, 0xbe, 0xd5, 0xa1, 0xbe, 0xeb, 0x5c, 0x74, 0x02, 0xcf,
-	0xe2, 0x51, 0x29, 0x08, 0xfd, 0x03, 0xae, 0x8e, 0x3e, 0x86, 0x1c, 0x3b, 0xf5, 0x88, 0x7f, 0x4a,
-	0x47, 0x76, 0x35, 0xc3, 0x03, 0x54, 0xe4, 0xc9, 0x7f, 0xee, 0x59, 0x3c, 0x8a, 0x51, 0xf6, 0x17,
-	0x28, 0xf4, 0x14, 0xd2, 0x5f, 0x05, 0xd4, 0x0b, 0xc6, 0xd5, 0xec, 0x12, 0x3c, 0x8e, 0x16, 0xe3,
-	0x29, 0xce, 0xfd, 0x0f, 0x29, 0x56, 0x3e, 0x87, 0xec, 0xcc, 0x26, 0x7a, 0x0c, 0x39, 0x37, 0x18,
-	0x13, 0xcf, 0x62, 0xd4, 0xe3, 0x69, 0x2c, 0xe2, 0x85, 0x00, 0xc9, 0x90, 0xb7, 0x89, 0x4b, 0xc7,
-	0x8e, 0xcb, 0xd7, 0x45, 0xea, 0xe2, 0x22, 0xe5, 0xb7, 0x79, 0xc8, 0x1e, 0x78, 0xf4, 0x8c, 0xfa,
-	0xd6, 0x68, 0x3d, 0x4a, 0xcc, 0xb3, 0x90, 0x88, 0x67, 0xe1, 0x3b, 0x00, 0x9e, 0x75, 0x6e, 0xd2,
-	0xb3, 0xd0, 0x3b, 0xc1, 0x09, 0x9c, 0xf3, 0xac, 0xf3, 0x1e, 0x17, 0x08, 0x87, 0xfc, 0x81, 0xe7,
-	0x88, 0x75, 0x71, 0xd9, 0xe2, 0x22, 0xa4, 0xc2, 0x16, 0x89, 0x68, 0x6a, 0x7a, 0xc1, 0x88, 0x98,
-	0x1e, 0x39, 0xe1, 0x89, 0xce, 0xef, 0x3d, 0x68, 0x50, 0x6f, 0xdc, 0x38, 0x14, 0xc4, 0x23, 0xb6,
-	0xd6, 0xc1, 0xe4, 0x24, 0x4a, 0x42, 0x99, 0xc4, 0xa8, 0x8d, 0xc9, 0x09, 0xfa, 0x05, 0x94, 0x62,
-	0xd4, 0x0a, 0x6d, 0xa4, 0xff, 0x9b, 0x8d, 0x18, 0x17, 0x43, 0x0b, 0xbf, 0x82, 0xad, 0x88, 0x4f,
-	0x3e, 0xb3, 0x3c, 0x66, 0x32, 0x67, 0x4c, 0x38, 0x0f, 0x92, 0xad, 0xa7, 0x6f, 0xa6, 0xf5, 0xef,
-	0xae, 0xe4, 0x94, 0xe1, 0x8c, 0x09, 0x2e, 0x0b, 0x7d, 0x3d, 0x54, 0x0f, 0x05, 0xe8, 0x05, 0x44,
-	0x22, 0x93, 0xb8, 0xb6, 0x30, 0x98, 0x5d, 0xc7, 0x60, 0x44, 0x70, 0xd5, 0xb5, 0xb9, 0xb9, 0x2e,
-	0x94, 0xfd, 0xe0, 0x78, 0xec, 0xf8, 0xe1, 0x59, 0x84, 0xb9, 0xdc, 0x3a, 0xe6, 0x4a, 0x0b, 0x6d,
-	0x6e, 0xef, 0x33, 0x48, 0x5b, 0x01, 0x3b, 0xa5, 0x5e, 0x15, 0xd6, 0xa0, 0x65, 0xa4, 0x83, 0x3e,
-	0x05, 0x98, 0x50, 0x46, 0xc2, 0x68, 0x31, 0x52, 0xcd, 0xf3, 0x68, 0x57, 0xf8, 0x05, 0x30, 0xac,
-	0xd1, 0xe8, 0x6b, 0x4c, 0xfc, 0x60, 0xc4, 0x66, 0x77, 0x26, 0x44, 0xea, 0x21, 0x10, 0x3d, 0x83,
-	0x74, 0xa8, 0x11, 0xf8, 0xd5, 0x82, 0x2c, 0xed, 0x94, 0xf6, 0x1e, 0x72, 0x95, 0x19, 0x25, 0x1b,
-	0x3a, 0x5f, 0xc3, 0x11, 0x26, 0x44, 0x7b, 0xdc, 0x50, 0xb5, 0xb8, 0x0c, 0x2d, 0x36, 0xc1, 0x11,
-	0x06, 0xa9, 0x50, 0x26, 0x17, 0x64, 0x10, 0x30, 0xea, 0x99, 0x91, 0x5a, 0x89, 0xab, 0x3d, 0xbe,
-	0xa9, 0xa6, 0x46, 0xa0, 0x48, 0xbd, 0x44, 0x6e, 0x7c, 0xa3, 0x4f, 0xa0, 0xc8, 0xc2, 0x23, 0x98,
-	0xcc, 0xf2, 0xbf, 0x0c, 0xcb, 0x54, 0x99, 0x87, 0xa7, 0x7c, 0x3d, 0xad, 0xe7, 0xf9, 0xd9, 0x0c,
-	0xcb, 0xff, 0x52, 0xeb, 0xe0, 0x3c, 0x9b, 0x7f, 0xd8, 0xca, 0x9f, 0x24, 0x48, 0x0b, 0xe7, 0xd1,
-	0x87, 0xf0, 0xe8, 0x00, 0xf7, 0x0e, 0x7a, 0x7a, 0x73, 0xdf, 0xd4, 0x8d, 0xa6, 0xd1, 0xd7, 0x4d,
-	0xad, 0x7b, 0xd8, 0xdc, 0xd7, 0x3a, 0x95, 0x0d, 0xf4, 0x0c, 0x3e, 0xb8, 0xbd, 0xa8, 0xf7, 0x5b,
-	0x2f, 0x34, 0xc3, 0x50, 0x3b, 0x15, 0xa9, 0x56, 0xbc, 0xbc, 0x92, 0x73, 0x7a, 0x98, 0x27, 0xc6,
-	0x88, 0x8d, 0xbe, 0x0f, 0xef, 0xdf, 0x46, 0xb7, 0xf7, 0x7b, 0xba, 0xda, 0xa9, 0x24, 0x6a, 0x70,
-	0x79, 0x25, 0xa7, 0xdb, 0x23, 0xea, 0x13, 0x7b, 0x99, 0xd5, 0x23, 0xcd, 0xf8, 0x65, 0x07, 0x37,
-	0x8f, 0xba, 0x95, 0xa4, 0xb0, 0x7a, 0xe4, 0xb0, 0x53, 0xdb, 0xb3, 0xce, 0x5d, 0xe5, 0xcf, 0x12,
-	0xa4, 0xa3, 0xb3, 0xc6, 0x7d, 0xc5, 0xaa, 0xde, 0xdf, 0x37, 0xde, 0xe2, 0x6b, 0xb4, 0xd8, 0xef,
-	0x76, 0xd4, 0xe7, 0x5a, 0x77, 0xe1, 0x6b, 0xdf, 0xb5, 0xc9, 0x89, 0xe3, 0x12, 0x1b, 0x7d, 0x04,
-	0xd5, 0xdb, 0xe8, 0x66, 0xbb, 0xad, 0x1e, 0x18, 0xdc, 0xdb, 0xc2, 0xe5, 0x95, 0x9c, 0x6d, 0x0e,
-	0x06, 0xe4, 0x8c, 0x2d, 0xc7, 0x62, 0xf5, 0x73, 0xb5, 0x1d, 0x62, 0x93, 0x02, 0x8b, 0xc9, 0x6f,
-	0xc8, 0x80, 0x11, 0x5b, 0xf9, 0xbb, 0x04, 0xa5, 0x9b, 0x19, 0x43, 0x4f, 0x40, 0x9e, 0xab, 0xab,
-	0xbf, 0x56, 0xdb, 0x7d, 0xa3, 0x87, 0xef, 0xba, 0xff, 0xe3, 0x15, 0xa8, 0x6e, 0xcf, 0x30, 0x71,
-	0xbf, 0x5b, 0x91, 0x44, 0x18, 0xbb, 0x94, 0xe1, 0xc0, 0x45, 0x1f, 0xaf, 0xd0, 0xd0, 0xfb, 0xed,
-	0xb6, 0xaa, 0xeb, 0x95, 0x44, 0x2d, 0x7f, 0x79, 0x25, 0x67, 0xf4, 0x60, 0x30, 0x08, 0xfb, 0xef,
-	0x2a, 0x95, 0xe7, 0x4d, 0x6d, 0xbf, 0x8f, 0xd5, 0x4a, 0x52, 0xa8, 0x3c, 0xb7, 0x9c, 0x51, 0xe0,
-	0x11, 0xe5, 0x6f, 0x12, 0x00, 0x26, 0x3e, 0x1d, 0x05, 0xbc, 0x02, 0xae, 0x55, 0x85, 0x77, 0x21,
-	0x7f, 0x16, 0xd1, 0x38, 0x64, 0x66, 0x82, 0x33, 0xb3, 0x74, 0x3d, 0xad, 0xc3, 0x8c, 0xdd, 0x5a,
-	0x07, 0xc3, 0x0c, 0xa2, 0xd9, 0x4b, 0x0a, 0x63, 0x72, 0xcd, 0xc2, 0xb8, 0x0d, 0xe0, 0xcd, 0xbd,
-	0x8d, 0x4a, 0x78, 0x4c, 0xa2, 0xfc, 0x5b, 0x82, 0x7c, 0xec, 0xca, 0xa3, 0x0f, 0x21, 0x27, 0x86,
-	0xa2, 0xaf, 0x89, 0x98, 0x69, 0x52, 0x38, 0xcb, 0x05, 0x5f, 0x10, 0x1f, 0x7d, 0x00, 0xe2, 0xb7,
-	0xe9, 0x52, 0xee, 0x7c, 0x0a, 0x67, 0xf8, 0x77, 0x97, 0xa2, 0xef, 0x41, 0x51, 0x2c, 0x59, 0xc7,
-	0x3e, 0xb3, 0xa2, 0x09, 0x23, 0x85, 0x0b, 0x5c, 0xd8, 0x14, 0xb2, 0x55, 0x13, 0x57, 0x6a, 0xc5,
-	0xc4, 0x15, 0x6b, 0xd5, 0x9b, 0xab, 0x5a, 0xf5, 0x8d, 0x21, 0x20, 0x7d, 0x9f, 0x21, 0x40, 0xf9,
-	0x9d, 0x04, 0xa9, 0x43, 0xba, 0xee, 0x54, 0xfb, 0x0c, 0x32, 0xd1, 0x09, 0x78, 0x18, 0x96, 0x0f,
-	0x9a, 0x33, 0x08, 0x7a, 0x0a, 0x9b, 0x61, 0x05, 0xb5, 0x79, 0x48, 0x4a, 0x7b, 0x65, 0x8e, 0x0d,
-	0x37, 0x15, 0x6d, 0x16, 0x8b, 0x55, 0xe5, 0x9f, 0x09, 0xd8, 0x6a, 0x7b, 0xc4, 0x62, 0x64, 0x46,
-	0x86, 0x17, 0xfe, 0xf0, 0x9d, 0xe8, 0xf2, 0x9f, 0x41, 0xe5, 0x66, 0x97, 0x77, 0x6c, 0x9e, 0x88,
-	0x42, 0x0b, 0x5d, 0x4f, 0xeb, 0xa5, 0xf8, 0xa0, 0xaa, 0x75, 0x70, 0x29, 0xde, 0xdd, 0x35, 0x1b,
-	0x75, 0x00, 0x62, 0x3d, 0x39, 0xbd, 0x4e, 0xcf, 0xcb, 0xf9, 0xf3, 0x6e, 0xbc, 0x68, 0x77, 0x99,
-	0xf5, 0xdb, 0x9d, 0xf2, 0x15, 0x6c, 0x75, 0xc8, 0x88, 0xfc, 0x1f, 0xa1, 0x5d, 0xf7, 0xea, 0x2a,
-	0xaf, 0x24, 0xc8, 0x84, 0x49, 0xfe, 0xd6, 0x77, 0x0a, 0x87, 0xfa, 0x90, 0x41, 0xde, 0x7a, 0x43,
-	0x3d, 0x57, 0x09, 0x3d, 0xf3, 0x79, 0xbe, 0x88, 0x98, 0xe7, 0x97, 0xd0, 0x73, 0x0e, 0x50, 0x4e,
-	0x21, 0xcb, 0x4b, 0xc5, 0xb7, 0x1f, 0xbc, 0x13, 0x78, 0x24, 0xae, 0x82, 0x41, 0x2e, 0xd8, 0xa2,
-	0xda, 0xae, 0xbd, 0xf1, 0xcd, 0xea, 0x97, 0xb8, 0x53, 0xfd, 0xfe, 0x2a, 0xc1, 0x83, 0xfe, 0x99,
-	0x6d, 0x31, 0xb2, 0xa8, 0x39, 0x6b, 0x6f, 0x72, 0xe7, 0x61, 0x94, 0xb8, 0xd7, 0xc3, 0xe8, 0xa7,
-	0x50, 0xb4, 0x9d, 0x93, 0x13, 0x73, 0xfe, 0x66, 0x4d, 0xbe, 0xf5, 0xcd, 0x5a, 0x08, 0x81, 0x91,
-	0xc8, 0x57, 0xfe, 0x92, 0x80, 0xf7, 0x62, 0x4e, 0x47, 0x37, 0x6d, 0x6d, 0xb7, 0x97, 0xdd, 0xea,
-	0xc4, 0xbd, 0x6f, 0xf5, 0x9d, 0x07, 0x5c, 0xf2, 0x1b, 0x7c, 0xc0, 0xa5, 0xd6, 0x7c, 0xc0, 0xad,
-	0xea, 0x0a, 0x1f, 0xfd, 0x51, 0x02, 0x58, 0xd0, 0x19, 0x3d, 0x81, 0x07, 0x87, 0x3d, 0x43, 0x35,
-	0x7b, 0x07, 0x86, 0xd6, 0xeb, 0x2e, 0x06, 0x0e, 0xd1, 0xe5, 0x35, 0x77, 0x62, 0x8d, 0x1c, 0x1b,
-	0x3d, 0x86, 0x72, 0x1c, 0xf5, 0x85, 0xaa, 0x57, 0xa4, 0x5a, 0xe6, 0xf2, 0x4a, 0x4e, 0x86, 0x7d,
-	0xb0, 0x06, 0xa5, 0xf8, 0x6a, 0xb7, 0x57, 0x49, 0xd4, 0xd2, 0x97, 0x57, 0x72, 0xa2, 0x4b, 0x6f,
-	0xdb, 0x6f, 0xb6, 0x74, 0xa3, 0xa9, 0x75, 0x67, 0x53, 0x44, 0xd4, 0x09, 0x5b, 0xd5, 0x97, 0xd7,
-	0xdb, 0xd2, 0xab, 0xeb, 0x6d, 0xe9, 0x5f, 0xd7, 0xdb, 0xd2, 0x1f, 0x5e, 0x6f, 0x6f, 0xbc, 0x7a,
-	0xbd, 0xbd, 0xf1, 0x8f, 0xd7, 0xdb, 0x1b, 0xc7, 0x69, 0xfe, 0xa7, 0xcb, 0x27, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x57, 0xd4, 0x04, 0x1d, 0xc2, 0x11, 0x00, 0x00,
+	// 1791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xf7, 0x48, 0xb2, 0x3e, 0x9e, 0x3e, 0xdd, 0xf1, 0x66, 0x15, 0x6d, 0xb0, 0xb4, 0x22, 0xa1,
+	0xcc, 0x12, 0x64, 0xd6, 0xcb, 0x42, 0x01, 0x5b, 0x2c, 0xfa, 0x18, 0xc3, 0x6c, 0x39, 0x92, 0xb7,
+	0x25, 0x25, 0xec, 0x69, 0x6a, 0xa2, 0x69, 0xc9, 0xc3, 0x8e, 0xa6, 0xbd, 0x33, 0x2d, 0xd9, 0xe1,
+	0x2f, 0xa0, 0x5c, 0x45, 0x15, 0xc5, 0x95, 0xf2, 0x5f, 0xc0, 0x8d, 0x0b, 0x27, 0xee, 0x7b, 0x00,
+	0x2a, 0x47, 0xb8, 0xa8, 0x28, 0xa7, 0x8a, 0x3f, 0x22, 0x27, 0x6a, 0xba, 0x7b, 0xa4, 0xb1, 0xa3,
+	0x98, 0x0c, 0x10, 0x6a, 0x6f, 0x33, 0xef, 0xfd, 0xde, 0x9b, 0xf7, 0xdd, 0x6f, 0x1a, 0xb6, 0xce,
+	0xf6, 0x26, 0x74, 0xbe, 0x37, 0xa2, 0x26, 0x19, 0x35, 0x4e, 0x5c, 0xca, 0x28, 0x8a, 0x4f, 0xe8,
+	0xbc, 0x92, 0x0d, 0x51, 0x2a, 0xdb, 0x13, 0x3a, 0xa1, 0xfc, 0x71, 0xcf, 0x7f, 0x92, 0xd4, 0x22,
+	0x75, 0xa7, 0x61, 0xc1, 0xfa, 0xaf, 0x63, 0x00, 0xaa, 0x4d, 0x46, 0x8c, 0xba, 0x06, 0x23, 0xe8,
+	0x5b, 0x90, 0x9e, 0x12, 0x66, 0x98, 0x06, 0x33, 0xca, 0x4a, 0x4d, 0xd9, 0xcd, 0xee, 0x17, 0x1b,
+	0xa7, 0xc4, 0x98, 0x93, 0xc6, 0x43, 0x49, 0xc6, 0x4b, 0x00, 0x2a, 0x43, 0x6a, 0x4e, 0x5c, 0xcf,
+	0xa2, 0x4e, 0x39, 0x56, 0x53, 0x76, 0xf3, 0x38, 0x78, 0x45, 0x3f, 0x84, 0x4d, 0xc3, 0x9c, 0x5a,
+	0x4e, 0x39, 0x5e, 0x53, 0x76, 0x73, 0xad, 0x7b, 0x2f, 0x16, 0xd5, 0xda, 0xc4, 0x62, 0xc7, 0xb3,
+	0x27, 0x8d, 0x11, 0x9d, 0xee, 0x59, 0x74, 0xfe, 0x6d, 0xea, 0x90, 0x3d, 0xa1, 0xb9, 0x69, 0x9a,
+	0x2e, 0xf1, 0x3c, 0x2c, 0x44, 0xd0, 0x36, 0x6c, 0x32, 0x8b, 0xd9, 0xa4, 0x9c, 0xa8, 0x29, 0xbb,
+	0x19, 0x2c, 0x5e, 0x50, 0x03, 0xd2, 0x44, 0x98, 0xe9, 0x95, 0x37, 0x6b, 0xf1, 0xdd, 0xec, 0x7e,
+	0xae, 0x31, 0xa1, 0xf3, 0x86, 0xb4, 0xbd, 0x95, 0xf8, 0x72, 0x51, 0xdd, 0xc0, 0x4b, 0x0c, 0xfa,
+	0x1e, 0xbc, 0xcd, 0x28, 0x33, 0x6c, 0x9d, 0x2c, 0x9d, 0xd3, 0x4f, 0x89, 0x35, 0x39, 0x66, 0xe5,
+	0x64, 0x4d, 0xd9, 0x4d, 0xe0, 0xb7, 0x38, 0x7b, 0xe5, 0xfa, 0x63, 0xce, 0xac, 0x1b, 0x90, 0x92,
+	0x34, 0xf4, 0x63, 0x48, 0x19, 0xc2, 0xb4, 0xb2, 0x12, 0xc1, 0x8d, 0x40, 0x08, 0xdd, 0x86, 0xa4,
+	0xfc, 0xa2, 0x88, 0x8e, 0x7c, 0xab, 0xff, 0x71, 0x13, 0x72, 0xfc, 0x1b, 0x16, 0x75, 0xf0, 0xcc,
+	0xfe, 0x4a, 0x04, 0xfd, 0x43, 0xc8, 0x87, 0x02, 0x65, 0x99, 0x3c, 0xf8, 0xb9, 0x56, 0xe9, 0x72,
+	0x51, 0xcd, 0xad, 0x62, 0xa4, 0x75, 0x70, 0x6e, 0x05, 0xd3, 0xcc, 0x55, 0xae, 0x36, 0xc3, 0xb9,
+	0xea, 0x42, 0x7e, 0x4e, 0x99, 0xe5, 0x4c, 0xf4, 0x13, 0xe2, 0x5a, 0xd4, 0xe4, 0x11, 0xcf, 0xb7,
+	0xbe, 0xf9, 0x62, 0x51, 0xbd, 0xff, 0x4a, 0x83, 0x86, 0x8e, 0x75, 0xd6, 0x99, 0xb9, 0x06, 0x8f,
+	0x4a, 0x4e, 0xc8, 0x1f, 0x71, 0x71, 0xf4, 0x3e, 0x64, 0xd8, 0xb1, 0x4b, 0xbc, 0x63, 0x6a, 0x9b,
+	0xe5, 0x14, 0x0f, 0x50, 0x9e, 0x27, 0xff, 0xc0, 0x35, 0x78, 0x14, 0x65, 0xf6, 0x57, 0x28, 0x74,
+	0x1f, 0x92, 0x5f, 0xcc, 0xa8, 0x3b, 0x9b, 0x96, 0xd3, 0x6b, 0xf0, 0x58, 0x32, 0xc3, 0x29, 0xce,
+	0xfc, 0x27, 0x29, 0xc6, 0x50, 0x24, 0x67, 0x64, 0x34, 0xf3, 0x95, 0xea, 0x26, 0xb1, 0x8d, 0xa7,
+	0x65, 0x88, 0xea, 0x6b, 0x61, 0xa9, 0xa1, 0xe3, 0x2b, 0x40, 0x3f, 0x80, 0x3b, 0xc6, 0x13, 0x8f,
+	0x19, 0x96, 0xa3, 0x8f, 0xe8, 0xcc, 0x61, 0x9e, 0x3e, 0xa6, 0xae, 0x2e, 0xbd, 0xc9, 0xd6, 0x94,
+	0xdd, 0x34, 0xbe, 0x2d, 0x01, 0x6d, 0xce, 0x3f, 0xa0, 0xee, 0xa7, 0xc2, 0x9d, 0x5d, 0x28, 0x4d,
+	0x8d, 0x33, 0x9d, 0x67, 0x41, 0xb7, 0x89, 0x33, 0x61, 0xc7, 0xe5, 0x1c, 0x2f, 0x92, 0xc2, 0xd4,
+	0x38, 0x1b, 0xf8, 0xe4, 0x43, 0x4e, 0x45, 0xdf, 0x85, 0xdb, 0x3e, 0xd2, 0x24, 0xde, 0xc8, 0xb5,
+	0x4e, 0xb8, 0xf9, 0x12, 0x9f, 0xe7, 0xf8, 0xed, 0xa9, 0x71, 0xd6, 0x59, 0x31, 0x85, 0x54, 0xfd,
+	0x13, 0x48, 0x07, 0x21, 0x44, 0x77, 0x21, 0xe3, 0xcc, 0xa6, 0xc4, 0x35, 0x18, 0x75, 0x79, 0xd5,
+	0xe6, 0xf1, 0x8a, 0x80, 0x6a, 0x90, 0x35, 0x89, 0x43, 0xa7, 0x96, 0xc3, 0xf9, 0xa2, 0x52, 0xc3,
+	0xa4, 0xfa, 0x5f, 0x72, 0x90, 0x3e, 0x72, 0xe9, 0x09, 0xf5, 0x0c, 0x3b, 0x5a, 0x07, 0x2c, 0x8b,
+	0x2e, 0x16, 0x2e, 0xba, 0xaf, 0x01, 0xb8, 0xc6, 0xa9, 0x4e, 0xb9, 0xbd, 0xa2, 0x05, 0x70, 0xc6,
+	0x35, 0x4e, 0x7b, 0x9c, 0x20, 0x0c, 0x5a, 0xfa, 0x23, 0x67, 0x4b, 0x98, 0x84, 0x54, 0xd8, 0x22,
+	0xb2, 0x2b, 0x75, 0x77, 0x66, 0x13, 0xdd, 0x25, 0x63, 0x5e, 0xd7, 0xd9, 0xfd, 0x5b, 0x0d, 0xea,
+	0x4e, 0x1b, 0x8f, 0x44, 0x9f, 0x11, 0x53, 0xeb, 0x60, 0x32, 0x96, 0x35, 0x57, 0x24, 0xa1, 0x4e,
+	0xc6, 0x64, 0x8c, 0x7e, 0x02, 0x85, 0x50, 0x27, 0xf9, 0x3a, 0x92, 0xff, 0x4e, 0x47, 0xa8, 0xf5,
+	0x7c, 0x0d, 0x9f, 0xc2, 0x96, 0x6c, 0x1f, 0x8f, 0x19, 0x2e, 0xd3, 0x99, 0x35, 0x25, 0xbc, 0xec,
+	0xe3, 0xad, 0xfb, 0x2f, 0x16, 0xd5, 0x77, 0x6f, 0x2c, 0xab, 0x81, 0x35, 0x25, 0xb8, 0x28, 0xe4,
+	0xfb, 0xbe, 0xb8, 0x4f, 0x40, 0x0f, 0x41, 0x92, 0x74, 0xe2, 0x98, 0x42, 0x61, 0x3a, 0x8a, 0x42,
+	0xd9, 0xcf, 0xaa, 0x63, 0x72, 0x75, 0x5d, 0x28, 0x7a, 0xb3, 0x27, 0x53, 0xcb, 0xf3, 0x7d, 0x11,
+	0xea, 0x32, 0x51, 0xd4, 0x15, 0x56, 0xd2, 0x5c, 0xdf, 0x47, 0x90, 0x34, 0x66, 0xec, 0x98, 0xba,
+	0x65, 0x88, 0xd0, 0x85, 0x52, 0x06, 0x7d, 0x08, 0x30, 0xa7, 0x8c, 0xf8, 0xd1, 0x62, 0x84, 0x77,
+	0x48, 0x76, 0xbf, 0xc4, 0xfb, 0x7d, 0x60, 0xd8, 0xf6, 0x53, 0x4c, 0xbc, 0x99, 0xcd, 0x82, 0x11,
+	0xe1, 0x23, 0xfb, 0x3e, 0x10, 0x3d, 0x80, 0xa4, 0x2f, 0x31, 0xf3, 0x78, 0x8b, 0x14, 0xf6, 0xb7,
+	0xb9, 0x48, 0x50, 0x92, 0x8d, 0x3e, 0xe7, 0x61, 0x89, 0xf1, 0xd1, 0x2e, 0x57, 0x54, 0xce, 0xaf,
+	0x43, 0x8b, 0x8f, 0x60, 0x89, 0x41, 0x6a, 0x30, 0x17, 0xa8, 0xab, 0x4b, 0xb1, 0x02, 0x17, 0xbb,
+	0x7b, 0x55, 0x4c, 0x95, 0x20, 0x29, 0x5e, 0x20, 0x57, 0xde, 0xd1, 0x07, 0x90, 0x67, 0xbe, 0x0b,
+	0x3a, 0x33, 0xbc, 0xcf, 0xfd, 0xa9, 0x5c, 0xe4, 0xe1, 0x29, 0x5e, 0x2e, 0xaa, 0x59, 0xee, 0xdb,
+	0xc0, 0xf0, 0x3e, 0xd7, 0x3a, 0x38, 0xcb, 0x96, 0x2f, 0x26, 0xfa, 0x18, 0xb6, 0x56, 0x33, 0x29,
+	0x10, 0x2c, 0x71, 0xc1, 0x5b, 0x97, 0x8b, 0x6a, 0x51, 0x0d, 0x98, 0x52, 0xb8, 0x48, 0xae, 0x10,
+	0x4c, 0x74, 0x08, 0x85, 0x90, 0x02, 0x3f, 0xb9, 0x5b, 0x91, 0x6a, 0x65, 0xa5, 0xcf, 0x9a, 0x92,
+	0xfa, 0x3f, 0x15, 0x48, 0x8a, 0x58, 0xa2, 0x77, 0xe0, 0xed, 0x23, 0xdc, 0x3b, 0xea, 0xf5, 0x9b,
+	0x87, 0x7a, 0x7f, 0xd0, 0x1c, 0x0c, 0xfb, 0xba, 0xd6, 0x7d, 0xd4, 0x3c, 0xd4, 0x3a, 0xa5, 0x0d,
+	0xf4, 0x00, 0xee, 0x5c, 0x67, 0xf6, 0x87, 0xad, 0x87, 0xda, 0x60, 0xa0, 0x76, 0x4a, 0x4a, 0x25,
+	0x7f, 0x7e, 0x51, 0xcb, 0xf4, 0xfd, 0xb2, 0x61, 0x8c, 0x98, 0xe8, 0x1b, 0x70, 0xfb, 0x3a, 0xba,
+	0x7d, 0xd8, 0xeb, 0xab, 0x9d, 0x52, 0xac, 0x02, 0xe7, 0x17, 0xb5, 0x64, 0xdb, 0xa6, 0x1e, 0x31,
+	0xd7, 0x69, 0x7d, 0xac, 0x0d, 0x7e, 0xd6, 0xc1, 0xcd, 0xc7, 0xdd, 0x52, 0x5c, 0x68, 0x7d, 0x6c,
+	0xb1, 0x63, 0xd3, 0x35, 0x4e, 0x1d, 0xf4, 0x23, 0x78, 0xf7, 0x3a, 0xfa, 0x48, 0xed, 0x76, 0xb4,
+	0xee, 0x4f, 0x75, 0xf5, 0xe7, 0x6a, 0x7b, 0x38, 0xd0, 0x7a, 0xdd, 0x52, 0xa2, 0xb2, 0x7d, 0x7e,
+	0x51, 0x2b, 0x1d, 0x11, 0xc7, 0xf4, 0x5b, 0x22, 0x70, 0xb6, 0xfe, 0x7b, 0x05, 0x92, 0x32, 0x6f,
+	0x61, 0x47, 0xb1, 0xda, 0x1f, 0x1e, 0x0e, 0x5e, 0xe1, 0xa8, 0x64, 0x0e, 0xbb, 0x1d, 0xf5, 0x40,
+	0xeb, 0xae, 0x1c, 0x1d, 0x3a, 0x26, 0x19, 0x5b, 0x0e, 0x31, 0xd1, 0x7b, 0x50, 0xbe, 0x8e, 0x6e,
+	0xb6, 0xdb, 0xea, 0xd1, 0x80, 0xbb, 0x9a, 0x3b, 0xbf, 0xa8, 0xa5, 0x9b, 0xa3, 0x11, 0x39, 0x61,
+	0xeb, 0xb1, 0x58, 0xfd, 0x44, 0x6d, 0xfb, 0xd8, 0xb8, 0xc0, 0x62, 0xf2, 0x0b, 0x32, 0x62, 0xc4,
+	0xac, 0xff, 0x55, 0x81, 0xc2, 0xd5, 0xea, 0x43, 0xf7, 0xa0, 0xb6, 0x14, 0x17, 0xee, 0xf6, 0xf0,
+	0xcb, 0xe6, 0x7f, 0xe7, 0x06, 0x54, 0xb7, 0x37, 0xd0, 0xf1, 0xb0, 0x5b, 0x52, 0x44, 0x0e, 0xba,
+	0x94, 0xe1, 0x99, 0x83, 0xde, 0xbf, 0x41, 0xa2, 0x3f, 0x6c, 0xb7, 0xd5, 0x7e, 0xbf, 0x14, 0xab,
+	0x64, 0xcf, 0x2f, 0x6a, 0xa9, 0xfe, 0x6c, 0x34, 0xf2, 0xcf, 0xd5, 0x9b, 0x44, 0x0e, 0x9a, 0xda,
+	0xe1, 0x10, 0xab, 0xa5, 0xb8, 0x10, 0x39, 0x30, 0x2c, 0x7b, 0xe6, 0x92, 0xfa, 0x9f, 0x15, 0x00,
+	0x4c, 0x3c, 0x6a, 0xf3, 0x6c, 0x44, 0x3b, 0x51, 0xf6, 0x20, 0x7b, 0x22, 0x5b, 0xd2, 0x6f, 0x96,
+	0x18, 0x6f, 0x96, 0xc2, 0xe5, 0xa2, 0x0a, 0x41, 0xa7, 0x6a, 0x1d, 0x0c, 0x01, 0x44, 0x33, 0xd7,
+	0x0c, 0xf9, 0x78, 0xc4, 0x21, 0xbf, 0x03, 0xe0, 0x2e, 0xad, 0x95, 0xc7, 0x51, 0x88, 0x52, 0xff,
+	0x53, 0x0c, 0xb2, 0xa1, 0xf1, 0x85, 0xde, 0x81, 0x8c, 0xd8, 0x67, 0x9f, 0x12, 0xb1, 0x8e, 0x26,
+	0x70, 0x9a, 0x13, 0x3e, 0x23, 0x1e, 0xba, 0x03, 0xe2, 0x59, 0x77, 0x28, 0x37, 0x3e, 0x81, 0x53,
+	0xfc, 0xbd, 0x4b, 0xd1, 0xd7, 0x21, 0x2f, 0x58, 0x72, 0x65, 0xe0, 0x86, 0x26, 0x70, 0x8e, 0x13,
+	0x9b, 0x82, 0x76, 0xd3, 0xb2, 0x9c, 0xb8, 0x61, 0x59, 0x0e, 0x6d, 0x59, 0x9b, 0x37, 0x6d, 0x59,
+	0x57, 0xf6, 0xb7, 0xe4, 0x6b, 0xed, 0x6f, 0x1f, 0xc3, 0xdd, 0x60, 0x09, 0x22, 0x67, 0x23, 0x7b,
+	0x66, 0x12, 0x53, 0x1f, 0xbb, 0x74, 0x1a, 0xec, 0x41, 0x29, 0xbe, 0x07, 0x05, 0x8b, 0x92, 0x2a,
+	0x21, 0x07, 0x2e, 0x9d, 0x8a, 0x55, 0xa8, 0xfe, 0x2b, 0x05, 0x12, 0x8f, 0x68, 0xd4, 0x3f, 0x9a,
+	0x07, 0x90, 0x92, 0x21, 0xe0, 0x71, 0x5c, 0xff, 0x93, 0x11, 0x40, 0xd0, 0x7d, 0xd8, 0xf4, 0x8f,
+	0x13, 0x93, 0xc7, 0xb4, 0xb0, 0x5f, 0xe4, 0x58, 0xff, 0xa3, 0x62, 0xe7, 0xc0, 0x82, 0x5b, 0xff,
+	0x7b, 0x0c, 0xb6, 0xda, 0x2e, 0x31, 0x18, 0x09, 0xaa, 0xe9, 0xa1, 0x37, 0xf9, 0x4a, 0xac, 0x3c,
+	0x1f, 0x41, 0xe9, 0xea, 0xca, 0x63, 0x99, 0x3c, 0x93, 0xb9, 0x16, 0xba, 0x5c, 0x54, 0x0b, 0xe1,
+	0x9f, 0x14, 0xad, 0x83, 0x0b, 0xe1, 0x55, 0x47, 0x33, 0x51, 0x07, 0x20, 0xb4, 0xa0, 0x24, 0xa3,
+	0x9c, 0x11, 0x19, 0x6f, 0xb9, 0x9a, 0xac, 0xce, 0xfe, 0x54, 0xf4, 0xb3, 0xbf, 0xfe, 0x05, 0x6c,
+	0x75, 0x88, 0x4d, 0xfe, 0x8b, 0xd0, 0x46, 0xed, 0xfd, 0xfa, 0x33, 0x05, 0x52, 0x7e, 0x92, 0xdf,
+	0xf8, 0x97, 0xfc, 0x1f, 0x3a, 0xbf, 0x82, 0xdc, 0x68, 0x3f, 0x74, 0x5c, 0xc4, 0xb7, 0xcc, 0xe3,
+	0xf9, 0x22, 0xe2, 0x5f, 0x6e, 0x4d, 0x79, 0x2e, 0x01, 0xf5, 0x63, 0x48, 0xf3, 0x59, 0xf3, 0xe6,
+	0x83, 0xe7, 0x02, 0x12, 0xa7, 0xce, 0xff, 0x31, 0x61, 0xbf, 0x84, 0x4a, 0xdb, 0x70, 0x46, 0xc4,
+	0x0e, 0xf8, 0xcb, 0x33, 0xfb, 0xcd, 0x7f, 0x7b, 0x0c, 0x6f, 0x8b, 0xd6, 0x1f, 0x90, 0x33, 0xb6,
+	0x3a, 0x9e, 0x22, 0x7f, 0xf8, 0xea, 0x71, 0x11, 0x7b, 0xe9, 0xb8, 0xf8, 0x83, 0x02, 0xb7, 0x86,
+	0x27, 0xa6, 0xc1, 0xc8, 0x6a, 0x48, 0x47, 0xfe, 0xc8, 0x4b, 0x97, 0x00, 0xb1, 0xd7, 0xba, 0x04,
+	0xf8, 0x3e, 0xe4, 0x4d, 0x6b, 0x3c, 0xd6, 0x97, 0xf7, 0x33, 0xf1, 0x57, 0xde, 0xcf, 0xe4, 0x7c,
+	0xa0, 0x24, 0x79, 0xf5, 0xdf, 0x25, 0xe0, 0xad, 0x90, 0xd1, 0x72, 0xb2, 0x44, 0x36, 0x7b, 0xdd,
+	0x14, 0x8b, 0xbd, 0xf6, 0x14, 0x7b, 0xe9, 0xb2, 0x22, 0xfe, 0x3f, 0xbc, 0xac, 0x48, 0x44, 0xbc,
+	0xac, 0xb8, 0xf1, 0x18, 0x5d, 0x73, 0xd9, 0x90, 0x7c, 0xa3, 0x97, 0x0d, 0xa9, 0xc8, 0x97, 0x0d,
+	0xe9, 0x88, 0x97, 0x0d, 0x99, 0x57, 0x5f, 0x36, 0xbc, 0xf7, 0x5b, 0x05, 0x60, 0x35, 0xad, 0xd0,
+	0x3d, 0xb8, 0xf5, 0xa8, 0x37, 0x50, 0xf5, 0xde, 0x91, 0xbf, 0x85, 0xaf, 0x16, 0x52, 0xb1, 0x05,
+	0x6a, 0xce, 0xdc, 0xb0, 0x2d, 0x13, 0xdd, 0x85, 0x62, 0x18, 0xf5, 0x99, 0xda, 0x2f, 0x29, 0x95,
+	0xd4, 0xf9, 0x45, 0x2d, 0xee, 0xef, 0x49, 0x15, 0x28, 0x84, 0xb9, 0xdd, 0x5e, 0x29, 0x56, 0x49,
+	0x9e, 0x5f, 0xd4, 0x62, 0x5d, 0x7a, 0x5d, 0x7f, 0xb3, 0xd5, 0x1f, 0x34, 0xb5, 0x6e, 0xb0, 0x65,
+	0xca, 0x4d, 0xa9, 0x55, 0xfe, 0xf2, 0x72, 0x47, 0x79, 0x76, 0xb9, 0xa3, 0xfc, 0xe3, 0x72, 0x47,
+	0xf9, 0xcd, 0xf3, 0x9d, 0x8d, 0x67, 0xcf, 0x77, 0x36, 0xfe, 0xf6, 0x7c, 0x67, 0xe3, 0x49, 0x92,
+	0xdf, 0xa7, 0x7e, 0xf0, 0xaf, 0x01, 0x00, 0xc9, 0x3f, 0x85, 0xeb, 0x9d, 0x15, 0x00, 0x00,
 }
 
 func (m *Electorate) Marshal() (dAtA []byte, err error) {
@@ -1693,6 +1944,31 @@ func (m *ElectionRule) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.ExecutionDelay != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionDelay))
+	}
+	if m.AbstainCountsForQuorum {
+		dAtA[i] = 0x58
+		i++
+		if m.AbstainCountsForQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxTitleLength != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxDescriptionLength))
+	}
 	return i, nil
 }
 
@@ -1833,6 +2109,21 @@ func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.TallyTaskID)))
 		i += copy(dAtA[i:], m.TallyTaskID)
 	}
+	if len(m.ExecutionTaskID) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExecutionTaskID)))
+		i += copy(dAtA[i:], m.ExecutionTaskID)
+	}
+	if m.ExecutionTime != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionTime))
+	}
 	return i, nil
 }
 
@@ -1937,6 +2228,16 @@ func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n12
+	if m.AbstainExcludedFromQuorum {
+		dAtA[i] = 0x38
+		i++
+		if m.AbstainExcludedFromQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -2157,7 +2458,7 @@ func (m *TallyMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
+func (m *ExecuteProposalMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2167,7 +2468,7 @@ func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2182,6 +2483,74 @@ func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n19
 	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	return i, nil
+}
+
+func (m *CancelProposalExecutionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelProposalExecutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	return i, nil
+}
+
+func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
 	if len(m.Resolution) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2210,11 +2579,11 @@ func (m *UpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	if len(m.ElectorateID) > 0 {
 		dAtA[i] = 0x12
@@ -2256,11 +2625,11 @@ func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		n23, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	if len(m.ElectionRuleID) > 0 {
 		dAtA[i] = 0x12
@@ -2276,20 +2645,45 @@ func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n22, err := m.Threshold.MarshalTo(dAtA[i:])
+	n24, err := m.Threshold.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n24
 	if m.Quorum != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n23, err := m.Quorum.MarshalTo(dAtA[i:])
+		n25, err := m.Quorum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
+	}
+	if m.ExecutionDelay != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutionDelay))
+	}
+	if m.AbstainCountsForQuorum {
+		dAtA[i] = 0x38
+		i++
+		if m.AbstainCountsForQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxTitleLength != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxDescriptionLength))
 	}
 	return i, nil
 }
@@ -2390,6 +2784,18 @@ func (m *ElectionRule) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovCodec(uint64(m.ExecutionDelay))
+	}
+	if m.AbstainCountsForQuorum {
+		n += 2
+	}
+	if m.MaxTitleLength != 0 {
+		n += 1 + sovCodec(uint64(m.MaxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovCodec(uint64(m.MaxDescriptionLength))
+	}
 	return n
 }
 
@@ -2462,6 +2868,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.ExecutionTaskID)
+	if l > 0 {
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	if m.ExecutionTime != 0 {
+		n += 2 + sovCodec(uint64(m.ExecutionTime))
+	}
 	return n
 }
 
@@ -2512,6 +2925,9 @@ func (m *TallyResult) Size() (n int) {
 	}
 	l = m.Threshold.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	if m.AbstainExcludedFromQuorum {
+		n += 2
+	}
 	return n
 }
 
@@ -2627,7 +3043,7 @@ func (m *TallyMsg) Size() (n int) {
 	return n
 }
 
-func (m *CreateTextResolutionMsg) Size() (n int) {
+func (m *ExecuteProposalMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2637,14 +3053,14 @@ func (m *CreateTextResolutionMsg) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
-	l = len(m.Resolution)
+	l = len(m.ProposalID)
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
 	return n
 }
 
-func (m *UpdateElectorateMsg) Size() (n int) {
+func (m *CancelProposalExecutionMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2654,13 +3070,47 @@ func (m *UpdateElectorateMsg) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
-	l = len(m.ElectorateID)
+	l = len(m.ProposalID)
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
-	if len(m.DiffElectors) > 0 {
-		for _, e := range m.DiffElectors {
-			l = e.Size()
+	return n
+}
+
+func (m *CreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.DiffElectors) > 0 {
+		for _, e := range m.DiffElectors {
+			l = e.Size()
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
@@ -2690,6 +3140,18 @@ func (m *UpdateElectionRuleMsg) Size() (n int) {
 		l = m.Quorum.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovCodec(uint64(m.ExecutionDelay))
+	}
+	if m.AbstainCountsForQuorum {
+		n += 2
+	}
+	if m.MaxTitleLength != 0 {
+		n += 1 + sovCodec(uint64(m.MaxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovCodec(uint64(m.MaxDescriptionLength))
+	}
 	return n
 }
 
@@ -3345,6 +3807,83 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCountsForQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbstainCountsForQuorum = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleLength", wireType)
+			}
+			m.MaxTitleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3904,6 +4443,59 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.TallyTaskID = []byte{}
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionTaskID = append(m.ExecutionTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionTaskID == nil {
+				m.ExecutionTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			m.ExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4290,6 +4882,26 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainExcludedFromQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbstainExcludedFromQuorum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5151,6 +5763,252 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecuteProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelProposalExecutionMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelProposalExecutionMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelProposalExecutionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5616,6 +6474,83 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCountsForQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbstainCountsForQuorum = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleLength", wireType)
+			}
+			m.MaxTitleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
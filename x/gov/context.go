@@ -30,6 +30,26 @@ func ElectionCondition(ruleID []byte) weave.Condition {
 	return weave.NewCondition("gov", "rule", ruleID)
 }
 
+func withTreasury(ctx weave.Context, electorateID []byte) weave.Context {
+	val, _ := ctx.Value(contextKeyGov).([]weave.Condition)
+	return context.WithValue(ctx, contextKeyGov, append(val, TreasuryCondition(electorateID)))
+}
+
+// TreasuryCondition returns the condition of the treasury account controlled
+// by the electorate with given ID. This condition is granted only to the
+// messages executed as a result of an accepted proposal of that electorate.
+func TreasuryCondition(electorateID []byte) weave.Condition {
+	return weave.NewCondition("gov", "treasury", electorateID)
+}
+
+// TreasuryAddress returns the address of the treasury account controlled by
+// the electorate with given ID. Funds of this account can be moved only by an
+// accepted proposal. Use it to fund the treasury, for example in the genesis
+// file.
+func TreasuryAddress(electorateID []byte) weave.Address {
+	return TreasuryCondition(electorateID).Address()
+}
+
 // Authenticate gets/sets permissions on the given context key.
 type Authenticate struct {
 }
@@ -18,6 +18,7 @@ const (
 	updateElectorateCost   = 0
 	updateElectionRuleCost = 0
 	textResolutionCost     = 0
+	cancelExecutionCost    = 0
 )
 
 const packageName = "gov"
@@ -27,7 +28,9 @@ func RegisterQuery(qr weave.QueryRouter) {
 	NewElectionRulesBucket().Register("electionrules", qr)
 	NewElectorateBucket().Register("electorates", qr)
 	NewProposalBucket().Register("proposals", qr)
-	NewVoteBucket().Register("votes", qr)
+	votes := NewVoteBucket()
+	votes.Register("votes", qr)
+	qr.Register("/votes/proposal", &proposalVotesQuery{votes: votes})
 }
 
 // RegisterRoutes registers handlers for governance message processing.
@@ -52,16 +55,19 @@ func RegisterCronRoutes(
 	auth x.Authenticator,
 	decoder OptionDecoder,
 	executor Executor,
+	scheduler weave.Scheduler,
 ) {
-	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor))
+	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor, scheduler))
+	r.Handle(&ExecuteProposalMsg{}, newExecuteProposalHandler(auth, decoder, executor))
 }
 
 // RegisterBasicProposalRouters register the routes we accept for executing governance decisions.
-func RegisterBasicProposalRouters(r weave.Registry, auth x.Authenticator) {
+func RegisterBasicProposalRouters(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry(packageName, r)
 	r.Handle(&UpdateElectorateMsg{}, newUpdateElectorateHandler(auth))
 	r.Handle(&UpdateElectionRuleMsg{}, newUpdateElectionRuleHandler(auth))
 	r.Handle(&CreateTextResolutionMsg{}, newCreateTextResolutionHandler(auth))
+	r.Handle(&CancelProposalExecutionMsg{}, newCancelProposalExecutionHandler(auth, scheduler))
 }
 
 type VoteHandler struct {
@@ -167,20 +173,24 @@ func (h VoteHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 }
 
 type TallyHandler struct {
-	auth       x.Authenticator
-	propBucket *ProposalBucket
-	elecBucket *ElectorateBucket
-	decoder    OptionDecoder
-	executor   Executor
+	auth        x.Authenticator
+	propBucket  *ProposalBucket
+	elecBucket  *ElectorateBucket
+	rulesBucket *ElectionRulesBucket
+	decoder     OptionDecoder
+	executor    Executor
+	scheduler   weave.Scheduler
 }
 
-func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor) *TallyHandler {
+func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor, scheduler weave.Scheduler) *TallyHandler {
 	return &TallyHandler{
-		auth:       auth,
-		propBucket: NewProposalBucket(),
-		elecBucket: NewElectorateBucket(),
-		decoder:    decoder,
-		executor:   executor,
+		auth:        auth,
+		propBucket:  NewProposalBucket(),
+		elecBucket:  NewElectorateBucket(),
+		rulesBucket: NewElectionRulesBucket(),
+		decoder:     decoder,
+		executor:    executor,
+		scheduler:   scheduler,
 	}
 }
 
@@ -214,45 +224,97 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 		return &weave.DeliverResult{Log: "Proposal not accepted"}, nil
 	}
 
+	obj, err := h.rulesBucket.GetVersion(db, common.ElectionRuleRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load election rule")
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "election rule")
+	}
+	rule, err := asElectionRule(obj)
+	if err != nil {
+		return nil, err
+	}
+	if rule.ExecutionDelay == 0 {
+		return executeProposal(ctx, db, h.decoder, h.executor, proposal, msg.ProposalID), nil
+	}
+
+	// Execution is delayed. Instead of executing the proposal options now,
+	// schedule the execution and give integrators time to prepare.
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	runAt := now.Add(rule.ExecutionDelay.Duration())
+	executeMsg := &ExecuteProposalMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: msg.ProposalID,
+	}
+	// Execute message requires no authentication.
+	taskID, err := h.scheduler.Schedule(db, runAt, nil, executeMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule execution task")
+	}
+	proposal.Status = Proposal_PendingExecution
+	proposal.ExecutionTaskID = taskID
+	proposal.ExecutionTime = weave.AsUnixTime(runAt)
+	return &weave.DeliverResult{Log: "Proposal accepted: execution scheduled"}, nil
+}
+
+// executeProposal executes options of an accepted proposal and updates the
+// proposal executor result. Failure of the options execution does not result
+// in an error, so that the proposal state can be persisted. Information about
+// the execution result is returned in the log.
+func executeProposal(
+	ctx weave.Context,
+	db weave.KVStore,
+	decoder OptionDecoder,
+	executor Executor,
+	proposal *Proposal,
+	proposalID []byte,
+) *weave.DeliverResult {
 	// we only execute the store options upon success
 	// if this fails... we should still return no error, so the tally update works
 	// we just return the info from the executor in logs (tags?)
-	opts, err := h.decoder(proposal.RawOption)
+	opts, err := decoder(proposal.RawOption)
 	if err != nil {
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: "Proposal accepted: error: cannot parse raw options"}, nil
+		return &weave.DeliverResult{Log: "Proposal accepted: error: cannot parse raw options"}
 	}
 	if err := opts.Validate(); err != nil {
-		return &weave.DeliverResult{Log: "Proposal accepted: error: options invalid"}, nil
+		return &weave.DeliverResult{Log: "Proposal accepted: error: options invalid"}
 	}
 
 	// we add the vote ctx here, to authenticate results in the executor
 	// ensure that the gov.Authenticator is used in those Handlers
 	// we also add the proposal with id that was passed that can be accessed via CtxProposal()
-	voteCtx := withProposal(withElectionSuccess(ctx, common.ElectionRuleRef.ID), proposal, msg.ProposalID)
+	// The treasury of the electorate is controlled by its proposals.
+	voteCtx := withElectionSuccess(ctx, proposal.ElectionRuleRef.ID)
+	voteCtx = withTreasury(voteCtx, proposal.ElectorateRef.ID)
+	voteCtx = withProposal(voteCtx, proposal, proposalID)
 	cstore, ok := db.(weave.CacheableKVStore)
 	if !ok {
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: "Proposal accepted: error: need cachable kvstore"}, nil
+		return &weave.DeliverResult{Log: "Proposal accepted: error: need cachable kvstore"}
 	}
 	subDB := cstore.CacheWrap()
 
-	res, err := h.executor(voteCtx, subDB, opts)
+	res, err := executor(voteCtx, subDB, opts)
 	if err != nil {
 		subDB.Discard()
 		log := fmt.Sprintf("Proposal accepted: execution error: %v", err)
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: log}, nil
+		return &weave.DeliverResult{Log: log}
 	}
 	if err := subDB.Write(); err != nil {
 		log := fmt.Sprintf("Proposal accepted: commit error: %v", err)
 		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: log}, nil
+		return &weave.DeliverResult{Log: log}
 	}
 
 	proposal.ExecutorResult = Proposal_Success
 	res.Log = "Proposal accepted: execution success"
-	return res, nil
+	return res
 }
 
 func (h TallyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TallyMsg, *Proposal, error) {
@@ -277,6 +339,62 @@ func (h TallyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	return &msg, proposal, nil
 }
 
+type ExecuteProposalHandler struct {
+	auth       x.Authenticator
+	propBucket *ProposalBucket
+	decoder    OptionDecoder
+	executor   Executor
+}
+
+func newExecuteProposalHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor) *ExecuteProposalHandler {
+	return &ExecuteProposalHandler{
+		auth:       auth,
+		propBucket: NewProposalBucket(),
+		decoder:    decoder,
+		executor:   executor,
+	}
+}
+
+func (h ExecuteProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "execute proposal handler is to be executed by cron only")
+}
+
+func (h ExecuteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (resOut *weave.DeliverResult, errOut error) {
+	msg, proposal, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the proposal when done processing it, via whatever path
+	defer func() {
+		if err := h.propBucket.Update(db, msg.ProposalID, proposal); err != nil {
+			resOut = nil
+			errOut = err
+		}
+	}()
+
+	proposal.Status = Proposal_Closed
+	return executeProposal(ctx, db, h.decoder, h.executor, proposal, msg.ProposalID), nil
+}
+
+func (h ExecuteProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ExecuteProposalMsg, *Proposal, error) {
+	var msg ExecuteProposalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	proposal, err := h.propBucket.GetProposal(db, msg.ProposalID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load proposal")
+	}
+	if proposal.Status != Proposal_PendingExecution {
+		return nil, nil, errors.Wrapf(errors.ErrState, "unexpected status: %s", proposal.Status.String())
+	}
+	if weave.InTheFuture(ctx, proposal.ExecutionTime.Time()) {
+		return nil, nil, errors.Wrap(errors.ErrState, "execution before proposal execution time: block time")
+	}
+	return &msg, proposal, nil
+}
+
 type CreateProposalHandler struct {
 	auth        x.Authenticator
 	decoder     OptionDecoder
@@ -316,6 +434,8 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	}
 
 	votingEnd := msg.StartTime.Add(rule.VotingPeriod.Duration())
+	voteState := NewTallyResult(rule.Quorum, rule.Threshold, electorate.TotalElectorateWeight)
+	voteState.AbstainExcludedFromQuorum = !rule.AbstainCountsForQuorum
 	proposal := &Proposal{
 		Metadata:        &weave.Metadata{Schema: 1},
 		Title:           msg.Title,
@@ -327,7 +447,7 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		VotingEndTime:   votingEnd,
 		SubmissionTime:  weave.AsUnixTime(blockTime),
 		Author:          msg.Author,
-		VoteState:       NewTallyResult(rule.Quorum, rule.Threshold, electorate.TotalElectorateWeight),
+		VoteState:       voteState,
 		Status:          Proposal_Submitted,
 		Result:          Proposal_Undefined,
 		ExecutorResult:  Proposal_NotRun,
@@ -383,6 +503,23 @@ func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx
 		return nil, nil, nil, err
 	}
 
+	// Title and description are stored sanitized, therefore their length
+	// is checked after the sanitization.
+	msg.Title = sanitizeText(msg.Title)
+	if !validTitle(msg.Title) {
+		return nil, nil, nil, errors.Wrap(errors.ErrInput, "sanitized title is not valid")
+	}
+	msg.Description = sanitizeText(msg.Description)
+	if len(msg.Description) < minDescriptionLength {
+		return nil, nil, nil, errors.Wrapf(errors.ErrInput, "sanitized description length lower than minimum of %d", minDescriptionLength)
+	}
+	if max := rule.ProposalDescriptionLimit(); len(msg.Description) > max {
+		return nil, nil, nil, errors.Wrapf(errors.ErrInput, "description length exceeds the election rule limit of %d", max)
+	}
+	if max := rule.ProposalTitleLimit(); len(msg.Title) > max {
+		return nil, nil, nil, errors.Wrapf(errors.ErrInput, "title length exceeds the election rule limit of %d", max)
+	}
+
 	_, obj, err := h.elecBucket.GetLatestVersion(db, rule.ElectorateID)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "failed to load electorate")
@@ -496,6 +633,75 @@ func (h DeleteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	return &weave.DeliverResult{}, nil
 }
 
+type CancelProposalExecutionHandler struct {
+	auth       x.Authenticator
+	propBucket *ProposalBucket
+	scheduler  weave.Scheduler
+}
+
+func newCancelProposalExecutionHandler(auth x.Authenticator, scheduler weave.Scheduler) *CancelProposalExecutionHandler {
+	return &CancelProposalExecutionHandler{
+		auth:       auth,
+		propBucket: NewProposalBucket(),
+		scheduler:  scheduler,
+	}
+}
+
+func (h CancelProposalExecutionHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: cancelExecutionCost}, nil
+}
+
+func (h CancelProposalExecutionHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, prop, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Executor result remains NotRun, which together with the Accepted
+	// result describes a cancelled execution.
+	prop.Status = Proposal_Closed
+
+	if err := h.propBucket.Update(db, msg.ProposalID, prop); err != nil {
+		return nil, errors.Wrap(err, "failed to persist proposal")
+	}
+
+	switch err := h.scheduler.Delete(db, prop.ExecutionTaskID); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// This is unexpected but not critical. We want the task to not exist
+		// and this is true.
+	default:
+		return nil, errors.Wrap(err, "cannot delete scheduled execution task")
+	}
+
+	return &weave.DeliverResult{}, nil
+}
+
+func (h CancelProposalExecutionHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CancelProposalExecutionMsg, *Proposal, error) {
+	var msg CancelProposalExecutionMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	prop, err := h.propBucket.GetProposal(db, msg.ProposalID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to load a proposal with id %s", msg.ProposalID)
+	}
+	if prop.Status != Proposal_PendingExecution {
+		return nil, nil, errors.Wrapf(errors.ErrState, "unexpected status: %s", prop.Status.String())
+	}
+	// Cancellation is possible only as a result of another proposal
+	// accepted by the same election rule.
+	ruleAddr := Condition(prop.ElectionRuleRef.ID).Address()
+	if !h.auth.HasAddress(ctx, ruleAddr) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "election rule signature required")
+	}
+	return &msg, prop, nil
+}
+
 type UpdateElectorateHandler struct {
 	auth       x.Authenticator
 	propBucket *ProposalBucket
@@ -588,6 +794,21 @@ func (h UpdateElectionRuleHandler) Deliver(ctx weave.Context, db weave.KVStore,
 	rule.Threshold = msg.Threshold
 	rule.VotingPeriod = msg.VotingPeriod
 	rule.Quorum = msg.Quorum
+	// Fields added after the message was released keep their current
+	// value when not set, so that clients not aware of them do not reset
+	// the rule configuration.
+	if msg.ExecutionDelay != 0 {
+		rule.ExecutionDelay = msg.ExecutionDelay
+	}
+	if msg.AbstainCountsForQuorum {
+		rule.AbstainCountsForQuorum = true
+	}
+	if msg.MaxTitleLength != 0 {
+		rule.MaxTitleLength = msg.MaxTitleLength
+	}
+	if msg.MaxDescriptionLength != 0 {
+		rule.MaxDescriptionLength = msg.MaxDescriptionLength
+	}
 	if _, err := h.ruleBucket.Update(db, msg.ElectionRuleID, rule); err != nil {
 		return nil, errors.Wrap(err, "failed to store update")
 	}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 var (
@@ -67,8 +70,9 @@ func TestCreateTextProposal(t *testing.T) {
 				SubmissionTime:  now,
 				Author:          hBobby,
 				VoteState: TallyResult{
-					Threshold:             Fraction{Numerator: 1, Denominator: 2},
-					TotalElectorateWeight: 11,
+					Threshold:                 Fraction{Numerator: 1, Denominator: 2},
+					TotalElectorateWeight:     11,
+					AbstainExcludedFromQuorum: true,
 				},
 				RawOption: textOption,
 			},
@@ -99,8 +103,9 @@ func TestCreateTextProposal(t *testing.T) {
 				SubmissionTime:  now,
 				Author:          hBobby,
 				VoteState: TallyResult{
-					Threshold:             Fraction{Numerator: 1, Denominator: 2},
-					TotalElectorateWeight: 11,
+					Threshold:                 Fraction{Numerator: 1, Denominator: 2},
+					TotalElectorateWeight:     11,
+					AbstainExcludedFromQuorum: true,
 				},
 				RawOption: electorateOption,
 			},
@@ -131,8 +136,9 @@ func TestCreateTextProposal(t *testing.T) {
 				SubmissionTime:  now,
 				Author:          hBobby,
 				VoteState: TallyResult{
-					Threshold:             Fraction{Numerator: 1, Denominator: 2},
-					TotalElectorateWeight: 11,
+					Threshold:                 Fraction{Numerator: 1, Denominator: 2},
+					TotalElectorateWeight:     11,
+					AbstainExcludedFromQuorum: true,
 				},
 				RawOption: ruleOption,
 			},
@@ -162,8 +168,9 @@ func TestCreateTextProposal(t *testing.T) {
 				SubmissionTime:  now,
 				Author:          hAlice,
 				VoteState: TallyResult{
-					Threshold:             Fraction{Numerator: 1, Denominator: 2},
-					TotalElectorateWeight: 11,
+					Threshold:                 Fraction{Numerator: 1, Denominator: 2},
+					TotalElectorateWeight:     11,
+					AbstainExcludedFromQuorum: true,
 				},
 				RawOption: textOption,
 			},
@@ -335,8 +342,9 @@ func TestCreateTextProposal(t *testing.T) {
 				SubmissionTime:  now,
 				Author:          hBobby,
 				VoteState: TallyResult{
-					Threshold:             Fraction{Numerator: 1, Denominator: 2},
-					TotalElectorateWeight: 3,
+					Threshold:                 Fraction{Numerator: 1, Denominator: 2},
+					TotalElectorateWeight:     3,
+					AbstainExcludedFromQuorum: true,
 				},
 				RawOption: textOption,
 			},
@@ -411,6 +419,165 @@ func assertProposalsEqual(t testing.TB, a, b Proposal) {
 	}
 }
 
+func TestCreateProposalTextLimits(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+	textOption := genTextOptions(t)
+
+	specs := map[string]struct {
+		MaxTitle       uint32
+		MaxDescription uint32
+		Title          string
+		Description    string
+		WantErr        *errors.Error
+		WantStored     string
+	}{
+		"title at the rule limit": {
+			MaxTitle:    10,
+			Title:       BigString(10),
+			Description: "my description",
+		},
+		"title over the rule limit": {
+			MaxTitle:    10,
+			Title:       BigString(11),
+			Description: "my description",
+			WantErr:     errors.ErrInput,
+		},
+		"description at the rule limit": {
+			MaxDescription: 20,
+			Title:          "my proposal",
+			Description:    BigString(20),
+		},
+		"description over the rule limit": {
+			MaxDescription: 20,
+			Title:          "my proposal",
+			Description:    BigString(21),
+			WantErr:        errors.ErrInput,
+		},
+		"description at the default limit": {
+			Title:       BigString(128),
+			Description: BigString(5000),
+		},
+		"control characters are removed before the limit is checked": {
+			MaxDescription: 20,
+			Title:          "my proposal",
+			Description:    "# Header\r\n\x00" + BigString(7) + "\x1b[0m\t",
+			WantStored:     "# Header\n" + BigString(7) + "[0m\t",
+		},
+		"invalid UTF-8 is normalized before the limit is checked": {
+			MaxDescription: 20,
+			Title:          "my proposal",
+			// Replacement character is encoded using 3 bytes.
+			Description: BigString(18) + "\xff\xfe",
+			WantErr:     errors.ErrInput,
+		},
+		"invalid UTF-8 is normalized": {
+			Title:       "my proposal",
+			Description: "caf\xe9 au lait",
+			WantStored:  "caf\uFFFD au lait",
+		},
+		"description too short after sanitization": {
+			Title:       "my proposal",
+			Description: "a\x00\x01\x02b",
+			WantErr:     errors.ErrInput,
+		},
+		"title with control characters": {
+			Title:       "my\x00 proposal\r\n",
+			Description: "my description",
+			WantErr:     errors.ErrInput,
+		},
+		"title with invalid UTF-8": {
+			Title:       "my proposal\xff",
+			Description: "my description",
+			WantErr:     errors.ErrInput,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signers: []weave.Condition{hAliceCond, hBobbyCond},
+			}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+			withElectorate(t, db)
+			rules := NewElectionRulesBucket()
+			ruleID, err := rules.NextID(db)
+			assert.Nil(t, err)
+			rule := &ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Title:                "barr",
+				Admin:                hBobby,
+				VotingPeriod:         weave.AsUnixDuration(time.Hour),
+				Threshold:            Fraction{1, 2},
+				ElectorateID:         weavetest.SequenceID(1),
+				Address:              Condition(ruleID).Address(),
+				MaxTitleLength:       spec.MaxTitle,
+				MaxDescriptionLength: spec.MaxDescription,
+			}
+			_, err = rules.CreateWithID(db, ruleID, rule)
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{Msg: &CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          spec.Title,
+				Description:    spec.Description,
+				StartTime:      now.Add(time.Hour),
+				ElectionRuleID: ruleID,
+				RawOption:      textOption,
+			}}
+			res, err := rt.Deliver(ctx, db, tx)
+			if !spec.WantErr.Is(err) {
+				t.Fatalf("deliver expected: %+v  but got %+v", spec.WantErr, err)
+			}
+			if spec.WantErr != nil {
+				return
+			}
+			p, err := NewProposalBucket().GetProposal(db, res.Data)
+			assert.Nil(t, err)
+			want := spec.WantStored
+			if want == "" {
+				want = spec.Description
+			}
+			assert.Equal(t, want, p.Description)
+			assert.Equal(t, spec.Title, p.Title)
+		})
+	}
+}
+
+// TestLoweredTextLimitsKeepExistingProposals ensures that a proposal created
+// before the election rule limit was lowered can still be loaded and voted
+// on, without any migration.
+func TestLoweredTextLimitsKeepExistingProposals(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	proposals := withTextProposal(t, db, ctx, func(ctx weave.Context, p *Proposal) {
+		p.Description = BigString(5000)
+	})
+
+	auth := &weavetest.Auth{Signers: []weave.Condition{hBobbyCond}}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+	_, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &UpdateElectionRuleMsg{
+		Metadata:             &weave.Metadata{Schema: 1},
+		ElectionRuleID:       weavetest.SequenceID(1),
+		VotingPeriod:         weave.AsUnixDuration(time.Hour),
+		Threshold:            Fraction{1, 2},
+		MaxTitleLength:       10,
+		MaxDescriptionLength: 20,
+	}})
+	assert.Nil(t, err)
+
+	p, err := proposals.GetProposal(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, 5000, len(p.Description))
+	assert.Nil(t, p.Validate())
+}
+
 func TestDeleteProposal(t *testing.T) {
 	proposalID := weavetest.SequenceID(1)
 	nonExistentProposalID := weavetest.SequenceID(2)
@@ -541,7 +708,7 @@ func TestCreateResolution(t *testing.T) {
 
 			auth := &weavetest.Auth{}
 			rt := app.NewRouter()
-			RegisterBasicProposalRouters(rt, auth)
+			RegisterBasicProposalRouters(rt, auth, &weavetest.Cron{})
 
 			// given
 			bucket := NewResolutionBucket()
@@ -827,6 +994,7 @@ func TestTally(t *testing.T) {
 		threshold             Fraction
 		totalWeightElectorate uint64
 		yes, no, abstain      uint64
+		excludeAbstain        bool
 	}
 	specs := map[string]struct {
 		Mods              func(weave.Context, *Proposal)
@@ -1055,6 +1223,70 @@ func TestTally(t *testing.T) {
 			ExpExecutorResult: Proposal_Success,
 			WantDeliverLog:    "Proposal accepted: execution success",
 		},
+		"Rejected with yes and no votes not exceeding quorum": {
+			Src: tallySetup{
+				excludeAbstain:        true,
+				yes:                   3,
+				no:                    2,
+				abstain:               1,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 10,
+			},
+			ExpResult:         Proposal_Rejected,
+			ExpExecutorResult: Proposal_NotRun,
+			WantDeliverLog:    "Proposal not accepted",
+		},
+		"Accepted with yes and no votes exceeding quorum": {
+			Src: tallySetup{
+				excludeAbstain:        true,
+				yes:                   4,
+				no:                    2,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 10,
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			WantDeliverLog:    "Proposal accepted: execution success",
+		},
+		"Rejected with abstain counted and all votes not exceeding quorum": {
+			Src: tallySetup{
+				yes:                   3,
+				abstain:               2,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 10,
+			},
+			ExpResult:         Proposal_Rejected,
+			ExpExecutorResult: Proposal_NotRun,
+			WantDeliverLog:    "Proposal not accepted",
+		},
+		"Accepted with abstain counted and all votes exceeding quorum": {
+			Src: tallySetup{
+				yes:                   3,
+				abstain:               3,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 10,
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			WantDeliverLog:    "Proposal accepted: execution success",
+		},
+		"Rejected with abstain counted for quorum but not for acceptance": {
+			Src: tallySetup{
+				yes:                   2,
+				no:                    2,
+				abstain:               4,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 10,
+			},
+			ExpResult:         Proposal_Rejected,
+			ExpExecutorResult: Proposal_NotRun,
+			WantDeliverLog:    "Proposal not accepted",
+		},
 		"Works with high values: accept": {
 			Src: tallySetup{
 				yes:                   math.MaxUint64,
@@ -1250,8 +1482,9 @@ func TestTally(t *testing.T) {
 		},
 	}
 	rt := app.NewRouter()
+	cron := &weavetest.Cron{}
 	// Tally is registered for the cron, not for the usual routes.
-	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor())
+	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
 
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1261,6 +1494,7 @@ func TestTally(t *testing.T) {
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
 			setupForTally := func(_ weave.Context, p *Proposal) {
 				p.VoteState = NewTallyResult(spec.Src.quorum, spec.Src.threshold, spec.Src.totalWeightElectorate)
+				p.VoteState.AbstainExcludedFromQuorum = spec.Src.excludeAbstain
 				p.VoteState.TotalYes = spec.Src.yes
 				p.VoteState.TotalNo = spec.Src.no
 				p.VoteState.TotalAbstain = spec.Src.abstain
@@ -1310,6 +1544,195 @@ func TestTally(t *testing.T) {
 	}
 }
 
+func TestDelayedProposalExecution(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	pBucket := withDelayedProposal(t, db, ctx, time.Hour)
+
+	rt := app.NewRouter()
+	cron := &weavetest.Cron{}
+	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
+
+	tally := &weavetest.Tx{Msg: &TallyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}}
+	res, err := rt.Deliver(ctx, db, tally)
+	assert.Nil(t, err)
+	assert.Equal(t, "Proposal accepted: execution scheduled", res.Log)
+
+	p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, Proposal_PendingExecution, p.Status)
+	assert.Equal(t, Proposal_Accepted, p.Result)
+	assert.Equal(t, Proposal_NotRun, p.ExecutorResult)
+	assert.Equal(t, weave.AsUnixTime(now.Add(time.Hour)), p.ExecutionTime)
+	if _, err := NewResolutionBucket().GetResolution(db, weavetest.SequenceID(1)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want resolution to not be created before the execution, got %+v", err)
+	}
+
+	execute := &weavetest.Tx{Msg: &ExecuteProposalMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}}
+	if _, err := rt.Deliver(ctx, db, execute); !errors.ErrState.Is(err) {
+		t.Fatalf("want execution before the delay to fail, got %+v", err)
+	}
+
+	execCtx := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	tick := cron.Tick(execCtx, db)
+	assert.Equal(t, 1, len(tick.Tags))
+	assert.Equal(t, p.ExecutionTaskID, tick.Tags[0].Value)
+
+	res, err = rt.Deliver(execCtx, db, execute)
+	assert.Nil(t, err)
+	assert.Equal(t, "Proposal accepted: execution success", res.Log)
+
+	p, err = pBucket.GetProposal(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, Proposal_Closed, p.Status)
+	assert.Equal(t, Proposal_Success, p.ExecutorResult)
+	if _, err := NewResolutionBucket().GetResolution(db, weavetest.SequenceID(1)); err != nil {
+		t.Fatalf("want resolution to be created, got %+v", err)
+	}
+
+	// Proposal can be executed only once.
+	if _, err := rt.Deliver(execCtx, db, execute); !errors.ErrState.Is(err) {
+		t.Fatalf("want second execution to fail, got %+v", err)
+	}
+}
+
+func TestCancelProposalExecution(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	pBucket := withDelayedProposal(t, db, ctx, time.Hour)
+
+	cron := &weavetest.Cron{}
+	cronRt := app.NewRouter()
+	RegisterCronRoutes(cronRt, nil, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
+	_, err := cronRt.Deliver(ctx, db, &weavetest.Tx{Msg: &TallyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}})
+	assert.Nil(t, err)
+
+	signer := &weavetest.CtxAuth{Key: "signer"}
+	rt := app.NewRouter()
+	RegisterBasicProposalRouters(rt, x.ChainAuth(Authenticate{}, signer), cron)
+	cancel := &weavetest.Tx{Msg: &CancelProposalExecutionMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}}
+
+	// Cancellation requires the election rule or the author signature.
+	if _, err := rt.Deliver(ctx, db, cancel); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	memberCtx := signer.SetConditions(ctx, hBobbyCond)
+	if _, err := rt.Deliver(memberCtx, db, cancel); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("electorate member: want unauthorized error, got %+v", err)
+	}
+	other := proposalFixture(t, hAlice)
+	otherRuleCtx := withProposal(withElectionSuccess(ctx, weavetest.SequenceID(9)), &other, weavetest.SequenceID(2))
+	if _, err := rt.Deliver(otherRuleCtx, db, cancel); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("other election rule: want unauthorized error, got %+v", err)
+	}
+
+	propCtx := withProposal(withElectionSuccess(ctx, other.ElectionRuleRef.ID), &other, weavetest.SequenceID(2))
+	_, err = rt.Deliver(propCtx, db, cancel)
+	assert.Nil(t, err)
+
+	p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, Proposal_Closed, p.Status)
+	assert.Equal(t, Proposal_Accepted, p.Result)
+	assert.Equal(t, Proposal_NotRun, p.ExecutorResult)
+
+	// Scheduled execution task must be deleted.
+	tick := cron.Tick(weave.WithBlockTime(context.Background(), now.Add(2*time.Hour)), db)
+	assert.Equal(t, 0, len(tick.Tags))
+
+	// Execution cannot be cancelled twice.
+	if _, err := rt.Deliver(propCtx, db, cancel); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+}
+
+// TestCancelProposalExecutionByAuthor ensures that the proposal author cannot
+// cancel a pending execution without another proposal.
+func TestCancelProposalExecutionByAuthor(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	now := time.Now().Round(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	pBucket := withDelayedProposal(t, db, ctx, time.Hour)
+
+	cron := &weavetest.Cron{}
+	cronRt := app.NewRouter()
+	RegisterCronRoutes(cronRt, nil, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
+	_, err := cronRt.Deliver(ctx, db, &weavetest.Tx{Msg: &TallyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}})
+	assert.Nil(t, err)
+
+	rt := app.NewRouter()
+	RegisterBasicProposalRouters(rt, &weavetest.Auth{Signer: hAliceCond}, cron)
+	_, err = rt.Deliver(ctx, db, &weavetest.Tx{Msg: &CancelProposalExecutionMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(1),
+	}})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, Proposal_PendingExecution, p.Status)
+}
+
+// withDelayedProposal persists a proposal that is ready for the tally and is
+// accepted. Proposal election rule delays the execution by given duration.
+func withDelayedProposal(t *testing.T, db store.KVStore, ctx weave.Context, delay time.Duration) *ProposalBucket {
+	t.Helper()
+
+	withElectorate(t, db)
+
+	rulesBucket := NewElectionRulesBucket()
+	id, err := rulesBucket.NextID(db)
+	assert.Nil(t, err)
+	rule := &ElectionRule{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Title:          "barr",
+		Admin:          hBobby,
+		VotingPeriod:   weave.AsUnixDuration(time.Hour),
+		Threshold:      Fraction{1, 2},
+		ElectorateID:   weavetest.SequenceID(1),
+		Address:        Condition(id).Address(),
+		ExecutionDelay: weave.AsUnixDuration(delay),
+	}
+	if _, err := rulesBucket.CreateWithID(db, id, rule); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	pBucket := NewProposalBucket()
+	proposal := proposalFixture(t, hAlice, func(p *Proposal) {
+		p.VoteState.TotalYes = 10
+		p.VotingEndTime = unixBlockTime(t, ctx) - 1
+	})
+	if _, err := pBucket.Create(db, &proposal); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	return pBucket
+}
+
 func TestUpdateElectorate(t *testing.T) {
 	electorateID := weavetest.SequenceID(1)
 
@@ -1514,6 +1937,41 @@ func TestUpdateElectionRules(t *testing.T) {
 				Address:      Condition(electionRulesID).Address(),
 			},
 		},
+		"Update can set proposal text limits": {
+			Msg: UpdateElectionRuleMsg{
+				Metadata:             &weave.Metadata{Schema: 1},
+				ElectionRuleID:       electionRulesID,
+				VotingPeriod:         weave.AsUnixDuration(12 * time.Hour),
+				Threshold:            Fraction{Numerator: 2, Denominator: 3},
+				MaxTitleLength:       4,
+				MaxDescriptionLength: 5000,
+			},
+			SignedBy: hBobbyCond,
+			ExpModel: &ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Version:              2,
+				Admin:                hBobby,
+				ElectorateID:         weavetest.SequenceID(1),
+				Title:                "barr",
+				VotingPeriod:         weave.AsUnixDuration(12 * time.Hour),
+				Threshold:            Fraction{Numerator: 2, Denominator: 3},
+				Address:              Condition(electionRulesID).Address(),
+				MaxTitleLength:       4,
+				MaxDescriptionLength: 5000,
+			},
+		},
+		"Update with a description limit over the maximum": {
+			Msg: UpdateElectionRuleMsg{
+				Metadata:             &weave.Metadata{Schema: 1},
+				ElectionRuleID:       electionRulesID,
+				VotingPeriod:         weave.AsUnixDuration(12 * time.Hour),
+				Threshold:            Fraction{Numerator: 2, Denominator: 3},
+				MaxDescriptionLength: 5001,
+			},
+			SignedBy:       hBobbyCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
 		"Update by non owner should fail": {
 			Msg: UpdateElectionRuleMsg{
 				Metadata:       &weave.Metadata{Schema: 1},
@@ -1578,6 +2036,18 @@ func TestUpdateElectionRules(t *testing.T) {
 			WantCheckErr:   errors.ErrInput,
 			WantDeliverErr: errors.ErrInput,
 		},
+		"execution delay must not be negative": {
+			Msg: UpdateElectionRuleMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				ElectionRuleID: electionRulesID,
+				VotingPeriod:   weave.AsUnixDuration(12 * time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ExecutionDelay: -1,
+			},
+			SignedBy:       hBobbyCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
 		"voting period hours must not be empty": {
 			Msg: UpdateElectionRuleMsg{
 				Metadata:       &weave.Metadata{Schema: 1},
@@ -1644,6 +2114,160 @@ func TestUpdateElectionRules(t *testing.T) {
 	}
 }
 
+// TestUpdateElectionRuleKeepsUnsetFields ensures that an update not setting
+// the fields added to the election rule later does not reset them.
+func TestUpdateElectionRuleKeepsUnsetFields(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	bucket := NewElectionRulesBucket()
+	id, err := bucket.NextID(db)
+	assert.Nil(t, err)
+	rule := &ElectionRule{
+		Metadata:               &weave.Metadata{Schema: 1},
+		Title:                  "barr",
+		Admin:                  hBobby,
+		VotingPeriod:           weave.AsUnixDuration(time.Hour),
+		Threshold:              Fraction{1, 2},
+		ElectorateID:           weavetest.SequenceID(1),
+		Address:                Condition(id).Address(),
+		ExecutionDelay:         weave.AsUnixDuration(2 * time.Hour),
+		AbstainCountsForQuorum: true,
+		MaxTitleLength:         16,
+		MaxDescriptionLength:   1000,
+	}
+	if _, err := bucket.CreateWithID(db, id, rule); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	rt := app.NewRouter()
+	RegisterRoutes(rt, &weavetest.Auth{Signer: hBobbyCond}, decodeProposalOptions, nil, &weavetest.Cron{})
+	_, err = rt.Deliver(context.Background(), db, &weavetest.Tx{Msg: &UpdateElectionRuleMsg{
+		Metadata:       &weave.Metadata{Schema: 1},
+		ElectionRuleID: id,
+		VotingPeriod:   weave.AsUnixDuration(12 * time.Hour),
+		Threshold:      Fraction{Numerator: 2, Denominator: 3},
+		Quorum:         &Fraction{Numerator: 6, Denominator: 7},
+	}})
+	assert.Nil(t, err)
+
+	_, obj, err := bucket.GetLatestVersion(db, id)
+	assert.Nil(t, err)
+	got, err := asElectionRule(obj)
+	assert.Nil(t, err)
+	assert.Equal(t, weave.AsUnixDuration(12*time.Hour), got.VotingPeriod)
+	assert.Equal(t, Fraction{Numerator: 2, Denominator: 3}, got.Threshold)
+	assert.Equal(t, &Fraction{Numerator: 6, Denominator: 7}, got.Quorum)
+	assert.Equal(t, weave.AsUnixDuration(2*time.Hour), got.ExecutionDelay)
+	assert.Equal(t, true, got.AbstainCountsForQuorum)
+	assert.Equal(t, uint32(16), got.MaxTitleLength)
+	assert.Equal(t, uint32(1000), got.MaxDescriptionLength)
+}
+
+// TestUpdateElectionRuleMidVote ensures that a proposal is tallied using the
+// election rule version that was active when the proposal was created. A rule
+// tightened while voting is in progress must affect only proposals created
+// after the change.
+func TestUpdateElectionRuleMidVote(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	withElectorate(t, db)
+	rulesBucket := NewElectionRulesBucket()
+	ruleID, err := rulesBucket.NextID(db)
+	assert.Nil(t, err)
+	// The rule is administrated by its own governance, so that it can be
+	// changed only as a result of an accepted proposal.
+	_, err = rulesBucket.CreateWithID(db, ruleID, &ElectionRule{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Title:        "rule",
+		Admin:        Condition(ruleID).Address(),
+		VotingPeriod: weave.AsUnixDuration(time.Hour),
+		Threshold:    Fraction{Numerator: 1, Denominator: 2},
+		ElectorateID: weavetest.SequenceID(1),
+		Address:      Condition(ruleID).Address(),
+	})
+	assert.Nil(t, err)
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
+	RegisterCronRoutes(rt, auth, decodeProposalOptions, proposalOptionsExecutor(cron), cron)
+
+	now := time.Now().Round(time.Second)
+	atTime := func(d time.Duration, signers ...weave.Condition) weave.Context {
+		ctx := weave.WithBlockTime(context.Background(), now.Add(d))
+		return auth.SetConditions(ctx, signers...)
+	}
+	createProposal := func(ctx weave.Context) []byte {
+		t.Helper()
+		res, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &CreateProposalMsg{
+			Metadata:       &weave.Metadata{Schema: 1},
+			Title:          "my proposal",
+			Description:    "my description",
+			RawOption:      genTextOptions(t),
+			ElectionRuleID: ruleID,
+			StartTime:      unixBlockTime(t, ctx) + 1,
+		}})
+		assert.Nil(t, err)
+		return res.Data
+	}
+	voteYes := func(ctx weave.Context, proposalID []byte) {
+		t.Helper()
+		_, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &VoteMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+			Selected:   VoteOption_Yes,
+		}})
+		assert.Nil(t, err)
+	}
+	tally := func(ctx weave.Context, proposalID []byte) *Proposal {
+		t.Helper()
+		_, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &TallyMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+		}})
+		assert.Nil(t, err)
+		p, err := NewProposalBucket().GetProposal(db, proposalID)
+		assert.Nil(t, err)
+		return p
+	}
+
+	openID := createProposal(atTime(0, hBobbyCond))
+
+	// Require all electors to agree while the first proposal is being
+	// voted on.
+	tighten := &weavetest.Tx{Msg: &UpdateElectionRuleMsg{
+		Metadata:       &weave.Metadata{Schema: 1},
+		ElectionRuleID: ruleID,
+		VotingPeriod:   weave.AsUnixDuration(time.Hour),
+		Threshold:      Fraction{Numerator: 1, Denominator: 1},
+	}}
+	if _, err := rt.Deliver(atTime(10*time.Minute, hBobbyCond), db, tighten); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want the update to require governance, got %+v", err)
+	}
+	_, err = rt.Deliver(atTime(10*time.Minute, Condition(ruleID)), db, tighten)
+	assert.Nil(t, err)
+
+	nextID := createProposal(atTime(10*time.Minute, hBobbyCond))
+
+	// Bobby holds 10 of 11 votes. This is enough to pass the original
+	// threshold but not the tightened one.
+	voteYes(atTime(20*time.Minute, hBobbyCond), openID)
+	voteYes(atTime(20*time.Minute, hBobbyCond), nextID)
+
+	open := tally(atTime(2*time.Hour), openID)
+	assert.Equal(t, uint32(1), open.ElectionRuleRef.Version)
+	assert.Equal(t, Fraction{Numerator: 1, Denominator: 2}, open.VoteState.Threshold)
+	assert.Equal(t, Proposal_Accepted, open.Result)
+
+	next := tally(atTime(2*time.Hour), nextID)
+	assert.Equal(t, uint32(2), next.ElectionRuleRef.Version)
+	assert.Equal(t, Fraction{Numerator: 1, Denominator: 1}, next.VoteState.Threshold)
+	assert.Equal(t, Proposal_Rejected, next.Result)
+}
+
 func unixBlockTime(t testing.TB, ctx context.Context) weave.UnixTime {
 	now, err := weave.BlockTime(ctx)
 	if err != nil {
@@ -1651,3 +2275,140 @@ func unixBlockTime(t testing.TB, ctx context.Context) weave.UnixTime {
 	}
 	return weave.AsUnixTime(now)
 }
+
+func TestTreasury(t *testing.T) {
+	treasury := TreasuryAddress(weavetest.SequenceID(1))
+
+	specs := map[string]struct {
+		Source            weave.Address
+		ExpExecutorResult Proposal_ExecutorResult
+		ExpTreasury       coin.Coin
+		ExpDestination    coin.Coin
+	}{
+		"proposal can move funds of its electorate treasury": {
+			Source:            treasury,
+			ExpExecutorResult: Proposal_Success,
+			ExpTreasury:       coin.NewCoin(7, 0, "IOV"),
+			ExpDestination:    coin.NewCoin(3, 0, "IOV"),
+		},
+		"proposal cannot move funds of another electorate treasury": {
+			Source:            TreasuryAddress(weavetest.SequenceID(2)),
+			ExpExecutorResult: Proposal_Failure,
+			ExpTreasury:       coin.NewCoin(10, 0, "IOV"),
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName, "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			for _, addr := range []weave.Address{treasury, TreasuryAddress(weavetest.SequenceID(2))} {
+				if err := ctrl.CoinMint(db, addr, coin.NewCoin(10, 0, "IOV")); err != nil {
+					t.Fatalf("cannot mint: %s", err)
+				}
+			}
+
+			send := cash.SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      spec.Source,
+				Destination: hCharlie,
+				Amount:      coin.NewCoinp(3, 0, "IOV"),
+			}
+			rawOption, err := send.Marshal()
+			if err != nil {
+				t.Fatalf("cannot marshal option: %s", err)
+			}
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+			pBucket := withTextProposal(t, db, ctx, func(ctx weave.Context, p *Proposal) {
+				p.RawOption = rawOption
+				p.VoteState = NewTallyResult(nil, Fraction{Numerator: 1, Denominator: 2}, 11)
+				p.VoteState.TotalYes = 10
+				p.VotingEndTime = unixBlockTime(t, ctx) - 1
+			})
+
+			decoder := func(raw []byte) (weave.Msg, error) {
+				var msg cash.SendMsg
+				if err := msg.Unmarshal(raw); err != nil {
+					return nil, errors.Wrap(err, "cannot parse option")
+				}
+				return &msg, nil
+			}
+			r := app.NewRouter()
+			cash.RegisterRoutes(r, Authenticate{}, ctrl)
+			rt := app.NewRouter()
+			RegisterCronRoutes(rt, nil, decoder, HandlerAsExecutor(r), &weavetest.Cron{})
+
+			tx := &weavetest.Tx{
+				Msg: &TallyMsg{
+					Metadata:   &weave.Metadata{Schema: 1},
+					ProposalID: weavetest.SequenceID(1),
+				},
+			}
+			if _, err := rt.Deliver(ctx, db, tx); err != nil {
+				t.Fatalf("cannot tally: %s", err)
+			}
+
+			p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+			if err != nil {
+				t.Fatalf("cannot get proposal: %s", err)
+			}
+			assert.Equal(t, Proposal_Accepted, p.Result)
+			assert.Equal(t, spec.ExpExecutorResult, p.ExecutorResult)
+
+			assertBalance(t, db, ctrl, treasury, spec.ExpTreasury)
+			assertBalance(t, db, ctrl, hCharlie, spec.ExpDestination)
+		})
+	}
+}
+
+func TestTreasuryDirectSend(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName, "cash")
+
+	treasury := TreasuryAddress(weavetest.SequenceID(1))
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, treasury, coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	cash.RegisterRoutes(rt, x.ChainAuth(auth, Authenticate{}), ctrl)
+
+	tx := &weavetest.Tx{
+		Msg: &cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      treasury,
+			Destination: hCharlie,
+			Amount:      coin.NewCoinp(3, 0, "IOV"),
+		},
+	}
+	// Neither an electorate member nor the election rule can sign for
+	// the treasury.
+	conditions := []weave.Condition{hAliceCond, hBobbyCond, ElectionCondition(weavetest.SequenceID(1))}
+	ctx := auth.SetConditions(context.Background(), conditions...)
+	if _, err := rt.Deliver(ctx, db, tx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	assertBalance(t, db, ctrl, treasury, coin.NewCoin(10, 0, "IOV"))
+}
+
+func assertBalance(t testing.TB, db weave.KVStore, ctrl cash.Controller, addr weave.Address, want coin.Coin) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if want.IsZero() {
+		if !got.IsEmpty() {
+			t.Fatalf("want %s balance to be empty, got %v", addr, got)
+		}
+		return
+	}
+	if !got.Equals(coin.Coins{&want}) {
+		t.Fatalf("want %s balance %v, got %v", addr, want, got)
+	}
+}
@@ -11,33 +11,48 @@ import (
 // file.
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisGovernance is the genesis file representation of the governance
+// electorates and election rules.
+type genesisGovernance struct {
+	Electorate []struct {
+		Admin    weave.Address `json:"admin"`
+		Title    string        `json:"title"`
+		Electors []struct {
+			Address weave.Address `json:"address"`
+			Weight  uint32        `json:"weight"`
+		} `json:"electors"`
+	} `json:"electorate"`
+	Rules []struct {
+		Admin        weave.Address      `json:"admin"`
+		ElectorateID uint64             `json:"electorate_id"`
+		Title        string             `json:"title"`
+		VotingPeriod weave.UnixDuration `json:"voting_period"`
+		Quorum       genesisFraction    `json:"quorum"`
+		Threshold    genesisFraction    `json:"threshold"`
+		// AbstainCountsForQuorum is optional.
+		AbstainCountsForQuorum bool `json:"abstain_counts_for_quorum,omitempty"`
+		// Proposal text length limits are optional.
+		MaxTitleLength       uint32 `json:"max_title_length,omitempty"`
+		MaxDescriptionLength uint32 `json:"max_description_length,omitempty"`
+	} `json:"rules"`
+}
+
+type genesisFraction struct {
+	Numerator   uint32 `json:"numerator"`
+	Denominator uint32 `json:"denominator"`
+}
 
 // FromGenesis will parse initial governance electorate and election rules from genesis
 // and saves it in the database.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type fraction struct {
-		Numerator   uint32 `json:"numerator"`
-		Denominator uint32 `json:"denominator"`
-	}
-	var governance struct {
-		Electorate []struct {
-			Admin    weave.Address `json:"admin"`
-			Title    string        `json:"title"`
-			Electors []struct {
-				Address weave.Address `json:"address"`
-				Weight  uint32        `json:"weight"`
-			} `json:"electors"`
-		} `json:"electorate"`
-		Rules []struct {
-			Admin        weave.Address      `json:"admin"`
-			ElectorateID uint64             `json:"electorate_id"`
-			Title        string             `json:"title"`
-			VotingPeriod weave.UnixDuration `json:"voting_period"`
-			Quorum       fraction           `json:"quorum"`
-			Threshold    fraction           `json:"threshold"`
-		} `json:"rules"`
-	}
+	var governance genesisGovernance
 	if err := opts.ReadOptions("governance", &governance); err != nil {
 		return err
 	}
@@ -91,6 +106,10 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Threshold:    Fraction{Numerator: r.Threshold.Numerator, Denominator: r.Threshold.Denominator},
 			ElectorateID: electorateID,
 			Address:      Condition(newRuleID).Address(),
+
+			AbstainCountsForQuorum: r.AbstainCountsForQuorum,
+			MaxTitleLength:         r.MaxTitleLength,
+			MaxDescriptionLength:   r.MaxDescriptionLength,
 		}
 		if r.Quorum.Numerator != 0 || r.Quorum.Denominator != 0 {
 			rule.Quorum = &Fraction{Numerator: r.Quorum.Numerator, Denominator: r.Quorum.Denominator}
@@ -104,6 +123,11 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		}
 	}
 
+	// No votes exist at genesis, so the index is ready right away.
+	if err := BuildProposalVoterIndex(kv); err != nil {
+		return errors.Wrap(err, "proposal voter index")
+	}
+
 	return nil
 }
 
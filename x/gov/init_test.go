@@ -67,6 +67,7 @@ func TestInitFromGenesis(t *testing.T) {
 						"numerator": 2,
 						"denominator": 3
 					},
+					"abstain_counts_for_quorum": true,
 					"electorate_id": 2
 				}
 			]
@@ -203,6 +204,9 @@ func TestInitFromGenesis(t *testing.T) {
 	if exp, got := (Fraction{Numerator: 2, Denominator: 3}), *r.Quorum; exp != got {
 		t.Errorf("expected %#v but got %#v", exp, got)
 	}
+	if !r.AbstainCountsForQuorum {
+		t.Error("expected abstain votes to count for quorum")
+	}
 	if exp, got := weavetest.SequenceID(2), r.ElectorateID; !bytes.Equal(exp, got) {
 		t.Errorf("expected %v but got %v", exp, got)
 	}
@@ -218,3 +222,29 @@ func addr(s string) weave.Address {
 	}
 	return a
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `{
+		"electorate": [
+			{
+				"admin": "seq:test/admin/1",
+				"title": "first",
+				"electors": [
+					{"address": "0102030405060708090021222324252627282930", "weight": 10},
+					{"address": "seq:test/alice/1", "weight": 1}
+				]
+			}
+		],
+		"rules": [
+			{
+				"admin": "seq:test/admin/1",
+				"electorate_id": 1,
+				"title": "fooo",
+				"voting_period": "1h",
+				"quorum": {"numerator": 1, "denominator": 2},
+				"threshold": {"numerator": 2, "denominator": 3}
+			}
+		]
+	}`
+	assert.JSONRoundTrip(t, []byte(genesis), &genesisGovernance{})
+}
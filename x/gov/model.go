@@ -13,8 +13,15 @@ import (
 
 const maxElectors = 2000
 
+// validTitle title length boundaries must match minTitleLength and
+// maxTitleLength.
 var validTitle = regexp.MustCompile(`^[a-zA-Z0-9 _.-]{4,128}$`).MatchString
 
+const (
+	minTitleLength = 4
+	maxTitleLength = 128
+)
+
 func init() {
 	migration.MustRegister(1, &Electorate{}, migration.NoModification)
 	migration.MustRegister(1, &ElectionRule{}, migration.NoModification)
@@ -83,8 +90,9 @@ func (m Elector) Validate() error {
 }
 
 var (
-	minVotingPeriod = time.Second
-	maxVotingPeriod = 4 * 7 * 24 * time.Hour // 4 weeks
+	minVotingPeriod   = time.Second
+	maxVotingPeriod   = 4 * 7 * 24 * time.Hour // 4 weeks
+	maxExecutionDelay = 4 * 7 * 24 * time.Hour // 4 weeks
 )
 
 func (m *ElectionRule) SetVersion(v uint32) {
@@ -111,6 +119,12 @@ func (m ElectionRule) Validate() error {
 	if m.VotingPeriod.Duration() > maxVotingPeriod {
 		return errors.Wrapf(errors.ErrInput, "max %s", maxVotingPeriod)
 	}
+	if m.ExecutionDelay < 0 {
+		return errors.Wrap(errors.ErrInput, "execution delay must not be negative")
+	}
+	if m.ExecutionDelay.Duration() > maxExecutionDelay {
+		return errors.Wrapf(errors.ErrInput, "execution delay max %s", maxExecutionDelay)
+	}
 
 	if err := m.Admin.Validate(); err != nil {
 		return errors.Wrap(err, "admin")
@@ -126,9 +140,42 @@ func (m ElectionRule) Validate() error {
 	if err := m.Address.Validate(); err != nil {
 		return errors.Wrap(err, "address")
 	}
+	if err := validateTextLimits(m.MaxTitleLength, m.MaxDescriptionLength); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateTextLimits returns an error if any of the proposal text length
+// limits is not zero and is outside of the range allowed by the package.
+func validateTextLimits(title, description uint32) error {
+	if title != 0 && (title < minTitleLength || title > maxTitleLength) {
+		return errors.Wrapf(errors.ErrInput, "max title length must be between %d and %d", minTitleLength, maxTitleLength)
+	}
+	if description != 0 && (description < minDescriptionLength || description > maxDescriptionLength) {
+		return errors.Wrapf(errors.ErrInput, "max description length must be between %d and %d", minDescriptionLength, maxDescriptionLength)
+	}
 	return nil
 }
 
+// ProposalTitleLimit returns the greatest length of a title of a proposal
+// created using this rule.
+func (m ElectionRule) ProposalTitleLimit() int {
+	if m.MaxTitleLength == 0 {
+		return maxTitleLength
+	}
+	return int(m.MaxTitleLength)
+}
+
+// ProposalDescriptionLimit returns the greatest length of a sanitized
+// description of a proposal created using this rule.
+func (m ElectionRule) ProposalDescriptionLimit() int {
+	if m.MaxDescriptionLength == 0 {
+		return maxDescriptionLength
+	}
+	return int(m.MaxDescriptionLength)
+}
+
 func (m Fraction) Validate() error {
 	if m.Numerator == 0 {
 		return errors.Wrap(errors.ErrInput, "numerator must not be 0")
@@ -146,6 +193,10 @@ func (m Fraction) Validate() error {
 }
 
 const (
+	// Description length boundaries are the absolute limits. An election
+	// rule can declare a lower maximum for new proposals. Proposal
+	// validation uses the absolute limits only, so that proposals created
+	// before a rule limit was lowered can still be loaded.
 	minDescriptionLength = 3
 	maxDescriptionLength = 5000
 	maxFutureStart       = 7 * 24 * time.Hour // 1 week
@@ -167,6 +218,14 @@ func (m *Proposal) Validate() error {
 	if m.Status == Proposal_PROPOSAL_STATUS_INVALID {
 		return errors.Wrap(errors.ErrState, "invalid status")
 	}
+	if m.Status == Proposal_PendingExecution {
+		if len(m.ExecutionTaskID) == 0 {
+			return errors.Wrap(errors.ErrState, "execution task id required")
+		}
+		if m.ExecutionTime == 0 {
+			return errors.Wrap(errors.ErrState, "execution time required")
+		}
+	}
 	if m.VotingStartTime >= m.VotingEndTime {
 		return errors.Wrap(errors.ErrState, "start time must be before end time")
 	}
@@ -281,13 +340,17 @@ func (m TallyResult) Accepted() bool {
 		return true
 	}
 
-	total := m.TotalVotes()
 	bTotalElectorateWeight := new(big.Int).SetUint64(m.TotalElectorateWeight)
 	bBaseWeight := bTotalElectorateWeight
 	if m.Quorum != nil {
 		// new base = total Yes + total No
 		bBaseWeight = new(big.Int).Add(new(big.Int).SetUint64(m.TotalYes), new(big.Int).SetUint64(m.TotalNo))
 
+		// Abstain votes are part of the turnout unless excluded.
+		total := m.TotalVotes()
+		if m.AbstainExcludedFromQuorum {
+			total = m.TotalYes + m.TotalNo
+		}
 		if total != m.TotalElectorateWeight { // handles non 1/1 quorums only
 			// quorum reached when
 			// totalVotes * quorumDenominator > electorate * quorumNumerator
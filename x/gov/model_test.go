@@ -235,6 +235,106 @@ func TestElectionRuleValidation(t *testing.T) {
 				Address:      Condition(weavetest.SequenceID(6)).Address(),
 			},
 		},
+		"Proposal title limit at the minimum": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				MaxTitleLength: 4,
+			},
+		},
+		"Proposal title limit at the maximum": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				MaxTitleLength: 128,
+			},
+		},
+		"Proposal title limit too low": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				MaxTitleLength: 3,
+			},
+			Exp: errors.ErrInput,
+		},
+		"Proposal title limit too high": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				MaxTitleLength: 129,
+			},
+			Exp: errors.ErrInput,
+		},
+		"Proposal description limit at the minimum": {
+			Src: ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Title:                "My election rule",
+				Admin:                alice,
+				VotingPeriod:         weave.AsUnixDuration(time.Hour),
+				Threshold:            Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:         weavetest.SequenceID(5),
+				Address:              Condition(weavetest.SequenceID(6)).Address(),
+				MaxDescriptionLength: 3,
+			},
+		},
+		"Proposal description limit at the maximum": {
+			Src: ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Title:                "My election rule",
+				Admin:                alice,
+				VotingPeriod:         weave.AsUnixDuration(time.Hour),
+				Threshold:            Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:         weavetest.SequenceID(5),
+				Address:              Condition(weavetest.SequenceID(6)).Address(),
+				MaxDescriptionLength: 5000,
+			},
+		},
+		"Proposal description limit too low": {
+			Src: ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Title:                "My election rule",
+				Admin:                alice,
+				VotingPeriod:         weave.AsUnixDuration(time.Hour),
+				Threshold:            Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:         weavetest.SequenceID(5),
+				Address:              Condition(weavetest.SequenceID(6)).Address(),
+				MaxDescriptionLength: 2,
+			},
+			Exp: errors.ErrInput,
+		},
+		"Proposal description limit too high": {
+			Src: ElectionRule{
+				Metadata:             &weave.Metadata{Schema: 1},
+				Title:                "My election rule",
+				Admin:                alice,
+				VotingPeriod:         weave.AsUnixDuration(time.Hour),
+				Threshold:            Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:         weavetest.SequenceID(5),
+				Address:              Condition(weavetest.SequenceID(6)).Address(),
+				MaxDescriptionLength: 5001,
+			},
+			Exp: errors.ErrInput,
+		},
 		"Title too short": {
 			Src: ElectionRule{
 				Metadata:     &weave.Metadata{Schema: 1},
@@ -283,6 +383,32 @@ func TestElectionRuleValidation(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"Execution delay too long": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				ExecutionDelay: weave.AsUnixDuration((24*7*4 + 1) * time.Hour),
+			},
+			Exp: errors.ErrInput,
+		},
+		"Execution delay must not be negative": {
+			Src: ElectionRule{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "My election rule",
+				Admin:          alice,
+				VotingPeriod:   weave.AsUnixDuration(time.Hour),
+				Threshold:      Fraction{Numerator: 1, Denominator: 2},
+				ElectorateID:   weavetest.SequenceID(5),
+				Address:        Condition(weavetest.SequenceID(6)).Address(),
+				ExecutionDelay: -1,
+			},
+			Exp: errors.ErrInput,
+		},
 		"Threshold must not be lower han 0.5": {
 			Src: ElectionRule{
 				Metadata:     &weave.Metadata{Schema: 1},
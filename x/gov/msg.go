@@ -15,6 +15,8 @@ func init() {
 	migration.MustRegister(1, &DeleteProposalMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectionRuleMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectorateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExecuteProposalMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CancelProposalExecutionMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateProposalMsg)(nil)
@@ -104,6 +106,36 @@ func (m TallyMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*ExecuteProposalMsg)(nil)
+
+func (ExecuteProposalMsg) Path() string {
+	return "gov/execute_proposal"
+}
+
+func (m ExecuteProposalMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ProposalID) == 0 {
+		errs = errors.Append(errs, errors.Field("ProposalID", errors.ErrInput, "proposal ID is required"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*CancelProposalExecutionMsg)(nil)
+
+func (CancelProposalExecutionMsg) Path() string {
+	return "gov/cancel_proposal_execution"
+}
+
+func (m CancelProposalExecutionMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ProposalID) != 8 {
+		errs = errors.Append(errs, errors.Field("ProposalID", errors.ErrInput, "proposal ID must be 8 bytes (sequence)"))
+	}
+	return errs
+}
+
 var _ weave.Msg = (*UpdateElectionRuleMsg)(nil)
 
 func (UpdateElectionRuleMsg) Path() string {
@@ -125,6 +157,17 @@ func (m UpdateElectionRuleMsg) Validate() error {
 		errs = errors.AppendField(errs, "Quorum", m.Quorum.Validate())
 	}
 	errs = errors.AppendField(errs, "Threshold", m.Threshold.Validate())
+	if m.ExecutionDelay < 0 {
+		errs = errors.Append(errs, errors.Field("ExecutionDelay", errors.ErrInput, "value must not be negative"))
+	} else if m.ExecutionDelay.Duration() > maxExecutionDelay {
+		errs = errors.Append(errs, errors.Field("ExecutionDelay", errors.ErrInput, "value must not be greater than %s", maxExecutionDelay))
+	}
+	if m.MaxTitleLength != 0 && (m.MaxTitleLength < minTitleLength || m.MaxTitleLength > maxTitleLength) {
+		errs = errors.Append(errs, errors.Field("MaxTitleLength", errors.ErrInput, "value must be between %d and %d", minTitleLength, maxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 && (m.MaxDescriptionLength < minDescriptionLength || m.MaxDescriptionLength > maxDescriptionLength) {
+		errs = errors.Append(errs, errors.Field("MaxDescriptionLength", errors.ErrInput, "value must be between %d and %d", minDescriptionLength, maxDescriptionLength))
+	}
 	return errs
 }
 
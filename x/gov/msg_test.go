@@ -140,15 +140,25 @@ func TestCreateProposalMsg(t *testing.T) {
 			}),
 			Exp: errors.ErrInput,
 		},
+		"Short description within range": {
+			Msg: buildMsg(func(p *CreateProposalMsg) {
+				p.Description = "foo"
+			}),
+		},
+		"Long description within range": {
+			Msg: buildMsg(func(p *CreateProposalMsg) {
+				p.Description = BigString(5000)
+			}),
+		},
 		"Description too short": {
 			Msg: buildMsg(func(p *CreateProposalMsg) {
-				p.Title = "foo"
+				p.Description = "fo"
 			}),
 			Exp: errors.ErrInput,
 		},
 		"Description too long": {
 			Msg: buildMsg(func(p *CreateProposalMsg) {
-				p.Title = BigString(5001)
+				p.Description = BigString(5001)
 			}),
 			Exp: errors.ErrInput,
 		},
@@ -220,6 +230,33 @@ func TestDeleteProposalMsg(t *testing.T) {
 	}
 }
 
+func TestCancelProposalExecutionMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg CancelProposalExecutionMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: CancelProposalExecutionMsg{ProposalID: weavetest.SequenceID(1), Metadata: &weave.Metadata{Schema: 1}},
+		},
+		"Empty ID": {
+			Msg: CancelProposalExecutionMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Msg: CancelProposalExecutionMsg{ProposalID: weavetest.SequenceID(1)},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
 func TestCreateTextResolutionMsg(t *testing.T) {
 	specs := map[string]struct {
 		Msg CreateTextResolutionMsg
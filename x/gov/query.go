@@ -0,0 +1,68 @@
+package gov
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+var _ weave.QueryHandler = (*proposalVotesQuery)(nil)
+
+// proposalVotesQuery returns votes cast on a single proposal, ordered by the
+// voter address. A single query returns a limited amount of votes.
+//
+// Query data is the proposal ID, optionally followed by the address of a
+// voter. If the address is given, only votes of the voters with an address
+// greater than given one are returned. Use the address of the last returned
+// vote to query for the next page of the result.
+type proposalVotesQuery struct {
+	votes *VoteBucket
+}
+
+func (q *proposalVotesQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	if len(data) < proposalIDLength {
+		return nil, errors.Wrap(errors.ErrInput, "proposal ID required")
+	}
+	proposalID, after := data[:proposalIDLength], data[proposalIDLength:]
+
+	start := proposalID
+	if len(after) > 0 {
+		// Compact index ignores values shorter than the range start,
+		// therefore orm.NextCursor cannot be used.
+		next, ok := increment(after)
+		if !ok {
+			return nil, nil
+		}
+		start = proposalVoterKey(proposalID, next)
+	}
+	end := proposalVoterKey(proposalID, bytes.Repeat([]byte{255}, weave.AddressLength+1))
+
+	idx, err := q.votes.Index(indexNameProposalVoter)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposal voter index")
+	}
+	rng := hex.EncodeToString(start) + "::" + hex.EncodeToString(end)
+	return idx.Query(db, weave.RangeQueryMod, []byte(rng))
+}
+
+// increment returns the smallest value of the same length that is greater
+// than given one. It returns false if such value does not exist.
+func increment(b []byte) ([]byte, bool) {
+	next := append([]byte{}, b...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next, true
+		}
+	}
+	return nil, false
+}
+
+// proposalIDLength is the length of the proposal ID generated by the proposal
+// bucket sequence.
+const proposalIDLength = 8
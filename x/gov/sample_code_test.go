@@ -18,9 +18,9 @@ func decodeProposalOptions(raw []byte) (weave.Msg, error) {
 
 // proposalOptionsExecutor will set up an executor to allow governance-internal actions
 // such a setup can be easily extended to allow many more actions in other modules.
-func proposalOptionsExecutor() Executor {
+func proposalOptionsExecutor(scheduler weave.Scheduler) Executor {
 	r := app.NewRouter()
 	// we only allow these to be authenticated by the governance context, not by sigs or other items
-	RegisterBasicProposalRouters(r, Authenticate{})
+	RegisterBasicProposalRouters(r, Authenticate{}, scheduler)
 	return HandlerAsExecutor(r)
 }
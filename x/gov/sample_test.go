@@ -32,6 +32,7 @@ type ProposalOptions struct {
 	//	*ProposalOptions_Text
 	//	*ProposalOptions_Electorate
 	//	*ProposalOptions_Rule
+	//	*ProposalOptions_CancelExecution
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -83,10 +84,14 @@ type ProposalOptions_Electorate struct {
 type ProposalOptions_Rule struct {
 	Rule *UpdateElectionRuleMsg `protobuf:"bytes,3,opt,name=rule,proto3,oneof"`
 }
+type ProposalOptions_CancelExecution struct {
+	CancelExecution *CancelProposalExecutionMsg `protobuf:"bytes,4,opt,name=cancel_execution,json=cancelExecution,proto3,oneof"`
+}
 
-func (*ProposalOptions_Text) isProposalOptions_Option()       {}
-func (*ProposalOptions_Electorate) isProposalOptions_Option() {}
-func (*ProposalOptions_Rule) isProposalOptions_Option()       {}
+func (*ProposalOptions_Text) isProposalOptions_Option()            {}
+func (*ProposalOptions_Electorate) isProposalOptions_Option()      {}
+func (*ProposalOptions_Rule) isProposalOptions_Option()            {}
+func (*ProposalOptions_CancelExecution) isProposalOptions_Option() {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -116,12 +121,20 @@ func (m *ProposalOptions) GetRule() *UpdateElectionRuleMsg {
 	return nil
 }
 
+func (m *ProposalOptions) GetCancelExecution() *CancelProposalExecutionMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CancelExecution); ok {
+		return x.CancelExecution
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
 		(*ProposalOptions_Text)(nil),
 		(*ProposalOptions_Electorate)(nil),
 		(*ProposalOptions_Rule)(nil),
+		(*ProposalOptions_CancelExecution)(nil),
 	}
 }
 
@@ -144,6 +157,11 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Rule); err != nil {
 			return err
 		}
+	case *ProposalOptions_CancelExecution:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CancelExecution); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -178,6 +196,14 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_Rule{msg}
 		return true, err
+	case 4: // option.cancel_execution
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CancelProposalExecutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CancelExecution{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -202,6 +228,11 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ProposalOptions_CancelExecution:
+		s := proto.Size(x.CancelExecution)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -216,23 +247,24 @@ func init() {
 func init() { proto.RegisterFile("x/gov/sample_test.proto", fileDescriptor_a3ad181f69ab09f1) }
 
 var fileDescriptor_a3ad181f69ab09f1 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xcf, 0x31, 0x4b, 0xc4, 0x30,
-	0x14, 0xc0, 0xf1, 0xc6, 0x3b, 0x0e, 0x89, 0x83, 0x58, 0x04, 0x43, 0x91, 0x20, 0x4e, 0x4e, 0xad,
-	0x9c, 0x9b, 0xe3, 0x89, 0xe0, 0x22, 0x4a, 0xd1, 0x59, 0x62, 0xef, 0x11, 0x0e, 0xe2, 0xbd, 0x90,
-	0xbc, 0x96, 0x7e, 0x0c, 0xbf, 0x90, 0xbb, 0xe3, 0x8d, 0x8e, 0xd2, 0x7e, 0x11, 0xb9, 0x17, 0x11,
-	0xb9, 0x2d, 0xc9, 0xfb, 0xff, 0x20, 0x4f, 0x9e, 0xf4, 0x95, 0xc5, 0xae, 0x8a, 0xe6, 0xcd, 0x3b,
-	0x78, 0x21, 0x88, 0x54, 0xfa, 0x80, 0x84, 0xf9, 0xc4, 0x62, 0x57, 0x1c, 0x5b, 0xb4, 0xc8, 0xf7,
-	0x6a, 0x7b, 0x4a, 0xa3, 0xe2, 0x28, 0x99, 0x06, 0x97, 0xd0, 0xa4, 0xa7, 0xf3, 0x0f, 0x21, 0x0f,
-	0x1f, 0x03, 0x7a, 0x8c, 0xc6, 0x3d, 0x78, 0x5a, 0xe1, 0x3a, 0xe6, 0x73, 0x39, 0x25, 0xe8, 0x49,
-	0x89, 0x33, 0x71, 0x71, 0x30, 0x3f, 0x2d, 0x2d, 0x76, 0xe5, 0x4d, 0x00, 0x43, 0xf0, 0x04, 0x3d,
-	0xd5, 0x10, 0xd1, 0xb5, 0xdb, 0xf2, 0x3e, 0xda, 0xbb, 0xac, 0xe6, 0x36, 0xbf, 0x96, 0x12, 0x1c,
-	0x34, 0x84, 0xc1, 0x10, 0xa8, 0x3d, 0x96, 0x8a, 0xe5, 0xb3, 0x5f, 0x1a, 0x82, 0xdb, 0xbf, 0x61,
-	0x52, 0xff, 0xea, 0xfc, 0x52, 0x4e, 0x43, 0xeb, 0x40, 0x4d, 0x58, 0x15, 0xbb, 0x6a, 0x85, 0xeb,
-	0xba, 0x75, 0xbf, 0x8e, 0xcb, 0xc5, 0xbe, 0x9c, 0x21, 0x7f, 0x76, 0xa1, 0x3e, 0x07, 0x2d, 0x36,
-	0x83, 0x16, 0xdf, 0x83, 0x16, 0xef, 0xa3, 0xce, 0x36, 0xa3, 0xce, 0xbe, 0x46, 0x9d, 0xbd, 0xce,
-	0x78, 0xc1, 0xab, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x84, 0x40, 0xb5, 0xf6, 0x29, 0x01, 0x00,
-	0x00,
+	// 272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4b, 0xf3, 0x40,
+	0x10, 0x86, 0x93, 0xb6, 0x94, 0x8f, 0xfd, 0x0e, 0xd5, 0x20, 0xb8, 0x14, 0x59, 0xc5, 0x93, 0xa7,
+	0x44, 0xea, 0xcd, 0x63, 0xa5, 0xe0, 0x41, 0x51, 0x82, 0x9e, 0xcb, 0xba, 0x1d, 0x96, 0xc2, 0x9a,
+	0x09, 0xd9, 0x49, 0xc8, 0x7f, 0xf0, 0xe2, 0xcf, 0xf2, 0xd8, 0xa3, 0x47, 0x49, 0xfe, 0x88, 0x74,
+	0xd6, 0x16, 0xf1, 0xb6, 0x3b, 0xef, 0xf3, 0x2c, 0xf3, 0xae, 0x38, 0x6e, 0x33, 0x8b, 0x4d, 0xe6,
+	0xf5, 0x6b, 0xe9, 0x60, 0x49, 0xe0, 0x29, 0x2d, 0x2b, 0x24, 0x4c, 0x86, 0x16, 0x9b, 0xe9, 0x91,
+	0x45, 0x8b, 0x7c, 0xcf, 0xb6, 0xa7, 0x10, 0x4d, 0x0f, 0x83, 0x63, 0x70, 0x05, 0x26, 0x8c, 0xce,
+	0xdf, 0x06, 0x62, 0xf2, 0x58, 0x61, 0x89, 0x5e, 0xbb, 0x87, 0x92, 0xd6, 0x58, 0xf8, 0x64, 0x26,
+	0x46, 0x04, 0x2d, 0xc9, 0xf8, 0x2c, 0xbe, 0xf8, 0x3f, 0x3b, 0x49, 0x2d, 0x36, 0xe9, 0x4d, 0x05,
+	0x9a, 0xe0, 0x09, 0x5a, 0xca, 0xc1, 0xa3, 0xab, 0xb7, 0xe4, 0xbd, 0xb7, 0xb7, 0x51, 0xce, 0x6c,
+	0x72, 0x2d, 0x04, 0x38, 0x30, 0x84, 0x95, 0x26, 0x90, 0x03, 0x36, 0x25, 0x9b, 0xcf, 0xe5, 0x4a,
+	0x13, 0x2c, 0xf6, 0x61, 0xb0, 0x7e, 0xd1, 0xc9, 0xa5, 0x18, 0x55, 0xb5, 0x03, 0x39, 0x64, 0x6b,
+	0xfa, 0xd7, 0x5a, 0x63, 0x91, 0xd7, 0xee, 0xc7, 0x63, 0x32, 0xb9, 0x13, 0x07, 0x46, 0x17, 0x06,
+	0xdc, 0x12, 0x5a, 0x30, 0xbc, 0x8c, 0x1c, 0xb1, 0x7d, 0x1a, 0xb6, 0xe5, 0x70, 0xd7, 0x6b, 0xb1,
+	0x63, 0xc2, 0x13, 0x93, 0xa0, 0xee, 0xa7, 0xf3, 0x7f, 0x62, 0x8c, 0x5c, 0x7d, 0x2e, 0x3f, 0x3a,
+	0x15, 0x6f, 0x3a, 0x15, 0x7f, 0x75, 0x2a, 0x7e, 0xef, 0x55, 0xb4, 0xe9, 0x55, 0xf4, 0xd9, 0xab,
+	0xe8, 0x65, 0xcc, 0xdf, 0x75, 0xf5, 0x3d, 0x00, 0x93, 0xbf, 0xa5, 0xc4, 0x77, 0x01, 0x00, 0x00,
 }
 
 func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
@@ -302,6 +334,20 @@ func (m *ProposalOptions_Rule) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *ProposalOptions_CancelExecution) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CancelExecution != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSampleTest(dAtA, i, uint64(m.CancelExecution.Size()))
+		n5, err := m.CancelExecution.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
 func encodeVarintSampleTest(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -359,6 +405,18 @@ func (m *ProposalOptions_Rule) Size() (n int) {
 	}
 	return n
 }
+func (m *ProposalOptions_CancelExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelExecution != nil {
+		l = m.CancelExecution.Size()
+		n += 1 + l + sovSampleTest(uint64(l))
+	}
+	return n
+}
 
 func sovSampleTest(x uint64) (n int) {
 	for {
@@ -507,6 +565,41 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.Option = &ProposalOptions_Rule{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSampleTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSampleTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSampleTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelProposalExecutionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CancelExecution{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSampleTest(dAtA[iNdEx:])
@@ -0,0 +1,36 @@
+package gov
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sanitizeText returns given text normalized to a valid UTF-8 string, without
+// control characters. Each sequence of invalid bytes is replaced with a
+// single unicode replacement character. All control characters except the
+// new line and the tab are removed, which normalizes line endings to a new
+// line only. The result depends on the input only, so that it can be safely
+// used by the state transition.
+func sanitizeText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	invalid := false
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if r == utf8.RuneError && size == 1 {
+			if !invalid {
+				b.WriteRune(utf8.RuneError)
+			}
+			invalid = true
+			continue
+		}
+		invalid = false
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
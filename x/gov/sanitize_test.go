@@ -0,0 +1,33 @@
+package gov
+
+import "testing"
+
+func TestSanitizeText(t *testing.T) {
+	cases := map[string]struct {
+		Text string
+		Want string
+	}{
+		"empty":                          {Text: "", Want: ""},
+		"markdown is preserved":          {Text: "# Title\n\n* item\t`code`", Want: "# Title\n\n* item\t`code`"},
+		"multibyte characters":           {Text: "zażółć 🚀", Want: "zażółć 🚀"},
+		"carriage return removed":        {Text: "a\r\nb", Want: "a\nb"},
+		"C0 and DEL removed":             {Text: "a\x00\x07\x1b[1mb\x7f", Want: "a[1mb"},
+		"C1 removed":                     {Text: "a\u0085\u009bb", Want: "ab"},
+		"invalid byte replaced":          {Text: "a\xffb", Want: "a�b"},
+		"invalid sequence replaced once": {Text: "a\xff\xfe\xc3b", Want: "a�b"},
+		"truncated rune replaced":        {Text: "ab\xe2\x82", Want: "ab�"},
+		"separate sequences replaced":    {Text: "\xffa\xff", Want: "�a�"},
+		"encoded replacement kept":       {Text: "�", Want: "�"},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := sanitizeText(tc.Text); got != tc.Want {
+				t.Fatalf("want %q, got %q", tc.Want, got)
+			}
+			// Sanitization must be idempotent.
+			if got := sanitizeText(tc.Want); got != tc.Want {
+				t.Fatalf("not idempotent: %q", got)
+			}
+		})
+	}
+}
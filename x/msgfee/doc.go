@@ -12,5 +12,8 @@ This extension does not know of supported (installed) message paths and
 therefore cannot validate for their existence. Make sure that when registering
 a new message fee the path is set correctly.
 
+The whole fee schedule can be fetched using the "/msgfee/schedule" range
+query. It returns the fees ordered by the message path, one page at a time.
+
 */
 package msgfee
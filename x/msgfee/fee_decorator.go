@@ -1,10 +1,14 @@
 package msgfee
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/batch"
 )
 
 // FeeDecorator implements a decorator that for each processed transaction
@@ -13,6 +17,8 @@ import (
 // not increase the required fee value.
 // Additional fee is attached to only those transaction results that represent
 // a success.
+// For a batch transaction, the fees of all batched messages are added to the
+// fee of the batch message and the breakdown is appended to the check log.
 type FeeDecorator struct {
 	bucket orm.ModelBucket
 }
@@ -33,10 +39,14 @@ func (d *FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx
 		return nil, err
 	}
 
-	fee, err := txFee(d.bucket, store, tx)
+	fees, err := txFees(d.bucket, store, tx)
 	if err != nil {
 		return nil, err
 	}
+	fee, err := fees.total()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot sum message type fees")
+	}
 	if !coin.IsEmpty(fee) {
 		total, err := res.RequiredFee.Add(*fee)
 		if err != nil {
@@ -44,6 +54,12 @@ func (d *FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx
 		}
 		res.RequiredFee = total
 	}
+	if fees.batch {
+		if res.Log != "" {
+			res.Log += "\n"
+		}
+		res.Log += fees.String()
+	}
 	return res, nil
 }
 
@@ -53,10 +69,14 @@ func (d *FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 		return nil, err
 	}
 
-	fee, err := txFee(d.bucket, store, tx)
+	fees, err := txFees(d.bucket, store, tx)
 	if err != nil {
 		return nil, err
 	}
+	fee, err := fees.total()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot sum message type fees")
+	}
 	if !coin.IsEmpty(fee) {
 		total, err := res.RequiredFee.Add(*fee)
 		if err != nil {
@@ -67,13 +87,88 @@ func (d *FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 	return res, nil
 }
 
-// txFee returns the fee value for a given transaction as configured in the
-// store. This function returns nil fee value if none was set.
-func txFee(fees orm.ModelBucket, store weave.KVStore, tx weave.Tx) (*coin.Coin, error) {
+// msgFees is a breakdown of the fees required by a transaction. A batch
+// transaction requires the fee for the batch message itself and the fee of
+// each of the batched messages.
+type msgFees struct {
+	batch bool
+	fees  []pathFee
+}
+
+type pathFee struct {
+	path string
+	fee  *coin.Coin
+}
+
+// total returns the sum of all fees or nil if no fee is required.
+func (m *msgFees) total() (*coin.Coin, error) {
+	var total *coin.Coin
+	for _, f := range m.fees {
+		if coin.IsEmpty(f.fee) {
+			continue
+		}
+		if total == nil {
+			total = f.fee.Clone()
+			continue
+		}
+		sum, err := total.Add(*f.fee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fee of %q", f.path)
+		}
+		total = &sum
+	}
+	return total, nil
+}
+
+// String returns a human readable representation of the fee breakdown, one
+// message per line.
+func (m *msgFees) String() string {
+	lines := make([]string, 0, len(m.fees))
+	for _, f := range m.fees {
+		fee := "none"
+		if !coin.IsEmpty(f.fee) {
+			fee = f.fee.String()
+		}
+		lines = append(lines, fmt.Sprintf("msgfee %s: %s", f.path, fee))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// txFees returns the fee values for a given transaction as configured in the
+// store. For a batch transaction, the fee of every batched message is
+// included as well.
+func txFees(fees orm.ModelBucket, store weave.KVStore, tx weave.Tx) (*msgFees, error) {
 	msg, err := tx.GetMsg()
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get message")
 	}
+	msgs := []weave.Msg{msg}
+	batchMsg, isBatch := msg.(batch.Msg)
+	if isBatch {
+		inner, err := batchMsg.MsgList()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot retrieve batch messages")
+		}
+		msgs = append(msgs, inner...)
+	}
+
+	res := msgFees{
+		batch: isBatch,
+		fees:  make([]pathFee, 0, len(msgs)),
+	}
+	for _, m := range msgs {
+		fee, err := msgFee(fees, store, m)
+		if err != nil {
+			return nil, err
+		}
+		res.fees = append(res.fees, pathFee{path: m.Path(), fee: fee})
+	}
+	return &res, nil
+}
+
+// msgFee returns the fee value for a given message as configured in the
+// store. This function returns nil fee value if none was set.
+func msgFee(fees orm.ModelBucket, store weave.KVStore, msg weave.Msg) (*coin.Coin, error) {
 	var fee MsgFee
 	switch err := fees.One(store, []byte(msg.Path()), &fee); {
 	case err == nil:
@@ -81,6 +176,6 @@ func txFee(fees orm.ModelBucket, store weave.KVStore, tx weave.Tx) (*coin.Coin,
 	case errors.ErrNotFound.Is(err):
 		return nil, nil
 	default:
-		return nil, errors.Wrap(err, "cannot get fee")
+		return nil, errors.Wrapf(err, "cannot get %q fee", msg.Path())
 	}
 }
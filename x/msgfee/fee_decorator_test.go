@@ -10,6 +10,8 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/batch"
 )
 
 func TestFeeDecorator(t *testing.T) {
@@ -202,3 +204,108 @@ func BenchmarkFeeDecorator(b *testing.B) {
 		})
 	}
 }
+
+func TestFeeDecoratorBatch(t *testing.T) {
+	fees := []MsgFee{
+		{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "batch/execute", Fee: coin.NewCoin(0, 100, "IOV")},
+		{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/bar", Fee: coin.NewCoin(1, 500000000, "IOV")},
+		{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/baz", Fee: coin.NewCoin(2, 700000000, "IOV")},
+		{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/eth", Fee: coin.NewCoin(1, 0, "ETH")},
+	}
+
+	cases := map[string]struct {
+		Msgs         []weave.Msg
+		Handler      weave.Handler
+		WantErr      *errors.Error
+		WantFee      coin.Coin
+		WantCheckLog string
+	}{
+		"fees of all messages are summed": {
+			Msgs: []weave.Msg{
+				&weavetest.Msg{RoutePath: "foo/bar"},
+				&weavetest.Msg{RoutePath: "foo/baz"},
+				&weavetest.Msg{RoutePath: "foo/bar"},
+			},
+			Handler: &weavetest.Handler{},
+			WantFee: coin.NewCoin(5, 700000100, "IOV"),
+			WantCheckLog: "msgfee batch/execute: 0.0000001 IOV\n" +
+				"msgfee foo/bar: 1.5 IOV\n" +
+				"msgfee foo/baz: 2.7 IOV\n" +
+				"msgfee foo/bar: 1.5 IOV",
+		},
+		"messages without a fee are included in the breakdown": {
+			Msgs: []weave.Msg{
+				&weavetest.Msg{RoutePath: "foo/nofee"},
+				&weavetest.Msg{RoutePath: "foo/baz"},
+			},
+			Handler: &weavetest.Handler{
+				CheckResult:   weave.CheckResult{Log: "handler", RequiredFee: coin.NewCoin(1, 0, "IOV")},
+				DeliverResult: weave.DeliverResult{RequiredFee: coin.NewCoin(1, 0, "IOV")},
+			},
+			WantFee: coin.NewCoin(3, 700000100, "IOV"),
+			WantCheckLog: "handler\n" +
+				"msgfee batch/execute: 0.0000001 IOV\n" +
+				"msgfee foo/nofee: none\n" +
+				"msgfee foo/baz: 2.7 IOV",
+		},
+		"empty batch requires only the batch message fee": {
+			Handler:      &weavetest.Handler{},
+			WantFee:      coin.NewCoin(0, 100, "IOV"),
+			WantCheckLog: "msgfee batch/execute: 0.0000001 IOV",
+		},
+		"fees in different currencies cannot be summed": {
+			Msgs: []weave.Msg{
+				&weavetest.Msg{RoutePath: "foo/bar"},
+				&weavetest.Msg{RoutePath: "foo/eth"},
+			},
+			Handler: &weavetest.Handler{},
+			WantErr: errors.ErrCurrency,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "msgfee")
+			bucket := NewMsgFeeBucket()
+			for i, f := range fees {
+				if _, err := bucket.Put(db, []byte(f.MsgPath), &f); err != nil {
+					t.Fatalf("cannot create #%d transaction fee: %s", i, err)
+				}
+			}
+
+			decorator := NewFeeDecorator()
+			tx := &weavetest.Tx{Msg: &batchMsg{Msg: weavetest.Msg{RoutePath: "batch/execute"}, msgs: tc.Msgs}}
+
+			cres, err := decorator.Check(nil, db, tx, tc.Handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("check returned an unexpected error: %v", err)
+			}
+			if tc.WantErr == nil {
+				if !tc.WantFee.Equals(cres.RequiredFee) {
+					t.Fatalf("unexpected check fee: %v", cres.RequiredFee)
+				}
+				assert.Equal(t, tc.WantCheckLog, cres.Log)
+			}
+
+			dres, err := decorator.Deliver(nil, db, tx, tc.Handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("deliver returned an unexpected error: %v", err)
+			}
+			if tc.WantErr == nil && !tc.WantFee.Equals(dres.RequiredFee) {
+				t.Fatalf("unexpected deliver fee: %v", dres.RequiredFee)
+			}
+		})
+	}
+}
+
+type batchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+var _ batch.Msg = (*batchMsg)(nil)
+
+func (m *batchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
@@ -28,6 +28,7 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 // RegisterQuery register queries from buckets in this package
 func RegisterQuery(qr weave.QueryRouter) {
 	NewMsgFeeBucket().Register("msgfee", qr)
+	NewScheduleQuery().RegisterQuery(qr)
 }
 
 type setMsgFeeHandler struct {
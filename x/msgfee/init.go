@@ -13,16 +13,23 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisMsgFee is the genesis file representation of a message fee.
+type genesisMsgFee struct {
+	MsgPath string    `json:"msg_path"`
+	Fee     coin.Coin `json:"fee"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type msgfee struct {
-		MsgPath string    `json:"msg_path"`
-		Fee     coin.Coin `json:"fee"`
-	}
-	var fees []*msgfee
+	var fees []*genesisMsgFee
 	if err := opts.ReadOptions("msgfee", &fees); err != nil {
 		return errors.Wrap(err, "cannot load fees")
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestGenesis(t *testing.T) {
@@ -100,3 +101,11 @@ func TestGenesisConfigurationIsOptional(t *testing.T) {
 		t.Fatalf("cannot load genesis: %s", err)
 	}
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{"msg_path": "foo/bar", "fee": {"whole": 1, "fractional": 2, "ticker": "DOGE"}},
+		{"msg_path": "a/b", "fee": "0.01 IOV"}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]*genesisMsgFee{})
+}
@@ -0,0 +1,60 @@
+package msgfee
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+var _ weave.QueryHandler = (*ScheduleQuery)(nil)
+
+// ScheduleQuery allows querying the fee schedule, which is the fee of every
+// message path that a fee is set for. Each returned model key is the message
+// path and the value is a serialized coin.Coin with the fee.
+//
+// Using the key query mod returns the fee of a single message path, or
+// nothing if no fee is set. Using the range query mod returns a page of the
+// schedule, ordered by the message path. Query data format is the same as
+// for a bucket range query: <start>[:<end>], where both are hex encoded
+// message paths and start is inclusive. To request the next page, use the
+// last returned path followed by a zero byte as the start.
+type ScheduleQuery struct {
+	fees orm.Bucket
+}
+
+func NewScheduleQuery() *ScheduleQuery {
+	return &ScheduleQuery{fees: orm.NewBucket("msgfee", &MsgFee{})}
+}
+
+func (q *ScheduleQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	switch mod {
+	case weave.KeyQueryMod, weave.RangeQueryMod:
+	default:
+		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+	models, err := q.fees.Query(db, mod, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "load fees")
+	}
+
+	prefix := q.fees.DBKey(nil)
+	res := make([]weave.Model, 0, len(models))
+	for _, m := range models {
+		var f MsgFee
+		if err := f.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrap(err, "unmarshal fee")
+		}
+		raw, err := f.Fee.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal fee")
+		}
+		res = append(res, weave.Pair(bytes.TrimPrefix(m.Key, prefix), raw))
+	}
+	return res, nil
+}
+
+func (q *ScheduleQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/msgfee/schedule", q)
+}
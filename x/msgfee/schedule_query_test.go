@@ -0,0 +1,120 @@
+package msgfee
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestScheduleQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "msgfee")
+
+	fees := NewMsgFeeBucket()
+	// Insert in a reverse order to ensure that the result is ordered by
+	// the path and not by the insertion.
+	for i := 7; i >= 0; i-- {
+		path := fmt.Sprintf("path/%03d", i)
+		_, err := fees.Put(db, []byte(path), &MsgFee{
+			Metadata: &weave.Metadata{Schema: 1},
+			MsgPath:  path,
+			Fee:      coin.NewCoin(int64(i+1), 0, "IOV"),
+		})
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	q := qr.Handler("/msgfee/schedule")
+
+	cases := map[string]struct {
+		Mod       string
+		Data      string
+		WantPaths []string
+		WantErr   *errors.Error
+	}{
+		"whole schedule": {
+			Mod:       weave.RangeQueryMod,
+			WantPaths: paths(0, 8),
+		},
+		"range with a start": {
+			Mod:       weave.RangeQueryMod,
+			Data:      hex.EncodeToString([]byte("path/003")),
+			WantPaths: paths(3, 5),
+		},
+		"range with a start and an end": {
+			Mod:       weave.RangeQueryMod,
+			Data:      hex.EncodeToString([]byte("path/002")) + ":" + hex.EncodeToString([]byte("path/005")),
+			WantPaths: paths(2, 4),
+		},
+		"next page": {
+			Mod:       weave.RangeQueryMod,
+			Data:      hex.EncodeToString([]byte("path/004\x00")),
+			WantPaths: paths(5, 3),
+		},
+		"page after the last path": {
+			Mod:       weave.RangeQueryMod,
+			Data:      hex.EncodeToString([]byte("path/007\x00")),
+			WantPaths: []string{},
+		},
+		"range data must be hex encoded": {
+			Mod:     weave.RangeQueryMod,
+			Data:    "path/001",
+			WantErr: errors.ErrInput,
+		},
+		"exact path": {
+			Mod:       weave.KeyQueryMod,
+			Data:      "path/007",
+			WantPaths: []string{"path/007"},
+		},
+		"exact path without a fee": {
+			Mod:       weave.KeyQueryMod,
+			Data:      "path/unknown",
+			WantPaths: []string{},
+		},
+		"prefix query is not supported": {
+			Mod:     weave.PrefixQueryMod,
+			WantErr: errors.ErrHuman,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := q.Query(db, tc.Mod, []byte(tc.Data))
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			got := make([]string, 0, len(models))
+			for _, m := range models {
+				var fee coin.Coin
+				assert.Nil(t, fee.Unmarshal(m.Value))
+				var i int
+				_, err := fmt.Sscanf(string(m.Key), "path/%03d", &i)
+				assert.Nil(t, err)
+				assert.Equal(t, coin.NewCoin(int64(i+1), 0, "IOV"), fee)
+				got = append(got, string(m.Key))
+			}
+			assert.Equal(t, tc.WantPaths, got)
+		})
+	}
+}
+
+// paths returns n message paths used by the schedule test, starting with
+// given index.
+func paths(start, n int) []string {
+	res := make([]string, 0, n)
+	for i := start; i < start+n; i++ {
+		res = append(res, fmt.Sprintf("path/%03d", i))
+	}
+	return res
+}
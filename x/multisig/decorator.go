@@ -1,17 +1,27 @@
 package multisig
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/sigs"
 )
 
 const (
 	multisigParticipantGasCost = 10
 )
 
-// Decorator checks multisig contract if available
+// Decorator checks multisig contract if available.
+//
+// Each contract referenced by the transaction is loaded and evaluated once,
+// before any message is processed. The decorator must be placed before the
+// batch decorator, so that a batch transaction is authorized once for all its
+// messages, using the contract state from before the transaction. A contract
+// modified by one of the batched messages does not change the authorization
+// of the following messages.
 type Decorator struct {
 	auth   x.Authenticator
 	bucket orm.ModelBucket
@@ -50,8 +60,16 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 }
 
 func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave.Tx) (weave.Context, int64, error) {
+	reps, err := representations(ctx)
+	if err != nil {
+		return ctx, 0, err
+	}
+
 	multisigContract, ok := tx.(MultiSigTx)
 	if !ok {
+		if len(reps) != 0 {
+			return ctx, 0, errors.Wrap(errors.ErrUnauthorized, "signature on behalf of a contract not referenced by the transaction")
+		}
 		return ctx, 0, nil
 	}
 
@@ -62,8 +80,11 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 			continue
 		}
 
+		represented := representedBy(reps, contractID)
+
 		// A contract can be activated by another contract being fulfilled.
-		if d.auth.HasAddress(ctx, MultiSigCondition(contractID).Address()) {
+		activated := d.auth.HasAddress(ctx, MultiSigCondition(contractID).Address())
+		if activated && len(represented) == 0 {
 			continue
 		}
 
@@ -72,9 +93,21 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 			return ctx, 0, errors.Wrap(err, "cannot load contract from the store")
 		}
 
+		// Each signature on behalf of this contract must be created
+		// by one of its participants.
+		for _, signer := range represented {
+			if !isParticipant(&contract, signer) {
+				return ctx, 0, errors.Wrapf(errors.ErrUnauthorized,
+					"%s is not a participant of %q", signer, contractID)
+			}
+		}
+		if activated {
+			continue
+		}
+
 		var weight Weight
 		for _, p := range contract.Participants {
-			if d.auth.HasAddress(ctx, p.Signature) {
+			if d.auth.HasAddress(ctx, p.Signature) || containsAddress(represented, p.Signature) {
 				weight += p.Weight
 				gasCost += multisigParticipantGasCost
 			}
@@ -88,5 +121,78 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 		ctx = withMultisig(ctx, contractID)
 	}
 
+	for _, r := range reps {
+		if !containsID(ids, r.contractID) {
+			return ctx, 0, errors.Wrapf(errors.ErrUnauthorized,
+				"signature on behalf of contract %q not referenced by the transaction", r.contractID)
+		}
+	}
+
 	return ctx, gasCost, nil
 }
+
+// representation is a signature created by a participant on behalf of a
+// multisig contract.
+type representation struct {
+	contractID []byte
+	signer     weave.Address
+}
+
+// representations returns all signatures of the current context that were
+// created on behalf of a multisig contract, in the order of signing.
+// Multisig contracts are the only conditions that a signature can be created
+// on behalf of, so a signature on behalf of any other condition is rejected.
+func representations(ctx weave.Context) ([]representation, error) {
+	var reps []representation
+	for _, r := range sigs.GetRepresentations(ctx) {
+		ext, typ, data, err := r.Condition.Parse()
+		if err != nil {
+			return nil, errors.Wrap(err, "represented condition")
+		}
+		if ext != "multisig" || typ != "usage" {
+			return nil, errors.Wrapf(errors.ErrUnauthorized,
+				"signature on behalf of %s is not supported", r.Condition)
+		}
+		reps = append(reps, representation{contractID: data, signer: r.Signer.Address()})
+	}
+	return reps, nil
+}
+
+// representedBy returns the addresses of all signers that signed on behalf of
+// the contract with given ID.
+func representedBy(reps []representation, contractID []byte) []weave.Address {
+	var signers []weave.Address
+	for _, r := range reps {
+		if bytes.Equal(r.contractID, contractID) {
+			signers = append(signers, r.signer)
+		}
+	}
+	return signers
+}
+
+func isParticipant(c *Contract, addr weave.Address) bool {
+	for _, p := range c.Participants {
+		if p.Signature.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addrs []weave.Address, addr weave.Address) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids [][]byte, id []byte) bool {
+	for _, i := range ids {
+		if bytes.Equal(i, id) {
+			return true
+		}
+	}
+	return false
+}
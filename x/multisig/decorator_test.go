@@ -1,15 +1,18 @@
 package multisig
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/batch"
 )
 
 func TestDecorator(t *testing.T) {
@@ -140,6 +143,189 @@ func TestDecorator(t *testing.T) {
 	}
 }
 
+func TestDecoratorLoadsContractOnce(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	a := weavetest.NewCondition()
+	contractID := createContract(t, db, Contract{
+		Metadata:            &weave.Metadata{Schema: 1},
+		Participants:        []*Participant{{Weight: 1, Signature: a.Address()}},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+	})
+
+	ctx := weave.WithHeight(context.Background(), 100)
+	auth := &weavetest.CtxAuth{Key: "authKey"}
+	ctx = auth.SetConditions(ctx, a)
+	d := NewDecorator(x.ChainAuth(auth, Authenticate{}))
+	var hn MultisigCheckHandler
+	stack := weavetest.Decorate(&hn, d)
+
+	// Contract listed many times is evaluated only once.
+	tx := ContractTx{
+		Tx:         &weavetest.Tx{Msg: &weavetest.Msg{}},
+		MultisigID: [][]byte{contractID, contractID, contractID},
+	}
+	reads := &countingStore{KVStore: db}
+	cres, err := stack.Check(ctx, reads, tx)
+	if err != nil {
+		t.Fatalf("check: %+v", err)
+	}
+	if cres.GasPayment != multisigParticipantGasCost {
+		t.Errorf("want %d gas payment, got %d", multisigParticipantGasCost, cres.GasPayment)
+	}
+	if reads.gets[string(contractID)] != 1 {
+		t.Errorf("want contract loaded once, got %d", reads.gets[string(contractID)])
+	}
+}
+
+func TestDecoratorBatchUpdatingContract(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	a := weavetest.NewCondition()
+	b := weavetest.NewCondition()
+	contractID := createContract(t, db, Contract{
+		Metadata:            &weave.Metadata{Schema: 1},
+		Participants:        []*Participant{{Weight: 1, Signature: a.Address()}},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+	})
+
+	ctx := weave.WithHeight(context.Background(), 100)
+	auth := &weavetest.CtxAuth{Key: "authKey"}
+	ctx = auth.SetConditions(ctx, a)
+	var hn MultisigCheckHandler
+	stack := batchStack(x.ChainAuth(auth, Authenticate{}), &hn)
+
+	// The first message removes the only signer from the contract. The
+	// second message is authorized using the contract state from before
+	// the transaction.
+	tx := ContractTx{
+		Tx: &weavetest.Tx{Msg: &batchMsg{msgs: []weave.Msg{
+			&UpdateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ContractID:          contractID,
+				Participants:        []*Participant{{Weight: 1, Signature: b.Address()}},
+				ActivationThreshold: 1,
+				AdminThreshold:      1,
+			},
+			&weavetest.Msg{RoutePath: "test/msg"},
+		}}},
+		MultisigID: [][]byte{contractID},
+	}
+	if _, err := stack.Deliver(ctx, db, tx); err != nil {
+		t.Fatalf("deliver: %+v", err)
+	}
+	if !MultiSigCondition(contractID).Equals(hn.Perms[0]) {
+		t.Fatalf("want contract condition, got %v", hn.Perms)
+	}
+	var c Contract
+	if err := NewContractBucket().One(db, contractID, &c); err != nil {
+		t.Fatalf("cannot load contract: %s", err)
+	}
+	if !c.Participants[0].Signature.Equals(b.Address()) {
+		t.Fatalf("contract not updated: %v", c.Participants)
+	}
+
+	// The update is visible to the following transactions.
+	tx.Tx = &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}}
+	if _, err := stack.Deliver(ctx, db, tx); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+}
+
+func BenchmarkDecoratorBatch(b *testing.B) {
+	cases := map[string]int{
+		"one message":      1,
+		"max batch length": batch.MaxBatchMessages,
+	}
+	for testName, size := range cases {
+		b.Run(testName, func(b *testing.B) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "multisig")
+
+			a := weavetest.NewCondition()
+			contractID := createContract(b, db, Contract{
+				Metadata:            &weave.Metadata{Schema: 1},
+				Participants:        []*Participant{{Weight: 1, Signature: a.Address()}},
+				ActivationThreshold: 1,
+				AdminThreshold:      1,
+			})
+
+			ctx := weave.WithHeight(context.Background(), 100)
+			auth := &weavetest.CtxAuth{Key: "authKey"}
+			ctx = auth.SetConditions(ctx, a)
+			var hn MultisigCheckHandler
+			stack := batchStack(x.ChainAuth(auth, Authenticate{}), &hn)
+
+			msgs := make([]weave.Msg, size)
+			for i := range msgs {
+				msgs[i] = &weavetest.Msg{RoutePath: "test/msg"}
+			}
+			tx := ContractTx{
+				Tx:         &weavetest.Tx{Msg: &batchMsg{msgs: msgs}},
+				MultisigID: [][]byte{contractID},
+			}
+			reads := &countingStore{KVStore: db}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := stack.Deliver(ctx, reads, tx); err != nil {
+					b.Fatalf("deliver: %+v", err)
+				}
+			}
+			b.StopTimer()
+
+			// Contract reads do not depend on the batch length.
+			if got := reads.gets[string(contractID)]; got != b.N {
+				b.Fatalf("want %d contract reads, got %d", b.N, got)
+			}
+			b.ReportMetric(float64(reads.gets[string(contractID)])/float64(b.N), "contract-reads/op")
+		})
+	}
+}
+
+// batchStack returns a handler with the multisig decorator placed before
+// the batch decorator, as in the application stack. Update contract
+// messages are handled by the multisig handler and all other messages by
+// the given handler.
+func batchStack(auth x.Authenticator, h weave.Handler) weave.Handler {
+	r := app.NewRouter()
+	RegisterRoutes(r, auth)
+	r.Handle(&weavetest.Msg{RoutePath: "test/msg"}, h)
+	return weavetest.Decorate(weavetest.Decorate(r, batch.NewDecorator()), NewDecorator(auth))
+}
+
+// batchMsg is a batch message containing given messages.
+type batchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+var _ batch.Msg = (*batchMsg)(nil)
+
+func (m *batchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
+
+// countingStore counts reads of each key, ignoring the bucket prefix.
+type countingStore struct {
+	weave.KVStore
+	gets map[string]int
+}
+
+func (s *countingStore) Get(key []byte) ([]byte, error) {
+	if s.gets == nil {
+		s.gets = make(map[string]int)
+	}
+	if i := bytes.IndexByte(key, ':'); i >= 0 {
+		s.gets[string(key[i+1:])]++
+	}
+	return s.KVStore.Get(key)
+}
+
 // MultisigCheckHandler stores the seen permissions on each call
 // for this extension's authenticator (ie. multisig.Authenticate)
 type MultisigCheckHandler struct {
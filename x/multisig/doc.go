@@ -9,6 +9,12 @@ A `Decorator` is designed as middleware to load and validate the signatures of a
 When the threshold is reached a `MultiSigCondition` is stored into the request context.
 This condition can be resolved to an address by the multisig `Authenticator` when authenticating the request in a handler.
 
+A participant can sign on behalf of a contract instead of signing personally, by setting the signature `condition` to
+the `MultiSigCondition` of the contract. Such signature counts only toward the activation of that contract and does not
+authorize the participant's own address. The `Decorator` rejects a signature on behalf of a contract if the signer is
+not its participant or if the contract is not referenced by the transaction. A signature on behalf of any condition
+other than a multisig contract is rejected as well.
+
 An `Initializer` can be instrumented to define multisig contracts in the Genesis file and load them on startup.
 The transaction `Handlers` provide functionality for persistent updates and new contracts.
 
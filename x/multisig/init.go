@@ -9,19 +9,27 @@ import (
 // file
 type Initializer struct{}
 
-var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.DependentInitializer = (*Initializer)(nil)
+
+// Dependencies implements weave.DependentInitializer.
+func (*Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
+
+// genesisContract is the genesis file representation of a contract.
+type genesisContract struct {
+	Participants []struct {
+		Signature weave.Address `json:"signature"`
+		Weight    Weight        `json:"weight"`
+	} `json:"participants"`
+	ActivationThreshold Weight `json:"activation_threshold"`
+	AdminThreshold      Weight `json:"admin_threshold"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it in the
 // database.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var contracts []struct {
-		Participants []struct {
-			Signature weave.Address `json:"signature"`
-			Weight    Weight        `json:"weight"`
-		} `json:"participants"`
-		ActivationThreshold Weight `json:"activation_threshold"`
-		AdminThreshold      Weight `json:"admin_threshold"`
-	}
+	var contracts []genesisContract
 	if err := opts.ReadOptions("multisig", &contracts); err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestGenesisKey(t *testing.T) {
@@ -73,3 +74,17 @@ func fromHex(t *testing.T, s string) []byte {
 	}
 	return raw
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `[
+		{
+			"participants": [
+				{"signature": "0102030405060708090021222324252627282930", "weight": 2},
+				{"signature": "seq:test/alice/1", "weight": 1}
+			],
+			"activation_threshold": 2,
+			"admin_threshold": 3
+		}
+	]`
+	assert.JSONRoundTrip(t, []byte(genesis), &[]genesisContract{})
+}
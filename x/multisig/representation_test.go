@@ -0,0 +1,223 @@
+package multisig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+// TestSignatureOnBehalfOfContract ensures that participants can authorize a
+// multisig contract using signatures created on behalf of it, next to a
+// personal signature of another signer in the same transaction.
+func TestSignatureOnBehalfOfContract(t *testing.T) {
+	const chainID = "repr-chain"
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	alice := weavetest.NewKey()
+	bobby := weavetest.NewKey()
+	operator := weavetest.NewKey()
+	stranger := weavetest.NewKey()
+
+	db := store.MemStore()
+	for _, pkg := range []string{"sigs", "multisig", "cash", "escrow"} {
+		migration.MustInitPkg(db, pkg)
+	}
+
+	contractID := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: alice.PublicKey().Address()},
+			{Weight: 1, Signature: bobby.PublicKey().Address()},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      3,
+	})
+	treasury := MultiSigCondition(contractID)
+
+	ctrl := cash.NewController(cash.NewBucket())
+	assert.Nil(t, ctrl.CoinMint(db, treasury.Address(), coin.NewCoin(100, 0, "IOV")))
+	assert.Nil(t, ctrl.CoinMint(db, alice.PublicKey().Address(), coin.NewCoin(10, 0, "IOV")))
+
+	auth := x.ChainAuth(sigs.Authenticate{}, Authenticate{})
+	rt := app.NewRouter()
+	cash.RegisterRoutes(rt, auth, ctrl)
+	escrow.RegisterRoutes(rt, auth, ctrl)
+	handler := app.ChainDecorators(
+		sigs.NewDecorator(),
+		NewDecorator(auth),
+	).WithHandler(rt)
+
+	sequences := make(map[string]int64)
+	deliverAs := func(cond weave.Condition, msg weave.Msg, contracts [][]byte, personal []crypto.Signer, onBehalf []crypto.Signer) error {
+		t.Helper()
+		tx := &signedMultisigTx{
+			Tx:         &weavetest.Tx{Msg: msg},
+			MultisigID: contracts,
+		}
+		for _, s := range personal {
+			sig, err := sigs.SignTx(s, tx, chainID, sequences[s.PublicKey().String()])
+			assert.Nil(t, err)
+			tx.Signatures = append(tx.Signatures, sig)
+		}
+		for _, s := range onBehalf {
+			sig, err := sigs.SignTxOnBehalf(s, tx, chainID, cond, sequences[s.PublicKey().String()])
+			assert.Nil(t, err)
+			tx.Signatures = append(tx.Signatures, sig)
+		}
+
+		ctx := weave.WithChainID(context.Background(), chainID)
+		ctx = weave.WithHeight(ctx, 1)
+		ctx = weave.WithBlockTime(ctx, now)
+		cache := db.CacheWrap()
+		if _, err := handler.Deliver(ctx, cache, tx); err != nil {
+			cache.Discard()
+			return err
+		}
+		assert.Nil(t, cache.Write())
+		for _, s := range append(personal, onBehalf...) {
+			sequences[s.PublicKey().String()]++
+		}
+		return nil
+	}
+
+	deliver := func(msg weave.Msg, contracts [][]byte, personal []crypto.Signer, onBehalf []crypto.Signer) error {
+		t.Helper()
+		return deliverAs(treasury, msg, contracts, personal, onBehalf)
+	}
+
+	payout := func(amount int64) *cash.SendMsg {
+		return &cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      treasury.Address(),
+			Destination: operator.PublicKey().Address(),
+			Amount:      coin.NewCoinp(amount, 0, "IOV"),
+		}
+	}
+
+	// Treasury payout authorized by both participants, co-signed by the
+	// operator using a personal signature.
+	err := deliver(payout(10), [][]byte{contractID}, []crypto.Signer{operator}, []crypto.Signer{alice, bobby})
+	assert.Nil(t, err)
+	assertBalance(t, ctrl, db, operator.PublicKey().Address(), coin.NewCoin(10, 0, "IOV"))
+
+	// Signatures are required from both participants.
+	err = deliver(payout(10), [][]byte{contractID}, []crypto.Signer{operator}, []crypto.Signer{alice})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	// Only a participant can sign on behalf of the contract.
+	err = deliver(payout(10), [][]byte{contractID}, nil, []crypto.Signer{alice, stranger})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	// Contract that a signature is created on behalf of must be
+	// referenced by the transaction.
+	err = deliver(payout(10), nil, []crypto.Signer{operator}, []crypto.Signer{alice, bobby})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	// Signature on behalf of the contract does not authorize the signer's
+	// own address.
+	err = deliver(&cash.SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      alice.PublicKey().Address(),
+		Destination: operator.PublicKey().Address(),
+		Amount:      coin.NewCoinp(1, 0, "IOV"),
+	}, [][]byte{contractID}, nil, []crypto.Signer{alice, bobby})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	// Signatures can be created only on behalf of a multisig contract.
+	err = deliverAs(alice.PublicKey().Condition(), payout(10), [][]byte{contractID}, []crypto.Signer{operator}, []crypto.Signer{bobby})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+
+	// Treasury funds can be locked in an escrow that the treasury is the
+	// arbiter of and released by the participants.
+	createEscrow := escrow.NewCreateMsg(
+		treasury.Address(),
+		operator.PublicKey().Address(),
+		treasury.Address(),
+		coin.Coins{coin.NewCoinp(50, 0, "IOV")},
+		weave.AsUnixTime(now.Add(time.Hour)),
+		"payout",
+	)
+	err = deliver(createEscrow, [][]byte{contractID}, nil, []crypto.Signer{alice, bobby})
+	assert.Nil(t, err)
+
+	release := &escrow.ReleaseMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: weavetest.SequenceID(1),
+		Amount:   coin.Coins{coin.NewCoinp(20, 0, "IOV")},
+	}
+	err = deliver(release, [][]byte{contractID}, []crypto.Signer{operator}, []crypto.Signer{bobby})
+	if !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	err = deliver(release, [][]byte{contractID}, []crypto.Signer{operator}, []crypto.Signer{alice, bobby})
+	assert.Nil(t, err)
+
+	assertBalance(t, ctrl, db, operator.PublicKey().Address(), coin.NewCoin(30, 0, "IOV"))
+	assertBalance(t, ctrl, db, treasury.Address(), coin.NewCoin(40, 0, "IOV"))
+	assertBalance(t, ctrl, db, alice.PublicKey().Address(), coin.NewCoin(10, 0, "IOV"))
+}
+
+func assertBalance(t testing.TB, ctrl cash.Controller, db weave.KVStore, addr weave.Address, want coin.Coin) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.Coins{&want}, got)
+}
+
+// signedMultisigTx is a transaction that can carry both signatures and
+// multisig contract references.
+type signedMultisigTx struct {
+	weave.Tx
+	Signatures []*sigs.StdSignature
+	MultisigID [][]byte
+}
+
+var _ sigs.SignedTx = (*signedMultisigTx)(nil)
+var _ MultiSigTx = (*signedMultisigTx)(nil)
+
+func (tx *signedMultisigTx) GetSignatures() []*sigs.StdSignature {
+	return tx.Signatures
+}
+
+func (tx *signedMultisigTx) GetMultisig() [][]byte {
+	return tx.MultisigID
+}
+
+func (tx *signedMultisigTx) GetSignBytes() ([]byte, error) {
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range tx.MultisigID {
+		bz = append(bz, id...)
+	}
+	return bz, nil
+}
@@ -54,6 +54,20 @@ type PaymentChannel struct {
 	Transferred *coin.Coin `protobuf:"bytes,8,opt,name=transferred,proto3" json:"transferred,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,9,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Close delay is the length of the dispute window. When not zero, closing
+	// the channel by anyone but the destination does not release the funds
+	// immediately. Instead, the channel is settled once the delay passes.
+	CloseDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,10,opt,name=close_delay,json=closeDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"close_delay,omitempty"`
+	// Close deadline is the time when a channel that is being closed is
+	// settled. Zero if the channel is not being closed.
+	CloseDeadline github_com_iov_one_weave.UnixTime `protobuf:"varint,11,opt,name=close_deadline,json=closeDeadline,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"close_deadline,omitempty"`
+	// Claimed is the total amount that the destination receives when the
+	// channel is settled. It is set when the channel closing is requested and
+	// can be increased by a dispute until the close deadline.
+	Claimed *coin.Coin `protobuf:"bytes,12,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// Settle task ID is the ID of the task scheduled to settle the channel at
+	// the close deadline.
+	SettleTaskID []byte `protobuf:"bytes,13,opt,name=settle_task_id,json=settleTaskId,proto3" json:"settle_task_id,omitempty"`
 }
 
 func (m *PaymentChannel) Reset()         { *m = PaymentChannel{} }
@@ -152,6 +166,34 @@ func (m *PaymentChannel) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *PaymentChannel) GetCloseDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.CloseDelay
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetCloseDeadline() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CloseDeadline
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetClaimed() *coin.Coin {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *PaymentChannel) GetSettleTaskID() []byte {
+	if m != nil {
+		return m.SettleTaskID
+	}
+	return nil
+}
+
 // CreateMsg creates a new payment channel that can be used to
 // transfer value between two parties.
 //
@@ -171,6 +213,9 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// Max length 128 character.
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Length of the dispute window that delays the settlement of a channel
+	// closed by anyone but the destination. Zero settles immediately.
+	CloseDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=close_delay,json=closeDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"close_delay,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
@@ -255,6 +300,13 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetCloseDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.CloseDelay
+	}
+	return 0
+}
+
 // Payment is created by the source. Source should give the message to the
 // destination, so that it can be redeemed at any time.
 //
@@ -458,53 +510,185 @@ func (m *CloseMsg) GetMemo() string {
 	return ""
 }
 
+// DisputeCloseMsg is submitted during the dispute window of a channel that is
+// being closed, in order to claim a higher transferred amount than the one
+// declared by the closing party. It can be submitted by anyone holding a
+// payment signed by the source, for example a watchtower acting on behalf of
+// the destination. Payment amount must be greater than the currently claimed
+// one.
+type DisputeCloseMsg struct {
+	Metadata  *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Payment   *Payment          `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	Signature *crypto.Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DisputeCloseMsg) Reset()         { *m = DisputeCloseMsg{} }
+func (m *DisputeCloseMsg) String() string { return proto.CompactTextString(m) }
+func (*DisputeCloseMsg) ProtoMessage()    {}
+func (*DisputeCloseMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{5}
+}
+func (m *DisputeCloseMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisputeCloseMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisputeCloseMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisputeCloseMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeCloseMsg.Merge(m, src)
+}
+func (m *DisputeCloseMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisputeCloseMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeCloseMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeCloseMsg proto.InternalMessageInfo
+
+func (m *DisputeCloseMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DisputeCloseMsg) GetPayment() *Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *DisputeCloseMsg) GetSignature() *crypto.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// SettleMsg settles a channel that is being closed, once its close deadline
+// is reached. Claimed amount is released to the destination and the rest of
+// the funds is returned to the source. This message is executed by the cron
+// at the close deadline, but it can be submitted by anyone after that time.
+type SettleMsg struct {
+	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ChannelID []byte          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *SettleMsg) Reset()         { *m = SettleMsg{} }
+func (m *SettleMsg) String() string { return proto.CompactTextString(m) }
+func (*SettleMsg) ProtoMessage()    {}
+func (*SettleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf7b5492d84b22a, []int{6}
+}
+func (m *SettleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleMsg.Merge(m, src)
+}
+func (m *SettleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleMsg proto.InternalMessageInfo
+
+func (m *SettleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SettleMsg) GetChannelID() []byte {
+	if m != nil {
+		return m.ChannelID
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PaymentChannel)(nil), "paychan.PaymentChannel")
 	proto.RegisterType((*CreateMsg)(nil), "paychan.CreateMsg")
 	proto.RegisterType((*Payment)(nil), "paychan.Payment")
 	proto.RegisterType((*TransferMsg)(nil), "paychan.TransferMsg")
 	proto.RegisterType((*CloseMsg)(nil), "paychan.CloseMsg")
+	proto.RegisterType((*DisputeCloseMsg)(nil), "paychan.DisputeCloseMsg")
+	proto.RegisterType((*SettleMsg)(nil), "paychan.SettleMsg")
 }
 
 func init() { proto.RegisterFile("x/paychan/codec.proto", fileDescriptor_daf7b5492d84b22a) }
 
 var fileDescriptor_daf7b5492d84b22a = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x34, 0x8e, 0xc7, 0x2d, 0x94, 0x05, 0x24, 0x2b, 0x07, 0xc7, 0x44, 0x80, 0x22,
-	0x28, 0xb6, 0x54, 0x24, 0x4e, 0x08, 0x44, 0x52, 0x21, 0x45, 0xa8, 0x52, 0x64, 0xca, 0xb9, 0xda,
-	0xd8, 0x43, 0xb2, 0x22, 0xde, 0x8d, 0xec, 0x75, 0xa9, 0xff, 0x82, 0x1b, 0xbf, 0xc4, 0xb1, 0x47,
-	0x4e, 0x51, 0x95, 0x9c, 0xf9, 0x81, 0x9e, 0x90, 0xed, 0x4d, 0x9b, 0x82, 0x38, 0xf8, 0xc0, 0xad,
-	0xb7, 0xd1, 0x9b, 0x37, 0x3b, 0x33, 0x6f, 0x67, 0x06, 0x1e, 0x9e, 0x79, 0x73, 0x9a, 0x05, 0x53,
-	0xca, 0xbd, 0x40, 0x84, 0x18, 0xb8, 0xf3, 0x58, 0x48, 0x41, 0x74, 0x05, 0xb6, 0xcd, 0x0d, 0xb4,
-	0xbd, 0x17, 0x08, 0x76, 0x83, 0xd7, 0xbe, 0x1f, 0xc4, 0xd9, 0x5c, 0x0a, 0x2f, 0x12, 0x21, 0xce,
-	0x12, 0x05, 0x3e, 0x98, 0x88, 0x89, 0x28, 0x4c, 0x2f, 0xb7, 0x4a, 0xb4, 0x7b, 0x51, 0x87, 0x3b,
-	0x23, 0x9a, 0x45, 0xc8, 0xe5, 0x60, 0x4a, 0x39, 0xc7, 0x19, 0x79, 0x0e, 0xad, 0x08, 0x25, 0x0d,
-	0xa9, 0xa4, 0x96, 0xe6, 0x68, 0x3d, 0xf3, 0xe0, 0xae, 0xfb, 0x15, 0xe9, 0x29, 0xba, 0x47, 0x0a,
-	0xf6, 0xaf, 0x08, 0xe4, 0x35, 0x34, 0x13, 0x91, 0xc6, 0x01, 0x5a, 0x5b, 0x8e, 0xd6, 0xdb, 0xe9,
-	0x3f, 0xbe, 0x5c, 0x74, 0x9c, 0x09, 0x93, 0xd3, 0x74, 0xec, 0x06, 0x22, 0xf2, 0x98, 0x38, 0x7d,
-	0x21, 0x38, 0x7a, 0xe5, 0x03, 0xef, 0xc2, 0x30, 0xc6, 0x24, 0xf1, 0x55, 0x0c, 0x79, 0x05, 0xbb,
-	0xa5, 0x75, 0x32, 0x4f, 0xc7, 0x5f, 0x30, 0xb3, 0xea, 0x45, 0xbe, 0x7b, 0x6e, 0xd9, 0x80, 0x3b,
-	0x4a, 0xc7, 0x33, 0x16, 0x7c, 0xc0, 0xcc, 0xdf, 0x29, 0x79, 0xa3, 0x82, 0x46, 0xde, 0x83, 0x19,
-	0x62, 0x22, 0x19, 0xa7, 0x92, 0x09, 0x6e, 0x35, 0x2a, 0xa4, 0xde, 0x0c, 0x24, 0x0e, 0x6c, 0x4b,
-	0x21, 0xe9, 0xcc, 0xda, 0x2e, 0xf2, 0x82, 0x9b, 0x4b, 0xe9, 0x0e, 0x04, 0xe3, 0x7e, 0xe9, 0x20,
-	0x6f, 0x41, 0x97, 0x2c, 0x42, 0x91, 0x4a, 0xab, 0xe9, 0x68, 0xbd, 0x7a, 0xff, 0xc9, 0xe5, 0xa2,
-	0xf3, 0xe8, 0x9f, 0x59, 0x3e, 0x71, 0x76, 0x76, 0xcc, 0x22, 0xf4, 0xd7, 0x51, 0x84, 0x40, 0x23,
-	0xc2, 0x48, 0x58, 0xba, 0xa3, 0xf5, 0x0c, 0xbf, 0xb0, 0xc9, 0x3e, 0x98, 0x32, 0xa6, 0x3c, 0xf9,
-	0x8c, 0x71, 0x8c, 0xa1, 0xd5, 0xfa, 0x2b, 0xf9, 0xa6, 0x9b, 0xbc, 0x01, 0x9d, 0x96, 0xc5, 0x5b,
-	0x46, 0x85, 0x46, 0xd7, 0x41, 0xdd, 0x5f, 0x5b, 0x60, 0x0c, 0x62, 0xa4, 0x12, 0x8f, 0x92, 0xc9,
-	0xed, 0xef, 0xfe, 0xef, 0xdf, 0xed, 0x7e, 0xd7, 0x40, 0x57, 0x2b, 0x45, 0x9e, 0x42, 0x2b, 0x98,
-	0x52, 0xc6, 0x4f, 0x58, 0x58, 0xa8, 0x6d, 0xf4, 0xcd, 0xe5, 0xa2, 0xa3, 0x0f, 0x72, 0x6c, 0x78,
-	0xe8, 0xeb, 0x85, 0x73, 0x18, 0x92, 0x7d, 0x80, 0xa0, 0x5c, 0xbf, 0x9c, 0x59, 0x8a, 0xbd, 0xbb,
-	0x5c, 0x74, 0x0c, 0xb5, 0x94, 0xc3, 0x43, 0xdf, 0x50, 0x84, 0x61, 0x48, 0xba, 0xd0, 0xa4, 0x91,
-	0x48, 0xb9, 0x54, 0x8a, 0x6e, 0x76, 0xa6, 0x3c, 0x57, 0x95, 0x35, 0x6e, 0x56, 0x66, 0x1e, 0xab,
-	0xc9, 0xaa, 0x3c, 0x0b, 0xcf, 0x20, 0x3f, 0x3f, 0x79, 0x57, 0x45, 0x7d, 0xe6, 0xc1, 0x9e, 0xab,
-	0xce, 0x91, 0xab, 0xba, 0xf5, 0xd7, 0x04, 0xe2, 0x81, 0x91, 0xb0, 0x09, 0xa7, 0x32, 0x8d, 0xf1,
-	0xcf, 0x5f, 0xff, 0xb8, 0x76, 0xf8, 0xd7, 0x9c, 0x6e, 0x06, 0xad, 0xc1, 0x4c, 0x24, 0xd5, 0x27,
-	0xb4, 0x9a, 0x70, 0x6b, 0x51, 0xea, 0xd7, 0xa2, 0xf4, 0xad, 0x1f, 0x4b, 0x5b, 0x3b, 0x5f, 0xda,
-	0xda, 0xc5, 0xd2, 0xd6, 0xbe, 0xad, 0xec, 0xda, 0xf9, 0xca, 0xae, 0xfd, 0x5c, 0xd9, 0xb5, 0x71,
-	0xb3, 0x38, 0x91, 0x2f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x43, 0xfb, 0x17, 0x2b, 0x8e, 0x05,
-	0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xae, 0x7f, 0x69, 0xe3, 0x78, 0x9c, 0xb4, 0xfd, 0x2d, 0x20, 0xad, 0x7a, 0x48, 0x42, 0xd4,
+	0xa2, 0x00, 0xc5, 0x91, 0x8a, 0xd4, 0x13, 0x02, 0x91, 0x44, 0x48, 0x01, 0x2a, 0x55, 0x6e, 0x39,
+	0x47, 0x1b, 0x7b, 0x9a, 0xac, 0x6a, 0x7b, 0x23, 0xef, 0xba, 0x34, 0x6f, 0xc1, 0x8d, 0x03, 0x4f,
+	0xc2, 0x1b, 0x70, 0xec, 0xb1, 0xa7, 0x08, 0xa5, 0x6f, 0xd1, 0x13, 0xf2, 0xbf, 0x36, 0xb4, 0x02,
+	0x29, 0xa0, 0xde, 0xb8, 0x6d, 0x66, 0xbe, 0x6f, 0x67, 0xbe, 0x6f, 0x27, 0x63, 0x78, 0x70, 0xda,
+	0x1a, 0xb3, 0x89, 0x33, 0x62, 0x41, 0xcb, 0x11, 0x2e, 0x3a, 0xd6, 0x38, 0x14, 0x4a, 0x10, 0x3d,
+	0x0b, 0x6e, 0x98, 0x73, 0xd1, 0x8d, 0x75, 0x47, 0xf0, 0x9f, 0x70, 0x1b, 0xf7, 0x9c, 0x70, 0x32,
+	0x56, 0xa2, 0xe5, 0x0b, 0x17, 0x3d, 0x99, 0x05, 0xef, 0x0f, 0xc5, 0x50, 0x24, 0xc7, 0x56, 0x7c,
+	0x4a, 0xa3, 0x8d, 0xf3, 0x15, 0x58, 0xdd, 0x67, 0x13, 0x1f, 0x03, 0xd5, 0x19, 0xb1, 0x20, 0x40,
+	0x8f, 0x3c, 0x85, 0x92, 0x8f, 0x8a, 0xb9, 0x4c, 0x31, 0xaa, 0xd5, 0xb5, 0xa6, 0xb9, 0xb3, 0x66,
+	0x7d, 0x44, 0x76, 0x82, 0xd6, 0x5e, 0x16, 0xb6, 0xaf, 0x00, 0xe4, 0x05, 0x14, 0xa5, 0x88, 0x42,
+	0x07, 0xe9, 0x7f, 0x75, 0xad, 0x59, 0x6e, 0x6f, 0x5e, 0x4e, 0x6b, 0xf5, 0x21, 0x57, 0xa3, 0x68,
+	0x60, 0x39, 0xc2, 0x6f, 0x71, 0x71, 0xf2, 0x4c, 0x04, 0xd8, 0x4a, 0x2f, 0x78, 0xed, 0xba, 0x21,
+	0x4a, 0x69, 0x67, 0x1c, 0xb2, 0x0b, 0x95, 0xf4, 0xd4, 0x1f, 0x47, 0x83, 0x63, 0x9c, 0xd0, 0x42,
+	0x52, 0xef, 0x7f, 0x2b, 0x15, 0x60, 0xed, 0x47, 0x03, 0x8f, 0x3b, 0xef, 0x70, 0x62, 0x97, 0x53,
+	0xdc, 0x7e, 0x02, 0x23, 0x6f, 0xc0, 0x74, 0x51, 0x2a, 0x1e, 0x30, 0xc5, 0x45, 0x40, 0x97, 0x17,
+	0x28, 0x3d, 0x4f, 0x24, 0x75, 0x58, 0x51, 0x42, 0x31, 0x8f, 0xae, 0x24, 0x75, 0xc1, 0x8a, 0xad,
+	0xb4, 0x3a, 0x82, 0x07, 0x76, 0x9a, 0x20, 0xaf, 0x40, 0x57, 0xdc, 0x47, 0x11, 0x29, 0x5a, 0xac,
+	0x6b, 0xcd, 0x42, 0x7b, 0xeb, 0x72, 0x5a, 0x7b, 0xf8, 0xcb, 0x2a, 0x1f, 0x02, 0x7e, 0x7a, 0xc8,
+	0x7d, 0xb4, 0x73, 0x16, 0x21, 0xb0, 0xec, 0xa3, 0x2f, 0xa8, 0x5e, 0xd7, 0x9a, 0x86, 0x9d, 0x9c,
+	0xc9, 0x36, 0x98, 0x2a, 0x64, 0x81, 0x3c, 0xc2, 0x30, 0x44, 0x97, 0x96, 0x6e, 0x15, 0x9f, 0x4f,
+	0x93, 0x97, 0xa0, 0xb3, 0xb4, 0x79, 0x6a, 0x2c, 0x20, 0x34, 0x27, 0x91, 0xb7, 0x60, 0x3a, 0x9e,
+	0x90, 0xd8, 0x77, 0xd1, 0x63, 0x13, 0x0a, 0x89, 0x8c, 0xc7, 0x97, 0xd3, 0xda, 0xd6, 0x6f, 0x65,
+	0x74, 0xa3, 0x30, 0x31, 0xc9, 0x86, 0x84, 0xdd, 0x8d, 0xc9, 0xe4, 0x3d, 0xac, 0xe6, 0x77, 0x31,
+	0xd7, 0xe3, 0x01, 0x52, 0x73, 0x11, 0x57, 0x2a, 0xd9, 0x55, 0x29, 0x97, 0x6c, 0x82, 0xee, 0x78,
+	0x8c, 0xfb, 0xe8, 0xd2, 0xf2, 0x2d, 0x0f, 0xf2, 0x14, 0xd9, 0x85, 0x55, 0x89, 0x4a, 0x79, 0xd8,
+	0x57, 0x4c, 0x1e, 0xf7, 0xb9, 0x4b, 0x2b, 0x89, 0x0d, 0xeb, 0xb3, 0x69, 0xad, 0x7c, 0x90, 0x64,
+	0x0e, 0x99, 0x3c, 0xee, 0x75, 0xed, 0xb2, 0xbc, 0xfe, 0xe5, 0x36, 0xbe, 0x16, 0xc0, 0xe8, 0x84,
+	0xc8, 0x14, 0xee, 0xc9, 0xe1, 0xbf, 0xa9, 0xbe, 0xf3, 0xa9, 0xbe, 0x31, 0x67, 0xa5, 0xbf, 0x98,
+	0xb3, 0xc6, 0x67, 0x0d, 0xf4, 0x6c, 0x2d, 0x91, 0x47, 0x50, 0x72, 0x46, 0x8c, 0x07, 0xf1, 0xcb,
+	0xc7, 0x2f, 0x67, 0xb4, 0xcd, 0xd9, 0xb4, 0xa6, 0x77, 0xe2, 0x58, 0xaf, 0x6b, 0xeb, 0x49, 0xb2,
+	0xe7, 0x92, 0x6d, 0x00, 0x27, 0x5d, 0x61, 0x31, 0x32, 0x7d, 0xb8, 0xca, 0x6c, 0x5a, 0x33, 0xb2,
+	0xc5, 0xd6, 0xeb, 0xda, 0x46, 0x06, 0xe8, 0xb9, 0xa4, 0x01, 0x45, 0xe6, 0x8b, 0x28, 0x50, 0xb4,
+	0x70, 0xcb, 0xa5, 0x2c, 0x73, 0xa5, 0x72, 0xf9, 0x5a, 0x65, 0xdc, 0x99, 0x79, 0x98, 0xfd, 0x3b,
+	0x17, 0x9e, 0xab, 0x27, 0xa0, 0x8f, 0x53, 0x55, 0x49, 0x7f, 0xe6, 0xce, 0xba, 0x95, 0xad, 0x74,
+	0x2b, 0x53, 0x6b, 0xe7, 0x00, 0xd2, 0x02, 0x43, 0xf2, 0x61, 0xc0, 0x54, 0x14, 0xe2, 0xcd, 0x09,
+	0x3a, 0xc8, 0x13, 0xf6, 0x35, 0xa6, 0x31, 0x81, 0x52, 0x27, 0x76, 0x70, 0xe1, 0xae, 0x16, 0x33,
+	0x2e, 0x37, 0xa5, 0x30, 0x67, 0xca, 0x17, 0x0d, 0xd6, 0xba, 0x5c, 0x8e, 0x23, 0x85, 0x7f, 0xd6,
+	0xc2, 0x9d, 0x1a, 0x73, 0x04, 0x46, 0xba, 0x26, 0xee, 0xd6, 0x99, 0x36, 0xfd, 0x36, 0xab, 0x6a,
+	0x67, 0xb3, 0xaa, 0xf6, 0x7d, 0x56, 0xd5, 0x3e, 0x5d, 0x54, 0x97, 0xce, 0x2e, 0xaa, 0x4b, 0xe7,
+	0x17, 0xd5, 0xa5, 0x41, 0x31, 0xf9, 0xd8, 0x3e, 0xff, 0x31, 0x00, 0x66, 0x4f, 0xc7, 0x3a, 0xd8,
+	0x07, 0x00, 0x00,
 }
 
 func (m *PaymentChannel) Marshal() (dAtA []byte, err error) {
@@ -591,6 +775,32 @@ func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if m.CloseDelay != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CloseDelay))
+	}
+	if m.CloseDeadline != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CloseDeadline))
+	}
+	if m.Claimed != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Claimed.Size()))
+		n5, err := m.Claimed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.SettleTaskID) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SettleTaskID)))
+		i += copy(dAtA[i:], m.SettleTaskID)
+	}
 	return i, nil
 }
 
@@ -613,11 +823,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if len(m.Source) > 0 {
 		dAtA[i] = 0x12
@@ -629,11 +839,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SourcePubkey.Size()))
-		n6, err := m.SourcePubkey.MarshalTo(dAtA[i:])
+		n7, err := m.SourcePubkey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if len(m.Destination) > 0 {
 		dAtA[i] = 0x22
@@ -645,11 +855,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Total.Size()))
-		n7, err := m.Total.MarshalTo(dAtA[i:])
+		n8, err := m.Total.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.Timeout != 0 {
 		dAtA[i] = 0x30
@@ -662,6 +872,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if m.CloseDelay != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CloseDelay))
+	}
 	return i, nil
 }
 
@@ -696,11 +911,11 @@ func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Amount.Size()))
-		n8, err := m.Amount.MarshalTo(dAtA[i:])
+		n9, err := m.Amount.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if len(m.Memo) > 0 {
 		dAtA[i] = 0x22
@@ -730,31 +945,31 @@ func (m *TransferMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if m.Payment != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Payment.Size()))
-		n10, err := m.Payment.MarshalTo(dAtA[i:])
+		n11, err := m.Payment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.Signature != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
-		n11, err := m.Signature.MarshalTo(dAtA[i:])
+		n12, err := m.Signature.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	return i, nil
 }
@@ -778,11 +993,11 @@ func (m *CloseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if len(m.ChannelID) > 0 {
 		dAtA[i] = 0x12
@@ -799,6 +1014,88 @@ func (m *CloseMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DisputeCloseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisputeCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n14, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Payment != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Payment.Size()))
+		n15, err := m.Payment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Signature != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
+		n16, err := m.Signature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+
+func (m *SettleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.ChannelID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChannelID)))
+		i += copy(dAtA[i:], m.ChannelID)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -849,6 +1146,20 @@ func (m *PaymentChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.CloseDelay != 0 {
+		n += 1 + sovCodec(uint64(m.CloseDelay))
+	}
+	if m.CloseDeadline != 0 {
+		n += 1 + sovCodec(uint64(m.CloseDeadline))
+	}
+	if m.Claimed != nil {
+		l = m.Claimed.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SettleTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -885,6 +1196,9 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.CloseDelay != 0 {
+		n += 1 + sovCodec(uint64(m.CloseDelay))
+	}
 	return n
 }
 
@@ -955,6 +1269,44 @@ func (m *CloseMsg) Size() (n int) {
 	return n
 }
 
+func (m *DisputeCloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SettleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -1294,17 +1646,125 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseDelay", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
+			m.CloseDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseDeadline", wireType)
+			}
+			m.CloseDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseDeadline |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claimed == nil {
+				m.Claimed = &coin.Coin{}
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettleTaskID = append(m.SettleTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SettleTaskID == nil {
+				m.SettleTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1574,6 +2034,25 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseDelay", wireType)
+			}
+			m.CloseDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2101,6 +2580,290 @@ func (m *CloseMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DisputeCloseMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisputeCloseMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisputeCloseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payment == nil {
+				m.Payment = &Payment{}
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signature == nil {
+				m.Signature = &crypto.Signature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = append(m.ChannelID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChannelID == nil {
+				m.ChannelID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
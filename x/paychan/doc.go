@@ -14,5 +14,14 @@ are made off the chain and therefore are very fast and cheap to execute.
 Payment channel can be closed only by the destination when claiming received
 funds or by the payment channel owner after the deadline was reached.
 
+A payment channel can be created with a close delay. When such channel is
+closed by anyone other than the destination, funds are not released
+immediately. Instead, a dispute window is opened for the duration of the close
+delay. Until the window passes, anyone can submit a payment signed by the
+source that is greater than the currently claimed one. This allows a third
+party watchtower to protect the destination that is offline. Once the dispute
+window passed, the highest claimed amount is settled by a cron task or by
+anyone submitting a settle message.
+
 */
 package paychan
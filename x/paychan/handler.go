@@ -21,7 +21,7 @@ func RegisterQuery(qr weave.QueryRouter) {
 }
 
 // RegisterRouters registers payment channel message handelers in given registry.
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("paychan", r)
 
 	bucket := NewPaymentChannelBucket()
@@ -30,7 +30,11 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller
 	r.Handle(&TransferMsg{},
 		&transferPaymentChannelHandler{auth: auth, bucket: bucket, cash: cash})
 	r.Handle(&CloseMsg{},
-		&closePaymentChannelHandler{auth: auth, bucket: bucket, cash: cash})
+		&closePaymentChannelHandler{auth: auth, bucket: bucket, cash: cash, scheduler: scheduler})
+	r.Handle(&DisputeCloseMsg{},
+		&disputeClosePaymentChannelHandler{bucket: bucket})
+	r.Handle(&SettleMsg{},
+		&settlePaymentChannelHandler{bucket: bucket, cash: cash, scheduler: scheduler})
 }
 
 type createPaymentChannelHandler struct {
@@ -84,6 +88,7 @@ func (h *createPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStor
 		Total:        msg.Total,
 		Timeout:      msg.Timeout,
 		Memo:         msg.Memo,
+		CloseDelay:   msg.CloseDelay,
 		Transferred:  &coin.Coin{Ticker: msg.Total.Ticker},
 		Address:      paymentChannelAccount(key),
 	}
@@ -93,7 +98,7 @@ func (h *createPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStor
 
 	// Move coins from source account and deposit total amount available on
 	// that channels account.
-	if err := h.cash.MoveCoins(db, msg.Source, pc.Address, *msg.Total); err != nil {
+	if err := h.cash.MoveCoins(ctx, db, msg.Source, pc.Address, *msg.Total); err != nil {
 		return nil, errors.Wrap(err, "cannot move coins")
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -136,6 +141,9 @@ func (h *transferPaymentChannelHandler) validate(ctx weave.Context, db weave.KVS
 	if err := h.bucket.One(db, msg.Payment.ChannelID, &pc); err != nil {
 		return nil, err
 	}
+	if pc.IsClosing() {
+		return nil, errors.Wrap(errors.ErrState, "payment channel is closing, submit a dispute instead")
+	}
 
 	// Check signature to ensure the message was not altered.
 	raw, err := msg.Payment.Marshal()
@@ -182,7 +190,7 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 		return nil, errors.Wrap(errors.ErrMsg, "invalid amount")
 	}
 
-	if err := h.cash.MoveCoins(db, pc.Address, pc.Destination, diff); err != nil {
+	if err := h.cash.MoveCoins(ctx, db, pc.Address, pc.Destination, diff); err != nil {
 		return nil, err
 	}
 
@@ -212,9 +220,10 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 }
 
 type closePaymentChannelHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	cash   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	cash      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = (*closePaymentChannelHandler)(nil)
@@ -244,25 +253,198 @@ func (h *closePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore
 		return nil, err
 	}
 
+	if pc.IsClosing() {
+		return nil, errors.Wrap(errors.ErrState, "payment channel is already closing")
+	}
+
+	isDestination := h.auth.HasAddress(ctx, pc.Destination)
 	if !weave.IsExpired(ctx, pc.Timeout) {
 		// If timeout was not reached, only the destination is allowed to
 		// close the channel.
-		if !h.auth.HasAddress(ctx, pc.Destination) {
+		if !isDestination {
 			return nil, errors.Wrap(errors.ErrMsg, "only the destination is allowed to close the channel")
 		}
 	}
 
-	// Before deleting the channel, return to source all leftover funds
-	// that are still allocated on this payment channel account.
-	diff, err := pc.Total.Subtract(*pc.Transferred)
+	// Destination cannot claim more than it was already transferred, so
+	// there is nothing to dispute when it is closing the channel.
+	if isDestination || pc.CloseDelay == 0 {
+		if err := settle(ctx, db, h.bucket, h.cash, msg.ChannelID, &pc, *pc.Transferred); err != nil {
+			return nil, err
+		}
+		return &weave.DeliverResult{}, nil
+	}
+
+	// Otherwise the channel is settled only after the dispute window
+	// passed. Until then, the destination or anyone acting on its behalf
+	// can present a payment signed by the source that was not yet
+	// transferred.
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	claimed := *pc.Transferred
+	pc.Claimed = &claimed
+	pc.CloseDeadline = weave.AsUnixTime(now).Add(pc.CloseDelay.Duration())
+
+	settleMsg := &SettleMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		ChannelID: msg.ChannelID,
+	}
+	pc.SettleTaskID, err = h.scheduler.Schedule(db, pc.CloseDeadline.Time(), nil, settleMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule settlement")
+	}
+
+	if _, err := h.bucket.Put(db, msg.ChannelID, &pc); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+type disputeClosePaymentChannelHandler struct {
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = (*disputeClosePaymentChannelHandler)(nil)
+
+func (h *disputeClosePaymentChannelHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: transferPaymentChannelCost}, nil
+}
+
+// validate returns the message and the payment channel it disputes. Anyone
+// is allowed to submit a dispute, because only payments signed by the source
+// are accepted.
+func (h *disputeClosePaymentChannelHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*DisputeCloseMsg, *PaymentChannel, error) {
+	var msg DisputeCloseMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if weave.GetChainID(ctx) != msg.Payment.ChainID {
+		return nil, nil, errors.Wrap(errors.ErrMsg, "invalid chain ID")
+	}
+
+	var pc PaymentChannel
+	if err := h.bucket.One(db, msg.Payment.ChannelID, &pc); err != nil {
+		return nil, nil, err
+	}
+	if !pc.IsClosing() {
+		return nil, nil, errors.Wrap(errors.ErrState, "payment channel is not closing")
+	}
+	if weave.IsExpired(ctx, pc.CloseDeadline) {
+		return nil, nil, errors.Wrap(errors.ErrExpired, "dispute window is closed")
+	}
+
+	raw, err := msg.Payment.Marshal()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot serialize payment")
+	}
+	if !pc.SourcePubkey.Verify(raw, msg.Signature) {
+		return nil, nil, errors.Wrap(errors.ErrMsg, "invalid signature")
+	}
+	if !msg.Payment.Amount.SameType(*pc.Total) {
+		return nil, nil, errors.Wrap(errors.ErrMsg, "amount and total amount use different ticker")
+	}
+	if msg.Payment.Amount.Compare(*pc.Total) > 0 {
+		return nil, nil, errors.Wrap(errors.ErrMsg, "amount greater than total amount")
+	}
+	// Only a payment greater than the currently claimed one can win the
+	// dispute.
+	if msg.Payment.Amount.Compare(*pc.Claimed) <= 0 {
+		return nil, nil, errors.Wrap(errors.ErrMsg, "amount must be greater than currently claimed")
+	}
+	return &msg, &pc, nil
+}
+
+func (h *disputeClosePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, pc, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
-	if err := h.cash.MoveCoins(db, pc.Address, pc.Source, diff); err != nil {
+	pc.Claimed = msg.Payment.Amount
+	pc.Memo = msg.Payment.Memo
+	if _, err := h.bucket.Put(db, msg.Payment.ChannelID, pc); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+type settlePaymentChannelHandler struct {
+	bucket    orm.ModelBucket
+	cash      cash.Controller
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = (*settlePaymentChannelHandler)(nil)
+
+func (h *settlePaymentChannelHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *settlePaymentChannelHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*SettleMsg, *PaymentChannel, error) {
+	var msg SettleMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var pc PaymentChannel
+	if err := h.bucket.One(db, msg.ChannelID, &pc); err != nil {
+		return nil, nil, err
+	}
+	if !pc.IsClosing() {
+		return nil, nil, errors.Wrap(errors.ErrState, "payment channel is not closing")
+	}
+	if !weave.IsExpired(ctx, pc.CloseDeadline) {
+		return nil, nil, errors.Wrap(errors.ErrState, "dispute window is not closed yet")
+	}
+	return &msg, &pc, nil
+}
+
+func (h *settlePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, pc, err := h.validate(ctx, db, tx)
+	if err != nil {
 		return nil, err
 	}
-	if err := h.bucket.Delete(db, msg.ChannelID); err != nil {
+	// Settlement can be requested by anyone once the dispute window
+	// passed. Make sure the scheduled task does not fail afterwards.
+	switch err := h.scheduler.Delete(db, pc.SettleTaskID); {
+	case err == nil, errors.ErrNotFound.Is(err):
+		// All good.
+	default:
+		return nil, errors.Wrap(err, "cannot cancel settle task")
+	}
+	if err := settle(ctx, db, h.bucket, h.cash, msg.ChannelID, pc, *pc.Claimed); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{}, nil
 }
+
+// settle transfers to the destination the claimed amount that was not yet
+// transferred, returns to the source all leftover funds that are still
+// allocated on the payment channel account and deletes the channel.
+func settle(ctx weave.Context, db weave.KVStore, bucket orm.ModelBucket, ctrl cash.Controller, channelID []byte, pc *PaymentChannel, claimed coin.Coin) error {
+	toDestination, err := claimed.Subtract(*pc.Transferred)
+	if err != nil {
+		return err
+	}
+	if !toDestination.IsZero() {
+		if err := ctrl.MoveCoins(ctx, db, pc.Address, pc.Destination, toDestination); err != nil {
+			return err
+		}
+	}
+	toSource, err := pc.Total.Subtract(claimed)
+	if err != nil {
+		return err
+	}
+	if !toSource.IsZero() {
+		if err := ctrl.MoveCoins(ctx, db, pc.Address, pc.Source, toSource); err != nil {
+			return err
+		}
+	}
+	return bucket.Delete(db, channelID)
+}
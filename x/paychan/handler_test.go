@@ -31,7 +31,7 @@ func TestPaymentChannelHandlers(t *testing.T) {
 	auth := &weavetest.CtxAuth{Key: "auth"}
 
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth, bankCtrl)
+	RegisterRoutes(rt, auth, bankCtrl, &weavetest.Cron{})
 
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -499,6 +499,314 @@ func TestPaymentChannelHandlers(t *testing.T) {
 				},
 			},
 		},
+		"closing a channel with a close delay and no dispute settles transferred funds": {
+			actions: []action{
+				{
+					conditions: []weave.Condition{source},
+					msg: &CreateMsg{
+						Metadata:     &weave.Metadata{Schema: 1},
+						Source:       source.Address(),
+						Destination:  destination.Address(),
+						SourcePubkey: sourceSig.PublicKey(),
+						Total:        dogeCoin(10, 0),
+						Timeout:      weave.AsUnixTime(inOneHour),
+						Memo:         "start",
+						CloseDelay:   weave.AsUnixDuration(time.Hour),
+					},
+					blocksize: 100,
+				},
+				{
+					conditions: []weave.Condition{source},
+					msg: setSignature(sourceSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(2, 0),
+						},
+					}),
+					blocksize: 101,
+				},
+				{
+					conditions: []weave.Condition{}, // Timeout was reached so anyone can close it.
+					msg: &CloseMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 200,
+					blockTime: now.Add(2 * time.Hour),
+				},
+				{
+					conditions: []weave.Condition{},
+					msg: &SettleMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize:    201,
+					blockTime:    now.Add(150 * time.Minute),
+					wantCheckErr: errors.ErrState,
+				},
+				{
+					conditions: []weave.Condition{},
+					msg: &SettleMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 300,
+					blockTime: now.Add(3 * time.Hour),
+				},
+			},
+			dbtests: []querycheck{
+				{
+					path:    "/paychans",
+					data:    weavetest.SequenceID(1),
+					bucket:  payChanBucket,
+					wantRes: nil,
+				},
+				{
+					path:   "/wallets",
+					data:   source.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(source.Address(), dogeCoin(9, 22))),
+					},
+				},
+				{
+					path:   "/wallets",
+					data:   destination.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(destination.Address(), dogeCoin(2, 0))),
+					},
+				},
+			},
+		},
+		"a dispute submitted after the close deadline is rejected": {
+			actions: []action{
+				{
+					conditions: []weave.Condition{source},
+					msg: &CreateMsg{
+						Metadata:     &weave.Metadata{Schema: 1},
+						Source:       source.Address(),
+						Destination:  destination.Address(),
+						SourcePubkey: sourceSig.PublicKey(),
+						Total:        dogeCoin(10, 0),
+						Timeout:      weave.AsUnixTime(inOneHour),
+						Memo:         "start",
+						CloseDelay:   weave.AsUnixDuration(time.Hour),
+					},
+					blocksize: 100,
+				},
+				{
+					conditions: []weave.Condition{source},
+					msg: setSignature(sourceSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(2, 0),
+						},
+					}),
+					blocksize: 101,
+				},
+				{
+					conditions: []weave.Condition{}, // Timeout was reached so anyone can close it.
+					msg: &CloseMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 200,
+					blockTime: now.Add(2 * time.Hour),
+				},
+				{
+					conditions: []weave.Condition{}, // Anyone can submit a dispute.
+					msg: setDisputeSignature(sourceSig, &DisputeCloseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(5, 0),
+						},
+					}),
+					blocksize:    300,
+					blockTime:    now.Add(3 * time.Hour),
+					wantCheckErr: errors.ErrExpired,
+				},
+				{
+					conditions: []weave.Condition{},
+					msg: &SettleMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 301,
+					blockTime: now.Add(3 * time.Hour),
+				},
+			},
+			dbtests: []querycheck{
+				{
+					path:    "/paychans",
+					data:    weavetest.SequenceID(1),
+					bucket:  payChanBucket,
+					wantRes: nil,
+				},
+				{
+					path:   "/wallets",
+					data:   source.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(source.Address(), dogeCoin(9, 22))),
+					},
+				},
+				{
+					path:   "/wallets",
+					data:   destination.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(destination.Address(), dogeCoin(2, 0))),
+					},
+				},
+			},
+		},
+		"the highest of competing disputes is settled": {
+			actions: []action{
+				{
+					conditions: []weave.Condition{source},
+					msg: &CreateMsg{
+						Metadata:     &weave.Metadata{Schema: 1},
+						Source:       source.Address(),
+						Destination:  destination.Address(),
+						SourcePubkey: sourceSig.PublicKey(),
+						Total:        dogeCoin(10, 0),
+						Timeout:      weave.AsUnixTime(inOneHour),
+						Memo:         "start",
+						CloseDelay:   weave.AsUnixDuration(time.Hour),
+					},
+					blocksize: 100,
+				},
+				{
+					conditions: []weave.Condition{source},
+					msg: setSignature(sourceSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(2, 0),
+						},
+					}),
+					blocksize: 101,
+				},
+				{
+					conditions: []weave.Condition{}, // Timeout was reached so anyone can close it.
+					msg: &CloseMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 200,
+					blockTime: now.Add(2 * time.Hour),
+				},
+				{
+					conditions: []weave.Condition{}, // Anyone can submit a dispute.
+					msg: setDisputeSignature(sourceSig, &DisputeCloseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(6, 0),
+						},
+					}),
+					blocksize: 201,
+					blockTime: now.Add(130 * time.Minute),
+				},
+				{
+					conditions: []weave.Condition{}, // Anyone can submit a dispute.
+					msg: setDisputeSignature(sourceSig, &DisputeCloseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(4, 0),
+						},
+					}),
+					blocksize:    202,
+					blockTime:    now.Add(140 * time.Minute),
+					wantCheckErr: errors.ErrMsg,
+				},
+				{
+					conditions: []weave.Condition{}, // Anyone can submit a dispute.
+					msg: setDisputeSignature(sourceSig, &DisputeCloseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(6, 0),
+						},
+					}),
+					blocksize:    203,
+					blockTime:    now.Add(150 * time.Minute),
+					wantCheckErr: errors.ErrMsg,
+				},
+				{
+					conditions: []weave.Condition{destination},
+					msg: setSignature(sourceSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(8, 0),
+						},
+					}),
+					blocksize:    204,
+					blockTime:    now.Add(150 * time.Minute),
+					wantCheckErr: errors.ErrState,
+				},
+				{
+					conditions: []weave.Condition{}, // Anyone can submit a dispute.
+					msg: setDisputeSignature(sourceSig, &DisputeCloseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(7, 0),
+						},
+					}),
+					blocksize: 205,
+					blockTime: now.Add(170 * time.Minute),
+				},
+				{
+					conditions: []weave.Condition{},
+					msg: &SettleMsg{
+						Metadata:  &weave.Metadata{Schema: 1},
+						ChannelID: weavetest.SequenceID(1),
+					},
+					blocksize: 300,
+					blockTime: now.Add(3 * time.Hour),
+				},
+			},
+			dbtests: []querycheck{
+				{
+					path:    "/paychans",
+					data:    weavetest.SequenceID(1),
+					bucket:  payChanBucket,
+					wantRes: nil,
+				},
+				{
+					path:   "/wallets",
+					data:   source.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(source.Address(), dogeCoin(4, 22))),
+					},
+				},
+				{
+					path:   "/wallets",
+					data:   destination.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(destination.Address(), dogeCoin(7, 0))),
+					},
+				},
+			},
+		},
 	}
 
 	for testName, tc := range cases {
@@ -630,3 +938,17 @@ func setSignature(key crypto.Signer, msg *TransferMsg) *TransferMsg {
 	msg.Signature = sig
 	return msg
 }
+
+// setDisputeSignature computes and sets signature for given message.
+func setDisputeSignature(key crypto.Signer, msg *DisputeCloseMsg) *DisputeCloseMsg {
+	raw, err := msg.Payment.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	sig, err := key.Sign(raw)
+	if err != nil {
+		panic(err)
+	}
+	msg.Signature = sig
+	return msg
+}
@@ -8,10 +8,19 @@ import (
 
 func init() {
 	migration.MustRegister(1, &PaymentChannel{}, migration.NoModification)
+	// Version 2 introduces the dispute window. Channels created before
+	// have no close delay and are settled immediately when closed.
+	migration.MustRegister(2, &PaymentChannel{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*PaymentChannel)(nil)
 
+// IsClosing returns true if closing of this payment channel was requested
+// and it awaits the settlement.
+func (pc *PaymentChannel) IsClosing() bool {
+	return pc.CloseDeadline != 0
+}
+
 // Validate ensures the payment channel is valid.
 func (pc *PaymentChannel) Validate() error {
 	var errs error
@@ -49,6 +58,24 @@ func (pc *PaymentChannel) Validate() error {
 		errs = errors.AppendField(errs, "Address", err)
 	}
 
+	if pc.CloseDelay < 0 {
+		errs = errors.Append(errs,
+			errors.Field("CloseDelay", errors.ErrModel, "negative close delay"))
+	}
+	if pc.CloseDeadline != 0 {
+		errs = errors.AppendField(errs, "CloseDeadline", pc.CloseDeadline.Validate())
+		// Claimed amount cannot be lower than already transferred
+		// and cannot exceed the total.
+		if pc.Claimed == nil || pc.Transferred == nil || !pc.Claimed.SameType(*pc.Transferred) ||
+			pc.Claimed.Compare(*pc.Transferred) < 0 || pc.Claimed.Compare(*pc.Total) > 0 {
+			errs = errors.Append(errs,
+				errors.Field("Claimed", errors.ErrModel, "invalid claimed value"))
+		}
+	} else if pc.Claimed != nil {
+		errs = errors.Append(errs,
+			errors.Field("Claimed", errors.ErrModel, "claimed value set for a channel that is not closing"))
+	}
+
 	return errs
 }
 
@@ -2,6 +2,7 @@ package paychan
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 )
@@ -10,6 +11,15 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &TransferMsg{}, migration.NoModification)
 	migration.MustRegister(1, &CloseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DisputeCloseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SettleMsg{}, migration.NoModification)
+
+	// Version 2 introduces the dispute window.
+	migration.MustRegister(2, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &TransferMsg{}, migration.NoModification)
+	migration.MustRegister(2, &CloseMsg{}, migration.NoModification)
+	migration.MustRegister(2, &DisputeCloseMsg{}, migration.NoModification)
+	migration.MustRegister(2, &SettleMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -38,6 +48,10 @@ func (m *CreateMsg) Validate() error {
 		errs = errors.Append(errs,
 			errors.Field("Memo", errors.ErrMsg, "memo too long"))
 	}
+	if m.CloseDelay < 0 {
+		errs = errors.Append(errs,
+			errors.Field("CloseDelay", errors.ErrMsg, "negative close delay"))
+	}
 	return errs
 }
 
@@ -51,23 +65,31 @@ func (m *TransferMsg) Validate() error {
 	var errs error
 
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
-	if m.Signature == nil {
+	errs = errors.Append(errs, validateSignedPayment(m.Payment, m.Signature))
+	return errs
+}
+
+// validateSignedPayment returns an error if given payment or its signature is
+// not valid.
+func validateSignedPayment(p *Payment, sig *crypto.Signature) error {
+	var errs error
+	if sig == nil {
 		errs = errors.Append(errs,
 			errors.Field("Signature", errors.ErrMsg, "missing signature"))
 	}
-	if m.Payment == nil {
+	if p == nil {
 		errs = errors.Append(errs,
 			errors.Field("Payment", errors.ErrMsg, "missing payment"))
 	} else {
-		if m.Payment.ChainID == "" {
+		if p.ChainID == "" {
 			errs = errors.Append(errs,
 				errors.Field("Payment.ChainID", errors.ErrMsg, "missing chain ID"))
 		}
-		if m.Payment.ChannelID == nil {
+		if p.ChannelID == nil {
 			errs = errors.Append(errs,
 				errors.Field("Payment.ChannelID", errors.ErrMsg, "missing channel ID"))
 		}
-		if !m.Payment.Amount.IsPositive() {
+		if !p.Amount.IsPositive() {
 			errs = errors.Append(errs,
 				errors.Field("Payment.Amount", errors.ErrMsg, "invalid amount value"))
 		}
@@ -101,6 +123,37 @@ func (CloseMsg) Path() string {
 	return "paychan/close"
 }
 
+var _ weave.Msg = (*DisputeCloseMsg)(nil)
+
+func (m *DisputeCloseMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.Append(errs, validateSignedPayment(m.Payment, m.Signature))
+	return errs
+}
+
+func (DisputeCloseMsg) Path() string {
+	return "paychan/dispute_close"
+}
+
+var _ weave.Msg = (*SettleMsg)(nil)
+
+func (m *SettleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.ChannelID == nil {
+		errs = errors.Append(errs,
+			errors.Field("ChannelID", errors.ErrMsg, "missing channel ID"))
+	}
+	return errs
+}
+
+func (SettleMsg) Path() string {
+	return "paychan/settle"
+}
+
 // inThePast represents time value for Monday, January 1, 2018 2:00:00 AM GMT+01:00
 //
 // Assumption of this extension is that year 2018 is always in the past and it
@@ -100,6 +100,12 @@ type StdSignature struct {
 	Pubkey   *crypto.PublicKey `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	// Removed Address, Pubkey is more powerful
 	Signature *crypto.Signature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Condition is optional. When set, the signature does not authorize the
+	// signer's own address. Instead it authorizes on behalf of given condition,
+	// for example a multisig contract that the signer is a participant of. The
+	// extension that the condition belongs to decides if the signer is allowed
+	// to represent it. Condition is part of the signed data.
+	Condition github_com_iov_one_weave.Condition `protobuf:"bytes,5,opt,name=condition,proto3,casttype=github.com/iov-one/weave.Condition" json:"condition,omitempty"`
 }
 
 func (m *StdSignature) Reset()         { *m = StdSignature{} }
@@ -156,6 +162,13 @@ func (m *StdSignature) GetSignature() *crypto.Signature {
 	return nil
 }
 
+func (m *StdSignature) GetCondition() github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
 // BumpSequenceMsg increments a sequence counter by given amount for a user.
 type BumpSequenceMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -223,37 +236,191 @@ func (m *BumpSequenceMsg) GetUser() github_com_iov_one_weave.Address {
 	return nil
 }
 
+// Configuration of the signature verification.
+type Configuration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	// This defines the Address that is allowed to update the Configuration object and is
+	// needed to make use of gconf.NewUpdateConfigurationHandler
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Fork discriminator is the hash of the chain genesis time. Once
+	// activated, it is included in the signed bytes, so that a signature cannot
+	// be replayed on a fork that reuses the chain ID. See ForkDiscriminator.
+	ForkDiscriminator []byte `protobuf:"bytes,3,opt,name=fork_discriminator,json=forkDiscriminator,proto3" json:"fork_discriminator,omitempty"`
+	// Fork discriminator height is the block height starting with which
+	// signatures must include the fork discriminator. Zero disables the fork
+	// discriminator.
+	ForkDiscriminatorHeight int64 `protobuf:"varint,4,opt,name=fork_discriminator_height,json=forkDiscriminatorHeight,proto3" json:"fork_discriminator_height,omitempty"`
+	// Legacy sign bytes blocks is the number of blocks, starting with the fork
+	// discriminator height, during which signatures that do not include the
+	// fork discriminator are still accepted.
+	LegacySignBytesBlocks int64 `protobuf:"varint,5,opt,name=legacy_sign_bytes_blocks,json=legacySignBytesBlocks,proto3" json:"legacy_sign_bytes_blocks,omitempty"`
+}
+
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{3}
+}
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Configuration) GetForkDiscriminator() []byte {
+	if m != nil {
+		return m.ForkDiscriminator
+	}
+	return nil
+}
+
+func (m *Configuration) GetForkDiscriminatorHeight() int64 {
+	if m != nil {
+		return m.ForkDiscriminatorHeight
+	}
+	return 0
+}
+
+func (m *Configuration) GetLegacySignBytesBlocks() int64 {
+	if m != nil {
+		return m.LegacySignBytesBlocks
+	}
+	return 0
+}
+
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{4}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserData)(nil), "sigs.UserData")
 	proto.RegisterType((*StdSignature)(nil), "sigs.StdSignature")
 	proto.RegisterType((*BumpSequenceMsg)(nil), "sigs.BumpSequenceMsg")
+	proto.RegisterType((*Configuration)(nil), "sigs.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "sigs.UpdateConfigurationMsg")
 }
 
 func init() { proto.RegisterFile("x/sigs/codec.proto", fileDescriptor_1f3400434997a8ae) }
 
 var fileDescriptor_1f3400434997a8ae = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0xc6, 0x39, 0x20, 0x04, 0x0e, 0x0c, 0xb1, 0x3a, 0x34, 0xc4, 0x54, 0x42, 0x1c, 0x30, 0xc6,
-	0x5e, 0xa2, 0x8b, 0xab, 0xc4, 0xcd, 0x90, 0x98, 0x12, 0x3f, 0xc0, 0xf5, 0xfa, 0xa6, 0x5e, 0xa4,
-	0xbd, 0x7a, 0x7f, 0x50, 0x16, 0x47, 0x67, 0x27, 0x3f, 0x93, 0x23, 0xa3, 0x93, 0x31, 0xf0, 0x2d,
-	0x9c, 0x0c, 0x47, 0x05, 0x3a, 0x19, 0xb7, 0xf7, 0x7e, 0xf9, 0xdd, 0xfb, 0x3c, 0xb9, 0xc3, 0xce,
-	0x13, 0x51, 0x3c, 0x56, 0x84, 0x89, 0x08, 0x98, 0x9f, 0x49, 0xa1, 0x85, 0x53, 0x5d, 0x92, 0x4e,
-	0x73, 0x0b, 0x75, 0xf6, 0x98, 0x9c, 0x66, 0x5a, 0x90, 0x44, 0x44, 0x30, 0x56, 0x39, 0xdc, 0x8f,
-	0x45, 0x2c, 0xec, 0x48, 0x96, 0xd3, 0x8a, 0xf6, 0x9e, 0x71, 0xfd, 0x56, 0x81, 0xbc, 0xa2, 0x9a,
-	0x3a, 0x27, 0xb8, 0x9e, 0x80, 0xa6, 0x11, 0xd5, 0xd4, 0x45, 0x5d, 0xd4, 0x6f, 0x9e, 0xb5, 0xfd,
-	0x47, 0xa0, 0x13, 0xf0, 0x87, 0x39, 0x0e, 0xd6, 0x82, 0x73, 0x8c, 0x6b, 0x99, 0x09, 0xef, 0x61,
-	0xea, 0x96, 0xad, 0xba, 0xeb, 0xaf, 0x42, 0xfd, 0x1b, 0x13, 0x8e, 0x39, 0xbb, 0x86, 0x69, 0x90,
-	0x0b, 0x4e, 0x07, 0xd7, 0x15, 0x3c, 0x18, 0x48, 0x19, 0xb8, 0x95, 0x2e, 0xea, 0x57, 0x82, 0xf5,
-	0xb9, 0xf7, 0x82, 0x70, 0x6b, 0xa4, 0xa3, 0x11, 0x8f, 0x53, 0xaa, 0x8d, 0x84, 0x82, 0x5c, 0x2e,
-	0xca, 0x5b, 0x99, 0x95, 0xbf, 0x32, 0x09, 0x6e, 0xa8, 0xdf, 0x9d, 0x6e, 0xb5, 0x68, 0xaf, 0xc3,
-	0x82, 0x8d, 0xd3, 0x7b, 0x43, 0xb8, 0x3d, 0x30, 0x49, 0x36, 0xca, 0xc3, 0x86, 0x2a, 0xfe, 0xdf,
-	0x83, 0x1c, 0xe0, 0x06, 0x4f, 0x99, 0x84, 0x04, 0x52, 0x6d, 0x9b, 0xef, 0x04, 0x1b, 0xe0, 0x5c,
-	0xe0, 0xaa, 0x51, 0x20, 0x6d, 0xf1, 0xd6, 0xe0, 0xe8, 0xfb, 0xf3, 0xb0, 0x1b, 0x73, 0x7d, 0x67,
-	0x42, 0x9f, 0x89, 0x84, 0x70, 0x31, 0x39, 0x15, 0x29, 0x90, 0xd5, 0xf2, 0xcb, 0x28, 0x92, 0xa0,
-	0x54, 0x60, 0x6f, 0x0c, 0xdc, 0xf7, 0xb9, 0x87, 0x66, 0x73, 0x0f, 0x7d, 0xcd, 0x3d, 0xf4, 0xba,
-	0xf0, 0x4a, 0xb3, 0x85, 0x57, 0xfa, 0x58, 0x78, 0xa5, 0xb0, 0x66, 0xbf, 0xf0, 0xfc, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x8b, 0x87, 0x10, 0xa6, 0x16, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0x2d, 0x7f, 0x04, 0x7b, 0xe3, 0x10, 0xb2, 0xe9, 0x87, 0x6a, 0x8a, 0x62, 0x44, 0x29,
+	0x0e, 0x25, 0x12, 0xb4, 0x87, 0x96, 0xdc, 0xaa, 0xf8, 0x50, 0x28, 0x81, 0xb2, 0x26, 0x67, 0xb3,
+	0x5a, 0x4d, 0xe4, 0xc5, 0x96, 0x56, 0xdd, 0x5d, 0x25, 0xf5, 0xa5, 0xcf, 0xd0, 0x53, 0x4f, 0x7d,
+	0xa0, 0x1e, 0x7a, 0xc8, 0xb1, 0xa7, 0x50, 0xec, 0xb7, 0xc8, 0xa9, 0xe8, 0x23, 0xb6, 0x45, 0x28,
+	0xc5, 0xb7, 0xd5, 0xff, 0xff, 0x9b, 0x9d, 0xd9, 0x19, 0x0d, 0xc2, 0x5f, 0x5c, 0xc5, 0x43, 0xe5,
+	0x32, 0x11, 0x00, 0x73, 0x12, 0x29, 0xb4, 0xc0, 0xcd, 0x4c, 0xe9, 0xed, 0x6e, 0x48, 0xbd, 0x43,
+	0x26, 0xe7, 0x89, 0x16, 0x6e, 0x24, 0x02, 0x98, 0xa9, 0x52, 0x7c, 0x14, 0x8a, 0x50, 0xe4, 0x47,
+	0x37, 0x3b, 0x15, 0xaa, 0xfd, 0x15, 0xb5, 0x2f, 0x14, 0xc8, 0x21, 0xd5, 0x14, 0xbf, 0x42, 0xed,
+	0x08, 0x34, 0x0d, 0xa8, 0xa6, 0xa6, 0xd1, 0x37, 0x06, 0xbb, 0xaf, 0xf7, 0x9d, 0x6b, 0xa0, 0x57,
+	0xe0, 0x9c, 0x97, 0x32, 0x59, 0x01, 0xf8, 0x18, 0xed, 0x24, 0xa9, 0x3f, 0x85, 0xb9, 0x59, 0xcf,
+	0xd1, 0x03, 0xa7, 0x48, 0xea, 0x7c, 0x4a, 0xfd, 0x19, 0x67, 0x1f, 0x61, 0x4e, 0x4a, 0x00, 0xf7,
+	0x50, 0x5b, 0xc1, 0xe7, 0x14, 0x62, 0x06, 0x66, 0xa3, 0x6f, 0x0c, 0x1a, 0x64, 0xf5, 0x6d, 0xff,
+	0x32, 0x50, 0x77, 0xa4, 0x83, 0x11, 0x0f, 0x63, 0xaa, 0x53, 0x09, 0x15, 0xb8, 0x5e, 0x85, 0x37,
+	0x72, 0x36, 0xfe, 0x97, 0xd3, 0x45, 0x1d, 0x75, 0x7f, 0xa7, 0xd9, 0xac, 0xd2, 0xab, 0x64, 0x64,
+	0xcd, 0xe0, 0x21, 0xea, 0x30, 0x11, 0x07, 0x5c, 0x73, 0x11, 0x9b, 0xad, 0xbe, 0x31, 0xe8, 0x7a,
+	0x2f, 0xef, 0x6e, 0x8f, 0xec, 0x90, 0xeb, 0x49, 0xea, 0x3b, 0x4c, 0x44, 0x2e, 0x17, 0x57, 0x27,
+	0x22, 0x06, 0xb7, 0xe8, 0xc9, 0xd9, 0x3d, 0x4d, 0xd6, 0x81, 0xf6, 0x77, 0x03, 0xed, 0x7b, 0x69,
+	0x94, 0x8c, 0xca, 0x92, 0xcf, 0x55, 0xb8, 0x5d, 0x5b, 0x9f, 0xa3, 0x0e, 0x8f, 0x99, 0x84, 0x08,
+	0x62, 0x9d, 0xbf, 0x7f, 0x8f, 0xac, 0x05, 0xfc, 0x0e, 0x35, 0x53, 0x05, 0x32, 0x7f, 0x7e, 0xd7,
+	0x7b, 0x71, 0x77, 0x7b, 0xd4, 0xff, 0x67, 0x7d, 0xef, 0x83, 0x40, 0x82, 0x52, 0x24, 0x8f, 0xb0,
+	0x7f, 0xd4, 0xd1, 0xde, 0x99, 0x88, 0x2f, 0x79, 0x98, 0x4a, 0x9a, 0x95, 0xba, 0x5d, 0x59, 0xa7,
+	0xa8, 0x25, 0xae, 0x63, 0x90, 0x66, 0x7d, 0x8b, 0xcc, 0x45, 0x08, 0x3e, 0x41, 0xf8, 0x52, 0xc8,
+	0xe9, 0x38, 0xe0, 0x8a, 0x49, 0x1e, 0xf1, 0x98, 0x6a, 0x51, 0x3e, 0x81, 0x1c, 0x64, 0xce, 0x70,
+	0xd3, 0xc0, 0xa7, 0xe8, 0xd9, 0x43, 0x7c, 0x3c, 0x01, 0x1e, 0x4e, 0x74, 0x3e, 0xc9, 0x06, 0x79,
+	0xfa, 0x20, 0xea, 0x43, 0x6e, 0xe3, 0xb7, 0xc8, 0x9c, 0x41, 0x48, 0xd9, 0x7c, 0x9c, 0x0d, 0x76,
+	0xec, 0xcf, 0x35, 0xa8, 0xb1, 0x3f, 0x13, 0x6c, 0xaa, 0xf2, 0x99, 0x36, 0xc8, 0xe3, 0xc2, 0xcf,
+	0x7e, 0x01, 0x2f, 0x73, 0xbd, 0xdc, 0xb4, 0x13, 0xf4, 0xe4, 0x22, 0x09, 0xa8, 0x86, 0x4a, 0x8f,
+	0xb6, 0x9e, 0xde, 0x31, 0x6a, 0x25, 0x54, 0xb3, 0x49, 0xb9, 0x13, 0x87, 0x4e, 0xb6, 0x9b, 0x4e,
+	0xe5, 0x4e, 0x52, 0x10, 0x9e, 0xf9, 0x73, 0x61, 0x19, 0x37, 0x0b, 0xcb, 0xf8, 0xb3, 0xb0, 0x8c,
+	0x6f, 0x4b, 0xab, 0x76, 0xb3, 0xb4, 0x6a, 0xbf, 0x97, 0x56, 0xcd, 0xdf, 0xc9, 0x37, 0xf3, 0xcd,
+	0xdf, 0x01, 0x00, 0x9e, 0x5f, 0x54, 0x04, 0xed, 0x03, 0x00, 0x00,
 }
 
 func (m *UserData) Marshal() (dAtA []byte, err error) {
@@ -339,6 +506,12 @@ func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if len(m.Condition) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Condition)))
+		i += copy(dAtA[i:], m.Condition)
+	}
 	return i, nil
 }
 
@@ -381,6 +554,94 @@ func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.ForkDiscriminator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ForkDiscriminator)))
+		i += copy(dAtA[i:], m.ForkDiscriminator)
+	}
+	if m.ForkDiscriminatorHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ForkDiscriminatorHeight))
+	}
+	if m.LegacySignBytesBlocks != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.LegacySignBytesBlocks))
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n8, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -427,6 +688,10 @@ func (m *StdSignature) Size() (n int) {
 		l = m.Signature.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.Condition)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -450,6 +715,50 @@ func (m *BumpSequenceMsg) Size() (n int) {
 	return n
 }
 
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ForkDiscriminator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ForkDiscriminatorHeight != 0 {
+		n += 1 + sovCodec(uint64(m.ForkDiscriminatorHeight))
+	}
+	if m.LegacySignBytesBlocks != 0 {
+		n += 1 + sovCodec(uint64(m.LegacySignBytesBlocks))
+	}
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -727,6 +1036,40 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = append(m.Condition[:0], dAtA[iNdEx:postIndex]...)
+			if m.Condition == nil {
+				m.Condition = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -893,6 +1236,326 @@ func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkDiscriminator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkDiscriminator = append(m.ForkDiscriminator[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkDiscriminator == nil {
+				m.ForkDiscriminator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkDiscriminatorHeight", wireType)
+			}
+			m.ForkDiscriminatorHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkDiscriminatorHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacySignBytesBlocks", wireType)
+			}
+			m.LegacySignBytesBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacySignBytesBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
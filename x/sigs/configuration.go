@@ -0,0 +1,48 @@
+package sigs
+
+import (
+	"crypto/sha256"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	if n := len(c.ForkDiscriminator); n != 0 && n != sha256.Size {
+		errs = errors.AppendField(errs, "ForkDiscriminator",
+			errors.Wrapf(errors.ErrInput, "must be %d bytes long", sha256.Size))
+	}
+	if c.ForkDiscriminatorHeight < 0 {
+		errs = errors.AppendField(errs, "ForkDiscriminatorHeight",
+			errors.Wrap(errors.ErrInput, "must not be negative"))
+	} else if c.ForkDiscriminatorHeight > 0 && len(c.ForkDiscriminator) == 0 {
+		errs = errors.AppendField(errs, "ForkDiscriminator",
+			errors.Wrap(errors.ErrEmpty, "required when the fork discriminator height is set"))
+	}
+	if c.LegacySignBytesBlocks < 0 {
+		errs = errors.AppendField(errs, "LegacySignBytesBlocks",
+			errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+// loadConf returns the package configuration or nil if the configuration was
+// never initialized. Configuration is optional.
+func loadConf(db gconf.ReadStore) (*Configuration, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "sigs", &conf); {
+	case err == nil:
+		return &conf, nil
+	case errors.ErrNotFound.Is(err):
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "gconf")
+	}
+}
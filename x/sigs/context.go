@@ -14,6 +14,8 @@ type contextKey int // local to the auth module
 
 const (
 	contextKeySigners contextKey = iota
+	contextKeyRepresentations
+	contextKeyVerificationCache
 )
 
 // withSigners is a private method, as only this module
@@ -22,6 +24,35 @@ func withSigners(ctx weave.Context, signers []weave.Condition) weave.Context {
 	return context.WithValue(ctx, contextKeySigners, signers)
 }
 
+// withRepresentations is a private method, as only this module can add a
+// representation.
+func withRepresentations(ctx weave.Context, reps []Representation) weave.Context {
+	return context.WithValue(ctx, contextKeyRepresentations, reps)
+}
+
+// WithVerificationCache returns a context that makes the signature decorator
+// use given cache when checking a transaction. Delivery of a transaction
+// always verifies all signatures.
+func WithVerificationCache(ctx weave.Context, cache VerificationCache) weave.Context {
+	return context.WithValue(ctx, contextKeyVerificationCache, cache)
+}
+
+func verificationCache(ctx weave.Context) VerificationCache {
+	val, _ := ctx.Value(contextKeyVerificationCache).(VerificationCache)
+	return val
+}
+
+// GetRepresentations returns all signatures of the current Context that were
+// created on behalf of a condition. Each provides both the signer and the
+// represented condition. Representation does not authorize anything by
+// itself. It is up to the extension that the condition belongs to, to verify
+// that the signer is allowed to represent it.
+// May be empty
+func GetRepresentations(ctx weave.Context) []Representation {
+	val, _ := ctx.Value(contextKeyRepresentations).([]Representation)
+	return val
+}
+
 // Authenticate implements x.Authenticator and provides
 // authentication based on public-key signatures.
 type Authenticate struct{}
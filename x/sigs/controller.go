@@ -1,8 +1,10 @@
 package sigs
 
 import (
+	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/binary"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
@@ -13,6 +15,14 @@ import (
 // a signature
 var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 
+// SignCodeV2 is the prefix of the bytes used to build a signature that
+// includes the fork discriminator. See BuildSignBytesV2.
+var SignCodeV2 = []byte{0, 0xCA, 0xFE, 1}
+
+// SignCodeCondition is the prefix of the transaction bytes signed on behalf of
+// a condition. See ConditionSignBytes.
+var SignCodeCondition = []byte{0, 0xC0, 0x4D, 0}
+
 //----------------- Controller ------------------
 //
 // Place actual business logic here.
@@ -28,33 +38,79 @@ var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 // which must have at least one.
 //
 // returns list of signer addresses (possibly empty),
-// or error if any signature is invalid
+// or error if any signature is invalid. Signatures created on behalf of a
+// condition are verified, but their signers are not returned.
 func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 	chainID string) ([]weave.Condition, error) {
+	signers, _, err := verifyTxSignatures(store, tx, []signBytesFn{signBytesV1(chainID)}, nil)
+	return signers, err
+}
+
+// Representation is a signature that authorizes on behalf of a condition,
+// instead of the signer's own address.
+type Representation struct {
+	// Signer is the condition of the public key that created the
+	// signature.
+	Signer weave.Condition
+	// Condition is the condition that the signer represents.
+	Condition weave.Condition
+}
 
+// verifyTxSignatures checks all the signatures on the tx. It returns the
+// signers of all personal signatures and all signatures created on behalf of
+// a condition. Cache is optional.
+func verifyTxSignatures(store weave.KVStore, tx SignedTx, formats []signBytesFn, cache VerificationCache) ([]weave.Condition, []Representation, error) {
 	bz, err := tx.GetSignBytes()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	sigs := tx.GetSignatures()
 
 	signers := make([]weave.Condition, 0, len(sigs))
+	var reps []Representation
 	for _, sig := range sigs {
-		// TODO: separate into own function (verify one sig)
-		signer, err := VerifySignature(store, sig, bz, chainID)
+		signer, err := verifySignature(store, sig, bz, formats, cache)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if len(sig.Condition) == 0 {
+			signers = append(signers, signer)
+		} else {
+			reps = append(reps, Representation{Signer: signer, Condition: sig.Condition})
 		}
-		signers = append(signers, signer)
 	}
 
-	return signers, nil
+	return signers, reps, nil
 }
 
 // VerifySignature checks one signature against signbytes,
 // check chain and updates state in the store
 func VerifySignature(db weave.KVStore, sig *StdSignature,
 	signBytes []byte, chainID string) (weave.Condition, error) {
+	return verifySignature(db, sig, signBytes, []signBytesFn{signBytesV1(chainID)}, nil)
+}
+
+// signBytesFn returns the bytes that are signed for given transaction sign
+// bytes and signer sequence.
+type signBytesFn func(signBytes []byte, seq int64) ([]byte, error)
+
+func signBytesV1(chainID string) signBytesFn {
+	return func(signBytes []byte, seq int64) ([]byte, error) {
+		return BuildSignBytes(signBytes, chainID, seq)
+	}
+}
+
+func signBytesV2(chainID string, discriminator []byte) signBytesFn {
+	return func(signBytes []byte, seq int64) ([]byte, error) {
+		return BuildSignBytesV2(signBytes, chainID, discriminator, seq)
+	}
+}
+
+// verifySignature checks one signature against signbytes. Signature is
+// accepted if it was created using any of the given sign bytes formats.
+// Cache is optional.
+func verifySignature(db weave.KVStore, sig *StdSignature,
+	signBytes []byte, formats []signBytesFn, cache VerificationCache) (weave.Condition, error) {
 
 	// we guarantee sequence makes sense and pubkey or address is there
 	err := sig.Validate()
@@ -62,6 +118,13 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 		return nil, err
 	}
 
+	// Signature on behalf of a condition signs the condition as well, so
+	// that it cannot be used for a different condition or as a personal
+	// signature.
+	if len(sig.Condition) != 0 {
+		signBytes = ConditionSignBytes(signBytes, sig.Condition)
+	}
+
 	bucket := NewBucket()
 
 	// load account
@@ -70,13 +133,19 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 		return nil, err
 	}
 
-	toSign, err := BuildSignBytes(signBytes, chainID, sig.Sequence)
-	if err != nil {
-		return nil, err
-	}
-
 	user := AsUser(obj)
-	if !user.Pubkey.Verify(toSign, sig.Signature) {
+	var verified bool
+	for _, format := range formats {
+		toSign, err := format(signBytes, sig.Sequence)
+		if err != nil {
+			return nil, err
+		}
+		if verify(cache, user.Pubkey, toSign, sig.Signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid signature")
 	}
 
@@ -91,6 +160,58 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 	return user.Pubkey.Condition(), nil
 }
 
+// VerificationCache remembers successful signature verifications. The result
+// of a verification depends only on the public key, the signed message and
+// the signature, so a cached verification is valid in any state.
+type VerificationCache interface {
+	// Has returns true if given verification key was added to the cache.
+	Has(key []byte) bool
+	// Add stores given verification key.
+	Add(key []byte)
+}
+
+// verify returns true if given signature of the message was created using
+// the public key. Successful verifications are stored in the cache, if
+// given.
+func verify(cache VerificationCache, pubkey *crypto.PublicKey, msg []byte, sig *crypto.Signature) bool {
+	if cache == nil {
+		return pubkey.Verify(msg, sig)
+	}
+	key, err := verificationKey(pubkey, msg, sig)
+	if err != nil {
+		return pubkey.Verify(msg, sig)
+	}
+	if cache.Has(key) {
+		return true
+	}
+	if !pubkey.Verify(msg, sig) {
+		return false
+	}
+	cache.Add(key)
+	return true
+}
+
+// verificationKey returns a SHA-256 digest of the length prefixed public
+// key, message and signature.
+func verificationKey(pubkey *crypto.PublicKey, msg []byte, sig *crypto.Signature) ([]byte, error) {
+	rawPubkey, err := pubkey.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal public key")
+	}
+	rawSig, err := sig.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal signature")
+	}
+	h := sha256.New()
+	for _, b := range [][]byte{rawPubkey, msg, rawSig} {
+		var size [8]byte
+		binary.BigEndian.PutUint64(size[:], uint64(len(b)))
+		_, _ = h.Write(size[:])
+		_, _ = h.Write(b)
+	}
+	return h.Sum(nil), nil
+}
+
 /*
 BuildSignBytes combines all info on the actual tx before signing
 
@@ -130,6 +251,74 @@ func BuildSignBytes(signBytes []byte, chainID string, seq int64) ([]byte, error)
 	return hashed[:], nil
 }
 
+/*
+BuildSignBytesV2 combines all info on the actual tx before signing, including
+the fork discriminator. It uses the following format:
+
+version | len(chainID) | chainID      | discriminator | nonce             | signBytes
+4bytes  | uint8        | ascii string | 32 bytes      | int64 (bigendian) | serialized transaction
+
+This is then prehashed with sha512 before fed into
+the public key signing/verification step
+*/
+func BuildSignBytesV2(signBytes []byte, chainID string, discriminator []byte, seq int64) ([]byte, error) {
+	if seq < 0 {
+		return nil, errors.Wrap(ErrInvalidSequence, "negative")
+	}
+	if !weave.IsValidChainID(chainID) {
+		return nil, errors.Wrapf(errors.ErrInput, "chain id: %v", chainID)
+	}
+	if len(discriminator) != sha256.Size {
+		return nil, errors.Wrapf(errors.ErrInput, "fork discriminator must be %d bytes long", sha256.Size)
+	}
+
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, uint64(seq))
+
+	output := make([]byte, 0, 4+1+len(chainID)+len(discriminator)+8+len(signBytes))
+	output = append(output, SignCodeV2...)
+	output = append(output, uint8(len(chainID)))
+	output = append(output, []byte(chainID)...)
+	output = append(output, discriminator...)
+	output = append(output, nonce...)
+	output = append(output, signBytes...)
+
+	hashed := sha512.Sum512(output)
+	return hashed[:], nil
+}
+
+/*
+ConditionSignBytes returns the transaction bytes that are signed by a signature
+created on behalf of given condition. It uses the following format:
+
+version | len(condition)     | condition | signBytes
+4bytes  | uint32 (bigendian) | bytes     | serialized transaction
+
+The result is used instead of the serialized transaction to build the sign
+bytes, using any of the supported formats. See BuildSignBytes.
+*/
+func ConditionSignBytes(signBytes []byte, cond weave.Condition) []byte {
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(cond)))
+
+	output := make([]byte, 0, 4+4+len(cond)+len(signBytes))
+	output = append(output, SignCodeCondition...)
+	output = append(output, size...)
+	output = append(output, cond...)
+	output = append(output, signBytes...)
+	return output
+}
+
+// ForkDiscriminator returns the fork discriminator of a chain started at
+// given genesis time. This is the sha256 hash of the genesis time in
+// nanoseconds since the Unix epoch, encoded as a big endian int64.
+func ForkDiscriminator(genesisTime time.Time) []byte {
+	raw := make([]byte, 8)
+	binary.BigEndian.PutUint64(raw, uint64(genesisTime.UnixNano()))
+	hash := sha256.Sum256(raw)
+	return hash[:]
+}
+
 // BuildSignBytesTx calculates the sign bytes given a tx
 func BuildSignBytesTx(tx SignedTx, chainID string, seq int64) ([]byte, error) {
 	signBytes, err := tx.GetSignBytes()
@@ -162,3 +351,78 @@ func SignTx(signer crypto.Signer, tx SignedTx, chainID string,
 
 	return res, nil
 }
+
+// SignTxOnBehalf creates a signature for the given tx, that authorizes on
+// behalf of given condition instead of the signer's own address.
+func SignTxOnBehalf(signer crypto.Signer, tx SignedTx, chainID string, cond weave.Condition,
+	seq int64) (*StdSignature, error) {
+
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildSignBytes(ConditionSignBytes(signBytes, cond), chainID, seq)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(toSign)
+	if err != nil {
+		return nil, err
+	}
+	return &StdSignature{
+		Pubkey:    signer.PublicKey(),
+		Signature: sig,
+		Sequence:  seq,
+		Condition: cond,
+	}, nil
+}
+
+// SignTxV2 creates a signature for the given tx, that includes the fork
+// discriminator. See BuildSignBytesV2.
+func SignTxV2(signer crypto.Signer, tx SignedTx, chainID string, discriminator []byte,
+	seq int64) (*StdSignature, error) {
+
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildSignBytesV2(signBytes, chainID, discriminator, seq)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(toSign)
+	if err != nil {
+		return nil, err
+	}
+	return &StdSignature{
+		Pubkey:    signer.PublicKey(),
+		Signature: sig,
+		Sequence:  seq,
+	}, nil
+}
+
+// SignTxOnBehalfV2 creates a signature for the given tx, that includes the
+// fork discriminator and authorizes on behalf of given condition instead of
+// the signer's own address. See BuildSignBytesV2 and ConditionSignBytes.
+func SignTxOnBehalfV2(signer crypto.Signer, tx SignedTx, chainID string, discriminator []byte,
+	cond weave.Condition, seq int64) (*StdSignature, error) {
+
+	signBytes, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	toSign, err := BuildSignBytesV2(ConditionSignBytes(signBytes, cond), chainID, discriminator, seq)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(toSign)
+	if err != nil {
+		return nil, err
+	}
+	return &StdSignature{
+		Pubkey:    signer.PublicKey(),
+		Signature: sig,
+		Sequence:  seq,
+		Condition: cond,
+	}, nil
+}
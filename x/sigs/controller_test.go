@@ -2,8 +2,10 @@ package sigs
 
 import (
 	"bytes"
+	"encoding/hex"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
@@ -59,6 +61,30 @@ func TestSignBytes(t *testing.T) {
 	}
 }
 
+func TestSignBytesVectors(t *testing.T) {
+	// Expected values are computed independently of this implementation,
+	// so that clients can rely on them when implementing signing.
+	discriminator := ForkDiscriminator(time.Date(2019, 11, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, "9d069dcc86dbf3f439e92ab0410cc11d0d277d7056c10a65acdf460553751ba8",
+		hex.EncodeToString(discriminator))
+
+	v1, err := BuildSignBytes([]byte("vector"), "test-chain", 7)
+	assert.Nil(t, err)
+	assert.Equal(t, "475265c51d8f57e2ce531396ab55e69364e007193f70339b0ea5b30c4e922f40"+
+		"8fe25046def76b0072fb271e6b2a47c5fb8b3a6aeca635ef798d0dc37f34c3cf",
+		hex.EncodeToString(v1))
+
+	v2, err := BuildSignBytesV2([]byte("vector"), "test-chain", discriminator, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, "f6cd9452beaaa6087558754b308873d20fc233414a83c10eca81f88f8131a0dd"+
+		"45452ebdb8baf436d2239c839f0431ec83aabf3501459abf07e72fb79c2f8dc2",
+		hex.EncodeToString(v2))
+
+	if _, err := BuildSignBytesV2([]byte("vector"), "test-chain", []byte("short"), 7); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestVerifySignature(t *testing.T) {
 	kv := store.MemStore()
 	migration.MustInitPkg(kv, "sigs")
@@ -125,6 +151,47 @@ func TestVerifySignature(t *testing.T) {
 	}
 }
 
+func TestVerifyWithCache(t *testing.T) {
+	priv := crypto.GenPrivKeyEd25519()
+	pub := priv.PublicKey()
+	msg := []byte("my special valentine")
+	sig, err := priv.Sign(msg)
+	assert.Nil(t, err)
+	other, err := priv.Sign([]byte("other message"))
+	assert.Nil(t, err)
+
+	cache := make(mapVerificationCache)
+	assert.Equal(t, true, verify(cache, pub, msg, sig))
+	assert.Equal(t, 1, len(cache))
+
+	// Failed verification is not cached.
+	assert.Equal(t, false, verify(cache, pub, msg, other))
+	assert.Equal(t, 1, len(cache))
+
+	// Cached verification is not executed again.
+	key, err := verificationKey(pub, msg, sig)
+	assert.Nil(t, err)
+	if _, ok := cache[string(key)]; !ok {
+		t.Fatal("verification must be cached under its key")
+	}
+	otherKey, err := verificationKey(pub, msg, other)
+	assert.Nil(t, err)
+	cache.Add(otherKey)
+	assert.Equal(t, true, verify(cache, pub, msg, other))
+	assert.Equal(t, false, verify(nil, pub, msg, other))
+}
+
+type mapVerificationCache map[string]struct{}
+
+func (c mapVerificationCache) Has(key []byte) bool {
+	_, ok := c[string(key)]
+	return ok
+}
+
+func (c mapVerificationCache) Add(key []byte) {
+	c[string(key)] = struct{}{}
+}
+
 func TestVerifyTxSignatures(t *testing.T) {
 	kv := store.MemStore()
 	migration.MustInitPkg(kv, "sigs")
@@ -53,16 +53,20 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 		return next.Check(ctx, store, tx)
 	}
 
-	chainID := weave.GetChainID(ctx)
-	signers, err := VerifyTxSignatures(store, stx, chainID)
+	formats, err := signBytesFormats(ctx, store)
+	if err != nil {
+		return nil, errors.Wrap(err, "sign bytes formats")
+	}
+	signers, reps, err := verifyTxSignatures(store, stx, formats, verificationCache(ctx))
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot verify signatures")
 	}
-	if len(signers) == 0 && !d.allowMissingSigs {
+	if len(signers) == 0 && len(reps) == 0 && !d.allowMissingSigs {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "missing signature")
 	}
 
 	ctx = withSigners(ctx, signers)
+	ctx = withRepresentations(ctx, reps)
 
 	res, err := next.Check(ctx, store, tx)
 	if err != nil {
@@ -71,7 +75,7 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	// The most expensive operation is the signature validation. We must
 	// charge gas proportionally to the effort. We only charge for the
 	// valid signatures. Invalid signatures are ignored.
-	res.GasPayment += int64(len(signers) * signatureVerifyCost)
+	res.GasPayment += int64((len(signers) + len(reps)) * signatureVerifyCost)
 	return res, nil
 }
 
@@ -82,15 +86,48 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		return next.Deliver(ctx, store, tx)
 	}
 
-	chainID := weave.GetChainID(ctx)
-	signers, err := VerifyTxSignatures(store, stx, chainID)
+	formats, err := signBytesFormats(ctx, store)
+	if err != nil {
+		return nil, errors.Wrap(err, "sign bytes formats")
+	}
+	signers, reps, err := verifyTxSignatures(store, stx, formats, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot verify signatures")
 	}
-	if len(signers) == 0 && !d.allowMissingSigs {
+	if len(signers) == 0 && len(reps) == 0 && !d.allowMissingSigs {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "missing signature")
 	}
 
 	ctx = withSigners(ctx, signers)
+	ctx = withRepresentations(ctx, reps)
 	return next.Deliver(ctx, store, tx)
 }
+
+// signBytesFormats returns all sign bytes formats that are accepted at the
+// current block height. Signatures must include the fork discriminator once
+// it is activated. Signatures without it are still accepted for the
+// configured number of blocks, to allow clients to migrate.
+func signBytesFormats(ctx weave.Context, db weave.KVStore) ([]signBytesFn, error) {
+	chainID := weave.GetChainID(ctx)
+	legacy := signBytesV1(chainID)
+
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	if conf == nil || conf.ForkDiscriminatorHeight == 0 {
+		return []signBytesFn{legacy}, nil
+	}
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "block height not in the context")
+	}
+	if height < conf.ForkDiscriminatorHeight {
+		return []signBytesFn{legacy}, nil
+	}
+	current := signBytesV2(chainID, conf.ForkDiscriminator)
+	if height < conf.ForkDiscriminatorHeight+conf.LegacySignBytesBlocks {
+		return []signBytesFn{current, legacy}, nil
+	}
+	return []signBytesFn{current}, nil
+}
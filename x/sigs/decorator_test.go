@@ -3,9 +3,11 @@ package sigs
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -109,9 +111,189 @@ func TestDecorator(t *testing.T) {
 
 }
 
+func TestDecoratorForkDiscriminator(t *testing.T) {
+	const chainID = "deco-fork"
+	discriminator := ForkDiscriminator(time.Date(2019, 11, 1, 12, 0, 0, 0, time.UTC))
+
+	priv := weavetest.NewKey()
+	tx := NewStdTx([]byte("fork"))
+	legacySig, err := SignTx(priv, tx, chainID, 0)
+	assert.Nil(t, err)
+	forkSig, err := SignTxV2(priv, tx, chainID, discriminator, 0)
+	assert.Nil(t, err)
+	cond := weave.NewCondition("multisig", "usage", []byte{0, 0, 0, 0, 0, 0, 0, 1})
+	forkCondSig, err := SignTxOnBehalfV2(priv, tx, chainID, discriminator, cond, 0)
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		conf    *Configuration
+		height  int64
+		sig     *StdSignature
+		wantErr *errors.Error
+	}{
+		"legacy signature without configuration": {
+			height: 100,
+			sig:    legacySig,
+		},
+		"fork signature without configuration": {
+			height:  100,
+			sig:     forkSig,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"legacy signature before activation": {
+			conf:   &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height: 9,
+			sig:    legacySig,
+		},
+		"fork signature before activation": {
+			conf:    &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height:  9,
+			sig:     forkSig,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"legacy signature within the legacy window": {
+			conf:   &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height: 14,
+			sig:    legacySig,
+		},
+		"fork signature within the legacy window": {
+			conf:   &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height: 10,
+			sig:    forkSig,
+		},
+		"legacy signature after the legacy window": {
+			conf:    &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height:  15,
+			sig:     legacySig,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"fork signature after the legacy window": {
+			conf:   &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height: 15,
+			sig:    forkSig,
+		},
+		"fork signature on behalf of a condition without configuration": {
+			height:  100,
+			sig:     forkCondSig,
+			wantErr: errors.ErrUnauthorized,
+		},
+		"fork signature on behalf of a condition after the legacy window": {
+			conf:   &Configuration{ForkDiscriminator: discriminator, ForkDiscriminatorHeight: 10, LegacySignBytesBlocks: 5},
+			height: 15,
+			sig:    forkCondSig,
+		},
+		"fork signature with a different discriminator": {
+			conf:    &Configuration{ForkDiscriminator: ForkDiscriminator(time.Unix(1, 0)), ForkDiscriminatorHeight: 10},
+			height:  15,
+			sig:     forkSig,
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "sigs")
+			if tc.conf != nil {
+				tc.conf.Metadata = &weave.Metadata{Schema: 1}
+				tc.conf.Owner = weavetest.NewCondition().Address()
+				assert.Nil(t, gconf.Save(db, "sigs", tc.conf))
+			}
+
+			ctx := weave.WithChainID(context.Background(), chainID)
+			ctx = weave.WithHeight(ctx, tc.height)
+			tx.Signatures = []*StdSignature{tc.sig}
+
+			if _, err := NewDecorator().Check(ctx, db.CacheWrap(), tx, new(SigCheckHandler)); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := NewDecorator().Deliver(ctx, db.CacheWrap(), tx, new(SigCheckHandler)); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestDecoratorSignatureOnBehalfOfCondition(t *testing.T) {
+	const chainID = "deco-cond"
+
+	cond := weave.NewCondition("multisig", "usage", []byte{0, 0, 0, 0, 0, 0, 0, 1})
+	other := weave.NewCondition("multisig", "usage", []byte{0, 0, 0, 0, 0, 0, 0, 2})
+
+	operator := weavetest.NewKey()
+	participant := weavetest.NewKey()
+	tx := NewStdTx([]byte("payout"))
+	personalSig, err := SignTx(operator, tx, chainID, 0)
+	assert.Nil(t, err)
+	condSig, err := SignTxOnBehalf(participant, tx, chainID, cond, 0)
+	assert.Nil(t, err)
+
+	// A signature moved to a different condition or used as a personal
+	// one must not be valid.
+	movedSig := *condSig
+	movedSig.Condition = other
+	strippedSig := *condSig
+	strippedSig.Condition = nil
+	invalidSig := *condSig
+	invalidSig.Condition = weave.Condition("invalid")
+
+	cases := map[string]struct {
+		sigs     []*StdSignature
+		wantErr  *errors.Error
+		wantCond []weave.Condition
+		wantReps []Representation
+	}{
+		"personal and condition signatures": {
+			sigs:     []*StdSignature{personalSig, condSig},
+			wantCond: []weave.Condition{operator.PublicKey().Condition()},
+			wantReps: []Representation{
+				{Signer: participant.PublicKey().Condition(), Condition: cond},
+			},
+		},
+		"condition signature only": {
+			sigs:     []*StdSignature{condSig},
+			wantCond: []weave.Condition{},
+			wantReps: []Representation{
+				{Signer: participant.PublicKey().Condition(), Condition: cond},
+			},
+		},
+		"signature moved to a different condition": {
+			sigs:    []*StdSignature{&movedSig},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"condition removed from the signature": {
+			sigs:    []*StdSignature{&strippedSig},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"invalid condition": {
+			sigs:    []*StdSignature{&invalidSig},
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "sigs")
+			ctx := weave.WithChainID(context.Background(), chainID)
+			tx.Signatures = tc.sigs
+
+			h := new(SigCheckHandler)
+			if _, err := NewDecorator().Deliver(ctx, db, tx, h); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantCond, h.Signers)
+			assert.Equal(t, tc.wantReps, h.Representations)
+		})
+	}
+}
+
 // SigCheckHandler stores the seen signers on each call
 type SigCheckHandler struct {
-	Signers []weave.Condition
+	Signers         []weave.Condition
+	Representations []Representation
 }
 
 var _ weave.Handler = (*SigCheckHandler)(nil)
@@ -123,6 +305,7 @@ func (s *SigCheckHandler) Check(ctx weave.Context, store weave.KVStore, tx weave
 
 func (s *SigCheckHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
 	s.Signers = Authenticate{}.GetConditions(ctx)
+	s.Representations = GetRepresentations(ctx)
 	return &weave.DeliverResult{}, nil
 }
 
@@ -3,6 +3,7 @@ package sigs
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
@@ -14,6 +15,8 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 			b:    NewBucket(),
 			auth: auth,
 		}))
+	r.Handle(&UpdateConfigurationMsg{}, migration.SchemaMigratingHandler("sigs",
+		gconf.NewUpdateConfigurationHandler("sigs", &Configuration{}, auth, migration.CurrentAdmin)))
 }
 
 type bumpSequenceHandler struct {
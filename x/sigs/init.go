@@ -0,0 +1,37 @@
+package sigs
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+
+// FromGenesis initializes the package configuration, if it is declared. When
+// the configuration does not declare the fork discriminator, it is computed
+// from the genesis time.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	// We allow to initialize configuration but it is not required.
+	var conf Configuration
+	switch err := gconf.InitConfig(kv, opts, "sigs", &conf); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrap(err, "init config")
+	}
+
+	if len(conf.ForkDiscriminator) != 0 || params.Time.IsZero() {
+		return nil
+	}
+	conf.ForkDiscriminator = ForkDiscriminator(params.Time)
+	if err := gconf.Save(kv, "sigs", &conf); err != nil {
+		return errors.Wrap(err, "save config")
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package sigs
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const conf = `{
+		"metadata": {"schema": 1},
+		"owner": "0102030405060708090021222324252627282930",
+		"fork_discriminator": "3q2+796tvu/erb7v3q2+796tvu/erb7v3q2+796tvu8=",
+		"fork_discriminator_height": 100,
+		"legacy_sign_bytes_blocks": 10
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
+}
@@ -1,6 +1,8 @@
 package sigs
 
 import (
+	"crypto/sha256"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -8,6 +10,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &BumpSequenceMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
 }
 
 const (
@@ -35,3 +38,34 @@ func (msg *BumpSequenceMsg) Validate() error {
 func (BumpSequenceMsg) Path() string {
 	return "sigs/bump_sequence"
 }
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.Patch == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	c := m.Patch
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", c.Owner.Validate())
+	}
+	if n := len(c.ForkDiscriminator); n != 0 && n != sha256.Size {
+		errs = errors.AppendField(errs, "Patch.ForkDiscriminator",
+			errors.Wrapf(errors.ErrInput, "must be %d bytes long", sha256.Size))
+	}
+	if c.ForkDiscriminatorHeight < 0 {
+		errs = errors.AppendField(errs, "Patch.ForkDiscriminatorHeight",
+			errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	if c.LegacySignBytesBlocks < 0 {
+		errs = errors.AppendField(errs, "Patch.LegacySignBytesBlocks",
+			errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "sigs/update_configuration"
+}
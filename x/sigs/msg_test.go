@@ -63,3 +63,58 @@ func TestBumpSequenceValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateConfigurationMsgValidate(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ForkDiscriminator:       make([]byte, 32),
+					ForkDiscriminatorHeight: 100,
+					LegacySignBytesBlocks:   1000,
+				},
+			},
+			WantErr: nil,
+		},
+		"missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"invalid discriminator length": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{ForkDiscriminator: []byte("short")},
+			},
+			WantErr: errors.ErrInput,
+		},
+		"negative height": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{ForkDiscriminatorHeight: -1},
+			},
+			WantErr: errors.ErrInput,
+		},
+		"negative legacy window": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{LegacySignBytesBlocks: -1},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Msg.Validate()
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
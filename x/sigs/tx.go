@@ -30,6 +30,11 @@ func (s *StdSignature) Validate() error {
 	if s.Signature == nil {
 		return errors.Wrap(errors.ErrUnauthorized, "missing signature")
 	}
+	if len(s.Condition) != 0 {
+		if err := s.Condition.Validate(); err != nil {
+			return errors.Wrap(err, "condition")
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,17 @@
+package txfee
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const conf = `{
+		"metadata": {"schema": 1},
+		"owner": "0102030405060708090021222324252627282930",
+		"free_bytes": 1024,
+		"base_fee": "0.1 IOV"
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &Configuration{})
+}
@@ -1,6 +1,6 @@
 package utils
 
 /**
-Utils package provides useful utilities for logging, tagging saved data, recovering from panics
-and the ability to have savepoint to rollback to.
+Utils package provides useful utilities for logging, tagging saved data and block proposers, recovering from panics,
+limiting the number of transactions signed by a single address and the ability to have savepoint to rollback to.
 */
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// ProposerTagger adds a tag `proposer = <address>` to the result of every
+// successfully delivered transaction, with the consensus address of the
+// validator that proposed the block. This is the hex encoded address declared
+// by Tendermint and not a weave account address. This allows to search for
+// transactions by the block proposer, for example for analytics. No tag is
+// added if the block proposer is not known.
+type ProposerTagger struct{}
+
+var _ weave.Decorator = ProposerTagger{}
+
+// ProposerKey is used by ProposerTagger as the Key in the Tag it appends
+const ProposerKey = "proposer"
+
+// NewProposerTagger creates a ProposerTagger decorator
+func NewProposerTagger() ProposerTagger {
+	return ProposerTagger{}
+}
+
+// Check just passes the request along
+func (ProposerTagger) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	return next.Check(ctx, db, tx)
+}
+
+// Deliver appends a tag on the result if there is a success.
+func (ProposerTagger) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	res, err := next.Deliver(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	proposer, ok := weave.BlockProposer(ctx)
+	if !ok {
+		return res, nil
+	}
+	tag := common.KVPair{
+		Key:   []byte(ProposerKey),
+		Value: []byte(proposer.String()),
+	}
+	res.Tags = append(res.Tags, tag)
+	return res, nil
+}
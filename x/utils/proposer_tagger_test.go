@@ -0,0 +1,84 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/utils"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestProposerTagger(t *testing.T) {
+	proposer := weave.ConsensusAddress("proposer-address-1234")
+
+	rec := &resultRecorder{}
+	handler := app.ChainDecorators(rec, utils.NewProposerTagger()).WithHandler(&weavetest.Handler{})
+	decoder := func(raw []byte) (weave.Tx, error) {
+		return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}}, nil
+	}
+	storeApp := app.NewStoreApp("proposer", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+	base := app.NewBaseApp(storeApp, decoder, handler, nil, false)
+
+	runner := weavetest.NewWeaveRunner(t, base, "test-chain")
+	tx := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}}
+
+	// Proposer is not known.
+	runner.InBlock(func(wapp weavetest.WeaveApp) error {
+		return wapp.DeliverTx(tx)
+	})
+	assert.Equal(t, 0, len(rec.tags))
+	assert.Equal(t, false, rec.hasProposer)
+	// Block time is controlled by the runner.
+	assert.Equal(t, weavetest.GenesisTime, rec.blockTime)
+
+	runner.SetBlockProposer(proposer)
+	runner.SetLastCommitInfo(weave.CommitInfo{
+		Votes: []abci.VoteInfo{
+			{Validator: abci.Validator{Address: []byte("a"), Power: 1}, SignedLastBlock: true},
+			{Validator: abci.Validator{Address: []byte("b"), Power: 1}, SignedLastBlock: false},
+		},
+	})
+	runner.InBlock(func(wapp weavetest.WeaveApp) error {
+		return wapp.DeliverTx(tx)
+	})
+	assert.Equal(t, true, rec.hasProposer)
+	assert.Equal(t, proposer, rec.proposer)
+	assert.Equal(t, []bool{true, false}, rec.signed)
+	assert.Equal(t, weavetest.GenesisTime.Add(weavetest.BlockInterval), rec.blockTime)
+	assert.Equal(t, []common.KVPair{stringTag(utils.ProposerKey, proposer.String())}, rec.tags)
+}
+
+// resultRecorder is a decorator that records the block information found in
+// the context and the tags of the delivered transaction result.
+type resultRecorder struct {
+	hasProposer bool
+	proposer    weave.ConsensusAddress
+	signed      []bool
+	blockTime   time.Time
+	tags        []common.KVPair
+}
+
+var _ weave.Decorator = (*resultRecorder)(nil)
+
+func (r *resultRecorder) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	return next.Check(ctx, db, tx)
+}
+
+func (r *resultRecorder) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	r.proposer, r.hasProposer = weave.BlockProposer(ctx)
+	r.signed, _ = weave.SignedValidators(ctx)
+	r.blockTime, _ = weave.BlockTime(ctx)
+	res, err := next.Deliver(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	r.tags = res.Tags
+	return res, nil
+}
@@ -0,0 +1,261 @@
+package utils
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+func init() {
+	migration.MustRegister(1, &RateLimitConfiguration{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateRateLimitConfigurationMsg{}, migration.NoModification)
+}
+
+// rateLimitConfPkg is the name that the rate limit configuration is stored
+// under.
+const rateLimitConfPkg = "ratelimit"
+
+// rateLimitBucket is the name of the bucket that stores transaction counters.
+const rateLimitBucket = "ratelimit"
+
+var _ orm.Model = (*RateLimitConfiguration)(nil)
+
+func (c *RateLimitConfiguration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	if c.MaxTx == 0 {
+		errs = errors.AppendField(errs, "MaxTx", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	if c.WindowBlocks <= 0 {
+		errs = errors.AppendField(errs, "WindowBlocks", errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+var _ orm.Model = (*RateLimitCounter)(nil)
+
+func (c *RateLimitCounter) Validate() error {
+	if c.Count == 0 {
+		return errors.Wrap(errors.ErrState, "empty counter must not be stored")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*UpdateRateLimitConfigurationMsg)(nil)
+
+func (m *UpdateRateLimitConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.Patch == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(m.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", m.Patch.Owner.Validate())
+	}
+	if m.Patch.WindowBlocks < 0 {
+		errs = errors.AppendField(errs, "Patch.WindowBlocks", errors.Wrap(errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+func (*UpdateRateLimitConfigurationMsg) Path() string {
+	return "utils/update_ratelimit_configuration"
+}
+
+// RegisterRoutes registers the handler of the rate limit configuration
+// update. The configuration can be created by the migration admin if it does
+// not exist yet.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("utils", r)
+	r.Handle(&UpdateRateLimitConfigurationMsg{},
+		gconf.NewUpdateConfigurationHandler(rateLimitConfPkg, &RateLimitConfiguration{}, auth, migration.CurrentAdmin))
+}
+
+// RateLimitInitializer loads the rate limit configuration from the genesis
+// file. Configuration is optional.
+type RateLimitInitializer struct{}
+
+var _ weave.Initializer = (*RateLimitInitializer)(nil)
+
+func (*RateLimitInitializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	if err := gconf.InitConfig(kv, opts, rateLimitConfPkg, &RateLimitConfiguration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
+
+// RateLimit is a decorator that limits the number of transactions that a
+// single address can sign within a window of blocks. Windows are aligned to
+// the block height and their length, as well as the limit, are declared by
+// RateLimitConfiguration. If the configuration does not exist, this decorator
+// is a no-op.
+//
+// Transactions above the limit are rejected in CheckTx only. Counters are
+// updated in DeliverTx only, so that CheckTx consults the values of the last
+// committed block and the DeliverTx result never depends on the mempool
+// content. DeliverTx never rejects a transaction because of the limit.
+//
+// Counters of past windows expire. Each DeliverTx deletes up to
+// rateLimitExpireBatch of them, so that the cost of a single transaction is
+// bounded.
+type RateLimit struct {
+	auth     x.Authenticator
+	counters orm.ModelBucket
+}
+
+var _ weave.Decorator = RateLimit{}
+
+// NewRateLimit returns a decorator that limits the number of transactions
+// signed by each address authenticated by given authenticator.
+func NewRateLimit(auth x.Authenticator) RateLimit {
+	return RateLimit{
+		auth:     auth,
+		counters: orm.NewModelBucket(rateLimitBucket, &RateLimitCounter{}),
+	}
+}
+
+// Check rejects the transaction if any of its signers already reached the
+// limit within the current window.
+func (r RateLimit) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	conf, err := loadRateLimitConf(db)
+	switch {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return next.Check(ctx, db, tx)
+	default:
+		return nil, err
+	}
+	window, err := rateLimitWindow(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range x.GetAddresses(ctx, r.auth) {
+		var c RateLimitCounter
+		switch err := r.counters.One(db, rateLimitKey(window, addr), &c); {
+		case err == nil:
+		case errors.ErrNotFound.Is(err):
+			continue
+		default:
+			return nil, errors.Wrap(err, "cannot load counter")
+		}
+		if c.Count >= conf.MaxTx {
+			return nil, errors.Wrapf(errors.ErrOverflow, "%s reached the limit of %d transactions per %d blocks", addr, conf.MaxTx, conf.WindowBlocks)
+		}
+	}
+	return next.Check(ctx, db, tx)
+}
+
+// Deliver increments the counter of each transaction signer and removes
+// counters of past windows of those signers.
+func (r RateLimit) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	conf, err := loadRateLimitConf(db)
+	switch {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return next.Deliver(ctx, db, tx)
+	default:
+		return nil, err
+	}
+	window, err := rateLimitWindow(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.expire(db, window); err != nil {
+		return nil, errors.Wrap(err, "expire counters")
+	}
+	for _, addr := range x.GetAddresses(ctx, r.auth) {
+		if err := r.count(db, addr, window); err != nil {
+			return nil, errors.Wrapf(err, "counter of %s", addr)
+		}
+	}
+	return next.Deliver(ctx, db, tx)
+}
+
+// count increments the counter of given address within given window.
+func (r RateLimit) count(db weave.KVStore, addr weave.Address, window uint64) error {
+	key := rateLimitKey(window, addr)
+	var c RateLimitCounter
+	if err := r.counters.One(db, key, &c); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "cannot load")
+	}
+	c.Count++
+	if _, err := r.counters.Put(db, key, &c); err != nil {
+		return errors.Wrap(err, "cannot save")
+	}
+	return nil
+}
+
+// rateLimitExpireBatch is the maximum number of expired counters deleted by
+// a single transaction.
+const rateLimitExpireBatch = 20
+
+// expire deletes up to rateLimitExpireBatch counters of the windows before
+// given one. Counter keys start with the window number, so the expired
+// counters are the first ones in the bucket.
+func (r RateLimit) expire(db weave.KVStore, window uint64) error {
+	if window == 0 {
+		return nil
+	}
+	// This is how Bucket.DBKey is implemented.
+	prefix := rateLimitBucket + ":"
+	it, err := db.Iterator(
+		[]byte(prefix),
+		append([]byte(prefix), rateLimitKey(window, nil)...),
+	)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	var stale [][]byte
+	for len(stale) < rateLimitExpireBatch {
+		key, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Release()
+			return errors.Wrap(err, "next")
+		}
+		stale = append(stale, key[len(prefix):])
+	}
+	it.Release()
+
+	for _, key := range stale {
+		if err := r.counters.Delete(db, key); err != nil {
+			return errors.Wrapf(err, "delete %q", key)
+		}
+	}
+	return nil
+}
+
+// rateLimitWindow returns the number of the window that the current block
+// belongs to.
+func rateLimitWindow(ctx weave.Context, conf *RateLimitConfiguration) (uint64, error) {
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return 0, errors.Wrap(errors.ErrHuman, "block height not present in the context")
+	}
+	return uint64(height / conf.WindowBlocks), nil
+}
+
+// rateLimitKey returns the counter key of given address within given window.
+// Keys are ordered by the window first.
+func rateLimitKey(window uint64, addr weave.Address) []byte {
+	key := make([]byte, 8+len(addr))
+	binary.BigEndian.PutUint64(key, window)
+	copy(key[8:], addr)
+	return key
+}
+
+func loadRateLimitConf(db gconf.ReadStore) (*RateLimitConfiguration, error) {
+	var conf RateLimitConfiguration
+	if err := gconf.Load(db, rateLimitConfPkg, &conf); err != nil {
+		return nil, errors.Wrap(err, "gconf")
+	}
+	return &conf, nil
+}
@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rl := NewRateLimit(auth)
+	handler := weavetest.Decorate(&weavetest.Handler{}, rl)
+
+	db := store.MemStore()
+	conf := RateLimitConfiguration{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        weavetest.NewCondition().Address(),
+		MaxTx:        2,
+		WindowBlocks: 10,
+	}
+	assert.Nil(t, gconf.Save(db, rateLimitConfPkg, &conf))
+
+	ctxAt := func(height int64, signers ...weave.Condition) weave.Context {
+		ctx := weave.WithHeight(context.Background(), height)
+		return auth.SetConditions(ctx, signers...)
+	}
+	check := func(height int64, signers ...weave.Condition) error {
+		_, err := handler.Check(ctxAt(height, signers...), db, &weavetest.Tx{})
+		return err
+	}
+	deliver := func(height int64, signers ...weave.Condition) {
+		t.Helper()
+		if _, err := handler.Deliver(ctxAt(height, signers...), db, &weavetest.Tx{}); err != nil {
+			t.Fatalf("cannot deliver: %s", err)
+		}
+	}
+
+	// Check does not count transactions.
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, check(3, alice))
+	}
+
+	deliver(3, alice)
+	assert.Nil(t, check(4, alice))
+	deliver(4, alice, bob)
+	if err := check(5, alice); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want overflow error, got %+v", err)
+	}
+	if err := check(5, bob, alice); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want overflow error, got %+v", err)
+	}
+	assert.Nil(t, check(5, bob))
+	// Deliver is never rejected.
+	deliver(9, alice)
+	assert.Equal(t, uint32(3), counter(t, rl, db, alice.Address(), 0))
+
+	// A new window starts at height 10.
+	assert.Nil(t, check(10, alice))
+	deliver(10, alice)
+	assert.Equal(t, uint32(1), counter(t, rl, db, alice.Address(), 1))
+
+	// Counters of the past windows expire, also for addresses that are
+	// not counted.
+	assert.Equal(t, uint32(0), counter(t, rl, db, alice.Address(), 0))
+	assert.Equal(t, uint32(0), counter(t, rl, db, bob.Address(), 0))
+
+	// All past windows expire, not only the previous one.
+	deliver(25, bob)
+	deliver(47, bob)
+	assert.Equal(t, uint32(0), counter(t, rl, db, bob.Address(), 2))
+	assert.Equal(t, uint32(1), counter(t, rl, db, bob.Address(), 4))
+	assert.Equal(t, uint32(0), counter(t, rl, db, alice.Address(), 1))
+}
+
+func TestRateLimitExpiresInBatches(t *testing.T) {
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rl := NewRateLimit(auth)
+	handler := weavetest.Decorate(&weavetest.Handler{}, rl)
+
+	db := store.MemStore()
+	conf := RateLimitConfiguration{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        weavetest.NewCondition().Address(),
+		MaxTx:        10,
+		WindowBlocks: 10,
+	}
+	assert.Nil(t, gconf.Save(db, rateLimitConfPkg, &conf))
+
+	signers := make([]weave.Condition, rateLimitExpireBatch+5)
+	for i := range signers {
+		signers[i] = weavetest.NewCondition()
+	}
+	ctx := auth.SetConditions(weave.WithHeight(context.Background(), 1), signers...)
+	_, err := handler.Deliver(ctx, db, &weavetest.Tx{})
+	assert.Nil(t, err)
+
+	expired := func() int {
+		t.Helper()
+		var n int
+		for _, s := range signers {
+			if counter(t, rl, db, s.Address(), 0) == 0 {
+				n++
+			}
+		}
+		return n
+	}
+	assert.Equal(t, 0, expired())
+
+	alice := weavetest.NewCondition()
+	ctx = auth.SetConditions(weave.WithHeight(context.Background(), 11), alice)
+	_, err = handler.Deliver(ctx, db, &weavetest.Tx{})
+	assert.Nil(t, err)
+	assert.Equal(t, rateLimitExpireBatch, expired())
+
+	_, err = handler.Deliver(ctx, db, &weavetest.Tx{})
+	assert.Nil(t, err)
+	assert.Equal(t, len(signers), expired())
+	assert.Equal(t, uint32(2), counter(t, rl, db, alice.Address(), 1))
+}
+
+func TestRateLimitWithoutConfiguration(t *testing.T) {
+	alice := weavetest.NewCondition()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rl := NewRateLimit(auth)
+	handler := weavetest.Decorate(&weavetest.Handler{}, rl)
+	db := store.MemStore()
+
+	ctx := auth.SetConditions(weave.WithHeight(context.Background(), 1), alice)
+	for i := 0; i < 3; i++ {
+		_, err := handler.Deliver(ctx, db, &weavetest.Tx{})
+		assert.Nil(t, err)
+		_, err = handler.Check(ctx, db, &weavetest.Tx{})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, uint32(0), counter(t, rl, db, alice.Address(), 0))
+}
+
+func TestRateLimitConfigurationValidate(t *testing.T) {
+	cases := map[string]struct {
+		conf RateLimitConfiguration
+		errs map[string]*errors.Error
+	}{
+		"valid": {
+			conf: RateLimitConfiguration{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Owner:        weavetest.NewCondition().Address(),
+				MaxTx:        1,
+				WindowBlocks: 1,
+			},
+			errs: map[string]*errors.Error{
+				"Metadata":     nil,
+				"Owner":        nil,
+				"MaxTx":        nil,
+				"WindowBlocks": nil,
+			},
+		},
+		"empty": {
+			conf: RateLimitConfiguration{},
+			errs: map[string]*errors.Error{
+				"Metadata":     errors.ErrMetadata,
+				"Owner":        errors.ErrEmpty,
+				"MaxTx":        errors.ErrInput,
+				"WindowBlocks": errors.ErrInput,
+			},
+		},
+		"negative window": {
+			conf: RateLimitConfiguration{WindowBlocks: -4},
+			errs: map[string]*errors.Error{
+				"WindowBlocks": errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.conf.Validate()
+			for field, wantErr := range tc.errs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+// counter returns the counter value of given address within given window.
+func counter(t testing.TB, rl RateLimit, db weave.KVStore, addr weave.Address, window uint64) uint32 {
+	t.Helper()
+	var c RateLimitCounter
+	switch err := rl.counters.One(db, rateLimitKey(window, addr), &c); {
+	case err == nil:
+		return c.Count
+	case errors.ErrNotFound.Is(err):
+		return 0
+	default:
+		t.Fatalf("cannot load counter: %s", err)
+		return 0
+	}
+}
+
+func TestUpdateRateLimitConfiguration(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "utils")
+	conf := RateLimitConfiguration{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Owner:        owner.Address(),
+		MaxTx:        2,
+		WindowBlocks: 10,
+	}
+	assert.Nil(t, gconf.Save(db, rateLimitConfPkg, &conf))
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	RegisterRoutes(rt, auth)
+
+	update := func(signer weave.Condition, patch *RateLimitConfiguration) error {
+		t.Helper()
+		ctx := auth.SetConditions(weave.WithHeight(context.Background(), 1), signer)
+		tx := &weavetest.Tx{Msg: &UpdateRateLimitConfigurationMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Patch:    patch,
+		}}
+		_, err := rt.Deliver(ctx, db, tx)
+		return err
+	}
+
+	if err := update(weavetest.NewCondition(), &RateLimitConfiguration{MaxTx: 5}); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if err := update(owner, nil); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want empty error, got %+v", err)
+	}
+	assert.Nil(t, update(owner, &RateLimitConfiguration{Metadata: &weave.Metadata{Schema: 1}, MaxTx: 5}))
+
+	got, err := loadRateLimitConf(db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(5), got.MaxTx)
+	assert.Equal(t, int64(10), got.WindowBlocks)
+}
+
+func TestRateLimitGenesisJSONRoundTrip(t *testing.T) {
+	const conf = `{
+		"metadata": {"schema": 1},
+		"owner": "0102030405060708090021222324252627282930",
+		"max_tx": 5,
+		"window_blocks": 10
+	}`
+	assert.JSONRoundTrip(t, []byte(conf), &RateLimitConfiguration{})
+}
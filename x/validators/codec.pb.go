@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
 	io "io"
 	math "math"
@@ -27,6 +28,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type ApplyDiffMsg struct {
 	Metadata         *weave.Metadata         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	ValidatorUpdates []weave.ValidatorUpdate `protobuf:"bytes,2,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	// When set, profiles of validators that are removed (power set to zero)
+	// are not deleted and are kept for the history.
+	KeepProfiles bool `protobuf:"varint,3,opt,name=keep_profiles,json=keepProfiles,proto3" json:"keep_profiles,omitempty"`
+	// Addresses allowed to manage the profile of validators. A registration
+	// replaces any previous one of the same validator. The validator must be
+	// present in the validator set after the diff is applied.
+	ValidatorAccounts []ValidatorAccount `protobuf:"bytes,4,rep,name=validator_accounts,json=validatorAccounts,proto3" json:"validator_accounts"`
 }
 
 func (m *ApplyDiffMsg) Reset()         { *m = ApplyDiffMsg{} }
@@ -76,10 +84,27 @@ func (m *ApplyDiffMsg) GetValidatorUpdates() []weave.ValidatorUpdate {
 	return nil
 }
 
+func (m *ApplyDiffMsg) GetKeepProfiles() bool {
+	if m != nil {
+		return m.KeepProfiles
+	}
+	return false
+}
+
+func (m *ApplyDiffMsg) GetValidatorAccounts() []ValidatorAccount {
+	if m != nil {
+		return m.ValidatorAccounts
+	}
+	return nil
+}
+
 // Accounts is a list of accounts allowed to update validators
 type Accounts struct {
 	Metadata  *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Addresses [][]byte        `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Validators holds the address registered for each validator that is
+	// allowed to manage the profile of that validator.
+	Validators []ValidatorAccount `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
 }
 
 func (m *Accounts) Reset()         { *m = Accounts{} }
@@ -129,30 +154,265 @@ func (m *Accounts) GetAddresses() [][]byte {
 	return nil
 }
 
+func (m *Accounts) GetValidators() []ValidatorAccount {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorAccount registers an address that is allowed to manage the profile
+// of the validator with given public key.
+type ValidatorAccount struct {
+	PubKey  weave.PubKey                     `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *ValidatorAccount) Reset()         { *m = ValidatorAccount{} }
+func (m *ValidatorAccount) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccount) ProtoMessage()    {}
+func (*ValidatorAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_596edf0ef2fd1c32, []int{2}
+}
+func (m *ValidatorAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccount.Merge(m, src)
+}
+func (m *ValidatorAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAccount proto.InternalMessageInfo
+
+func (m *ValidatorAccount) GetPubKey() weave.PubKey {
+	if m != nil {
+		return m.PubKey
+	}
+	return weave.PubKey{}
+}
+
+func (m *ValidatorAccount) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// ValidatorProfile contains human readable information about a validator. It
+// is stored under the validator public key data.
+type ValidatorProfile struct {
+	Metadata        *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PubKey          weave.PubKey    `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	Moniker         string          `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Website         string          `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	SecurityContact string          `protobuf:"bytes,5,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+}
+
+func (m *ValidatorProfile) Reset()         { *m = ValidatorProfile{} }
+func (m *ValidatorProfile) String() string { return proto.CompactTextString(m) }
+func (*ValidatorProfile) ProtoMessage()    {}
+func (*ValidatorProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_596edf0ef2fd1c32, []int{3}
+}
+func (m *ValidatorProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorProfile.Merge(m, src)
+}
+func (m *ValidatorProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorProfile proto.InternalMessageInfo
+
+func (m *ValidatorProfile) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ValidatorProfile) GetPubKey() weave.PubKey {
+	if m != nil {
+		return m.PubKey
+	}
+	return weave.PubKey{}
+}
+
+func (m *ValidatorProfile) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *ValidatorProfile) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
+	}
+	return ""
+}
+
+// SetValidatorProfileMsg creates or updates a validator profile. It must be
+// signed by the address registered for the validator in the accounts list.
+// Only a profile of a validator from the current validator set can be set.
+type SetValidatorProfileMsg struct {
+	Metadata        *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PubKey          weave.PubKey    `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	Moniker         string          `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Website         string          `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	SecurityContact string          `protobuf:"bytes,5,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+}
+
+func (m *SetValidatorProfileMsg) Reset()         { *m = SetValidatorProfileMsg{} }
+func (m *SetValidatorProfileMsg) String() string { return proto.CompactTextString(m) }
+func (*SetValidatorProfileMsg) ProtoMessage()    {}
+func (*SetValidatorProfileMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_596edf0ef2fd1c32, []int{4}
+}
+func (m *SetValidatorProfileMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetValidatorProfileMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetValidatorProfileMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetValidatorProfileMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetValidatorProfileMsg.Merge(m, src)
+}
+func (m *SetValidatorProfileMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetValidatorProfileMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetValidatorProfileMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetValidatorProfileMsg proto.InternalMessageInfo
+
+func (m *SetValidatorProfileMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetValidatorProfileMsg) GetPubKey() weave.PubKey {
+	if m != nil {
+		return m.PubKey
+	}
+	return weave.PubKey{}
+}
+
+func (m *SetValidatorProfileMsg) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *SetValidatorProfileMsg) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *SetValidatorProfileMsg) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplyDiffMsg)(nil), "validators.ApplyDiffMsg")
 	proto.RegisterType((*Accounts)(nil), "validators.Accounts")
+	proto.RegisterType((*ValidatorAccount)(nil), "validators.ValidatorAccount")
+	proto.RegisterType((*ValidatorProfile)(nil), "validators.ValidatorProfile")
+	proto.RegisterType((*SetValidatorProfileMsg)(nil), "validators.SetValidatorProfileMsg")
 }
 
 func init() { proto.RegisterFile("x/validators/codec.proto", fileDescriptor_596edf0ef2fd1c32) }
 
 var fileDescriptor_596edf0ef2fd1c32 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xa8, 0xd0, 0x2f, 0x4b,
-	0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0x2a, 0xd6, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x88, 0x4b, 0x71, 0x23, 0x49, 0x48, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x8d, 0x91, 0x8b, 0xc7, 0xb1, 0xa0,
-	0x20, 0xa7, 0xd2, 0x25, 0x33, 0x2d, 0xcd, 0xb7, 0x38, 0x5d, 0x48, 0x9b, 0x8b, 0x23, 0x37, 0xb5,
-	0x24, 0x31, 0x25, 0xb1, 0x24, 0x51, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x88, 0x5f, 0xaf, 0x3c,
-	0x35, 0xb1, 0x2c, 0x55, 0xcf, 0x17, 0x2a, 0x1c, 0x04, 0x57, 0x20, 0xe4, 0xc9, 0x25, 0x08, 0xb7,
-	0x2e, 0xbe, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48,
-	0x0c, 0xaa, 0x2b, 0x0c, 0x26, 0x1f, 0x0a, 0x96, 0x76, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x48,
-	0xa0, 0x0c, 0x55, 0xb8, 0x58, 0x29, 0x94, 0x8b, 0xc3, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4,
-	0x98, 0x34, 0x37, 0xc8, 0x70, 0x71, 0x26, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x43, 0xed, 0xe6,
-	0x09, 0x42, 0x08, 0x38, 0x49, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x12,
-	0x1b, 0x38, 0x00, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x18, 0x21, 0xae, 0x4b, 0x01,
-	0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x49, 0x68, 0xd2, 0x37, 0xa9, 0x9a, 0x9e, 0x50, 0x75, 0xaa, 0x2a, 0xd7, 0x32,
+	0x0c, 0x41, 0x80, 0x2d, 0x95, 0x1d, 0x29, 0x81, 0x05, 0xa1, 0x4a, 0xc5, 0x08, 0xd6, 0xe8, 0x6c,
+	0xbf, 0x09, 0x56, 0xfe, 0x9c, 0xe5, 0x3b, 0xbb, 0x64, 0xe3, 0x23, 0xb0, 0xf2, 0x8d, 0x3a, 0x30,
+	0x54, 0x4c, 0x4c, 0x15, 0x4a, 0xbe, 0x05, 0x03, 0x42, 0xb1, 0xcf, 0xb5, 0xc9, 0x02, 0xd9, 0xd8,
+	0xee, 0xfd, 0xbd, 0xef, 0xdd, 0xf3, 0x3c, 0xbe, 0x33, 0xb0, 0x8f, 0x4e, 0xca, 0x67, 0x61, 0xc0,
+	0x95, 0x88, 0xa5, 0xe3, 0x8b, 0x00, 0x7d, 0x3b, 0x8a, 0x85, 0x12, 0x14, 0x4a, 0x7e, 0xd2, 0xa9,
+	0x34, 0x4e, 0xee, 0x4f, 0xc4, 0x44, 0x64, 0x4b, 0x67, 0xb3, 0xca, 0xa9, 0xf5, 0x8b, 0x40, 0x77,
+	0x10, 0x45, 0xb3, 0xe5, 0xcb, 0x70, 0x3c, 0xbe, 0x90, 0x13, 0xfa, 0x18, 0xda, 0x73, 0x54, 0x3c,
+	0xe0, 0x8a, 0x33, 0x62, 0x92, 0x7e, 0xe7, 0xfc, 0xd0, 0xbe, 0x42, 0x9e, 0xa2, 0x7d, 0xa1, 0xb1,
+	0x7b, 0x37, 0x40, 0x5f, 0xc1, 0xd1, 0x9d, 0xdc, 0x28, 0x89, 0x02, 0xae, 0x50, 0xb2, 0xba, 0xd9,
+	0xe8, 0x77, 0xce, 0x8f, 0xf5, 0xae, 0xf7, 0x45, 0xff, 0x5d, 0xd6, 0x1e, 0x36, 0xaf, 0x6f, 0xcf,
+	0x6a, 0x6e, 0x2f, 0xfd, 0x13, 0x4b, 0xfa, 0x00, 0x0e, 0xa6, 0x88, 0xd1, 0x28, 0x8a, 0xc5, 0x38,
+	0x9c, 0xa1, 0x64, 0x0d, 0x93, 0xf4, 0xdb, 0x6e, 0x77, 0x03, 0x2f, 0x35, 0xa3, 0x6f, 0x80, 0x96,
+	0x7a, 0xdc, 0xf7, 0x45, 0xb2, 0x50, 0x92, 0x35, 0x33, 0xc1, 0x53, 0xbb, 0x4c, 0x5e, 0xaa, 0x0e,
+	0xf2, 0x21, 0x2d, 0x7b, 0x94, 0x6e, 0x71, 0x69, 0x7d, 0x21, 0xd0, 0x2e, 0x8a, 0xdd, 0xc2, 0x9f,
+	0xc2, 0x3e, 0x0f, 0x82, 0x18, 0xa5, 0xd4, 0xa1, 0xbb, 0x6e, 0x09, 0xe8, 0x10, 0x2a, 0x37, 0xc1,
+	0x1a, 0xff, 0x6c, 0xb1, 0xb2, 0xcb, 0xfa, 0x44, 0xa0, 0xb7, 0x3d, 0x46, 0x9f, 0x40, 0x2b, 0x4a,
+	0xbc, 0xd1, 0x14, 0x97, 0xda, 0xe2, 0x81, 0xb6, 0x78, 0x99, 0x78, 0xaf, 0x71, 0xa9, 0x8f, 0xd9,
+	0x8b, 0xb2, 0x8a, 0x3e, 0x87, 0x96, 0xf6, 0xc4, 0xea, 0x26, 0xe9, 0x77, 0x87, 0x0f, 0x7f, 0xde,
+	0x9e, 0x99, 0x93, 0x50, 0x7d, 0x48, 0x3c, 0xdb, 0x17, 0x73, 0x27, 0x14, 0xe9, 0x53, 0xb1, 0x40,
+	0x27, 0x3f, 0x63, 0x90, 0xcf, 0xba, 0xc5, 0x26, 0xeb, 0x6b, 0xd5, 0x82, 0xbe, 0x87, 0xdd, 0x3e,
+	0x53, 0xc5, 0x6f, 0xfd, 0xef, 0x7e, 0x19, 0xb4, 0xe6, 0x62, 0x11, 0x4e, 0x31, 0xce, 0x1e, 0xc0,
+	0xbe, 0x5b, 0x94, 0x9b, 0xce, 0x15, 0x7a, 0x32, 0x54, 0xc8, 0x9a, 0x79, 0x47, 0x97, 0xf4, 0x11,
+	0xf4, 0x24, 0xfa, 0x49, 0x1c, 0xaa, 0xe5, 0xc8, 0x17, 0x0b, 0xc5, 0x7d, 0xc5, 0xee, 0x65, 0x23,
+	0x87, 0x05, 0x7f, 0x91, 0x63, 0xeb, 0x1b, 0x81, 0xe3, 0xb7, 0xa8, 0xb6, 0x13, 0xed, 0xfc, 0xf0,
+	0xff, 0xa7, 0x50, 0x43, 0x76, 0xbd, 0x32, 0xc8, 0xcd, 0xca, 0x20, 0x3f, 0x56, 0x06, 0xf9, 0xbc,
+	0x36, 0x6a, 0x37, 0x6b, 0xa3, 0xf6, 0x7d, 0x6d, 0xd4, 0xbc, 0xbd, 0xec, 0x27, 0x7f, 0xf6, 0x7b,
+	0x00, 0xd0, 0x9a, 0xaa, 0xac, 0x2f, 0x04, 0x00, 0x00,
 }
 
 func (m *ApplyDiffMsg) Marshal() (dAtA []byte, err error) {
@@ -192,6 +452,28 @@ func (m *ApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.KeepProfiles {
+		dAtA[i] = 0x18
+		i++
+		if m.KeepProfiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ValidatorAccounts) > 0 {
+		for _, msg := range m.ValidatorAccounts {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -228,6 +510,158 @@ func (m *Accounts) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ValidatorAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.PubKey.Size()))
+	n3, err := m.PubKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *ValidatorProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.PubKey.Size()))
+	n5, err := m.PubKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if len(m.Moniker) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Moniker)))
+		i += copy(dAtA[i:], m.Moniker)
+	}
+	if len(m.Website) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Website)))
+		i += copy(dAtA[i:], m.Website)
+	}
+	if len(m.SecurityContact) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SecurityContact)))
+		i += copy(dAtA[i:], m.SecurityContact)
+	}
+	return i, nil
+}
+
+func (m *SetValidatorProfileMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetValidatorProfileMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.PubKey.Size()))
+	n7, err := m.PubKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if len(m.Moniker) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Moniker)))
+		i += copy(dAtA[i:], m.Moniker)
+	}
+	if len(m.Website) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Website)))
+		i += copy(dAtA[i:], m.Website)
+	}
+	if len(m.SecurityContact) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SecurityContact)))
+		i += copy(dAtA[i:], m.SecurityContact)
+	}
 	return i, nil
 }
 
@@ -256,6 +690,15 @@ func (m *ApplyDiffMsg) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if m.KeepProfiles {
+		n += 2
+	}
+	if len(m.ValidatorAccounts) > 0 {
+		for _, e := range m.ValidatorAccounts {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -275,23 +718,550 @@ func (m *Accounts) Size() (n int) {
 			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PubKey.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.PubKey.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SetValidatorProfileMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.PubKey.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyDiffMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyDiffMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, weave.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepProfiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepProfiles = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAccounts = append(m.ValidatorAccounts, ValidatorAccount{})
+			if err := m.ValidatorAccounts[len(m.ValidatorAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Accounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Accounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Accounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorAccount{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
+func (m *ValidatorProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -314,10 +1284,10 @@ func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyDiffMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyDiffMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -358,7 +1328,7 @@ func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -385,11 +1355,106 @@ func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorUpdates = append(m.ValidatorUpdates, weave.ValidatorUpdate{})
-			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -414,7 +1479,7 @@ func (m *ApplyDiffMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Accounts) Unmarshal(dAtA []byte) error {
+func (m *SetValidatorProfileMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -437,10 +1502,10 @@ func (m *Accounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Accounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetValidatorProfileMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Accounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetValidatorProfileMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -481,9 +1546,9 @@ func (m *Accounts) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -493,23 +1558,120 @@ func (m *Accounts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
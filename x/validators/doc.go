@@ -9,6 +9,12 @@ Power represents the voting power of the validator. To remove a validator the po
 Any operation requires a valid signature. The whitelist of addresses which is used for authz should be set in the genesis file
 and is persisted during init phase. It is recommended to use MultiSig contracts for managing validator operations.
 
+Each validator can have a profile with human readable information (moniker, website and security contact) set with the
+`SetValidatorProfileMsg` message. The message must be signed by the address registered for that validator, either in the
+genesis file or with `ApplyDiffMsg.ValidatorAccounts`, and only a profile of a validator from the current validator set can
+be set. A profile and the registered address are deleted when the validator is removed. The profile is kept if
+`ApplyDiffMsg.KeepProfiles` is set.
+
 */
 
 package validators
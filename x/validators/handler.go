@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
@@ -11,20 +12,30 @@ import (
 // all handlers in this package.
 func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 	bucket := NewAccountBucket()
+	profiles := NewProfileBucket()
 	r.Handle(&ApplyDiffMsg{}, migration.SchemaMigratingHandler("validators", &updateHandler{
-		auth:   auth,
-		bucket: bucket,
+		auth:     auth,
+		bucket:   bucket,
+		profiles: profiles,
+	}))
+	r.Handle(&SetValidatorProfileMsg{}, migration.SchemaMigratingHandler("validators", &setProfileHandler{
+		auth:     auth,
+		bucket:   bucket,
+		profiles: profiles,
 	}))
 }
 
-// RegisterQuery will register this bucket as "/validators".
+// RegisterQuery will register this bucket as "/validators" and the profiles
+// bucket as "/validatorprofiles".
 func RegisterQuery(qr weave.QueryRouter) {
 	NewAccountBucket().Register("validators", qr)
+	NewProfileBucket().Register("validatorprofiles", qr)
 }
 
 type updateHandler struct {
-	auth   x.Authenticator
-	bucket *AccountBucket
+	auth     x.Authenticator
+	bucket   *AccountBucket
+	profiles orm.ModelBucket
 }
 
 var _ weave.Handler = (*updateHandler)(nil)
@@ -37,7 +48,7 @@ func (h updateHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx
 }
 
 func (h updateHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	diff, updates, err := h.validate(ctx, store, tx)
+	msg, updates, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +57,55 @@ func (h updateHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 		return nil, errors.Wrap(err, "store validator updates")
 	}
 
+	diff := msg.ValidatorUpdates
+	if !msg.KeepProfiles {
+		// Profile of a removed validator is deleted, unless requested
+		// otherwise. Power change does not affect the profile.
+		for _, v := range diff {
+			if v.Power != 0 {
+				continue
+			}
+			switch err := h.profiles.Delete(store, v.PubKey.Data); {
+			case err == nil, errors.ErrNotFound.Is(err):
+				// Not every validator has a profile.
+			default:
+				return nil, errors.Wrap(err, "delete profile")
+			}
+		}
+	}
+
+	if err := h.updateValidatorAccounts(store, msg); err != nil {
+		return nil, err
+	}
+
 	return &weave.DeliverResult{Diff: diff}, nil
 }
 
-// Validate returns an update diff, ValidatorUpdates to store for bookkeeping and an error.
-func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) ([]weave.ValidatorUpdate,
+// updateValidatorAccounts removes the address registrations of removed
+// validators and stores the registrations of given message.
+func (h updateHandler) updateValidatorAccounts(store weave.KVStore, msg *ApplyDiffMsg) error {
+	accounts, err := h.bucket.GetAccounts(store)
+	if err != nil {
+		return err
+	}
+	for _, v := range msg.ValidatorUpdates {
+		if v.Power == 0 {
+			accounts.RemoveValidatorAddress(v.PubKey)
+		}
+	}
+	for _, v := range msg.ValidatorAccounts {
+		accounts.SetValidatorAddress(v.PubKey, v.Address)
+	}
+	obj := orm.NewSimpleObj([]byte(accountListKey), accounts)
+	if err := h.bucket.Save(store, obj); err != nil {
+		return errors.Wrap(err, "save accounts")
+	}
+	return nil
+}
+
+// Validate returns the message containing an update diff, ValidatorUpdates to
+// store for bookkeeping and an error.
+func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ApplyDiffMsg,
 	weave.ValidatorUpdates, error) {
 	var msg ApplyDiffMsg
 	var resUpdates weave.ValidatorUpdates
@@ -68,14 +123,7 @@ func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave
 		return nil, resUpdates, err
 	}
 
-	var hasPermission bool
-	for _, addr := range accounts.Addresses {
-		if h.auth.HasAddress(ctx, addr) {
-			hasPermission = true
-			break
-		}
-	}
-	if !hasPermission {
+	if !hasPermission(ctx, h.auth, accounts) {
 		return nil, resUpdates, errors.Wrap(errors.ErrUnauthorized, "no permission")
 	}
 
@@ -103,5 +151,83 @@ func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave
 	}
 
 	// Deduplicate updates for storage.
-	return diff, resUpdates.Deduplicate(true), nil
+	resUpdates = resUpdates.Deduplicate(true)
+
+	for i, v := range msg.ValidatorAccounts {
+		if _, _, ok := resUpdates.Get(v.PubKey); !ok {
+			return nil, resUpdates, errors.Wrapf(errors.ErrInput, "validator account %d: not a validator", i)
+		}
+	}
+
+	return &msg, resUpdates, nil
+}
+
+// hasPermission returns true if any of the accounts allowed to update
+// validators signed the transaction.
+func hasPermission(ctx weave.Context, auth x.Authenticator, accounts *Accounts) bool {
+	for _, addr := range accounts.Addresses {
+		if auth.HasAddress(ctx, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+type setProfileHandler struct {
+	auth     x.Authenticator
+	bucket   *AccountBucket
+	profiles orm.ModelBucket
+}
+
+var _ weave.Handler = (*setProfileHandler)(nil)
+
+func (h setProfileHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h setProfileHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	profile := ValidatorProfile{
+		Metadata:        &weave.Metadata{Schema: 1},
+		PubKey:          msg.PubKey,
+		Moniker:         msg.Moniker,
+		Website:         msg.Website,
+		SecurityContact: msg.SecurityContact,
+	}
+	if _, err := h.profiles.Put(store, msg.PubKey.Data, &profile); err != nil {
+		return nil, errors.Wrap(err, "store profile")
+	}
+	return &weave.DeliverResult{Data: msg.PubKey.Data}, nil
+}
+
+func (h setProfileHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetValidatorProfileMsg, error) {
+	var msg SetValidatorProfileMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	validators, err := weave.GetValidatorUpdates(store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query validators")
+	}
+	if _, _, ok := validators.Get(msg.PubKey); !ok {
+		return nil, errors.Wrap(errors.ErrNotFound, "not a current validator")
+	}
+	accounts, err := h.bucket.GetAccounts(store)
+	if err != nil {
+		return nil, err
+	}
+	addr, ok := accounts.ValidatorAddress(msg.PubKey)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "no address registered for the validator")
+	}
+	if !h.auth.HasAddress(ctx, addr) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "validator address signature required")
+	}
+	return &msg, nil
 }
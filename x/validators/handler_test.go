@@ -181,3 +181,253 @@ func TestHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestProfileHandler(t *testing.T) {
+	alice := weavetest.NewKey()
+	bobby := weavetest.NewKey()
+
+	alicePubKey := weave.PubKey{Data: alice.PublicKey().GetEd25519(), Type: "ed25519"}
+	bobbyPubKey := weave.PubKey{Data: bobby.PublicKey().GetEd25519(), Type: "ed25519"}
+
+	specs := map[string]struct {
+		AuthzAddress weave.Address
+		// ValidatorAddress is registered for alice validator. Bobby
+		// is registered with alice address but is not a validator.
+		ValidatorAddress weave.Address
+		Msgs             []weave.Msg
+		// WantErr is the error expected from the last message. All
+		// previous messages must succeed.
+		WantErr     *errors.Error
+		WantProfile *ValidatorProfile
+	}{
+		"authorized address can set a profile": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata:        &weave.Metadata{Schema: 1},
+					PubKey:          alicePubKey,
+					Moniker:         "alice",
+					Website:         "https://alice.example.com",
+					SecurityContact: "security@alice.example.com",
+				},
+			},
+			WantProfile: &ValidatorProfile{
+				Metadata:        &weave.Metadata{Schema: 1},
+				PubKey:          alicePubKey,
+				Moniker:         "alice",
+				Website:         "https://alice.example.com",
+				SecurityContact: "security@alice.example.com",
+			},
+		},
+		"profile can be updated": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+					Website:  "https://alice.example.com",
+				},
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice2",
+				},
+			},
+			WantProfile: &ValidatorProfile{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   alicePubKey,
+				Moniker:  "alice2",
+			},
+		},
+		"power change does not affect the profile": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+				&ApplyDiffMsg{
+					Metadata:         &weave.Metadata{Schema: 1},
+					ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 7}},
+				},
+			},
+			WantProfile: &ValidatorProfile{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   alicePubKey,
+				Moniker:  "alice",
+			},
+		},
+		"removing a validator deletes the profile": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+				&ApplyDiffMsg{
+					Metadata:         &weave.Metadata{Schema: 1},
+					ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 0}},
+				},
+			},
+			WantProfile: nil,
+		},
+		"removing a validator can keep the profile": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+				&ApplyDiffMsg{
+					Metadata:         &weave.Metadata{Schema: 1},
+					ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 0}},
+					KeepProfiles:     true,
+				},
+			},
+			WantProfile: &ValidatorProfile{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   alicePubKey,
+				Moniker:  "alice",
+			},
+		},
+		"address registered via a diff can set a profile": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: bobby.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&ApplyDiffMsg{
+					Metadata:          &weave.Metadata{Schema: 1},
+					ValidatorUpdates:  []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 2}},
+					ValidatorAccounts: []ValidatorAccount{{PubKey: alicePubKey, Address: alice.PublicKey().Address()}},
+				},
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+			},
+			WantProfile: &ValidatorProfile{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   alicePubKey,
+				Moniker:  "alice",
+			},
+		},
+		"whitelisted address cannot set a profile of a validator registered with another address": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: bobby.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+			},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"profile of a public key that is not a current validator cannot be set": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   bobbyPubKey,
+					Moniker:  "bobby",
+				},
+			},
+			WantErr: errors.ErrNotFound,
+		},
+		"profile of a removed validator cannot be set": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&ApplyDiffMsg{
+					Metadata:         &weave.Metadata{Schema: 1},
+					ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 0}},
+				},
+				&SetValidatorProfileMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   alicePubKey,
+					Moniker:  "alice",
+				},
+			},
+			WantErr: errors.ErrNotFound,
+		},
+		"address cannot be registered for a public key that is not a validator": {
+			AuthzAddress:     alice.PublicKey().Address(),
+			ValidatorAddress: alice.PublicKey().Address(),
+			Msgs: []weave.Msg{
+				&ApplyDiffMsg{
+					Metadata:          &weave.Metadata{Schema: 1},
+					ValidatorUpdates:  []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 2}},
+					ValidatorAccounts: []ValidatorAccount{{PubKey: bobbyPubKey, Address: bobby.PublicKey().Address()}},
+				},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	auth := &weavetest.Auth{
+		Signer: alice.PublicKey().Condition(),
+	}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth)
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "validators")
+			ctx := context.Background()
+			err := NewAccountBucket().Save(db, AccountsWith(WeaveAccounts{
+				Addresses: []weave.Address{spec.AuthzAddress},
+				Validators: []ValidatorAccount{
+					{PubKey: alicePubKey, Address: spec.ValidatorAddress},
+					{PubKey: bobbyPubKey, Address: alice.PublicKey().Address()},
+				},
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			initial := weave.ValidatorUpdates{ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: alicePubKey, Power: 1}}}
+			if err := weave.StoreValidatorUpdates(db, initial); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for i, m := range spec.Msgs {
+				tx := &weavetest.Tx{Msg: m}
+				var wantErr *errors.Error
+				if i == len(spec.Msgs)-1 {
+					wantErr = spec.WantErr
+				}
+				if _, err := rt.Deliver(ctx, db, tx); !wantErr.Is(err) {
+					t.Fatalf("message %d: want %q error, got %+v", i, wantErr, err)
+				}
+			}
+			if spec.WantErr != nil {
+				return
+			}
+
+			var profile ValidatorProfile
+			err = NewProfileBucket().One(db, alicePubKey.Data, &profile)
+			if spec.WantProfile == nil {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want profile to be deleted, got %+v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot load profile: %s", err)
+			}
+			if !reflect.DeepEqual(spec.WantProfile, &profile) {
+				t.Errorf("want %v profile, got %v", spec.WantProfile, &profile)
+			}
+		})
+	}
+}
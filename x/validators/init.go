@@ -13,7 +13,12 @@ const (
 // the genesis file
 type Initializer struct{}
 
-var _ weave.Initializer = Initializer{}
+var _ weave.DependentInitializer = Initializer{}
+
+// Dependencies implements weave.DependentInitializer.
+func (Initializer) Dependencies() []string {
+	return []string{"migration"}
+}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
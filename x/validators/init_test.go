@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/tendermint/tendermint/abci/types"
 )
 
@@ -25,7 +26,18 @@ func TestInitState(t *testing.T) {
 	}{
 		"Init with addresses": {
 			State: weave.Options{optKey: []byte(`{"addresses":["0102030405060708090021222324252627282930", "0B0C0D0E0F101112130A21222324252627282930"]}`)},
-			Exp:   &WeaveAccounts{[]weave.Address{alice, bert}},
+			Exp:   &WeaveAccounts{Addresses: []weave.Address{alice, bert}},
+		},
+		"Init with validator addresses": {
+			State: weave.Options{optKey: []byte(`{"addresses":["0102030405060708090021222324252627282930"], "validators":[{"pub_key":{"type":"ed25519","data":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="},"address":"0B0C0D0E0F101112130A21222324252627282930"}]}`)},
+			Exp: &WeaveAccounts{
+				Addresses:  []weave.Address{alice},
+				Validators: []ValidatorAccount{{PubKey: weave.PubKey{Type: "ed25519", Data: make([]byte, 32)}, Address: bert}},
+			},
+		},
+		"Init fails with a validator registered twice": {
+			State:    weave.Options{optKey: []byte(`{"addresses":["0102030405060708090021222324252627282930"], "validators":[{"pub_key":{"type":"ed25519","data":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="},"address":"0B0C0D0E0F101112130A21222324252627282930"},{"pub_key":{"type":"ed25519","data":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="},"address":"0102030405060708090021222324252627282930"}]}`)},
+			ExpError: errors.ErrDuplicate,
 		},
 		"Init works with no appState data": {
 			State: weave.Options{},
@@ -113,3 +125,13 @@ func TestInitState(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisJSONRoundTrip(t *testing.T) {
+	const genesis = `{
+		"addresses": [
+			"0102030405060708090021222324252627282930",
+			"seq:test/alice/1"
+		]
+	}`
+	assert.JSONRoundTrip(t, []byte(genesis), &WeaveAccounts{})
+}
@@ -1,6 +1,7 @@
 package validators
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/iov-one/weave"
@@ -11,6 +12,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Accounts{}, migration.NoModification)
+	migration.MustRegister(1, &ValidatorProfile{}, migration.NoModification)
 }
 
 const (
@@ -22,7 +24,8 @@ const (
 // WeaveAccounts is used to parse the json from genesis file
 // use weave.Address, so address in hex, not base64
 type WeaveAccounts struct {
-	Addresses []weave.Address `json:"addresses"`
+	Addresses  []weave.Address    `json:"addresses"`
+	Validators []ValidatorAccount `json:"validators,omitempty"`
 }
 
 func (wa WeaveAccounts) Validate() error {
@@ -30,6 +33,7 @@ func (wa WeaveAccounts) Validate() error {
 	for i, v := range wa.Addresses {
 		errs = errors.AppendField(errs, fmt.Sprintf("Addresses.%d", i), v.Validate())
 	}
+	errs = errors.Append(errs, validateValidatorAccounts("Validators", wa.Validators))
 	return errs
 }
 
@@ -38,7 +42,7 @@ func AsWeaveAccounts(a *Accounts) WeaveAccounts {
 	for k, v := range a.Addresses {
 		addrs[k] = weave.Address(v)
 	}
-	return WeaveAccounts{Addresses: addrs}
+	return WeaveAccounts{Addresses: addrs, Validators: a.Validators}
 }
 
 func AsAccounts(a WeaveAccounts) *Accounts {
@@ -47,8 +51,9 @@ func AsAccounts(a WeaveAccounts) *Accounts {
 		addrs[k] = []byte(v)
 	}
 	return &Accounts{
-		Metadata:  &weave.Metadata{Schema: 1},
-		Addresses: addrs,
+		Metadata:   &weave.Metadata{Schema: 1},
+		Addresses:  addrs,
+		Validators: a.Validators,
 	}
 }
 
@@ -59,6 +64,68 @@ func (m *Accounts) Validate() error {
 	return errs
 }
 
+// ValidatorAddress returns the address registered for the validator with
+// given public key.
+func (m *Accounts) ValidatorAddress(pubkey weave.PubKey) (weave.Address, bool) {
+	for _, v := range m.Validators {
+		if samePubKey(v.PubKey, pubkey) {
+			return v.Address, true
+		}
+	}
+	return nil, false
+}
+
+// SetValidatorAddress registers given address for the validator with given
+// public key, replacing any previous registration.
+func (m *Accounts) SetValidatorAddress(pubkey weave.PubKey, addr weave.Address) {
+	for i, v := range m.Validators {
+		if samePubKey(v.PubKey, pubkey) {
+			m.Validators[i].Address = addr
+			return
+		}
+	}
+	m.Validators = append(m.Validators, ValidatorAccount{PubKey: pubkey, Address: addr})
+}
+
+// RemoveValidatorAddress removes the address registered for the validator
+// with given public key, if any.
+func (m *Accounts) RemoveValidatorAddress(pubkey weave.PubKey) {
+	for i, v := range m.Validators {
+		if samePubKey(v.PubKey, pubkey) {
+			m.Validators = append(m.Validators[:i], m.Validators[i+1:]...)
+			return
+		}
+	}
+}
+
+func samePubKey(a, b weave.PubKey) bool {
+	return a.Type == b.Type && bytes.Equal(a.Data, b.Data)
+}
+
+func (m *ValidatorAccount) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "PubKey", validatePubKey(m.PubKey))
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	return errs
+}
+
+// validateValidatorAccounts validates each of given registrations and
+// ensures that no validator is registered more than once.
+func validateValidatorAccounts(field string, accounts []ValidatorAccount) error {
+	var errs error
+	for i, v := range accounts {
+		errs = errors.AppendField(errs, fmt.Sprintf("%s.%d", field, i), v.Validate())
+		for _, prev := range accounts[:i] {
+			if samePubKey(prev.PubKey, v.PubKey) {
+				errs = errors.AppendField(errs, fmt.Sprintf("%s.%d", field, i),
+					errors.Wrap(errors.ErrDuplicate, "validator already registered"))
+				break
+			}
+		}
+	}
+	return errs
+}
+
 type AccountBucket struct {
 	orm.Bucket
 }
@@ -88,3 +155,51 @@ func AccountsWith(acct WeaveAccounts) orm.Object {
 	acc := AsAccounts(acct)
 	return orm.NewSimpleObj([]byte(accountListKey), acc)
 }
+
+const (
+	maxMonikerLength         = 70
+	maxWebsiteLength         = 140
+	maxSecurityContactLength = 140
+)
+
+var _ orm.Model = (*ValidatorProfile)(nil)
+
+func (m *ValidatorProfile) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PubKey", validatePubKey(m.PubKey))
+	errs = errors.Append(errs, validateProfileInfo(m.Moniker, m.Website, m.SecurityContact))
+	return errs
+}
+
+func validatePubKey(k weave.PubKey) error {
+	// Public key is validated in the same way as for a validator update.
+	return weave.ValidatorUpdate{PubKey: k}.Validate()
+}
+
+func validateProfileInfo(moniker, website, contact string) error {
+	var errs error
+	switch n := len(moniker); {
+	case n == 0:
+		errs = errors.AppendField(errs, "Moniker", errors.ErrEmpty)
+	case n > maxMonikerLength:
+		errs = errors.AppendField(errs, "Moniker",
+			errors.Wrapf(errors.ErrInput, "must not be longer than %d characters", maxMonikerLength))
+	}
+	if len(website) > maxWebsiteLength {
+		errs = errors.AppendField(errs, "Website",
+			errors.Wrapf(errors.ErrInput, "must not be longer than %d characters", maxWebsiteLength))
+	}
+	if len(contact) > maxSecurityContactLength {
+		errs = errors.AppendField(errs, "SecurityContact",
+			errors.Wrapf(errors.ErrInput, "must not be longer than %d characters", maxSecurityContactLength))
+	}
+	return errs
+}
+
+// NewProfileBucket returns a bucket for storing validator profiles. Each
+// profile is stored under the validator public key data.
+func NewProfileBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("valprof", &ValidatorProfile{})
+	return migration.NewModelBucket("validators", b)
+}
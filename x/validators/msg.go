@@ -11,6 +11,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &ApplyDiffMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetValidatorProfileMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*ApplyDiffMsg)(nil)
@@ -29,6 +30,7 @@ func (m *ApplyDiffMsg) Validate() error {
 	for i, v := range m.ValidatorUpdates {
 		errs = errors.AppendField(errs, fmt.Sprintf("ValidatorUpdates.%d", i), v.Validate())
 	}
+	errs = errors.Append(errs, validateValidatorAccounts("ValidatorAccounts", m.ValidatorAccounts))
 	return errs
 }
 
@@ -40,3 +42,18 @@ func (m *ApplyDiffMsg) AsABCI() []abci.ValidatorUpdate {
 
 	return validators
 }
+
+var _ weave.Msg = (*SetValidatorProfileMsg)(nil)
+
+// Path implements weave.Msg interface.
+func (*SetValidatorProfileMsg) Path() string {
+	return "validators/set_validator_profile"
+}
+
+func (m *SetValidatorProfileMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PubKey", validatePubKey(m.PubKey))
+	errs = errors.Append(errs, validateProfileInfo(m.Moniker, m.Website, m.SecurityContact))
+	return errs
+}
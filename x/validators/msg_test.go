@@ -1,6 +1,7 @@
 package validators
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -48,3 +49,82 @@ func TestValidateSetValidatorMsg(t *testing.T) {
 	}
 
 }
+
+func TestValidateSetValidatorProfileMsg(t *testing.T) {
+	pubkey := weave.PubKey{
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+		Type: "ed25519",
+	}
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid model": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				PubKey:          pubkey,
+				Moniker:         "my validator",
+				Website:         "https://example.com",
+				SecurityContact: "security@example.com",
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &SetValidatorProfileMsg{
+				PubKey:  pubkey,
+				Moniker: "my validator",
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"invalid public key": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   weave.PubKey{Data: []byte{1, 2, 3}, Type: "ed25519"},
+				Moniker:  "my validator",
+			},
+			WantErr: errors.ErrType,
+		},
+		"missing moniker": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   pubkey,
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"moniker too long": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   pubkey,
+				Moniker:  strings.Repeat("x", maxMonikerLength+1),
+			},
+			WantErr: errors.ErrInput,
+		},
+		"website too long": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   pubkey,
+				Moniker:  "my validator",
+				Website:  strings.Repeat("x", maxWebsiteLength+1),
+			},
+			WantErr: errors.ErrInput,
+		},
+		"security contact too long": {
+			Msg: &SetValidatorProfileMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				PubKey:          pubkey,
+				Moniker:         "my validator",
+				SecurityContact: strings.Repeat("x", maxSecurityContactLength+1),
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}